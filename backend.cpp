@@ -2,6 +2,9 @@
 #define CIMGUI_USE_GLFW
 #define CIMGUI_USE_OPENGL3
 
+#include <stdlib.h>
+#include <string.h>
+
 #include "backend.h"
 #include "cimgui/cimgui.h"
 #include "cimgui/cimgui_impl.h"
@@ -17,28 +20,188 @@
 #pragma comment(lib, "legacy_stdio_definitions")
 #endif
 
-#define MAX_EXTRA_FRAME_COUNT 15
-unsigned int glfw_target_fps = 30;
-int extra_frame_count = MAX_EXTRA_FRAME_COUNT;
+// Windows only ships OpenGL 1.1 headers
+#ifndef GL_CLAMP_TO_EDGE
+#define GL_CLAMP_TO_EDGE 0x812F
+#endif
+#ifndef GL_MIRRORED_REPEAT
+#define GL_MIRRORED_REPEAT 0x8370
+#endif
 
-void glfw_render(GLFWwindow *window, VoidCallback renderLoop);
+extern "C" void glfwWindowRefreshCallback(GLFWwindow *window);
+extern "C" void glfwWindowDropCallback(GLFWwindow *window, int count, const char **paths);
+extern "C" bool glfwWindowCloseCallback(GLFWwindow *window);
+extern "C" void glfwWindowFocusCallback(GLFWwindow *window, int focused);
+extern "C" void glfwWindowSizeCallback(GLFWwindow *window, int width, int height);
+extern "C" void glfwWindowContentScaleCallback(GLFWwindow *window, float x, float y);
+
+// igGLFWWindowData is attached, as GLFW user pointer, to a window created by
+// igCreateGLFWWindow and to the viewport windows of its context, so events
+// polled for all windows at once reach the right dear imgui context.
+struct igGLFWWindowData {
+  GLFWwindow *window;
+  ImGuiContext *context;
+  char *ini_filename;
+  ImVec4 clear_color;
+  igGLFWWindowData *next;
+};
 
-void igSetTargetFPS(unsigned int fps) { glfw_target_fps = fps; }
+static igGLFWWindowData *glfw_windows = NULL;
+
+static GLFWcursor *glfw_standard_cursors[ImGuiMouseCursor_COUNT];
+
+// Callbacks installed by imgui_impl_glfw on viewport windows, they are the same for every window.
+static void (*glfw_impl_create_window)(ImGuiViewport *viewport) = NULL;
+static GLFWwindowclosefun glfw_impl_window_close_callback = NULL;
+static GLFWwindowposfun glfw_impl_window_pos_callback = NULL;
+static GLFWwindowsizefun glfw_impl_window_size_callback = NULL;
 
 static void glfw_error_callback(int error, const char *description) { fprintf(stderr, "Glfw Error %d: %s\n", error, description); }
 
-void glfw_window_refresh_callback(GLFWwindow *window) {
-  VoidCallback loopFunc = (VoidCallback)(glfwGetWindowUserPointer(window));
-  glfw_render(window, loopFunc);
+static igGLFWWindowData *glfw_window_data(GLFWwindow *window) { return (igGLFWWindowData *)glfwGetWindowUserPointer(window); }
+
+// igContextScope makes the context of a window current for its lifetime.
+struct igContextScope {
+  ImGuiContext *backup;
+
+  igContextScope(GLFWwindow *window) : backup(igGetCurrentContext()) {
+    igGLFWWindowData *data = glfw_window_data(window);
+    if (data != NULL)
+      igSetCurrentContext(data->context);
+  }
+
+  ~igContextScope() { igSetCurrentContext(backup); }
+};
+
+// glfw_is_main_window reports whether window was created by igCreateGLFWWindow, rather than for a viewport.
+static bool glfw_is_main_window(GLFWwindow *window) {
+  igGLFWWindowData *data = glfw_window_data(window);
+  return data != NULL && data->window == window;
+}
+
+static void glfw_window_focus_callback(GLFWwindow *window, int focused) {
+  igContextScope scope(window);
+  ImGui_ImplGlfw_WindowFocusCallback(window, focused);
+
+  if (glfw_is_main_window(window))
+    glfwWindowFocusCallback(window, focused);
+}
+
+static void glfw_cursor_enter_callback(GLFWwindow *window, int entered) {
+  igContextScope scope(window);
+  ImGui_ImplGlfw_CursorEnterCallback(window, entered);
+}
+
+static void glfw_cursor_pos_callback(GLFWwindow *window, double x, double y) {
+  igContextScope scope(window);
+  ImGui_ImplGlfw_CursorPosCallback(window, x, y);
+}
+
+static void glfw_mouse_button_callback(GLFWwindow *window, int button, int action, int mods) {
+  igContextScope scope(window);
+  ImGui_ImplGlfw_MouseButtonCallback(window, button, action, mods);
+}
+
+static void glfw_scroll_callback(GLFWwindow *window, double xoffset, double yoffset) {
+  igContextScope scope(window);
+  ImGui_ImplGlfw_ScrollCallback(window, xoffset, yoffset);
+}
+
+static void glfw_key_callback(GLFWwindow *window, int key, int scancode, int action, int mods) {
+  igContextScope scope(window);
+  ImGui_ImplGlfw_KeyCallback(window, key, scancode, action, mods);
+}
+
+static void glfw_char_callback(GLFWwindow *window, unsigned int c) {
+  igContextScope scope(window);
+  ImGui_ImplGlfw_CharCallback(window, c);
 }
 
-GLFWwindow *igCreateGLFWWindow(const char *title, int width, int height, GLFWWindowFlags flags) {
+static void glfw_viewport_close_callback(GLFWwindow *window) {
+  igContextScope scope(window);
+  glfw_impl_window_close_callback(window);
+}
+
+static void glfw_viewport_pos_callback(GLFWwindow *window, int x, int y) {
+  igContextScope scope(window);
+  glfw_impl_window_pos_callback(window, x, y);
+}
+
+static void glfw_viewport_size_callback(GLFWwindow *window, int width, int height) {
+  igContextScope scope(window);
+  glfw_impl_window_size_callback(window, width, height);
+}
+
+static void glfw_monitor_callback(GLFWmonitor *monitor, int event) {
+  ImGuiContext *backup = igGetCurrentContext();
+  for (igGLFWWindowData *data = glfw_windows; data != NULL; data = data->next) {
+    igSetCurrentContext(data->context);
+    ImGui_ImplGlfw_MonitorCallback(monitor, event);
+  }
+  igSetCurrentContext(backup);
+}
+
+static void glfw_window_refresh_callback(GLFWwindow *window) { glfwWindowRefreshCallback(window); }
+
+static void glfw_drop_callback(GLFWwindow *window, int count, const char **paths) {
+  igContextScope scope(window);
+  glfwWindowDropCallback(window, count, paths);
+}
+
+static void glfw_window_close_callback(GLFWwindow *window) {
+  igContextScope scope(window);
+  if (!glfwWindowCloseCallback(window))
+    glfwSetWindowShouldClose(window, GLFW_FALSE);
+}
+
+static void glfw_window_size_callback(GLFWwindow *window, int width, int height) {
+  igContextScope scope(window);
+  glfwWindowSizeCallback(window, width, height);
+}
+
+static void glfw_window_content_scale_callback(GLFWwindow *window, float x, float y) {
+  igContextScope scope(window);
+  glfwWindowContentScaleCallback(window, x, y);
+}
+
+static void glfw_install_callbacks(GLFWwindow *window) {
+  glfwSetWindowFocusCallback(window, glfw_window_focus_callback);
+  glfwSetCursorEnterCallback(window, glfw_cursor_enter_callback);
+  glfwSetCursorPosCallback(window, glfw_cursor_pos_callback);
+  glfwSetMouseButtonCallback(window, glfw_mouse_button_callback);
+  glfwSetScrollCallback(window, glfw_scroll_callback);
+  glfwSetKeyCallback(window, glfw_key_callback);
+  glfwSetCharCallback(window, glfw_char_callback);
+}
+
+// glfw_create_viewport_window wraps the Platform_CreateWindow of imgui_impl_glfw
+// to route the events of the new window to the context of its main window.
+static void glfw_create_viewport_window(ImGuiViewport *viewport) {
+  glfw_impl_create_window(viewport);
+
+  GLFWwindow *window = (GLFWwindow *)viewport->PlatformHandle;
+  GLFWwindow *main_window = (GLFWwindow *)igGetMainViewport()->PlatformHandle;
+  glfwSetWindowUserPointer(window, glfwGetWindowUserPointer(main_window));
+
+  glfw_install_callbacks(window);
+  glfw_impl_window_close_callback = glfwSetWindowCloseCallback(window, glfw_viewport_close_callback);
+  glfw_impl_window_pos_callback = glfwSetWindowPosCallback(window, glfw_viewport_pos_callback);
+  glfw_impl_window_size_callback = glfwSetWindowSizeCallback(window, glfw_viewport_size_callback);
+}
+
+GLFWwindow *igCreateGLFWWindow(const char *title, int width, int height, const GLFWWindowOptions *options, GLFWwindow *shared) {
+  igGLFWWindowData *shared_data = shared != NULL ? glfw_window_data(shared) : NULL;
+  GLFWWindowFlags flags = options->flags;
+
   // Setup window
   glfwSetErrorCallback(glfw_error_callback);
   if (!glfwInit())
     return NULL;
 
-    // Decide GL+GLSL versions
+  // Hints are kept between windows, start from the defaults
+  glfwDefaultWindowHints();
+
+  // Decide GL+GLSL versions
 #if defined(IMGUI_IMPL_OPENGL_ES2)
   // GL ES 2.0 + GLSL 100
   const char *glsl_version = "#version 100";
@@ -61,6 +224,25 @@ GLFWwindow *igCreateGLFWWindow(const char *title, int width, int height, GLFWWin
   // only glfwWindowHint(GLFW_OPENGL_FORWARD_COMPAT, GL_TRUE); // 3.0+ only
 #endif
 
+  if (options->gl_major > 0) {
+    glfwWindowHint(GLFW_CONTEXT_VERSION_MAJOR, options->gl_major);
+    glfwWindowHint(GLFW_CONTEXT_VERSION_MINOR, options->gl_minor);
+    if (options->gl_major > 3 || (options->gl_major == 3 && options->gl_minor >= 2)) {
+      glfwWindowHint(GLFW_OPENGL_PROFILE, GLFW_OPENGL_CORE_PROFILE);
+#if defined(__APPLE__)
+      glfwWindowHint(GLFW_OPENGL_FORWARD_COMPAT, GL_TRUE);
+#endif
+    }
+  }
+
+  if (options->glsl_version != NULL) {
+    glsl_version = options->glsl_version;
+  }
+
+  if (options->samples > 0) {
+    glfwWindowHint(GLFW_SAMPLES, options->samples);
+  }
+
   if ((flags & GLFWWindowFlagsNotResizable) != 0) {
     glfwWindowHint(GLFW_RESIZABLE, GLFW_FALSE);
   }
@@ -74,37 +256,82 @@ GLFWwindow *igCreateGLFWWindow(const char *title, int width, int height, GLFWWin
   }
 
   if ((flags & GLFWWindowFlagsFrameless) != 0) {
-    glfwWindowHint(GLFW_FLOATING, GLFW_TRUE);
+    glfwWindowHint(GLFW_DECORATED, GLFW_FALSE);
   }
 
   if ((flags & GLFWWindowFlagsTransparent) != 0) {
     glfwWindowHint(GLFW_TRANSPARENT_FRAMEBUFFER, GLFW_TRUE);
   }
 
+  // Show the window once it is at its initial position
+  bool place = options->has_position || options->monitor >= 0;
+  if (place) {
+    glfwWindowHint(GLFW_VISIBLE, GLFW_FALSE);
+  }
+
   // Create window with graphics context
-  GLFWwindow *window = glfwCreateWindow(width, height, title, NULL, NULL);
-  if (window == NULL)
+  GLFWwindow *window = glfwCreateWindow(width, height, title, NULL, shared);
+  if (window == NULL) {
+    if (glfw_windows == NULL)
+      glfwTerminate();
     return NULL;
+  }
   glfwMakeContextCurrent(window);
-  glfwSwapInterval(1); // Enable vsync
+  glfwSwapInterval(options->vsync ? 1 : 0);
+
+  if (place) {
+    int monitor_x = 0, monitor_y = 0, monitor_w = 0, monitor_h = 0;
+    if (options->monitor >= 0) {
+      int count;
+      GLFWmonitor **monitors = glfwGetMonitors(&count);
+      GLFWmonitor *monitor = options->monitor < count ? monitors[options->monitor] : glfwGetPrimaryMonitor();
+      if (monitor != NULL)
+        glfwGetMonitorWorkarea(monitor, &monitor_x, &monitor_y, &monitor_w, &monitor_h);
+    }
+
+    if (options->has_position) {
+      glfwSetWindowPos(window, monitor_x + options->x, monitor_y + options->y);
+    } else {
+      glfwSetWindowPos(window, monitor_x + (monitor_w - width) / 2, monitor_y + (monitor_h - height) / 2);
+    }
 
-  // Setup Dear ImGui context
-  igCreateContext(0);
+    glfwShowWindow(window);
+  }
+
+  // Setup Dear ImGui context, sharing the font atlas with the shared window if any.
+  // The atlas is then freed with the last context using it.
+  ImFontAtlas *shared_atlas = NULL;
+  if (shared_data != NULL) {
+    shared_atlas = shared_data->context->IO.Fonts;
+    shared_data->context->FontAtlasOwnedByContext = false;
+  }
+
+  ImGuiContext *context = igCreateContext(shared_atlas);
+  igSetCurrentContext(context);
   ImGuiIO *io = igGetIO();
-  io->ConfigFlags |= ImGuiConfigFlags_NavEnableKeyboard; // Enable Keyboard Controls
-  // io.ConfigFlags |= ImGuiConfigFlags_NavEnableGamepad;      // Enable Gamepad
-  // Controls
-  io->ConfigFlags |= ImGuiConfigFlags_DockingEnable;   // Enable Docking
-  io->ConfigFlags |= ImGuiConfigFlags_ViewportsEnable; // Enable Multi-Viewport
-                                                       // / Platform Windows
+  io->ConfigFlags = options->config_flags;
   // io.ConfigViewportsNoAutoMerge = true;
   // io.ConfigViewportsNoTaskBarIcon = true;
 
-  io->IniFilename = "";
+  // The context keeps a pointer to the file name, so it lives with the window
+  char *ini_filename = NULL;
+  if (options->ini_filename != NULL) {
+    ini_filename = strdup(options->ini_filename);
+  }
+  io->IniFilename = ini_filename;
 
   // Setup Dear ImGui style
-  igStyleColorsDark(0);
-  // igStyleColorsLight();
+  switch (options->style) {
+  case GLFWStylePresetLight:
+    igStyleColorsLight(0);
+    break;
+  case GLFWStylePresetClassic:
+    igStyleColorsClassic(0);
+    break;
+  default:
+    igStyleColorsDark(0);
+    break;
+  }
 
   // When viewports are enabled we tweak WindowRounding/WindowBg so platform
   // windows can look identical to regular ones.
@@ -115,44 +342,144 @@ GLFWwindow *igCreateGLFWWindow(const char *title, int width, int height, GLFWWin
   }
 
   // Setup Platform/Renderer backends
-  ImGui_ImplGlfw_InitForOpenGL(window, true);
+  igGLFWWindowData *data = (igGLFWWindowData *)malloc(sizeof(igGLFWWindowData));
+  data->window = window;
+  data->context = context;
+  data->ini_filename = ini_filename;
+  data->clear_color = options->clear_color;
+  data->next = glfw_windows;
+  glfw_windows = data;
+  glfwSetWindowUserPointer(window, data);
+
+  ImGui_ImplGlfw_InitForOpenGL(window, false);
   ImGui_ImplOpenGL3_Init(glsl_version);
 
-  // Install extra callback
+  glfw_install_callbacks(window);
+  glfwSetMonitorCallback(glfw_monitor_callback);
   glfwSetWindowRefreshCallback(window, glfw_window_refresh_callback);
+  glfwSetDropCallback(window, glfw_drop_callback);
+  glfwSetWindowCloseCallback(window, glfw_window_close_callback);
+  glfwSetWindowSizeCallback(window, glfw_window_size_callback);
+  glfwSetWindowContentScaleCallback(window, glfw_window_content_scale_callback);
+
+  ImGuiPlatformIO *platform_io = igGetPlatformIO();
+  if (platform_io->Platform_CreateWindow != NULL) {
+    glfw_impl_create_window = platform_io->Platform_CreateWindow;
+    platform_io->Platform_CreateWindow = glfw_create_viewport_window;
+  }
 
   return window;
 }
 
+void igPollEvents() { glfwPollEvents(); }
+
+void igWaitEvents() { glfwWaitEvents(); }
+
+void igWaitEventsTimeout(double timeout) { glfwWaitEventsTimeout(timeout); }
+
 void igRefresh() { glfwPostEmptyEvent(); }
 
-void glfw_render(GLFWwindow *window, VoidCallback renderLoop) {
+bool igUpdateGamepadMappings(const char *mappings) { return glfwUpdateGamepadMappings(mappings) == GLFW_TRUE; }
+
+static float gamepad_axis(float v, float v0, float v1) {
+  v = (v - v0) / (v1 - v0);
+  return v < 0.0f ? 0.0f : v > 1.0f ? 1.0f : v;
+}
+
+// Reads the first joystick as the GLFW backend does, keys[i] is ImGuiKey_GamepadStart+i
+bool igGamepadState(float *keys) {
+  GLFWgamepadstate gamepad;
+  if (!glfwGetGamepadState(GLFW_JOYSTICK_1, &gamepad)) {
+    return false;
+  }
+
+#define MAP_BUTTON(KEY, BUTTON) keys[KEY - ImGuiKey_GamepadStart] = gamepad.buttons[BUTTON] ? 1.0f : 0.0f
+#define MAP_ANALOG(KEY, AXIS, V0, V1) keys[KEY - ImGuiKey_GamepadStart] = gamepad_axis(gamepad.axes[AXIS], V0, V1)
+  MAP_BUTTON(ImGuiKey_GamepadStart, GLFW_GAMEPAD_BUTTON_START);
+  MAP_BUTTON(ImGuiKey_GamepadBack, GLFW_GAMEPAD_BUTTON_BACK);
+  MAP_BUTTON(ImGuiKey_GamepadFaceLeft, GLFW_GAMEPAD_BUTTON_X);
+  MAP_BUTTON(ImGuiKey_GamepadFaceRight, GLFW_GAMEPAD_BUTTON_B);
+  MAP_BUTTON(ImGuiKey_GamepadFaceUp, GLFW_GAMEPAD_BUTTON_Y);
+  MAP_BUTTON(ImGuiKey_GamepadFaceDown, GLFW_GAMEPAD_BUTTON_A);
+  MAP_BUTTON(ImGuiKey_GamepadDpadLeft, GLFW_GAMEPAD_BUTTON_DPAD_LEFT);
+  MAP_BUTTON(ImGuiKey_GamepadDpadRight, GLFW_GAMEPAD_BUTTON_DPAD_RIGHT);
+  MAP_BUTTON(ImGuiKey_GamepadDpadUp, GLFW_GAMEPAD_BUTTON_DPAD_UP);
+  MAP_BUTTON(ImGuiKey_GamepadDpadDown, GLFW_GAMEPAD_BUTTON_DPAD_DOWN);
+  MAP_BUTTON(ImGuiKey_GamepadL1, GLFW_GAMEPAD_BUTTON_LEFT_BUMPER);
+  MAP_BUTTON(ImGuiKey_GamepadR1, GLFW_GAMEPAD_BUTTON_RIGHT_BUMPER);
+  MAP_ANALOG(ImGuiKey_GamepadL2, GLFW_GAMEPAD_AXIS_LEFT_TRIGGER, -0.75f, +1.0f);
+  MAP_ANALOG(ImGuiKey_GamepadR2, GLFW_GAMEPAD_AXIS_RIGHT_TRIGGER, -0.75f, +1.0f);
+  MAP_BUTTON(ImGuiKey_GamepadL3, GLFW_GAMEPAD_BUTTON_LEFT_THUMB);
+  MAP_BUTTON(ImGuiKey_GamepadR3, GLFW_GAMEPAD_BUTTON_RIGHT_THUMB);
+  MAP_ANALOG(ImGuiKey_GamepadLStickLeft, GLFW_GAMEPAD_AXIS_LEFT_X, -0.25f, -1.0f);
+  MAP_ANALOG(ImGuiKey_GamepadLStickRight, GLFW_GAMEPAD_AXIS_LEFT_X, +0.25f, +1.0f);
+  MAP_ANALOG(ImGuiKey_GamepadLStickUp, GLFW_GAMEPAD_AXIS_LEFT_Y, -0.25f, -1.0f);
+  MAP_ANALOG(ImGuiKey_GamepadLStickDown, GLFW_GAMEPAD_AXIS_LEFT_Y, +0.25f, +1.0f);
+  MAP_ANALOG(ImGuiKey_GamepadRStickLeft, GLFW_GAMEPAD_AXIS_RIGHT_X, -0.25f, -1.0f);
+  MAP_ANALOG(ImGuiKey_GamepadRStickRight, GLFW_GAMEPAD_AXIS_RIGHT_X, +0.25f, +1.0f);
+  MAP_ANALOG(ImGuiKey_GamepadRStickUp, GLFW_GAMEPAD_AXIS_RIGHT_Y, -0.25f, -1.0f);
+  MAP_ANALOG(ImGuiKey_GamepadRStickDown, GLFW_GAMEPAD_AXIS_RIGHT_Y, +0.25f, +1.0f);
+#undef MAP_BUTTON
+#undef MAP_ANALOG
+
+  return true;
+}
+
+void igGLFWWindow_NewFrame(GLFWwindow *window, GLFWcursor *cursor, CursorMode mode) {
+  glfwMakeContextCurrent(window);
+  igSetCurrentContext(glfw_window_data(window)->context);
+
+  // The GLFW backend only knows the standard cursors and shows the cursor on
+  // every frame, so it keeps away from custom and hidden cursors
+  ImGuiIO *io = igGetIO();
+  ImGuiConfigFlags config_flags = io->ConfigFlags;
+  bool own_cursor = cursor != NULL || igGetMouseCursor() >= ImGuiMouseCursor_COUNT || mode != CursorModeNormal;
+  if (own_cursor) {
+    io->ConfigFlags |= ImGuiConfigFlags_NoMouseCursorChange;
+  }
+
   // Start the Dear ImGui frame
   ImGui_ImplOpenGL3_NewFrame();
   ImGui_ImplGlfw_NewFrame();
-  igNewFrame();
 
-  glfwSetWindowUserPointer(window, (void *)renderLoop);
+  io->ConfigFlags = config_flags;
+  if (!own_cursor || mode != CursorModeNormal || (config_flags & ImGuiConfigFlags_NoMouseCursorChange)) {
+    return;
+  }
+
+  ImGuiPlatformIO *platform_io = igGetPlatformIO();
+  for (int n = 0; n < platform_io->Viewports.Size; n++) {
+    GLFWwindow *viewport_window = (GLFWwindow *)platform_io->Viewports.Data[n]->PlatformHandle;
+    if (io->MouseDrawCursor) {
+      glfwSetInputMode(viewport_window, GLFW_CURSOR, GLFW_CURSOR_HIDDEN);
+      continue;
+    }
 
-  // Do ui stuff here
-  if (renderLoop != NULL) {
-    renderLoop();
+    // NULL is the default arrow, for the custom cursors without an image
+    glfwSetCursor(viewport_window, cursor);
+    glfwSetInputMode(viewport_window, GLFW_CURSOR, GLFW_CURSOR_NORMAL);
   }
+}
 
-  ImVec4 clear_color;
-  clear_color.x = 0.45f;
-  clear_color.y = 0.55f;
-  clear_color.w = 0.60f;
-  clear_color.z = 1.00f;
+// glfw_draw clears the back buffer of window and draws drawData into it.
+static void glfw_draw(GLFWwindow *window, ImDrawData *drawData, int width, int height) {
+  ImVec4 clear_color = glfw_window_data(window)->clear_color;
+
+  glViewport(0, 0, width, height);
+  // Transparent framebuffers are composited with premultiplied alpha
+  glClearColor(clear_color.x * clear_color.w, clear_color.y * clear_color.w, clear_color.z * clear_color.w, clear_color.w);
+  glClear(GL_COLOR_BUFFER_BIT);
+  ImGui_ImplOpenGL3_RenderDrawData(drawData);
+}
 
+void igGLFWWindow_SetClearColor(GLFWwindow *window, ImVec4 color) { glfw_window_data(window)->clear_color = color; }
+
+void igGLFWWindow_Render(GLFWwindow *window, ImDrawData *drawData) {
   // Rendering
-  igRender();
   int display_w, display_h;
+  glfwMakeContextCurrent(window);
   glfwGetFramebufferSize(window, &display_w, &display_h);
-  glViewport(0, 0, display_w, display_h);
-  glClearColor(clear_color.x * clear_color.w, clear_color.y * clear_color.w, clear_color.z * clear_color.w, clear_color.w);
-  glClear(GL_COLOR_BUFFER_BIT);
-  ImGui_ImplOpenGL3_RenderDrawData(igGetDrawData());
+  glfw_draw(window, drawData, display_w, display_h);
 
   ImGuiIO *io = igGetIO();
 
@@ -171,80 +498,205 @@ void glfw_render(GLFWwindow *window, VoidCallback renderLoop) {
   glfwSwapBuffers(window);
 }
 
-void igRunLoop(GLFWwindow *window, VoidCallback loop, VoidCallback beforeRender, VoidCallback afterRender) {
-  ImGuiIO *io = igGetIO();
+void igGLFWWindow_Capture(GLFWwindow *window, ImDrawData *drawData, int width, int height, unsigned char *pixels) {
+  glfwMakeContextCurrent(window);
 
-  // Load Fonts
-  // - If no fonts are loaded, dear imgui will use the default font. You can
-  // also load multiple fonts and use igPushFont()/PopFont() to select them.
-  // - AddFontFromFileTTF() will return the ImFont* so you can store it if you
-  // need to select the font among multiple.
-  // - If the file cannot be loaded, the function will return NULL. Please
-  // handle those errors in your application (e.g. use an assertion, or display
-  // an error and quit).
-  // - The fonts will be rasterized at a given size (w/ oversampling) and stored
-  // into a texture when calling ImFontAtlas::Build()/GetTexDataAsXXXX(), which
-  // ImGui_ImplXXXX_NewFrame below will call.
-  // - Read 'docs/FONTS.md' for more instructions and details.
-  // - Remember that in C/C++ if you want to include a backslash \ in a string
-  // literal you need to write a double backslash \\ !
-  // io.Fonts->AddFontDefault();
-  // io.Fonts->AddFontFromFileTTF("../../misc/fonts/Roboto-Medium.ttf", 16.0f);
-  // io.Fonts->AddFontFromFileTTF("../../misc/fonts/Cousine-Regular.ttf", 15.0f);
-  // io.Fonts->AddFontFromFileTTF("../../misc/fonts/DroidSans.ttf", 16.0f);
-  // io.Fonts->AddFontFromFileTTF("../../misc/fonts/ProggyTiny.ttf", 10.0f);
-  // ImFont* font =
-  // io.Fonts->AddFontFromFileTTF("c:\\Windows\\Fonts\\ArialUni.ttf", 18.0f,
-  // NULL, io.Fonts->GetGlyphRangesJapanese()); IM_ASSERT(font != NULL);
-
-  // Main loop
-  double lasttime = glfwGetTime();
-  while (!glfwWindowShouldClose(window)) {
-    if (beforeRender != NULL) {
-      beforeRender();
-    }
+  // The back buffer is undefined after a swap, draw the frame again without presenting it
+  glfw_draw(window, drawData, width, height);
+
+  GLint last_pack_alignment;
+  glGetIntegerv(GL_PACK_ALIGNMENT, &last_pack_alignment);
+  glPixelStorei(GL_PACK_ALIGNMENT, 1);
+  glReadPixels(0, 0, width, height, GL_RGBA, GL_UNSIGNED_BYTE, pixels);
+  glPixelStorei(GL_PACK_ALIGNMENT, last_pack_alignment);
+}
+
+void igGLFWWindow_ReloadFontsTexture(GLFWwindow *window) {
+  glfwMakeContextCurrent(window);
+  igSetCurrentContext(glfw_window_data(window)->context);
+
+  ImGui_ImplOpenGL3_DestroyFontsTexture();
+  ImGui_ImplOpenGL3_CreateFontsTexture();
+}
+
+void igGLFWWindow_Destroy(GLFWwindow *window) {
+  igGLFWWindowData *data = glfw_window_data(window);
+
+  glfwMakeContextCurrent(window);
+  igSetCurrentContext(data->context);
 
-    glfw_render(window, loop);
+  ImFontAtlas *atlas = data->context->IO.Fonts;
+  bool atlas_shared = !data->context->FontAtlasOwnedByContext;
 
-    while (glfwGetTime() < lasttime + 1.0 / glfw_target_fps) {
-      // do nothing here
+  // Cleanup
+  ImGui_ImplOpenGL3_Shutdown();
+  ImGui_ImplGlfw_Shutdown();
+  igDestroyContext(data->context);
+
+  for (igGLFWWindowData **it = &glfw_windows; *it != NULL; it = &(*it)->next) {
+    if (*it == data) {
+      *it = data->next;
+      break;
     }
-    lasttime += 1.0 / glfw_target_fps;
+  }
 
-    if (extra_frame_count > 0) {
-      extra_frame_count--;
+  glfwDestroyWindow(window);
+  free(data->ini_filename);
+  free(data);
+
+  // The renderer deleted the texture of a shared font atlas, upload it again
+  // from a window still using it, or free the atlas with its last user.
+  if (atlas_shared) {
+    igGLFWWindowData *user = NULL;
+    for (igGLFWWindowData *it = glfw_windows; it != NULL; it = it->next) {
+      if (it->context->IO.Fonts == atlas) {
+        user = it;
+        break;
+      }
+    }
+
+    if (user != NULL) {
+      glfwMakeContextCurrent(user->window);
+      igSetCurrentContext(user->context);
+      ImGui_ImplOpenGL3_DestroyFontsTexture();
+      ImGui_ImplOpenGL3_CreateFontsTexture();
     } else {
-      glfwWaitEvents();
-      extra_frame_count = MAX_EXTRA_FRAME_COUNT;
+      ImFontAtlas_destroy(atlas);
+    }
+  }
+
+  if (glfw_windows == NULL) {
+    for (int i = 0; i < ImGuiMouseCursor_COUNT; i++) {
+      if (glfw_standard_cursors[i] != NULL) {
+        glfwDestroyCursor(glfw_standard_cursors[i]);
+        glfw_standard_cursors[i] = NULL;
+      }
     }
 
-    glfwPollEvents();
+    glfwTerminate();
+  }
 
-    if (afterRender != NULL) {
-      afterRender();
+  igSetCurrentContext(glfw_windows != NULL ? glfw_windows->context : NULL);
+}
+
+bool igGLFWWindow_ShouldClose(GLFWwindow *window) { return glfwWindowShouldClose(window) != 0; }
+
+void igGLFWWindow_SetShouldClose(GLFWwindow *window, bool value) { glfwSetWindowShouldClose(window, value ? GLFW_TRUE : GLFW_FALSE); }
+
+ImGuiContext *igGLFWWindow_GetContext(GLFWwindow *window) { return glfw_window_data(window)->context; }
+
+void igGLFWWindow_MakeContextCurrent(GLFWwindow *window) { glfwMakeContextCurrent(window); }
+
+const char *igGLFWWindow_GetClipboardText(GLFWwindow *window) { return glfwGetClipboardString(window); }
+
+void igGLFWWindow_SetClipboardText(GLFWwindow *window, const char *text) { glfwSetClipboardString(window, text); }
+
+void igGLFWWindow_SetMouseCursor(GLFWwindow *window, ImGuiMouseCursor cursor, GLFWcursor *custom) {
+  if (custom != NULL) {
+    glfwSetCursor(window, custom);
+    glfwSetInputMode(window, GLFW_CURSOR, GLFW_CURSOR_NORMAL);
+    return;
+  }
+
+  if (cursor == ImGuiMouseCursor_None || cursor < 0) {
+    glfwSetInputMode(window, GLFW_CURSOR, GLFW_CURSOR_HIDDEN);
+    return;
+  }
+
+  // Custom cursors without an image are arrows
+  if (cursor >= ImGuiMouseCursor_COUNT) {
+    cursor = ImGuiMouseCursor_Arrow;
+  }
+
+  if (glfw_standard_cursors[cursor] == NULL) {
+    int shape = GLFW_ARROW_CURSOR;
+    switch (cursor) {
+    case ImGuiMouseCursor_TextInput:
+      shape = GLFW_IBEAM_CURSOR;
+      break;
+    case ImGuiMouseCursor_ResizeNS:
+      shape = GLFW_VRESIZE_CURSOR;
+      break;
+    case ImGuiMouseCursor_ResizeEW:
+      shape = GLFW_HRESIZE_CURSOR;
+      break;
+    case ImGuiMouseCursor_Hand:
+      shape = GLFW_HAND_CURSOR;
+      break;
+    default:
+      break;
     }
+    glfw_standard_cursors[cursor] = glfwCreateStandardCursor(shape);
   }
 
-  // Cleanup
-  ImGui_ImplOpenGL3_Shutdown();
-  ImGui_ImplGlfw_Shutdown();
-  igDestroyContext(0);
+  glfwSetCursor(window, glfw_standard_cursors[cursor]);
+  glfwSetInputMode(window, GLFW_CURSOR, GLFW_CURSOR_NORMAL);
+}
 
-  glfwDestroyWindow(window);
-  glfwTerminate();
+void igGLFWWindow_SetCursorMode(GLFWwindow *window, CursorMode mode) {
+  int glfw_mode = GLFW_CURSOR_NORMAL;
+  if (mode == CursorModeHidden) {
+    glfw_mode = GLFW_CURSOR_HIDDEN;
+  } else if (mode == CursorModeCaptured) {
+    glfw_mode = GLFW_CURSOR_DISABLED;
+  }
 
-  return;
+  glfwSetInputMode(window, GLFW_CURSOR, glfw_mode);
+
+  // Unaccelerated motion suits dragging in 3D viewports
+  if (glfwRawMouseMotionSupported()) {
+    glfwSetInputMode(window, GLFW_RAW_MOUSE_MOTION, mode == CursorModeCaptured ? GLFW_TRUE : GLFW_FALSE);
+  }
 }
 
-ImTextureID igCreateTexture(unsigned char *pixels, int width, int height) {
+void igGLFWWindow_GetCursorPos(GLFWwindow *window, double *x, double *y) { glfwGetCursorPos(window, x, y); }
+
+void igGLFWWindow_SetCursorPos(GLFWwindow *window, double x, double y) {
+  glfwSetCursorPos(window, x, y);
+
+  // Tell dear imgui right away, as the GLFW backend does for io.WantSetMousePos
+  igContextScope scope(window);
+  ImGuiIO *io = igGetIO();
+  if (io->ConfigFlags & ImGuiConfigFlags_ViewportsEnable) {
+    int window_x, window_y;
+    glfwGetWindowPos(window, &window_x, &window_y);
+    x += window_x;
+    y += window_y;
+  }
+  ImGuiIO_AddMousePosEvent(io, (float)x, (float)y);
+}
+
+GLFWcursor *igCreateCursor(unsigned char *pixels, int width, int height, int xhot, int yhot) {
+  GLFWimage image = {width, height, pixels};
+  return glfwCreateCursor(&image, xhot, yhot);
+}
+
+void igDestroyCursor(GLFWcursor *cursor) { glfwDestroyCursor(cursor); }
+
+GLFWwindow *igGetCurrentGLFWWindow() { return glfwGetCurrentContext(); }
+
+ImTextureID igCreateTexture(unsigned char *pixels, int width, int height, TextureFilter filter, TextureWrap wrap) {
   GLint last_texture;
   GLuint texId;
 
+  GLint gl_filter = filter == TextureFilterNearest ? GL_NEAREST : GL_LINEAR;
+  GLint gl_wrap = GL_REPEAT;
+  switch (wrap) {
+  case TextureWrapClampToEdge:
+    gl_wrap = GL_CLAMP_TO_EDGE;
+    break;
+  case TextureWrapMirroredRepeat:
+    gl_wrap = GL_MIRRORED_REPEAT;
+    break;
+  }
+
   glGetIntegerv(GL_TEXTURE_BINDING_2D, &last_texture);
   glGenTextures(1, &texId);
   glBindTexture(GL_TEXTURE_2D, texId);
-  glTexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MIN_FILTER, GL_LINEAR);
-  glTexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MAG_FILTER, GL_LINEAR);
+  glTexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MIN_FILTER, gl_filter);
+  glTexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MAG_FILTER, gl_filter);
+  glTexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_S, gl_wrap);
+  glTexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_T, gl_wrap);
   glTexImage2D(GL_TEXTURE_2D, 0, GL_RGBA, width, height, 0, GL_RGBA, GL_UNSIGNED_BYTE, pixels);
 
   // Restore state
@@ -253,4 +705,88 @@ ImTextureID igCreateTexture(unsigned char *pixels, int width, int height) {
   return ImTextureID((intptr_t(texId)));
 }
 
+void igUpdateTexture(uintptr_t id, unsigned char *pixels, int width, int height) {
+  GLint last_texture;
+
+  glGetIntegerv(GL_TEXTURE_BINDING_2D, &last_texture);
+  glBindTexture(GL_TEXTURE_2D, (GLuint)(intptr_t)id);
+  glTexImage2D(GL_TEXTURE_2D, 0, GL_RGBA, width, height, 0, GL_RGBA, GL_UNSIGNED_BYTE, pixels);
+
+  // Restore state
+  glBindTexture(GL_TEXTURE_2D, last_texture);
+}
+
+void igDeleteTexture(uintptr_t id) {
+  GLuint texId = (GLuint)(intptr_t)id;
+  glDeleteTextures(1, &texId);
+}
+
 void igGLFWWindow_GetDisplaySize(GLFWwindow *window, int *width, int *height) { glfwGetWindowSize(window, width, height); }
+
+void igGLFWWindow_GetFramebufferSize(GLFWwindow *window, int *width, int *height) { glfwGetFramebufferSize(window, width, height); }
+
+void igGLFWWindow_GetContentScale(GLFWwindow *window, float *x, float *y) { glfwGetWindowContentScale(window, x, y); }
+
+void igGLFWWindow_SetTitle(GLFWwindow *window, const char *title) { glfwSetWindowTitle(window, title); }
+
+void igGLFWWindow_SetSize(GLFWwindow *window, int width, int height) { glfwSetWindowSize(window, width, height); }
+
+void igGLFWWindow_GetPos(GLFWwindow *window, int *x, int *y) { glfwGetWindowPos(window, x, y); }
+
+void igGLFWWindow_SetPos(GLFWwindow *window, int x, int y) { glfwSetWindowPos(window, x, y); }
+
+void igGLFWWindow_SetSizeLimits(GLFWwindow *window, int min_width, int min_height, int max_width, int max_height) { glfwSetWindowSizeLimits(window, min_width, min_height, max_width, max_height); }
+
+void igGLFWWindow_SetIcon(GLFWwindow *window, int count, const int *sizes, unsigned char *pixels) {
+  GLFWimage *images = (GLFWimage *)malloc(sizeof(GLFWimage) * (count > 0 ? count : 1));
+
+  for (int i = 0; i < count; i++) {
+    images[i].width = sizes[i * 2];
+    images[i].height = sizes[i * 2 + 1];
+    images[i].pixels = pixels;
+    pixels += images[i].width * images[i].height * 4;
+  }
+
+  glfwSetWindowIcon(window, count, count > 0 ? images : NULL);
+  free(images);
+}
+
+GLFWmonitor *igGLFWWindow_GetMonitor(GLFWwindow *window) { return glfwGetWindowMonitor(window); }
+
+void igGLFWWindow_SetMonitor(GLFWwindow *window, GLFWmonitor *monitor, int x, int y, int width, int height, int refresh_rate) {
+  glfwSetWindowMonitor(window, monitor, x, y, width, height, refresh_rate);
+}
+
+void igGLFWWindow_Iconify(GLFWwindow *window) { glfwIconifyWindow(window); }
+
+void igGLFWWindow_Maximize(GLFWwindow *window) { glfwMaximizeWindow(window); }
+
+void igGLFWWindow_Restore(GLFWwindow *window) { glfwRestoreWindow(window); }
+
+bool igGLFWWindow_IsIconified(GLFWwindow *window) { return glfwGetWindowAttrib(window, GLFW_ICONIFIED) != 0; }
+
+bool igGLFWWindow_IsMaximized(GLFWwindow *window) { return glfwGetWindowAttrib(window, GLFW_MAXIMIZED) != 0; }
+
+GLFWmonitor **igGetMonitors(int *count) { return glfwGetMonitors(count); }
+
+GLFWmonitor *igGetPrimaryMonitor() { return glfwGetPrimaryMonitor(); }
+
+const char *igGLFWMonitor_GetName(GLFWmonitor *monitor) { return glfwGetMonitorName(monitor); }
+
+void igGLFWMonitor_GetPos(GLFWmonitor *monitor, int *x, int *y) { glfwGetMonitorPos(monitor, x, y); }
+
+void igGLFWMonitor_GetWorkarea(GLFWmonitor *monitor, int *x, int *y, int *width, int *height) { glfwGetMonitorWorkarea(monitor, x, y, width, height); }
+
+void igGLFWMonitor_GetContentScale(GLFWmonitor *monitor, float *x, float *y) { glfwGetMonitorContentScale(monitor, x, y); }
+
+void igGLFWMonitor_GetVideoMode(GLFWmonitor *monitor, int *width, int *height, int *refresh_rate) {
+  const GLFWvidmode *mode = glfwGetVideoMode(monitor);
+  if (mode == NULL) {
+    *width = *height = *refresh_rate = 0;
+    return;
+  }
+
+  *width = mode->width;
+  *height = mode->height;
+  *refresh_rate = mode->refreshRate;
+}
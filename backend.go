@@ -7,16 +7,74 @@ package cimgui
 // #cgo arm64,darwin LDFLAGS: ${SRCDIR}/lib/macos/arm64/libglfw3.a
 // #cgo !gles2,darwin LDFLAGS: -framework OpenGL
 // #cgo gles2,darwin LDFLAGS: -lGLESv2
-// extern void glfwWindowLoopCallback();
-// extern void glfwBeforeRender();
-// extern void glfwAfterRender();
 // #include <stdint.h>
 // #include "backend.h"
 import "C"
 import (
+	"image"
+	"time"
 	"unsafe"
 )
 
+// Backend is what a Window needs to show dear imgui: a platform feeding
+// input and display information, and a renderer drawing the result.
+type Backend interface {
+	PlatformBackend
+	RendererBackend
+}
+
+// PlatformBackend owns the native window: events, display size, clipboard and cursor.
+type PlatformBackend interface {
+	// NewFrame prepares the current context for a new frame:
+	// display size, delta time and pending input.
+	NewFrame()
+	// ProcessEvents handles the pending events without blocking.
+	ProcessEvents()
+	// WaitEvents blocks until at least one event is available, then handles the pending events.
+	WaitEvents()
+	// WaitEventsTimeout is WaitEvents returning after timeout at the latest.
+	WaitEventsTimeout(timeout time.Duration)
+	// PostEmptyEvent wakes up WaitEvents, it may be called from any goroutine.
+	PostEmptyEvent()
+	ShouldClose() bool
+	SetShouldClose(value bool)
+	// DisplaySize returns the size of the window in screen coordinates, the unit of dear imgui.
+	DisplaySize() (width int32, height int32)
+	// FramebufferSize returns the size of the window in pixels.
+	FramebufferSize() (width int32, height int32)
+	// ContentScale returns the ratio between the DPI of the window's monitor and the platform default DPI.
+	ContentScale() (x, y float32)
+	// ClipboardText and SetClipboardText back the clipboard of dear imgui.
+	ClipboardText() string
+	SetClipboardText(text string)
+	// SetMouseCursor shows the cursor requested by dear imgui, it is called after each frame.
+	SetMouseCursor(cursor ImGuiMouseCursor)
+	// Context returns the dear imgui context owned by the backend.
+	Context() ImGuiContext
+	// Shutdown releases the window and the dear imgui context created for it.
+	Shutdown()
+}
+
+// RendererBackend owns the textures and draws the frames.
+type RendererBackend interface {
+	// CreateTexture uploads width x height non premultiplied RGBA pixels.
+	// It returns 0 for an empty size.
+	CreateTexture(pixels unsafe.Pointer, width, height int, options TextureOptions) ImTextureID
+	UpdateTexture(id ImTextureID, pixels unsafe.Pointer, width, height int)
+	DeleteTexture(id ImTextureID)
+	// Textures returns the live textures, sorted by ID.
+	Textures() []TextureInfo
+	// ReloadFontsTexture uploads the font atlas of the current context again, e.g. after it was rebuilt.
+	ReloadFontsTexture()
+	// CaptureFrame returns the pixels of the last frame of the current context,
+	// it must be called between frames.
+	CaptureFrame() (*image.RGBA, error)
+	// SetClearColor sets the background drawn behind dear imgui, see Window.SetClearColor.
+	SetClearColor(color ImVec4)
+	// RenderDrawData draws and presents a frame.
+	RenderDrawData(data ImDrawData)
+}
+
 type GLFWWindowFlags int
 
 const (
@@ -24,29 +82,143 @@ const (
 	GLFWWindowFlagsNotResizable GLFWWindowFlags = GLFWWindowFlags(C.GLFWWindowFlagsNotResizable)
 	GLFWWindowFlagsMaximized    GLFWWindowFlags = GLFWWindowFlags(C.GLFWWindowFlagsMaximized)
 	GLFWWindowFlagsFloating     GLFWWindowFlags = GLFWWindowFlags(C.GLFWWindowFlagsFloating)
-	GLFWWindowFlagsFrameless    GLFWWindowFlags = GLFWWindowFlags(C.GLFWWindowFlagsFrameless)
-	GLFWWindowFlagsTransparent  GLFWWindowFlags = GLFWWindowFlags(C.GLFWWindowFlagsTransparent)
+	// GLFWWindowFlagsFrameless removes the title bar and borders, see WindowChrome.
+	GLFWWindowFlagsFrameless   GLFWWindowFlags = GLFWWindowFlags(C.GLFWWindowFlagsFrameless)
+	GLFWWindowFlagsTransparent GLFWWindowFlags = GLFWWindowFlags(C.GLFWWindowFlagsTransparent)
 )
 
-type voidCallbackFunc func()
+type GLFWStylePreset int
 
-var (
-	loopFunc     voidCallbackFunc
-	beforeRender voidCallbackFunc
-	afterRender  voidCallbackFunc
+const (
+	GLFWStylePresetDark    GLFWStylePreset = GLFWStylePreset(C.GLFWStylePresetDark)
+	GLFWStylePresetLight   GLFWStylePreset = GLFWStylePreset(C.GLFWStylePresetLight)
+	GLFWStylePresetClassic GLFWStylePreset = GLFWStylePreset(C.GLFWStylePresetClassic)
 )
 
+// WindowOptions configures a window and its dear imgui context at creation.
+type WindowOptions struct {
+	Flags       GLFWWindowFlags
+	ConfigFlags ImGuiConfigFlags
+	// IniFilename is where dear imgui keeps its settings, empty disables the .ini file.
+	// See Window.SetIniFile to keep them in the user config directory instead.
+	IniFilename string
+	Style       GLFWStylePreset
+	VSync       bool
+	// GLMajor and GLMinor request an OpenGL version, 0 picks the platform default.
+	GLMajor int
+	GLMinor int
+	// GLSLVersion is the shaders #version line, e.g. "#version 150". Empty picks the platform default.
+	GLSLVersion string
+	// Samples is the number of MSAA samples, 0 disables multisampling.
+	Samples int
+	// ClearColor is the background of the window, see NewImVec4 and Window.SetClearColor.
+	ClearColor ImVec4
+	// Position is the top left corner of the window, relative to the work area of
+	// Monitor if any. When nil, the window is centered on Monitor.
+	Position *image.Point
+	// Monitor is the index of the monitor the window opens on, -1 lets the window manager decide.
+	Monitor int
+	// Shared is a window to share the font atlas and the OpenGL textures with.
+	Shared GLFWwindow
+	// Gamepad enables gamepad navigation with the first joystick GLFW has
+	// a gamepad mapping for, see UpdateGamepadMappings and Window.SetGamepadHook.
+	Gamepad bool
+}
+
+// DefaultWindowOptions returns the options used by CreateGlfwWindow.
+func DefaultWindowOptions() WindowOptions {
+	return WindowOptions{
+		ConfigFlags: ImGuiConfigFlags_NavEnableKeyboard | ImGuiConfigFlags_DockingEnable | ImGuiConfigFlags_ViewportsEnable,
+		Style:       GLFWStylePresetDark,
+		VSync:       true,
+		ClearColor:  NewImVec4(0.45, 0.55, 0.60, 1.00),
+		Monitor:     -1,
+	}
+}
+
+// glfwWindowState is the Go side data attached to a GLFWwindow.
+type glfwWindowState struct {
+	refresh             func()
+	drop                func(paths []string)
+	closeRequest        func() bool
+	focus               func(focused bool)
+	resize              func(width, height int)
+	contentScaleChanged func(x, y float32)
+
+	cursors    map[ImGuiMouseCursor]GLFWcursor
+	cursorMode CursorMode
+
+	// textures are shared with the windows sharing the OpenGL textures.
+	textures textureRegistry
+
+	// windowed is the geometry restored when leaving fullscreen.
+	windowed image.Rectangle
+}
+
+var glfwWindowStates = make(map[GLFWwindow]*glfwWindowState)
+
+// GLFWwindow is the Backend implemented with GLFW and OpenGL3.
 type GLFWwindow uintptr
 
+var _ Backend = GLFWwindow(0)
+
 func (w GLFWwindow) handle() *C.GLFWwindow {
 	return (*C.GLFWwindow)(unsafe.Pointer(w))
 }
 
+func (w GLFWwindow) state() *glfwWindowState {
+	return glfwWindowStates[w]
+}
+
+// Run drives the window with loop until it is closed.
+// beforeRenderFunc and afterRenderFunc may be nil.
 func (w GLFWwindow) Run(loop func(), beforeRenderFunc func(), afterRenderFunc func()) {
-	loopFunc = loop
-	beforeRender = beforeRenderFunc
-	afterRender = afterRenderFunc
-	C.igRunLoop(w.handle(), C.VoidCallback(C.glfwWindowLoopCallback), C.VoidCallback(C.glfwBeforeRender), C.VoidCallback(C.glfwAfterRender))
+	window := NewWindow(w)
+	window.SetBeforeRenderHook(beforeRenderFunc)
+	window.SetAfterRenderHook(afterRenderFunc)
+	window.Run(loop)
+}
+
+func (w GLFWwindow) onRefresh(refresh func()) {
+	if state := w.state(); state != nil {
+		state.refresh = refresh
+	}
+}
+
+func (w GLFWwindow) NewFrame() {
+	var cursor *C.GLFWcursor
+	mode := CursorModeNormal
+	if state := w.state(); state != nil {
+		SetCurrentContext(w.Context())
+		cursor = state.cursors[GetMouseCursor()].handle()
+		mode = state.cursorMode
+	}
+
+	C.igGLFWWindow_NewFrame(w.handle(), cursor, C.CursorMode(mode))
+}
+
+func (w GLFWwindow) ProcessEvents() {
+	C.igPollEvents()
+}
+
+func (w GLFWwindow) WaitEvents() {
+	C.igWaitEvents()
+}
+
+func (w GLFWwindow) WaitEventsTimeout(timeout time.Duration) {
+	C.igWaitEventsTimeout(C.double(timeout.Seconds()))
+}
+
+func (w GLFWwindow) PostEmptyEvent() {
+	C.igRefresh()
+}
+
+func (w GLFWwindow) ShouldClose() bool {
+	return C.igGLFWWindow_ShouldClose(w.handle()) == C.bool(true)
+}
+
+func (w GLFWwindow) SetShouldClose(value bool) {
+	C.igGLFWWindow_SetShouldClose(w.handle(), C.bool(value))
 }
 
 func (w GLFWwindow) DisplaySize() (width int32, height int32) {
@@ -61,47 +233,232 @@ func (w GLFWwindow) DisplaySize() (width int32, height int32) {
 	return
 }
 
-//export glfwWindowLoopCallback
-func glfwWindowLoopCallback() {
-	if loopFunc != nil {
-		loopFunc()
+func (w GLFWwindow) FramebufferSize() (width int32, height int32) {
+	var cw, ch C.int
+	C.igGLFWWindow_GetFramebufferSize(w.handle(), &cw, &ch)
+
+	return int32(cw), int32(ch)
+}
+
+func (w GLFWwindow) ContentScale() (x, y float32) {
+	var cx, cy C.float
+	C.igGLFWWindow_GetContentScale(w.handle(), &cx, &cy)
+
+	return float32(cx), float32(cy)
+}
+
+func (w GLFWwindow) ClipboardText() string {
+	text := C.igGLFWWindow_GetClipboardText(w.handle())
+	if text == nil {
+		return ""
 	}
+
+	return C.GoString(text)
+}
+
+func (w GLFWwindow) SetClipboardText(text string) {
+	textArg, textFin := wrapString(text)
+	defer textFin()
+
+	C.igGLFWWindow_SetClipboardText(w.handle(), textArg)
 }
 
-//export glfwBeforeRender
-func glfwBeforeRender() {
-	if beforeRender != nil {
-		beforeRender()
+// SetMouseCursor changes the OS cursor of the window. Unless
+// ImGuiConfigFlags_NoMouseCursorChange is set, the cursor requested by
+// dear imgui is applied again after each frame. A cursor hidden or captured
+// with SetCursorMode stays so.
+func (w GLFWwindow) SetMouseCursor(cursor ImGuiMouseCursor) {
+	var custom *C.GLFWcursor
+	if state := w.state(); state != nil {
+		if state.cursorMode != CursorModeNormal {
+			return
+		}
+
+		custom = state.cursors[cursor].handle()
 	}
+
+	C.igGLFWWindow_SetMouseCursor(w.handle(), C.ImGuiMouseCursor(cursor), custom)
 }
 
-//export glfwAfterRender
-func glfwAfterRender() {
-	if afterRender != nil {
-		afterRender()
+func (w GLFWwindow) Context() ImGuiContext {
+	return ImGuiContext(unsafe.Pointer(C.igGLFWWindow_GetContext(w.handle())))
+}
+
+func (w GLFWwindow) Shutdown() {
+	C.igGLFWWindow_Destroy(w.handle())
+	delete(glfwWindowStates, w)
+}
+
+func (w GLFWwindow) CreateTexture(pixels unsafe.Pointer, width, height int, options TextureOptions) ImTextureID {
+	C.igGLFWWindow_MakeContextCurrent(w.handle())
+	return CreateTextureWithOptions(pixels, width, height, options)
+}
+
+func (w GLFWwindow) UpdateTexture(id ImTextureID, pixels unsafe.Pointer, width, height int) {
+	C.igGLFWWindow_MakeContextCurrent(w.handle())
+	UpdateTexture(id, pixels, width, height)
+}
+
+func (w GLFWwindow) DeleteTexture(id ImTextureID) {
+	C.igGLFWWindow_MakeContextCurrent(w.handle())
+	DeleteTexture(id)
+}
+
+// Textures returns the live OpenGL textures of the window, including the
+// ones of the windows it shares them with.
+func (w GLFWwindow) Textures() []TextureInfo {
+	if state := w.state(); state != nil {
+		return state.textures.list()
+	}
+
+	return nil
+}
+
+func (w GLFWwindow) SetClearColor(color ImVec4) {
+	C.igGLFWWindow_SetClearColor(w.handle(), clearColorToC(color))
+}
+
+// clearColorToC converts a color made by NewImVec4, whose W and Z are blue
+// and alpha, to the red, green, blue, alpha order of the x, y, z, w C fields.
+func clearColorToC(color ImVec4) C.ImVec4 {
+	return C.ImVec4{x: C.float(color.X), y: C.float(color.Y), z: C.float(color.W), w: C.float(color.Z)}
+}
+
+func (w GLFWwindow) CaptureFrame() (*image.RGBA, error) {
+	data := GetDrawData()
+	if data == 0 || !data.GetValid() {
+		return nil, errNoFrame
+	}
+
+	size := data.GetDisplaySize()
+	scale := data.GetFramebufferScale()
+	width, height := int(size.X*scale.X), int(size.Y*scale.Y)
+	if width <= 0 || height <= 0 {
+		return nil, errNoFrame
+	}
+
+	pixels := make([]byte, width*height*4)
+	C.igGLFWWindow_Capture(w.handle(), data.handle(), C.int(width), C.int(height), (*C.uchar)(unsafe.Pointer(&pixels[0])))
+
+	// OpenGL rows go bottom up
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		copy(img.Pix[y*img.Stride:(y+1)*img.Stride], pixels[(height-1-y)*width*4:])
+	}
+
+	return img, nil
+}
+
+func (w GLFWwindow) ReloadFontsTexture() {
+	C.igGLFWWindow_ReloadFontsTexture(w.handle())
+}
+
+func (w GLFWwindow) RenderDrawData(data ImDrawData) {
+	C.igGLFWWindow_Render(w.handle(), data.handle())
+}
+
+//export glfwWindowRefreshCallback
+func glfwWindowRefreshCallback(window *C.GLFWwindow) {
+	if state := GLFWwindow(unsafe.Pointer(window)).state(); state != nil && state.refresh != nil {
+		state.refresh()
 	}
 }
 
+// CreateGlfwWindow creates a window with its own dear imgui context and the default options.
 func CreateGlfwWindow(title string, width, height int, flags GLFWWindowFlags) GLFWwindow {
+	options := DefaultWindowOptions()
+	options.Flags = flags
+
+	return CreateGlfwWindowWithOptions(title, width, height, options)
+}
+
+// CreateSharedGlfwWindow creates a window with its own dear imgui context,
+// sharing the font atlas and the OpenGL textures of shared.
+func CreateSharedGlfwWindow(shared GLFWwindow, title string, width, height int, flags GLFWWindowFlags) GLFWwindow {
+	options := DefaultWindowOptions()
+	options.Flags = flags
+	options.Shared = shared
+
+	return CreateGlfwWindowWithOptions(title, width, height, options)
+}
+
+// CreateGlfwWindowWithOptions creates a window with its own dear imgui context configured by options.
+func CreateGlfwWindowWithOptions(title string, width, height int, options WindowOptions) GLFWwindow {
 	titleArg, titleFin := wrapString(title)
 	defer titleFin()
 
-	window := GLFWwindow(unsafe.Pointer(C.igCreateGLFWWindow(titleArg, C.int(width), C.int(height), C.GLFWWindowFlags(flags))))
+	if options.Gamepad {
+		options.ConfigFlags |= ImGuiConfigFlags_NavEnableGamepad
+	}
+
+	opts := C.GLFWWindowOptions{
+		flags:        C.GLFWWindowFlags(options.Flags),
+		config_flags: C.ImGuiConfigFlags(options.ConfigFlags),
+		style:        C.GLFWStylePreset(options.Style),
+		vsync:        C.bool(options.VSync),
+		gl_major:     C.int(options.GLMajor),
+		gl_minor:     C.int(options.GLMinor),
+		samples:      C.int(options.Samples),
+		clear_color:  clearColorToC(options.ClearColor),
+		monitor:      C.int(options.Monitor),
+	}
+
+	if options.IniFilename != "" {
+		iniArg, iniFin := wrapString(options.IniFilename)
+		defer iniFin()
+		opts.ini_filename = iniArg
+	}
+
+	if options.GLSLVersion != "" {
+		glslArg, glslFin := wrapString(options.GLSLVersion)
+		defer glslFin()
+		opts.glsl_version = glslArg
+	}
+
+	if options.Position != nil {
+		opts.has_position = C.bool(true)
+		opts.x = C.int(options.Position.X)
+		opts.y = C.int(options.Position.Y)
+	}
+
+	window := GLFWwindow(unsafe.Pointer(C.igCreateGLFWWindow(titleArg, C.int(width), C.int(height), &opts, options.Shared.handle())))
 	if window == 0 {
 		panic("Failed to create GLFW window")
 	}
 
-	return window
-}
+	state := &glfwWindowState{textures: textureRegistry{}}
+	if shared := options.Shared.state(); shared != nil {
+		state.textures = shared.textures
+	}
+	glfwWindowStates[window] = state
 
-func SetTargetFPS(fps uint) {
-	C.igSetTargetFPS(C.uint(fps))
+	return window
 }
 
+// Refresh wakes up the windows waiting for events, it may be called from any goroutine.
 func Refresh() {
 	C.igRefresh()
 }
 
-func CreateTexture(pixels unsafe.Pointer, width, height int) ImTextureID {
-	return ImTextureID(C.igCreateTexture((*C.uchar)(pixels), C.int(width), C.int(height)))
+func (w GLFWwindow) gamepad(state GamepadState) bool {
+	var keys [ImGuiKey_GamepadRStickDown - ImGuiKey_GamepadStart + 1]C.float
+	if !C.igGamepadState(&keys[0]) {
+		return false
+	}
+
+	for i, value := range keys {
+		state[ImGuiKey(ImGuiKey_GamepadStart+i)] = float32(value)
+	}
+
+	return true
+}
+
+// UpdateGamepadMappings adds SDL_GameControllerDB mappings to the ones built
+// into GLFW, for the gamepads GLFW does not know about.
+// It reports whether the mappings were parsed.
+func UpdateGamepadMappings(mappings string) bool {
+	mappingsArg, mappingsFin := wrapString(mappings)
+	defer mappingsFin()
+
+	return bool(C.igUpdateGamepadMappings(mappingsArg))
 }
@@ -16,19 +16,109 @@ enum GLFWWindowFlags_ {
   GLFWWindowFlagsTransparent = 1 << 4,
 };
 
+typedef int TextureFilter;
+enum TextureFilter_ {
+  TextureFilterLinear = 0,
+  TextureFilterNearest = 1,
+};
+
+typedef int TextureWrap;
+enum TextureWrap_ {
+  TextureWrapRepeat = 0,
+  TextureWrapClampToEdge = 1,
+  TextureWrapMirroredRepeat = 2,
+};
+
+typedef int CursorMode;
+enum CursorMode_ {
+  CursorModeNormal = 0,
+  CursorModeHidden = 1,
+  CursorModeCaptured = 2,
+};
+
+typedef int GLFWStylePreset;
+enum GLFWStylePreset_ {
+  GLFWStylePresetDark = 0,
+  GLFWStylePresetLight = 1,
+  GLFWStylePresetClassic = 2,
+};
+
+typedef struct GLFWWindowOptions {
+  GLFWWindowFlags flags;
+  ImGuiConfigFlags config_flags;
+  const char *ini_filename; // NULL disables the .ini file
+  GLFWStylePreset style;
+  bool vsync;
+  int gl_major; // 0 picks the platform default version
+  int gl_minor;
+  const char *glsl_version; // NULL picks the platform default version
+  int samples;
+  ImVec4 clear_color; // x, y, z, w are red, green, blue and alpha
+  bool has_position; // position relative to the work area of the monitor, if any
+  int x;
+  int y;
+  int monitor; // -1 lets the window manager place the window
+} GLFWWindowOptions;
+
 typedef struct GLFWwindow GLFWwindow;
 typedef struct GLFWmonitor GLFWmonitor;
+typedef struct GLFWcursor GLFWcursor;
 struct GLFWwindow;
 struct GLFWmonitor;
+struct GLFWcursor;
 
-typedef void (*VoidCallback)();
-
-extern void igSetTargetFPS(unsigned int fps);
-extern GLFWwindow *igCreateGLFWWindow(const char *title, int width, int height, GLFWWindowFlags flags);
-extern void igRunLoop(GLFWwindow *window, VoidCallback loop, VoidCallback beforeRender, VoidCallback afterRender);
+extern GLFWwindow *igCreateGLFWWindow(const char *title, int width, int height, const GLFWWindowOptions *options, GLFWwindow *shared);
+extern void igGLFWWindow_NewFrame(GLFWwindow *window, GLFWcursor *cursor, CursorMode mode);
+extern void igGLFWWindow_Render(GLFWwindow *window, ImDrawData *drawData);
+extern void igGLFWWindow_Capture(GLFWwindow *window, ImDrawData *drawData, int width, int height, unsigned char *pixels);
+extern void igGLFWWindow_SetClearColor(GLFWwindow *window, ImVec4 color);
+extern void igGLFWWindow_ReloadFontsTexture(GLFWwindow *window);
+extern void igGLFWWindow_Destroy(GLFWwindow *window);
+extern ImGuiContext *igGLFWWindow_GetContext(GLFWwindow *window);
+extern void igGLFWWindow_MakeContextCurrent(GLFWwindow *window);
+extern bool igGLFWWindow_ShouldClose(GLFWwindow *window);
+extern void igGLFWWindow_SetShouldClose(GLFWwindow *window, bool value);
 extern void igGLFWWindow_GetDisplaySize(GLFWwindow *window, int *width, int *height);
+extern const char *igGLFWWindow_GetClipboardText(GLFWwindow *window);
+extern void igGLFWWindow_SetClipboardText(GLFWwindow *window, const char *text);
+extern void igGLFWWindow_SetMouseCursor(GLFWwindow *window, ImGuiMouseCursor cursor, GLFWcursor *custom);
+extern void igGLFWWindow_SetCursorMode(GLFWwindow *window, CursorMode mode);
+extern void igGLFWWindow_GetCursorPos(GLFWwindow *window, double *x, double *y);
+extern void igGLFWWindow_SetCursorPos(GLFWwindow *window, double x, double y);
+extern void igGLFWWindow_GetFramebufferSize(GLFWwindow *window, int *width, int *height);
+extern void igGLFWWindow_GetContentScale(GLFWwindow *window, float *x, float *y);
+extern void igGLFWWindow_SetTitle(GLFWwindow *window, const char *title);
+extern void igGLFWWindow_SetSize(GLFWwindow *window, int width, int height);
+extern void igGLFWWindow_GetPos(GLFWwindow *window, int *x, int *y);
+extern void igGLFWWindow_SetPos(GLFWwindow *window, int x, int y);
+extern void igGLFWWindow_SetSizeLimits(GLFWwindow *window, int min_width, int min_height, int max_width, int max_height);
+extern void igGLFWWindow_SetIcon(GLFWwindow *window, int count, const int *sizes, unsigned char *pixels);
+extern GLFWmonitor *igGLFWWindow_GetMonitor(GLFWwindow *window);
+extern void igGLFWWindow_SetMonitor(GLFWwindow *window, GLFWmonitor *monitor, int x, int y, int width, int height, int refresh_rate);
+extern void igGLFWWindow_Iconify(GLFWwindow *window);
+extern void igGLFWWindow_Maximize(GLFWwindow *window);
+extern void igGLFWWindow_Restore(GLFWwindow *window);
+extern bool igGLFWWindow_IsIconified(GLFWwindow *window);
+extern bool igGLFWWindow_IsMaximized(GLFWwindow *window);
+extern GLFWmonitor **igGetMonitors(int *count);
+extern GLFWmonitor *igGetPrimaryMonitor();
+extern const char *igGLFWMonitor_GetName(GLFWmonitor *monitor);
+extern void igGLFWMonitor_GetPos(GLFWmonitor *monitor, int *x, int *y);
+extern void igGLFWMonitor_GetWorkarea(GLFWmonitor *monitor, int *x, int *y, int *width, int *height);
+extern void igGLFWMonitor_GetContentScale(GLFWmonitor *monitor, float *x, float *y);
+extern void igGLFWMonitor_GetVideoMode(GLFWmonitor *monitor, int *width, int *height, int *refresh_rate);
+extern void igPollEvents();
+extern void igWaitEvents();
+extern void igWaitEventsTimeout(double timeout);
 extern void igRefresh();
-extern ImTextureID igCreateTexture(unsigned char *pixels, int width, int height);
+extern bool igUpdateGamepadMappings(const char *mappings);
+extern bool igGamepadState(float *keys);
+extern GLFWcursor *igCreateCursor(unsigned char *pixels, int width, int height, int xhot, int yhot);
+extern void igDestroyCursor(GLFWcursor *cursor);
+extern GLFWwindow *igGetCurrentGLFWWindow();
+extern ImTextureID igCreateTexture(unsigned char *pixels, int width, int height, TextureFilter filter, TextureWrap wrap);
+extern void igUpdateTexture(uintptr_t id, unsigned char *pixels, int width, int height);
+extern void igDeleteTexture(uintptr_t id);
 
 #ifdef __cplusplus
 }
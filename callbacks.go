@@ -0,0 +1,131 @@
+package cimgui
+
+// #include <stdlib.h>
+// #include "extra_type.h"
+// #include "cimgui_wrapper.h"
+// #include "util.h"
+import "C"
+import (
+	"runtime/cgo"
+	"unsafe"
+)
+
+// ImGuiSizeCallback constrains the size of the next window, see SetNextWindowSizeConstraints.
+type ImGuiSizeCallback func(data ImGuiSizeCallbackData)
+
+// ImDrawCallback is called by the renderer in place of a draw command, see ImDrawList.AddCallback.
+type ImDrawCallback func(parentList ImDrawList, cmd ImDrawCmd)
+
+// ItemsGetter returns the text of the item idx, e.g. for ListBox_FnBoolPtr.
+type ItemsGetter func(idx int32) string
+
+// ValuesGetter returns the value idx, e.g. for PlotLines_FnFloatPtr.
+type ValuesGetter func(idx int32) float32
+
+type itemsGetterState struct {
+	getter ItemsGetter
+	text   *C.char
+}
+
+// frameCallbacks keeps the callbacks dear imgui calls after the function
+// taking them returns, until the next frame of their context.
+var frameCallbacks = map[ImGuiContext][]cgo.Handle{}
+
+// frameCallbacksHooked are the contexts releasing their frame callbacks
+// from a NewFrame hook, whoever calls NewFrame.
+var frameCallbacksHooked = map[ImGuiContext]bool{}
+
+func retainFrameCallback(callback any) C.uintptr_t {
+	handle := cgo.NewHandle(callback)
+	context := GetCurrentContext()
+	frameCallbacks[context] = append(frameCallbacks[context], handle)
+
+	if !frameCallbacksHooked[context] {
+		frameCallbacksHooked[context] = true
+		C.AddGoFrameCallbacksHooks(context.handle())
+	}
+
+	return C.uintptr_t(handle)
+}
+
+// releaseFrameCallbacks frees the callbacks retained by the last frame of context.
+func releaseFrameCallbacks(context ImGuiContext) {
+	for _, handle := range frameCallbacks[context] {
+		handle.Delete()
+	}
+
+	delete(frameCallbacks, context)
+}
+
+//export goReleaseFrameCallbacks
+func goReleaseFrameCallbacks(ctx *C.ImGuiContext, shutdown C.bool) {
+	context := ImGuiContext(unsafe.Pointer(ctx))
+	releaseFrameCallbacks(context)
+
+	if shutdown {
+		delete(frameCallbacksHooked, context)
+	}
+}
+
+func retainSizeCallback(callback ImGuiSizeCallback) C.uintptr_t {
+	if callback == nil {
+		return 0
+	}
+
+	return retainFrameCallback(callback)
+}
+
+func retainDrawCallback(callback ImDrawCallback) C.uintptr_t {
+	if callback == nil {
+		return 0
+	}
+
+	return retainFrameCallback(callback)
+}
+
+func wrapItemsGetter(getter ItemsGetter) (C.uintptr_t, func()) {
+	state := &itemsGetterState{getter: getter}
+	handle := cgo.NewHandle(state)
+
+	return C.uintptr_t(handle), func() {
+		handle.Delete()
+		C.free(unsafe.Pointer(state.text))
+	}
+}
+
+func wrapValuesGetter(getter ValuesGetter) (C.uintptr_t, func()) {
+	handle := cgo.NewHandle(getter)
+
+	return C.uintptr_t(handle), handle.Delete
+}
+
+//export goSizeCallback
+func goSizeCallback(handle C.uintptr_t, data *C.ImGuiSizeCallbackData) {
+	callback := cgo.Handle(handle).Value().(ImGuiSizeCallback)
+	callback(ImGuiSizeCallbackData(unsafe.Pointer(data)))
+}
+
+//export goDrawCallback
+func goDrawCallback(handle C.uintptr_t, parentList *C.ImDrawList, cmd *C.ImDrawCmd) {
+	callback := cgo.Handle(handle).Value().(ImDrawCallback)
+	callback(ImDrawList(unsafe.Pointer(parentList)), ImDrawCmd(unsafe.Pointer(cmd)))
+}
+
+//export goItemsGetter
+func goItemsGetter(handle C.uintptr_t, idx C.int, outText **C.char) C.bool {
+	state := cgo.Handle(handle).Value().(*itemsGetterState)
+
+	// The text is only used until the next item is got
+	C.free(unsafe.Pointer(state.text))
+	state.text = C.CString(state.getter(int32(idx)))
+	*outText = state.text
+
+	return C.bool(true)
+}
+
+//export goValuesGetter
+func goValuesGetter(handle C.uintptr_t, idx C.int) C.float {
+	getter := cgo.Handle(handle).Value().(ValuesGetter)
+
+	return C.float(getter(int32(idx)))
+}
@@ -0,0 +1,123 @@
+package cimgui
+
+import "testing"
+
+func TestCallbacks(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(320, 240))
+	defer window.Close()
+
+	items := []string{"First", "Second", "Third"}
+	values := []float32{1, 3, 2}
+
+	var size ImVec2
+	var got []string
+	plotted := 0
+	drawn := 0
+	window.SetLoop(func() {
+		SetNextWindowPosV(NewImVec2(0, 0), ImGuiCond_Always, NewImVec2(0, 0))
+		SetNextWindowSizeV(NewImVec2(200, 100), ImGuiCond_Always)
+		SetNextWindowSizeConstraintsV(NewImVec2(0, 0), NewImVec2(1000, 1000), func(data ImGuiSizeCallbackData) {
+			desired := data.GetDesiredSize()
+			data.SetDesiredSize(NewImVec2(desired.X, desired.X))
+		})
+		Begin("Callbacks")
+		GetWindowSize(&size)
+
+		got = got[:0]
+		current := int32(1)
+		ListBox_FnBoolPtr("Items", &current, func(idx int32) string {
+			got = append(got, items[idx])
+			return items[idx]
+		}, int32(len(items)))
+
+		PlotLines_FnFloatPtrV("Values", func(idx int32) float32 {
+			plotted++
+			return values[idx]
+		}, int32(len(values)), 0, "", 0, 3, NewImVec2(0, 0))
+
+		GetWindowDrawList().AddCallback(func(parentList ImDrawList, cmd ImDrawCmd) {
+			drawn++
+		})
+		End()
+	})
+	window.Step()
+
+	if size != NewImVec2(200, 200) {
+		t.Errorf("expect the size callback to make the window square got %v", size)
+	}
+	if len(got) != len(items) {
+		t.Errorf("expect the %d items to be got, got %v", len(items), got)
+	}
+	if plotted < len(values) {
+		t.Errorf("expect the %d values to be plotted, got %d", len(values), plotted)
+	}
+	if drawn != 1 {
+		t.Errorf("expect the draw callback to be called once got %d", drawn)
+	}
+
+	window.Step()
+	if len(frameCallbacks) != 1 || len(frameCallbacks[window.Backend().Context()]) != 2 {
+		t.Errorf("expect only the callbacks of the last frame to be kept got %v", frameCallbacks)
+	}
+}
+
+func TestFrameCallbacksWithoutWindow(t *testing.T) {
+	context := CreateContext()
+
+	io := GetIO()
+	io.handle().IniFilename = nil
+	io.SetDisplaySize(NewImVec2(64, 64))
+	io.GetFonts().GetTextureDataAsRGBA32()
+
+	for i := 0; i < 3; i++ {
+		NewFrame()
+		SetNextWindowSizeConstraintsV(NewImVec2(0, 0), NewImVec2(100, 100), func(data ImGuiSizeCallbackData) {})
+		Begin("Constrained")
+		End()
+		Render()
+	}
+
+	if got := len(frameCallbacks[context]); got != 1 {
+		t.Errorf("expect NewFrame to release the callbacks of the previous frames, %d kept", got)
+	}
+
+	DestroyContext()
+
+	if _, ok := frameCallbacks[context]; ok || frameCallbacksHooked[context] {
+		t.Error("expect the callbacks to be released with the context")
+	}
+}
+
+func TestListBoxItems(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(320, 240))
+	defer window.Close()
+
+	current := int32(0)
+	changed := false
+	var listMin ImVec2
+	var lineHeight float32
+	window.SetLoop(func() {
+		SetNextWindowPosV(NewImVec2(0, 0), ImGuiCond_Always, NewImVec2(0, 0))
+		BeginV("Items", nil, ImGuiWindowFlags_NoTitleBar)
+		if ListBox("List", &current, []string{"First", "", "Third"}) {
+			changed = true
+		}
+		GetItemRectMin(&listMin)
+		lineHeight = GetTextLineHeightWithSpacing()
+		End()
+	})
+	window.Step()
+
+	// Click the middle item, an empty string
+	io := GetIO()
+	io.AddMousePosEvent(listMin.X+20, listMin.Y+GetStyle().GetFramePadding().Y+1.5*lineHeight)
+	window.Step()
+	io.AddMouseButtonEvent(0, true)
+	window.Step()
+	io.AddMouseButtonEvent(0, false)
+	window.Step()
+
+	if !changed || current != 1 {
+		t.Errorf("expect the second item to be selected got %d, changed: %v", current, changed)
+	}
+}
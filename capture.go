@@ -0,0 +1,85 @@
+package cimgui
+
+// #include "cimgui_wrapper.h"
+import "C"
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"os"
+)
+
+var (
+	errNoFrame        = errors.New("cimgui: no frame rendered yet")
+	errCaptureInFrame = errors.New("cimgui: frames can only be captured outside of the loop, e.g. from a function given to Post")
+)
+
+// CaptureFrame returns the pixels of the last frame of the window.
+// It must be called on the UI thread outside of the loop: from a function
+// given to Post or Call, from a render hook, or after Step.
+func (w *Window) CaptureFrame() (*image.RGBA, error) {
+	if w.closed {
+		return nil, ErrWindowClosed
+	}
+
+	SetCurrentContext(w.backend.Context())
+	if bool(C.igGetCurrentContext().WithinFrameScope) {
+		return nil, errCaptureInFrame
+	}
+
+	return w.backend.CaptureFrame()
+}
+
+// CaptureWindow is CaptureFrame limited to the rect of the dear imgui window
+// called name, as of the last frame.
+func (w *Window) CaptureWindow(name string) (*image.RGBA, error) {
+	frame, err := w.CaptureFrame()
+	if err != nil {
+		return nil, err
+	}
+
+	nameArg, nameFin := wrapString(name)
+	defer nameFin()
+
+	window := C.igFindWindowByName(nameArg)
+	if window == nil {
+		return nil, fmt.Errorf("cimgui: no window called %q", name)
+	}
+
+	data := GetDrawData()
+	origin := data.GetDisplayPos()
+	scale := data.GetFramebufferScale()
+	rect := image.Rect(
+		int((float32(window.Pos.x)-origin.X)*scale.X),
+		int((float32(window.Pos.y)-origin.Y)*scale.Y),
+		int((float32(window.Pos.x+window.Size.x)-origin.X)*scale.X),
+		int((float32(window.Pos.y+window.Size.y)-origin.Y)*scale.Y),
+	).Intersect(frame.Rect)
+
+	img := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	draw.Draw(img, img.Rect, frame, rect.Min, draw.Src)
+
+	return img, nil
+}
+
+// SaveFramePNG writes the last frame of the window to a PNG file, see CaptureFrame.
+func (w *Window) SaveFramePNG(path string) error {
+	frame, err := w.CaptureFrame()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(f, frame); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -6,1538 +6,272 @@
 extern "C" {
 #endif
 
-extern void ImDrawList_SetCmdBuffer(ImDrawList *ImDrawListPtr, ImVector_ImDrawCmd v);
-extern ImVector_ImDrawCmd ImDrawList_GetCmdBuffer(ImDrawList *self);
-extern void ImDrawList_SetIdxBuffer(ImDrawList *ImDrawListPtr, ImVector_ImDrawIdx v);
-extern ImVector_ImDrawIdx ImDrawList_GetIdxBuffer(ImDrawList *self);
-extern void ImDrawList_SetVtxBuffer(ImDrawList *ImDrawListPtr, ImVector_ImDrawVert v);
-extern ImVector_ImDrawVert ImDrawList_GetVtxBuffer(ImDrawList *self);
-extern void ImDrawList_SetFlags(ImDrawList *ImDrawListPtr, ImDrawListFlags v);
-extern ImDrawListFlags ImDrawList_GetFlags(ImDrawList *self);
-extern void ImDrawList_Set_VtxCurrentIdx(ImDrawList *ImDrawListPtr, unsigned int v);
-extern unsigned int ImDrawList_Get_VtxCurrentIdx(ImDrawList *self);
-extern void ImDrawList_Set_Data(ImDrawList *ImDrawListPtr, const ImDrawListSharedData* v);
-extern const ImDrawListSharedData* ImDrawList_Get_Data(ImDrawList *self);
-extern void ImDrawList_Set_OwnerName(ImDrawList *ImDrawListPtr, const char* v);
-extern const char* ImDrawList_Get_OwnerName(ImDrawList *self);
-extern void ImDrawList_Set_VtxWritePtr(ImDrawList *ImDrawListPtr, ImDrawVert* v);
-extern ImDrawVert* ImDrawList_Get_VtxWritePtr(ImDrawList *self);
-extern void ImDrawList_Set_IdxWritePtr(ImDrawList *ImDrawListPtr, ImDrawIdx* v);
-extern ImDrawIdx* ImDrawList_Get_IdxWritePtr(ImDrawList *self);
-extern void ImDrawList_Set_ClipRectStack(ImDrawList *ImDrawListPtr, ImVector_ImVec4 v);
-extern ImVector_ImVec4 ImDrawList_Get_ClipRectStack(ImDrawList *self);
-extern void ImDrawList_Set_TextureIdStack(ImDrawList *ImDrawListPtr, ImVector_ImTextureID v);
-extern ImVector_ImTextureID ImDrawList_Get_TextureIdStack(ImDrawList *self);
-extern void ImDrawList_Set_Path(ImDrawList *ImDrawListPtr, ImVector_ImVec2 v);
-extern ImVector_ImVec2 ImDrawList_Get_Path(ImDrawList *self);
-extern void ImDrawList_Set_CmdHeader(ImDrawList *ImDrawListPtr, ImDrawCmdHeader v);
-extern ImDrawCmdHeader ImDrawList_Get_CmdHeader(ImDrawList *self);
-extern void ImDrawList_Set_Splitter(ImDrawList *ImDrawListPtr, ImDrawListSplitter v);
-extern ImDrawListSplitter ImDrawList_Get_Splitter(ImDrawList *self);
-extern void ImDrawList_Set_FringeScale(ImDrawList *ImDrawListPtr, float v);
-extern float ImDrawList_Get_FringeScale(ImDrawList *self);
-extern void ImFontAtlasCustomRect_SetWidth(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, unsigned short v);
-extern unsigned short ImFontAtlasCustomRect_GetWidth(ImFontAtlasCustomRect *self);
-extern void ImFontAtlasCustomRect_SetHeight(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, unsigned short v);
-extern unsigned short ImFontAtlasCustomRect_GetHeight(ImFontAtlasCustomRect *self);
-extern void ImFontAtlasCustomRect_SetX(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, unsigned short v);
-extern unsigned short ImFontAtlasCustomRect_GetX(ImFontAtlasCustomRect *self);
-extern void ImFontAtlasCustomRect_SetY(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, unsigned short v);
-extern unsigned short ImFontAtlasCustomRect_GetY(ImFontAtlasCustomRect *self);
-extern void ImFontAtlasCustomRect_SetGlyphID(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, unsigned int v);
-extern unsigned int ImFontAtlasCustomRect_GetGlyphID(ImFontAtlasCustomRect *self);
-extern void ImFontAtlasCustomRect_SetGlyphAdvanceX(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, float v);
-extern float ImFontAtlasCustomRect_GetGlyphAdvanceX(ImFontAtlasCustomRect *self);
-extern void ImFontAtlasCustomRect_SetGlyphOffset(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, ImVec2 v);
-extern ImVec2 ImFontAtlasCustomRect_GetGlyphOffset(ImFontAtlasCustomRect *self);
-extern void ImFontAtlasCustomRect_SetFont(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, ImFont* v);
-extern ImFont* ImFontAtlasCustomRect_GetFont(ImFontAtlasCustomRect *self);
-extern void ImGuiTabBar_SetTabs(ImGuiTabBar *ImGuiTabBarPtr, ImVector_ImGuiTabItem v);
-extern ImVector_ImGuiTabItem ImGuiTabBar_GetTabs(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetFlags(ImGuiTabBar *ImGuiTabBarPtr, ImGuiTabBarFlags v);
-extern ImGuiTabBarFlags ImGuiTabBar_GetFlags(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetID(ImGuiTabBar *ImGuiTabBarPtr, ImGuiID v);
-extern ImGuiID ImGuiTabBar_GetID(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetSelectedTabId(ImGuiTabBar *ImGuiTabBarPtr, ImGuiID v);
-extern ImGuiID ImGuiTabBar_GetSelectedTabId(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetNextSelectedTabId(ImGuiTabBar *ImGuiTabBarPtr, ImGuiID v);
-extern ImGuiID ImGuiTabBar_GetNextSelectedTabId(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetVisibleTabId(ImGuiTabBar *ImGuiTabBarPtr, ImGuiID v);
-extern ImGuiID ImGuiTabBar_GetVisibleTabId(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetCurrFrameVisible(ImGuiTabBar *ImGuiTabBarPtr, int v);
-extern int ImGuiTabBar_GetCurrFrameVisible(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetPrevFrameVisible(ImGuiTabBar *ImGuiTabBarPtr, int v);
-extern int ImGuiTabBar_GetPrevFrameVisible(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetBarRect(ImGuiTabBar *ImGuiTabBarPtr, ImRect v);
-extern ImRect ImGuiTabBar_GetBarRect(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetCurrTabsContentsHeight(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetCurrTabsContentsHeight(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetPrevTabsContentsHeight(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetPrevTabsContentsHeight(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetWidthAllTabs(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetWidthAllTabs(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetWidthAllTabsIdeal(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetWidthAllTabsIdeal(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetScrollingAnim(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetScrollingAnim(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetScrollingTarget(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetScrollingTarget(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetScrollingTargetDistToVisibility(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetScrollingTargetDistToVisibility(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetScrollingSpeed(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetScrollingSpeed(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetScrollingRectMinX(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetScrollingRectMinX(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetScrollingRectMaxX(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetScrollingRectMaxX(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetReorderRequestTabId(ImGuiTabBar *ImGuiTabBarPtr, ImGuiID v);
-extern ImGuiID ImGuiTabBar_GetReorderRequestTabId(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetReorderRequestOffset(ImGuiTabBar *ImGuiTabBarPtr, ImS16 v);
-extern ImS16 ImGuiTabBar_GetReorderRequestOffset(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetBeginCount(ImGuiTabBar *ImGuiTabBarPtr, ImS8 v);
-extern ImS8 ImGuiTabBar_GetBeginCount(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetWantLayout(ImGuiTabBar *ImGuiTabBarPtr, bool v);
-extern bool ImGuiTabBar_GetWantLayout(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetVisibleTabWasSubmitted(ImGuiTabBar *ImGuiTabBarPtr, bool v);
-extern bool ImGuiTabBar_GetVisibleTabWasSubmitted(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetTabsAddedNew(ImGuiTabBar *ImGuiTabBarPtr, bool v);
-extern bool ImGuiTabBar_GetTabsAddedNew(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetTabsActiveCount(ImGuiTabBar *ImGuiTabBarPtr, ImS16 v);
-extern ImS16 ImGuiTabBar_GetTabsActiveCount(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetLastTabItemIdx(ImGuiTabBar *ImGuiTabBarPtr, ImS16 v);
-extern ImS16 ImGuiTabBar_GetLastTabItemIdx(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetItemSpacingY(ImGuiTabBar *ImGuiTabBarPtr, float v);
-extern float ImGuiTabBar_GetItemSpacingY(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetFramePadding(ImGuiTabBar *ImGuiTabBarPtr, ImVec2 v);
-extern ImVec2 ImGuiTabBar_GetFramePadding(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetBackupCursorPos(ImGuiTabBar *ImGuiTabBarPtr, ImVec2 v);
-extern ImVec2 ImGuiTabBar_GetBackupCursorPos(ImGuiTabBar *self);
-extern void ImGuiTabBar_SetTabsNames(ImGuiTabBar *ImGuiTabBarPtr, ImGuiTextBuffer v);
-extern ImGuiTextBuffer ImGuiTabBar_GetTabsNames(ImGuiTabBar *self);
-extern void ImVec1_Setx(ImVec1 *ImVec1Ptr, float v);
-extern float ImVec1_Getx(ImVec1 *self);
-extern void ImBitVector_SetStorage(ImBitVector *ImBitVectorPtr, ImVector_ImU32 v);
-extern ImVector_ImU32 ImBitVector_GetStorage(ImBitVector *self);
-extern void ImGuiNavItemData_SetWindow(ImGuiNavItemData *ImGuiNavItemDataPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiNavItemData_GetWindow(ImGuiNavItemData *self);
-extern void ImGuiNavItemData_SetID(ImGuiNavItemData *ImGuiNavItemDataPtr, ImGuiID v);
-extern ImGuiID ImGuiNavItemData_GetID(ImGuiNavItemData *self);
-extern void ImGuiNavItemData_SetFocusScopeId(ImGuiNavItemData *ImGuiNavItemDataPtr, ImGuiID v);
-extern ImGuiID ImGuiNavItemData_GetFocusScopeId(ImGuiNavItemData *self);
-extern void ImGuiNavItemData_SetRectRel(ImGuiNavItemData *ImGuiNavItemDataPtr, ImRect v);
-extern ImRect ImGuiNavItemData_GetRectRel(ImGuiNavItemData *self);
-extern void ImGuiNavItemData_SetInFlags(ImGuiNavItemData *ImGuiNavItemDataPtr, ImGuiItemFlags v);
-extern ImGuiItemFlags ImGuiNavItemData_GetInFlags(ImGuiNavItemData *self);
-extern void ImGuiNavItemData_SetDistBox(ImGuiNavItemData *ImGuiNavItemDataPtr, float v);
-extern float ImGuiNavItemData_GetDistBox(ImGuiNavItemData *self);
-extern void ImGuiNavItemData_SetDistCenter(ImGuiNavItemData *ImGuiNavItemDataPtr, float v);
-extern float ImGuiNavItemData_GetDistCenter(ImGuiNavItemData *self);
-extern void ImGuiNavItemData_SetDistAxial(ImGuiNavItemData *ImGuiNavItemDataPtr, float v);
-extern float ImGuiNavItemData_GetDistAxial(ImGuiNavItemData *self);
-extern void ImGuiOldColumns_SetID(ImGuiOldColumns *ImGuiOldColumnsPtr, ImGuiID v);
-extern ImGuiID ImGuiOldColumns_GetID(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetFlags(ImGuiOldColumns *ImGuiOldColumnsPtr, ImGuiOldColumnFlags v);
-extern ImGuiOldColumnFlags ImGuiOldColumns_GetFlags(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetIsFirstFrame(ImGuiOldColumns *ImGuiOldColumnsPtr, bool v);
-extern bool ImGuiOldColumns_GetIsFirstFrame(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetIsBeingResized(ImGuiOldColumns *ImGuiOldColumnsPtr, bool v);
-extern bool ImGuiOldColumns_GetIsBeingResized(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetCurrent(ImGuiOldColumns *ImGuiOldColumnsPtr, int v);
-extern int ImGuiOldColumns_GetCurrent(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetCount(ImGuiOldColumns *ImGuiOldColumnsPtr, int v);
-extern int ImGuiOldColumns_GetCount(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetOffMinX(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
-extern float ImGuiOldColumns_GetOffMinX(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetOffMaxX(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
-extern float ImGuiOldColumns_GetOffMaxX(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetLineMinY(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
-extern float ImGuiOldColumns_GetLineMinY(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetLineMaxY(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
-extern float ImGuiOldColumns_GetLineMaxY(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetHostCursorPosY(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
-extern float ImGuiOldColumns_GetHostCursorPosY(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetHostCursorMaxPosX(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
-extern float ImGuiOldColumns_GetHostCursorMaxPosX(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetHostInitialClipRect(ImGuiOldColumns *ImGuiOldColumnsPtr, ImRect v);
-extern ImRect ImGuiOldColumns_GetHostInitialClipRect(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetHostBackupClipRect(ImGuiOldColumns *ImGuiOldColumnsPtr, ImRect v);
-extern ImRect ImGuiOldColumns_GetHostBackupClipRect(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetHostBackupParentWorkRect(ImGuiOldColumns *ImGuiOldColumnsPtr, ImRect v);
-extern ImRect ImGuiOldColumns_GetHostBackupParentWorkRect(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetColumns(ImGuiOldColumns *ImGuiOldColumnsPtr, ImVector_ImGuiOldColumnData v);
-extern ImVector_ImGuiOldColumnData ImGuiOldColumns_GetColumns(ImGuiOldColumns *self);
-extern void ImGuiOldColumns_SetSplitter(ImGuiOldColumns *ImGuiOldColumnsPtr, ImDrawListSplitter v);
-extern ImDrawListSplitter ImGuiOldColumns_GetSplitter(ImGuiOldColumns *self);
-extern void ImGuiTableCellData_SetBgColor(ImGuiTableCellData *ImGuiTableCellDataPtr, ImU32 v);
-extern ImU32 ImGuiTableCellData_GetBgColor(ImGuiTableCellData *self);
-extern void ImGuiTableCellData_SetColumn(ImGuiTableCellData *ImGuiTableCellDataPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableCellData_GetColumn(ImGuiTableCellData *self);
-extern void ImGuiTableColumn_SetFlags(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnFlags v);
-extern ImGuiTableColumnFlags ImGuiTableColumn_GetFlags(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetWidthGiven(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetWidthGiven(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetMinX(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetMinX(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetMaxX(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetMaxX(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetWidthRequest(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetWidthRequest(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetWidthAuto(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetWidthAuto(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetStretchWeight(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetStretchWeight(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetInitStretchWeightOrWidth(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetInitStretchWeightOrWidth(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetClipRect(ImGuiTableColumn *ImGuiTableColumnPtr, ImRect v);
-extern ImRect ImGuiTableColumn_GetClipRect(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetUserID(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiID v);
-extern ImGuiID ImGuiTableColumn_GetUserID(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetWorkMinX(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetWorkMinX(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetWorkMaxX(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetWorkMaxX(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetItemWidth(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetItemWidth(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetContentMaxXFrozen(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetContentMaxXFrozen(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetContentMaxXUnfrozen(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetContentMaxXUnfrozen(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetContentMaxXHeadersUsed(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetContentMaxXHeadersUsed(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetContentMaxXHeadersIdeal(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
-extern float ImGuiTableColumn_GetContentMaxXHeadersIdeal(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetNameOffset(ImGuiTableColumn *ImGuiTableColumnPtr, ImS16 v);
-extern ImS16 ImGuiTableColumn_GetNameOffset(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetDisplayOrder(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableColumn_GetDisplayOrder(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetIndexWithinEnabledSet(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableColumn_GetIndexWithinEnabledSet(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetPrevEnabledColumn(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableColumn_GetPrevEnabledColumn(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetNextEnabledColumn(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableColumn_GetNextEnabledColumn(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetSortOrder(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableColumn_GetSortOrder(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetDrawChannelCurrent(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableDrawChannelIdx v);
-extern ImGuiTableDrawChannelIdx ImGuiTableColumn_GetDrawChannelCurrent(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetDrawChannelFrozen(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableDrawChannelIdx v);
-extern ImGuiTableDrawChannelIdx ImGuiTableColumn_GetDrawChannelFrozen(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetDrawChannelUnfrozen(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableDrawChannelIdx v);
-extern ImGuiTableDrawChannelIdx ImGuiTableColumn_GetDrawChannelUnfrozen(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetIsEnabled(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
-extern bool ImGuiTableColumn_GetIsEnabled(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetIsUserEnabled(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
-extern bool ImGuiTableColumn_GetIsUserEnabled(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetIsUserEnabledNextFrame(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
-extern bool ImGuiTableColumn_GetIsUserEnabledNextFrame(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetIsVisibleX(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
-extern bool ImGuiTableColumn_GetIsVisibleX(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetIsVisibleY(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
-extern bool ImGuiTableColumn_GetIsVisibleY(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetIsRequestOutput(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
-extern bool ImGuiTableColumn_GetIsRequestOutput(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetIsSkipItems(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
-extern bool ImGuiTableColumn_GetIsSkipItems(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetIsPreserveWidthAuto(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
-extern bool ImGuiTableColumn_GetIsPreserveWidthAuto(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetNavLayerCurrent(ImGuiTableColumn *ImGuiTableColumnPtr, ImS8 v);
-extern ImS8 ImGuiTableColumn_GetNavLayerCurrent(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetAutoFitQueue(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
-extern ImU8 ImGuiTableColumn_GetAutoFitQueue(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetCannotSkipItemsQueue(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
-extern ImU8 ImGuiTableColumn_GetCannotSkipItemsQueue(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetSortDirection(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
-extern ImU8 ImGuiTableColumn_GetSortDirection(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetSortDirectionsAvailCount(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
-extern ImU8 ImGuiTableColumn_GetSortDirectionsAvailCount(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetSortDirectionsAvailMask(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
-extern ImU8 ImGuiTableColumn_GetSortDirectionsAvailMask(ImGuiTableColumn *self);
-extern void ImGuiTableColumn_SetSortDirectionsAvailList(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
-extern ImU8 ImGuiTableColumn_GetSortDirectionsAvailList(ImGuiTableColumn *self);
-extern void ImGuiTableTempData_SetTableIndex(ImGuiTableTempData *ImGuiTableTempDataPtr, int v);
-extern int ImGuiTableTempData_GetTableIndex(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetLastTimeActive(ImGuiTableTempData *ImGuiTableTempDataPtr, float v);
-extern float ImGuiTableTempData_GetLastTimeActive(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetUserOuterSize(ImGuiTableTempData *ImGuiTableTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiTableTempData_GetUserOuterSize(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetDrawSplitter(ImGuiTableTempData *ImGuiTableTempDataPtr, ImDrawListSplitter v);
-extern ImDrawListSplitter ImGuiTableTempData_GetDrawSplitter(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetHostBackupWorkRect(ImGuiTableTempData *ImGuiTableTempDataPtr, ImRect v);
-extern ImRect ImGuiTableTempData_GetHostBackupWorkRect(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetHostBackupParentWorkRect(ImGuiTableTempData *ImGuiTableTempDataPtr, ImRect v);
-extern ImRect ImGuiTableTempData_GetHostBackupParentWorkRect(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetHostBackupPrevLineSize(ImGuiTableTempData *ImGuiTableTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiTableTempData_GetHostBackupPrevLineSize(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetHostBackupCurrLineSize(ImGuiTableTempData *ImGuiTableTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiTableTempData_GetHostBackupCurrLineSize(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetHostBackupCursorMaxPos(ImGuiTableTempData *ImGuiTableTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiTableTempData_GetHostBackupCursorMaxPos(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetHostBackupColumnsOffset(ImGuiTableTempData *ImGuiTableTempDataPtr, ImVec1 v);
-extern ImVec1 ImGuiTableTempData_GetHostBackupColumnsOffset(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetHostBackupItemWidth(ImGuiTableTempData *ImGuiTableTempDataPtr, float v);
-extern float ImGuiTableTempData_GetHostBackupItemWidth(ImGuiTableTempData *self);
-extern void ImGuiTableTempData_SetHostBackupItemWidthStackSize(ImGuiTableTempData *ImGuiTableTempDataPtr, int v);
-extern int ImGuiTableTempData_GetHostBackupItemWidthStackSize(ImGuiTableTempData *self);
-extern void ImGuiInputEvent_SetType(ImGuiInputEvent *ImGuiInputEventPtr, ImGuiInputEventType v);
-extern ImGuiInputEventType ImGuiInputEvent_GetType(ImGuiInputEvent *self);
-extern void ImGuiInputEvent_SetSource(ImGuiInputEvent *ImGuiInputEventPtr, ImGuiInputSource v);
-extern ImGuiInputSource ImGuiInputEvent_GetSource(ImGuiInputEvent *self);
-extern void ImGuiInputEvent_SetAddedByTestEngine(ImGuiInputEvent *ImGuiInputEventPtr, bool v);
-extern bool ImGuiInputEvent_GetAddedByTestEngine(ImGuiInputEvent *self);
-extern void ImDrawVert_Setpos(ImDrawVert *ImDrawVertPtr, ImVec2 v);
-extern ImVec2 ImDrawVert_Getpos(ImDrawVert *self);
-extern void ImDrawVert_Setuv(ImDrawVert *ImDrawVertPtr, ImVec2 v);
-extern ImVec2 ImDrawVert_Getuv(ImDrawVert *self);
-extern void ImDrawVert_Setcol(ImDrawVert *ImDrawVertPtr, ImU32 v);
-extern ImU32 ImDrawVert_Getcol(ImDrawVert *self);
-extern void ImGuiContext_SetInitialized(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetInitialized(ImGuiContext *self);
-extern void ImGuiContext_SetFontAtlasOwnedByContext(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetFontAtlasOwnedByContext(ImGuiContext *self);
-extern void ImGuiContext_SetIO(ImGuiContext *ImGuiContextPtr, ImGuiIO v);
-extern ImGuiIO ImGuiContext_GetIO(ImGuiContext *self);
-extern void ImGuiContext_SetPlatformIO(ImGuiContext *ImGuiContextPtr, ImGuiPlatformIO v);
-extern ImGuiPlatformIO ImGuiContext_GetPlatformIO(ImGuiContext *self);
-extern void ImGuiContext_SetInputEventsQueue(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiInputEvent v);
-extern ImVector_ImGuiInputEvent ImGuiContext_GetInputEventsQueue(ImGuiContext *self);
-extern void ImGuiContext_SetInputEventsTrail(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiInputEvent v);
-extern ImVector_ImGuiInputEvent ImGuiContext_GetInputEventsTrail(ImGuiContext *self);
-extern void ImGuiContext_SetStyle(ImGuiContext *ImGuiContextPtr, ImGuiStyle v);
-extern ImGuiStyle ImGuiContext_GetStyle(ImGuiContext *self);
-extern void ImGuiContext_SetConfigFlagsCurrFrame(ImGuiContext *ImGuiContextPtr, ImGuiConfigFlags v);
-extern ImGuiConfigFlags ImGuiContext_GetConfigFlagsCurrFrame(ImGuiContext *self);
-extern void ImGuiContext_SetConfigFlagsLastFrame(ImGuiContext *ImGuiContextPtr, ImGuiConfigFlags v);
-extern ImGuiConfigFlags ImGuiContext_GetConfigFlagsLastFrame(ImGuiContext *self);
-extern void ImGuiContext_SetFont(ImGuiContext *ImGuiContextPtr, ImFont* v);
-extern ImFont* ImGuiContext_GetFont(ImGuiContext *self);
-extern void ImGuiContext_SetFontSize(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetFontSize(ImGuiContext *self);
-extern void ImGuiContext_SetFontBaseSize(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetFontBaseSize(ImGuiContext *self);
-extern void ImGuiContext_SetDrawListSharedData(ImGuiContext *ImGuiContextPtr, ImDrawListSharedData v);
-extern ImDrawListSharedData ImGuiContext_GetDrawListSharedData(ImGuiContext *self);
-extern void ImGuiContext_SetTime(ImGuiContext *ImGuiContextPtr, double v);
-extern double ImGuiContext_GetTime(ImGuiContext *self);
-extern void ImGuiContext_SetFrameCount(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetFrameCount(ImGuiContext *self);
-extern void ImGuiContext_SetFrameCountEnded(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetFrameCountEnded(ImGuiContext *self);
-extern void ImGuiContext_SetFrameCountPlatformEnded(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetFrameCountPlatformEnded(ImGuiContext *self);
-extern void ImGuiContext_SetFrameCountRendered(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetFrameCountRendered(ImGuiContext *self);
-extern void ImGuiContext_SetWithinFrameScope(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetWithinFrameScope(ImGuiContext *self);
-extern void ImGuiContext_SetWithinFrameScopeWithImplicitWindow(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetWithinFrameScopeWithImplicitWindow(ImGuiContext *self);
-extern void ImGuiContext_SetWithinEndChild(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetWithinEndChild(ImGuiContext *self);
-extern void ImGuiContext_SetGcCompactAll(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetGcCompactAll(ImGuiContext *self);
-extern void ImGuiContext_SetTestEngineHookItems(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetTestEngineHookItems(ImGuiContext *self);
-extern void ImGuiContext_SetTestEngine(ImGuiContext *ImGuiContextPtr, void* v);
-extern void* ImGuiContext_GetTestEngine(ImGuiContext *self);
-extern void ImGuiContext_SetWindows(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiWindowPtr v);
-extern ImVector_ImGuiWindowPtr ImGuiContext_GetWindows(ImGuiContext *self);
-extern void ImGuiContext_SetWindowsFocusOrder(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiWindowPtr v);
-extern ImVector_ImGuiWindowPtr ImGuiContext_GetWindowsFocusOrder(ImGuiContext *self);
-extern void ImGuiContext_SetWindowsTempSortBuffer(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiWindowPtr v);
-extern ImVector_ImGuiWindowPtr ImGuiContext_GetWindowsTempSortBuffer(ImGuiContext *self);
-extern void ImGuiContext_SetCurrentWindowStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiWindowStackData v);
-extern ImVector_ImGuiWindowStackData ImGuiContext_GetCurrentWindowStack(ImGuiContext *self);
-extern void ImGuiContext_SetWindowsById(ImGuiContext *ImGuiContextPtr, ImGuiStorage v);
-extern ImGuiStorage ImGuiContext_GetWindowsById(ImGuiContext *self);
-extern void ImGuiContext_SetWindowsActiveCount(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetWindowsActiveCount(ImGuiContext *self);
-extern void ImGuiContext_SetWindowsHoverPadding(ImGuiContext *ImGuiContextPtr, ImVec2 v);
-extern ImVec2 ImGuiContext_GetWindowsHoverPadding(ImGuiContext *self);
-extern void ImGuiContext_SetCurrentWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetCurrentWindow(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetHoveredWindow(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredWindowUnderMovingWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetHoveredWindowUnderMovingWindow(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredDockNode(ImGuiContext *ImGuiContextPtr, ImGuiDockNode* v);
-extern ImGuiDockNode* ImGuiContext_GetHoveredDockNode(ImGuiContext *self);
-extern void ImGuiContext_SetMovingWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetMovingWindow(ImGuiContext *self);
-extern void ImGuiContext_SetWheelingWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetWheelingWindow(ImGuiContext *self);
-extern void ImGuiContext_SetWheelingWindowRefMousePos(ImGuiContext *ImGuiContextPtr, ImVec2 v);
-extern ImVec2 ImGuiContext_GetWheelingWindowRefMousePos(ImGuiContext *self);
-extern void ImGuiContext_SetWheelingWindowTimer(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetWheelingWindowTimer(ImGuiContext *self);
-extern void ImGuiContext_SetDebugHookIdInfo(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetDebugHookIdInfo(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetHoveredId(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredIdPreviousFrame(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetHoveredIdPreviousFrame(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredIdAllowOverlap(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetHoveredIdAllowOverlap(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredIdUsingMouseWheel(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetHoveredIdUsingMouseWheel(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredIdPreviousFrameUsingMouseWheel(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetHoveredIdPreviousFrameUsingMouseWheel(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredIdDisabled(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetHoveredIdDisabled(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredIdTimer(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetHoveredIdTimer(ImGuiContext *self);
-extern void ImGuiContext_SetHoveredIdNotActiveTimer(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetHoveredIdNotActiveTimer(ImGuiContext *self);
-extern void ImGuiContext_SetActiveId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetActiveId(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdIsAlive(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetActiveIdIsAlive(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdTimer(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetActiveIdTimer(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdIsJustActivated(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetActiveIdIsJustActivated(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdAllowOverlap(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetActiveIdAllowOverlap(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdNoClearOnFocusLoss(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetActiveIdNoClearOnFocusLoss(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdHasBeenPressedBefore(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetActiveIdHasBeenPressedBefore(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdHasBeenEditedBefore(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetActiveIdHasBeenEditedBefore(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdHasBeenEditedThisFrame(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetActiveIdHasBeenEditedThisFrame(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdClickOffset(ImGuiContext *ImGuiContextPtr, ImVec2 v);
-extern ImVec2 ImGuiContext_GetActiveIdClickOffset(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetActiveIdWindow(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdSource(ImGuiContext *ImGuiContextPtr, ImGuiInputSource v);
-extern ImGuiInputSource ImGuiContext_GetActiveIdSource(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdMouseButton(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetActiveIdMouseButton(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdPreviousFrame(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetActiveIdPreviousFrame(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdPreviousFrameIsAlive(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetActiveIdPreviousFrameIsAlive(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdPreviousFrameHasBeenEditedBefore(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetActiveIdPreviousFrameHasBeenEditedBefore(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdPreviousFrameWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetActiveIdPreviousFrameWindow(ImGuiContext *self);
-extern void ImGuiContext_SetLastActiveId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetLastActiveId(ImGuiContext *self);
-extern void ImGuiContext_SetLastActiveIdTimer(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetLastActiveIdTimer(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdUsingNavDirMask(ImGuiContext *ImGuiContextPtr, ImU32 v);
-extern ImU32 ImGuiContext_GetActiveIdUsingNavDirMask(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdUsingKeyInputMask(ImGuiContext *ImGuiContextPtr, ImBitArrayForNamedKeys v);
-extern ImBitArrayForNamedKeys ImGuiContext_GetActiveIdUsingKeyInputMask(ImGuiContext *self);
-extern void ImGuiContext_SetActiveIdUsingNavInputMask(ImGuiContext *ImGuiContextPtr, ImU32 v);
-extern ImU32 ImGuiContext_GetActiveIdUsingNavInputMask(ImGuiContext *self);
-extern void ImGuiContext_SetCurrentItemFlags(ImGuiContext *ImGuiContextPtr, ImGuiItemFlags v);
-extern ImGuiItemFlags ImGuiContext_GetCurrentItemFlags(ImGuiContext *self);
-extern void ImGuiContext_SetNextItemData(ImGuiContext *ImGuiContextPtr, ImGuiNextItemData v);
-extern ImGuiNextItemData ImGuiContext_GetNextItemData(ImGuiContext *self);
-extern void ImGuiContext_SetLastItemData(ImGuiContext *ImGuiContextPtr, ImGuiLastItemData v);
-extern ImGuiLastItemData ImGuiContext_GetLastItemData(ImGuiContext *self);
-extern void ImGuiContext_SetNextWindowData(ImGuiContext *ImGuiContextPtr, ImGuiNextWindowData v);
-extern ImGuiNextWindowData ImGuiContext_GetNextWindowData(ImGuiContext *self);
-extern void ImGuiContext_SetColorStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiColorMod v);
-extern ImVector_ImGuiColorMod ImGuiContext_GetColorStack(ImGuiContext *self);
-extern void ImGuiContext_SetStyleVarStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiStyleMod v);
-extern ImVector_ImGuiStyleMod ImGuiContext_GetStyleVarStack(ImGuiContext *self);
-extern void ImGuiContext_SetFontStack(ImGuiContext *ImGuiContextPtr, ImVector_ImFontPtr v);
-extern ImVector_ImFontPtr ImGuiContext_GetFontStack(ImGuiContext *self);
-extern void ImGuiContext_SetFocusScopeStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiID v);
-extern ImVector_ImGuiID ImGuiContext_GetFocusScopeStack(ImGuiContext *self);
-extern void ImGuiContext_SetItemFlagsStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiItemFlags v);
-extern ImVector_ImGuiItemFlags ImGuiContext_GetItemFlagsStack(ImGuiContext *self);
-extern void ImGuiContext_SetGroupStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiGroupData v);
-extern ImVector_ImGuiGroupData ImGuiContext_GetGroupStack(ImGuiContext *self);
-extern void ImGuiContext_SetOpenPopupStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiPopupData v);
-extern ImVector_ImGuiPopupData ImGuiContext_GetOpenPopupStack(ImGuiContext *self);
-extern void ImGuiContext_SetBeginPopupStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiPopupData v);
-extern ImVector_ImGuiPopupData ImGuiContext_GetBeginPopupStack(ImGuiContext *self);
-extern void ImGuiContext_SetBeginMenuCount(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetBeginMenuCount(ImGuiContext *self);
-extern void ImGuiContext_SetViewports(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiViewportPPtr v);
-extern ImVector_ImGuiViewportPPtr ImGuiContext_GetViewports(ImGuiContext *self);
-extern void ImGuiContext_SetCurrentDpiScale(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetCurrentDpiScale(ImGuiContext *self);
-extern void ImGuiContext_SetCurrentViewport(ImGuiContext *ImGuiContextPtr, ImGuiViewportP* v);
-extern ImGuiViewportP* ImGuiContext_GetCurrentViewport(ImGuiContext *self);
-extern void ImGuiContext_SetMouseViewport(ImGuiContext *ImGuiContextPtr, ImGuiViewportP* v);
-extern ImGuiViewportP* ImGuiContext_GetMouseViewport(ImGuiContext *self);
-extern void ImGuiContext_SetMouseLastHoveredViewport(ImGuiContext *ImGuiContextPtr, ImGuiViewportP* v);
-extern ImGuiViewportP* ImGuiContext_GetMouseLastHoveredViewport(ImGuiContext *self);
-extern void ImGuiContext_SetPlatformLastFocusedViewportId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetPlatformLastFocusedViewportId(ImGuiContext *self);
-extern void ImGuiContext_SetFallbackMonitor(ImGuiContext *ImGuiContextPtr, ImGuiPlatformMonitor v);
-extern ImGuiPlatformMonitor ImGuiContext_GetFallbackMonitor(ImGuiContext *self);
-extern void ImGuiContext_SetViewportFrontMostStampCount(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetViewportFrontMostStampCount(ImGuiContext *self);
-extern void ImGuiContext_SetNavWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetNavWindow(ImGuiContext *self);
-extern void ImGuiContext_SetNavId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetNavId(ImGuiContext *self);
-extern void ImGuiContext_SetNavFocusScopeId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetNavFocusScopeId(ImGuiContext *self);
-extern void ImGuiContext_SetNavActivateId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetNavActivateId(ImGuiContext *self);
-extern void ImGuiContext_SetNavActivateDownId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetNavActivateDownId(ImGuiContext *self);
-extern void ImGuiContext_SetNavActivatePressedId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetNavActivatePressedId(ImGuiContext *self);
-extern void ImGuiContext_SetNavActivateInputId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetNavActivateInputId(ImGuiContext *self);
-extern void ImGuiContext_SetNavActivateFlags(ImGuiContext *ImGuiContextPtr, ImGuiActivateFlags v);
-extern ImGuiActivateFlags ImGuiContext_GetNavActivateFlags(ImGuiContext *self);
-extern void ImGuiContext_SetNavJustMovedToId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetNavJustMovedToId(ImGuiContext *self);
-extern void ImGuiContext_SetNavJustMovedToFocusScopeId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetNavJustMovedToFocusScopeId(ImGuiContext *self);
-extern void ImGuiContext_SetNavJustMovedToKeyMods(ImGuiContext *ImGuiContextPtr, ImGuiModFlags v);
-extern ImGuiModFlags ImGuiContext_GetNavJustMovedToKeyMods(ImGuiContext *self);
-extern void ImGuiContext_SetNavNextActivateId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetNavNextActivateId(ImGuiContext *self);
-extern void ImGuiContext_SetNavNextActivateFlags(ImGuiContext *ImGuiContextPtr, ImGuiActivateFlags v);
-extern ImGuiActivateFlags ImGuiContext_GetNavNextActivateFlags(ImGuiContext *self);
-extern void ImGuiContext_SetNavInputSource(ImGuiContext *ImGuiContextPtr, ImGuiInputSource v);
-extern ImGuiInputSource ImGuiContext_GetNavInputSource(ImGuiContext *self);
-extern void ImGuiContext_SetNavLayer(ImGuiContext *ImGuiContextPtr, ImGuiNavLayer v);
-extern ImGuiNavLayer ImGuiContext_GetNavLayer(ImGuiContext *self);
-extern void ImGuiContext_SetNavIdIsAlive(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavIdIsAlive(ImGuiContext *self);
-extern void ImGuiContext_SetNavMousePosDirty(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavMousePosDirty(ImGuiContext *self);
-extern void ImGuiContext_SetNavDisableHighlight(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavDisableHighlight(ImGuiContext *self);
-extern void ImGuiContext_SetNavDisableMouseHover(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavDisableMouseHover(ImGuiContext *self);
-extern void ImGuiContext_SetNavAnyRequest(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavAnyRequest(ImGuiContext *self);
-extern void ImGuiContext_SetNavInitRequest(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavInitRequest(ImGuiContext *self);
-extern void ImGuiContext_SetNavInitRequestFromMove(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavInitRequestFromMove(ImGuiContext *self);
-extern void ImGuiContext_SetNavInitResultId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetNavInitResultId(ImGuiContext *self);
-extern void ImGuiContext_SetNavInitResultRectRel(ImGuiContext *ImGuiContextPtr, ImRect v);
-extern ImRect ImGuiContext_GetNavInitResultRectRel(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveSubmitted(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavMoveSubmitted(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveScoringItems(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavMoveScoringItems(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveForwardToNextFrame(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavMoveForwardToNextFrame(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveFlags(ImGuiContext *ImGuiContextPtr, ImGuiNavMoveFlags v);
-extern ImGuiNavMoveFlags ImGuiContext_GetNavMoveFlags(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveScrollFlags(ImGuiContext *ImGuiContextPtr, ImGuiScrollFlags v);
-extern ImGuiScrollFlags ImGuiContext_GetNavMoveScrollFlags(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveKeyMods(ImGuiContext *ImGuiContextPtr, ImGuiModFlags v);
-extern ImGuiModFlags ImGuiContext_GetNavMoveKeyMods(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveDir(ImGuiContext *ImGuiContextPtr, ImGuiDir v);
-extern ImGuiDir ImGuiContext_GetNavMoveDir(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveDirForDebug(ImGuiContext *ImGuiContextPtr, ImGuiDir v);
-extern ImGuiDir ImGuiContext_GetNavMoveDirForDebug(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveClipDir(ImGuiContext *ImGuiContextPtr, ImGuiDir v);
-extern ImGuiDir ImGuiContext_GetNavMoveClipDir(ImGuiContext *self);
-extern void ImGuiContext_SetNavScoringRect(ImGuiContext *ImGuiContextPtr, ImRect v);
-extern ImRect ImGuiContext_GetNavScoringRect(ImGuiContext *self);
-extern void ImGuiContext_SetNavScoringNoClipRect(ImGuiContext *ImGuiContextPtr, ImRect v);
-extern ImRect ImGuiContext_GetNavScoringNoClipRect(ImGuiContext *self);
-extern void ImGuiContext_SetNavScoringDebugCount(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetNavScoringDebugCount(ImGuiContext *self);
-extern void ImGuiContext_SetNavTabbingDir(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetNavTabbingDir(ImGuiContext *self);
-extern void ImGuiContext_SetNavTabbingCounter(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetNavTabbingCounter(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveResultLocal(ImGuiContext *ImGuiContextPtr, ImGuiNavItemData v);
-extern ImGuiNavItemData ImGuiContext_GetNavMoveResultLocal(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveResultLocalVisible(ImGuiContext *ImGuiContextPtr, ImGuiNavItemData v);
-extern ImGuiNavItemData ImGuiContext_GetNavMoveResultLocalVisible(ImGuiContext *self);
-extern void ImGuiContext_SetNavMoveResultOther(ImGuiContext *ImGuiContextPtr, ImGuiNavItemData v);
-extern ImGuiNavItemData ImGuiContext_GetNavMoveResultOther(ImGuiContext *self);
-extern void ImGuiContext_SetNavTabbingResultFirst(ImGuiContext *ImGuiContextPtr, ImGuiNavItemData v);
-extern ImGuiNavItemData ImGuiContext_GetNavTabbingResultFirst(ImGuiContext *self);
-extern void ImGuiContext_SetNavWindowingTarget(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetNavWindowingTarget(ImGuiContext *self);
-extern void ImGuiContext_SetNavWindowingTargetAnim(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetNavWindowingTargetAnim(ImGuiContext *self);
-extern void ImGuiContext_SetNavWindowingListWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiContext_GetNavWindowingListWindow(ImGuiContext *self);
-extern void ImGuiContext_SetNavWindowingTimer(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetNavWindowingTimer(ImGuiContext *self);
-extern void ImGuiContext_SetNavWindowingHighlightAlpha(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetNavWindowingHighlightAlpha(ImGuiContext *self);
-extern void ImGuiContext_SetNavWindowingToggleLayer(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetNavWindowingToggleLayer(ImGuiContext *self);
-extern void ImGuiContext_SetNavWindowingAccumDeltaPos(ImGuiContext *ImGuiContextPtr, ImVec2 v);
-extern ImVec2 ImGuiContext_GetNavWindowingAccumDeltaPos(ImGuiContext *self);
-extern void ImGuiContext_SetNavWindowingAccumDeltaSize(ImGuiContext *ImGuiContextPtr, ImVec2 v);
-extern ImVec2 ImGuiContext_GetNavWindowingAccumDeltaSize(ImGuiContext *self);
-extern void ImGuiContext_SetDimBgRatio(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetDimBgRatio(ImGuiContext *self);
-extern void ImGuiContext_SetMouseCursor(ImGuiContext *ImGuiContextPtr, ImGuiMouseCursor v);
-extern ImGuiMouseCursor ImGuiContext_GetMouseCursor(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropActive(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetDragDropActive(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropWithinSource(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetDragDropWithinSource(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropWithinTarget(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetDragDropWithinTarget(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropSourceFlags(ImGuiContext *ImGuiContextPtr, ImGuiDragDropFlags v);
-extern ImGuiDragDropFlags ImGuiContext_GetDragDropSourceFlags(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropSourceFrameCount(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetDragDropSourceFrameCount(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropMouseButton(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetDragDropMouseButton(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropPayload(ImGuiContext *ImGuiContextPtr, ImGuiPayload v);
-extern ImGuiPayload ImGuiContext_GetDragDropPayload(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropTargetRect(ImGuiContext *ImGuiContextPtr, ImRect v);
-extern ImRect ImGuiContext_GetDragDropTargetRect(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropTargetId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetDragDropTargetId(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropAcceptFlags(ImGuiContext *ImGuiContextPtr, ImGuiDragDropFlags v);
-extern ImGuiDragDropFlags ImGuiContext_GetDragDropAcceptFlags(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropAcceptIdCurrRectSurface(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetDragDropAcceptIdCurrRectSurface(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropAcceptIdCurr(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetDragDropAcceptIdCurr(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropAcceptIdPrev(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetDragDropAcceptIdPrev(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropAcceptFrameCount(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetDragDropAcceptFrameCount(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropHoldJustPressedId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetDragDropHoldJustPressedId(ImGuiContext *self);
-extern void ImGuiContext_SetDragDropPayloadBufHeap(ImGuiContext *ImGuiContextPtr, ImVector_unsigned_char v);
-extern ImVector_unsigned_char ImGuiContext_GetDragDropPayloadBufHeap(ImGuiContext *self);
-extern void ImGuiContext_SetClipperTempDataStacked(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetClipperTempDataStacked(ImGuiContext *self);
-extern void ImGuiContext_SetClipperTempData(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiListClipperData v);
-extern ImVector_ImGuiListClipperData ImGuiContext_GetClipperTempData(ImGuiContext *self);
-extern void ImGuiContext_SetCurrentTable(ImGuiContext *ImGuiContextPtr, ImGuiTable* v);
-extern ImGuiTable* ImGuiContext_GetCurrentTable(ImGuiContext *self);
-extern void ImGuiContext_SetTablesTempDataStacked(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetTablesTempDataStacked(ImGuiContext *self);
-extern void ImGuiContext_SetTablesTempData(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiTableTempData v);
-extern ImVector_ImGuiTableTempData ImGuiContext_GetTablesTempData(ImGuiContext *self);
-extern void ImGuiContext_SetTables(ImGuiContext *ImGuiContextPtr, ImPool_ImGuiTable v);
-extern ImPool_ImGuiTable ImGuiContext_GetTables(ImGuiContext *self);
-extern void ImGuiContext_SetTablesLastTimeActive(ImGuiContext *ImGuiContextPtr, ImVector_float v);
-extern ImVector_float ImGuiContext_GetTablesLastTimeActive(ImGuiContext *self);
-extern void ImGuiContext_SetDrawChannelsTempMergeBuffer(ImGuiContext *ImGuiContextPtr, ImVector_ImDrawChannel v);
-extern ImVector_ImDrawChannel ImGuiContext_GetDrawChannelsTempMergeBuffer(ImGuiContext *self);
-extern void ImGuiContext_SetCurrentTabBar(ImGuiContext *ImGuiContextPtr, ImGuiTabBar* v);
-extern ImGuiTabBar* ImGuiContext_GetCurrentTabBar(ImGuiContext *self);
-extern void ImGuiContext_SetTabBars(ImGuiContext *ImGuiContextPtr, ImPool_ImGuiTabBar v);
-extern ImPool_ImGuiTabBar ImGuiContext_GetTabBars(ImGuiContext *self);
-extern void ImGuiContext_SetCurrentTabBarStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiPtrOrIndex v);
-extern ImVector_ImGuiPtrOrIndex ImGuiContext_GetCurrentTabBarStack(ImGuiContext *self);
-extern void ImGuiContext_SetShrinkWidthBuffer(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiShrinkWidthItem v);
-extern ImVector_ImGuiShrinkWidthItem ImGuiContext_GetShrinkWidthBuffer(ImGuiContext *self);
-extern void ImGuiContext_SetMouseLastValidPos(ImGuiContext *ImGuiContextPtr, ImVec2 v);
-extern ImVec2 ImGuiContext_GetMouseLastValidPos(ImGuiContext *self);
-extern void ImGuiContext_SetInputTextState(ImGuiContext *ImGuiContextPtr, ImGuiInputTextState v);
-extern ImGuiInputTextState ImGuiContext_GetInputTextState(ImGuiContext *self);
-extern void ImGuiContext_SetInputTextPasswordFont(ImGuiContext *ImGuiContextPtr, ImFont v);
-extern ImFont ImGuiContext_GetInputTextPasswordFont(ImGuiContext *self);
-extern void ImGuiContext_SetTempInputId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetTempInputId(ImGuiContext *self);
-extern void ImGuiContext_SetColorEditOptions(ImGuiContext *ImGuiContextPtr, ImGuiColorEditFlags v);
-extern ImGuiColorEditFlags ImGuiContext_GetColorEditOptions(ImGuiContext *self);
-extern void ImGuiContext_SetColorEditLastHue(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetColorEditLastHue(ImGuiContext *self);
-extern void ImGuiContext_SetColorEditLastSat(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetColorEditLastSat(ImGuiContext *self);
-extern void ImGuiContext_SetColorEditLastColor(ImGuiContext *ImGuiContextPtr, ImU32 v);
-extern ImU32 ImGuiContext_GetColorEditLastColor(ImGuiContext *self);
-extern void ImGuiContext_SetColorPickerRef(ImGuiContext *ImGuiContextPtr, ImVec4 v);
-extern ImVec4 ImGuiContext_GetColorPickerRef(ImGuiContext *self);
-extern void ImGuiContext_SetComboPreviewData(ImGuiContext *ImGuiContextPtr, ImGuiComboPreviewData v);
-extern ImGuiComboPreviewData ImGuiContext_GetComboPreviewData(ImGuiContext *self);
-extern void ImGuiContext_SetSliderGrabClickOffset(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetSliderGrabClickOffset(ImGuiContext *self);
-extern void ImGuiContext_SetSliderCurrentAccum(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetSliderCurrentAccum(ImGuiContext *self);
-extern void ImGuiContext_SetSliderCurrentAccumDirty(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetSliderCurrentAccumDirty(ImGuiContext *self);
-extern void ImGuiContext_SetDragCurrentAccumDirty(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetDragCurrentAccumDirty(ImGuiContext *self);
-extern void ImGuiContext_SetDragCurrentAccum(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetDragCurrentAccum(ImGuiContext *self);
-extern void ImGuiContext_SetDragSpeedDefaultRatio(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetDragSpeedDefaultRatio(ImGuiContext *self);
-extern void ImGuiContext_SetScrollbarClickDeltaToGrabCenter(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetScrollbarClickDeltaToGrabCenter(ImGuiContext *self);
-extern void ImGuiContext_SetDisabledAlphaBackup(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetDisabledAlphaBackup(ImGuiContext *self);
-extern void ImGuiContext_SetDisabledStackSize(ImGuiContext *ImGuiContextPtr, short v);
-extern short ImGuiContext_GetDisabledStackSize(ImGuiContext *self);
-extern void ImGuiContext_SetTooltipOverrideCount(ImGuiContext *ImGuiContextPtr, short v);
-extern short ImGuiContext_GetTooltipOverrideCount(ImGuiContext *self);
-extern void ImGuiContext_SetTooltipSlowDelay(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetTooltipSlowDelay(ImGuiContext *self);
-extern void ImGuiContext_SetClipboardHandlerData(ImGuiContext *ImGuiContextPtr, ImVector_char v);
-extern ImVector_char ImGuiContext_GetClipboardHandlerData(ImGuiContext *self);
-extern void ImGuiContext_SetMenusIdSubmittedThisFrame(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiID v);
-extern ImVector_ImGuiID ImGuiContext_GetMenusIdSubmittedThisFrame(ImGuiContext *self);
-extern void ImGuiContext_SetPlatformImeData(ImGuiContext *ImGuiContextPtr, ImGuiPlatformImeData v);
-extern ImGuiPlatformImeData ImGuiContext_GetPlatformImeData(ImGuiContext *self);
-extern void ImGuiContext_SetPlatformImeDataPrev(ImGuiContext *ImGuiContextPtr, ImGuiPlatformImeData v);
-extern ImGuiPlatformImeData ImGuiContext_GetPlatformImeDataPrev(ImGuiContext *self);
-extern void ImGuiContext_SetPlatformImeViewport(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetPlatformImeViewport(ImGuiContext *self);
-extern void ImGuiContext_SetPlatformLocaleDecimalPoint(ImGuiContext *ImGuiContextPtr, char v);
-extern char ImGuiContext_GetPlatformLocaleDecimalPoint(ImGuiContext *self);
-extern void ImGuiContext_SetDockContext(ImGuiContext *ImGuiContextPtr, ImGuiDockContext v);
-extern ImGuiDockContext ImGuiContext_GetDockContext(ImGuiContext *self);
-extern void ImGuiContext_SetSettingsLoaded(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetSettingsLoaded(ImGuiContext *self);
-extern void ImGuiContext_SetSettingsDirtyTimer(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetSettingsDirtyTimer(ImGuiContext *self);
-extern void ImGuiContext_SetSettingsIniData(ImGuiContext *ImGuiContextPtr, ImGuiTextBuffer v);
-extern ImGuiTextBuffer ImGuiContext_GetSettingsIniData(ImGuiContext *self);
-extern void ImGuiContext_SetSettingsHandlers(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiSettingsHandler v);
-extern ImVector_ImGuiSettingsHandler ImGuiContext_GetSettingsHandlers(ImGuiContext *self);
-extern void ImGuiContext_SetSettingsWindows(ImGuiContext *ImGuiContextPtr, ImChunkStream_ImGuiWindowSettings v);
-extern ImChunkStream_ImGuiWindowSettings ImGuiContext_GetSettingsWindows(ImGuiContext *self);
-extern void ImGuiContext_SetSettingsTables(ImGuiContext *ImGuiContextPtr, ImChunkStream_ImGuiTableSettings v);
-extern ImChunkStream_ImGuiTableSettings ImGuiContext_GetSettingsTables(ImGuiContext *self);
-extern void ImGuiContext_SetHooks(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiContextHook v);
-extern ImVector_ImGuiContextHook ImGuiContext_GetHooks(ImGuiContext *self);
-extern void ImGuiContext_SetHookIdNext(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetHookIdNext(ImGuiContext *self);
-extern void ImGuiContext_SetLogEnabled(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetLogEnabled(ImGuiContext *self);
-extern void ImGuiContext_SetLogType(ImGuiContext *ImGuiContextPtr, ImGuiLogType v);
-extern ImGuiLogType ImGuiContext_GetLogType(ImGuiContext *self);
-extern void ImGuiContext_SetLogFile(ImGuiContext *ImGuiContextPtr, ImFileHandle v);
-extern ImFileHandle ImGuiContext_GetLogFile(ImGuiContext *self);
-extern void ImGuiContext_SetLogBuffer(ImGuiContext *ImGuiContextPtr, ImGuiTextBuffer v);
-extern ImGuiTextBuffer ImGuiContext_GetLogBuffer(ImGuiContext *self);
-extern void ImGuiContext_SetLogNextPrefix(ImGuiContext *ImGuiContextPtr, const char* v);
-extern const char* ImGuiContext_GetLogNextPrefix(ImGuiContext *self);
-extern void ImGuiContext_SetLogNextSuffix(ImGuiContext *ImGuiContextPtr, const char* v);
-extern const char* ImGuiContext_GetLogNextSuffix(ImGuiContext *self);
-extern void ImGuiContext_SetLogLinePosY(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetLogLinePosY(ImGuiContext *self);
-extern void ImGuiContext_SetLogLineFirstItem(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetLogLineFirstItem(ImGuiContext *self);
-extern void ImGuiContext_SetLogDepthRef(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetLogDepthRef(ImGuiContext *self);
-extern void ImGuiContext_SetLogDepthToExpand(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetLogDepthToExpand(ImGuiContext *self);
-extern void ImGuiContext_SetLogDepthToExpandDefault(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetLogDepthToExpandDefault(ImGuiContext *self);
-extern void ImGuiContext_SetDebugLogFlags(ImGuiContext *ImGuiContextPtr, ImGuiDebugLogFlags v);
-extern ImGuiDebugLogFlags ImGuiContext_GetDebugLogFlags(ImGuiContext *self);
-extern void ImGuiContext_SetDebugLogBuf(ImGuiContext *ImGuiContextPtr, ImGuiTextBuffer v);
-extern ImGuiTextBuffer ImGuiContext_GetDebugLogBuf(ImGuiContext *self);
-extern void ImGuiContext_SetDebugItemPickerActive(ImGuiContext *ImGuiContextPtr, bool v);
-extern bool ImGuiContext_GetDebugItemPickerActive(ImGuiContext *self);
-extern void ImGuiContext_SetDebugItemPickerMouseButton(ImGuiContext *ImGuiContextPtr, ImU8 v);
-extern ImU8 ImGuiContext_GetDebugItemPickerMouseButton(ImGuiContext *self);
-extern void ImGuiContext_SetDebugItemPickerBreakId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
-extern ImGuiID ImGuiContext_GetDebugItemPickerBreakId(ImGuiContext *self);
-extern void ImGuiContext_SetDebugMetricsConfig(ImGuiContext *ImGuiContextPtr, ImGuiMetricsConfig v);
-extern ImGuiMetricsConfig ImGuiContext_GetDebugMetricsConfig(ImGuiContext *self);
-extern void ImGuiContext_SetDebugStackTool(ImGuiContext *ImGuiContextPtr, ImGuiStackTool v);
-extern ImGuiStackTool ImGuiContext_GetDebugStackTool(ImGuiContext *self);
-extern void ImGuiContext_SetFramerateSecPerFrameIdx(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetFramerateSecPerFrameIdx(ImGuiContext *self);
-extern void ImGuiContext_SetFramerateSecPerFrameCount(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetFramerateSecPerFrameCount(ImGuiContext *self);
-extern void ImGuiContext_SetFramerateSecPerFrameAccum(ImGuiContext *ImGuiContextPtr, float v);
-extern float ImGuiContext_GetFramerateSecPerFrameAccum(ImGuiContext *self);
-extern void ImGuiContext_SetWantCaptureMouseNextFrame(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetWantCaptureMouseNextFrame(ImGuiContext *self);
-extern void ImGuiContext_SetWantCaptureKeyboardNextFrame(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetWantCaptureKeyboardNextFrame(ImGuiContext *self);
-extern void ImGuiContext_SetWantTextInputNextFrame(ImGuiContext *ImGuiContextPtr, int v);
-extern int ImGuiContext_GetWantTextInputNextFrame(ImGuiContext *self);
-extern void ImGuiContext_SetTempBuffer(ImGuiContext *ImGuiContextPtr, ImVector_char v);
-extern ImVector_char ImGuiContext_GetTempBuffer(ImGuiContext *self);
-extern void ImGuiInputTextCallbackData_SetEventFlag(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, ImGuiInputTextFlags v);
-extern ImGuiInputTextFlags ImGuiInputTextCallbackData_GetEventFlag(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetFlags(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, ImGuiInputTextFlags v);
-extern ImGuiInputTextFlags ImGuiInputTextCallbackData_GetFlags(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetUserData(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, void* v);
-extern void* ImGuiInputTextCallbackData_GetUserData(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetEventChar(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, ImWchar v);
-extern ImWchar ImGuiInputTextCallbackData_GetEventChar(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetEventKey(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, ImGuiKey v);
-extern ImGuiKey ImGuiInputTextCallbackData_GetEventKey(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetBuf(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, char* v);
-extern char* ImGuiInputTextCallbackData_GetBuf(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetBufTextLen(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, int v);
-extern int ImGuiInputTextCallbackData_GetBufTextLen(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetBufSize(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, int v);
-extern int ImGuiInputTextCallbackData_GetBufSize(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetBufDirty(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, bool v);
-extern bool ImGuiInputTextCallbackData_GetBufDirty(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetCursorPos(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, int v);
-extern int ImGuiInputTextCallbackData_GetCursorPos(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetSelectionStart(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, int v);
-extern int ImGuiInputTextCallbackData_GetSelectionStart(ImGuiInputTextCallbackData *self);
-extern void ImGuiInputTextCallbackData_SetSelectionEnd(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, int v);
-extern int ImGuiInputTextCallbackData_GetSelectionEnd(ImGuiInputTextCallbackData *self);
-extern void ImGuiKeyData_SetDown(ImGuiKeyData *ImGuiKeyDataPtr, bool v);
-extern bool ImGuiKeyData_GetDown(ImGuiKeyData *self);
-extern void ImGuiKeyData_SetDownDuration(ImGuiKeyData *ImGuiKeyDataPtr, float v);
-extern float ImGuiKeyData_GetDownDuration(ImGuiKeyData *self);
-extern void ImGuiKeyData_SetDownDurationPrev(ImGuiKeyData *ImGuiKeyDataPtr, float v);
-extern float ImGuiKeyData_GetDownDurationPrev(ImGuiKeyData *self);
-extern void ImGuiKeyData_SetAnalogValue(ImGuiKeyData *ImGuiKeyDataPtr, float v);
-extern float ImGuiKeyData_GetAnalogValue(ImGuiKeyData *self);
-extern void ImGuiNextWindowData_SetFlags(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiNextWindowDataFlags v);
-extern ImGuiNextWindowDataFlags ImGuiNextWindowData_GetFlags(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetPosCond(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiCond v);
-extern ImGuiCond ImGuiNextWindowData_GetPosCond(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetSizeCond(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiCond v);
-extern ImGuiCond ImGuiNextWindowData_GetSizeCond(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetCollapsedCond(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiCond v);
-extern ImGuiCond ImGuiNextWindowData_GetCollapsedCond(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetDockCond(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiCond v);
-extern ImGuiCond ImGuiNextWindowData_GetDockCond(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetPosVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
-extern ImVec2 ImGuiNextWindowData_GetPosVal(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetPosPivotVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
-extern ImVec2 ImGuiNextWindowData_GetPosPivotVal(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetSizeVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
-extern ImVec2 ImGuiNextWindowData_GetSizeVal(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetContentSizeVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
-extern ImVec2 ImGuiNextWindowData_GetContentSizeVal(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetScrollVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
-extern ImVec2 ImGuiNextWindowData_GetScrollVal(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetPosUndock(ImGuiNextWindowData *ImGuiNextWindowDataPtr, bool v);
-extern bool ImGuiNextWindowData_GetPosUndock(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetCollapsedVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, bool v);
-extern bool ImGuiNextWindowData_GetCollapsedVal(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetSizeConstraintRect(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImRect v);
-extern ImRect ImGuiNextWindowData_GetSizeConstraintRect(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetSizeCallback(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiSizeCallback v);
-extern ImGuiSizeCallback ImGuiNextWindowData_GetSizeCallback(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetSizeCallbackUserData(ImGuiNextWindowData *ImGuiNextWindowDataPtr, void* v);
-extern void* ImGuiNextWindowData_GetSizeCallbackUserData(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetBgAlphaVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, float v);
-extern float ImGuiNextWindowData_GetBgAlphaVal(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetViewportId(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiID v);
-extern ImGuiID ImGuiNextWindowData_GetViewportId(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetDockId(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiID v);
-extern ImGuiID ImGuiNextWindowData_GetDockId(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetWindowClass(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiWindowClass v);
-extern ImGuiWindowClass ImGuiNextWindowData_GetWindowClass(ImGuiNextWindowData *self);
-extern void ImGuiNextWindowData_SetMenuBarOffsetMinVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
-extern ImVec2 ImGuiNextWindowData_GetMenuBarOffsetMinVal(ImGuiNextWindowData *self);
-extern void ImGuiStackTool_SetLastActiveFrame(ImGuiStackTool *ImGuiStackToolPtr, int v);
-extern int ImGuiStackTool_GetLastActiveFrame(ImGuiStackTool *self);
-extern void ImGuiStackTool_SetStackLevel(ImGuiStackTool *ImGuiStackToolPtr, int v);
-extern int ImGuiStackTool_GetStackLevel(ImGuiStackTool *self);
-extern void ImGuiStackTool_SetQueryId(ImGuiStackTool *ImGuiStackToolPtr, ImGuiID v);
-extern ImGuiID ImGuiStackTool_GetQueryId(ImGuiStackTool *self);
-extern void ImGuiStackTool_SetResults(ImGuiStackTool *ImGuiStackToolPtr, ImVector_ImGuiStackLevelInfo v);
-extern ImVector_ImGuiStackLevelInfo ImGuiStackTool_GetResults(ImGuiStackTool *self);
-extern void ImGuiStackTool_SetCopyToClipboardOnCtrlC(ImGuiStackTool *ImGuiStackToolPtr, bool v);
-extern bool ImGuiStackTool_GetCopyToClipboardOnCtrlC(ImGuiStackTool *self);
-extern void ImGuiStackTool_SetCopyToClipboardLastTime(ImGuiStackTool *ImGuiStackToolPtr, float v);
-extern float ImGuiStackTool_GetCopyToClipboardLastTime(ImGuiStackTool *self);
-extern void ImGuiTable_SetID(ImGuiTable *ImGuiTablePtr, ImGuiID v);
-extern ImGuiID ImGuiTable_GetID(ImGuiTable *self);
-extern void ImGuiTable_SetFlags(ImGuiTable *ImGuiTablePtr, ImGuiTableFlags v);
-extern ImGuiTableFlags ImGuiTable_GetFlags(ImGuiTable *self);
-extern void ImGuiTable_SetRawData(ImGuiTable *ImGuiTablePtr, void* v);
-extern void* ImGuiTable_GetRawData(ImGuiTable *self);
-extern void ImGuiTable_SetTempData(ImGuiTable *ImGuiTablePtr, ImGuiTableTempData* v);
-extern ImGuiTableTempData* ImGuiTable_GetTempData(ImGuiTable *self);
-extern void ImGuiTable_SetColumns(ImGuiTable *ImGuiTablePtr, ImSpan_ImGuiTableColumn v);
-extern ImSpan_ImGuiTableColumn ImGuiTable_GetColumns(ImGuiTable *self);
-extern void ImGuiTable_SetDisplayOrderToIndex(ImGuiTable *ImGuiTablePtr, ImSpan_ImGuiTableColumnIdx v);
-extern ImSpan_ImGuiTableColumnIdx ImGuiTable_GetDisplayOrderToIndex(ImGuiTable *self);
-extern void ImGuiTable_SetRowCellData(ImGuiTable *ImGuiTablePtr, ImSpan_ImGuiTableCellData v);
-extern ImSpan_ImGuiTableCellData ImGuiTable_GetRowCellData(ImGuiTable *self);
-extern void ImGuiTable_SetEnabledMaskByDisplayOrder(ImGuiTable *ImGuiTablePtr, ImU64 v);
-extern ImU64 ImGuiTable_GetEnabledMaskByDisplayOrder(ImGuiTable *self);
-extern void ImGuiTable_SetEnabledMaskByIndex(ImGuiTable *ImGuiTablePtr, ImU64 v);
-extern ImU64 ImGuiTable_GetEnabledMaskByIndex(ImGuiTable *self);
-extern void ImGuiTable_SetVisibleMaskByIndex(ImGuiTable *ImGuiTablePtr, ImU64 v);
-extern ImU64 ImGuiTable_GetVisibleMaskByIndex(ImGuiTable *self);
-extern void ImGuiTable_SetRequestOutputMaskByIndex(ImGuiTable *ImGuiTablePtr, ImU64 v);
-extern ImU64 ImGuiTable_GetRequestOutputMaskByIndex(ImGuiTable *self);
-extern void ImGuiTable_SetSettingsLoadedFlags(ImGuiTable *ImGuiTablePtr, ImGuiTableFlags v);
-extern ImGuiTableFlags ImGuiTable_GetSettingsLoadedFlags(ImGuiTable *self);
-extern void ImGuiTable_SetSettingsOffset(ImGuiTable *ImGuiTablePtr, int v);
-extern int ImGuiTable_GetSettingsOffset(ImGuiTable *self);
-extern void ImGuiTable_SetLastFrameActive(ImGuiTable *ImGuiTablePtr, int v);
-extern int ImGuiTable_GetLastFrameActive(ImGuiTable *self);
-extern void ImGuiTable_SetColumnsCount(ImGuiTable *ImGuiTablePtr, int v);
-extern int ImGuiTable_GetColumnsCount(ImGuiTable *self);
-extern void ImGuiTable_SetCurrentRow(ImGuiTable *ImGuiTablePtr, int v);
-extern int ImGuiTable_GetCurrentRow(ImGuiTable *self);
-extern void ImGuiTable_SetCurrentColumn(ImGuiTable *ImGuiTablePtr, int v);
-extern int ImGuiTable_GetCurrentColumn(ImGuiTable *self);
-extern void ImGuiTable_SetInstanceCurrent(ImGuiTable *ImGuiTablePtr, ImS16 v);
-extern ImS16 ImGuiTable_GetInstanceCurrent(ImGuiTable *self);
-extern void ImGuiTable_SetInstanceInteracted(ImGuiTable *ImGuiTablePtr, ImS16 v);
-extern ImS16 ImGuiTable_GetInstanceInteracted(ImGuiTable *self);
-extern void ImGuiTable_SetRowPosY1(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetRowPosY1(ImGuiTable *self);
-extern void ImGuiTable_SetRowPosY2(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetRowPosY2(ImGuiTable *self);
-extern void ImGuiTable_SetRowMinHeight(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetRowMinHeight(ImGuiTable *self);
-extern void ImGuiTable_SetRowTextBaseline(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetRowTextBaseline(ImGuiTable *self);
-extern void ImGuiTable_SetRowIndentOffsetX(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetRowIndentOffsetX(ImGuiTable *self);
-extern void ImGuiTable_SetRowFlags(ImGuiTable *ImGuiTablePtr, ImGuiTableRowFlags v);
-extern ImGuiTableRowFlags ImGuiTable_GetRowFlags(ImGuiTable *self);
-extern void ImGuiTable_SetLastRowFlags(ImGuiTable *ImGuiTablePtr, ImGuiTableRowFlags v);
-extern ImGuiTableRowFlags ImGuiTable_GetLastRowFlags(ImGuiTable *self);
-extern void ImGuiTable_SetRowBgColorCounter(ImGuiTable *ImGuiTablePtr, int v);
-extern int ImGuiTable_GetRowBgColorCounter(ImGuiTable *self);
-extern void ImGuiTable_SetBorderColorStrong(ImGuiTable *ImGuiTablePtr, ImU32 v);
-extern ImU32 ImGuiTable_GetBorderColorStrong(ImGuiTable *self);
-extern void ImGuiTable_SetBorderColorLight(ImGuiTable *ImGuiTablePtr, ImU32 v);
-extern ImU32 ImGuiTable_GetBorderColorLight(ImGuiTable *self);
-extern void ImGuiTable_SetBorderX1(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetBorderX1(ImGuiTable *self);
-extern void ImGuiTable_SetBorderX2(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetBorderX2(ImGuiTable *self);
-extern void ImGuiTable_SetHostIndentX(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetHostIndentX(ImGuiTable *self);
-extern void ImGuiTable_SetMinColumnWidth(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetMinColumnWidth(ImGuiTable *self);
-extern void ImGuiTable_SetOuterPaddingX(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetOuterPaddingX(ImGuiTable *self);
-extern void ImGuiTable_SetCellPaddingX(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetCellPaddingX(ImGuiTable *self);
-extern void ImGuiTable_SetCellPaddingY(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetCellPaddingY(ImGuiTable *self);
-extern void ImGuiTable_SetCellSpacingX1(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetCellSpacingX1(ImGuiTable *self);
-extern void ImGuiTable_SetCellSpacingX2(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetCellSpacingX2(ImGuiTable *self);
-extern void ImGuiTable_SetInnerWidth(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetInnerWidth(ImGuiTable *self);
-extern void ImGuiTable_SetColumnsGivenWidth(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetColumnsGivenWidth(ImGuiTable *self);
-extern void ImGuiTable_SetColumnsAutoFitWidth(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetColumnsAutoFitWidth(ImGuiTable *self);
-extern void ImGuiTable_SetColumnsStretchSumWeights(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetColumnsStretchSumWeights(ImGuiTable *self);
-extern void ImGuiTable_SetResizedColumnNextWidth(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetResizedColumnNextWidth(ImGuiTable *self);
-extern void ImGuiTable_SetResizeLockMinContentsX2(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetResizeLockMinContentsX2(ImGuiTable *self);
-extern void ImGuiTable_SetRefScale(ImGuiTable *ImGuiTablePtr, float v);
-extern float ImGuiTable_GetRefScale(ImGuiTable *self);
-extern void ImGuiTable_SetOuterRect(ImGuiTable *ImGuiTablePtr, ImRect v);
-extern ImRect ImGuiTable_GetOuterRect(ImGuiTable *self);
-extern void ImGuiTable_SetInnerRect(ImGuiTable *ImGuiTablePtr, ImRect v);
-extern ImRect ImGuiTable_GetInnerRect(ImGuiTable *self);
-extern void ImGuiTable_SetWorkRect(ImGuiTable *ImGuiTablePtr, ImRect v);
-extern ImRect ImGuiTable_GetWorkRect(ImGuiTable *self);
-extern void ImGuiTable_SetInnerClipRect(ImGuiTable *ImGuiTablePtr, ImRect v);
-extern ImRect ImGuiTable_GetInnerClipRect(ImGuiTable *self);
-extern void ImGuiTable_SetBgClipRect(ImGuiTable *ImGuiTablePtr, ImRect v);
-extern ImRect ImGuiTable_GetBgClipRect(ImGuiTable *self);
-extern void ImGuiTable_SetBg0ClipRectForDrawCmd(ImGuiTable *ImGuiTablePtr, ImRect v);
-extern ImRect ImGuiTable_GetBg0ClipRectForDrawCmd(ImGuiTable *self);
-extern void ImGuiTable_SetBg2ClipRectForDrawCmd(ImGuiTable *ImGuiTablePtr, ImRect v);
-extern ImRect ImGuiTable_GetBg2ClipRectForDrawCmd(ImGuiTable *self);
-extern void ImGuiTable_SetHostClipRect(ImGuiTable *ImGuiTablePtr, ImRect v);
-extern ImRect ImGuiTable_GetHostClipRect(ImGuiTable *self);
-extern void ImGuiTable_SetHostBackupInnerClipRect(ImGuiTable *ImGuiTablePtr, ImRect v);
-extern ImRect ImGuiTable_GetHostBackupInnerClipRect(ImGuiTable *self);
-extern void ImGuiTable_SetOuterWindow(ImGuiTable *ImGuiTablePtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiTable_GetOuterWindow(ImGuiTable *self);
-extern void ImGuiTable_SetInnerWindow(ImGuiTable *ImGuiTablePtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiTable_GetInnerWindow(ImGuiTable *self);
-extern void ImGuiTable_SetColumnsNames(ImGuiTable *ImGuiTablePtr, ImGuiTextBuffer v);
-extern ImGuiTextBuffer ImGuiTable_GetColumnsNames(ImGuiTable *self);
-extern void ImGuiTable_SetDrawSplitter(ImGuiTable *ImGuiTablePtr, ImDrawListSplitter* v);
-extern ImDrawListSplitter* ImGuiTable_GetDrawSplitter(ImGuiTable *self);
-extern void ImGuiTable_SetInstanceDataFirst(ImGuiTable *ImGuiTablePtr, ImGuiTableInstanceData v);
-extern ImGuiTableInstanceData ImGuiTable_GetInstanceDataFirst(ImGuiTable *self);
-extern void ImGuiTable_SetInstanceDataExtra(ImGuiTable *ImGuiTablePtr, ImVector_ImGuiTableInstanceData v);
-extern ImVector_ImGuiTableInstanceData ImGuiTable_GetInstanceDataExtra(ImGuiTable *self);
-extern void ImGuiTable_SetSortSpecsSingle(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnSortSpecs v);
-extern ImGuiTableColumnSortSpecs ImGuiTable_GetSortSpecsSingle(ImGuiTable *self);
-extern void ImGuiTable_SetSortSpecsMulti(ImGuiTable *ImGuiTablePtr, ImVector_ImGuiTableColumnSortSpecs v);
-extern ImVector_ImGuiTableColumnSortSpecs ImGuiTable_GetSortSpecsMulti(ImGuiTable *self);
-extern void ImGuiTable_SetSortSpecs(ImGuiTable *ImGuiTablePtr, ImGuiTableSortSpecs v);
-extern ImGuiTableSortSpecs ImGuiTable_GetSortSpecs(ImGuiTable *self);
-extern void ImGuiTable_SetSortSpecsCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetSortSpecsCount(ImGuiTable *self);
-extern void ImGuiTable_SetColumnsEnabledCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetColumnsEnabledCount(ImGuiTable *self);
-extern void ImGuiTable_SetColumnsEnabledFixedCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetColumnsEnabledFixedCount(ImGuiTable *self);
-extern void ImGuiTable_SetDeclColumnsCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetDeclColumnsCount(ImGuiTable *self);
-extern void ImGuiTable_SetHoveredColumnBody(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetHoveredColumnBody(ImGuiTable *self);
-extern void ImGuiTable_SetHoveredColumnBorder(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetHoveredColumnBorder(ImGuiTable *self);
-extern void ImGuiTable_SetAutoFitSingleColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetAutoFitSingleColumn(ImGuiTable *self);
-extern void ImGuiTable_SetResizedColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetResizedColumn(ImGuiTable *self);
-extern void ImGuiTable_SetLastResizedColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetLastResizedColumn(ImGuiTable *self);
-extern void ImGuiTable_SetHeldHeaderColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetHeldHeaderColumn(ImGuiTable *self);
-extern void ImGuiTable_SetReorderColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetReorderColumn(ImGuiTable *self);
-extern void ImGuiTable_SetReorderColumnDir(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetReorderColumnDir(ImGuiTable *self);
-extern void ImGuiTable_SetLeftMostEnabledColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetLeftMostEnabledColumn(ImGuiTable *self);
-extern void ImGuiTable_SetRightMostEnabledColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetRightMostEnabledColumn(ImGuiTable *self);
-extern void ImGuiTable_SetLeftMostStretchedColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetLeftMostStretchedColumn(ImGuiTable *self);
-extern void ImGuiTable_SetRightMostStretchedColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetRightMostStretchedColumn(ImGuiTable *self);
-extern void ImGuiTable_SetContextPopupColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetContextPopupColumn(ImGuiTable *self);
-extern void ImGuiTable_SetFreezeRowsRequest(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetFreezeRowsRequest(ImGuiTable *self);
-extern void ImGuiTable_SetFreezeRowsCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetFreezeRowsCount(ImGuiTable *self);
-extern void ImGuiTable_SetFreezeColumnsRequest(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetFreezeColumnsRequest(ImGuiTable *self);
-extern void ImGuiTable_SetFreezeColumnsCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetFreezeColumnsCount(ImGuiTable *self);
-extern void ImGuiTable_SetRowCellDataCurrent(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTable_GetRowCellDataCurrent(ImGuiTable *self);
-extern void ImGuiTable_SetDummyDrawChannel(ImGuiTable *ImGuiTablePtr, ImGuiTableDrawChannelIdx v);
-extern ImGuiTableDrawChannelIdx ImGuiTable_GetDummyDrawChannel(ImGuiTable *self);
-extern void ImGuiTable_SetBg2DrawChannelCurrent(ImGuiTable *ImGuiTablePtr, ImGuiTableDrawChannelIdx v);
-extern ImGuiTableDrawChannelIdx ImGuiTable_GetBg2DrawChannelCurrent(ImGuiTable *self);
-extern void ImGuiTable_SetBg2DrawChannelUnfrozen(ImGuiTable *ImGuiTablePtr, ImGuiTableDrawChannelIdx v);
-extern ImGuiTableDrawChannelIdx ImGuiTable_GetBg2DrawChannelUnfrozen(ImGuiTable *self);
-extern void ImGuiTable_SetIsLayoutLocked(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsLayoutLocked(ImGuiTable *self);
-extern void ImGuiTable_SetIsInsideRow(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsInsideRow(ImGuiTable *self);
-extern void ImGuiTable_SetIsInitializing(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsInitializing(ImGuiTable *self);
-extern void ImGuiTable_SetIsSortSpecsDirty(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsSortSpecsDirty(ImGuiTable *self);
-extern void ImGuiTable_SetIsUsingHeaders(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsUsingHeaders(ImGuiTable *self);
-extern void ImGuiTable_SetIsContextPopupOpen(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsContextPopupOpen(ImGuiTable *self);
-extern void ImGuiTable_SetIsSettingsRequestLoad(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsSettingsRequestLoad(ImGuiTable *self);
-extern void ImGuiTable_SetIsSettingsDirty(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsSettingsDirty(ImGuiTable *self);
-extern void ImGuiTable_SetIsDefaultDisplayOrder(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsDefaultDisplayOrder(ImGuiTable *self);
-extern void ImGuiTable_SetIsResetAllRequest(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsResetAllRequest(ImGuiTable *self);
-extern void ImGuiTable_SetIsResetDisplayOrderRequest(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsResetDisplayOrderRequest(ImGuiTable *self);
-extern void ImGuiTable_SetIsUnfrozenRows(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsUnfrozenRows(ImGuiTable *self);
-extern void ImGuiTable_SetIsDefaultSizingPolicy(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetIsDefaultSizingPolicy(ImGuiTable *self);
-extern void ImGuiTable_SetMemoryCompacted(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetMemoryCompacted(ImGuiTable *self);
-extern void ImGuiTable_SetHostSkipItems(ImGuiTable *ImGuiTablePtr, bool v);
-extern bool ImGuiTable_GetHostSkipItems(ImGuiTable *self);
-extern void ImDrawCmd_SetClipRect(ImDrawCmd *ImDrawCmdPtr, ImVec4 v);
-extern ImVec4 ImDrawCmd_GetClipRect(ImDrawCmd *self);
-extern void ImDrawCmd_SetTextureId(ImDrawCmd *ImDrawCmdPtr, ImTextureID v);
-extern ImTextureID ImDrawCmd_GetTextureId(ImDrawCmd *self);
-extern void ImDrawCmd_SetVtxOffset(ImDrawCmd *ImDrawCmdPtr, unsigned int v);
-extern unsigned int ImDrawCmd_GetVtxOffset(ImDrawCmd *self);
-extern void ImDrawCmd_SetIdxOffset(ImDrawCmd *ImDrawCmdPtr, unsigned int v);
-extern unsigned int ImDrawCmd_GetIdxOffset(ImDrawCmd *self);
-extern void ImDrawCmd_SetElemCount(ImDrawCmd *ImDrawCmdPtr, unsigned int v);
-extern unsigned int ImDrawCmd_GetElemCount(ImDrawCmd *self);
-extern void ImDrawCmd_SetUserCallback(ImDrawCmd *ImDrawCmdPtr, ImDrawCallback v);
-extern ImDrawCallback ImDrawCmd_GetUserCallback(ImDrawCmd *self);
-extern void ImDrawCmd_SetUserCallbackData(ImDrawCmd *ImDrawCmdPtr, void* v);
-extern void* ImDrawCmd_GetUserCallbackData(ImDrawCmd *self);
-extern void ImGuiTableSettings_SetID(ImGuiTableSettings *ImGuiTableSettingsPtr, ImGuiID v);
-extern ImGuiID ImGuiTableSettings_GetID(ImGuiTableSettings *self);
-extern void ImGuiTableSettings_SetSaveFlags(ImGuiTableSettings *ImGuiTableSettingsPtr, ImGuiTableFlags v);
-extern ImGuiTableFlags ImGuiTableSettings_GetSaveFlags(ImGuiTableSettings *self);
-extern void ImGuiTableSettings_SetRefScale(ImGuiTableSettings *ImGuiTableSettingsPtr, float v);
-extern float ImGuiTableSettings_GetRefScale(ImGuiTableSettings *self);
-extern void ImGuiTableSettings_SetColumnsCount(ImGuiTableSettings *ImGuiTableSettingsPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableSettings_GetColumnsCount(ImGuiTableSettings *self);
-extern void ImGuiTableSettings_SetColumnsCountMax(ImGuiTableSettings *ImGuiTableSettingsPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableSettings_GetColumnsCountMax(ImGuiTableSettings *self);
-extern void ImGuiTableSettings_SetWantApply(ImGuiTableSettings *ImGuiTableSettingsPtr, bool v);
-extern bool ImGuiTableSettings_GetWantApply(ImGuiTableSettings *self);
-extern void ImGuiWindowSettings_SetID(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImGuiID v);
-extern ImGuiID ImGuiWindowSettings_GetID(ImGuiWindowSettings *self);
-extern void ImGuiWindowSettings_SetPos(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImVec2ih v);
-extern ImVec2ih ImGuiWindowSettings_GetPos(ImGuiWindowSettings *self);
-extern void ImGuiWindowSettings_SetSize(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImVec2ih v);
-extern ImVec2ih ImGuiWindowSettings_GetSize(ImGuiWindowSettings *self);
-extern void ImGuiWindowSettings_SetViewportPos(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImVec2ih v);
-extern ImVec2ih ImGuiWindowSettings_GetViewportPos(ImGuiWindowSettings *self);
-extern void ImGuiWindowSettings_SetViewportId(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImGuiID v);
-extern ImGuiID ImGuiWindowSettings_GetViewportId(ImGuiWindowSettings *self);
-extern void ImGuiWindowSettings_SetDockId(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImGuiID v);
-extern ImGuiID ImGuiWindowSettings_GetDockId(ImGuiWindowSettings *self);
-extern void ImGuiWindowSettings_SetClassId(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImGuiID v);
-extern ImGuiID ImGuiWindowSettings_GetClassId(ImGuiWindowSettings *self);
-extern void ImGuiWindowSettings_SetDockOrder(ImGuiWindowSettings *ImGuiWindowSettingsPtr, short v);
-extern short ImGuiWindowSettings_GetDockOrder(ImGuiWindowSettings *self);
-extern void ImGuiWindowSettings_SetCollapsed(ImGuiWindowSettings *ImGuiWindowSettingsPtr, bool v);
-extern bool ImGuiWindowSettings_GetCollapsed(ImGuiWindowSettings *self);
-extern void ImGuiWindowSettings_SetWantApply(ImGuiWindowSettings *ImGuiWindowSettingsPtr, bool v);
-extern bool ImGuiWindowSettings_GetWantApply(ImGuiWindowSettings *self);
-extern void ImGuiTableInstanceData_SetLastOuterHeight(ImGuiTableInstanceData *ImGuiTableInstanceDataPtr, float v);
-extern float ImGuiTableInstanceData_GetLastOuterHeight(ImGuiTableInstanceData *self);
-extern void ImGuiTableInstanceData_SetLastFirstRowHeight(ImGuiTableInstanceData *ImGuiTableInstanceDataPtr, float v);
-extern float ImGuiTableInstanceData_GetLastFirstRowHeight(ImGuiTableInstanceData *self);
-extern void ImGuiInputEventMousePos_SetPosX(ImGuiInputEventMousePos *ImGuiInputEventMousePosPtr, float v);
-extern float ImGuiInputEventMousePos_GetPosX(ImGuiInputEventMousePos *self);
-extern void ImGuiInputEventMousePos_SetPosY(ImGuiInputEventMousePos *ImGuiInputEventMousePosPtr, float v);
-extern float ImGuiInputEventMousePos_GetPosY(ImGuiInputEventMousePos *self);
-extern void ImGuiMenuColumns_SetTotalWidth(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU32 v);
-extern ImU32 ImGuiMenuColumns_GetTotalWidth(ImGuiMenuColumns *self);
-extern void ImGuiMenuColumns_SetNextTotalWidth(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU32 v);
-extern ImU32 ImGuiMenuColumns_GetNextTotalWidth(ImGuiMenuColumns *self);
-extern void ImGuiMenuColumns_SetSpacing(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU16 v);
-extern ImU16 ImGuiMenuColumns_GetSpacing(ImGuiMenuColumns *self);
-extern void ImGuiMenuColumns_SetOffsetIcon(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU16 v);
-extern ImU16 ImGuiMenuColumns_GetOffsetIcon(ImGuiMenuColumns *self);
-extern void ImGuiMenuColumns_SetOffsetLabel(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU16 v);
-extern ImU16 ImGuiMenuColumns_GetOffsetLabel(ImGuiMenuColumns *self);
-extern void ImGuiMenuColumns_SetOffsetShortcut(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU16 v);
-extern ImU16 ImGuiMenuColumns_GetOffsetShortcut(ImGuiMenuColumns *self);
-extern void ImGuiMenuColumns_SetOffsetMark(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU16 v);
-extern ImU16 ImGuiMenuColumns_GetOffsetMark(ImGuiMenuColumns *self);
-extern void ImGuiTableColumnSettings_SetWidthOrWeight(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, float v);
-extern float ImGuiTableColumnSettings_GetWidthOrWeight(ImGuiTableColumnSettings *self);
-extern void ImGuiTableColumnSettings_SetUserID(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImGuiID v);
-extern ImGuiID ImGuiTableColumnSettings_GetUserID(ImGuiTableColumnSettings *self);
-extern void ImGuiTableColumnSettings_SetIndex(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableColumnSettings_GetIndex(ImGuiTableColumnSettings *self);
-extern void ImGuiTableColumnSettings_SetDisplayOrder(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableColumnSettings_GetDisplayOrder(ImGuiTableColumnSettings *self);
-extern void ImGuiTableColumnSettings_SetSortOrder(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImGuiTableColumnIdx v);
-extern ImGuiTableColumnIdx ImGuiTableColumnSettings_GetSortOrder(ImGuiTableColumnSettings *self);
-extern void ImGuiTableColumnSettings_SetSortDirection(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImU8 v);
-extern ImU8 ImGuiTableColumnSettings_GetSortDirection(ImGuiTableColumnSettings *self);
-extern void ImGuiTableColumnSettings_SetIsEnabled(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImU8 v);
-extern ImU8 ImGuiTableColumnSettings_GetIsEnabled(ImGuiTableColumnSettings *self);
-extern void ImGuiTableColumnSettings_SetIsStretch(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImU8 v);
-extern ImU8 ImGuiTableColumnSettings_GetIsStretch(ImGuiTableColumnSettings *self);
-extern void ImDrawListSharedData_SetTexUvWhitePixel(ImDrawListSharedData *ImDrawListSharedDataPtr, ImVec2 v);
-extern ImVec2 ImDrawListSharedData_GetTexUvWhitePixel(ImDrawListSharedData *self);
-extern void ImDrawListSharedData_SetFont(ImDrawListSharedData *ImDrawListSharedDataPtr, ImFont* v);
-extern ImFont* ImDrawListSharedData_GetFont(ImDrawListSharedData *self);
-extern void ImDrawListSharedData_SetFontSize(ImDrawListSharedData *ImDrawListSharedDataPtr, float v);
-extern float ImDrawListSharedData_GetFontSize(ImDrawListSharedData *self);
-extern void ImDrawListSharedData_SetCurveTessellationTol(ImDrawListSharedData *ImDrawListSharedDataPtr, float v);
-extern float ImDrawListSharedData_GetCurveTessellationTol(ImDrawListSharedData *self);
-extern void ImDrawListSharedData_SetCircleSegmentMaxError(ImDrawListSharedData *ImDrawListSharedDataPtr, float v);
-extern float ImDrawListSharedData_GetCircleSegmentMaxError(ImDrawListSharedData *self);
-extern void ImDrawListSharedData_SetClipRectFullscreen(ImDrawListSharedData *ImDrawListSharedDataPtr, ImVec4 v);
-extern ImVec4 ImDrawListSharedData_GetClipRectFullscreen(ImDrawListSharedData *self);
-extern void ImDrawListSharedData_SetInitialFlags(ImDrawListSharedData *ImDrawListSharedDataPtr, ImDrawListFlags v);
-extern ImDrawListFlags ImDrawListSharedData_GetInitialFlags(ImDrawListSharedData *self);
-extern void ImDrawListSharedData_SetArcFastRadiusCutoff(ImDrawListSharedData *ImDrawListSharedDataPtr, float v);
-extern float ImDrawListSharedData_GetArcFastRadiusCutoff(ImDrawListSharedData *self);
-extern void ImDrawListSharedData_SetTexUvLines(ImDrawListSharedData *ImDrawListSharedDataPtr, const ImVec4* v);
-extern const ImVec4* ImDrawListSharedData_GetTexUvLines(ImDrawListSharedData *self);
-extern void ImGuiStackSizes_SetSizeOfIDStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
-extern short ImGuiStackSizes_GetSizeOfIDStack(ImGuiStackSizes *self);
-extern void ImGuiStackSizes_SetSizeOfColorStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
-extern short ImGuiStackSizes_GetSizeOfColorStack(ImGuiStackSizes *self);
-extern void ImGuiStackSizes_SetSizeOfStyleVarStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
-extern short ImGuiStackSizes_GetSizeOfStyleVarStack(ImGuiStackSizes *self);
-extern void ImGuiStackSizes_SetSizeOfFontStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
-extern short ImGuiStackSizes_GetSizeOfFontStack(ImGuiStackSizes *self);
-extern void ImGuiStackSizes_SetSizeOfFocusScopeStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
-extern short ImGuiStackSizes_GetSizeOfFocusScopeStack(ImGuiStackSizes *self);
-extern void ImGuiStackSizes_SetSizeOfGroupStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
-extern short ImGuiStackSizes_GetSizeOfGroupStack(ImGuiStackSizes *self);
-extern void ImGuiStackSizes_SetSizeOfItemFlagsStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
-extern short ImGuiStackSizes_GetSizeOfItemFlagsStack(ImGuiStackSizes *self);
-extern void ImGuiStackSizes_SetSizeOfBeginPopupStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
-extern short ImGuiStackSizes_GetSizeOfBeginPopupStack(ImGuiStackSizes *self);
-extern void ImGuiStackSizes_SetSizeOfDisabledStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
-extern short ImGuiStackSizes_GetSizeOfDisabledStack(ImGuiStackSizes *self);
-extern void ImGuiStyleMod_SetVarIdx(ImGuiStyleMod *ImGuiStyleModPtr, ImGuiStyleVar v);
-extern ImGuiStyleVar ImGuiStyleMod_GetVarIdx(ImGuiStyleMod *self);
-extern void ImGuiTableColumnSortSpecs_SetColumnUserID(ImGuiTableColumnSortSpecs *ImGuiTableColumnSortSpecsPtr, ImGuiID v);
-extern ImGuiID ImGuiTableColumnSortSpecs_GetColumnUserID(ImGuiTableColumnSortSpecs *self);
-extern void ImGuiTableColumnSortSpecs_SetColumnIndex(ImGuiTableColumnSortSpecs *ImGuiTableColumnSortSpecsPtr, ImS16 v);
-extern ImS16 ImGuiTableColumnSortSpecs_GetColumnIndex(ImGuiTableColumnSortSpecs *self);
-extern void ImGuiTableColumnSortSpecs_SetSortOrder(ImGuiTableColumnSortSpecs *ImGuiTableColumnSortSpecsPtr, ImS16 v);
-extern ImS16 ImGuiTableColumnSortSpecs_GetSortOrder(ImGuiTableColumnSortSpecs *self);
-extern void ImGuiTableColumnSortSpecs_SetSortDirection(ImGuiTableColumnSortSpecs *ImGuiTableColumnSortSpecsPtr, ImGuiSortDirection v);
-extern ImGuiSortDirection ImGuiTableColumnSortSpecs_GetSortDirection(ImGuiTableColumnSortSpecs *self);
-extern void ImGuiTextFilter_SetFilters(ImGuiTextFilter *ImGuiTextFilterPtr, ImVector_ImGuiTextRange v);
-extern ImVector_ImGuiTextRange ImGuiTextFilter_GetFilters(ImGuiTextFilter *self);
-extern void ImGuiTextFilter_SetCountGrep(ImGuiTextFilter *ImGuiTextFilterPtr, int v);
-extern int ImGuiTextFilter_GetCountGrep(ImGuiTextFilter *self);
-extern void ImGuiWindow_SetName(ImGuiWindow *ImGuiWindowPtr, char* v);
-extern char* ImGuiWindow_GetName(ImGuiWindow *self);
-extern void ImGuiWindow_SetID(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
-extern ImGuiID ImGuiWindow_GetID(ImGuiWindow *self);
-extern void ImGuiWindow_SetFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiWindowFlags v);
-extern ImGuiWindowFlags ImGuiWindow_GetFlags(ImGuiWindow *self);
-extern void ImGuiWindow_SetFlagsPreviousFrame(ImGuiWindow *ImGuiWindowPtr, ImGuiWindowFlags v);
-extern ImGuiWindowFlags ImGuiWindow_GetFlagsPreviousFrame(ImGuiWindow *self);
-extern void ImGuiWindow_SetWindowClass(ImGuiWindow *ImGuiWindowPtr, ImGuiWindowClass v);
-extern ImGuiWindowClass ImGuiWindow_GetWindowClass(ImGuiWindow *self);
-extern void ImGuiWindow_SetViewport(ImGuiWindow *ImGuiWindowPtr, ImGuiViewportP* v);
-extern ImGuiViewportP* ImGuiWindow_GetViewport(ImGuiWindow *self);
-extern void ImGuiWindow_SetViewportId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
-extern ImGuiID ImGuiWindow_GetViewportId(ImGuiWindow *self);
-extern void ImGuiWindow_SetViewportPos(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetViewportPos(ImGuiWindow *self);
-extern void ImGuiWindow_SetViewportAllowPlatformMonitorExtend(ImGuiWindow *ImGuiWindowPtr, int v);
-extern int ImGuiWindow_GetViewportAllowPlatformMonitorExtend(ImGuiWindow *self);
-extern void ImGuiWindow_SetPos(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetPos(ImGuiWindow *self);
-extern void ImGuiWindow_SetSize(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetSize(ImGuiWindow *self);
-extern void ImGuiWindow_SetSizeFull(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetSizeFull(ImGuiWindow *self);
-extern void ImGuiWindow_SetContentSize(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetContentSize(ImGuiWindow *self);
-extern void ImGuiWindow_SetContentSizeIdeal(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetContentSizeIdeal(ImGuiWindow *self);
-extern void ImGuiWindow_SetContentSizeExplicit(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetContentSizeExplicit(ImGuiWindow *self);
-extern void ImGuiWindow_SetWindowPadding(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetWindowPadding(ImGuiWindow *self);
-extern void ImGuiWindow_SetWindowRounding(ImGuiWindow *ImGuiWindowPtr, float v);
-extern float ImGuiWindow_GetWindowRounding(ImGuiWindow *self);
-extern void ImGuiWindow_SetWindowBorderSize(ImGuiWindow *ImGuiWindowPtr, float v);
-extern float ImGuiWindow_GetWindowBorderSize(ImGuiWindow *self);
-extern void ImGuiWindow_SetNameBufLen(ImGuiWindow *ImGuiWindowPtr, int v);
-extern int ImGuiWindow_GetNameBufLen(ImGuiWindow *self);
-extern void ImGuiWindow_SetMoveId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
-extern ImGuiID ImGuiWindow_GetMoveId(ImGuiWindow *self);
-extern void ImGuiWindow_SetTabId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
-extern ImGuiID ImGuiWindow_GetTabId(ImGuiWindow *self);
-extern void ImGuiWindow_SetChildId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
-extern ImGuiID ImGuiWindow_GetChildId(ImGuiWindow *self);
-extern void ImGuiWindow_SetScroll(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetScroll(ImGuiWindow *self);
-extern void ImGuiWindow_SetScrollMax(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetScrollMax(ImGuiWindow *self);
-extern void ImGuiWindow_SetScrollTarget(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetScrollTarget(ImGuiWindow *self);
-extern void ImGuiWindow_SetScrollTargetCenterRatio(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetScrollTargetCenterRatio(ImGuiWindow *self);
-extern void ImGuiWindow_SetScrollTargetEdgeSnapDist(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetScrollTargetEdgeSnapDist(ImGuiWindow *self);
-extern void ImGuiWindow_SetScrollbarSizes(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetScrollbarSizes(ImGuiWindow *self);
-extern void ImGuiWindow_SetScrollbarX(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetScrollbarX(ImGuiWindow *self);
-extern void ImGuiWindow_SetScrollbarY(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetScrollbarY(ImGuiWindow *self);
-extern void ImGuiWindow_SetViewportOwned(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetViewportOwned(ImGuiWindow *self);
-extern void ImGuiWindow_SetActive(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetActive(ImGuiWindow *self);
-extern void ImGuiWindow_SetWasActive(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetWasActive(ImGuiWindow *self);
-extern void ImGuiWindow_SetWriteAccessed(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetWriteAccessed(ImGuiWindow *self);
-extern void ImGuiWindow_SetCollapsed(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetCollapsed(ImGuiWindow *self);
-extern void ImGuiWindow_SetWantCollapseToggle(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetWantCollapseToggle(ImGuiWindow *self);
-extern void ImGuiWindow_SetSkipItems(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetSkipItems(ImGuiWindow *self);
-extern void ImGuiWindow_SetAppearing(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetAppearing(ImGuiWindow *self);
-extern void ImGuiWindow_SetHidden(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetHidden(ImGuiWindow *self);
-extern void ImGuiWindow_SetIsFallbackWindow(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetIsFallbackWindow(ImGuiWindow *self);
-extern void ImGuiWindow_SetIsExplicitChild(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetIsExplicitChild(ImGuiWindow *self);
-extern void ImGuiWindow_SetHasCloseButton(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetHasCloseButton(ImGuiWindow *self);
-extern void ImGuiWindow_SetResizeBorderHeld(ImGuiWindow *ImGuiWindowPtr, signed char v);
-extern signed char ImGuiWindow_GetResizeBorderHeld(ImGuiWindow *self);
-extern void ImGuiWindow_SetBeginCount(ImGuiWindow *ImGuiWindowPtr, short v);
-extern short ImGuiWindow_GetBeginCount(ImGuiWindow *self);
-extern void ImGuiWindow_SetBeginOrderWithinParent(ImGuiWindow *ImGuiWindowPtr, short v);
-extern short ImGuiWindow_GetBeginOrderWithinParent(ImGuiWindow *self);
-extern void ImGuiWindow_SetBeginOrderWithinContext(ImGuiWindow *ImGuiWindowPtr, short v);
-extern short ImGuiWindow_GetBeginOrderWithinContext(ImGuiWindow *self);
-extern void ImGuiWindow_SetFocusOrder(ImGuiWindow *ImGuiWindowPtr, short v);
-extern short ImGuiWindow_GetFocusOrder(ImGuiWindow *self);
-extern void ImGuiWindow_SetPopupId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
-extern ImGuiID ImGuiWindow_GetPopupId(ImGuiWindow *self);
-extern void ImGuiWindow_SetAutoFitFramesX(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
-extern ImS8 ImGuiWindow_GetAutoFitFramesX(ImGuiWindow *self);
-extern void ImGuiWindow_SetAutoFitFramesY(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
-extern ImS8 ImGuiWindow_GetAutoFitFramesY(ImGuiWindow *self);
-extern void ImGuiWindow_SetAutoFitChildAxises(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
-extern ImS8 ImGuiWindow_GetAutoFitChildAxises(ImGuiWindow *self);
-extern void ImGuiWindow_SetAutoFitOnlyGrows(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetAutoFitOnlyGrows(ImGuiWindow *self);
-extern void ImGuiWindow_SetAutoPosLastDirection(ImGuiWindow *ImGuiWindowPtr, ImGuiDir v);
-extern ImGuiDir ImGuiWindow_GetAutoPosLastDirection(ImGuiWindow *self);
-extern void ImGuiWindow_SetHiddenFramesCanSkipItems(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
-extern ImS8 ImGuiWindow_GetHiddenFramesCanSkipItems(ImGuiWindow *self);
-extern void ImGuiWindow_SetHiddenFramesCannotSkipItems(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
-extern ImS8 ImGuiWindow_GetHiddenFramesCannotSkipItems(ImGuiWindow *self);
-extern void ImGuiWindow_SetHiddenFramesForRenderOnly(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
-extern ImS8 ImGuiWindow_GetHiddenFramesForRenderOnly(ImGuiWindow *self);
-extern void ImGuiWindow_SetDisableInputsFrames(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
-extern ImS8 ImGuiWindow_GetDisableInputsFrames(ImGuiWindow *self);
-extern void ImGuiWindow_SetSetWindowPosAllowFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiCond v);
-extern ImGuiCond ImGuiWindow_GetSetWindowPosAllowFlags(ImGuiWindow *self);
-extern void ImGuiWindow_SetSetWindowSizeAllowFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiCond v);
-extern ImGuiCond ImGuiWindow_GetSetWindowSizeAllowFlags(ImGuiWindow *self);
-extern void ImGuiWindow_SetSetWindowCollapsedAllowFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiCond v);
-extern ImGuiCond ImGuiWindow_GetSetWindowCollapsedAllowFlags(ImGuiWindow *self);
-extern void ImGuiWindow_SetSetWindowDockAllowFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiCond v);
-extern ImGuiCond ImGuiWindow_GetSetWindowDockAllowFlags(ImGuiWindow *self);
-extern void ImGuiWindow_SetSetWindowPosVal(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetSetWindowPosVal(ImGuiWindow *self);
-extern void ImGuiWindow_SetSetWindowPosPivot(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
-extern ImVec2 ImGuiWindow_GetSetWindowPosPivot(ImGuiWindow *self);
-extern void ImGuiWindow_SetIDStack(ImGuiWindow *ImGuiWindowPtr, ImVector_ImGuiID v);
-extern ImVector_ImGuiID ImGuiWindow_GetIDStack(ImGuiWindow *self);
-extern void ImGuiWindow_SetDC(ImGuiWindow *ImGuiWindowPtr, ImGuiWindowTempData v);
-extern ImGuiWindowTempData ImGuiWindow_GetDC(ImGuiWindow *self);
-extern void ImGuiWindow_SetOuterRectClipped(ImGuiWindow *ImGuiWindowPtr, ImRect v);
-extern ImRect ImGuiWindow_GetOuterRectClipped(ImGuiWindow *self);
-extern void ImGuiWindow_SetInnerRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
-extern ImRect ImGuiWindow_GetInnerRect(ImGuiWindow *self);
-extern void ImGuiWindow_SetInnerClipRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
-extern ImRect ImGuiWindow_GetInnerClipRect(ImGuiWindow *self);
-extern void ImGuiWindow_SetWorkRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
-extern ImRect ImGuiWindow_GetWorkRect(ImGuiWindow *self);
-extern void ImGuiWindow_SetParentWorkRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
-extern ImRect ImGuiWindow_GetParentWorkRect(ImGuiWindow *self);
-extern void ImGuiWindow_SetClipRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
-extern ImRect ImGuiWindow_GetClipRect(ImGuiWindow *self);
-extern void ImGuiWindow_SetContentRegionRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
-extern ImRect ImGuiWindow_GetContentRegionRect(ImGuiWindow *self);
-extern void ImGuiWindow_SetHitTestHoleSize(ImGuiWindow *ImGuiWindowPtr, ImVec2ih v);
-extern ImVec2ih ImGuiWindow_GetHitTestHoleSize(ImGuiWindow *self);
-extern void ImGuiWindow_SetHitTestHoleOffset(ImGuiWindow *ImGuiWindowPtr, ImVec2ih v);
-extern ImVec2ih ImGuiWindow_GetHitTestHoleOffset(ImGuiWindow *self);
-extern void ImGuiWindow_SetLastFrameActive(ImGuiWindow *ImGuiWindowPtr, int v);
-extern int ImGuiWindow_GetLastFrameActive(ImGuiWindow *self);
-extern void ImGuiWindow_SetLastFrameJustFocused(ImGuiWindow *ImGuiWindowPtr, int v);
-extern int ImGuiWindow_GetLastFrameJustFocused(ImGuiWindow *self);
-extern void ImGuiWindow_SetLastTimeActive(ImGuiWindow *ImGuiWindowPtr, float v);
-extern float ImGuiWindow_GetLastTimeActive(ImGuiWindow *self);
-extern void ImGuiWindow_SetItemWidthDefault(ImGuiWindow *ImGuiWindowPtr, float v);
-extern float ImGuiWindow_GetItemWidthDefault(ImGuiWindow *self);
-extern void ImGuiWindow_SetStateStorage(ImGuiWindow *ImGuiWindowPtr, ImGuiStorage v);
-extern ImGuiStorage ImGuiWindow_GetStateStorage(ImGuiWindow *self);
-extern void ImGuiWindow_SetColumnsStorage(ImGuiWindow *ImGuiWindowPtr, ImVector_ImGuiOldColumns v);
-extern ImVector_ImGuiOldColumns ImGuiWindow_GetColumnsStorage(ImGuiWindow *self);
-extern void ImGuiWindow_SetFontWindowScale(ImGuiWindow *ImGuiWindowPtr, float v);
-extern float ImGuiWindow_GetFontWindowScale(ImGuiWindow *self);
-extern void ImGuiWindow_SetFontDpiScale(ImGuiWindow *ImGuiWindowPtr, float v);
-extern float ImGuiWindow_GetFontDpiScale(ImGuiWindow *self);
-extern void ImGuiWindow_SetSettingsOffset(ImGuiWindow *ImGuiWindowPtr, int v);
-extern int ImGuiWindow_GetSettingsOffset(ImGuiWindow *self);
-extern void ImGuiWindow_SetDrawList(ImGuiWindow *ImGuiWindowPtr, ImDrawList* v);
-extern ImDrawList* ImGuiWindow_GetDrawList(ImGuiWindow *self);
-extern void ImGuiWindow_SetDrawListInst(ImGuiWindow *ImGuiWindowPtr, ImDrawList v);
-extern ImDrawList ImGuiWindow_GetDrawListInst(ImGuiWindow *self);
-extern void ImGuiWindow_SetParentWindow(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiWindow_GetParentWindow(ImGuiWindow *self);
-extern void ImGuiWindow_SetParentWindowInBeginStack(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiWindow_GetParentWindowInBeginStack(ImGuiWindow *self);
-extern void ImGuiWindow_SetRootWindow(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiWindow_GetRootWindow(ImGuiWindow *self);
-extern void ImGuiWindow_SetRootWindowPopupTree(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiWindow_GetRootWindowPopupTree(ImGuiWindow *self);
-extern void ImGuiWindow_SetRootWindowDockTree(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiWindow_GetRootWindowDockTree(ImGuiWindow *self);
-extern void ImGuiWindow_SetRootWindowForTitleBarHighlight(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiWindow_GetRootWindowForTitleBarHighlight(ImGuiWindow *self);
-extern void ImGuiWindow_SetRootWindowForNav(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiWindow_GetRootWindowForNav(ImGuiWindow *self);
-extern void ImGuiWindow_SetNavLastChildNavWindow(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiWindow_GetNavLastChildNavWindow(ImGuiWindow *self);
-extern void ImGuiWindow_SetMemoryDrawListIdxCapacity(ImGuiWindow *ImGuiWindowPtr, int v);
-extern int ImGuiWindow_GetMemoryDrawListIdxCapacity(ImGuiWindow *self);
-extern void ImGuiWindow_SetMemoryDrawListVtxCapacity(ImGuiWindow *ImGuiWindowPtr, int v);
-extern int ImGuiWindow_GetMemoryDrawListVtxCapacity(ImGuiWindow *self);
-extern void ImGuiWindow_SetMemoryCompacted(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetMemoryCompacted(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockIsActive(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetDockIsActive(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockNodeIsVisible(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetDockNodeIsVisible(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockTabIsVisible(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetDockTabIsVisible(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockTabWantClose(ImGuiWindow *ImGuiWindowPtr, bool v);
-extern bool ImGuiWindow_GetDockTabWantClose(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockOrder(ImGuiWindow *ImGuiWindowPtr, short v);
-extern short ImGuiWindow_GetDockOrder(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockStyle(ImGuiWindow *ImGuiWindowPtr, ImGuiWindowDockStyle v);
-extern ImGuiWindowDockStyle ImGuiWindow_GetDockStyle(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockNode(ImGuiWindow *ImGuiWindowPtr, ImGuiDockNode* v);
-extern ImGuiDockNode* ImGuiWindow_GetDockNode(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockNodeAsHost(ImGuiWindow *ImGuiWindowPtr, ImGuiDockNode* v);
-extern ImGuiDockNode* ImGuiWindow_GetDockNodeAsHost(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
-extern ImGuiID ImGuiWindow_GetDockId(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockTabItemStatusFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiItemStatusFlags v);
-extern ImGuiItemStatusFlags ImGuiWindow_GetDockTabItemStatusFlags(ImGuiWindow *self);
-extern void ImGuiWindow_SetDockTabItemRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
-extern ImRect ImGuiWindow_GetDockTabItemRect(ImGuiWindow *self);
-extern void ImColor_SetValue(ImColor *ImColorPtr, ImVec4 v);
-extern ImVec4 ImColor_GetValue(ImColor *self);
-extern void ImGuiComboPreviewData_SetPreviewRect(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, ImRect v);
-extern ImRect ImGuiComboPreviewData_GetPreviewRect(ImGuiComboPreviewData *self);
-extern void ImGuiComboPreviewData_SetBackupCursorPos(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, ImVec2 v);
-extern ImVec2 ImGuiComboPreviewData_GetBackupCursorPos(ImGuiComboPreviewData *self);
-extern void ImGuiComboPreviewData_SetBackupCursorMaxPos(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, ImVec2 v);
-extern ImVec2 ImGuiComboPreviewData_GetBackupCursorMaxPos(ImGuiComboPreviewData *self);
-extern void ImGuiComboPreviewData_SetBackupCursorPosPrevLine(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, ImVec2 v);
-extern ImVec2 ImGuiComboPreviewData_GetBackupCursorPosPrevLine(ImGuiComboPreviewData *self);
-extern void ImGuiComboPreviewData_SetBackupPrevLineTextBaseOffset(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, float v);
-extern float ImGuiComboPreviewData_GetBackupPrevLineTextBaseOffset(ImGuiComboPreviewData *self);
-extern void ImGuiComboPreviewData_SetBackupLayout(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, ImGuiLayoutType v);
-extern ImGuiLayoutType ImGuiComboPreviewData_GetBackupLayout(ImGuiComboPreviewData *self);
-extern void ImGuiInputTextState_SetID(ImGuiInputTextState *ImGuiInputTextStatePtr, ImGuiID v);
-extern ImGuiID ImGuiInputTextState_GetID(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetCurLenW(ImGuiInputTextState *ImGuiInputTextStatePtr, int v);
-extern int ImGuiInputTextState_GetCurLenW(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetCurLenA(ImGuiInputTextState *ImGuiInputTextStatePtr, int v);
-extern int ImGuiInputTextState_GetCurLenA(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetTextW(ImGuiInputTextState *ImGuiInputTextStatePtr, ImVector_ImWchar v);
-extern ImVector_ImWchar ImGuiInputTextState_GetTextW(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetTextA(ImGuiInputTextState *ImGuiInputTextStatePtr, ImVector_char v);
-extern ImVector_char ImGuiInputTextState_GetTextA(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetInitialTextA(ImGuiInputTextState *ImGuiInputTextStatePtr, ImVector_char v);
-extern ImVector_char ImGuiInputTextState_GetInitialTextA(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetTextAIsValid(ImGuiInputTextState *ImGuiInputTextStatePtr, bool v);
-extern bool ImGuiInputTextState_GetTextAIsValid(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetBufCapacityA(ImGuiInputTextState *ImGuiInputTextStatePtr, int v);
-extern int ImGuiInputTextState_GetBufCapacityA(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetScrollX(ImGuiInputTextState *ImGuiInputTextStatePtr, float v);
-extern float ImGuiInputTextState_GetScrollX(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetStb(ImGuiInputTextState *ImGuiInputTextStatePtr, STB_TexteditState v);
-extern STB_TexteditState ImGuiInputTextState_GetStb(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetCursorAnim(ImGuiInputTextState *ImGuiInputTextStatePtr, float v);
-extern float ImGuiInputTextState_GetCursorAnim(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetCursorFollow(ImGuiInputTextState *ImGuiInputTextStatePtr, bool v);
-extern bool ImGuiInputTextState_GetCursorFollow(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetSelectedAllMouseLock(ImGuiInputTextState *ImGuiInputTextStatePtr, bool v);
-extern bool ImGuiInputTextState_GetSelectedAllMouseLock(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetEdited(ImGuiInputTextState *ImGuiInputTextStatePtr, bool v);
-extern bool ImGuiInputTextState_GetEdited(ImGuiInputTextState *self);
-extern void ImGuiInputTextState_SetFlags(ImGuiInputTextState *ImGuiInputTextStatePtr, ImGuiInputTextFlags v);
-extern ImGuiInputTextFlags ImGuiInputTextState_GetFlags(ImGuiInputTextState *self);
-extern void ImGuiShrinkWidthItem_SetIndex(ImGuiShrinkWidthItem *ImGuiShrinkWidthItemPtr, int v);
-extern int ImGuiShrinkWidthItem_GetIndex(ImGuiShrinkWidthItem *self);
-extern void ImGuiShrinkWidthItem_SetWidth(ImGuiShrinkWidthItem *ImGuiShrinkWidthItemPtr, float v);
-extern float ImGuiShrinkWidthItem_GetWidth(ImGuiShrinkWidthItem *self);
-extern void ImGuiShrinkWidthItem_SetInitialWidth(ImGuiShrinkWidthItem *ImGuiShrinkWidthItemPtr, float v);
-extern float ImGuiShrinkWidthItem_GetInitialWidth(ImGuiShrinkWidthItem *self);
-extern void ImGuiStackLevelInfo_SetID(ImGuiStackLevelInfo *ImGuiStackLevelInfoPtr, ImGuiID v);
-extern ImGuiID ImGuiStackLevelInfo_GetID(ImGuiStackLevelInfo *self);
-extern void ImGuiStackLevelInfo_SetQueryFrameCount(ImGuiStackLevelInfo *ImGuiStackLevelInfoPtr, ImS8 v);
-extern ImS8 ImGuiStackLevelInfo_GetQueryFrameCount(ImGuiStackLevelInfo *self);
-extern void ImGuiStackLevelInfo_SetQuerySuccess(ImGuiStackLevelInfo *ImGuiStackLevelInfoPtr, bool v);
-extern bool ImGuiStackLevelInfo_GetQuerySuccess(ImGuiStackLevelInfo *self);
-extern void ImGuiStackLevelInfo_SetDataType(ImGuiStackLevelInfo *ImGuiStackLevelInfoPtr, ImGuiDataType v);
-extern ImGuiDataType ImGuiStackLevelInfo_GetDataType(ImGuiStackLevelInfo *self);
-extern void ImGuiStoragePair_Setkey(ImGuiStoragePair *ImGuiStoragePairPtr, ImGuiID v);
-extern ImGuiID ImGuiStoragePair_Getkey(ImGuiStoragePair *self);
-extern void STB_TexteditState_Setcursor(STB_TexteditState *STB_TexteditStatePtr, int v);
-extern int STB_TexteditState_Getcursor(STB_TexteditState *self);
-extern void STB_TexteditState_Setselect_start(STB_TexteditState *STB_TexteditStatePtr, int v);
-extern int STB_TexteditState_Getselect_start(STB_TexteditState *self);
-extern void STB_TexteditState_Setselect_end(STB_TexteditState *STB_TexteditStatePtr, int v);
-extern int STB_TexteditState_Getselect_end(STB_TexteditState *self);
-extern void STB_TexteditState_Setinsert_mode(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
-extern unsigned char STB_TexteditState_Getinsert_mode(STB_TexteditState *self);
-extern void STB_TexteditState_Setrow_count_per_page(STB_TexteditState *STB_TexteditStatePtr, int v);
-extern int STB_TexteditState_Getrow_count_per_page(STB_TexteditState *self);
-extern void STB_TexteditState_Setcursor_at_end_of_line(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
-extern unsigned char STB_TexteditState_Getcursor_at_end_of_line(STB_TexteditState *self);
-extern void STB_TexteditState_Setinitialized(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
-extern unsigned char STB_TexteditState_Getinitialized(STB_TexteditState *self);
-extern void STB_TexteditState_Sethas_preferred_x(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
-extern unsigned char STB_TexteditState_Gethas_preferred_x(STB_TexteditState *self);
-extern void STB_TexteditState_Setsingle_line(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
-extern unsigned char STB_TexteditState_Getsingle_line(STB_TexteditState *self);
-extern void STB_TexteditState_Setpadding1(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
-extern unsigned char STB_TexteditState_Getpadding1(STB_TexteditState *self);
-extern void STB_TexteditState_Setpadding2(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
-extern unsigned char STB_TexteditState_Getpadding2(STB_TexteditState *self);
-extern void STB_TexteditState_Setpadding3(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
-extern unsigned char STB_TexteditState_Getpadding3(STB_TexteditState *self);
-extern void STB_TexteditState_Setpreferred_x(STB_TexteditState *STB_TexteditStatePtr, float v);
-extern float STB_TexteditState_Getpreferred_x(STB_TexteditState *self);
-extern void STB_TexteditState_Setundostate(STB_TexteditState *STB_TexteditStatePtr, StbUndoState v);
-extern StbUndoState STB_TexteditState_Getundostate(STB_TexteditState *self);
-extern void ImFontGlyph_SetColored(ImFontGlyph *ImFontGlyphPtr, unsigned int v);
-extern unsigned int ImFontGlyph_GetColored(ImFontGlyph *self);
-extern void ImFontGlyph_SetVisible(ImFontGlyph *ImFontGlyphPtr, unsigned int v);
-extern unsigned int ImFontGlyph_GetVisible(ImFontGlyph *self);
-extern void ImFontGlyph_SetCodepoint(ImFontGlyph *ImFontGlyphPtr, unsigned int v);
-extern unsigned int ImFontGlyph_GetCodepoint(ImFontGlyph *self);
-extern void ImFontGlyph_SetAdvanceX(ImFontGlyph *ImFontGlyphPtr, float v);
-extern float ImFontGlyph_GetAdvanceX(ImFontGlyph *self);
-extern void ImFontGlyph_SetX0(ImFontGlyph *ImFontGlyphPtr, float v);
-extern float ImFontGlyph_GetX0(ImFontGlyph *self);
-extern void ImFontGlyph_SetY0(ImFontGlyph *ImFontGlyphPtr, float v);
-extern float ImFontGlyph_GetY0(ImFontGlyph *self);
-extern void ImFontGlyph_SetX1(ImFontGlyph *ImFontGlyphPtr, float v);
-extern float ImFontGlyph_GetX1(ImFontGlyph *self);
-extern void ImFontGlyph_SetY1(ImFontGlyph *ImFontGlyphPtr, float v);
-extern float ImFontGlyph_GetY1(ImFontGlyph *self);
-extern void ImFontGlyph_SetU0(ImFontGlyph *ImFontGlyphPtr, float v);
-extern float ImFontGlyph_GetU0(ImFontGlyph *self);
-extern void ImFontGlyph_SetV0(ImFontGlyph *ImFontGlyphPtr, float v);
-extern float ImFontGlyph_GetV0(ImFontGlyph *self);
-extern void ImFontGlyph_SetU1(ImFontGlyph *ImFontGlyphPtr, float v);
-extern float ImFontGlyph_GetU1(ImFontGlyph *self);
-extern void ImFontGlyph_SetV1(ImFontGlyph *ImFontGlyphPtr, float v);
-extern float ImFontGlyph_GetV1(ImFontGlyph *self);
-extern void ImGuiNextItemData_SetFlags(ImGuiNextItemData *ImGuiNextItemDataPtr, ImGuiNextItemDataFlags v);
-extern ImGuiNextItemDataFlags ImGuiNextItemData_GetFlags(ImGuiNextItemData *self);
-extern void ImGuiNextItemData_SetWidth(ImGuiNextItemData *ImGuiNextItemDataPtr, float v);
-extern float ImGuiNextItemData_GetWidth(ImGuiNextItemData *self);
-extern void ImGuiNextItemData_SetFocusScopeId(ImGuiNextItemData *ImGuiNextItemDataPtr, ImGuiID v);
-extern ImGuiID ImGuiNextItemData_GetFocusScopeId(ImGuiNextItemData *self);
-extern void ImGuiNextItemData_SetOpenCond(ImGuiNextItemData *ImGuiNextItemDataPtr, ImGuiCond v);
-extern ImGuiCond ImGuiNextItemData_GetOpenCond(ImGuiNextItemData *self);
-extern void ImGuiNextItemData_SetOpenVal(ImGuiNextItemData *ImGuiNextItemDataPtr, bool v);
-extern bool ImGuiNextItemData_GetOpenVal(ImGuiNextItemData *self);
-extern void StbUndoRecord_Setwhere(StbUndoRecord *StbUndoRecordPtr, int v);
-extern int StbUndoRecord_Getwhere(StbUndoRecord *self);
-extern void StbUndoRecord_Setinsert_length(StbUndoRecord *StbUndoRecordPtr, int v);
-extern int StbUndoRecord_Getinsert_length(StbUndoRecord *self);
-extern void StbUndoRecord_Setdelete_length(StbUndoRecord *StbUndoRecordPtr, int v);
-extern int StbUndoRecord_Getdelete_length(StbUndoRecord *self);
-extern void StbUndoRecord_Setchar_storage(StbUndoRecord *StbUndoRecordPtr, int v);
-extern int StbUndoRecord_Getchar_storage(StbUndoRecord *self);
-extern void ImGuiColorMod_SetCol(ImGuiColorMod *ImGuiColorModPtr, ImGuiCol v);
-extern ImGuiCol ImGuiColorMod_GetCol(ImGuiColorMod *self);
-extern void ImGuiColorMod_SetBackupValue(ImGuiColorMod *ImGuiColorModPtr, ImVec4 v);
-extern ImVec4 ImGuiColorMod_GetBackupValue(ImGuiColorMod *self);
-extern void ImGuiDockContext_SetNodes(ImGuiDockContext *ImGuiDockContextPtr, ImGuiStorage v);
-extern ImGuiStorage ImGuiDockContext_GetNodes(ImGuiDockContext *self);
-extern void ImGuiDockContext_SetRequests(ImGuiDockContext *ImGuiDockContextPtr, ImVector_ImGuiDockRequest v);
-extern ImVector_ImGuiDockRequest ImGuiDockContext_GetRequests(ImGuiDockContext *self);
-extern void ImGuiDockContext_SetNodesSettings(ImGuiDockContext *ImGuiDockContextPtr, ImVector_ImGuiDockNodeSettings v);
-extern ImVector_ImGuiDockNodeSettings ImGuiDockContext_GetNodesSettings(ImGuiDockContext *self);
-extern void ImGuiDockContext_SetWantFullRebuild(ImGuiDockContext *ImGuiDockContextPtr, bool v);
-extern bool ImGuiDockContext_GetWantFullRebuild(ImGuiDockContext *self);
-extern void ImGuiInputEventMouseWheel_SetWheelX(ImGuiInputEventMouseWheel *ImGuiInputEventMouseWheelPtr, float v);
-extern float ImGuiInputEventMouseWheel_GetWheelX(ImGuiInputEventMouseWheel *self);
-extern void ImGuiInputEventMouseWheel_SetWheelY(ImGuiInputEventMouseWheel *ImGuiInputEventMouseWheelPtr, float v);
-extern float ImGuiInputEventMouseWheel_GetWheelY(ImGuiInputEventMouseWheel *self);
+extern void ImGuiStyle_SetAlpha(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetAlpha(ImGuiStyle *self);
+extern void ImGuiStyle_SetDisabledAlpha(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetDisabledAlpha(ImGuiStyle *self);
+extern void ImGuiStyle_SetWindowPadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetWindowPadding(ImGuiStyle *self);
+extern void ImGuiStyle_SetWindowRounding(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetWindowRounding(ImGuiStyle *self);
+extern void ImGuiStyle_SetWindowBorderSize(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetWindowBorderSize(ImGuiStyle *self);
+extern void ImGuiStyle_SetWindowMinSize(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetWindowMinSize(ImGuiStyle *self);
+extern void ImGuiStyle_SetWindowTitleAlign(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetWindowTitleAlign(ImGuiStyle *self);
+extern void ImGuiStyle_SetWindowMenuButtonPosition(ImGuiStyle *ImGuiStylePtr, ImGuiDir v);
+extern ImGuiDir ImGuiStyle_GetWindowMenuButtonPosition(ImGuiStyle *self);
+extern void ImGuiStyle_SetChildRounding(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetChildRounding(ImGuiStyle *self);
+extern void ImGuiStyle_SetChildBorderSize(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetChildBorderSize(ImGuiStyle *self);
+extern void ImGuiStyle_SetPopupRounding(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetPopupRounding(ImGuiStyle *self);
+extern void ImGuiStyle_SetPopupBorderSize(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetPopupBorderSize(ImGuiStyle *self);
+extern void ImGuiStyle_SetFramePadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetFramePadding(ImGuiStyle *self);
+extern void ImGuiStyle_SetFrameRounding(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetFrameRounding(ImGuiStyle *self);
+extern void ImGuiStyle_SetFrameBorderSize(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetFrameBorderSize(ImGuiStyle *self);
+extern void ImGuiStyle_SetItemSpacing(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetItemSpacing(ImGuiStyle *self);
+extern void ImGuiStyle_SetItemInnerSpacing(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetItemInnerSpacing(ImGuiStyle *self);
+extern void ImGuiStyle_SetCellPadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetCellPadding(ImGuiStyle *self);
+extern void ImGuiStyle_SetTouchExtraPadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetTouchExtraPadding(ImGuiStyle *self);
+extern void ImGuiStyle_SetIndentSpacing(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetIndentSpacing(ImGuiStyle *self);
+extern void ImGuiStyle_SetColumnsMinSpacing(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetColumnsMinSpacing(ImGuiStyle *self);
+extern void ImGuiStyle_SetScrollbarSize(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetScrollbarSize(ImGuiStyle *self);
+extern void ImGuiStyle_SetScrollbarRounding(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetScrollbarRounding(ImGuiStyle *self);
+extern void ImGuiStyle_SetGrabMinSize(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetGrabMinSize(ImGuiStyle *self);
+extern void ImGuiStyle_SetGrabRounding(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetGrabRounding(ImGuiStyle *self);
+extern void ImGuiStyle_SetLogSliderDeadzone(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetLogSliderDeadzone(ImGuiStyle *self);
+extern void ImGuiStyle_SetTabRounding(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetTabRounding(ImGuiStyle *self);
+extern void ImGuiStyle_SetTabBorderSize(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetTabBorderSize(ImGuiStyle *self);
+extern void ImGuiStyle_SetTabMinWidthForCloseButton(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetTabMinWidthForCloseButton(ImGuiStyle *self);
+extern void ImGuiStyle_SetColorButtonPosition(ImGuiStyle *ImGuiStylePtr, ImGuiDir v);
+extern ImGuiDir ImGuiStyle_GetColorButtonPosition(ImGuiStyle *self);
+extern void ImGuiStyle_SetButtonTextAlign(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetButtonTextAlign(ImGuiStyle *self);
+extern void ImGuiStyle_SetSelectableTextAlign(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetSelectableTextAlign(ImGuiStyle *self);
+extern void ImGuiStyle_SetDisplayWindowPadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetDisplayWindowPadding(ImGuiStyle *self);
+extern void ImGuiStyle_SetDisplaySafeAreaPadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
+extern ImVec2 ImGuiStyle_GetDisplaySafeAreaPadding(ImGuiStyle *self);
+extern void ImGuiStyle_SetMouseCursorScale(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetMouseCursorScale(ImGuiStyle *self);
+extern void ImGuiStyle_SetAntiAliasedLines(ImGuiStyle *ImGuiStylePtr, bool v);
+extern bool ImGuiStyle_GetAntiAliasedLines(ImGuiStyle *self);
+extern void ImGuiStyle_SetAntiAliasedLinesUseTex(ImGuiStyle *ImGuiStylePtr, bool v);
+extern bool ImGuiStyle_GetAntiAliasedLinesUseTex(ImGuiStyle *self);
+extern void ImGuiStyle_SetAntiAliasedFill(ImGuiStyle *ImGuiStylePtr, bool v);
+extern bool ImGuiStyle_GetAntiAliasedFill(ImGuiStyle *self);
+extern void ImGuiStyle_SetCurveTessellationTol(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetCurveTessellationTol(ImGuiStyle *self);
+extern void ImGuiStyle_SetCircleTessellationMaxError(ImGuiStyle *ImGuiStylePtr, float v);
+extern float ImGuiStyle_GetCircleTessellationMaxError(ImGuiStyle *self);
+extern void ImGuiKeyData_SetDown(ImGuiKeyData *ImGuiKeyDataPtr, bool v);
+extern bool ImGuiKeyData_GetDown(ImGuiKeyData *self);
+extern void ImGuiKeyData_SetDownDuration(ImGuiKeyData *ImGuiKeyDataPtr, float v);
+extern float ImGuiKeyData_GetDownDuration(ImGuiKeyData *self);
+extern void ImGuiKeyData_SetDownDurationPrev(ImGuiKeyData *ImGuiKeyDataPtr, float v);
+extern float ImGuiKeyData_GetDownDurationPrev(ImGuiKeyData *self);
+extern void ImGuiKeyData_SetAnalogValue(ImGuiKeyData *ImGuiKeyDataPtr, float v);
+extern float ImGuiKeyData_GetAnalogValue(ImGuiKeyData *self);
+extern void ImGuiIO_SetConfigFlags(ImGuiIO *ImGuiIOPtr, ImGuiConfigFlags v);
+extern ImGuiConfigFlags ImGuiIO_GetConfigFlags(ImGuiIO *self);
+extern void ImGuiIO_SetBackendFlags(ImGuiIO *ImGuiIOPtr, ImGuiBackendFlags v);
+extern ImGuiBackendFlags ImGuiIO_GetBackendFlags(ImGuiIO *self);
+extern void ImGuiIO_SetDisplaySize(ImGuiIO *ImGuiIOPtr, ImVec2 v);
+extern ImVec2 ImGuiIO_GetDisplaySize(ImGuiIO *self);
+extern void ImGuiIO_SetDeltaTime(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetDeltaTime(ImGuiIO *self);
+extern void ImGuiIO_SetIniSavingRate(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetIniSavingRate(ImGuiIO *self);
+extern void ImGuiIO_SetIniFilename(ImGuiIO *ImGuiIOPtr, const char* v);
+extern const char* ImGuiIO_GetIniFilename(ImGuiIO *self);
+extern void ImGuiIO_SetLogFilename(ImGuiIO *ImGuiIOPtr, const char* v);
+extern const char* ImGuiIO_GetLogFilename(ImGuiIO *self);
+extern void ImGuiIO_SetMouseDoubleClickTime(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetMouseDoubleClickTime(ImGuiIO *self);
+extern void ImGuiIO_SetMouseDoubleClickMaxDist(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetMouseDoubleClickMaxDist(ImGuiIO *self);
+extern void ImGuiIO_SetMouseDragThreshold(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetMouseDragThreshold(ImGuiIO *self);
+extern void ImGuiIO_SetKeyRepeatDelay(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetKeyRepeatDelay(ImGuiIO *self);
+extern void ImGuiIO_SetKeyRepeatRate(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetKeyRepeatRate(ImGuiIO *self);
+extern void ImGuiIO_SetUserData(ImGuiIO *ImGuiIOPtr, void* v);
+extern void* ImGuiIO_GetUserData(ImGuiIO *self);
+extern void ImGuiIO_SetFonts(ImGuiIO *ImGuiIOPtr, ImFontAtlas* v);
+extern ImFontAtlas* ImGuiIO_GetFonts(ImGuiIO *self);
+extern void ImGuiIO_SetFontGlobalScale(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetFontGlobalScale(ImGuiIO *self);
+extern void ImGuiIO_SetFontAllowUserScaling(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetFontAllowUserScaling(ImGuiIO *self);
+extern void ImGuiIO_SetFontDefault(ImGuiIO *ImGuiIOPtr, ImFont* v);
+extern ImFont* ImGuiIO_GetFontDefault(ImGuiIO *self);
+extern void ImGuiIO_SetDisplayFramebufferScale(ImGuiIO *ImGuiIOPtr, ImVec2 v);
+extern ImVec2 ImGuiIO_GetDisplayFramebufferScale(ImGuiIO *self);
+extern void ImGuiIO_SetConfigDockingNoSplit(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigDockingNoSplit(ImGuiIO *self);
+extern void ImGuiIO_SetConfigDockingWithShift(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigDockingWithShift(ImGuiIO *self);
+extern void ImGuiIO_SetConfigDockingAlwaysTabBar(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigDockingAlwaysTabBar(ImGuiIO *self);
+extern void ImGuiIO_SetConfigDockingTransparentPayload(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigDockingTransparentPayload(ImGuiIO *self);
+extern void ImGuiIO_SetConfigViewportsNoAutoMerge(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigViewportsNoAutoMerge(ImGuiIO *self);
+extern void ImGuiIO_SetConfigViewportsNoTaskBarIcon(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigViewportsNoTaskBarIcon(ImGuiIO *self);
+extern void ImGuiIO_SetConfigViewportsNoDecoration(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigViewportsNoDecoration(ImGuiIO *self);
+extern void ImGuiIO_SetConfigViewportsNoDefaultParent(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigViewportsNoDefaultParent(ImGuiIO *self);
+extern void ImGuiIO_SetMouseDrawCursor(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetMouseDrawCursor(ImGuiIO *self);
+extern void ImGuiIO_SetConfigMacOSXBehaviors(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigMacOSXBehaviors(ImGuiIO *self);
+extern void ImGuiIO_SetConfigInputTrickleEventQueue(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigInputTrickleEventQueue(ImGuiIO *self);
+extern void ImGuiIO_SetConfigInputTextCursorBlink(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigInputTextCursorBlink(ImGuiIO *self);
+extern void ImGuiIO_SetConfigInputTextEnterKeepActive(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigInputTextEnterKeepActive(ImGuiIO *self);
+extern void ImGuiIO_SetConfigDragClickToInputText(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigDragClickToInputText(ImGuiIO *self);
+extern void ImGuiIO_SetConfigWindowsResizeFromEdges(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigWindowsResizeFromEdges(ImGuiIO *self);
+extern void ImGuiIO_SetConfigWindowsMoveFromTitleBarOnly(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetConfigWindowsMoveFromTitleBarOnly(ImGuiIO *self);
+extern void ImGuiIO_SetConfigMemoryCompactTimer(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetConfigMemoryCompactTimer(ImGuiIO *self);
+extern void ImGuiIO_SetBackendPlatformName(ImGuiIO *ImGuiIOPtr, const char* v);
+extern const char* ImGuiIO_GetBackendPlatformName(ImGuiIO *self);
+extern void ImGuiIO_SetBackendRendererName(ImGuiIO *ImGuiIOPtr, const char* v);
+extern const char* ImGuiIO_GetBackendRendererName(ImGuiIO *self);
+extern void ImGuiIO_SetBackendPlatformUserData(ImGuiIO *ImGuiIOPtr, void* v);
+extern void* ImGuiIO_GetBackendPlatformUserData(ImGuiIO *self);
+extern void ImGuiIO_SetBackendRendererUserData(ImGuiIO *ImGuiIOPtr, void* v);
+extern void* ImGuiIO_GetBackendRendererUserData(ImGuiIO *self);
+extern void ImGuiIO_SetBackendLanguageUserData(ImGuiIO *ImGuiIOPtr, void* v);
+extern void* ImGuiIO_GetBackendLanguageUserData(ImGuiIO *self);
+extern void ImGuiIO_SetClipboardUserData(ImGuiIO *ImGuiIOPtr, void* v);
+extern void* ImGuiIO_GetClipboardUserData(ImGuiIO *self);
+extern void ImGuiIO_Set_UnusedPadding(ImGuiIO *ImGuiIOPtr, void* v);
+extern void* ImGuiIO_Get_UnusedPadding(ImGuiIO *self);
+extern void ImGuiIO_SetWantCaptureMouse(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetWantCaptureMouse(ImGuiIO *self);
+extern void ImGuiIO_SetWantCaptureKeyboard(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetWantCaptureKeyboard(ImGuiIO *self);
+extern void ImGuiIO_SetWantTextInput(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetWantTextInput(ImGuiIO *self);
+extern void ImGuiIO_SetWantSetMousePos(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetWantSetMousePos(ImGuiIO *self);
+extern void ImGuiIO_SetWantSaveIniSettings(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetWantSaveIniSettings(ImGuiIO *self);
+extern void ImGuiIO_SetNavActive(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetNavActive(ImGuiIO *self);
+extern void ImGuiIO_SetNavVisible(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetNavVisible(ImGuiIO *self);
+extern void ImGuiIO_SetFramerate(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetFramerate(ImGuiIO *self);
+extern void ImGuiIO_SetMetricsRenderVertices(ImGuiIO *ImGuiIOPtr, int v);
+extern int ImGuiIO_GetMetricsRenderVertices(ImGuiIO *self);
+extern void ImGuiIO_SetMetricsRenderIndices(ImGuiIO *ImGuiIOPtr, int v);
+extern int ImGuiIO_GetMetricsRenderIndices(ImGuiIO *self);
+extern void ImGuiIO_SetMetricsRenderWindows(ImGuiIO *ImGuiIOPtr, int v);
+extern int ImGuiIO_GetMetricsRenderWindows(ImGuiIO *self);
+extern void ImGuiIO_SetMetricsActiveWindows(ImGuiIO *ImGuiIOPtr, int v);
+extern int ImGuiIO_GetMetricsActiveWindows(ImGuiIO *self);
+extern void ImGuiIO_SetMetricsActiveAllocations(ImGuiIO *ImGuiIOPtr, int v);
+extern int ImGuiIO_GetMetricsActiveAllocations(ImGuiIO *self);
+extern void ImGuiIO_SetMouseDelta(ImGuiIO *ImGuiIOPtr, ImVec2 v);
+extern ImVec2 ImGuiIO_GetMouseDelta(ImGuiIO *self);
+extern void ImGuiIO_SetMousePos(ImGuiIO *ImGuiIOPtr, ImVec2 v);
+extern ImVec2 ImGuiIO_GetMousePos(ImGuiIO *self);
+extern void ImGuiIO_SetMouseWheel(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetMouseWheel(ImGuiIO *self);
+extern void ImGuiIO_SetMouseWheelH(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetMouseWheelH(ImGuiIO *self);
+extern void ImGuiIO_SetMouseHoveredViewport(ImGuiIO *ImGuiIOPtr, ImGuiID v);
+extern ImGuiID ImGuiIO_GetMouseHoveredViewport(ImGuiIO *self);
+extern void ImGuiIO_SetKeyCtrl(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetKeyCtrl(ImGuiIO *self);
+extern void ImGuiIO_SetKeyShift(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetKeyShift(ImGuiIO *self);
+extern void ImGuiIO_SetKeyAlt(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetKeyAlt(ImGuiIO *self);
+extern void ImGuiIO_SetKeySuper(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetKeySuper(ImGuiIO *self);
+extern void ImGuiIO_SetKeyMods(ImGuiIO *ImGuiIOPtr, ImGuiModFlags v);
+extern ImGuiModFlags ImGuiIO_GetKeyMods(ImGuiIO *self);
+extern void ImGuiIO_SetWantCaptureMouseUnlessPopupClose(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetWantCaptureMouseUnlessPopupClose(ImGuiIO *self);
+extern void ImGuiIO_SetMousePosPrev(ImGuiIO *ImGuiIOPtr, ImVec2 v);
+extern ImVec2 ImGuiIO_GetMousePosPrev(ImGuiIO *self);
+extern void ImGuiIO_SetPenPressure(ImGuiIO *ImGuiIOPtr, float v);
+extern float ImGuiIO_GetPenPressure(ImGuiIO *self);
+extern void ImGuiIO_SetAppFocusLost(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetAppFocusLost(ImGuiIO *self);
+extern void ImGuiIO_SetBackendUsingLegacyKeyArrays(ImGuiIO *ImGuiIOPtr, ImS8 v);
+extern ImS8 ImGuiIO_GetBackendUsingLegacyKeyArrays(ImGuiIO *self);
+extern void ImGuiIO_SetBackendUsingLegacyNavInputArray(ImGuiIO *ImGuiIOPtr, bool v);
+extern bool ImGuiIO_GetBackendUsingLegacyNavInputArray(ImGuiIO *self);
+extern void ImGuiIO_SetInputQueueSurrogate(ImGuiIO *ImGuiIOPtr, ImWchar16 v);
+extern ImWchar16 ImGuiIO_GetInputQueueSurrogate(ImGuiIO *self);
+extern void ImGuiIO_SetInputQueueCharacters(ImGuiIO *ImGuiIOPtr, ImVector_ImWchar v);
+extern ImVector_ImWchar ImGuiIO_GetInputQueueCharacters(ImGuiIO *self);
+extern void ImGuiInputTextCallbackData_SetEventFlag(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, ImGuiInputTextFlags v);
+extern ImGuiInputTextFlags ImGuiInputTextCallbackData_GetEventFlag(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetFlags(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, ImGuiInputTextFlags v);
+extern ImGuiInputTextFlags ImGuiInputTextCallbackData_GetFlags(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetUserData(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, void* v);
+extern void* ImGuiInputTextCallbackData_GetUserData(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetEventChar(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, ImWchar v);
+extern ImWchar ImGuiInputTextCallbackData_GetEventChar(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetEventKey(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, ImGuiKey v);
+extern ImGuiKey ImGuiInputTextCallbackData_GetEventKey(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetBuf(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, char* v);
+extern char* ImGuiInputTextCallbackData_GetBuf(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetBufTextLen(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, int v);
+extern int ImGuiInputTextCallbackData_GetBufTextLen(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetBufSize(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, int v);
+extern int ImGuiInputTextCallbackData_GetBufSize(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetBufDirty(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, bool v);
+extern bool ImGuiInputTextCallbackData_GetBufDirty(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetCursorPos(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, int v);
+extern int ImGuiInputTextCallbackData_GetCursorPos(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetSelectionStart(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, int v);
+extern int ImGuiInputTextCallbackData_GetSelectionStart(ImGuiInputTextCallbackData *self);
+extern void ImGuiInputTextCallbackData_SetSelectionEnd(ImGuiInputTextCallbackData *ImGuiInputTextCallbackDataPtr, int v);
+extern int ImGuiInputTextCallbackData_GetSelectionEnd(ImGuiInputTextCallbackData *self);
+extern void ImGuiSizeCallbackData_SetUserData(ImGuiSizeCallbackData *ImGuiSizeCallbackDataPtr, void* v);
+extern void* ImGuiSizeCallbackData_GetUserData(ImGuiSizeCallbackData *self);
+extern void ImGuiSizeCallbackData_SetPos(ImGuiSizeCallbackData *ImGuiSizeCallbackDataPtr, ImVec2 v);
+extern ImVec2 ImGuiSizeCallbackData_GetPos(ImGuiSizeCallbackData *self);
+extern void ImGuiSizeCallbackData_SetCurrentSize(ImGuiSizeCallbackData *ImGuiSizeCallbackDataPtr, ImVec2 v);
+extern ImVec2 ImGuiSizeCallbackData_GetCurrentSize(ImGuiSizeCallbackData *self);
+extern void ImGuiSizeCallbackData_SetDesiredSize(ImGuiSizeCallbackData *ImGuiSizeCallbackDataPtr, ImVec2 v);
+extern ImVec2 ImGuiSizeCallbackData_GetDesiredSize(ImGuiSizeCallbackData *self);
 extern void ImGuiWindowClass_SetClassId(ImGuiWindowClass *ImGuiWindowClassPtr, ImGuiID v);
 extern ImGuiID ImGuiWindowClass_GetClassId(ImGuiWindowClass *self);
 extern void ImGuiWindowClass_SetParentViewportId(ImGuiWindowClass *ImGuiWindowClassPtr, ImGuiID v);
@@ -1554,210 +288,226 @@ extern void ImGuiWindowClass_SetDockingAlwaysTabBar(ImGuiWindowClass *ImGuiWindo
 extern bool ImGuiWindowClass_GetDockingAlwaysTabBar(ImGuiWindowClass *self);
 extern void ImGuiWindowClass_SetDockingAllowUnclassed(ImGuiWindowClass *ImGuiWindowClassPtr, bool v);
 extern bool ImGuiWindowClass_GetDockingAllowUnclassed(ImGuiWindowClass *self);
-extern void ImGuiInputEventMouseButton_SetButton(ImGuiInputEventMouseButton *ImGuiInputEventMouseButtonPtr, int v);
-extern int ImGuiInputEventMouseButton_GetButton(ImGuiInputEventMouseButton *self);
-extern void ImGuiInputEventMouseButton_SetDown(ImGuiInputEventMouseButton *ImGuiInputEventMouseButtonPtr, bool v);
-extern bool ImGuiInputEventMouseButton_GetDown(ImGuiInputEventMouseButton *self);
-extern void ImGuiPtrOrIndex_SetPtr(ImGuiPtrOrIndex *ImGuiPtrOrIndexPtr, void* v);
-extern void* ImGuiPtrOrIndex_GetPtr(ImGuiPtrOrIndex *self);
-extern void ImGuiPtrOrIndex_SetIndex(ImGuiPtrOrIndex *ImGuiPtrOrIndexPtr, int v);
-extern int ImGuiPtrOrIndex_GetIndex(ImGuiPtrOrIndex *self);
-extern void ImGuiSettingsHandler_SetTypeName(ImGuiSettingsHandler *ImGuiSettingsHandlerPtr, const char* v);
-extern const char* ImGuiSettingsHandler_GetTypeName(ImGuiSettingsHandler *self);
-extern void ImGuiSettingsHandler_SetTypeHash(ImGuiSettingsHandler *ImGuiSettingsHandlerPtr, ImGuiID v);
-extern ImGuiID ImGuiSettingsHandler_GetTypeHash(ImGuiSettingsHandler *self);
-extern void ImGuiSettingsHandler_SetUserData(ImGuiSettingsHandler *ImGuiSettingsHandlerPtr, void* v);
-extern void* ImGuiSettingsHandler_GetUserData(ImGuiSettingsHandler *self);
-extern void ImGuiInputEventAppFocused_SetFocused(ImGuiInputEventAppFocused *ImGuiInputEventAppFocusedPtr, bool v);
-extern bool ImGuiInputEventAppFocused_GetFocused(ImGuiInputEventAppFocused *self);
-extern void ImGuiInputEventText_SetChar(ImGuiInputEventText *ImGuiInputEventTextPtr, unsigned int v);
-extern unsigned int ImGuiInputEventText_GetChar(ImGuiInputEventText *self);
-extern void ImGuiListClipperData_SetListClipper(ImGuiListClipperData *ImGuiListClipperDataPtr, ImGuiListClipper* v);
-extern ImGuiListClipper* ImGuiListClipperData_GetListClipper(ImGuiListClipperData *self);
-extern void ImGuiListClipperData_SetLossynessOffset(ImGuiListClipperData *ImGuiListClipperDataPtr, float v);
-extern float ImGuiListClipperData_GetLossynessOffset(ImGuiListClipperData *self);
-extern void ImGuiListClipperData_SetStepNo(ImGuiListClipperData *ImGuiListClipperDataPtr, int v);
-extern int ImGuiListClipperData_GetStepNo(ImGuiListClipperData *self);
-extern void ImGuiListClipperData_SetItemsFrozen(ImGuiListClipperData *ImGuiListClipperDataPtr, int v);
-extern int ImGuiListClipperData_GetItemsFrozen(ImGuiListClipperData *self);
-extern void ImGuiListClipperData_SetRanges(ImGuiListClipperData *ImGuiListClipperDataPtr, ImVector_ImGuiListClipperRange v);
-extern ImVector_ImGuiListClipperRange ImGuiListClipperData_GetRanges(ImGuiListClipperData *self);
+extern void ImGuiPayload_SetData(ImGuiPayload *ImGuiPayloadPtr, void* v);
+extern void* ImGuiPayload_GetData(ImGuiPayload *self);
+extern void ImGuiPayload_SetDataSize(ImGuiPayload *ImGuiPayloadPtr, int v);
+extern int ImGuiPayload_GetDataSize(ImGuiPayload *self);
+extern void ImGuiPayload_SetSourceId(ImGuiPayload *ImGuiPayloadPtr, ImGuiID v);
+extern ImGuiID ImGuiPayload_GetSourceId(ImGuiPayload *self);
+extern void ImGuiPayload_SetSourceParentId(ImGuiPayload *ImGuiPayloadPtr, ImGuiID v);
+extern ImGuiID ImGuiPayload_GetSourceParentId(ImGuiPayload *self);
+extern void ImGuiPayload_SetDataFrameCount(ImGuiPayload *ImGuiPayloadPtr, int v);
+extern int ImGuiPayload_GetDataFrameCount(ImGuiPayload *self);
+extern void ImGuiPayload_SetPreview(ImGuiPayload *ImGuiPayloadPtr, bool v);
+extern bool ImGuiPayload_GetPreview(ImGuiPayload *self);
+extern void ImGuiPayload_SetDelivery(ImGuiPayload *ImGuiPayloadPtr, bool v);
+extern bool ImGuiPayload_GetDelivery(ImGuiPayload *self);
+extern void ImGuiTableColumnSortSpecs_SetColumnUserID(ImGuiTableColumnSortSpecs *ImGuiTableColumnSortSpecsPtr, ImGuiID v);
+extern ImGuiID ImGuiTableColumnSortSpecs_GetColumnUserID(ImGuiTableColumnSortSpecs *self);
+extern void ImGuiTableColumnSortSpecs_SetColumnIndex(ImGuiTableColumnSortSpecs *ImGuiTableColumnSortSpecsPtr, ImS16 v);
+extern ImS16 ImGuiTableColumnSortSpecs_GetColumnIndex(ImGuiTableColumnSortSpecs *self);
+extern void ImGuiTableColumnSortSpecs_SetSortOrder(ImGuiTableColumnSortSpecs *ImGuiTableColumnSortSpecsPtr, ImS16 v);
+extern ImS16 ImGuiTableColumnSortSpecs_GetSortOrder(ImGuiTableColumnSortSpecs *self);
+extern void ImGuiTableColumnSortSpecs_SetSortDirection(ImGuiTableColumnSortSpecs *ImGuiTableColumnSortSpecsPtr, ImGuiSortDirection v);
+extern ImGuiSortDirection ImGuiTableColumnSortSpecs_GetSortDirection(ImGuiTableColumnSortSpecs *self);
+extern void ImGuiTableSortSpecs_SetSpecs(ImGuiTableSortSpecs *ImGuiTableSortSpecsPtr, const ImGuiTableColumnSortSpecs* v);
+extern const ImGuiTableColumnSortSpecs* ImGuiTableSortSpecs_GetSpecs(ImGuiTableSortSpecs *self);
+extern void ImGuiTableSortSpecs_SetSpecsCount(ImGuiTableSortSpecs *ImGuiTableSortSpecsPtr, int v);
+extern int ImGuiTableSortSpecs_GetSpecsCount(ImGuiTableSortSpecs *self);
+extern void ImGuiTableSortSpecs_SetSpecsDirty(ImGuiTableSortSpecs *ImGuiTableSortSpecsPtr, bool v);
+extern bool ImGuiTableSortSpecs_GetSpecsDirty(ImGuiTableSortSpecs *self);
 extern void ImGuiOnceUponAFrame_SetRefFrame(ImGuiOnceUponAFrame *ImGuiOnceUponAFramePtr, int v);
 extern int ImGuiOnceUponAFrame_GetRefFrame(ImGuiOnceUponAFrame *self);
-extern void ImGuiSizeCallbackData_SetUserData(ImGuiSizeCallbackData *ImGuiSizeCallbackDataPtr, void* v);
-extern void* ImGuiSizeCallbackData_GetUserData(ImGuiSizeCallbackData *self);
-extern void ImGuiSizeCallbackData_SetPos(ImGuiSizeCallbackData *ImGuiSizeCallbackDataPtr, ImVec2 v);
-extern ImVec2 ImGuiSizeCallbackData_GetPos(ImGuiSizeCallbackData *self);
-extern void ImGuiSizeCallbackData_SetCurrentSize(ImGuiSizeCallbackData *ImGuiSizeCallbackDataPtr, ImVec2 v);
-extern ImVec2 ImGuiSizeCallbackData_GetCurrentSize(ImGuiSizeCallbackData *self);
-extern void ImGuiSizeCallbackData_SetDesiredSize(ImGuiSizeCallbackData *ImGuiSizeCallbackDataPtr, ImVec2 v);
-extern ImVec2 ImGuiSizeCallbackData_GetDesiredSize(ImGuiSizeCallbackData *self);
-extern void ImGuiStyle_SetAlpha(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetAlpha(ImGuiStyle *self);
-extern void ImGuiStyle_SetDisabledAlpha(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetDisabledAlpha(ImGuiStyle *self);
-extern void ImGuiStyle_SetWindowPadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetWindowPadding(ImGuiStyle *self);
-extern void ImGuiStyle_SetWindowRounding(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetWindowRounding(ImGuiStyle *self);
-extern void ImGuiStyle_SetWindowBorderSize(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetWindowBorderSize(ImGuiStyle *self);
-extern void ImGuiStyle_SetWindowMinSize(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetWindowMinSize(ImGuiStyle *self);
-extern void ImGuiStyle_SetWindowTitleAlign(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetWindowTitleAlign(ImGuiStyle *self);
-extern void ImGuiStyle_SetWindowMenuButtonPosition(ImGuiStyle *ImGuiStylePtr, ImGuiDir v);
-extern ImGuiDir ImGuiStyle_GetWindowMenuButtonPosition(ImGuiStyle *self);
-extern void ImGuiStyle_SetChildRounding(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetChildRounding(ImGuiStyle *self);
-extern void ImGuiStyle_SetChildBorderSize(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetChildBorderSize(ImGuiStyle *self);
-extern void ImGuiStyle_SetPopupRounding(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetPopupRounding(ImGuiStyle *self);
-extern void ImGuiStyle_SetPopupBorderSize(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetPopupBorderSize(ImGuiStyle *self);
-extern void ImGuiStyle_SetFramePadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetFramePadding(ImGuiStyle *self);
-extern void ImGuiStyle_SetFrameRounding(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetFrameRounding(ImGuiStyle *self);
-extern void ImGuiStyle_SetFrameBorderSize(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetFrameBorderSize(ImGuiStyle *self);
-extern void ImGuiStyle_SetItemSpacing(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetItemSpacing(ImGuiStyle *self);
-extern void ImGuiStyle_SetItemInnerSpacing(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetItemInnerSpacing(ImGuiStyle *self);
-extern void ImGuiStyle_SetCellPadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetCellPadding(ImGuiStyle *self);
-extern void ImGuiStyle_SetTouchExtraPadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetTouchExtraPadding(ImGuiStyle *self);
-extern void ImGuiStyle_SetIndentSpacing(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetIndentSpacing(ImGuiStyle *self);
-extern void ImGuiStyle_SetColumnsMinSpacing(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetColumnsMinSpacing(ImGuiStyle *self);
-extern void ImGuiStyle_SetScrollbarSize(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetScrollbarSize(ImGuiStyle *self);
-extern void ImGuiStyle_SetScrollbarRounding(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetScrollbarRounding(ImGuiStyle *self);
-extern void ImGuiStyle_SetGrabMinSize(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetGrabMinSize(ImGuiStyle *self);
-extern void ImGuiStyle_SetGrabRounding(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetGrabRounding(ImGuiStyle *self);
-extern void ImGuiStyle_SetLogSliderDeadzone(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetLogSliderDeadzone(ImGuiStyle *self);
-extern void ImGuiStyle_SetTabRounding(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetTabRounding(ImGuiStyle *self);
-extern void ImGuiStyle_SetTabBorderSize(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetTabBorderSize(ImGuiStyle *self);
-extern void ImGuiStyle_SetTabMinWidthForCloseButton(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetTabMinWidthForCloseButton(ImGuiStyle *self);
-extern void ImGuiStyle_SetColorButtonPosition(ImGuiStyle *ImGuiStylePtr, ImGuiDir v);
-extern ImGuiDir ImGuiStyle_GetColorButtonPosition(ImGuiStyle *self);
-extern void ImGuiStyle_SetButtonTextAlign(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetButtonTextAlign(ImGuiStyle *self);
-extern void ImGuiStyle_SetSelectableTextAlign(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetSelectableTextAlign(ImGuiStyle *self);
-extern void ImGuiStyle_SetDisplayWindowPadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetDisplayWindowPadding(ImGuiStyle *self);
-extern void ImGuiStyle_SetDisplaySafeAreaPadding(ImGuiStyle *ImGuiStylePtr, ImVec2 v);
-extern ImVec2 ImGuiStyle_GetDisplaySafeAreaPadding(ImGuiStyle *self);
-extern void ImGuiStyle_SetMouseCursorScale(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetMouseCursorScale(ImGuiStyle *self);
-extern void ImGuiStyle_SetAntiAliasedLines(ImGuiStyle *ImGuiStylePtr, bool v);
-extern bool ImGuiStyle_GetAntiAliasedLines(ImGuiStyle *self);
-extern void ImGuiStyle_SetAntiAliasedLinesUseTex(ImGuiStyle *ImGuiStylePtr, bool v);
-extern bool ImGuiStyle_GetAntiAliasedLinesUseTex(ImGuiStyle *self);
-extern void ImGuiStyle_SetAntiAliasedFill(ImGuiStyle *ImGuiStylePtr, bool v);
-extern bool ImGuiStyle_GetAntiAliasedFill(ImGuiStyle *self);
-extern void ImGuiStyle_SetCurveTessellationTol(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetCurveTessellationTol(ImGuiStyle *self);
-extern void ImGuiStyle_SetCircleTessellationMaxError(ImGuiStyle *ImGuiStylePtr, float v);
-extern float ImGuiStyle_GetCircleTessellationMaxError(ImGuiStyle *self);
-extern void ImGuiTabItem_SetID(ImGuiTabItem *ImGuiTabItemPtr, ImGuiID v);
-extern ImGuiID ImGuiTabItem_GetID(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetFlags(ImGuiTabItem *ImGuiTabItemPtr, ImGuiTabItemFlags v);
-extern ImGuiTabItemFlags ImGuiTabItem_GetFlags(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetWindow(ImGuiTabItem *ImGuiTabItemPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiTabItem_GetWindow(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetLastFrameVisible(ImGuiTabItem *ImGuiTabItemPtr, int v);
-extern int ImGuiTabItem_GetLastFrameVisible(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetLastFrameSelected(ImGuiTabItem *ImGuiTabItemPtr, int v);
-extern int ImGuiTabItem_GetLastFrameSelected(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetOffset(ImGuiTabItem *ImGuiTabItemPtr, float v);
-extern float ImGuiTabItem_GetOffset(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetWidth(ImGuiTabItem *ImGuiTabItemPtr, float v);
-extern float ImGuiTabItem_GetWidth(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetContentWidth(ImGuiTabItem *ImGuiTabItemPtr, float v);
-extern float ImGuiTabItem_GetContentWidth(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetRequestedWidth(ImGuiTabItem *ImGuiTabItemPtr, float v);
-extern float ImGuiTabItem_GetRequestedWidth(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetNameOffset(ImGuiTabItem *ImGuiTabItemPtr, ImS32 v);
-extern ImS32 ImGuiTabItem_GetNameOffset(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetBeginOrder(ImGuiTabItem *ImGuiTabItemPtr, ImS16 v);
-extern ImS16 ImGuiTabItem_GetBeginOrder(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetIndexDuringLayout(ImGuiTabItem *ImGuiTabItemPtr, ImS16 v);
-extern ImS16 ImGuiTabItem_GetIndexDuringLayout(ImGuiTabItem *self);
-extern void ImGuiTabItem_SetWantClose(ImGuiTabItem *ImGuiTabItemPtr, bool v);
-extern bool ImGuiTabItem_GetWantClose(ImGuiTabItem *self);
+extern void ImGuiTextFilter_SetFilters(ImGuiTextFilter *ImGuiTextFilterPtr, ImVector_ImGuiTextRange v);
+extern ImVector_ImGuiTextRange ImGuiTextFilter_GetFilters(ImGuiTextFilter *self);
+extern void ImGuiTextFilter_SetCountGrep(ImGuiTextFilter *ImGuiTextFilterPtr, int v);
+extern int ImGuiTextFilter_GetCountGrep(ImGuiTextFilter *self);
 extern void ImGuiTextRange_Setb(ImGuiTextRange *ImGuiTextRangePtr, const char* v);
 extern const char* ImGuiTextRange_Getb(ImGuiTextRange *self);
 extern void ImGuiTextRange_Sete(ImGuiTextRange *ImGuiTextRangePtr, const char* v);
 extern const char* ImGuiTextRange_Gete(ImGuiTextRange *self);
+extern void ImGuiTextBuffer_SetBuf(ImGuiTextBuffer *ImGuiTextBufferPtr, ImVector_char v);
+extern ImVector_char ImGuiTextBuffer_GetBuf(ImGuiTextBuffer *self);
+extern void ImGuiStorage_SetData(ImGuiStorage *ImGuiStoragePtr, ImVector_ImGuiStoragePair v);
+extern ImVector_ImGuiStoragePair ImGuiStorage_GetData(ImGuiStorage *self);
+extern void ImGuiStoragePair_Setkey(ImGuiStoragePair *ImGuiStoragePairPtr, ImGuiID v);
+extern ImGuiID ImGuiStoragePair_Getkey(ImGuiStoragePair *self);
+extern void ImGuiListClipper_SetDisplayStart(ImGuiListClipper *ImGuiListClipperPtr, int v);
+extern int ImGuiListClipper_GetDisplayStart(ImGuiListClipper *self);
+extern void ImGuiListClipper_SetDisplayEnd(ImGuiListClipper *ImGuiListClipperPtr, int v);
+extern int ImGuiListClipper_GetDisplayEnd(ImGuiListClipper *self);
+extern void ImGuiListClipper_SetItemsCount(ImGuiListClipper *ImGuiListClipperPtr, int v);
+extern int ImGuiListClipper_GetItemsCount(ImGuiListClipper *self);
+extern void ImGuiListClipper_SetItemsHeight(ImGuiListClipper *ImGuiListClipperPtr, float v);
+extern float ImGuiListClipper_GetItemsHeight(ImGuiListClipper *self);
+extern void ImGuiListClipper_SetStartPosY(ImGuiListClipper *ImGuiListClipperPtr, float v);
+extern float ImGuiListClipper_GetStartPosY(ImGuiListClipper *self);
+extern void ImGuiListClipper_SetTempData(ImGuiListClipper *ImGuiListClipperPtr, void* v);
+extern void* ImGuiListClipper_GetTempData(ImGuiListClipper *self);
+extern void ImColor_SetValue(ImColor *ImColorPtr, ImVec4 v);
+extern ImVec4 ImColor_GetValue(ImColor *self);
+extern void ImDrawCmd_SetClipRect(ImDrawCmd *ImDrawCmdPtr, ImVec4 v);
+extern ImVec4 ImDrawCmd_GetClipRect(ImDrawCmd *self);
+extern void ImDrawCmd_SetTextureId(ImDrawCmd *ImDrawCmdPtr, uintptr_t v);
+extern ImTextureID ImDrawCmd_GetTextureId(ImDrawCmd *self);
+extern void ImDrawCmd_SetVtxOffset(ImDrawCmd *ImDrawCmdPtr, unsigned int v);
+extern unsigned int ImDrawCmd_GetVtxOffset(ImDrawCmd *self);
+extern void ImDrawCmd_SetIdxOffset(ImDrawCmd *ImDrawCmdPtr, unsigned int v);
+extern unsigned int ImDrawCmd_GetIdxOffset(ImDrawCmd *self);
+extern void ImDrawCmd_SetElemCount(ImDrawCmd *ImDrawCmdPtr, unsigned int v);
+extern unsigned int ImDrawCmd_GetElemCount(ImDrawCmd *self);
+extern void ImDrawCmd_SetUserCallback(ImDrawCmd *ImDrawCmdPtr, ImDrawCallback v);
+extern ImDrawCallback ImDrawCmd_GetUserCallback(ImDrawCmd *self);
+extern void ImDrawCmd_SetUserCallbackData(ImDrawCmd *ImDrawCmdPtr, void* v);
+extern void* ImDrawCmd_GetUserCallbackData(ImDrawCmd *self);
+extern void ImDrawVert_Setpos(ImDrawVert *ImDrawVertPtr, ImVec2 v);
+extern ImVec2 ImDrawVert_Getpos(ImDrawVert *self);
+extern void ImDrawVert_Setuv(ImDrawVert *ImDrawVertPtr, ImVec2 v);
+extern ImVec2 ImDrawVert_Getuv(ImDrawVert *self);
+extern void ImDrawVert_Setcol(ImDrawVert *ImDrawVertPtr, ImU32 v);
+extern ImU32 ImDrawVert_Getcol(ImDrawVert *self);
 extern void ImDrawCmdHeader_SetClipRect(ImDrawCmdHeader *ImDrawCmdHeaderPtr, ImVec4 v);
 extern ImVec4 ImDrawCmdHeader_GetClipRect(ImDrawCmdHeader *self);
-extern void ImDrawCmdHeader_SetTextureId(ImDrawCmdHeader *ImDrawCmdHeaderPtr, ImTextureID v);
+extern void ImDrawCmdHeader_SetTextureId(ImDrawCmdHeader *ImDrawCmdHeaderPtr, uintptr_t v);
 extern ImTextureID ImDrawCmdHeader_GetTextureId(ImDrawCmdHeader *self);
 extern void ImDrawCmdHeader_SetVtxOffset(ImDrawCmdHeader *ImDrawCmdHeaderPtr, unsigned int v);
 extern unsigned int ImDrawCmdHeader_GetVtxOffset(ImDrawCmdHeader *self);
-extern void ImGuiViewport_SetID(ImGuiViewport *ImGuiViewportPtr, ImGuiID v);
-extern ImGuiID ImGuiViewport_GetID(ImGuiViewport *self);
-extern void ImGuiViewport_SetFlags(ImGuiViewport *ImGuiViewportPtr, ImGuiViewportFlags v);
-extern ImGuiViewportFlags ImGuiViewport_GetFlags(ImGuiViewport *self);
-extern void ImGuiViewport_SetPos(ImGuiViewport *ImGuiViewportPtr, ImVec2 v);
-extern ImVec2 ImGuiViewport_GetPos(ImGuiViewport *self);
-extern void ImGuiViewport_SetSize(ImGuiViewport *ImGuiViewportPtr, ImVec2 v);
-extern ImVec2 ImGuiViewport_GetSize(ImGuiViewport *self);
-extern void ImGuiViewport_SetWorkPos(ImGuiViewport *ImGuiViewportPtr, ImVec2 v);
-extern ImVec2 ImGuiViewport_GetWorkPos(ImGuiViewport *self);
-extern void ImGuiViewport_SetWorkSize(ImGuiViewport *ImGuiViewportPtr, ImVec2 v);
-extern ImVec2 ImGuiViewport_GetWorkSize(ImGuiViewport *self);
-extern void ImGuiViewport_SetDpiScale(ImGuiViewport *ImGuiViewportPtr, float v);
-extern float ImGuiViewport_GetDpiScale(ImGuiViewport *self);
-extern void ImGuiViewport_SetParentViewportId(ImGuiViewport *ImGuiViewportPtr, ImGuiID v);
-extern ImGuiID ImGuiViewport_GetParentViewportId(ImGuiViewport *self);
-extern void ImGuiViewport_SetDrawData(ImGuiViewport *ImGuiViewportPtr, ImDrawData* v);
-extern ImDrawData* ImGuiViewport_GetDrawData(ImGuiViewport *self);
-extern void ImGuiViewport_SetRendererUserData(ImGuiViewport *ImGuiViewportPtr, void* v);
-extern void* ImGuiViewport_GetRendererUserData(ImGuiViewport *self);
-extern void ImGuiViewport_SetPlatformUserData(ImGuiViewport *ImGuiViewportPtr, void* v);
-extern void* ImGuiViewport_GetPlatformUserData(ImGuiViewport *self);
-extern void ImGuiViewport_SetPlatformHandle(ImGuiViewport *ImGuiViewportPtr, void* v);
-extern void* ImGuiViewport_GetPlatformHandle(ImGuiViewport *self);
-extern void ImGuiViewport_SetPlatformHandleRaw(ImGuiViewport *ImGuiViewportPtr, void* v);
-extern void* ImGuiViewport_GetPlatformHandleRaw(ImGuiViewport *self);
-extern void ImGuiViewport_SetPlatformRequestMove(ImGuiViewport *ImGuiViewportPtr, bool v);
-extern bool ImGuiViewport_GetPlatformRequestMove(ImGuiViewport *self);
-extern void ImGuiViewport_SetPlatformRequestResize(ImGuiViewport *ImGuiViewportPtr, bool v);
-extern bool ImGuiViewport_GetPlatformRequestResize(ImGuiViewport *self);
-extern void ImGuiViewport_SetPlatformRequestClose(ImGuiViewport *ImGuiViewportPtr, bool v);
-extern bool ImGuiViewport_GetPlatformRequestClose(ImGuiViewport *self);
-extern void ImGuiTableSortSpecs_SetSpecs(ImGuiTableSortSpecs *ImGuiTableSortSpecsPtr, const ImGuiTableColumnSortSpecs* v);
-extern const ImGuiTableColumnSortSpecs* ImGuiTableSortSpecs_GetSpecs(ImGuiTableSortSpecs *self);
-extern void ImGuiTableSortSpecs_SetSpecsCount(ImGuiTableSortSpecs *ImGuiTableSortSpecsPtr, int v);
-extern int ImGuiTableSortSpecs_GetSpecsCount(ImGuiTableSortSpecs *self);
-extern void ImGuiTableSortSpecs_SetSpecsDirty(ImGuiTableSortSpecs *ImGuiTableSortSpecsPtr, bool v);
-extern bool ImGuiTableSortSpecs_GetSpecsDirty(ImGuiTableSortSpecs *self);
-extern void StbTexteditRow_Setx0(StbTexteditRow *StbTexteditRowPtr, float v);
-extern float StbTexteditRow_Getx0(StbTexteditRow *self);
-extern void StbTexteditRow_Setx1(StbTexteditRow *StbTexteditRowPtr, float v);
-extern float StbTexteditRow_Getx1(StbTexteditRow *self);
-extern void StbTexteditRow_Setbaseline_y_delta(StbTexteditRow *StbTexteditRowPtr, float v);
-extern float StbTexteditRow_Getbaseline_y_delta(StbTexteditRow *self);
-extern void StbTexteditRow_Setymin(StbTexteditRow *StbTexteditRowPtr, float v);
-extern float StbTexteditRow_Getymin(StbTexteditRow *self);
-extern void StbTexteditRow_Setymax(StbTexteditRow *StbTexteditRowPtr, float v);
-extern float StbTexteditRow_Getymax(StbTexteditRow *self);
-extern void StbTexteditRow_Setnum_chars(StbTexteditRow *StbTexteditRowPtr, int v);
-extern int StbTexteditRow_Getnum_chars(StbTexteditRow *self);
+extern void ImDrawChannel_Set_CmdBuffer(ImDrawChannel *ImDrawChannelPtr, ImVector_ImDrawCmd v);
+extern ImVector_ImDrawCmd ImDrawChannel_Get_CmdBuffer(ImDrawChannel *self);
+extern void ImDrawChannel_Set_IdxBuffer(ImDrawChannel *ImDrawChannelPtr, ImVector_ImDrawIdx v);
+extern ImVector_ImDrawIdx ImDrawChannel_Get_IdxBuffer(ImDrawChannel *self);
+extern void ImDrawListSplitter_Set_Current(ImDrawListSplitter *ImDrawListSplitterPtr, int v);
+extern int ImDrawListSplitter_Get_Current(ImDrawListSplitter *self);
+extern void ImDrawListSplitter_Set_Count(ImDrawListSplitter *ImDrawListSplitterPtr, int v);
+extern int ImDrawListSplitter_Get_Count(ImDrawListSplitter *self);
+extern void ImDrawListSplitter_Set_Channels(ImDrawListSplitter *ImDrawListSplitterPtr, ImVector_ImDrawChannel v);
+extern ImVector_ImDrawChannel ImDrawListSplitter_Get_Channels(ImDrawListSplitter *self);
+extern void ImDrawList_SetCmdBuffer(ImDrawList *ImDrawListPtr, ImVector_ImDrawCmd v);
+extern ImVector_ImDrawCmd ImDrawList_GetCmdBuffer(ImDrawList *self);
+extern void ImDrawList_SetIdxBuffer(ImDrawList *ImDrawListPtr, ImVector_ImDrawIdx v);
+extern ImVector_ImDrawIdx ImDrawList_GetIdxBuffer(ImDrawList *self);
+extern void ImDrawList_SetVtxBuffer(ImDrawList *ImDrawListPtr, ImVector_ImDrawVert v);
+extern ImVector_ImDrawVert ImDrawList_GetVtxBuffer(ImDrawList *self);
+extern void ImDrawList_SetFlags(ImDrawList *ImDrawListPtr, ImDrawListFlags v);
+extern ImDrawListFlags ImDrawList_GetFlags(ImDrawList *self);
+extern void ImDrawList_Set_VtxCurrentIdx(ImDrawList *ImDrawListPtr, unsigned int v);
+extern unsigned int ImDrawList_Get_VtxCurrentIdx(ImDrawList *self);
+extern void ImDrawList_Set_Data(ImDrawList *ImDrawListPtr, const ImDrawListSharedData* v);
+extern const ImDrawListSharedData* ImDrawList_Get_Data(ImDrawList *self);
+extern void ImDrawList_Set_OwnerName(ImDrawList *ImDrawListPtr, const char* v);
+extern const char* ImDrawList_Get_OwnerName(ImDrawList *self);
+extern void ImDrawList_Set_VtxWritePtr(ImDrawList *ImDrawListPtr, ImDrawVert* v);
+extern ImDrawVert* ImDrawList_Get_VtxWritePtr(ImDrawList *self);
+extern void ImDrawList_Set_IdxWritePtr(ImDrawList *ImDrawListPtr, ImDrawIdx* v);
+extern ImDrawIdx* ImDrawList_Get_IdxWritePtr(ImDrawList *self);
+extern void ImDrawList_Set_ClipRectStack(ImDrawList *ImDrawListPtr, ImVector_ImVec4 v);
+extern ImVector_ImVec4 ImDrawList_Get_ClipRectStack(ImDrawList *self);
+extern void ImDrawList_Set_TextureIdStack(ImDrawList *ImDrawListPtr, ImVector_ImTextureID v);
+extern ImVector_ImTextureID ImDrawList_Get_TextureIdStack(ImDrawList *self);
+extern void ImDrawList_Set_Path(ImDrawList *ImDrawListPtr, ImVector_ImVec2 v);
+extern ImVector_ImVec2 ImDrawList_Get_Path(ImDrawList *self);
+extern void ImDrawList_Set_CmdHeader(ImDrawList *ImDrawListPtr, ImDrawCmdHeader v);
+extern ImDrawCmdHeader ImDrawList_Get_CmdHeader(ImDrawList *self);
+extern void ImDrawList_Set_Splitter(ImDrawList *ImDrawListPtr, ImDrawListSplitter v);
+extern ImDrawListSplitter ImDrawList_Get_Splitter(ImDrawList *self);
+extern void ImDrawList_Set_FringeScale(ImDrawList *ImDrawListPtr, float v);
+extern float ImDrawList_Get_FringeScale(ImDrawList *self);
+extern void ImDrawData_SetValid(ImDrawData *ImDrawDataPtr, bool v);
+extern bool ImDrawData_GetValid(ImDrawData *self);
+extern void ImDrawData_SetCmdListsCount(ImDrawData *ImDrawDataPtr, int v);
+extern int ImDrawData_GetCmdListsCount(ImDrawData *self);
+extern void ImDrawData_SetTotalIdxCount(ImDrawData *ImDrawDataPtr, int v);
+extern int ImDrawData_GetTotalIdxCount(ImDrawData *self);
+extern void ImDrawData_SetTotalVtxCount(ImDrawData *ImDrawDataPtr, int v);
+extern int ImDrawData_GetTotalVtxCount(ImDrawData *self);
+extern void ImDrawData_SetCmdLists(ImDrawData *ImDrawDataPtr, ImDrawList** v);
+extern ImDrawList** ImDrawData_GetCmdLists(ImDrawData *self);
+extern void ImDrawData_SetDisplayPos(ImDrawData *ImDrawDataPtr, ImVec2 v);
+extern ImVec2 ImDrawData_GetDisplayPos(ImDrawData *self);
+extern void ImDrawData_SetDisplaySize(ImDrawData *ImDrawDataPtr, ImVec2 v);
+extern ImVec2 ImDrawData_GetDisplaySize(ImDrawData *self);
+extern void ImDrawData_SetFramebufferScale(ImDrawData *ImDrawDataPtr, ImVec2 v);
+extern ImVec2 ImDrawData_GetFramebufferScale(ImDrawData *self);
+extern void ImDrawData_SetOwnerViewport(ImDrawData *ImDrawDataPtr, ImGuiViewport* v);
+extern ImGuiViewport* ImDrawData_GetOwnerViewport(ImDrawData *self);
+extern void ImFontConfig_SetFontData(ImFontConfig *ImFontConfigPtr, void* v);
+extern void* ImFontConfig_GetFontData(ImFontConfig *self);
+extern void ImFontConfig_SetFontDataSize(ImFontConfig *ImFontConfigPtr, int v);
+extern int ImFontConfig_GetFontDataSize(ImFontConfig *self);
+extern void ImFontConfig_SetFontDataOwnedByAtlas(ImFontConfig *ImFontConfigPtr, bool v);
+extern bool ImFontConfig_GetFontDataOwnedByAtlas(ImFontConfig *self);
+extern void ImFontConfig_SetFontNo(ImFontConfig *ImFontConfigPtr, int v);
+extern int ImFontConfig_GetFontNo(ImFontConfig *self);
+extern void ImFontConfig_SetSizePixels(ImFontConfig *ImFontConfigPtr, float v);
+extern float ImFontConfig_GetSizePixels(ImFontConfig *self);
+extern void ImFontConfig_SetOversampleH(ImFontConfig *ImFontConfigPtr, int v);
+extern int ImFontConfig_GetOversampleH(ImFontConfig *self);
+extern void ImFontConfig_SetOversampleV(ImFontConfig *ImFontConfigPtr, int v);
+extern int ImFontConfig_GetOversampleV(ImFontConfig *self);
+extern void ImFontConfig_SetPixelSnapH(ImFontConfig *ImFontConfigPtr, bool v);
+extern bool ImFontConfig_GetPixelSnapH(ImFontConfig *self);
+extern void ImFontConfig_SetGlyphExtraSpacing(ImFontConfig *ImFontConfigPtr, ImVec2 v);
+extern ImVec2 ImFontConfig_GetGlyphExtraSpacing(ImFontConfig *self);
+extern void ImFontConfig_SetGlyphOffset(ImFontConfig *ImFontConfigPtr, ImVec2 v);
+extern ImVec2 ImFontConfig_GetGlyphOffset(ImFontConfig *self);
+extern void ImFontConfig_SetGlyphRanges(ImFontConfig *ImFontConfigPtr, const ImWchar* v);
+extern const ImWchar* ImFontConfig_GetGlyphRanges(ImFontConfig *self);
+extern void ImFontConfig_SetGlyphMinAdvanceX(ImFontConfig *ImFontConfigPtr, float v);
+extern float ImFontConfig_GetGlyphMinAdvanceX(ImFontConfig *self);
+extern void ImFontConfig_SetGlyphMaxAdvanceX(ImFontConfig *ImFontConfigPtr, float v);
+extern float ImFontConfig_GetGlyphMaxAdvanceX(ImFontConfig *self);
+extern void ImFontConfig_SetMergeMode(ImFontConfig *ImFontConfigPtr, bool v);
+extern bool ImFontConfig_GetMergeMode(ImFontConfig *self);
+extern void ImFontConfig_SetFontBuilderFlags(ImFontConfig *ImFontConfigPtr, unsigned int v);
+extern unsigned int ImFontConfig_GetFontBuilderFlags(ImFontConfig *self);
+extern void ImFontConfig_SetRasterizerMultiply(ImFontConfig *ImFontConfigPtr, float v);
+extern float ImFontConfig_GetRasterizerMultiply(ImFontConfig *self);
+extern void ImFontConfig_SetEllipsisChar(ImFontConfig *ImFontConfigPtr, ImWchar v);
+extern ImWchar ImFontConfig_GetEllipsisChar(ImFontConfig *self);
+extern void ImFontConfig_SetDstFont(ImFontConfig *ImFontConfigPtr, ImFont* v);
+extern ImFont* ImFontConfig_GetDstFont(ImFontConfig *self);
+extern void ImFontGlyph_SetColored(ImFontGlyph *ImFontGlyphPtr, unsigned int v);
+extern unsigned int ImFontGlyph_GetColored(ImFontGlyph *self);
+extern void ImFontGlyph_SetVisible(ImFontGlyph *ImFontGlyphPtr, unsigned int v);
+extern unsigned int ImFontGlyph_GetVisible(ImFontGlyph *self);
+extern void ImFontGlyph_SetCodepoint(ImFontGlyph *ImFontGlyphPtr, unsigned int v);
+extern unsigned int ImFontGlyph_GetCodepoint(ImFontGlyph *self);
+extern void ImFontGlyph_SetAdvanceX(ImFontGlyph *ImFontGlyphPtr, float v);
+extern float ImFontGlyph_GetAdvanceX(ImFontGlyph *self);
+extern void ImFontGlyph_SetX0(ImFontGlyph *ImFontGlyphPtr, float v);
+extern float ImFontGlyph_GetX0(ImFontGlyph *self);
+extern void ImFontGlyph_SetY0(ImFontGlyph *ImFontGlyphPtr, float v);
+extern float ImFontGlyph_GetY0(ImFontGlyph *self);
+extern void ImFontGlyph_SetX1(ImFontGlyph *ImFontGlyphPtr, float v);
+extern float ImFontGlyph_GetX1(ImFontGlyph *self);
+extern void ImFontGlyph_SetY1(ImFontGlyph *ImFontGlyphPtr, float v);
+extern float ImFontGlyph_GetY1(ImFontGlyph *self);
+extern void ImFontGlyph_SetU0(ImFontGlyph *ImFontGlyphPtr, float v);
+extern float ImFontGlyph_GetU0(ImFontGlyph *self);
+extern void ImFontGlyph_SetV0(ImFontGlyph *ImFontGlyphPtr, float v);
+extern float ImFontGlyph_GetV0(ImFontGlyph *self);
+extern void ImFontGlyph_SetU1(ImFontGlyph *ImFontGlyphPtr, float v);
+extern float ImFontGlyph_GetU1(ImFontGlyph *self);
+extern void ImFontGlyph_SetV1(ImFontGlyph *ImFontGlyphPtr, float v);
+extern float ImFontGlyph_GetV1(ImFontGlyph *self);
+extern void ImFontGlyphRangesBuilder_SetUsedChars(ImFontGlyphRangesBuilder *ImFontGlyphRangesBuilderPtr, ImVector_ImU32 v);
+extern ImVector_ImU32 ImFontGlyphRangesBuilder_GetUsedChars(ImFontGlyphRangesBuilder *self);
+extern void ImFontAtlasCustomRect_SetWidth(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, unsigned short v);
+extern unsigned short ImFontAtlasCustomRect_GetWidth(ImFontAtlasCustomRect *self);
+extern void ImFontAtlasCustomRect_SetHeight(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, unsigned short v);
+extern unsigned short ImFontAtlasCustomRect_GetHeight(ImFontAtlasCustomRect *self);
+extern void ImFontAtlasCustomRect_SetX(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, unsigned short v);
+extern unsigned short ImFontAtlasCustomRect_GetX(ImFontAtlasCustomRect *self);
+extern void ImFontAtlasCustomRect_SetY(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, unsigned short v);
+extern unsigned short ImFontAtlasCustomRect_GetY(ImFontAtlasCustomRect *self);
+extern void ImFontAtlasCustomRect_SetGlyphID(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, unsigned int v);
+extern unsigned int ImFontAtlasCustomRect_GetGlyphID(ImFontAtlasCustomRect *self);
+extern void ImFontAtlasCustomRect_SetGlyphAdvanceX(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, float v);
+extern float ImFontAtlasCustomRect_GetGlyphAdvanceX(ImFontAtlasCustomRect *self);
+extern void ImFontAtlasCustomRect_SetGlyphOffset(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, ImVec2 v);
+extern ImVec2 ImFontAtlasCustomRect_GetGlyphOffset(ImFontAtlasCustomRect *self);
+extern void ImFontAtlasCustomRect_SetFont(ImFontAtlasCustomRect *ImFontAtlasCustomRectPtr, ImFont* v);
+extern ImFont* ImFontAtlasCustomRect_GetFont(ImFontAtlasCustomRect *self);
 extern void ImFontAtlas_SetFlags(ImFontAtlas *ImFontAtlasPtr, ImFontAtlasFlags v);
 extern ImFontAtlasFlags ImFontAtlas_GetFlags(ImFontAtlas *self);
 extern void ImFontAtlas_SetTexDesiredWidth(ImFontAtlas *ImFontAtlasPtr, int v);
@@ -1794,160 +544,278 @@ extern void ImFontAtlas_SetFontBuilderFlags(ImFontAtlas *ImFontAtlasPtr, unsigne
 extern unsigned int ImFontAtlas_GetFontBuilderFlags(ImFontAtlas *self);
 extern void ImFontAtlas_SetPackIdMouseCursors(ImFontAtlas *ImFontAtlasPtr, int v);
 extern int ImFontAtlas_GetPackIdMouseCursors(ImFontAtlas *self);
-extern void ImFontAtlas_SetPackIdLines(ImFontAtlas *ImFontAtlasPtr, int v);
-extern int ImFontAtlas_GetPackIdLines(ImFontAtlas *self);
-extern void ImGuiIO_SetConfigFlags(ImGuiIO *ImGuiIOPtr, ImGuiConfigFlags v);
-extern ImGuiConfigFlags ImGuiIO_GetConfigFlags(ImGuiIO *self);
-extern void ImGuiIO_SetBackendFlags(ImGuiIO *ImGuiIOPtr, ImGuiBackendFlags v);
-extern ImGuiBackendFlags ImGuiIO_GetBackendFlags(ImGuiIO *self);
-extern void ImGuiIO_SetDisplaySize(ImGuiIO *ImGuiIOPtr, ImVec2 v);
-extern ImVec2 ImGuiIO_GetDisplaySize(ImGuiIO *self);
-extern void ImGuiIO_SetDeltaTime(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetDeltaTime(ImGuiIO *self);
-extern void ImGuiIO_SetIniSavingRate(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetIniSavingRate(ImGuiIO *self);
-extern void ImGuiIO_SetIniFilename(ImGuiIO *ImGuiIOPtr, const char* v);
-extern const char* ImGuiIO_GetIniFilename(ImGuiIO *self);
-extern void ImGuiIO_SetLogFilename(ImGuiIO *ImGuiIOPtr, const char* v);
-extern const char* ImGuiIO_GetLogFilename(ImGuiIO *self);
-extern void ImGuiIO_SetMouseDoubleClickTime(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetMouseDoubleClickTime(ImGuiIO *self);
-extern void ImGuiIO_SetMouseDoubleClickMaxDist(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetMouseDoubleClickMaxDist(ImGuiIO *self);
-extern void ImGuiIO_SetMouseDragThreshold(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetMouseDragThreshold(ImGuiIO *self);
-extern void ImGuiIO_SetKeyRepeatDelay(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetKeyRepeatDelay(ImGuiIO *self);
-extern void ImGuiIO_SetKeyRepeatRate(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetKeyRepeatRate(ImGuiIO *self);
-extern void ImGuiIO_SetUserData(ImGuiIO *ImGuiIOPtr, void* v);
-extern void* ImGuiIO_GetUserData(ImGuiIO *self);
-extern void ImGuiIO_SetFonts(ImGuiIO *ImGuiIOPtr, ImFontAtlas* v);
-extern ImFontAtlas* ImGuiIO_GetFonts(ImGuiIO *self);
-extern void ImGuiIO_SetFontGlobalScale(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetFontGlobalScale(ImGuiIO *self);
-extern void ImGuiIO_SetFontAllowUserScaling(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetFontAllowUserScaling(ImGuiIO *self);
-extern void ImGuiIO_SetFontDefault(ImGuiIO *ImGuiIOPtr, ImFont* v);
-extern ImFont* ImGuiIO_GetFontDefault(ImGuiIO *self);
-extern void ImGuiIO_SetDisplayFramebufferScale(ImGuiIO *ImGuiIOPtr, ImVec2 v);
-extern ImVec2 ImGuiIO_GetDisplayFramebufferScale(ImGuiIO *self);
-extern void ImGuiIO_SetConfigDockingNoSplit(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigDockingNoSplit(ImGuiIO *self);
-extern void ImGuiIO_SetConfigDockingWithShift(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigDockingWithShift(ImGuiIO *self);
-extern void ImGuiIO_SetConfigDockingAlwaysTabBar(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigDockingAlwaysTabBar(ImGuiIO *self);
-extern void ImGuiIO_SetConfigDockingTransparentPayload(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigDockingTransparentPayload(ImGuiIO *self);
-extern void ImGuiIO_SetConfigViewportsNoAutoMerge(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigViewportsNoAutoMerge(ImGuiIO *self);
-extern void ImGuiIO_SetConfigViewportsNoTaskBarIcon(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigViewportsNoTaskBarIcon(ImGuiIO *self);
-extern void ImGuiIO_SetConfigViewportsNoDecoration(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigViewportsNoDecoration(ImGuiIO *self);
-extern void ImGuiIO_SetConfigViewportsNoDefaultParent(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigViewportsNoDefaultParent(ImGuiIO *self);
-extern void ImGuiIO_SetMouseDrawCursor(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetMouseDrawCursor(ImGuiIO *self);
-extern void ImGuiIO_SetConfigMacOSXBehaviors(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigMacOSXBehaviors(ImGuiIO *self);
-extern void ImGuiIO_SetConfigInputTrickleEventQueue(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigInputTrickleEventQueue(ImGuiIO *self);
-extern void ImGuiIO_SetConfigInputTextCursorBlink(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigInputTextCursorBlink(ImGuiIO *self);
-extern void ImGuiIO_SetConfigInputTextEnterKeepActive(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigInputTextEnterKeepActive(ImGuiIO *self);
-extern void ImGuiIO_SetConfigDragClickToInputText(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigDragClickToInputText(ImGuiIO *self);
-extern void ImGuiIO_SetConfigWindowsResizeFromEdges(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigWindowsResizeFromEdges(ImGuiIO *self);
-extern void ImGuiIO_SetConfigWindowsMoveFromTitleBarOnly(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetConfigWindowsMoveFromTitleBarOnly(ImGuiIO *self);
-extern void ImGuiIO_SetConfigMemoryCompactTimer(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetConfigMemoryCompactTimer(ImGuiIO *self);
-extern void ImGuiIO_SetBackendPlatformName(ImGuiIO *ImGuiIOPtr, const char* v);
-extern const char* ImGuiIO_GetBackendPlatformName(ImGuiIO *self);
-extern void ImGuiIO_SetBackendRendererName(ImGuiIO *ImGuiIOPtr, const char* v);
-extern const char* ImGuiIO_GetBackendRendererName(ImGuiIO *self);
-extern void ImGuiIO_SetBackendPlatformUserData(ImGuiIO *ImGuiIOPtr, void* v);
-extern void* ImGuiIO_GetBackendPlatformUserData(ImGuiIO *self);
-extern void ImGuiIO_SetBackendRendererUserData(ImGuiIO *ImGuiIOPtr, void* v);
-extern void* ImGuiIO_GetBackendRendererUserData(ImGuiIO *self);
-extern void ImGuiIO_SetBackendLanguageUserData(ImGuiIO *ImGuiIOPtr, void* v);
-extern void* ImGuiIO_GetBackendLanguageUserData(ImGuiIO *self);
-extern void ImGuiIO_SetClipboardUserData(ImGuiIO *ImGuiIOPtr, void* v);
-extern void* ImGuiIO_GetClipboardUserData(ImGuiIO *self);
-extern void ImGuiIO_Set_UnusedPadding(ImGuiIO *ImGuiIOPtr, void* v);
-extern void* ImGuiIO_Get_UnusedPadding(ImGuiIO *self);
-extern void ImGuiIO_SetWantCaptureMouse(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetWantCaptureMouse(ImGuiIO *self);
-extern void ImGuiIO_SetWantCaptureKeyboard(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetWantCaptureKeyboard(ImGuiIO *self);
-extern void ImGuiIO_SetWantTextInput(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetWantTextInput(ImGuiIO *self);
-extern void ImGuiIO_SetWantSetMousePos(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetWantSetMousePos(ImGuiIO *self);
-extern void ImGuiIO_SetWantSaveIniSettings(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetWantSaveIniSettings(ImGuiIO *self);
-extern void ImGuiIO_SetNavActive(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetNavActive(ImGuiIO *self);
-extern void ImGuiIO_SetNavVisible(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetNavVisible(ImGuiIO *self);
-extern void ImGuiIO_SetFramerate(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetFramerate(ImGuiIO *self);
-extern void ImGuiIO_SetMetricsRenderVertices(ImGuiIO *ImGuiIOPtr, int v);
-extern int ImGuiIO_GetMetricsRenderVertices(ImGuiIO *self);
-extern void ImGuiIO_SetMetricsRenderIndices(ImGuiIO *ImGuiIOPtr, int v);
-extern int ImGuiIO_GetMetricsRenderIndices(ImGuiIO *self);
-extern void ImGuiIO_SetMetricsRenderWindows(ImGuiIO *ImGuiIOPtr, int v);
-extern int ImGuiIO_GetMetricsRenderWindows(ImGuiIO *self);
-extern void ImGuiIO_SetMetricsActiveWindows(ImGuiIO *ImGuiIOPtr, int v);
-extern int ImGuiIO_GetMetricsActiveWindows(ImGuiIO *self);
-extern void ImGuiIO_SetMetricsActiveAllocations(ImGuiIO *ImGuiIOPtr, int v);
-extern int ImGuiIO_GetMetricsActiveAllocations(ImGuiIO *self);
-extern void ImGuiIO_SetMouseDelta(ImGuiIO *ImGuiIOPtr, ImVec2 v);
-extern ImVec2 ImGuiIO_GetMouseDelta(ImGuiIO *self);
-extern void ImGuiIO_SetMousePos(ImGuiIO *ImGuiIOPtr, ImVec2 v);
-extern ImVec2 ImGuiIO_GetMousePos(ImGuiIO *self);
-extern void ImGuiIO_SetMouseWheel(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetMouseWheel(ImGuiIO *self);
-extern void ImGuiIO_SetMouseWheelH(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetMouseWheelH(ImGuiIO *self);
-extern void ImGuiIO_SetMouseHoveredViewport(ImGuiIO *ImGuiIOPtr, ImGuiID v);
-extern ImGuiID ImGuiIO_GetMouseHoveredViewport(ImGuiIO *self);
-extern void ImGuiIO_SetKeyCtrl(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetKeyCtrl(ImGuiIO *self);
-extern void ImGuiIO_SetKeyShift(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetKeyShift(ImGuiIO *self);
-extern void ImGuiIO_SetKeyAlt(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetKeyAlt(ImGuiIO *self);
-extern void ImGuiIO_SetKeySuper(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetKeySuper(ImGuiIO *self);
-extern void ImGuiIO_SetKeyMods(ImGuiIO *ImGuiIOPtr, ImGuiModFlags v);
-extern ImGuiModFlags ImGuiIO_GetKeyMods(ImGuiIO *self);
-extern void ImGuiIO_SetWantCaptureMouseUnlessPopupClose(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetWantCaptureMouseUnlessPopupClose(ImGuiIO *self);
-extern void ImGuiIO_SetMousePosPrev(ImGuiIO *ImGuiIOPtr, ImVec2 v);
-extern ImVec2 ImGuiIO_GetMousePosPrev(ImGuiIO *self);
-extern void ImGuiIO_SetPenPressure(ImGuiIO *ImGuiIOPtr, float v);
-extern float ImGuiIO_GetPenPressure(ImGuiIO *self);
-extern void ImGuiIO_SetAppFocusLost(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetAppFocusLost(ImGuiIO *self);
-extern void ImGuiIO_SetBackendUsingLegacyKeyArrays(ImGuiIO *ImGuiIOPtr, ImS8 v);
-extern ImS8 ImGuiIO_GetBackendUsingLegacyKeyArrays(ImGuiIO *self);
-extern void ImGuiIO_SetBackendUsingLegacyNavInputArray(ImGuiIO *ImGuiIOPtr, bool v);
-extern bool ImGuiIO_GetBackendUsingLegacyNavInputArray(ImGuiIO *self);
-extern void ImGuiIO_SetInputQueueSurrogate(ImGuiIO *ImGuiIOPtr, ImWchar16 v);
-extern ImWchar16 ImGuiIO_GetInputQueueSurrogate(ImGuiIO *self);
-extern void ImGuiIO_SetInputQueueCharacters(ImGuiIO *ImGuiIOPtr, ImVector_ImWchar v);
-extern ImVector_ImWchar ImGuiIO_GetInputQueueCharacters(ImGuiIO *self);
-extern void ImGuiInputEventKey_SetKey(ImGuiInputEventKey *ImGuiInputEventKeyPtr, ImGuiKey v);
-extern ImGuiKey ImGuiInputEventKey_GetKey(ImGuiInputEventKey *self);
-extern void ImGuiInputEventKey_SetDown(ImGuiInputEventKey *ImGuiInputEventKeyPtr, bool v);
-extern bool ImGuiInputEventKey_GetDown(ImGuiInputEventKey *self);
-extern void ImGuiInputEventKey_SetAnalogValue(ImGuiInputEventKey *ImGuiInputEventKeyPtr, float v);
-extern float ImGuiInputEventKey_GetAnalogValue(ImGuiInputEventKey *self);
+extern void ImFontAtlas_SetPackIdLines(ImFontAtlas *ImFontAtlasPtr, int v);
+extern int ImFontAtlas_GetPackIdLines(ImFontAtlas *self);
+extern void ImFont_SetIndexAdvanceX(ImFont *ImFontPtr, ImVector_float v);
+extern ImVector_float ImFont_GetIndexAdvanceX(ImFont *self);
+extern void ImFont_SetFallbackAdvanceX(ImFont *ImFontPtr, float v);
+extern float ImFont_GetFallbackAdvanceX(ImFont *self);
+extern void ImFont_SetFontSize(ImFont *ImFontPtr, float v);
+extern float ImFont_GetFontSize(ImFont *self);
+extern void ImFont_SetIndexLookup(ImFont *ImFontPtr, ImVector_ImWchar v);
+extern ImVector_ImWchar ImFont_GetIndexLookup(ImFont *self);
+extern void ImFont_SetGlyphs(ImFont *ImFontPtr, ImVector_ImFontGlyph v);
+extern ImVector_ImFontGlyph ImFont_GetGlyphs(ImFont *self);
+extern void ImFont_SetFallbackGlyph(ImFont *ImFontPtr, const ImFontGlyph* v);
+extern const ImFontGlyph* ImFont_GetFallbackGlyph(ImFont *self);
+extern void ImFont_SetContainerAtlas(ImFont *ImFontPtr, ImFontAtlas* v);
+extern ImFontAtlas* ImFont_GetContainerAtlas(ImFont *self);
+extern void ImFont_SetConfigData(ImFont *ImFontPtr, const ImFontConfig* v);
+extern const ImFontConfig* ImFont_GetConfigData(ImFont *self);
+extern void ImFont_SetConfigDataCount(ImFont *ImFontPtr, short v);
+extern short ImFont_GetConfigDataCount(ImFont *self);
+extern void ImFont_SetFallbackChar(ImFont *ImFontPtr, ImWchar v);
+extern ImWchar ImFont_GetFallbackChar(ImFont *self);
+extern void ImFont_SetEllipsisChar(ImFont *ImFontPtr, ImWchar v);
+extern ImWchar ImFont_GetEllipsisChar(ImFont *self);
+extern void ImFont_SetDotChar(ImFont *ImFontPtr, ImWchar v);
+extern ImWchar ImFont_GetDotChar(ImFont *self);
+extern void ImFont_SetDirtyLookupTables(ImFont *ImFontPtr, bool v);
+extern bool ImFont_GetDirtyLookupTables(ImFont *self);
+extern void ImFont_SetScale(ImFont *ImFontPtr, float v);
+extern float ImFont_GetScale(ImFont *self);
+extern void ImFont_SetAscent(ImFont *ImFontPtr, float v);
+extern float ImFont_GetAscent(ImFont *self);
+extern void ImFont_SetDescent(ImFont *ImFontPtr, float v);
+extern float ImFont_GetDescent(ImFont *self);
+extern void ImFont_SetMetricsTotalSurface(ImFont *ImFontPtr, int v);
+extern int ImFont_GetMetricsTotalSurface(ImFont *self);
+extern void ImGuiViewport_SetID(ImGuiViewport *ImGuiViewportPtr, ImGuiID v);
+extern ImGuiID ImGuiViewport_GetID(ImGuiViewport *self);
+extern void ImGuiViewport_SetFlags(ImGuiViewport *ImGuiViewportPtr, ImGuiViewportFlags v);
+extern ImGuiViewportFlags ImGuiViewport_GetFlags(ImGuiViewport *self);
+extern void ImGuiViewport_SetPos(ImGuiViewport *ImGuiViewportPtr, ImVec2 v);
+extern ImVec2 ImGuiViewport_GetPos(ImGuiViewport *self);
+extern void ImGuiViewport_SetSize(ImGuiViewport *ImGuiViewportPtr, ImVec2 v);
+extern ImVec2 ImGuiViewport_GetSize(ImGuiViewport *self);
+extern void ImGuiViewport_SetWorkPos(ImGuiViewport *ImGuiViewportPtr, ImVec2 v);
+extern ImVec2 ImGuiViewport_GetWorkPos(ImGuiViewport *self);
+extern void ImGuiViewport_SetWorkSize(ImGuiViewport *ImGuiViewportPtr, ImVec2 v);
+extern ImVec2 ImGuiViewport_GetWorkSize(ImGuiViewport *self);
+extern void ImGuiViewport_SetDpiScale(ImGuiViewport *ImGuiViewportPtr, float v);
+extern float ImGuiViewport_GetDpiScale(ImGuiViewport *self);
+extern void ImGuiViewport_SetParentViewportId(ImGuiViewport *ImGuiViewportPtr, ImGuiID v);
+extern ImGuiID ImGuiViewport_GetParentViewportId(ImGuiViewport *self);
+extern void ImGuiViewport_SetDrawData(ImGuiViewport *ImGuiViewportPtr, ImDrawData* v);
+extern ImDrawData* ImGuiViewport_GetDrawData(ImGuiViewport *self);
+extern void ImGuiViewport_SetRendererUserData(ImGuiViewport *ImGuiViewportPtr, void* v);
+extern void* ImGuiViewport_GetRendererUserData(ImGuiViewport *self);
+extern void ImGuiViewport_SetPlatformUserData(ImGuiViewport *ImGuiViewportPtr, void* v);
+extern void* ImGuiViewport_GetPlatformUserData(ImGuiViewport *self);
+extern void ImGuiViewport_SetPlatformHandle(ImGuiViewport *ImGuiViewportPtr, void* v);
+extern void* ImGuiViewport_GetPlatformHandle(ImGuiViewport *self);
+extern void ImGuiViewport_SetPlatformHandleRaw(ImGuiViewport *ImGuiViewportPtr, void* v);
+extern void* ImGuiViewport_GetPlatformHandleRaw(ImGuiViewport *self);
+extern void ImGuiViewport_SetPlatformRequestMove(ImGuiViewport *ImGuiViewportPtr, bool v);
+extern bool ImGuiViewport_GetPlatformRequestMove(ImGuiViewport *self);
+extern void ImGuiViewport_SetPlatformRequestResize(ImGuiViewport *ImGuiViewportPtr, bool v);
+extern bool ImGuiViewport_GetPlatformRequestResize(ImGuiViewport *self);
+extern void ImGuiViewport_SetPlatformRequestClose(ImGuiViewport *ImGuiViewportPtr, bool v);
+extern bool ImGuiViewport_GetPlatformRequestClose(ImGuiViewport *self);
+extern void ImGuiPlatformIO_SetMonitors(ImGuiPlatformIO *ImGuiPlatformIOPtr, ImVector_ImGuiPlatformMonitor v);
+extern ImVector_ImGuiPlatformMonitor ImGuiPlatformIO_GetMonitors(ImGuiPlatformIO *self);
+extern void ImGuiPlatformIO_SetViewports(ImGuiPlatformIO *ImGuiPlatformIOPtr, ImVector_ImGuiViewportPtr v);
+extern ImVector_ImGuiViewportPtr ImGuiPlatformIO_GetViewports(ImGuiPlatformIO *self);
+extern void ImGuiPlatformMonitor_SetMainPos(ImGuiPlatformMonitor *ImGuiPlatformMonitorPtr, ImVec2 v);
+extern ImVec2 ImGuiPlatformMonitor_GetMainPos(ImGuiPlatformMonitor *self);
+extern void ImGuiPlatformMonitor_SetMainSize(ImGuiPlatformMonitor *ImGuiPlatformMonitorPtr, ImVec2 v);
+extern ImVec2 ImGuiPlatformMonitor_GetMainSize(ImGuiPlatformMonitor *self);
+extern void ImGuiPlatformMonitor_SetWorkPos(ImGuiPlatformMonitor *ImGuiPlatformMonitorPtr, ImVec2 v);
+extern ImVec2 ImGuiPlatformMonitor_GetWorkPos(ImGuiPlatformMonitor *self);
+extern void ImGuiPlatformMonitor_SetWorkSize(ImGuiPlatformMonitor *ImGuiPlatformMonitorPtr, ImVec2 v);
+extern ImVec2 ImGuiPlatformMonitor_GetWorkSize(ImGuiPlatformMonitor *self);
+extern void ImGuiPlatformMonitor_SetDpiScale(ImGuiPlatformMonitor *ImGuiPlatformMonitorPtr, float v);
+extern float ImGuiPlatformMonitor_GetDpiScale(ImGuiPlatformMonitor *self);
+extern void ImGuiPlatformImeData_SetWantVisible(ImGuiPlatformImeData *ImGuiPlatformImeDataPtr, bool v);
+extern bool ImGuiPlatformImeData_GetWantVisible(ImGuiPlatformImeData *self);
+extern void ImGuiPlatformImeData_SetInputPos(ImGuiPlatformImeData *ImGuiPlatformImeDataPtr, ImVec2 v);
+extern ImVec2 ImGuiPlatformImeData_GetInputPos(ImGuiPlatformImeData *self);
+extern void ImGuiPlatformImeData_SetInputLineHeight(ImGuiPlatformImeData *ImGuiPlatformImeDataPtr, float v);
+extern float ImGuiPlatformImeData_GetInputLineHeight(ImGuiPlatformImeData *self);
+extern void ImVec1_Setx(ImVec1 *ImVec1Ptr, float v);
+extern float ImVec1_Getx(ImVec1 *self);
+extern void ImVec2ih_Setx(ImVec2ih *ImVec2ihPtr, short v);
+extern short ImVec2ih_Getx(ImVec2ih *self);
+extern void ImVec2ih_Sety(ImVec2ih *ImVec2ihPtr, short v);
+extern short ImVec2ih_Gety(ImVec2ih *self);
+extern void ImBitVector_SetStorage(ImBitVector *ImBitVectorPtr, ImVector_ImU32 v);
+extern ImVector_ImU32 ImBitVector_GetStorage(ImBitVector *self);
+extern void ImDrawListSharedData_SetTexUvWhitePixel(ImDrawListSharedData *ImDrawListSharedDataPtr, ImVec2 v);
+extern ImVec2 ImDrawListSharedData_GetTexUvWhitePixel(ImDrawListSharedData *self);
+extern void ImDrawListSharedData_SetFont(ImDrawListSharedData *ImDrawListSharedDataPtr, ImFont* v);
+extern ImFont* ImDrawListSharedData_GetFont(ImDrawListSharedData *self);
+extern void ImDrawListSharedData_SetFontSize(ImDrawListSharedData *ImDrawListSharedDataPtr, float v);
+extern float ImDrawListSharedData_GetFontSize(ImDrawListSharedData *self);
+extern void ImDrawListSharedData_SetCurveTessellationTol(ImDrawListSharedData *ImDrawListSharedDataPtr, float v);
+extern float ImDrawListSharedData_GetCurveTessellationTol(ImDrawListSharedData *self);
+extern void ImDrawListSharedData_SetCircleSegmentMaxError(ImDrawListSharedData *ImDrawListSharedDataPtr, float v);
+extern float ImDrawListSharedData_GetCircleSegmentMaxError(ImDrawListSharedData *self);
+extern void ImDrawListSharedData_SetClipRectFullscreen(ImDrawListSharedData *ImDrawListSharedDataPtr, ImVec4 v);
+extern ImVec4 ImDrawListSharedData_GetClipRectFullscreen(ImDrawListSharedData *self);
+extern void ImDrawListSharedData_SetInitialFlags(ImDrawListSharedData *ImDrawListSharedDataPtr, ImDrawListFlags v);
+extern ImDrawListFlags ImDrawListSharedData_GetInitialFlags(ImDrawListSharedData *self);
+extern void ImDrawListSharedData_SetArcFastRadiusCutoff(ImDrawListSharedData *ImDrawListSharedDataPtr, float v);
+extern float ImDrawListSharedData_GetArcFastRadiusCutoff(ImDrawListSharedData *self);
+extern void ImDrawListSharedData_SetTexUvLines(ImDrawListSharedData *ImDrawListSharedDataPtr, const ImVec4* v);
+extern const ImVec4* ImDrawListSharedData_GetTexUvLines(ImDrawListSharedData *self);
+extern void ImGuiDataTypeInfo_SetSize(ImGuiDataTypeInfo *ImGuiDataTypeInfoPtr, size_t v);
+extern size_t ImGuiDataTypeInfo_GetSize(ImGuiDataTypeInfo *self);
+extern void ImGuiDataTypeInfo_SetName(ImGuiDataTypeInfo *ImGuiDataTypeInfoPtr, const char* v);
+extern const char* ImGuiDataTypeInfo_GetName(ImGuiDataTypeInfo *self);
+extern void ImGuiDataTypeInfo_SetPrintFmt(ImGuiDataTypeInfo *ImGuiDataTypeInfoPtr, const char* v);
+extern const char* ImGuiDataTypeInfo_GetPrintFmt(ImGuiDataTypeInfo *self);
+extern void ImGuiDataTypeInfo_SetScanFmt(ImGuiDataTypeInfo *ImGuiDataTypeInfoPtr, const char* v);
+extern const char* ImGuiDataTypeInfo_GetScanFmt(ImGuiDataTypeInfo *self);
+extern void ImGuiColorMod_SetCol(ImGuiColorMod *ImGuiColorModPtr, ImGuiCol v);
+extern ImGuiCol ImGuiColorMod_GetCol(ImGuiColorMod *self);
+extern void ImGuiColorMod_SetBackupValue(ImGuiColorMod *ImGuiColorModPtr, ImVec4 v);
+extern ImVec4 ImGuiColorMod_GetBackupValue(ImGuiColorMod *self);
+extern void ImGuiStyleMod_SetVarIdx(ImGuiStyleMod *ImGuiStyleModPtr, ImGuiStyleVar v);
+extern ImGuiStyleVar ImGuiStyleMod_GetVarIdx(ImGuiStyleMod *self);
+extern void ImGuiComboPreviewData_SetPreviewRect(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, ImRect v);
+extern ImRect ImGuiComboPreviewData_GetPreviewRect(ImGuiComboPreviewData *self);
+extern void ImGuiComboPreviewData_SetBackupCursorPos(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, ImVec2 v);
+extern ImVec2 ImGuiComboPreviewData_GetBackupCursorPos(ImGuiComboPreviewData *self);
+extern void ImGuiComboPreviewData_SetBackupCursorMaxPos(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, ImVec2 v);
+extern ImVec2 ImGuiComboPreviewData_GetBackupCursorMaxPos(ImGuiComboPreviewData *self);
+extern void ImGuiComboPreviewData_SetBackupCursorPosPrevLine(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, ImVec2 v);
+extern ImVec2 ImGuiComboPreviewData_GetBackupCursorPosPrevLine(ImGuiComboPreviewData *self);
+extern void ImGuiComboPreviewData_SetBackupPrevLineTextBaseOffset(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, float v);
+extern float ImGuiComboPreviewData_GetBackupPrevLineTextBaseOffset(ImGuiComboPreviewData *self);
+extern void ImGuiComboPreviewData_SetBackupLayout(ImGuiComboPreviewData *ImGuiComboPreviewDataPtr, ImGuiLayoutType v);
+extern ImGuiLayoutType ImGuiComboPreviewData_GetBackupLayout(ImGuiComboPreviewData *self);
+extern void ImGuiGroupData_SetWindowID(ImGuiGroupData *ImGuiGroupDataPtr, ImGuiID v);
+extern ImGuiID ImGuiGroupData_GetWindowID(ImGuiGroupData *self);
+extern void ImGuiGroupData_SetBackupCursorPos(ImGuiGroupData *ImGuiGroupDataPtr, ImVec2 v);
+extern ImVec2 ImGuiGroupData_GetBackupCursorPos(ImGuiGroupData *self);
+extern void ImGuiGroupData_SetBackupCursorMaxPos(ImGuiGroupData *ImGuiGroupDataPtr, ImVec2 v);
+extern ImVec2 ImGuiGroupData_GetBackupCursorMaxPos(ImGuiGroupData *self);
+extern void ImGuiGroupData_SetBackupIndent(ImGuiGroupData *ImGuiGroupDataPtr, ImVec1 v);
+extern ImVec1 ImGuiGroupData_GetBackupIndent(ImGuiGroupData *self);
+extern void ImGuiGroupData_SetBackupGroupOffset(ImGuiGroupData *ImGuiGroupDataPtr, ImVec1 v);
+extern ImVec1 ImGuiGroupData_GetBackupGroupOffset(ImGuiGroupData *self);
+extern void ImGuiGroupData_SetBackupCurrLineSize(ImGuiGroupData *ImGuiGroupDataPtr, ImVec2 v);
+extern ImVec2 ImGuiGroupData_GetBackupCurrLineSize(ImGuiGroupData *self);
+extern void ImGuiGroupData_SetBackupCurrLineTextBaseOffset(ImGuiGroupData *ImGuiGroupDataPtr, float v);
+extern float ImGuiGroupData_GetBackupCurrLineTextBaseOffset(ImGuiGroupData *self);
+extern void ImGuiGroupData_SetBackupActiveIdIsAlive(ImGuiGroupData *ImGuiGroupDataPtr, ImGuiID v);
+extern ImGuiID ImGuiGroupData_GetBackupActiveIdIsAlive(ImGuiGroupData *self);
+extern void ImGuiGroupData_SetBackupActiveIdPreviousFrameIsAlive(ImGuiGroupData *ImGuiGroupDataPtr, bool v);
+extern bool ImGuiGroupData_GetBackupActiveIdPreviousFrameIsAlive(ImGuiGroupData *self);
+extern void ImGuiGroupData_SetBackupHoveredIdIsAlive(ImGuiGroupData *ImGuiGroupDataPtr, bool v);
+extern bool ImGuiGroupData_GetBackupHoveredIdIsAlive(ImGuiGroupData *self);
+extern void ImGuiGroupData_SetEmitItem(ImGuiGroupData *ImGuiGroupDataPtr, bool v);
+extern bool ImGuiGroupData_GetEmitItem(ImGuiGroupData *self);
+extern void ImGuiMenuColumns_SetTotalWidth(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU32 v);
+extern ImU32 ImGuiMenuColumns_GetTotalWidth(ImGuiMenuColumns *self);
+extern void ImGuiMenuColumns_SetNextTotalWidth(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU32 v);
+extern ImU32 ImGuiMenuColumns_GetNextTotalWidth(ImGuiMenuColumns *self);
+extern void ImGuiMenuColumns_SetSpacing(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU16 v);
+extern ImU16 ImGuiMenuColumns_GetSpacing(ImGuiMenuColumns *self);
+extern void ImGuiMenuColumns_SetOffsetIcon(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU16 v);
+extern ImU16 ImGuiMenuColumns_GetOffsetIcon(ImGuiMenuColumns *self);
+extern void ImGuiMenuColumns_SetOffsetLabel(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU16 v);
+extern ImU16 ImGuiMenuColumns_GetOffsetLabel(ImGuiMenuColumns *self);
+extern void ImGuiMenuColumns_SetOffsetShortcut(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU16 v);
+extern ImU16 ImGuiMenuColumns_GetOffsetShortcut(ImGuiMenuColumns *self);
+extern void ImGuiMenuColumns_SetOffsetMark(ImGuiMenuColumns *ImGuiMenuColumnsPtr, ImU16 v);
+extern ImU16 ImGuiMenuColumns_GetOffsetMark(ImGuiMenuColumns *self);
+extern void ImGuiInputTextState_SetID(ImGuiInputTextState *ImGuiInputTextStatePtr, ImGuiID v);
+extern ImGuiID ImGuiInputTextState_GetID(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetCurLenW(ImGuiInputTextState *ImGuiInputTextStatePtr, int v);
+extern int ImGuiInputTextState_GetCurLenW(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetCurLenA(ImGuiInputTextState *ImGuiInputTextStatePtr, int v);
+extern int ImGuiInputTextState_GetCurLenA(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetTextW(ImGuiInputTextState *ImGuiInputTextStatePtr, ImVector_ImWchar v);
+extern ImVector_ImWchar ImGuiInputTextState_GetTextW(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetTextA(ImGuiInputTextState *ImGuiInputTextStatePtr, ImVector_char v);
+extern ImVector_char ImGuiInputTextState_GetTextA(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetInitialTextA(ImGuiInputTextState *ImGuiInputTextStatePtr, ImVector_char v);
+extern ImVector_char ImGuiInputTextState_GetInitialTextA(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetTextAIsValid(ImGuiInputTextState *ImGuiInputTextStatePtr, bool v);
+extern bool ImGuiInputTextState_GetTextAIsValid(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetBufCapacityA(ImGuiInputTextState *ImGuiInputTextStatePtr, int v);
+extern int ImGuiInputTextState_GetBufCapacityA(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetScrollX(ImGuiInputTextState *ImGuiInputTextStatePtr, float v);
+extern float ImGuiInputTextState_GetScrollX(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetStb(ImGuiInputTextState *ImGuiInputTextStatePtr, STB_TexteditState v);
+extern STB_TexteditState ImGuiInputTextState_GetStb(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetCursorAnim(ImGuiInputTextState *ImGuiInputTextStatePtr, float v);
+extern float ImGuiInputTextState_GetCursorAnim(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetCursorFollow(ImGuiInputTextState *ImGuiInputTextStatePtr, bool v);
+extern bool ImGuiInputTextState_GetCursorFollow(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetSelectedAllMouseLock(ImGuiInputTextState *ImGuiInputTextStatePtr, bool v);
+extern bool ImGuiInputTextState_GetSelectedAllMouseLock(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetEdited(ImGuiInputTextState *ImGuiInputTextStatePtr, bool v);
+extern bool ImGuiInputTextState_GetEdited(ImGuiInputTextState *self);
+extern void ImGuiInputTextState_SetFlags(ImGuiInputTextState *ImGuiInputTextStatePtr, ImGuiInputTextFlags v);
+extern ImGuiInputTextFlags ImGuiInputTextState_GetFlags(ImGuiInputTextState *self);
+extern void ImGuiPopupData_SetPopupId(ImGuiPopupData *ImGuiPopupDataPtr, ImGuiID v);
+extern ImGuiID ImGuiPopupData_GetPopupId(ImGuiPopupData *self);
+extern void ImGuiPopupData_SetWindow(ImGuiPopupData *ImGuiPopupDataPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiPopupData_GetWindow(ImGuiPopupData *self);
+extern void ImGuiPopupData_SetSourceWindow(ImGuiPopupData *ImGuiPopupDataPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiPopupData_GetSourceWindow(ImGuiPopupData *self);
+extern void ImGuiPopupData_SetParentNavLayer(ImGuiPopupData *ImGuiPopupDataPtr, int v);
+extern int ImGuiPopupData_GetParentNavLayer(ImGuiPopupData *self);
+extern void ImGuiPopupData_SetOpenFrameCount(ImGuiPopupData *ImGuiPopupDataPtr, int v);
+extern int ImGuiPopupData_GetOpenFrameCount(ImGuiPopupData *self);
+extern void ImGuiPopupData_SetOpenParentId(ImGuiPopupData *ImGuiPopupDataPtr, ImGuiID v);
+extern ImGuiID ImGuiPopupData_GetOpenParentId(ImGuiPopupData *self);
+extern void ImGuiPopupData_SetOpenPopupPos(ImGuiPopupData *ImGuiPopupDataPtr, ImVec2 v);
+extern ImVec2 ImGuiPopupData_GetOpenPopupPos(ImGuiPopupData *self);
+extern void ImGuiPopupData_SetOpenMousePos(ImGuiPopupData *ImGuiPopupDataPtr, ImVec2 v);
+extern ImVec2 ImGuiPopupData_GetOpenMousePos(ImGuiPopupData *self);
+extern void ImGuiNextWindowData_SetFlags(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiNextWindowDataFlags v);
+extern ImGuiNextWindowDataFlags ImGuiNextWindowData_GetFlags(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetPosCond(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiCond v);
+extern ImGuiCond ImGuiNextWindowData_GetPosCond(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetSizeCond(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiCond v);
+extern ImGuiCond ImGuiNextWindowData_GetSizeCond(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetCollapsedCond(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiCond v);
+extern ImGuiCond ImGuiNextWindowData_GetCollapsedCond(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetDockCond(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiCond v);
+extern ImGuiCond ImGuiNextWindowData_GetDockCond(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetPosVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
+extern ImVec2 ImGuiNextWindowData_GetPosVal(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetPosPivotVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
+extern ImVec2 ImGuiNextWindowData_GetPosPivotVal(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetSizeVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
+extern ImVec2 ImGuiNextWindowData_GetSizeVal(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetContentSizeVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
+extern ImVec2 ImGuiNextWindowData_GetContentSizeVal(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetScrollVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
+extern ImVec2 ImGuiNextWindowData_GetScrollVal(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetPosUndock(ImGuiNextWindowData *ImGuiNextWindowDataPtr, bool v);
+extern bool ImGuiNextWindowData_GetPosUndock(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetCollapsedVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, bool v);
+extern bool ImGuiNextWindowData_GetCollapsedVal(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetSizeConstraintRect(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImRect v);
+extern ImRect ImGuiNextWindowData_GetSizeConstraintRect(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetSizeCallback(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiSizeCallback v);
+extern ImGuiSizeCallback ImGuiNextWindowData_GetSizeCallback(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetSizeCallbackUserData(ImGuiNextWindowData *ImGuiNextWindowDataPtr, void* v);
+extern void* ImGuiNextWindowData_GetSizeCallbackUserData(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetBgAlphaVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, float v);
+extern float ImGuiNextWindowData_GetBgAlphaVal(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetViewportId(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiID v);
+extern ImGuiID ImGuiNextWindowData_GetViewportId(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetDockId(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiID v);
+extern ImGuiID ImGuiNextWindowData_GetDockId(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetWindowClass(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImGuiWindowClass v);
+extern ImGuiWindowClass ImGuiNextWindowData_GetWindowClass(ImGuiNextWindowData *self);
+extern void ImGuiNextWindowData_SetMenuBarOffsetMinVal(ImGuiNextWindowData *ImGuiNextWindowDataPtr, ImVec2 v);
+extern ImVec2 ImGuiNextWindowData_GetMenuBarOffsetMinVal(ImGuiNextWindowData *self);
+extern void ImGuiNextItemData_SetFlags(ImGuiNextItemData *ImGuiNextItemDataPtr, ImGuiNextItemDataFlags v);
+extern ImGuiNextItemDataFlags ImGuiNextItemData_GetFlags(ImGuiNextItemData *self);
+extern void ImGuiNextItemData_SetWidth(ImGuiNextItemData *ImGuiNextItemDataPtr, float v);
+extern float ImGuiNextItemData_GetWidth(ImGuiNextItemData *self);
+extern void ImGuiNextItemData_SetFocusScopeId(ImGuiNextItemData *ImGuiNextItemDataPtr, ImGuiID v);
+extern ImGuiID ImGuiNextItemData_GetFocusScopeId(ImGuiNextItemData *self);
+extern void ImGuiNextItemData_SetOpenCond(ImGuiNextItemData *ImGuiNextItemDataPtr, ImGuiCond v);
+extern ImGuiCond ImGuiNextItemData_GetOpenCond(ImGuiNextItemData *self);
+extern void ImGuiNextItemData_SetOpenVal(ImGuiNextItemData *ImGuiNextItemDataPtr, bool v);
+extern bool ImGuiNextItemData_GetOpenVal(ImGuiNextItemData *self);
 extern void ImGuiLastItemData_SetID(ImGuiLastItemData *ImGuiLastItemDataPtr, ImGuiID v);
 extern ImGuiID ImGuiLastItemData_GetID(ImGuiLastItemData *self);
 extern void ImGuiLastItemData_SetInFlags(ImGuiLastItemData *ImGuiLastItemDataPtr, ImGuiItemFlags v);
@@ -1960,46 +828,148 @@ extern void ImGuiLastItemData_SetNavRect(ImGuiLastItemData *ImGuiLastItemDataPtr
 extern ImRect ImGuiLastItemData_GetNavRect(ImGuiLastItemData *self);
 extern void ImGuiLastItemData_SetDisplayRect(ImGuiLastItemData *ImGuiLastItemDataPtr, ImRect v);
 extern ImRect ImGuiLastItemData_GetDisplayRect(ImGuiLastItemData *self);
-extern void ImGuiMetricsConfig_SetShowDebugLog(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
-extern bool ImGuiMetricsConfig_GetShowDebugLog(ImGuiMetricsConfig *self);
-extern void ImGuiMetricsConfig_SetShowStackTool(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
-extern bool ImGuiMetricsConfig_GetShowStackTool(ImGuiMetricsConfig *self);
-extern void ImGuiMetricsConfig_SetShowWindowsRects(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
-extern bool ImGuiMetricsConfig_GetShowWindowsRects(ImGuiMetricsConfig *self);
-extern void ImGuiMetricsConfig_SetShowWindowsBeginOrder(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
-extern bool ImGuiMetricsConfig_GetShowWindowsBeginOrder(ImGuiMetricsConfig *self);
-extern void ImGuiMetricsConfig_SetShowTablesRects(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
-extern bool ImGuiMetricsConfig_GetShowTablesRects(ImGuiMetricsConfig *self);
-extern void ImGuiMetricsConfig_SetShowDrawCmdMesh(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
-extern bool ImGuiMetricsConfig_GetShowDrawCmdMesh(ImGuiMetricsConfig *self);
-extern void ImGuiMetricsConfig_SetShowDrawCmdBoundingBoxes(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
-extern bool ImGuiMetricsConfig_GetShowDrawCmdBoundingBoxes(ImGuiMetricsConfig *self);
-extern void ImGuiMetricsConfig_SetShowDockingNodes(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
-extern bool ImGuiMetricsConfig_GetShowDockingNodes(ImGuiMetricsConfig *self);
-extern void ImGuiMetricsConfig_SetShowWindowsRectsType(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, int v);
-extern int ImGuiMetricsConfig_GetShowWindowsRectsType(ImGuiMetricsConfig *self);
-extern void ImGuiMetricsConfig_SetShowTablesRectsType(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, int v);
-extern int ImGuiMetricsConfig_GetShowTablesRectsType(ImGuiMetricsConfig *self);
-extern void ImGuiPlatformMonitor_SetMainPos(ImGuiPlatformMonitor *ImGuiPlatformMonitorPtr, ImVec2 v);
-extern ImVec2 ImGuiPlatformMonitor_GetMainPos(ImGuiPlatformMonitor *self);
-extern void ImGuiPlatformMonitor_SetMainSize(ImGuiPlatformMonitor *ImGuiPlatformMonitorPtr, ImVec2 v);
-extern ImVec2 ImGuiPlatformMonitor_GetMainSize(ImGuiPlatformMonitor *self);
-extern void ImGuiPlatformMonitor_SetWorkPos(ImGuiPlatformMonitor *ImGuiPlatformMonitorPtr, ImVec2 v);
-extern ImVec2 ImGuiPlatformMonitor_GetWorkPos(ImGuiPlatformMonitor *self);
-extern void ImGuiPlatformMonitor_SetWorkSize(ImGuiPlatformMonitor *ImGuiPlatformMonitorPtr, ImVec2 v);
-extern ImVec2 ImGuiPlatformMonitor_GetWorkSize(ImGuiPlatformMonitor *self);
-extern void ImGuiPlatformMonitor_SetDpiScale(ImGuiPlatformMonitor *ImGuiPlatformMonitorPtr, float v);
-extern float ImGuiPlatformMonitor_GetDpiScale(ImGuiPlatformMonitor *self);
-extern void ImGuiContextHook_SetHookId(ImGuiContextHook *ImGuiContextHookPtr, ImGuiID v);
-extern ImGuiID ImGuiContextHook_GetHookId(ImGuiContextHook *self);
-extern void ImGuiContextHook_SetType(ImGuiContextHook *ImGuiContextHookPtr, ImGuiContextHookType v);
-extern ImGuiContextHookType ImGuiContextHook_GetType(ImGuiContextHook *self);
-extern void ImGuiContextHook_SetOwner(ImGuiContextHook *ImGuiContextHookPtr, ImGuiID v);
-extern ImGuiID ImGuiContextHook_GetOwner(ImGuiContextHook *self);
-extern void ImGuiContextHook_SetCallback(ImGuiContextHook *ImGuiContextHookPtr, ImGuiContextHookCallback v);
-extern ImGuiContextHookCallback ImGuiContextHook_GetCallback(ImGuiContextHook *self);
-extern void ImGuiContextHook_SetUserData(ImGuiContextHook *ImGuiContextHookPtr, void* v);
-extern void* ImGuiContextHook_GetUserData(ImGuiContextHook *self);
+extern void ImGuiStackSizes_SetSizeOfIDStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
+extern short ImGuiStackSizes_GetSizeOfIDStack(ImGuiStackSizes *self);
+extern void ImGuiStackSizes_SetSizeOfColorStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
+extern short ImGuiStackSizes_GetSizeOfColorStack(ImGuiStackSizes *self);
+extern void ImGuiStackSizes_SetSizeOfStyleVarStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
+extern short ImGuiStackSizes_GetSizeOfStyleVarStack(ImGuiStackSizes *self);
+extern void ImGuiStackSizes_SetSizeOfFontStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
+extern short ImGuiStackSizes_GetSizeOfFontStack(ImGuiStackSizes *self);
+extern void ImGuiStackSizes_SetSizeOfFocusScopeStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
+extern short ImGuiStackSizes_GetSizeOfFocusScopeStack(ImGuiStackSizes *self);
+extern void ImGuiStackSizes_SetSizeOfGroupStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
+extern short ImGuiStackSizes_GetSizeOfGroupStack(ImGuiStackSizes *self);
+extern void ImGuiStackSizes_SetSizeOfItemFlagsStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
+extern short ImGuiStackSizes_GetSizeOfItemFlagsStack(ImGuiStackSizes *self);
+extern void ImGuiStackSizes_SetSizeOfBeginPopupStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
+extern short ImGuiStackSizes_GetSizeOfBeginPopupStack(ImGuiStackSizes *self);
+extern void ImGuiStackSizes_SetSizeOfDisabledStack(ImGuiStackSizes *ImGuiStackSizesPtr, short v);
+extern short ImGuiStackSizes_GetSizeOfDisabledStack(ImGuiStackSizes *self);
+extern void ImGuiWindowStackData_SetWindow(ImGuiWindowStackData *ImGuiWindowStackDataPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiWindowStackData_GetWindow(ImGuiWindowStackData *self);
+extern void ImGuiWindowStackData_SetParentLastItemDataBackup(ImGuiWindowStackData *ImGuiWindowStackDataPtr, ImGuiLastItemData v);
+extern ImGuiLastItemData ImGuiWindowStackData_GetParentLastItemDataBackup(ImGuiWindowStackData *self);
+extern void ImGuiWindowStackData_SetStackSizesOnBegin(ImGuiWindowStackData *ImGuiWindowStackDataPtr, ImGuiStackSizes v);
+extern ImGuiStackSizes ImGuiWindowStackData_GetStackSizesOnBegin(ImGuiWindowStackData *self);
+extern void ImGuiShrinkWidthItem_SetIndex(ImGuiShrinkWidthItem *ImGuiShrinkWidthItemPtr, int v);
+extern int ImGuiShrinkWidthItem_GetIndex(ImGuiShrinkWidthItem *self);
+extern void ImGuiShrinkWidthItem_SetWidth(ImGuiShrinkWidthItem *ImGuiShrinkWidthItemPtr, float v);
+extern float ImGuiShrinkWidthItem_GetWidth(ImGuiShrinkWidthItem *self);
+extern void ImGuiShrinkWidthItem_SetInitialWidth(ImGuiShrinkWidthItem *ImGuiShrinkWidthItemPtr, float v);
+extern float ImGuiShrinkWidthItem_GetInitialWidth(ImGuiShrinkWidthItem *self);
+extern void ImGuiPtrOrIndex_SetPtr(ImGuiPtrOrIndex *ImGuiPtrOrIndexPtr, void* v);
+extern void* ImGuiPtrOrIndex_GetPtr(ImGuiPtrOrIndex *self);
+extern void ImGuiPtrOrIndex_SetIndex(ImGuiPtrOrIndex *ImGuiPtrOrIndexPtr, int v);
+extern int ImGuiPtrOrIndex_GetIndex(ImGuiPtrOrIndex *self);
+extern void ImGuiInputEventMousePos_SetPosX(ImGuiInputEventMousePos *ImGuiInputEventMousePosPtr, float v);
+extern float ImGuiInputEventMousePos_GetPosX(ImGuiInputEventMousePos *self);
+extern void ImGuiInputEventMousePos_SetPosY(ImGuiInputEventMousePos *ImGuiInputEventMousePosPtr, float v);
+extern float ImGuiInputEventMousePos_GetPosY(ImGuiInputEventMousePos *self);
+extern void ImGuiInputEventMouseWheel_SetWheelX(ImGuiInputEventMouseWheel *ImGuiInputEventMouseWheelPtr, float v);
+extern float ImGuiInputEventMouseWheel_GetWheelX(ImGuiInputEventMouseWheel *self);
+extern void ImGuiInputEventMouseWheel_SetWheelY(ImGuiInputEventMouseWheel *ImGuiInputEventMouseWheelPtr, float v);
+extern float ImGuiInputEventMouseWheel_GetWheelY(ImGuiInputEventMouseWheel *self);
+extern void ImGuiInputEventMouseButton_SetButton(ImGuiInputEventMouseButton *ImGuiInputEventMouseButtonPtr, int v);
+extern int ImGuiInputEventMouseButton_GetButton(ImGuiInputEventMouseButton *self);
+extern void ImGuiInputEventMouseButton_SetDown(ImGuiInputEventMouseButton *ImGuiInputEventMouseButtonPtr, bool v);
+extern bool ImGuiInputEventMouseButton_GetDown(ImGuiInputEventMouseButton *self);
+extern void ImGuiInputEventMouseViewport_SetHoveredViewportID(ImGuiInputEventMouseViewport *ImGuiInputEventMouseViewportPtr, ImGuiID v);
+extern ImGuiID ImGuiInputEventMouseViewport_GetHoveredViewportID(ImGuiInputEventMouseViewport *self);
+extern void ImGuiInputEventKey_SetKey(ImGuiInputEventKey *ImGuiInputEventKeyPtr, ImGuiKey v);
+extern ImGuiKey ImGuiInputEventKey_GetKey(ImGuiInputEventKey *self);
+extern void ImGuiInputEventKey_SetDown(ImGuiInputEventKey *ImGuiInputEventKeyPtr, bool v);
+extern bool ImGuiInputEventKey_GetDown(ImGuiInputEventKey *self);
+extern void ImGuiInputEventKey_SetAnalogValue(ImGuiInputEventKey *ImGuiInputEventKeyPtr, float v);
+extern float ImGuiInputEventKey_GetAnalogValue(ImGuiInputEventKey *self);
+extern void ImGuiInputEventText_SetChar(ImGuiInputEventText *ImGuiInputEventTextPtr, unsigned int v);
+extern unsigned int ImGuiInputEventText_GetChar(ImGuiInputEventText *self);
+extern void ImGuiInputEventAppFocused_SetFocused(ImGuiInputEventAppFocused *ImGuiInputEventAppFocusedPtr, bool v);
+extern bool ImGuiInputEventAppFocused_GetFocused(ImGuiInputEventAppFocused *self);
+extern void ImGuiInputEvent_SetType(ImGuiInputEvent *ImGuiInputEventPtr, ImGuiInputEventType v);
+extern ImGuiInputEventType ImGuiInputEvent_GetType(ImGuiInputEvent *self);
+extern void ImGuiInputEvent_SetSource(ImGuiInputEvent *ImGuiInputEventPtr, ImGuiInputSource v);
+extern ImGuiInputSource ImGuiInputEvent_GetSource(ImGuiInputEvent *self);
+extern void ImGuiInputEvent_SetAddedByTestEngine(ImGuiInputEvent *ImGuiInputEventPtr, bool v);
+extern bool ImGuiInputEvent_GetAddedByTestEngine(ImGuiInputEvent *self);
+extern void ImGuiListClipperRange_SetMin(ImGuiListClipperRange *ImGuiListClipperRangePtr, int v);
+extern int ImGuiListClipperRange_GetMin(ImGuiListClipperRange *self);
+extern void ImGuiListClipperRange_SetMax(ImGuiListClipperRange *ImGuiListClipperRangePtr, int v);
+extern int ImGuiListClipperRange_GetMax(ImGuiListClipperRange *self);
+extern void ImGuiListClipperRange_SetPosToIndexConvert(ImGuiListClipperRange *ImGuiListClipperRangePtr, bool v);
+extern bool ImGuiListClipperRange_GetPosToIndexConvert(ImGuiListClipperRange *self);
+extern void ImGuiListClipperRange_SetPosToIndexOffsetMin(ImGuiListClipperRange *ImGuiListClipperRangePtr, ImS8 v);
+extern ImS8 ImGuiListClipperRange_GetPosToIndexOffsetMin(ImGuiListClipperRange *self);
+extern void ImGuiListClipperRange_SetPosToIndexOffsetMax(ImGuiListClipperRange *ImGuiListClipperRangePtr, ImS8 v);
+extern ImS8 ImGuiListClipperRange_GetPosToIndexOffsetMax(ImGuiListClipperRange *self);
+extern void ImGuiListClipperData_SetListClipper(ImGuiListClipperData *ImGuiListClipperDataPtr, ImGuiListClipper* v);
+extern ImGuiListClipper* ImGuiListClipperData_GetListClipper(ImGuiListClipperData *self);
+extern void ImGuiListClipperData_SetLossynessOffset(ImGuiListClipperData *ImGuiListClipperDataPtr, float v);
+extern float ImGuiListClipperData_GetLossynessOffset(ImGuiListClipperData *self);
+extern void ImGuiListClipperData_SetStepNo(ImGuiListClipperData *ImGuiListClipperDataPtr, int v);
+extern int ImGuiListClipperData_GetStepNo(ImGuiListClipperData *self);
+extern void ImGuiListClipperData_SetItemsFrozen(ImGuiListClipperData *ImGuiListClipperDataPtr, int v);
+extern int ImGuiListClipperData_GetItemsFrozen(ImGuiListClipperData *self);
+extern void ImGuiListClipperData_SetRanges(ImGuiListClipperData *ImGuiListClipperDataPtr, ImVector_ImGuiListClipperRange v);
+extern ImVector_ImGuiListClipperRange ImGuiListClipperData_GetRanges(ImGuiListClipperData *self);
+extern void ImGuiNavItemData_SetWindow(ImGuiNavItemData *ImGuiNavItemDataPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiNavItemData_GetWindow(ImGuiNavItemData *self);
+extern void ImGuiNavItemData_SetID(ImGuiNavItemData *ImGuiNavItemDataPtr, ImGuiID v);
+extern ImGuiID ImGuiNavItemData_GetID(ImGuiNavItemData *self);
+extern void ImGuiNavItemData_SetFocusScopeId(ImGuiNavItemData *ImGuiNavItemDataPtr, ImGuiID v);
+extern ImGuiID ImGuiNavItemData_GetFocusScopeId(ImGuiNavItemData *self);
+extern void ImGuiNavItemData_SetRectRel(ImGuiNavItemData *ImGuiNavItemDataPtr, ImRect v);
+extern ImRect ImGuiNavItemData_GetRectRel(ImGuiNavItemData *self);
+extern void ImGuiNavItemData_SetInFlags(ImGuiNavItemData *ImGuiNavItemDataPtr, ImGuiItemFlags v);
+extern ImGuiItemFlags ImGuiNavItemData_GetInFlags(ImGuiNavItemData *self);
+extern void ImGuiNavItemData_SetDistBox(ImGuiNavItemData *ImGuiNavItemDataPtr, float v);
+extern float ImGuiNavItemData_GetDistBox(ImGuiNavItemData *self);
+extern void ImGuiNavItemData_SetDistCenter(ImGuiNavItemData *ImGuiNavItemDataPtr, float v);
+extern float ImGuiNavItemData_GetDistCenter(ImGuiNavItemData *self);
+extern void ImGuiNavItemData_SetDistAxial(ImGuiNavItemData *ImGuiNavItemDataPtr, float v);
+extern float ImGuiNavItemData_GetDistAxial(ImGuiNavItemData *self);
+extern void ImGuiOldColumnData_SetOffsetNorm(ImGuiOldColumnData *ImGuiOldColumnDataPtr, float v);
+extern float ImGuiOldColumnData_GetOffsetNorm(ImGuiOldColumnData *self);
+extern void ImGuiOldColumnData_SetOffsetNormBeforeResize(ImGuiOldColumnData *ImGuiOldColumnDataPtr, float v);
+extern float ImGuiOldColumnData_GetOffsetNormBeforeResize(ImGuiOldColumnData *self);
+extern void ImGuiOldColumnData_SetFlags(ImGuiOldColumnData *ImGuiOldColumnDataPtr, ImGuiOldColumnFlags v);
+extern ImGuiOldColumnFlags ImGuiOldColumnData_GetFlags(ImGuiOldColumnData *self);
+extern void ImGuiOldColumnData_SetClipRect(ImGuiOldColumnData *ImGuiOldColumnDataPtr, ImRect v);
+extern ImRect ImGuiOldColumnData_GetClipRect(ImGuiOldColumnData *self);
+extern void ImGuiOldColumns_SetID(ImGuiOldColumns *ImGuiOldColumnsPtr, ImGuiID v);
+extern ImGuiID ImGuiOldColumns_GetID(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetFlags(ImGuiOldColumns *ImGuiOldColumnsPtr, ImGuiOldColumnFlags v);
+extern ImGuiOldColumnFlags ImGuiOldColumns_GetFlags(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetIsFirstFrame(ImGuiOldColumns *ImGuiOldColumnsPtr, bool v);
+extern bool ImGuiOldColumns_GetIsFirstFrame(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetIsBeingResized(ImGuiOldColumns *ImGuiOldColumnsPtr, bool v);
+extern bool ImGuiOldColumns_GetIsBeingResized(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetCurrent(ImGuiOldColumns *ImGuiOldColumnsPtr, int v);
+extern int ImGuiOldColumns_GetCurrent(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetCount(ImGuiOldColumns *ImGuiOldColumnsPtr, int v);
+extern int ImGuiOldColumns_GetCount(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetOffMinX(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
+extern float ImGuiOldColumns_GetOffMinX(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetOffMaxX(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
+extern float ImGuiOldColumns_GetOffMaxX(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetLineMinY(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
+extern float ImGuiOldColumns_GetLineMinY(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetLineMaxY(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
+extern float ImGuiOldColumns_GetLineMaxY(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetHostCursorPosY(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
+extern float ImGuiOldColumns_GetHostCursorPosY(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetHostCursorMaxPosX(ImGuiOldColumns *ImGuiOldColumnsPtr, float v);
+extern float ImGuiOldColumns_GetHostCursorMaxPosX(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetHostInitialClipRect(ImGuiOldColumns *ImGuiOldColumnsPtr, ImRect v);
+extern ImRect ImGuiOldColumns_GetHostInitialClipRect(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetHostBackupClipRect(ImGuiOldColumns *ImGuiOldColumnsPtr, ImRect v);
+extern ImRect ImGuiOldColumns_GetHostBackupClipRect(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetHostBackupParentWorkRect(ImGuiOldColumns *ImGuiOldColumnsPtr, ImRect v);
+extern ImRect ImGuiOldColumns_GetHostBackupParentWorkRect(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetColumns(ImGuiOldColumns *ImGuiOldColumnsPtr, ImVector_ImGuiOldColumnData v);
+extern ImVector_ImGuiOldColumnData ImGuiOldColumns_GetColumns(ImGuiOldColumns *self);
+extern void ImGuiOldColumns_SetSplitter(ImGuiOldColumns *ImGuiOldColumnsPtr, ImDrawListSplitter v);
+extern ImDrawListSplitter ImGuiOldColumns_GetSplitter(ImGuiOldColumns *self);
 extern void ImGuiDockNode_SetID(ImGuiDockNode *ImGuiDockNodePtr, ImGuiID v);
 extern ImGuiID ImGuiDockNode_GetID(ImGuiDockNode *self);
 extern void ImGuiDockNode_SetSharedFlags(ImGuiDockNode *ImGuiDockNodePtr, ImGuiDockNodeFlags v);
@@ -2078,16 +1048,14 @@ extern void ImGuiDockNode_SetWantHiddenTabBarUpdate(ImGuiDockNode *ImGuiDockNode
 extern bool ImGuiDockNode_GetWantHiddenTabBarUpdate(ImGuiDockNode *self);
 extern void ImGuiDockNode_SetWantHiddenTabBarToggle(ImGuiDockNode *ImGuiDockNodePtr, bool v);
 extern bool ImGuiDockNode_GetWantHiddenTabBarToggle(ImGuiDockNode *self);
-extern void ImGuiListClipperRange_SetMin(ImGuiListClipperRange *ImGuiListClipperRangePtr, int v);
-extern int ImGuiListClipperRange_GetMin(ImGuiListClipperRange *self);
-extern void ImGuiListClipperRange_SetMax(ImGuiListClipperRange *ImGuiListClipperRangePtr, int v);
-extern int ImGuiListClipperRange_GetMax(ImGuiListClipperRange *self);
-extern void ImGuiListClipperRange_SetPosToIndexConvert(ImGuiListClipperRange *ImGuiListClipperRangePtr, bool v);
-extern bool ImGuiListClipperRange_GetPosToIndexConvert(ImGuiListClipperRange *self);
-extern void ImGuiListClipperRange_SetPosToIndexOffsetMin(ImGuiListClipperRange *ImGuiListClipperRangePtr, ImS8 v);
-extern ImS8 ImGuiListClipperRange_GetPosToIndexOffsetMin(ImGuiListClipperRange *self);
-extern void ImGuiListClipperRange_SetPosToIndexOffsetMax(ImGuiListClipperRange *ImGuiListClipperRangePtr, ImS8 v);
-extern ImS8 ImGuiListClipperRange_GetPosToIndexOffsetMax(ImGuiListClipperRange *self);
+extern void ImGuiDockContext_SetNodes(ImGuiDockContext *ImGuiDockContextPtr, ImGuiStorage v);
+extern ImGuiStorage ImGuiDockContext_GetNodes(ImGuiDockContext *self);
+extern void ImGuiDockContext_SetRequests(ImGuiDockContext *ImGuiDockContextPtr, ImVector_ImGuiDockRequest v);
+extern ImVector_ImGuiDockRequest ImGuiDockContext_GetRequests(ImGuiDockContext *self);
+extern void ImGuiDockContext_SetNodesSettings(ImGuiDockContext *ImGuiDockContextPtr, ImVector_ImGuiDockNodeSettings v);
+extern ImVector_ImGuiDockNodeSettings ImGuiDockContext_GetNodesSettings(ImGuiDockContext *self);
+extern void ImGuiDockContext_SetWantFullRebuild(ImGuiDockContext *ImGuiDockContextPtr, bool v);
+extern bool ImGuiDockContext_GetWantFullRebuild(ImGuiDockContext *self);
 extern void ImGuiViewportP_Set_ImGuiViewport(ImGuiViewportP *ImGuiViewportPPtr, ImGuiViewport v);
 extern ImGuiViewport ImGuiViewportP_Get_ImGuiViewport(ImGuiViewportP *self);
 extern void ImGuiViewportP_SetIdx(ImGuiViewportP *ImGuiViewportPPtr, int v);
@@ -2128,278 +1096,1274 @@ extern void ImGuiViewportP_SetBuildWorkOffsetMin(ImGuiViewportP *ImGuiViewportPP
 extern ImVec2 ImGuiViewportP_GetBuildWorkOffsetMin(ImGuiViewportP *self);
 extern void ImGuiViewportP_SetBuildWorkOffsetMax(ImGuiViewportP *ImGuiViewportPPtr, ImVec2 v);
 extern ImVec2 ImGuiViewportP_GetBuildWorkOffsetMax(ImGuiViewportP *self);
-extern void ImGuiWindowStackData_SetWindow(ImGuiWindowStackData *ImGuiWindowStackDataPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiWindowStackData_GetWindow(ImGuiWindowStackData *self);
-extern void ImGuiWindowStackData_SetParentLastItemDataBackup(ImGuiWindowStackData *ImGuiWindowStackDataPtr, ImGuiLastItemData v);
-extern ImGuiLastItemData ImGuiWindowStackData_GetParentLastItemDataBackup(ImGuiWindowStackData *self);
-extern void ImGuiWindowStackData_SetStackSizesOnBegin(ImGuiWindowStackData *ImGuiWindowStackDataPtr, ImGuiStackSizes v);
-extern ImGuiStackSizes ImGuiWindowStackData_GetStackSizesOnBegin(ImGuiWindowStackData *self);
-extern void ImFontGlyphRangesBuilder_SetUsedChars(ImFontGlyphRangesBuilder *ImFontGlyphRangesBuilderPtr, ImVector_ImU32 v);
-extern ImVector_ImU32 ImFontGlyphRangesBuilder_GetUsedChars(ImFontGlyphRangesBuilder *self);
-extern void ImGuiPlatformIO_SetMonitors(ImGuiPlatformIO *ImGuiPlatformIOPtr, ImVector_ImGuiPlatformMonitor v);
-extern ImVector_ImGuiPlatformMonitor ImGuiPlatformIO_GetMonitors(ImGuiPlatformIO *self);
-extern void ImGuiPlatformIO_SetViewports(ImGuiPlatformIO *ImGuiPlatformIOPtr, ImVector_ImGuiViewportPtr v);
-extern ImVector_ImGuiViewportPtr ImGuiPlatformIO_GetViewports(ImGuiPlatformIO *self);
-extern void ImGuiPopupData_SetPopupId(ImGuiPopupData *ImGuiPopupDataPtr, ImGuiID v);
-extern ImGuiID ImGuiPopupData_GetPopupId(ImGuiPopupData *self);
-extern void ImGuiPopupData_SetWindow(ImGuiPopupData *ImGuiPopupDataPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiPopupData_GetWindow(ImGuiPopupData *self);
-extern void ImGuiPopupData_SetSourceWindow(ImGuiPopupData *ImGuiPopupDataPtr, ImGuiWindow* v);
-extern ImGuiWindow* ImGuiPopupData_GetSourceWindow(ImGuiPopupData *self);
-extern void ImGuiPopupData_SetParentNavLayer(ImGuiPopupData *ImGuiPopupDataPtr, int v);
-extern int ImGuiPopupData_GetParentNavLayer(ImGuiPopupData *self);
-extern void ImGuiPopupData_SetOpenFrameCount(ImGuiPopupData *ImGuiPopupDataPtr, int v);
-extern int ImGuiPopupData_GetOpenFrameCount(ImGuiPopupData *self);
-extern void ImGuiPopupData_SetOpenParentId(ImGuiPopupData *ImGuiPopupDataPtr, ImGuiID v);
-extern ImGuiID ImGuiPopupData_GetOpenParentId(ImGuiPopupData *self);
-extern void ImGuiPopupData_SetOpenPopupPos(ImGuiPopupData *ImGuiPopupDataPtr, ImVec2 v);
-extern ImVec2 ImGuiPopupData_GetOpenPopupPos(ImGuiPopupData *self);
-extern void ImGuiPopupData_SetOpenMousePos(ImGuiPopupData *ImGuiPopupDataPtr, ImVec2 v);
-extern ImVec2 ImGuiPopupData_GetOpenMousePos(ImGuiPopupData *self);
-extern void ImGuiTextBuffer_SetBuf(ImGuiTextBuffer *ImGuiTextBufferPtr, ImVector_char v);
-extern ImVector_char ImGuiTextBuffer_GetBuf(ImGuiTextBuffer *self);
+extern void ImGuiWindowSettings_SetID(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImGuiID v);
+extern ImGuiID ImGuiWindowSettings_GetID(ImGuiWindowSettings *self);
+extern void ImGuiWindowSettings_SetPos(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImVec2ih v);
+extern ImVec2ih ImGuiWindowSettings_GetPos(ImGuiWindowSettings *self);
+extern void ImGuiWindowSettings_SetSize(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImVec2ih v);
+extern ImVec2ih ImGuiWindowSettings_GetSize(ImGuiWindowSettings *self);
+extern void ImGuiWindowSettings_SetViewportPos(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImVec2ih v);
+extern ImVec2ih ImGuiWindowSettings_GetViewportPos(ImGuiWindowSettings *self);
+extern void ImGuiWindowSettings_SetViewportId(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImGuiID v);
+extern ImGuiID ImGuiWindowSettings_GetViewportId(ImGuiWindowSettings *self);
+extern void ImGuiWindowSettings_SetDockId(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImGuiID v);
+extern ImGuiID ImGuiWindowSettings_GetDockId(ImGuiWindowSettings *self);
+extern void ImGuiWindowSettings_SetClassId(ImGuiWindowSettings *ImGuiWindowSettingsPtr, ImGuiID v);
+extern ImGuiID ImGuiWindowSettings_GetClassId(ImGuiWindowSettings *self);
+extern void ImGuiWindowSettings_SetDockOrder(ImGuiWindowSettings *ImGuiWindowSettingsPtr, short v);
+extern short ImGuiWindowSettings_GetDockOrder(ImGuiWindowSettings *self);
+extern void ImGuiWindowSettings_SetCollapsed(ImGuiWindowSettings *ImGuiWindowSettingsPtr, bool v);
+extern bool ImGuiWindowSettings_GetCollapsed(ImGuiWindowSettings *self);
+extern void ImGuiWindowSettings_SetWantApply(ImGuiWindowSettings *ImGuiWindowSettingsPtr, bool v);
+extern bool ImGuiWindowSettings_GetWantApply(ImGuiWindowSettings *self);
+extern void ImGuiSettingsHandler_SetTypeName(ImGuiSettingsHandler *ImGuiSettingsHandlerPtr, const char* v);
+extern const char* ImGuiSettingsHandler_GetTypeName(ImGuiSettingsHandler *self);
+extern void ImGuiSettingsHandler_SetTypeHash(ImGuiSettingsHandler *ImGuiSettingsHandlerPtr, ImGuiID v);
+extern ImGuiID ImGuiSettingsHandler_GetTypeHash(ImGuiSettingsHandler *self);
+extern void ImGuiSettingsHandler_SetUserData(ImGuiSettingsHandler *ImGuiSettingsHandlerPtr, void* v);
+extern void* ImGuiSettingsHandler_GetUserData(ImGuiSettingsHandler *self);
+extern void ImGuiMetricsConfig_SetShowDebugLog(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
+extern bool ImGuiMetricsConfig_GetShowDebugLog(ImGuiMetricsConfig *self);
+extern void ImGuiMetricsConfig_SetShowStackTool(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
+extern bool ImGuiMetricsConfig_GetShowStackTool(ImGuiMetricsConfig *self);
+extern void ImGuiMetricsConfig_SetShowWindowsRects(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
+extern bool ImGuiMetricsConfig_GetShowWindowsRects(ImGuiMetricsConfig *self);
+extern void ImGuiMetricsConfig_SetShowWindowsBeginOrder(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
+extern bool ImGuiMetricsConfig_GetShowWindowsBeginOrder(ImGuiMetricsConfig *self);
+extern void ImGuiMetricsConfig_SetShowTablesRects(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
+extern bool ImGuiMetricsConfig_GetShowTablesRects(ImGuiMetricsConfig *self);
+extern void ImGuiMetricsConfig_SetShowDrawCmdMesh(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
+extern bool ImGuiMetricsConfig_GetShowDrawCmdMesh(ImGuiMetricsConfig *self);
+extern void ImGuiMetricsConfig_SetShowDrawCmdBoundingBoxes(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
+extern bool ImGuiMetricsConfig_GetShowDrawCmdBoundingBoxes(ImGuiMetricsConfig *self);
+extern void ImGuiMetricsConfig_SetShowDockingNodes(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, bool v);
+extern bool ImGuiMetricsConfig_GetShowDockingNodes(ImGuiMetricsConfig *self);
+extern void ImGuiMetricsConfig_SetShowWindowsRectsType(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, int v);
+extern int ImGuiMetricsConfig_GetShowWindowsRectsType(ImGuiMetricsConfig *self);
+extern void ImGuiMetricsConfig_SetShowTablesRectsType(ImGuiMetricsConfig *ImGuiMetricsConfigPtr, int v);
+extern int ImGuiMetricsConfig_GetShowTablesRectsType(ImGuiMetricsConfig *self);
+extern void ImGuiStackLevelInfo_SetID(ImGuiStackLevelInfo *ImGuiStackLevelInfoPtr, ImGuiID v);
+extern ImGuiID ImGuiStackLevelInfo_GetID(ImGuiStackLevelInfo *self);
+extern void ImGuiStackLevelInfo_SetQueryFrameCount(ImGuiStackLevelInfo *ImGuiStackLevelInfoPtr, ImS8 v);
+extern ImS8 ImGuiStackLevelInfo_GetQueryFrameCount(ImGuiStackLevelInfo *self);
+extern void ImGuiStackLevelInfo_SetQuerySuccess(ImGuiStackLevelInfo *ImGuiStackLevelInfoPtr, bool v);
+extern bool ImGuiStackLevelInfo_GetQuerySuccess(ImGuiStackLevelInfo *self);
+extern void ImGuiStackLevelInfo_SetDataType(ImGuiStackLevelInfo *ImGuiStackLevelInfoPtr, ImGuiDataType v);
+extern ImGuiDataType ImGuiStackLevelInfo_GetDataType(ImGuiStackLevelInfo *self);
+extern void ImGuiStackTool_SetLastActiveFrame(ImGuiStackTool *ImGuiStackToolPtr, int v);
+extern int ImGuiStackTool_GetLastActiveFrame(ImGuiStackTool *self);
+extern void ImGuiStackTool_SetStackLevel(ImGuiStackTool *ImGuiStackToolPtr, int v);
+extern int ImGuiStackTool_GetStackLevel(ImGuiStackTool *self);
+extern void ImGuiStackTool_SetQueryId(ImGuiStackTool *ImGuiStackToolPtr, ImGuiID v);
+extern ImGuiID ImGuiStackTool_GetQueryId(ImGuiStackTool *self);
+extern void ImGuiStackTool_SetResults(ImGuiStackTool *ImGuiStackToolPtr, ImVector_ImGuiStackLevelInfo v);
+extern ImVector_ImGuiStackLevelInfo ImGuiStackTool_GetResults(ImGuiStackTool *self);
+extern void ImGuiStackTool_SetCopyToClipboardOnCtrlC(ImGuiStackTool *ImGuiStackToolPtr, bool v);
+extern bool ImGuiStackTool_GetCopyToClipboardOnCtrlC(ImGuiStackTool *self);
+extern void ImGuiStackTool_SetCopyToClipboardLastTime(ImGuiStackTool *ImGuiStackToolPtr, float v);
+extern float ImGuiStackTool_GetCopyToClipboardLastTime(ImGuiStackTool *self);
+extern void ImGuiContextHook_SetHookId(ImGuiContextHook *ImGuiContextHookPtr, ImGuiID v);
+extern ImGuiID ImGuiContextHook_GetHookId(ImGuiContextHook *self);
+extern void ImGuiContextHook_SetType(ImGuiContextHook *ImGuiContextHookPtr, ImGuiContextHookType v);
+extern ImGuiContextHookType ImGuiContextHook_GetType(ImGuiContextHook *self);
+extern void ImGuiContextHook_SetOwner(ImGuiContextHook *ImGuiContextHookPtr, ImGuiID v);
+extern ImGuiID ImGuiContextHook_GetOwner(ImGuiContextHook *self);
+extern void ImGuiContextHook_SetCallback(ImGuiContextHook *ImGuiContextHookPtr, ImGuiContextHookCallback v);
+extern ImGuiContextHookCallback ImGuiContextHook_GetCallback(ImGuiContextHook *self);
+extern void ImGuiContextHook_SetUserData(ImGuiContextHook *ImGuiContextHookPtr, void* v);
+extern void* ImGuiContextHook_GetUserData(ImGuiContextHook *self);
+extern void ImGuiContext_SetInitialized(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetInitialized(ImGuiContext *self);
+extern void ImGuiContext_SetFontAtlasOwnedByContext(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetFontAtlasOwnedByContext(ImGuiContext *self);
+extern void ImGuiContext_SetIO(ImGuiContext *ImGuiContextPtr, ImGuiIO v);
+extern ImGuiIO ImGuiContext_GetIO(ImGuiContext *self);
+extern void ImGuiContext_SetPlatformIO(ImGuiContext *ImGuiContextPtr, ImGuiPlatformIO v);
+extern ImGuiPlatformIO ImGuiContext_GetPlatformIO(ImGuiContext *self);
+extern void ImGuiContext_SetInputEventsQueue(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiInputEvent v);
+extern ImVector_ImGuiInputEvent ImGuiContext_GetInputEventsQueue(ImGuiContext *self);
+extern void ImGuiContext_SetInputEventsTrail(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiInputEvent v);
+extern ImVector_ImGuiInputEvent ImGuiContext_GetInputEventsTrail(ImGuiContext *self);
+extern void ImGuiContext_SetStyle(ImGuiContext *ImGuiContextPtr, ImGuiStyle v);
+extern ImGuiStyle ImGuiContext_GetStyle(ImGuiContext *self);
+extern void ImGuiContext_SetConfigFlagsCurrFrame(ImGuiContext *ImGuiContextPtr, ImGuiConfigFlags v);
+extern ImGuiConfigFlags ImGuiContext_GetConfigFlagsCurrFrame(ImGuiContext *self);
+extern void ImGuiContext_SetConfigFlagsLastFrame(ImGuiContext *ImGuiContextPtr, ImGuiConfigFlags v);
+extern ImGuiConfigFlags ImGuiContext_GetConfigFlagsLastFrame(ImGuiContext *self);
+extern void ImGuiContext_SetFont(ImGuiContext *ImGuiContextPtr, ImFont* v);
+extern ImFont* ImGuiContext_GetFont(ImGuiContext *self);
+extern void ImGuiContext_SetFontSize(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetFontSize(ImGuiContext *self);
+extern void ImGuiContext_SetFontBaseSize(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetFontBaseSize(ImGuiContext *self);
+extern void ImGuiContext_SetDrawListSharedData(ImGuiContext *ImGuiContextPtr, ImDrawListSharedData v);
+extern ImDrawListSharedData ImGuiContext_GetDrawListSharedData(ImGuiContext *self);
+extern void ImGuiContext_SetTime(ImGuiContext *ImGuiContextPtr, double v);
+extern double ImGuiContext_GetTime(ImGuiContext *self);
+extern void ImGuiContext_SetFrameCount(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetFrameCount(ImGuiContext *self);
+extern void ImGuiContext_SetFrameCountEnded(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetFrameCountEnded(ImGuiContext *self);
+extern void ImGuiContext_SetFrameCountPlatformEnded(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetFrameCountPlatformEnded(ImGuiContext *self);
+extern void ImGuiContext_SetFrameCountRendered(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetFrameCountRendered(ImGuiContext *self);
+extern void ImGuiContext_SetWithinFrameScope(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetWithinFrameScope(ImGuiContext *self);
+extern void ImGuiContext_SetWithinFrameScopeWithImplicitWindow(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetWithinFrameScopeWithImplicitWindow(ImGuiContext *self);
+extern void ImGuiContext_SetWithinEndChild(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetWithinEndChild(ImGuiContext *self);
+extern void ImGuiContext_SetGcCompactAll(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetGcCompactAll(ImGuiContext *self);
+extern void ImGuiContext_SetTestEngineHookItems(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetTestEngineHookItems(ImGuiContext *self);
+extern void ImGuiContext_SetTestEngine(ImGuiContext *ImGuiContextPtr, void* v);
+extern void* ImGuiContext_GetTestEngine(ImGuiContext *self);
+extern void ImGuiContext_SetWindows(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiWindowPtr v);
+extern ImVector_ImGuiWindowPtr ImGuiContext_GetWindows(ImGuiContext *self);
+extern void ImGuiContext_SetWindowsFocusOrder(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiWindowPtr v);
+extern ImVector_ImGuiWindowPtr ImGuiContext_GetWindowsFocusOrder(ImGuiContext *self);
+extern void ImGuiContext_SetWindowsTempSortBuffer(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiWindowPtr v);
+extern ImVector_ImGuiWindowPtr ImGuiContext_GetWindowsTempSortBuffer(ImGuiContext *self);
+extern void ImGuiContext_SetCurrentWindowStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiWindowStackData v);
+extern ImVector_ImGuiWindowStackData ImGuiContext_GetCurrentWindowStack(ImGuiContext *self);
+extern void ImGuiContext_SetWindowsById(ImGuiContext *ImGuiContextPtr, ImGuiStorage v);
+extern ImGuiStorage ImGuiContext_GetWindowsById(ImGuiContext *self);
+extern void ImGuiContext_SetWindowsActiveCount(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetWindowsActiveCount(ImGuiContext *self);
+extern void ImGuiContext_SetWindowsHoverPadding(ImGuiContext *ImGuiContextPtr, ImVec2 v);
+extern ImVec2 ImGuiContext_GetWindowsHoverPadding(ImGuiContext *self);
+extern void ImGuiContext_SetCurrentWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetCurrentWindow(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetHoveredWindow(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredWindowUnderMovingWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetHoveredWindowUnderMovingWindow(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredDockNode(ImGuiContext *ImGuiContextPtr, ImGuiDockNode* v);
+extern ImGuiDockNode* ImGuiContext_GetHoveredDockNode(ImGuiContext *self);
+extern void ImGuiContext_SetMovingWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetMovingWindow(ImGuiContext *self);
+extern void ImGuiContext_SetWheelingWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetWheelingWindow(ImGuiContext *self);
+extern void ImGuiContext_SetWheelingWindowRefMousePos(ImGuiContext *ImGuiContextPtr, ImVec2 v);
+extern ImVec2 ImGuiContext_GetWheelingWindowRefMousePos(ImGuiContext *self);
+extern void ImGuiContext_SetWheelingWindowTimer(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetWheelingWindowTimer(ImGuiContext *self);
+extern void ImGuiContext_SetDebugHookIdInfo(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetDebugHookIdInfo(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetHoveredId(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredIdPreviousFrame(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetHoveredIdPreviousFrame(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredIdAllowOverlap(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetHoveredIdAllowOverlap(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredIdUsingMouseWheel(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetHoveredIdUsingMouseWheel(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredIdPreviousFrameUsingMouseWheel(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetHoveredIdPreviousFrameUsingMouseWheel(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredIdDisabled(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetHoveredIdDisabled(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredIdTimer(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetHoveredIdTimer(ImGuiContext *self);
+extern void ImGuiContext_SetHoveredIdNotActiveTimer(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetHoveredIdNotActiveTimer(ImGuiContext *self);
+extern void ImGuiContext_SetActiveId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetActiveId(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdIsAlive(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetActiveIdIsAlive(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdTimer(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetActiveIdTimer(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdIsJustActivated(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetActiveIdIsJustActivated(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdAllowOverlap(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetActiveIdAllowOverlap(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdNoClearOnFocusLoss(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetActiveIdNoClearOnFocusLoss(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdHasBeenPressedBefore(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetActiveIdHasBeenPressedBefore(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdHasBeenEditedBefore(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetActiveIdHasBeenEditedBefore(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdHasBeenEditedThisFrame(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetActiveIdHasBeenEditedThisFrame(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdClickOffset(ImGuiContext *ImGuiContextPtr, ImVec2 v);
+extern ImVec2 ImGuiContext_GetActiveIdClickOffset(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetActiveIdWindow(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdSource(ImGuiContext *ImGuiContextPtr, ImGuiInputSource v);
+extern ImGuiInputSource ImGuiContext_GetActiveIdSource(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdMouseButton(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetActiveIdMouseButton(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdPreviousFrame(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetActiveIdPreviousFrame(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdPreviousFrameIsAlive(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetActiveIdPreviousFrameIsAlive(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdPreviousFrameHasBeenEditedBefore(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetActiveIdPreviousFrameHasBeenEditedBefore(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdPreviousFrameWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetActiveIdPreviousFrameWindow(ImGuiContext *self);
+extern void ImGuiContext_SetLastActiveId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetLastActiveId(ImGuiContext *self);
+extern void ImGuiContext_SetLastActiveIdTimer(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetLastActiveIdTimer(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdUsingNavDirMask(ImGuiContext *ImGuiContextPtr, ImU32 v);
+extern ImU32 ImGuiContext_GetActiveIdUsingNavDirMask(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdUsingKeyInputMask(ImGuiContext *ImGuiContextPtr, ImBitArrayForNamedKeys v);
+extern ImBitArrayForNamedKeys ImGuiContext_GetActiveIdUsingKeyInputMask(ImGuiContext *self);
+extern void ImGuiContext_SetActiveIdUsingNavInputMask(ImGuiContext *ImGuiContextPtr, ImU32 v);
+extern ImU32 ImGuiContext_GetActiveIdUsingNavInputMask(ImGuiContext *self);
+extern void ImGuiContext_SetCurrentItemFlags(ImGuiContext *ImGuiContextPtr, ImGuiItemFlags v);
+extern ImGuiItemFlags ImGuiContext_GetCurrentItemFlags(ImGuiContext *self);
+extern void ImGuiContext_SetNextItemData(ImGuiContext *ImGuiContextPtr, ImGuiNextItemData v);
+extern ImGuiNextItemData ImGuiContext_GetNextItemData(ImGuiContext *self);
+extern void ImGuiContext_SetLastItemData(ImGuiContext *ImGuiContextPtr, ImGuiLastItemData v);
+extern ImGuiLastItemData ImGuiContext_GetLastItemData(ImGuiContext *self);
+extern void ImGuiContext_SetNextWindowData(ImGuiContext *ImGuiContextPtr, ImGuiNextWindowData v);
+extern ImGuiNextWindowData ImGuiContext_GetNextWindowData(ImGuiContext *self);
+extern void ImGuiContext_SetColorStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiColorMod v);
+extern ImVector_ImGuiColorMod ImGuiContext_GetColorStack(ImGuiContext *self);
+extern void ImGuiContext_SetStyleVarStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiStyleMod v);
+extern ImVector_ImGuiStyleMod ImGuiContext_GetStyleVarStack(ImGuiContext *self);
+extern void ImGuiContext_SetFontStack(ImGuiContext *ImGuiContextPtr, ImVector_ImFontPtr v);
+extern ImVector_ImFontPtr ImGuiContext_GetFontStack(ImGuiContext *self);
+extern void ImGuiContext_SetFocusScopeStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiID v);
+extern ImVector_ImGuiID ImGuiContext_GetFocusScopeStack(ImGuiContext *self);
+extern void ImGuiContext_SetItemFlagsStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiItemFlags v);
+extern ImVector_ImGuiItemFlags ImGuiContext_GetItemFlagsStack(ImGuiContext *self);
+extern void ImGuiContext_SetGroupStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiGroupData v);
+extern ImVector_ImGuiGroupData ImGuiContext_GetGroupStack(ImGuiContext *self);
+extern void ImGuiContext_SetOpenPopupStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiPopupData v);
+extern ImVector_ImGuiPopupData ImGuiContext_GetOpenPopupStack(ImGuiContext *self);
+extern void ImGuiContext_SetBeginPopupStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiPopupData v);
+extern ImVector_ImGuiPopupData ImGuiContext_GetBeginPopupStack(ImGuiContext *self);
+extern void ImGuiContext_SetBeginMenuCount(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetBeginMenuCount(ImGuiContext *self);
+extern void ImGuiContext_SetViewports(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiViewportPPtr v);
+extern ImVector_ImGuiViewportPPtr ImGuiContext_GetViewports(ImGuiContext *self);
+extern void ImGuiContext_SetCurrentDpiScale(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetCurrentDpiScale(ImGuiContext *self);
+extern void ImGuiContext_SetCurrentViewport(ImGuiContext *ImGuiContextPtr, ImGuiViewportP* v);
+extern ImGuiViewportP* ImGuiContext_GetCurrentViewport(ImGuiContext *self);
+extern void ImGuiContext_SetMouseViewport(ImGuiContext *ImGuiContextPtr, ImGuiViewportP* v);
+extern ImGuiViewportP* ImGuiContext_GetMouseViewport(ImGuiContext *self);
+extern void ImGuiContext_SetMouseLastHoveredViewport(ImGuiContext *ImGuiContextPtr, ImGuiViewportP* v);
+extern ImGuiViewportP* ImGuiContext_GetMouseLastHoveredViewport(ImGuiContext *self);
+extern void ImGuiContext_SetPlatformLastFocusedViewportId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetPlatformLastFocusedViewportId(ImGuiContext *self);
+extern void ImGuiContext_SetFallbackMonitor(ImGuiContext *ImGuiContextPtr, ImGuiPlatformMonitor v);
+extern ImGuiPlatformMonitor ImGuiContext_GetFallbackMonitor(ImGuiContext *self);
+extern void ImGuiContext_SetViewportFrontMostStampCount(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetViewportFrontMostStampCount(ImGuiContext *self);
+extern void ImGuiContext_SetNavWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetNavWindow(ImGuiContext *self);
+extern void ImGuiContext_SetNavId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetNavId(ImGuiContext *self);
+extern void ImGuiContext_SetNavFocusScopeId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetNavFocusScopeId(ImGuiContext *self);
+extern void ImGuiContext_SetNavActivateId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetNavActivateId(ImGuiContext *self);
+extern void ImGuiContext_SetNavActivateDownId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetNavActivateDownId(ImGuiContext *self);
+extern void ImGuiContext_SetNavActivatePressedId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetNavActivatePressedId(ImGuiContext *self);
+extern void ImGuiContext_SetNavActivateInputId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetNavActivateInputId(ImGuiContext *self);
+extern void ImGuiContext_SetNavActivateFlags(ImGuiContext *ImGuiContextPtr, ImGuiActivateFlags v);
+extern ImGuiActivateFlags ImGuiContext_GetNavActivateFlags(ImGuiContext *self);
+extern void ImGuiContext_SetNavJustMovedToId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetNavJustMovedToId(ImGuiContext *self);
+extern void ImGuiContext_SetNavJustMovedToFocusScopeId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetNavJustMovedToFocusScopeId(ImGuiContext *self);
+extern void ImGuiContext_SetNavJustMovedToKeyMods(ImGuiContext *ImGuiContextPtr, ImGuiModFlags v);
+extern ImGuiModFlags ImGuiContext_GetNavJustMovedToKeyMods(ImGuiContext *self);
+extern void ImGuiContext_SetNavNextActivateId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetNavNextActivateId(ImGuiContext *self);
+extern void ImGuiContext_SetNavNextActivateFlags(ImGuiContext *ImGuiContextPtr, ImGuiActivateFlags v);
+extern ImGuiActivateFlags ImGuiContext_GetNavNextActivateFlags(ImGuiContext *self);
+extern void ImGuiContext_SetNavInputSource(ImGuiContext *ImGuiContextPtr, ImGuiInputSource v);
+extern ImGuiInputSource ImGuiContext_GetNavInputSource(ImGuiContext *self);
+extern void ImGuiContext_SetNavLayer(ImGuiContext *ImGuiContextPtr, ImGuiNavLayer v);
+extern ImGuiNavLayer ImGuiContext_GetNavLayer(ImGuiContext *self);
+extern void ImGuiContext_SetNavIdIsAlive(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavIdIsAlive(ImGuiContext *self);
+extern void ImGuiContext_SetNavMousePosDirty(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavMousePosDirty(ImGuiContext *self);
+extern void ImGuiContext_SetNavDisableHighlight(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavDisableHighlight(ImGuiContext *self);
+extern void ImGuiContext_SetNavDisableMouseHover(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavDisableMouseHover(ImGuiContext *self);
+extern void ImGuiContext_SetNavAnyRequest(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavAnyRequest(ImGuiContext *self);
+extern void ImGuiContext_SetNavInitRequest(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavInitRequest(ImGuiContext *self);
+extern void ImGuiContext_SetNavInitRequestFromMove(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavInitRequestFromMove(ImGuiContext *self);
+extern void ImGuiContext_SetNavInitResultId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetNavInitResultId(ImGuiContext *self);
+extern void ImGuiContext_SetNavInitResultRectRel(ImGuiContext *ImGuiContextPtr, ImRect v);
+extern ImRect ImGuiContext_GetNavInitResultRectRel(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveSubmitted(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavMoveSubmitted(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveScoringItems(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavMoveScoringItems(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveForwardToNextFrame(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavMoveForwardToNextFrame(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveFlags(ImGuiContext *ImGuiContextPtr, ImGuiNavMoveFlags v);
+extern ImGuiNavMoveFlags ImGuiContext_GetNavMoveFlags(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveScrollFlags(ImGuiContext *ImGuiContextPtr, ImGuiScrollFlags v);
+extern ImGuiScrollFlags ImGuiContext_GetNavMoveScrollFlags(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveKeyMods(ImGuiContext *ImGuiContextPtr, ImGuiModFlags v);
+extern ImGuiModFlags ImGuiContext_GetNavMoveKeyMods(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveDir(ImGuiContext *ImGuiContextPtr, ImGuiDir v);
+extern ImGuiDir ImGuiContext_GetNavMoveDir(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveDirForDebug(ImGuiContext *ImGuiContextPtr, ImGuiDir v);
+extern ImGuiDir ImGuiContext_GetNavMoveDirForDebug(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveClipDir(ImGuiContext *ImGuiContextPtr, ImGuiDir v);
+extern ImGuiDir ImGuiContext_GetNavMoveClipDir(ImGuiContext *self);
+extern void ImGuiContext_SetNavScoringRect(ImGuiContext *ImGuiContextPtr, ImRect v);
+extern ImRect ImGuiContext_GetNavScoringRect(ImGuiContext *self);
+extern void ImGuiContext_SetNavScoringNoClipRect(ImGuiContext *ImGuiContextPtr, ImRect v);
+extern ImRect ImGuiContext_GetNavScoringNoClipRect(ImGuiContext *self);
+extern void ImGuiContext_SetNavScoringDebugCount(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetNavScoringDebugCount(ImGuiContext *self);
+extern void ImGuiContext_SetNavTabbingDir(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetNavTabbingDir(ImGuiContext *self);
+extern void ImGuiContext_SetNavTabbingCounter(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetNavTabbingCounter(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveResultLocal(ImGuiContext *ImGuiContextPtr, ImGuiNavItemData v);
+extern ImGuiNavItemData ImGuiContext_GetNavMoveResultLocal(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveResultLocalVisible(ImGuiContext *ImGuiContextPtr, ImGuiNavItemData v);
+extern ImGuiNavItemData ImGuiContext_GetNavMoveResultLocalVisible(ImGuiContext *self);
+extern void ImGuiContext_SetNavMoveResultOther(ImGuiContext *ImGuiContextPtr, ImGuiNavItemData v);
+extern ImGuiNavItemData ImGuiContext_GetNavMoveResultOther(ImGuiContext *self);
+extern void ImGuiContext_SetNavTabbingResultFirst(ImGuiContext *ImGuiContextPtr, ImGuiNavItemData v);
+extern ImGuiNavItemData ImGuiContext_GetNavTabbingResultFirst(ImGuiContext *self);
+extern void ImGuiContext_SetNavWindowingTarget(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetNavWindowingTarget(ImGuiContext *self);
+extern void ImGuiContext_SetNavWindowingTargetAnim(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetNavWindowingTargetAnim(ImGuiContext *self);
+extern void ImGuiContext_SetNavWindowingListWindow(ImGuiContext *ImGuiContextPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiContext_GetNavWindowingListWindow(ImGuiContext *self);
+extern void ImGuiContext_SetNavWindowingTimer(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetNavWindowingTimer(ImGuiContext *self);
+extern void ImGuiContext_SetNavWindowingHighlightAlpha(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetNavWindowingHighlightAlpha(ImGuiContext *self);
+extern void ImGuiContext_SetNavWindowingToggleLayer(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetNavWindowingToggleLayer(ImGuiContext *self);
+extern void ImGuiContext_SetNavWindowingAccumDeltaPos(ImGuiContext *ImGuiContextPtr, ImVec2 v);
+extern ImVec2 ImGuiContext_GetNavWindowingAccumDeltaPos(ImGuiContext *self);
+extern void ImGuiContext_SetNavWindowingAccumDeltaSize(ImGuiContext *ImGuiContextPtr, ImVec2 v);
+extern ImVec2 ImGuiContext_GetNavWindowingAccumDeltaSize(ImGuiContext *self);
+extern void ImGuiContext_SetDimBgRatio(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetDimBgRatio(ImGuiContext *self);
+extern void ImGuiContext_SetMouseCursor(ImGuiContext *ImGuiContextPtr, ImGuiMouseCursor v);
+extern ImGuiMouseCursor ImGuiContext_GetMouseCursor(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropActive(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetDragDropActive(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropWithinSource(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetDragDropWithinSource(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropWithinTarget(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetDragDropWithinTarget(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropSourceFlags(ImGuiContext *ImGuiContextPtr, ImGuiDragDropFlags v);
+extern ImGuiDragDropFlags ImGuiContext_GetDragDropSourceFlags(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropSourceFrameCount(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetDragDropSourceFrameCount(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropMouseButton(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetDragDropMouseButton(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropPayload(ImGuiContext *ImGuiContextPtr, ImGuiPayload v);
+extern ImGuiPayload ImGuiContext_GetDragDropPayload(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropTargetRect(ImGuiContext *ImGuiContextPtr, ImRect v);
+extern ImRect ImGuiContext_GetDragDropTargetRect(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropTargetId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetDragDropTargetId(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropAcceptFlags(ImGuiContext *ImGuiContextPtr, ImGuiDragDropFlags v);
+extern ImGuiDragDropFlags ImGuiContext_GetDragDropAcceptFlags(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropAcceptIdCurrRectSurface(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetDragDropAcceptIdCurrRectSurface(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropAcceptIdCurr(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetDragDropAcceptIdCurr(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropAcceptIdPrev(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetDragDropAcceptIdPrev(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropAcceptFrameCount(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetDragDropAcceptFrameCount(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropHoldJustPressedId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetDragDropHoldJustPressedId(ImGuiContext *self);
+extern void ImGuiContext_SetDragDropPayloadBufHeap(ImGuiContext *ImGuiContextPtr, ImVector_unsigned_char v);
+extern ImVector_unsigned_char ImGuiContext_GetDragDropPayloadBufHeap(ImGuiContext *self);
+extern void ImGuiContext_SetClipperTempDataStacked(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetClipperTempDataStacked(ImGuiContext *self);
+extern void ImGuiContext_SetClipperTempData(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiListClipperData v);
+extern ImVector_ImGuiListClipperData ImGuiContext_GetClipperTempData(ImGuiContext *self);
+extern void ImGuiContext_SetCurrentTable(ImGuiContext *ImGuiContextPtr, ImGuiTable* v);
+extern ImGuiTable* ImGuiContext_GetCurrentTable(ImGuiContext *self);
+extern void ImGuiContext_SetTablesTempDataStacked(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetTablesTempDataStacked(ImGuiContext *self);
+extern void ImGuiContext_SetTablesTempData(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiTableTempData v);
+extern ImVector_ImGuiTableTempData ImGuiContext_GetTablesTempData(ImGuiContext *self);
+extern void ImGuiContext_SetTables(ImGuiContext *ImGuiContextPtr, ImPool_ImGuiTable v);
+extern ImPool_ImGuiTable ImGuiContext_GetTables(ImGuiContext *self);
+extern void ImGuiContext_SetTablesLastTimeActive(ImGuiContext *ImGuiContextPtr, ImVector_float v);
+extern ImVector_float ImGuiContext_GetTablesLastTimeActive(ImGuiContext *self);
+extern void ImGuiContext_SetDrawChannelsTempMergeBuffer(ImGuiContext *ImGuiContextPtr, ImVector_ImDrawChannel v);
+extern ImVector_ImDrawChannel ImGuiContext_GetDrawChannelsTempMergeBuffer(ImGuiContext *self);
+extern void ImGuiContext_SetCurrentTabBar(ImGuiContext *ImGuiContextPtr, ImGuiTabBar* v);
+extern ImGuiTabBar* ImGuiContext_GetCurrentTabBar(ImGuiContext *self);
+extern void ImGuiContext_SetTabBars(ImGuiContext *ImGuiContextPtr, ImPool_ImGuiTabBar v);
+extern ImPool_ImGuiTabBar ImGuiContext_GetTabBars(ImGuiContext *self);
+extern void ImGuiContext_SetCurrentTabBarStack(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiPtrOrIndex v);
+extern ImVector_ImGuiPtrOrIndex ImGuiContext_GetCurrentTabBarStack(ImGuiContext *self);
+extern void ImGuiContext_SetShrinkWidthBuffer(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiShrinkWidthItem v);
+extern ImVector_ImGuiShrinkWidthItem ImGuiContext_GetShrinkWidthBuffer(ImGuiContext *self);
+extern void ImGuiContext_SetMouseLastValidPos(ImGuiContext *ImGuiContextPtr, ImVec2 v);
+extern ImVec2 ImGuiContext_GetMouseLastValidPos(ImGuiContext *self);
+extern void ImGuiContext_SetInputTextState(ImGuiContext *ImGuiContextPtr, ImGuiInputTextState v);
+extern ImGuiInputTextState ImGuiContext_GetInputTextState(ImGuiContext *self);
+extern void ImGuiContext_SetInputTextPasswordFont(ImGuiContext *ImGuiContextPtr, ImFont v);
+extern ImFont ImGuiContext_GetInputTextPasswordFont(ImGuiContext *self);
+extern void ImGuiContext_SetTempInputId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetTempInputId(ImGuiContext *self);
+extern void ImGuiContext_SetColorEditOptions(ImGuiContext *ImGuiContextPtr, ImGuiColorEditFlags v);
+extern ImGuiColorEditFlags ImGuiContext_GetColorEditOptions(ImGuiContext *self);
+extern void ImGuiContext_SetColorEditLastHue(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetColorEditLastHue(ImGuiContext *self);
+extern void ImGuiContext_SetColorEditLastSat(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetColorEditLastSat(ImGuiContext *self);
+extern void ImGuiContext_SetColorEditLastColor(ImGuiContext *ImGuiContextPtr, ImU32 v);
+extern ImU32 ImGuiContext_GetColorEditLastColor(ImGuiContext *self);
+extern void ImGuiContext_SetColorPickerRef(ImGuiContext *ImGuiContextPtr, ImVec4 v);
+extern ImVec4 ImGuiContext_GetColorPickerRef(ImGuiContext *self);
+extern void ImGuiContext_SetComboPreviewData(ImGuiContext *ImGuiContextPtr, ImGuiComboPreviewData v);
+extern ImGuiComboPreviewData ImGuiContext_GetComboPreviewData(ImGuiContext *self);
+extern void ImGuiContext_SetSliderGrabClickOffset(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetSliderGrabClickOffset(ImGuiContext *self);
+extern void ImGuiContext_SetSliderCurrentAccum(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetSliderCurrentAccum(ImGuiContext *self);
+extern void ImGuiContext_SetSliderCurrentAccumDirty(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetSliderCurrentAccumDirty(ImGuiContext *self);
+extern void ImGuiContext_SetDragCurrentAccumDirty(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetDragCurrentAccumDirty(ImGuiContext *self);
+extern void ImGuiContext_SetDragCurrentAccum(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetDragCurrentAccum(ImGuiContext *self);
+extern void ImGuiContext_SetDragSpeedDefaultRatio(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetDragSpeedDefaultRatio(ImGuiContext *self);
+extern void ImGuiContext_SetScrollbarClickDeltaToGrabCenter(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetScrollbarClickDeltaToGrabCenter(ImGuiContext *self);
+extern void ImGuiContext_SetDisabledAlphaBackup(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetDisabledAlphaBackup(ImGuiContext *self);
+extern void ImGuiContext_SetDisabledStackSize(ImGuiContext *ImGuiContextPtr, short v);
+extern short ImGuiContext_GetDisabledStackSize(ImGuiContext *self);
+extern void ImGuiContext_SetTooltipOverrideCount(ImGuiContext *ImGuiContextPtr, short v);
+extern short ImGuiContext_GetTooltipOverrideCount(ImGuiContext *self);
+extern void ImGuiContext_SetTooltipSlowDelay(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetTooltipSlowDelay(ImGuiContext *self);
+extern void ImGuiContext_SetClipboardHandlerData(ImGuiContext *ImGuiContextPtr, ImVector_char v);
+extern ImVector_char ImGuiContext_GetClipboardHandlerData(ImGuiContext *self);
+extern void ImGuiContext_SetMenusIdSubmittedThisFrame(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiID v);
+extern ImVector_ImGuiID ImGuiContext_GetMenusIdSubmittedThisFrame(ImGuiContext *self);
+extern void ImGuiContext_SetPlatformImeData(ImGuiContext *ImGuiContextPtr, ImGuiPlatformImeData v);
+extern ImGuiPlatformImeData ImGuiContext_GetPlatformImeData(ImGuiContext *self);
+extern void ImGuiContext_SetPlatformImeDataPrev(ImGuiContext *ImGuiContextPtr, ImGuiPlatformImeData v);
+extern ImGuiPlatformImeData ImGuiContext_GetPlatformImeDataPrev(ImGuiContext *self);
+extern void ImGuiContext_SetPlatformImeViewport(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetPlatformImeViewport(ImGuiContext *self);
+extern void ImGuiContext_SetPlatformLocaleDecimalPoint(ImGuiContext *ImGuiContextPtr, char v);
+extern char ImGuiContext_GetPlatformLocaleDecimalPoint(ImGuiContext *self);
+extern void ImGuiContext_SetDockContext(ImGuiContext *ImGuiContextPtr, ImGuiDockContext v);
+extern ImGuiDockContext ImGuiContext_GetDockContext(ImGuiContext *self);
+extern void ImGuiContext_SetSettingsLoaded(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetSettingsLoaded(ImGuiContext *self);
+extern void ImGuiContext_SetSettingsDirtyTimer(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetSettingsDirtyTimer(ImGuiContext *self);
+extern void ImGuiContext_SetSettingsIniData(ImGuiContext *ImGuiContextPtr, ImGuiTextBuffer v);
+extern ImGuiTextBuffer ImGuiContext_GetSettingsIniData(ImGuiContext *self);
+extern void ImGuiContext_SetSettingsHandlers(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiSettingsHandler v);
+extern ImVector_ImGuiSettingsHandler ImGuiContext_GetSettingsHandlers(ImGuiContext *self);
+extern void ImGuiContext_SetSettingsWindows(ImGuiContext *ImGuiContextPtr, ImChunkStream_ImGuiWindowSettings v);
+extern ImChunkStream_ImGuiWindowSettings ImGuiContext_GetSettingsWindows(ImGuiContext *self);
+extern void ImGuiContext_SetSettingsTables(ImGuiContext *ImGuiContextPtr, ImChunkStream_ImGuiTableSettings v);
+extern ImChunkStream_ImGuiTableSettings ImGuiContext_GetSettingsTables(ImGuiContext *self);
+extern void ImGuiContext_SetHooks(ImGuiContext *ImGuiContextPtr, ImVector_ImGuiContextHook v);
+extern ImVector_ImGuiContextHook ImGuiContext_GetHooks(ImGuiContext *self);
+extern void ImGuiContext_SetHookIdNext(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetHookIdNext(ImGuiContext *self);
+extern void ImGuiContext_SetLogEnabled(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetLogEnabled(ImGuiContext *self);
+extern void ImGuiContext_SetLogType(ImGuiContext *ImGuiContextPtr, ImGuiLogType v);
+extern ImGuiLogType ImGuiContext_GetLogType(ImGuiContext *self);
+extern void ImGuiContext_SetLogFile(ImGuiContext *ImGuiContextPtr, ImFileHandle v);
+extern ImFileHandle ImGuiContext_GetLogFile(ImGuiContext *self);
+extern void ImGuiContext_SetLogBuffer(ImGuiContext *ImGuiContextPtr, ImGuiTextBuffer v);
+extern ImGuiTextBuffer ImGuiContext_GetLogBuffer(ImGuiContext *self);
+extern void ImGuiContext_SetLogNextPrefix(ImGuiContext *ImGuiContextPtr, const char* v);
+extern const char* ImGuiContext_GetLogNextPrefix(ImGuiContext *self);
+extern void ImGuiContext_SetLogNextSuffix(ImGuiContext *ImGuiContextPtr, const char* v);
+extern const char* ImGuiContext_GetLogNextSuffix(ImGuiContext *self);
+extern void ImGuiContext_SetLogLinePosY(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetLogLinePosY(ImGuiContext *self);
+extern void ImGuiContext_SetLogLineFirstItem(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetLogLineFirstItem(ImGuiContext *self);
+extern void ImGuiContext_SetLogDepthRef(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetLogDepthRef(ImGuiContext *self);
+extern void ImGuiContext_SetLogDepthToExpand(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetLogDepthToExpand(ImGuiContext *self);
+extern void ImGuiContext_SetLogDepthToExpandDefault(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetLogDepthToExpandDefault(ImGuiContext *self);
+extern void ImGuiContext_SetDebugLogFlags(ImGuiContext *ImGuiContextPtr, ImGuiDebugLogFlags v);
+extern ImGuiDebugLogFlags ImGuiContext_GetDebugLogFlags(ImGuiContext *self);
+extern void ImGuiContext_SetDebugLogBuf(ImGuiContext *ImGuiContextPtr, ImGuiTextBuffer v);
+extern ImGuiTextBuffer ImGuiContext_GetDebugLogBuf(ImGuiContext *self);
+extern void ImGuiContext_SetDebugItemPickerActive(ImGuiContext *ImGuiContextPtr, bool v);
+extern bool ImGuiContext_GetDebugItemPickerActive(ImGuiContext *self);
+extern void ImGuiContext_SetDebugItemPickerMouseButton(ImGuiContext *ImGuiContextPtr, ImU8 v);
+extern ImU8 ImGuiContext_GetDebugItemPickerMouseButton(ImGuiContext *self);
+extern void ImGuiContext_SetDebugItemPickerBreakId(ImGuiContext *ImGuiContextPtr, ImGuiID v);
+extern ImGuiID ImGuiContext_GetDebugItemPickerBreakId(ImGuiContext *self);
+extern void ImGuiContext_SetDebugMetricsConfig(ImGuiContext *ImGuiContextPtr, ImGuiMetricsConfig v);
+extern ImGuiMetricsConfig ImGuiContext_GetDebugMetricsConfig(ImGuiContext *self);
+extern void ImGuiContext_SetDebugStackTool(ImGuiContext *ImGuiContextPtr, ImGuiStackTool v);
+extern ImGuiStackTool ImGuiContext_GetDebugStackTool(ImGuiContext *self);
+extern void ImGuiContext_SetFramerateSecPerFrameIdx(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetFramerateSecPerFrameIdx(ImGuiContext *self);
+extern void ImGuiContext_SetFramerateSecPerFrameCount(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetFramerateSecPerFrameCount(ImGuiContext *self);
+extern void ImGuiContext_SetFramerateSecPerFrameAccum(ImGuiContext *ImGuiContextPtr, float v);
+extern float ImGuiContext_GetFramerateSecPerFrameAccum(ImGuiContext *self);
+extern void ImGuiContext_SetWantCaptureMouseNextFrame(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetWantCaptureMouseNextFrame(ImGuiContext *self);
+extern void ImGuiContext_SetWantCaptureKeyboardNextFrame(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetWantCaptureKeyboardNextFrame(ImGuiContext *self);
+extern void ImGuiContext_SetWantTextInputNextFrame(ImGuiContext *ImGuiContextPtr, int v);
+extern int ImGuiContext_GetWantTextInputNextFrame(ImGuiContext *self);
+extern void ImGuiContext_SetTempBuffer(ImGuiContext *ImGuiContextPtr, ImVector_char v);
+extern ImVector_char ImGuiContext_GetTempBuffer(ImGuiContext *self);
 extern void ImGuiWindowTempData_SetCursorPos(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
 extern ImVec2 ImGuiWindowTempData_GetCursorPos(ImGuiWindowTempData *self);
 extern void ImGuiWindowTempData_SetCursorPosPrevLine(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
 extern ImVec2 ImGuiWindowTempData_GetCursorPosPrevLine(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetCursorStartPos(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiWindowTempData_GetCursorStartPos(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetCursorMaxPos(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiWindowTempData_GetCursorMaxPos(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetIdealMaxPos(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiWindowTempData_GetIdealMaxPos(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetCurrLineSize(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiWindowTempData_GetCurrLineSize(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetPrevLineSize(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiWindowTempData_GetPrevLineSize(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetCurrLineTextBaseOffset(ImGuiWindowTempData *ImGuiWindowTempDataPtr, float v);
-extern float ImGuiWindowTempData_GetCurrLineTextBaseOffset(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetPrevLineTextBaseOffset(ImGuiWindowTempData *ImGuiWindowTempDataPtr, float v);
-extern float ImGuiWindowTempData_GetPrevLineTextBaseOffset(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetIsSameLine(ImGuiWindowTempData *ImGuiWindowTempDataPtr, bool v);
-extern bool ImGuiWindowTempData_GetIsSameLine(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetIndent(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec1 v);
-extern ImVec1 ImGuiWindowTempData_GetIndent(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetColumnsOffset(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec1 v);
-extern ImVec1 ImGuiWindowTempData_GetColumnsOffset(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetGroupOffset(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec1 v);
-extern ImVec1 ImGuiWindowTempData_GetGroupOffset(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetCursorStartPosLossyness(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiWindowTempData_GetCursorStartPosLossyness(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetNavLayerCurrent(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiNavLayer v);
-extern ImGuiNavLayer ImGuiWindowTempData_GetNavLayerCurrent(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetNavLayersActiveMask(ImGuiWindowTempData *ImGuiWindowTempDataPtr, short v);
-extern short ImGuiWindowTempData_GetNavLayersActiveMask(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetNavLayersActiveMaskNext(ImGuiWindowTempData *ImGuiWindowTempDataPtr, short v);
-extern short ImGuiWindowTempData_GetNavLayersActiveMaskNext(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetNavFocusScopeIdCurrent(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiID v);
-extern ImGuiID ImGuiWindowTempData_GetNavFocusScopeIdCurrent(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetNavHideHighlightOneFrame(ImGuiWindowTempData *ImGuiWindowTempDataPtr, bool v);
-extern bool ImGuiWindowTempData_GetNavHideHighlightOneFrame(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetNavHasScroll(ImGuiWindowTempData *ImGuiWindowTempDataPtr, bool v);
-extern bool ImGuiWindowTempData_GetNavHasScroll(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetMenuBarAppending(ImGuiWindowTempData *ImGuiWindowTempDataPtr, bool v);
-extern bool ImGuiWindowTempData_GetMenuBarAppending(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetMenuBarOffset(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
-extern ImVec2 ImGuiWindowTempData_GetMenuBarOffset(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetMenuColumns(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiMenuColumns v);
-extern ImGuiMenuColumns ImGuiWindowTempData_GetMenuColumns(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetTreeDepth(ImGuiWindowTempData *ImGuiWindowTempDataPtr, int v);
-extern int ImGuiWindowTempData_GetTreeDepth(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetTreeJumpToParentOnPopMask(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImU32 v);
-extern ImU32 ImGuiWindowTempData_GetTreeJumpToParentOnPopMask(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetChildWindows(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVector_ImGuiWindowPtr v);
-extern ImVector_ImGuiWindowPtr ImGuiWindowTempData_GetChildWindows(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetStateStorage(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiStorage* v);
-extern ImGuiStorage* ImGuiWindowTempData_GetStateStorage(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetCurrentColumns(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiOldColumns* v);
-extern ImGuiOldColumns* ImGuiWindowTempData_GetCurrentColumns(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetCurrentTableIdx(ImGuiWindowTempData *ImGuiWindowTempDataPtr, int v);
-extern int ImGuiWindowTempData_GetCurrentTableIdx(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetLayoutType(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiLayoutType v);
-extern ImGuiLayoutType ImGuiWindowTempData_GetLayoutType(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetParentLayoutType(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiLayoutType v);
-extern ImGuiLayoutType ImGuiWindowTempData_GetParentLayoutType(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetItemWidth(ImGuiWindowTempData *ImGuiWindowTempDataPtr, float v);
-extern float ImGuiWindowTempData_GetItemWidth(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetTextWrapPos(ImGuiWindowTempData *ImGuiWindowTempDataPtr, float v);
-extern float ImGuiWindowTempData_GetTextWrapPos(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetItemWidthStack(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVector_float v);
-extern ImVector_float ImGuiWindowTempData_GetItemWidthStack(ImGuiWindowTempData *self);
-extern void ImGuiWindowTempData_SetTextWrapPosStack(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVector_float v);
-extern ImVector_float ImGuiWindowTempData_GetTextWrapPosStack(ImGuiWindowTempData *self);
-extern void ImGuiListClipper_SetDisplayStart(ImGuiListClipper *ImGuiListClipperPtr, int v);
-extern int ImGuiListClipper_GetDisplayStart(ImGuiListClipper *self);
-extern void ImGuiListClipper_SetDisplayEnd(ImGuiListClipper *ImGuiListClipperPtr, int v);
-extern int ImGuiListClipper_GetDisplayEnd(ImGuiListClipper *self);
-extern void ImGuiListClipper_SetItemsCount(ImGuiListClipper *ImGuiListClipperPtr, int v);
-extern int ImGuiListClipper_GetItemsCount(ImGuiListClipper *self);
-extern void ImGuiListClipper_SetItemsHeight(ImGuiListClipper *ImGuiListClipperPtr, float v);
-extern float ImGuiListClipper_GetItemsHeight(ImGuiListClipper *self);
-extern void ImGuiListClipper_SetStartPosY(ImGuiListClipper *ImGuiListClipperPtr, float v);
-extern float ImGuiListClipper_GetStartPosY(ImGuiListClipper *self);
-extern void ImGuiListClipper_SetTempData(ImGuiListClipper *ImGuiListClipperPtr, void* v);
-extern void* ImGuiListClipper_GetTempData(ImGuiListClipper *self);
-extern void ImDrawListSplitter_Set_Current(ImDrawListSplitter *ImDrawListSplitterPtr, int v);
-extern int ImDrawListSplitter_Get_Current(ImDrawListSplitter *self);
-extern void ImDrawListSplitter_Set_Count(ImDrawListSplitter *ImDrawListSplitterPtr, int v);
-extern int ImDrawListSplitter_Get_Count(ImDrawListSplitter *self);
-extern void ImDrawListSplitter_Set_Channels(ImDrawListSplitter *ImDrawListSplitterPtr, ImVector_ImDrawChannel v);
-extern ImVector_ImDrawChannel ImDrawListSplitter_Get_Channels(ImDrawListSplitter *self);
-extern void ImFont_SetIndexAdvanceX(ImFont *ImFontPtr, ImVector_float v);
-extern ImVector_float ImFont_GetIndexAdvanceX(ImFont *self);
-extern void ImFont_SetFallbackAdvanceX(ImFont *ImFontPtr, float v);
-extern float ImFont_GetFallbackAdvanceX(ImFont *self);
-extern void ImFont_SetFontSize(ImFont *ImFontPtr, float v);
-extern float ImFont_GetFontSize(ImFont *self);
-extern void ImFont_SetIndexLookup(ImFont *ImFontPtr, ImVector_ImWchar v);
-extern ImVector_ImWchar ImFont_GetIndexLookup(ImFont *self);
-extern void ImFont_SetGlyphs(ImFont *ImFontPtr, ImVector_ImFontGlyph v);
-extern ImVector_ImFontGlyph ImFont_GetGlyphs(ImFont *self);
-extern void ImFont_SetFallbackGlyph(ImFont *ImFontPtr, const ImFontGlyph* v);
-extern const ImFontGlyph* ImFont_GetFallbackGlyph(ImFont *self);
-extern void ImFont_SetContainerAtlas(ImFont *ImFontPtr, ImFontAtlas* v);
-extern ImFontAtlas* ImFont_GetContainerAtlas(ImFont *self);
-extern void ImFont_SetConfigData(ImFont *ImFontPtr, const ImFontConfig* v);
-extern const ImFontConfig* ImFont_GetConfigData(ImFont *self);
-extern void ImFont_SetConfigDataCount(ImFont *ImFontPtr, short v);
-extern short ImFont_GetConfigDataCount(ImFont *self);
-extern void ImFont_SetFallbackChar(ImFont *ImFontPtr, ImWchar v);
-extern ImWchar ImFont_GetFallbackChar(ImFont *self);
-extern void ImFont_SetEllipsisChar(ImFont *ImFontPtr, ImWchar v);
-extern ImWchar ImFont_GetEllipsisChar(ImFont *self);
-extern void ImFont_SetDotChar(ImFont *ImFontPtr, ImWchar v);
-extern ImWchar ImFont_GetDotChar(ImFont *self);
-extern void ImFont_SetDirtyLookupTables(ImFont *ImFontPtr, bool v);
-extern bool ImFont_GetDirtyLookupTables(ImFont *self);
-extern void ImFont_SetScale(ImFont *ImFontPtr, float v);
-extern float ImFont_GetScale(ImFont *self);
-extern void ImFont_SetAscent(ImFont *ImFontPtr, float v);
-extern float ImFont_GetAscent(ImFont *self);
-extern void ImFont_SetDescent(ImFont *ImFontPtr, float v);
-extern float ImFont_GetDescent(ImFont *self);
-extern void ImFont_SetMetricsTotalSurface(ImFont *ImFontPtr, int v);
-extern int ImFont_GetMetricsTotalSurface(ImFont *self);
-extern void ImGuiDataTypeInfo_SetSize(ImGuiDataTypeInfo *ImGuiDataTypeInfoPtr, size_t v);
-extern size_t ImGuiDataTypeInfo_GetSize(ImGuiDataTypeInfo *self);
-extern void ImGuiDataTypeInfo_SetName(ImGuiDataTypeInfo *ImGuiDataTypeInfoPtr, const char* v);
-extern const char* ImGuiDataTypeInfo_GetName(ImGuiDataTypeInfo *self);
-extern void ImGuiDataTypeInfo_SetPrintFmt(ImGuiDataTypeInfo *ImGuiDataTypeInfoPtr, const char* v);
-extern const char* ImGuiDataTypeInfo_GetPrintFmt(ImGuiDataTypeInfo *self);
-extern void ImGuiDataTypeInfo_SetScanFmt(ImGuiDataTypeInfo *ImGuiDataTypeInfoPtr, const char* v);
-extern const char* ImGuiDataTypeInfo_GetScanFmt(ImGuiDataTypeInfo *self);
-extern void ImGuiGroupData_SetWindowID(ImGuiGroupData *ImGuiGroupDataPtr, ImGuiID v);
-extern ImGuiID ImGuiGroupData_GetWindowID(ImGuiGroupData *self);
-extern void ImGuiGroupData_SetBackupCursorPos(ImGuiGroupData *ImGuiGroupDataPtr, ImVec2 v);
-extern ImVec2 ImGuiGroupData_GetBackupCursorPos(ImGuiGroupData *self);
-extern void ImGuiGroupData_SetBackupCursorMaxPos(ImGuiGroupData *ImGuiGroupDataPtr, ImVec2 v);
-extern ImVec2 ImGuiGroupData_GetBackupCursorMaxPos(ImGuiGroupData *self);
-extern void ImGuiGroupData_SetBackupIndent(ImGuiGroupData *ImGuiGroupDataPtr, ImVec1 v);
-extern ImVec1 ImGuiGroupData_GetBackupIndent(ImGuiGroupData *self);
-extern void ImGuiGroupData_SetBackupGroupOffset(ImGuiGroupData *ImGuiGroupDataPtr, ImVec1 v);
-extern ImVec1 ImGuiGroupData_GetBackupGroupOffset(ImGuiGroupData *self);
-extern void ImGuiGroupData_SetBackupCurrLineSize(ImGuiGroupData *ImGuiGroupDataPtr, ImVec2 v);
-extern ImVec2 ImGuiGroupData_GetBackupCurrLineSize(ImGuiGroupData *self);
-extern void ImGuiGroupData_SetBackupCurrLineTextBaseOffset(ImGuiGroupData *ImGuiGroupDataPtr, float v);
-extern float ImGuiGroupData_GetBackupCurrLineTextBaseOffset(ImGuiGroupData *self);
-extern void ImGuiGroupData_SetBackupActiveIdIsAlive(ImGuiGroupData *ImGuiGroupDataPtr, ImGuiID v);
-extern ImGuiID ImGuiGroupData_GetBackupActiveIdIsAlive(ImGuiGroupData *self);
-extern void ImGuiGroupData_SetBackupActiveIdPreviousFrameIsAlive(ImGuiGroupData *ImGuiGroupDataPtr, bool v);
-extern bool ImGuiGroupData_GetBackupActiveIdPreviousFrameIsAlive(ImGuiGroupData *self);
-extern void ImGuiGroupData_SetBackupHoveredIdIsAlive(ImGuiGroupData *ImGuiGroupDataPtr, bool v);
-extern bool ImGuiGroupData_GetBackupHoveredIdIsAlive(ImGuiGroupData *self);
-extern void ImGuiGroupData_SetEmitItem(ImGuiGroupData *ImGuiGroupDataPtr, bool v);
-extern bool ImGuiGroupData_GetEmitItem(ImGuiGroupData *self);
-extern void ImGuiStorage_SetData(ImGuiStorage *ImGuiStoragePtr, ImVector_ImGuiStoragePair v);
-extern ImVector_ImGuiStoragePair ImGuiStorage_GetData(ImGuiStorage *self);
-extern void ImDrawData_SetValid(ImDrawData *ImDrawDataPtr, bool v);
-extern bool ImDrawData_GetValid(ImDrawData *self);
-extern void ImDrawData_SetCmdListsCount(ImDrawData *ImDrawDataPtr, int v);
-extern int ImDrawData_GetCmdListsCount(ImDrawData *self);
-extern void ImDrawData_SetTotalIdxCount(ImDrawData *ImDrawDataPtr, int v);
-extern int ImDrawData_GetTotalIdxCount(ImDrawData *self);
-extern void ImDrawData_SetTotalVtxCount(ImDrawData *ImDrawDataPtr, int v);
-extern int ImDrawData_GetTotalVtxCount(ImDrawData *self);
-extern void ImDrawData_SetCmdLists(ImDrawData *ImDrawDataPtr, ImDrawList** v);
-extern ImDrawList** ImDrawData_GetCmdLists(ImDrawData *self);
-extern void ImDrawData_SetDisplayPos(ImDrawData *ImDrawDataPtr, ImVec2 v);
-extern ImVec2 ImDrawData_GetDisplayPos(ImDrawData *self);
-extern void ImDrawData_SetDisplaySize(ImDrawData *ImDrawDataPtr, ImVec2 v);
-extern ImVec2 ImDrawData_GetDisplaySize(ImDrawData *self);
-extern void ImDrawData_SetFramebufferScale(ImDrawData *ImDrawDataPtr, ImVec2 v);
-extern ImVec2 ImDrawData_GetFramebufferScale(ImDrawData *self);
-extern void ImDrawData_SetOwnerViewport(ImDrawData *ImDrawDataPtr, ImGuiViewport* v);
-extern ImGuiViewport* ImDrawData_GetOwnerViewport(ImDrawData *self);
-extern void ImFontConfig_SetFontData(ImFontConfig *ImFontConfigPtr, void* v);
-extern void* ImFontConfig_GetFontData(ImFontConfig *self);
-extern void ImFontConfig_SetFontDataSize(ImFontConfig *ImFontConfigPtr, int v);
-extern int ImFontConfig_GetFontDataSize(ImFontConfig *self);
-extern void ImFontConfig_SetFontDataOwnedByAtlas(ImFontConfig *ImFontConfigPtr, bool v);
-extern bool ImFontConfig_GetFontDataOwnedByAtlas(ImFontConfig *self);
-extern void ImFontConfig_SetFontNo(ImFontConfig *ImFontConfigPtr, int v);
-extern int ImFontConfig_GetFontNo(ImFontConfig *self);
-extern void ImFontConfig_SetSizePixels(ImFontConfig *ImFontConfigPtr, float v);
-extern float ImFontConfig_GetSizePixels(ImFontConfig *self);
-extern void ImFontConfig_SetOversampleH(ImFontConfig *ImFontConfigPtr, int v);
-extern int ImFontConfig_GetOversampleH(ImFontConfig *self);
-extern void ImFontConfig_SetOversampleV(ImFontConfig *ImFontConfigPtr, int v);
-extern int ImFontConfig_GetOversampleV(ImFontConfig *self);
-extern void ImFontConfig_SetPixelSnapH(ImFontConfig *ImFontConfigPtr, bool v);
-extern bool ImFontConfig_GetPixelSnapH(ImFontConfig *self);
-extern void ImFontConfig_SetGlyphExtraSpacing(ImFontConfig *ImFontConfigPtr, ImVec2 v);
-extern ImVec2 ImFontConfig_GetGlyphExtraSpacing(ImFontConfig *self);
-extern void ImFontConfig_SetGlyphOffset(ImFontConfig *ImFontConfigPtr, ImVec2 v);
-extern ImVec2 ImFontConfig_GetGlyphOffset(ImFontConfig *self);
-extern void ImFontConfig_SetGlyphRanges(ImFontConfig *ImFontConfigPtr, const ImWchar* v);
-extern const ImWchar* ImFontConfig_GetGlyphRanges(ImFontConfig *self);
-extern void ImFontConfig_SetGlyphMinAdvanceX(ImFontConfig *ImFontConfigPtr, float v);
-extern float ImFontConfig_GetGlyphMinAdvanceX(ImFontConfig *self);
-extern void ImFontConfig_SetGlyphMaxAdvanceX(ImFontConfig *ImFontConfigPtr, float v);
-extern float ImFontConfig_GetGlyphMaxAdvanceX(ImFontConfig *self);
-extern void ImFontConfig_SetMergeMode(ImFontConfig *ImFontConfigPtr, bool v);
-extern bool ImFontConfig_GetMergeMode(ImFontConfig *self);
-extern void ImFontConfig_SetFontBuilderFlags(ImFontConfig *ImFontConfigPtr, unsigned int v);
-extern unsigned int ImFontConfig_GetFontBuilderFlags(ImFontConfig *self);
-extern void ImFontConfig_SetRasterizerMultiply(ImFontConfig *ImFontConfigPtr, float v);
-extern float ImFontConfig_GetRasterizerMultiply(ImFontConfig *self);
-extern void ImFontConfig_SetEllipsisChar(ImFontConfig *ImFontConfigPtr, ImWchar v);
-extern ImWchar ImFontConfig_GetEllipsisChar(ImFontConfig *self);
-extern void ImFontConfig_SetDstFont(ImFontConfig *ImFontConfigPtr, ImFont* v);
-extern ImFont* ImFontConfig_GetDstFont(ImFontConfig *self);
-extern void ImGuiInputEventMouseViewport_SetHoveredViewportID(ImGuiInputEventMouseViewport *ImGuiInputEventMouseViewportPtr, ImGuiID v);
-extern ImGuiID ImGuiInputEventMouseViewport_GetHoveredViewportID(ImGuiInputEventMouseViewport *self);
-extern void ImGuiOldColumnData_SetOffsetNorm(ImGuiOldColumnData *ImGuiOldColumnDataPtr, float v);
-extern float ImGuiOldColumnData_GetOffsetNorm(ImGuiOldColumnData *self);
-extern void ImGuiOldColumnData_SetOffsetNormBeforeResize(ImGuiOldColumnData *ImGuiOldColumnDataPtr, float v);
-extern float ImGuiOldColumnData_GetOffsetNormBeforeResize(ImGuiOldColumnData *self);
-extern void ImGuiOldColumnData_SetFlags(ImGuiOldColumnData *ImGuiOldColumnDataPtr, ImGuiOldColumnFlags v);
-extern ImGuiOldColumnFlags ImGuiOldColumnData_GetFlags(ImGuiOldColumnData *self);
-extern void ImGuiOldColumnData_SetClipRect(ImGuiOldColumnData *ImGuiOldColumnDataPtr, ImRect v);
-extern ImRect ImGuiOldColumnData_GetClipRect(ImGuiOldColumnData *self);
-extern void ImGuiPayload_SetData(ImGuiPayload *ImGuiPayloadPtr, void* v);
-extern void* ImGuiPayload_GetData(ImGuiPayload *self);
-extern void ImGuiPayload_SetDataSize(ImGuiPayload *ImGuiPayloadPtr, int v);
-extern int ImGuiPayload_GetDataSize(ImGuiPayload *self);
-extern void ImGuiPayload_SetSourceId(ImGuiPayload *ImGuiPayloadPtr, ImGuiID v);
-extern ImGuiID ImGuiPayload_GetSourceId(ImGuiPayload *self);
-extern void ImGuiPayload_SetSourceParentId(ImGuiPayload *ImGuiPayloadPtr, ImGuiID v);
-extern ImGuiID ImGuiPayload_GetSourceParentId(ImGuiPayload *self);
-extern void ImGuiPayload_SetDataFrameCount(ImGuiPayload *ImGuiPayloadPtr, int v);
-extern int ImGuiPayload_GetDataFrameCount(ImGuiPayload *self);
-extern void ImGuiPayload_SetPreview(ImGuiPayload *ImGuiPayloadPtr, bool v);
-extern bool ImGuiPayload_GetPreview(ImGuiPayload *self);
-extern void ImGuiPayload_SetDelivery(ImGuiPayload *ImGuiPayloadPtr, bool v);
-extern bool ImGuiPayload_GetDelivery(ImGuiPayload *self);
-extern void ImGuiPlatformImeData_SetWantVisible(ImGuiPlatformImeData *ImGuiPlatformImeDataPtr, bool v);
-extern bool ImGuiPlatformImeData_GetWantVisible(ImGuiPlatformImeData *self);
-extern void ImGuiPlatformImeData_SetInputPos(ImGuiPlatformImeData *ImGuiPlatformImeDataPtr, ImVec2 v);
-extern ImVec2 ImGuiPlatformImeData_GetInputPos(ImGuiPlatformImeData *self);
-extern void ImGuiPlatformImeData_SetInputLineHeight(ImGuiPlatformImeData *ImGuiPlatformImeDataPtr, float v);
-extern float ImGuiPlatformImeData_GetInputLineHeight(ImGuiPlatformImeData *self);
-extern void ImVec2ih_Setx(ImVec2ih *ImVec2ihPtr, short v);
-extern short ImVec2ih_Getx(ImVec2ih *self);
-extern void ImVec2ih_Sety(ImVec2ih *ImVec2ihPtr, short v);
-extern short ImVec2ih_Gety(ImVec2ih *self);
+extern void ImGuiWindowTempData_SetCursorStartPos(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiWindowTempData_GetCursorStartPos(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetCursorMaxPos(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiWindowTempData_GetCursorMaxPos(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetIdealMaxPos(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiWindowTempData_GetIdealMaxPos(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetCurrLineSize(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiWindowTempData_GetCurrLineSize(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetPrevLineSize(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiWindowTempData_GetPrevLineSize(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetCurrLineTextBaseOffset(ImGuiWindowTempData *ImGuiWindowTempDataPtr, float v);
+extern float ImGuiWindowTempData_GetCurrLineTextBaseOffset(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetPrevLineTextBaseOffset(ImGuiWindowTempData *ImGuiWindowTempDataPtr, float v);
+extern float ImGuiWindowTempData_GetPrevLineTextBaseOffset(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetIsSameLine(ImGuiWindowTempData *ImGuiWindowTempDataPtr, bool v);
+extern bool ImGuiWindowTempData_GetIsSameLine(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetIndent(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec1 v);
+extern ImVec1 ImGuiWindowTempData_GetIndent(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetColumnsOffset(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec1 v);
+extern ImVec1 ImGuiWindowTempData_GetColumnsOffset(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetGroupOffset(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec1 v);
+extern ImVec1 ImGuiWindowTempData_GetGroupOffset(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetCursorStartPosLossyness(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiWindowTempData_GetCursorStartPosLossyness(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetNavLayerCurrent(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiNavLayer v);
+extern ImGuiNavLayer ImGuiWindowTempData_GetNavLayerCurrent(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetNavLayersActiveMask(ImGuiWindowTempData *ImGuiWindowTempDataPtr, short v);
+extern short ImGuiWindowTempData_GetNavLayersActiveMask(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetNavLayersActiveMaskNext(ImGuiWindowTempData *ImGuiWindowTempDataPtr, short v);
+extern short ImGuiWindowTempData_GetNavLayersActiveMaskNext(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetNavFocusScopeIdCurrent(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiID v);
+extern ImGuiID ImGuiWindowTempData_GetNavFocusScopeIdCurrent(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetNavHideHighlightOneFrame(ImGuiWindowTempData *ImGuiWindowTempDataPtr, bool v);
+extern bool ImGuiWindowTempData_GetNavHideHighlightOneFrame(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetNavHasScroll(ImGuiWindowTempData *ImGuiWindowTempDataPtr, bool v);
+extern bool ImGuiWindowTempData_GetNavHasScroll(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetMenuBarAppending(ImGuiWindowTempData *ImGuiWindowTempDataPtr, bool v);
+extern bool ImGuiWindowTempData_GetMenuBarAppending(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetMenuBarOffset(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiWindowTempData_GetMenuBarOffset(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetMenuColumns(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiMenuColumns v);
+extern ImGuiMenuColumns ImGuiWindowTempData_GetMenuColumns(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetTreeDepth(ImGuiWindowTempData *ImGuiWindowTempDataPtr, int v);
+extern int ImGuiWindowTempData_GetTreeDepth(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetTreeJumpToParentOnPopMask(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImU32 v);
+extern ImU32 ImGuiWindowTempData_GetTreeJumpToParentOnPopMask(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetChildWindows(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVector_ImGuiWindowPtr v);
+extern ImVector_ImGuiWindowPtr ImGuiWindowTempData_GetChildWindows(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetStateStorage(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiStorage* v);
+extern ImGuiStorage* ImGuiWindowTempData_GetStateStorage(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetCurrentColumns(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiOldColumns* v);
+extern ImGuiOldColumns* ImGuiWindowTempData_GetCurrentColumns(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetCurrentTableIdx(ImGuiWindowTempData *ImGuiWindowTempDataPtr, int v);
+extern int ImGuiWindowTempData_GetCurrentTableIdx(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetLayoutType(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiLayoutType v);
+extern ImGuiLayoutType ImGuiWindowTempData_GetLayoutType(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetParentLayoutType(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImGuiLayoutType v);
+extern ImGuiLayoutType ImGuiWindowTempData_GetParentLayoutType(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetItemWidth(ImGuiWindowTempData *ImGuiWindowTempDataPtr, float v);
+extern float ImGuiWindowTempData_GetItemWidth(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetTextWrapPos(ImGuiWindowTempData *ImGuiWindowTempDataPtr, float v);
+extern float ImGuiWindowTempData_GetTextWrapPos(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetItemWidthStack(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVector_float v);
+extern ImVector_float ImGuiWindowTempData_GetItemWidthStack(ImGuiWindowTempData *self);
+extern void ImGuiWindowTempData_SetTextWrapPosStack(ImGuiWindowTempData *ImGuiWindowTempDataPtr, ImVector_float v);
+extern ImVector_float ImGuiWindowTempData_GetTextWrapPosStack(ImGuiWindowTempData *self);
+extern void ImGuiWindow_SetName(ImGuiWindow *ImGuiWindowPtr, char* v);
+extern char* ImGuiWindow_GetName(ImGuiWindow *self);
+extern void ImGuiWindow_SetID(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
+extern ImGuiID ImGuiWindow_GetID(ImGuiWindow *self);
+extern void ImGuiWindow_SetFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiWindowFlags v);
+extern ImGuiWindowFlags ImGuiWindow_GetFlags(ImGuiWindow *self);
+extern void ImGuiWindow_SetFlagsPreviousFrame(ImGuiWindow *ImGuiWindowPtr, ImGuiWindowFlags v);
+extern ImGuiWindowFlags ImGuiWindow_GetFlagsPreviousFrame(ImGuiWindow *self);
+extern void ImGuiWindow_SetWindowClass(ImGuiWindow *ImGuiWindowPtr, ImGuiWindowClass v);
+extern ImGuiWindowClass ImGuiWindow_GetWindowClass(ImGuiWindow *self);
+extern void ImGuiWindow_SetViewport(ImGuiWindow *ImGuiWindowPtr, ImGuiViewportP* v);
+extern ImGuiViewportP* ImGuiWindow_GetViewport(ImGuiWindow *self);
+extern void ImGuiWindow_SetViewportId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
+extern ImGuiID ImGuiWindow_GetViewportId(ImGuiWindow *self);
+extern void ImGuiWindow_SetViewportPos(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetViewportPos(ImGuiWindow *self);
+extern void ImGuiWindow_SetViewportAllowPlatformMonitorExtend(ImGuiWindow *ImGuiWindowPtr, int v);
+extern int ImGuiWindow_GetViewportAllowPlatformMonitorExtend(ImGuiWindow *self);
+extern void ImGuiWindow_SetPos(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetPos(ImGuiWindow *self);
+extern void ImGuiWindow_SetSize(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetSize(ImGuiWindow *self);
+extern void ImGuiWindow_SetSizeFull(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetSizeFull(ImGuiWindow *self);
+extern void ImGuiWindow_SetContentSize(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetContentSize(ImGuiWindow *self);
+extern void ImGuiWindow_SetContentSizeIdeal(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetContentSizeIdeal(ImGuiWindow *self);
+extern void ImGuiWindow_SetContentSizeExplicit(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetContentSizeExplicit(ImGuiWindow *self);
+extern void ImGuiWindow_SetWindowPadding(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetWindowPadding(ImGuiWindow *self);
+extern void ImGuiWindow_SetWindowRounding(ImGuiWindow *ImGuiWindowPtr, float v);
+extern float ImGuiWindow_GetWindowRounding(ImGuiWindow *self);
+extern void ImGuiWindow_SetWindowBorderSize(ImGuiWindow *ImGuiWindowPtr, float v);
+extern float ImGuiWindow_GetWindowBorderSize(ImGuiWindow *self);
+extern void ImGuiWindow_SetNameBufLen(ImGuiWindow *ImGuiWindowPtr, int v);
+extern int ImGuiWindow_GetNameBufLen(ImGuiWindow *self);
+extern void ImGuiWindow_SetMoveId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
+extern ImGuiID ImGuiWindow_GetMoveId(ImGuiWindow *self);
+extern void ImGuiWindow_SetTabId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
+extern ImGuiID ImGuiWindow_GetTabId(ImGuiWindow *self);
+extern void ImGuiWindow_SetChildId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
+extern ImGuiID ImGuiWindow_GetChildId(ImGuiWindow *self);
+extern void ImGuiWindow_SetScroll(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetScroll(ImGuiWindow *self);
+extern void ImGuiWindow_SetScrollMax(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetScrollMax(ImGuiWindow *self);
+extern void ImGuiWindow_SetScrollTarget(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetScrollTarget(ImGuiWindow *self);
+extern void ImGuiWindow_SetScrollTargetCenterRatio(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetScrollTargetCenterRatio(ImGuiWindow *self);
+extern void ImGuiWindow_SetScrollTargetEdgeSnapDist(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetScrollTargetEdgeSnapDist(ImGuiWindow *self);
+extern void ImGuiWindow_SetScrollbarSizes(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetScrollbarSizes(ImGuiWindow *self);
+extern void ImGuiWindow_SetScrollbarX(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetScrollbarX(ImGuiWindow *self);
+extern void ImGuiWindow_SetScrollbarY(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetScrollbarY(ImGuiWindow *self);
+extern void ImGuiWindow_SetViewportOwned(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetViewportOwned(ImGuiWindow *self);
+extern void ImGuiWindow_SetActive(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetActive(ImGuiWindow *self);
+extern void ImGuiWindow_SetWasActive(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetWasActive(ImGuiWindow *self);
+extern void ImGuiWindow_SetWriteAccessed(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetWriteAccessed(ImGuiWindow *self);
+extern void ImGuiWindow_SetCollapsed(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetCollapsed(ImGuiWindow *self);
+extern void ImGuiWindow_SetWantCollapseToggle(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetWantCollapseToggle(ImGuiWindow *self);
+extern void ImGuiWindow_SetSkipItems(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetSkipItems(ImGuiWindow *self);
+extern void ImGuiWindow_SetAppearing(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetAppearing(ImGuiWindow *self);
+extern void ImGuiWindow_SetHidden(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetHidden(ImGuiWindow *self);
+extern void ImGuiWindow_SetIsFallbackWindow(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetIsFallbackWindow(ImGuiWindow *self);
+extern void ImGuiWindow_SetIsExplicitChild(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetIsExplicitChild(ImGuiWindow *self);
+extern void ImGuiWindow_SetHasCloseButton(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetHasCloseButton(ImGuiWindow *self);
+extern void ImGuiWindow_SetResizeBorderHeld(ImGuiWindow *ImGuiWindowPtr, signed char v);
+extern signed char ImGuiWindow_GetResizeBorderHeld(ImGuiWindow *self);
+extern void ImGuiWindow_SetBeginCount(ImGuiWindow *ImGuiWindowPtr, short v);
+extern short ImGuiWindow_GetBeginCount(ImGuiWindow *self);
+extern void ImGuiWindow_SetBeginOrderWithinParent(ImGuiWindow *ImGuiWindowPtr, short v);
+extern short ImGuiWindow_GetBeginOrderWithinParent(ImGuiWindow *self);
+extern void ImGuiWindow_SetBeginOrderWithinContext(ImGuiWindow *ImGuiWindowPtr, short v);
+extern short ImGuiWindow_GetBeginOrderWithinContext(ImGuiWindow *self);
+extern void ImGuiWindow_SetFocusOrder(ImGuiWindow *ImGuiWindowPtr, short v);
+extern short ImGuiWindow_GetFocusOrder(ImGuiWindow *self);
+extern void ImGuiWindow_SetPopupId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
+extern ImGuiID ImGuiWindow_GetPopupId(ImGuiWindow *self);
+extern void ImGuiWindow_SetAutoFitFramesX(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
+extern ImS8 ImGuiWindow_GetAutoFitFramesX(ImGuiWindow *self);
+extern void ImGuiWindow_SetAutoFitFramesY(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
+extern ImS8 ImGuiWindow_GetAutoFitFramesY(ImGuiWindow *self);
+extern void ImGuiWindow_SetAutoFitChildAxises(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
+extern ImS8 ImGuiWindow_GetAutoFitChildAxises(ImGuiWindow *self);
+extern void ImGuiWindow_SetAutoFitOnlyGrows(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetAutoFitOnlyGrows(ImGuiWindow *self);
+extern void ImGuiWindow_SetAutoPosLastDirection(ImGuiWindow *ImGuiWindowPtr, ImGuiDir v);
+extern ImGuiDir ImGuiWindow_GetAutoPosLastDirection(ImGuiWindow *self);
+extern void ImGuiWindow_SetHiddenFramesCanSkipItems(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
+extern ImS8 ImGuiWindow_GetHiddenFramesCanSkipItems(ImGuiWindow *self);
+extern void ImGuiWindow_SetHiddenFramesCannotSkipItems(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
+extern ImS8 ImGuiWindow_GetHiddenFramesCannotSkipItems(ImGuiWindow *self);
+extern void ImGuiWindow_SetHiddenFramesForRenderOnly(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
+extern ImS8 ImGuiWindow_GetHiddenFramesForRenderOnly(ImGuiWindow *self);
+extern void ImGuiWindow_SetDisableInputsFrames(ImGuiWindow *ImGuiWindowPtr, ImS8 v);
+extern ImS8 ImGuiWindow_GetDisableInputsFrames(ImGuiWindow *self);
+extern void ImGuiWindow_SetSetWindowPosAllowFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiCond v);
+extern ImGuiCond ImGuiWindow_GetSetWindowPosAllowFlags(ImGuiWindow *self);
+extern void ImGuiWindow_SetSetWindowSizeAllowFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiCond v);
+extern ImGuiCond ImGuiWindow_GetSetWindowSizeAllowFlags(ImGuiWindow *self);
+extern void ImGuiWindow_SetSetWindowCollapsedAllowFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiCond v);
+extern ImGuiCond ImGuiWindow_GetSetWindowCollapsedAllowFlags(ImGuiWindow *self);
+extern void ImGuiWindow_SetSetWindowDockAllowFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiCond v);
+extern ImGuiCond ImGuiWindow_GetSetWindowDockAllowFlags(ImGuiWindow *self);
+extern void ImGuiWindow_SetSetWindowPosVal(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetSetWindowPosVal(ImGuiWindow *self);
+extern void ImGuiWindow_SetSetWindowPosPivot(ImGuiWindow *ImGuiWindowPtr, ImVec2 v);
+extern ImVec2 ImGuiWindow_GetSetWindowPosPivot(ImGuiWindow *self);
+extern void ImGuiWindow_SetIDStack(ImGuiWindow *ImGuiWindowPtr, ImVector_ImGuiID v);
+extern ImVector_ImGuiID ImGuiWindow_GetIDStack(ImGuiWindow *self);
+extern void ImGuiWindow_SetDC(ImGuiWindow *ImGuiWindowPtr, ImGuiWindowTempData v);
+extern ImGuiWindowTempData ImGuiWindow_GetDC(ImGuiWindow *self);
+extern void ImGuiWindow_SetOuterRectClipped(ImGuiWindow *ImGuiWindowPtr, ImRect v);
+extern ImRect ImGuiWindow_GetOuterRectClipped(ImGuiWindow *self);
+extern void ImGuiWindow_SetInnerRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
+extern ImRect ImGuiWindow_GetInnerRect(ImGuiWindow *self);
+extern void ImGuiWindow_SetInnerClipRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
+extern ImRect ImGuiWindow_GetInnerClipRect(ImGuiWindow *self);
+extern void ImGuiWindow_SetWorkRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
+extern ImRect ImGuiWindow_GetWorkRect(ImGuiWindow *self);
+extern void ImGuiWindow_SetParentWorkRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
+extern ImRect ImGuiWindow_GetParentWorkRect(ImGuiWindow *self);
+extern void ImGuiWindow_SetClipRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
+extern ImRect ImGuiWindow_GetClipRect(ImGuiWindow *self);
+extern void ImGuiWindow_SetContentRegionRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
+extern ImRect ImGuiWindow_GetContentRegionRect(ImGuiWindow *self);
+extern void ImGuiWindow_SetHitTestHoleSize(ImGuiWindow *ImGuiWindowPtr, ImVec2ih v);
+extern ImVec2ih ImGuiWindow_GetHitTestHoleSize(ImGuiWindow *self);
+extern void ImGuiWindow_SetHitTestHoleOffset(ImGuiWindow *ImGuiWindowPtr, ImVec2ih v);
+extern ImVec2ih ImGuiWindow_GetHitTestHoleOffset(ImGuiWindow *self);
+extern void ImGuiWindow_SetLastFrameActive(ImGuiWindow *ImGuiWindowPtr, int v);
+extern int ImGuiWindow_GetLastFrameActive(ImGuiWindow *self);
+extern void ImGuiWindow_SetLastFrameJustFocused(ImGuiWindow *ImGuiWindowPtr, int v);
+extern int ImGuiWindow_GetLastFrameJustFocused(ImGuiWindow *self);
+extern void ImGuiWindow_SetLastTimeActive(ImGuiWindow *ImGuiWindowPtr, float v);
+extern float ImGuiWindow_GetLastTimeActive(ImGuiWindow *self);
+extern void ImGuiWindow_SetItemWidthDefault(ImGuiWindow *ImGuiWindowPtr, float v);
+extern float ImGuiWindow_GetItemWidthDefault(ImGuiWindow *self);
+extern void ImGuiWindow_SetStateStorage(ImGuiWindow *ImGuiWindowPtr, ImGuiStorage v);
+extern ImGuiStorage ImGuiWindow_GetStateStorage(ImGuiWindow *self);
+extern void ImGuiWindow_SetColumnsStorage(ImGuiWindow *ImGuiWindowPtr, ImVector_ImGuiOldColumns v);
+extern ImVector_ImGuiOldColumns ImGuiWindow_GetColumnsStorage(ImGuiWindow *self);
+extern void ImGuiWindow_SetFontWindowScale(ImGuiWindow *ImGuiWindowPtr, float v);
+extern float ImGuiWindow_GetFontWindowScale(ImGuiWindow *self);
+extern void ImGuiWindow_SetFontDpiScale(ImGuiWindow *ImGuiWindowPtr, float v);
+extern float ImGuiWindow_GetFontDpiScale(ImGuiWindow *self);
+extern void ImGuiWindow_SetSettingsOffset(ImGuiWindow *ImGuiWindowPtr, int v);
+extern int ImGuiWindow_GetSettingsOffset(ImGuiWindow *self);
+extern void ImGuiWindow_SetDrawList(ImGuiWindow *ImGuiWindowPtr, ImDrawList* v);
+extern ImDrawList* ImGuiWindow_GetDrawList(ImGuiWindow *self);
+extern void ImGuiWindow_SetDrawListInst(ImGuiWindow *ImGuiWindowPtr, ImDrawList v);
+extern ImDrawList ImGuiWindow_GetDrawListInst(ImGuiWindow *self);
+extern void ImGuiWindow_SetParentWindow(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiWindow_GetParentWindow(ImGuiWindow *self);
+extern void ImGuiWindow_SetParentWindowInBeginStack(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiWindow_GetParentWindowInBeginStack(ImGuiWindow *self);
+extern void ImGuiWindow_SetRootWindow(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiWindow_GetRootWindow(ImGuiWindow *self);
+extern void ImGuiWindow_SetRootWindowPopupTree(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiWindow_GetRootWindowPopupTree(ImGuiWindow *self);
+extern void ImGuiWindow_SetRootWindowDockTree(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiWindow_GetRootWindowDockTree(ImGuiWindow *self);
+extern void ImGuiWindow_SetRootWindowForTitleBarHighlight(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiWindow_GetRootWindowForTitleBarHighlight(ImGuiWindow *self);
+extern void ImGuiWindow_SetRootWindowForNav(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiWindow_GetRootWindowForNav(ImGuiWindow *self);
+extern void ImGuiWindow_SetNavLastChildNavWindow(ImGuiWindow *ImGuiWindowPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiWindow_GetNavLastChildNavWindow(ImGuiWindow *self);
+extern void ImGuiWindow_SetMemoryDrawListIdxCapacity(ImGuiWindow *ImGuiWindowPtr, int v);
+extern int ImGuiWindow_GetMemoryDrawListIdxCapacity(ImGuiWindow *self);
+extern void ImGuiWindow_SetMemoryDrawListVtxCapacity(ImGuiWindow *ImGuiWindowPtr, int v);
+extern int ImGuiWindow_GetMemoryDrawListVtxCapacity(ImGuiWindow *self);
+extern void ImGuiWindow_SetMemoryCompacted(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetMemoryCompacted(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockIsActive(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetDockIsActive(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockNodeIsVisible(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetDockNodeIsVisible(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockTabIsVisible(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetDockTabIsVisible(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockTabWantClose(ImGuiWindow *ImGuiWindowPtr, bool v);
+extern bool ImGuiWindow_GetDockTabWantClose(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockOrder(ImGuiWindow *ImGuiWindowPtr, short v);
+extern short ImGuiWindow_GetDockOrder(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockStyle(ImGuiWindow *ImGuiWindowPtr, ImGuiWindowDockStyle v);
+extern ImGuiWindowDockStyle ImGuiWindow_GetDockStyle(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockNode(ImGuiWindow *ImGuiWindowPtr, ImGuiDockNode* v);
+extern ImGuiDockNode* ImGuiWindow_GetDockNode(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockNodeAsHost(ImGuiWindow *ImGuiWindowPtr, ImGuiDockNode* v);
+extern ImGuiDockNode* ImGuiWindow_GetDockNodeAsHost(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockId(ImGuiWindow *ImGuiWindowPtr, ImGuiID v);
+extern ImGuiID ImGuiWindow_GetDockId(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockTabItemStatusFlags(ImGuiWindow *ImGuiWindowPtr, ImGuiItemStatusFlags v);
+extern ImGuiItemStatusFlags ImGuiWindow_GetDockTabItemStatusFlags(ImGuiWindow *self);
+extern void ImGuiWindow_SetDockTabItemRect(ImGuiWindow *ImGuiWindowPtr, ImRect v);
+extern ImRect ImGuiWindow_GetDockTabItemRect(ImGuiWindow *self);
+extern void ImGuiTabItem_SetID(ImGuiTabItem *ImGuiTabItemPtr, ImGuiID v);
+extern ImGuiID ImGuiTabItem_GetID(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetFlags(ImGuiTabItem *ImGuiTabItemPtr, ImGuiTabItemFlags v);
+extern ImGuiTabItemFlags ImGuiTabItem_GetFlags(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetWindow(ImGuiTabItem *ImGuiTabItemPtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiTabItem_GetWindow(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetLastFrameVisible(ImGuiTabItem *ImGuiTabItemPtr, int v);
+extern int ImGuiTabItem_GetLastFrameVisible(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetLastFrameSelected(ImGuiTabItem *ImGuiTabItemPtr, int v);
+extern int ImGuiTabItem_GetLastFrameSelected(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetOffset(ImGuiTabItem *ImGuiTabItemPtr, float v);
+extern float ImGuiTabItem_GetOffset(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetWidth(ImGuiTabItem *ImGuiTabItemPtr, float v);
+extern float ImGuiTabItem_GetWidth(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetContentWidth(ImGuiTabItem *ImGuiTabItemPtr, float v);
+extern float ImGuiTabItem_GetContentWidth(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetRequestedWidth(ImGuiTabItem *ImGuiTabItemPtr, float v);
+extern float ImGuiTabItem_GetRequestedWidth(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetNameOffset(ImGuiTabItem *ImGuiTabItemPtr, ImS32 v);
+extern ImS32 ImGuiTabItem_GetNameOffset(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetBeginOrder(ImGuiTabItem *ImGuiTabItemPtr, ImS16 v);
+extern ImS16 ImGuiTabItem_GetBeginOrder(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetIndexDuringLayout(ImGuiTabItem *ImGuiTabItemPtr, ImS16 v);
+extern ImS16 ImGuiTabItem_GetIndexDuringLayout(ImGuiTabItem *self);
+extern void ImGuiTabItem_SetWantClose(ImGuiTabItem *ImGuiTabItemPtr, bool v);
+extern bool ImGuiTabItem_GetWantClose(ImGuiTabItem *self);
+extern void ImGuiTabBar_SetTabs(ImGuiTabBar *ImGuiTabBarPtr, ImVector_ImGuiTabItem v);
+extern ImVector_ImGuiTabItem ImGuiTabBar_GetTabs(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetFlags(ImGuiTabBar *ImGuiTabBarPtr, ImGuiTabBarFlags v);
+extern ImGuiTabBarFlags ImGuiTabBar_GetFlags(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetID(ImGuiTabBar *ImGuiTabBarPtr, ImGuiID v);
+extern ImGuiID ImGuiTabBar_GetID(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetSelectedTabId(ImGuiTabBar *ImGuiTabBarPtr, ImGuiID v);
+extern ImGuiID ImGuiTabBar_GetSelectedTabId(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetNextSelectedTabId(ImGuiTabBar *ImGuiTabBarPtr, ImGuiID v);
+extern ImGuiID ImGuiTabBar_GetNextSelectedTabId(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetVisibleTabId(ImGuiTabBar *ImGuiTabBarPtr, ImGuiID v);
+extern ImGuiID ImGuiTabBar_GetVisibleTabId(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetCurrFrameVisible(ImGuiTabBar *ImGuiTabBarPtr, int v);
+extern int ImGuiTabBar_GetCurrFrameVisible(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetPrevFrameVisible(ImGuiTabBar *ImGuiTabBarPtr, int v);
+extern int ImGuiTabBar_GetPrevFrameVisible(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetBarRect(ImGuiTabBar *ImGuiTabBarPtr, ImRect v);
+extern ImRect ImGuiTabBar_GetBarRect(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetCurrTabsContentsHeight(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetCurrTabsContentsHeight(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetPrevTabsContentsHeight(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetPrevTabsContentsHeight(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetWidthAllTabs(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetWidthAllTabs(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetWidthAllTabsIdeal(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetWidthAllTabsIdeal(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetScrollingAnim(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetScrollingAnim(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetScrollingTarget(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetScrollingTarget(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetScrollingTargetDistToVisibility(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetScrollingTargetDistToVisibility(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetScrollingSpeed(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetScrollingSpeed(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetScrollingRectMinX(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetScrollingRectMinX(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetScrollingRectMaxX(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetScrollingRectMaxX(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetReorderRequestTabId(ImGuiTabBar *ImGuiTabBarPtr, ImGuiID v);
+extern ImGuiID ImGuiTabBar_GetReorderRequestTabId(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetReorderRequestOffset(ImGuiTabBar *ImGuiTabBarPtr, ImS16 v);
+extern ImS16 ImGuiTabBar_GetReorderRequestOffset(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetBeginCount(ImGuiTabBar *ImGuiTabBarPtr, ImS8 v);
+extern ImS8 ImGuiTabBar_GetBeginCount(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetWantLayout(ImGuiTabBar *ImGuiTabBarPtr, bool v);
+extern bool ImGuiTabBar_GetWantLayout(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetVisibleTabWasSubmitted(ImGuiTabBar *ImGuiTabBarPtr, bool v);
+extern bool ImGuiTabBar_GetVisibleTabWasSubmitted(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetTabsAddedNew(ImGuiTabBar *ImGuiTabBarPtr, bool v);
+extern bool ImGuiTabBar_GetTabsAddedNew(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetTabsActiveCount(ImGuiTabBar *ImGuiTabBarPtr, ImS16 v);
+extern ImS16 ImGuiTabBar_GetTabsActiveCount(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetLastTabItemIdx(ImGuiTabBar *ImGuiTabBarPtr, ImS16 v);
+extern ImS16 ImGuiTabBar_GetLastTabItemIdx(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetItemSpacingY(ImGuiTabBar *ImGuiTabBarPtr, float v);
+extern float ImGuiTabBar_GetItemSpacingY(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetFramePadding(ImGuiTabBar *ImGuiTabBarPtr, ImVec2 v);
+extern ImVec2 ImGuiTabBar_GetFramePadding(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetBackupCursorPos(ImGuiTabBar *ImGuiTabBarPtr, ImVec2 v);
+extern ImVec2 ImGuiTabBar_GetBackupCursorPos(ImGuiTabBar *self);
+extern void ImGuiTabBar_SetTabsNames(ImGuiTabBar *ImGuiTabBarPtr, ImGuiTextBuffer v);
+extern ImGuiTextBuffer ImGuiTabBar_GetTabsNames(ImGuiTabBar *self);
+extern void ImGuiTableColumn_SetFlags(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnFlags v);
+extern ImGuiTableColumnFlags ImGuiTableColumn_GetFlags(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetWidthGiven(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetWidthGiven(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetMinX(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetMinX(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetMaxX(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetMaxX(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetWidthRequest(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetWidthRequest(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetWidthAuto(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetWidthAuto(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetStretchWeight(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetStretchWeight(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetInitStretchWeightOrWidth(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetInitStretchWeightOrWidth(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetClipRect(ImGuiTableColumn *ImGuiTableColumnPtr, ImRect v);
+extern ImRect ImGuiTableColumn_GetClipRect(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetUserID(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiID v);
+extern ImGuiID ImGuiTableColumn_GetUserID(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetWorkMinX(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetWorkMinX(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetWorkMaxX(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetWorkMaxX(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetItemWidth(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetItemWidth(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetContentMaxXFrozen(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetContentMaxXFrozen(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetContentMaxXUnfrozen(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetContentMaxXUnfrozen(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetContentMaxXHeadersUsed(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetContentMaxXHeadersUsed(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetContentMaxXHeadersIdeal(ImGuiTableColumn *ImGuiTableColumnPtr, float v);
+extern float ImGuiTableColumn_GetContentMaxXHeadersIdeal(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetNameOffset(ImGuiTableColumn *ImGuiTableColumnPtr, ImS16 v);
+extern ImS16 ImGuiTableColumn_GetNameOffset(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetDisplayOrder(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableColumn_GetDisplayOrder(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetIndexWithinEnabledSet(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableColumn_GetIndexWithinEnabledSet(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetPrevEnabledColumn(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableColumn_GetPrevEnabledColumn(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetNextEnabledColumn(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableColumn_GetNextEnabledColumn(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetSortOrder(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableColumn_GetSortOrder(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetDrawChannelCurrent(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableDrawChannelIdx v);
+extern ImGuiTableDrawChannelIdx ImGuiTableColumn_GetDrawChannelCurrent(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetDrawChannelFrozen(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableDrawChannelIdx v);
+extern ImGuiTableDrawChannelIdx ImGuiTableColumn_GetDrawChannelFrozen(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetDrawChannelUnfrozen(ImGuiTableColumn *ImGuiTableColumnPtr, ImGuiTableDrawChannelIdx v);
+extern ImGuiTableDrawChannelIdx ImGuiTableColumn_GetDrawChannelUnfrozen(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetIsEnabled(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
+extern bool ImGuiTableColumn_GetIsEnabled(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetIsUserEnabled(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
+extern bool ImGuiTableColumn_GetIsUserEnabled(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetIsUserEnabledNextFrame(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
+extern bool ImGuiTableColumn_GetIsUserEnabledNextFrame(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetIsVisibleX(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
+extern bool ImGuiTableColumn_GetIsVisibleX(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetIsVisibleY(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
+extern bool ImGuiTableColumn_GetIsVisibleY(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetIsRequestOutput(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
+extern bool ImGuiTableColumn_GetIsRequestOutput(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetIsSkipItems(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
+extern bool ImGuiTableColumn_GetIsSkipItems(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetIsPreserveWidthAuto(ImGuiTableColumn *ImGuiTableColumnPtr, bool v);
+extern bool ImGuiTableColumn_GetIsPreserveWidthAuto(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetNavLayerCurrent(ImGuiTableColumn *ImGuiTableColumnPtr, ImS8 v);
+extern ImS8 ImGuiTableColumn_GetNavLayerCurrent(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetAutoFitQueue(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
+extern ImU8 ImGuiTableColumn_GetAutoFitQueue(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetCannotSkipItemsQueue(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
+extern ImU8 ImGuiTableColumn_GetCannotSkipItemsQueue(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetSortDirection(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
+extern ImU8 ImGuiTableColumn_GetSortDirection(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetSortDirectionsAvailCount(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
+extern ImU8 ImGuiTableColumn_GetSortDirectionsAvailCount(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetSortDirectionsAvailMask(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
+extern ImU8 ImGuiTableColumn_GetSortDirectionsAvailMask(ImGuiTableColumn *self);
+extern void ImGuiTableColumn_SetSortDirectionsAvailList(ImGuiTableColumn *ImGuiTableColumnPtr, ImU8 v);
+extern ImU8 ImGuiTableColumn_GetSortDirectionsAvailList(ImGuiTableColumn *self);
+extern void ImGuiTableCellData_SetBgColor(ImGuiTableCellData *ImGuiTableCellDataPtr, ImU32 v);
+extern ImU32 ImGuiTableCellData_GetBgColor(ImGuiTableCellData *self);
+extern void ImGuiTableCellData_SetColumn(ImGuiTableCellData *ImGuiTableCellDataPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableCellData_GetColumn(ImGuiTableCellData *self);
+extern void ImGuiTableInstanceData_SetLastOuterHeight(ImGuiTableInstanceData *ImGuiTableInstanceDataPtr, float v);
+extern float ImGuiTableInstanceData_GetLastOuterHeight(ImGuiTableInstanceData *self);
+extern void ImGuiTableInstanceData_SetLastFirstRowHeight(ImGuiTableInstanceData *ImGuiTableInstanceDataPtr, float v);
+extern float ImGuiTableInstanceData_GetLastFirstRowHeight(ImGuiTableInstanceData *self);
+extern void ImGuiTable_SetID(ImGuiTable *ImGuiTablePtr, ImGuiID v);
+extern ImGuiID ImGuiTable_GetID(ImGuiTable *self);
+extern void ImGuiTable_SetFlags(ImGuiTable *ImGuiTablePtr, ImGuiTableFlags v);
+extern ImGuiTableFlags ImGuiTable_GetFlags(ImGuiTable *self);
+extern void ImGuiTable_SetRawData(ImGuiTable *ImGuiTablePtr, void* v);
+extern void* ImGuiTable_GetRawData(ImGuiTable *self);
+extern void ImGuiTable_SetTempData(ImGuiTable *ImGuiTablePtr, ImGuiTableTempData* v);
+extern ImGuiTableTempData* ImGuiTable_GetTempData(ImGuiTable *self);
+extern void ImGuiTable_SetColumns(ImGuiTable *ImGuiTablePtr, ImSpan_ImGuiTableColumn v);
+extern ImSpan_ImGuiTableColumn ImGuiTable_GetColumns(ImGuiTable *self);
+extern void ImGuiTable_SetDisplayOrderToIndex(ImGuiTable *ImGuiTablePtr, ImSpan_ImGuiTableColumnIdx v);
+extern ImSpan_ImGuiTableColumnIdx ImGuiTable_GetDisplayOrderToIndex(ImGuiTable *self);
+extern void ImGuiTable_SetRowCellData(ImGuiTable *ImGuiTablePtr, ImSpan_ImGuiTableCellData v);
+extern ImSpan_ImGuiTableCellData ImGuiTable_GetRowCellData(ImGuiTable *self);
+extern void ImGuiTable_SetEnabledMaskByDisplayOrder(ImGuiTable *ImGuiTablePtr, ImU64 v);
+extern ImU64 ImGuiTable_GetEnabledMaskByDisplayOrder(ImGuiTable *self);
+extern void ImGuiTable_SetEnabledMaskByIndex(ImGuiTable *ImGuiTablePtr, ImU64 v);
+extern ImU64 ImGuiTable_GetEnabledMaskByIndex(ImGuiTable *self);
+extern void ImGuiTable_SetVisibleMaskByIndex(ImGuiTable *ImGuiTablePtr, ImU64 v);
+extern ImU64 ImGuiTable_GetVisibleMaskByIndex(ImGuiTable *self);
+extern void ImGuiTable_SetRequestOutputMaskByIndex(ImGuiTable *ImGuiTablePtr, ImU64 v);
+extern ImU64 ImGuiTable_GetRequestOutputMaskByIndex(ImGuiTable *self);
+extern void ImGuiTable_SetSettingsLoadedFlags(ImGuiTable *ImGuiTablePtr, ImGuiTableFlags v);
+extern ImGuiTableFlags ImGuiTable_GetSettingsLoadedFlags(ImGuiTable *self);
+extern void ImGuiTable_SetSettingsOffset(ImGuiTable *ImGuiTablePtr, int v);
+extern int ImGuiTable_GetSettingsOffset(ImGuiTable *self);
+extern void ImGuiTable_SetLastFrameActive(ImGuiTable *ImGuiTablePtr, int v);
+extern int ImGuiTable_GetLastFrameActive(ImGuiTable *self);
+extern void ImGuiTable_SetColumnsCount(ImGuiTable *ImGuiTablePtr, int v);
+extern int ImGuiTable_GetColumnsCount(ImGuiTable *self);
+extern void ImGuiTable_SetCurrentRow(ImGuiTable *ImGuiTablePtr, int v);
+extern int ImGuiTable_GetCurrentRow(ImGuiTable *self);
+extern void ImGuiTable_SetCurrentColumn(ImGuiTable *ImGuiTablePtr, int v);
+extern int ImGuiTable_GetCurrentColumn(ImGuiTable *self);
+extern void ImGuiTable_SetInstanceCurrent(ImGuiTable *ImGuiTablePtr, ImS16 v);
+extern ImS16 ImGuiTable_GetInstanceCurrent(ImGuiTable *self);
+extern void ImGuiTable_SetInstanceInteracted(ImGuiTable *ImGuiTablePtr, ImS16 v);
+extern ImS16 ImGuiTable_GetInstanceInteracted(ImGuiTable *self);
+extern void ImGuiTable_SetRowPosY1(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetRowPosY1(ImGuiTable *self);
+extern void ImGuiTable_SetRowPosY2(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetRowPosY2(ImGuiTable *self);
+extern void ImGuiTable_SetRowMinHeight(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetRowMinHeight(ImGuiTable *self);
+extern void ImGuiTable_SetRowTextBaseline(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetRowTextBaseline(ImGuiTable *self);
+extern void ImGuiTable_SetRowIndentOffsetX(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetRowIndentOffsetX(ImGuiTable *self);
+extern void ImGuiTable_SetRowFlags(ImGuiTable *ImGuiTablePtr, ImGuiTableRowFlags v);
+extern ImGuiTableRowFlags ImGuiTable_GetRowFlags(ImGuiTable *self);
+extern void ImGuiTable_SetLastRowFlags(ImGuiTable *ImGuiTablePtr, ImGuiTableRowFlags v);
+extern ImGuiTableRowFlags ImGuiTable_GetLastRowFlags(ImGuiTable *self);
+extern void ImGuiTable_SetRowBgColorCounter(ImGuiTable *ImGuiTablePtr, int v);
+extern int ImGuiTable_GetRowBgColorCounter(ImGuiTable *self);
+extern void ImGuiTable_SetBorderColorStrong(ImGuiTable *ImGuiTablePtr, ImU32 v);
+extern ImU32 ImGuiTable_GetBorderColorStrong(ImGuiTable *self);
+extern void ImGuiTable_SetBorderColorLight(ImGuiTable *ImGuiTablePtr, ImU32 v);
+extern ImU32 ImGuiTable_GetBorderColorLight(ImGuiTable *self);
+extern void ImGuiTable_SetBorderX1(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetBorderX1(ImGuiTable *self);
+extern void ImGuiTable_SetBorderX2(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetBorderX2(ImGuiTable *self);
+extern void ImGuiTable_SetHostIndentX(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetHostIndentX(ImGuiTable *self);
+extern void ImGuiTable_SetMinColumnWidth(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetMinColumnWidth(ImGuiTable *self);
+extern void ImGuiTable_SetOuterPaddingX(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetOuterPaddingX(ImGuiTable *self);
+extern void ImGuiTable_SetCellPaddingX(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetCellPaddingX(ImGuiTable *self);
+extern void ImGuiTable_SetCellPaddingY(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetCellPaddingY(ImGuiTable *self);
+extern void ImGuiTable_SetCellSpacingX1(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetCellSpacingX1(ImGuiTable *self);
+extern void ImGuiTable_SetCellSpacingX2(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetCellSpacingX2(ImGuiTable *self);
+extern void ImGuiTable_SetInnerWidth(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetInnerWidth(ImGuiTable *self);
+extern void ImGuiTable_SetColumnsGivenWidth(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetColumnsGivenWidth(ImGuiTable *self);
+extern void ImGuiTable_SetColumnsAutoFitWidth(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetColumnsAutoFitWidth(ImGuiTable *self);
+extern void ImGuiTable_SetColumnsStretchSumWeights(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetColumnsStretchSumWeights(ImGuiTable *self);
+extern void ImGuiTable_SetResizedColumnNextWidth(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetResizedColumnNextWidth(ImGuiTable *self);
+extern void ImGuiTable_SetResizeLockMinContentsX2(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetResizeLockMinContentsX2(ImGuiTable *self);
+extern void ImGuiTable_SetRefScale(ImGuiTable *ImGuiTablePtr, float v);
+extern float ImGuiTable_GetRefScale(ImGuiTable *self);
+extern void ImGuiTable_SetOuterRect(ImGuiTable *ImGuiTablePtr, ImRect v);
+extern ImRect ImGuiTable_GetOuterRect(ImGuiTable *self);
+extern void ImGuiTable_SetInnerRect(ImGuiTable *ImGuiTablePtr, ImRect v);
+extern ImRect ImGuiTable_GetInnerRect(ImGuiTable *self);
+extern void ImGuiTable_SetWorkRect(ImGuiTable *ImGuiTablePtr, ImRect v);
+extern ImRect ImGuiTable_GetWorkRect(ImGuiTable *self);
+extern void ImGuiTable_SetInnerClipRect(ImGuiTable *ImGuiTablePtr, ImRect v);
+extern ImRect ImGuiTable_GetInnerClipRect(ImGuiTable *self);
+extern void ImGuiTable_SetBgClipRect(ImGuiTable *ImGuiTablePtr, ImRect v);
+extern ImRect ImGuiTable_GetBgClipRect(ImGuiTable *self);
+extern void ImGuiTable_SetBg0ClipRectForDrawCmd(ImGuiTable *ImGuiTablePtr, ImRect v);
+extern ImRect ImGuiTable_GetBg0ClipRectForDrawCmd(ImGuiTable *self);
+extern void ImGuiTable_SetBg2ClipRectForDrawCmd(ImGuiTable *ImGuiTablePtr, ImRect v);
+extern ImRect ImGuiTable_GetBg2ClipRectForDrawCmd(ImGuiTable *self);
+extern void ImGuiTable_SetHostClipRect(ImGuiTable *ImGuiTablePtr, ImRect v);
+extern ImRect ImGuiTable_GetHostClipRect(ImGuiTable *self);
+extern void ImGuiTable_SetHostBackupInnerClipRect(ImGuiTable *ImGuiTablePtr, ImRect v);
+extern ImRect ImGuiTable_GetHostBackupInnerClipRect(ImGuiTable *self);
+extern void ImGuiTable_SetOuterWindow(ImGuiTable *ImGuiTablePtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiTable_GetOuterWindow(ImGuiTable *self);
+extern void ImGuiTable_SetInnerWindow(ImGuiTable *ImGuiTablePtr, ImGuiWindow* v);
+extern ImGuiWindow* ImGuiTable_GetInnerWindow(ImGuiTable *self);
+extern void ImGuiTable_SetColumnsNames(ImGuiTable *ImGuiTablePtr, ImGuiTextBuffer v);
+extern ImGuiTextBuffer ImGuiTable_GetColumnsNames(ImGuiTable *self);
+extern void ImGuiTable_SetDrawSplitter(ImGuiTable *ImGuiTablePtr, ImDrawListSplitter* v);
+extern ImDrawListSplitter* ImGuiTable_GetDrawSplitter(ImGuiTable *self);
+extern void ImGuiTable_SetInstanceDataFirst(ImGuiTable *ImGuiTablePtr, ImGuiTableInstanceData v);
+extern ImGuiTableInstanceData ImGuiTable_GetInstanceDataFirst(ImGuiTable *self);
+extern void ImGuiTable_SetInstanceDataExtra(ImGuiTable *ImGuiTablePtr, ImVector_ImGuiTableInstanceData v);
+extern ImVector_ImGuiTableInstanceData ImGuiTable_GetInstanceDataExtra(ImGuiTable *self);
+extern void ImGuiTable_SetSortSpecsSingle(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnSortSpecs v);
+extern ImGuiTableColumnSortSpecs ImGuiTable_GetSortSpecsSingle(ImGuiTable *self);
+extern void ImGuiTable_SetSortSpecsMulti(ImGuiTable *ImGuiTablePtr, ImVector_ImGuiTableColumnSortSpecs v);
+extern ImVector_ImGuiTableColumnSortSpecs ImGuiTable_GetSortSpecsMulti(ImGuiTable *self);
+extern void ImGuiTable_SetSortSpecs(ImGuiTable *ImGuiTablePtr, ImGuiTableSortSpecs v);
+extern ImGuiTableSortSpecs ImGuiTable_GetSortSpecs(ImGuiTable *self);
+extern void ImGuiTable_SetSortSpecsCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetSortSpecsCount(ImGuiTable *self);
+extern void ImGuiTable_SetColumnsEnabledCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetColumnsEnabledCount(ImGuiTable *self);
+extern void ImGuiTable_SetColumnsEnabledFixedCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetColumnsEnabledFixedCount(ImGuiTable *self);
+extern void ImGuiTable_SetDeclColumnsCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetDeclColumnsCount(ImGuiTable *self);
+extern void ImGuiTable_SetHoveredColumnBody(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetHoveredColumnBody(ImGuiTable *self);
+extern void ImGuiTable_SetHoveredColumnBorder(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetHoveredColumnBorder(ImGuiTable *self);
+extern void ImGuiTable_SetAutoFitSingleColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetAutoFitSingleColumn(ImGuiTable *self);
+extern void ImGuiTable_SetResizedColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetResizedColumn(ImGuiTable *self);
+extern void ImGuiTable_SetLastResizedColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetLastResizedColumn(ImGuiTable *self);
+extern void ImGuiTable_SetHeldHeaderColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetHeldHeaderColumn(ImGuiTable *self);
+extern void ImGuiTable_SetReorderColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetReorderColumn(ImGuiTable *self);
+extern void ImGuiTable_SetReorderColumnDir(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetReorderColumnDir(ImGuiTable *self);
+extern void ImGuiTable_SetLeftMostEnabledColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetLeftMostEnabledColumn(ImGuiTable *self);
+extern void ImGuiTable_SetRightMostEnabledColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetRightMostEnabledColumn(ImGuiTable *self);
+extern void ImGuiTable_SetLeftMostStretchedColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetLeftMostStretchedColumn(ImGuiTable *self);
+extern void ImGuiTable_SetRightMostStretchedColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetRightMostStretchedColumn(ImGuiTable *self);
+extern void ImGuiTable_SetContextPopupColumn(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetContextPopupColumn(ImGuiTable *self);
+extern void ImGuiTable_SetFreezeRowsRequest(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetFreezeRowsRequest(ImGuiTable *self);
+extern void ImGuiTable_SetFreezeRowsCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetFreezeRowsCount(ImGuiTable *self);
+extern void ImGuiTable_SetFreezeColumnsRequest(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetFreezeColumnsRequest(ImGuiTable *self);
+extern void ImGuiTable_SetFreezeColumnsCount(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetFreezeColumnsCount(ImGuiTable *self);
+extern void ImGuiTable_SetRowCellDataCurrent(ImGuiTable *ImGuiTablePtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTable_GetRowCellDataCurrent(ImGuiTable *self);
+extern void ImGuiTable_SetDummyDrawChannel(ImGuiTable *ImGuiTablePtr, ImGuiTableDrawChannelIdx v);
+extern ImGuiTableDrawChannelIdx ImGuiTable_GetDummyDrawChannel(ImGuiTable *self);
+extern void ImGuiTable_SetBg2DrawChannelCurrent(ImGuiTable *ImGuiTablePtr, ImGuiTableDrawChannelIdx v);
+extern ImGuiTableDrawChannelIdx ImGuiTable_GetBg2DrawChannelCurrent(ImGuiTable *self);
+extern void ImGuiTable_SetBg2DrawChannelUnfrozen(ImGuiTable *ImGuiTablePtr, ImGuiTableDrawChannelIdx v);
+extern ImGuiTableDrawChannelIdx ImGuiTable_GetBg2DrawChannelUnfrozen(ImGuiTable *self);
+extern void ImGuiTable_SetIsLayoutLocked(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsLayoutLocked(ImGuiTable *self);
+extern void ImGuiTable_SetIsInsideRow(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsInsideRow(ImGuiTable *self);
+extern void ImGuiTable_SetIsInitializing(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsInitializing(ImGuiTable *self);
+extern void ImGuiTable_SetIsSortSpecsDirty(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsSortSpecsDirty(ImGuiTable *self);
+extern void ImGuiTable_SetIsUsingHeaders(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsUsingHeaders(ImGuiTable *self);
+extern void ImGuiTable_SetIsContextPopupOpen(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsContextPopupOpen(ImGuiTable *self);
+extern void ImGuiTable_SetIsSettingsRequestLoad(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsSettingsRequestLoad(ImGuiTable *self);
+extern void ImGuiTable_SetIsSettingsDirty(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsSettingsDirty(ImGuiTable *self);
+extern void ImGuiTable_SetIsDefaultDisplayOrder(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsDefaultDisplayOrder(ImGuiTable *self);
+extern void ImGuiTable_SetIsResetAllRequest(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsResetAllRequest(ImGuiTable *self);
+extern void ImGuiTable_SetIsResetDisplayOrderRequest(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsResetDisplayOrderRequest(ImGuiTable *self);
+extern void ImGuiTable_SetIsUnfrozenRows(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsUnfrozenRows(ImGuiTable *self);
+extern void ImGuiTable_SetIsDefaultSizingPolicy(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetIsDefaultSizingPolicy(ImGuiTable *self);
+extern void ImGuiTable_SetMemoryCompacted(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetMemoryCompacted(ImGuiTable *self);
+extern void ImGuiTable_SetHostSkipItems(ImGuiTable *ImGuiTablePtr, bool v);
+extern bool ImGuiTable_GetHostSkipItems(ImGuiTable *self);
+extern void ImGuiTableTempData_SetTableIndex(ImGuiTableTempData *ImGuiTableTempDataPtr, int v);
+extern int ImGuiTableTempData_GetTableIndex(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetLastTimeActive(ImGuiTableTempData *ImGuiTableTempDataPtr, float v);
+extern float ImGuiTableTempData_GetLastTimeActive(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetUserOuterSize(ImGuiTableTempData *ImGuiTableTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiTableTempData_GetUserOuterSize(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetDrawSplitter(ImGuiTableTempData *ImGuiTableTempDataPtr, ImDrawListSplitter v);
+extern ImDrawListSplitter ImGuiTableTempData_GetDrawSplitter(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetHostBackupWorkRect(ImGuiTableTempData *ImGuiTableTempDataPtr, ImRect v);
+extern ImRect ImGuiTableTempData_GetHostBackupWorkRect(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetHostBackupParentWorkRect(ImGuiTableTempData *ImGuiTableTempDataPtr, ImRect v);
+extern ImRect ImGuiTableTempData_GetHostBackupParentWorkRect(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetHostBackupPrevLineSize(ImGuiTableTempData *ImGuiTableTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiTableTempData_GetHostBackupPrevLineSize(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetHostBackupCurrLineSize(ImGuiTableTempData *ImGuiTableTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiTableTempData_GetHostBackupCurrLineSize(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetHostBackupCursorMaxPos(ImGuiTableTempData *ImGuiTableTempDataPtr, ImVec2 v);
+extern ImVec2 ImGuiTableTempData_GetHostBackupCursorMaxPos(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetHostBackupColumnsOffset(ImGuiTableTempData *ImGuiTableTempDataPtr, ImVec1 v);
+extern ImVec1 ImGuiTableTempData_GetHostBackupColumnsOffset(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetHostBackupItemWidth(ImGuiTableTempData *ImGuiTableTempDataPtr, float v);
+extern float ImGuiTableTempData_GetHostBackupItemWidth(ImGuiTableTempData *self);
+extern void ImGuiTableTempData_SetHostBackupItemWidthStackSize(ImGuiTableTempData *ImGuiTableTempDataPtr, int v);
+extern int ImGuiTableTempData_GetHostBackupItemWidthStackSize(ImGuiTableTempData *self);
+extern void ImGuiTableColumnSettings_SetWidthOrWeight(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, float v);
+extern float ImGuiTableColumnSettings_GetWidthOrWeight(ImGuiTableColumnSettings *self);
+extern void ImGuiTableColumnSettings_SetUserID(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImGuiID v);
+extern ImGuiID ImGuiTableColumnSettings_GetUserID(ImGuiTableColumnSettings *self);
+extern void ImGuiTableColumnSettings_SetIndex(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableColumnSettings_GetIndex(ImGuiTableColumnSettings *self);
+extern void ImGuiTableColumnSettings_SetDisplayOrder(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableColumnSettings_GetDisplayOrder(ImGuiTableColumnSettings *self);
+extern void ImGuiTableColumnSettings_SetSortOrder(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableColumnSettings_GetSortOrder(ImGuiTableColumnSettings *self);
+extern void ImGuiTableColumnSettings_SetSortDirection(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImU8 v);
+extern ImU8 ImGuiTableColumnSettings_GetSortDirection(ImGuiTableColumnSettings *self);
+extern void ImGuiTableColumnSettings_SetIsEnabled(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImU8 v);
+extern ImU8 ImGuiTableColumnSettings_GetIsEnabled(ImGuiTableColumnSettings *self);
+extern void ImGuiTableColumnSettings_SetIsStretch(ImGuiTableColumnSettings *ImGuiTableColumnSettingsPtr, ImU8 v);
+extern ImU8 ImGuiTableColumnSettings_GetIsStretch(ImGuiTableColumnSettings *self);
+extern void ImGuiTableSettings_SetID(ImGuiTableSettings *ImGuiTableSettingsPtr, ImGuiID v);
+extern ImGuiID ImGuiTableSettings_GetID(ImGuiTableSettings *self);
+extern void ImGuiTableSettings_SetSaveFlags(ImGuiTableSettings *ImGuiTableSettingsPtr, ImGuiTableFlags v);
+extern ImGuiTableFlags ImGuiTableSettings_GetSaveFlags(ImGuiTableSettings *self);
+extern void ImGuiTableSettings_SetRefScale(ImGuiTableSettings *ImGuiTableSettingsPtr, float v);
+extern float ImGuiTableSettings_GetRefScale(ImGuiTableSettings *self);
+extern void ImGuiTableSettings_SetColumnsCount(ImGuiTableSettings *ImGuiTableSettingsPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableSettings_GetColumnsCount(ImGuiTableSettings *self);
+extern void ImGuiTableSettings_SetColumnsCountMax(ImGuiTableSettings *ImGuiTableSettingsPtr, ImGuiTableColumnIdx v);
+extern ImGuiTableColumnIdx ImGuiTableSettings_GetColumnsCountMax(ImGuiTableSettings *self);
+extern void ImGuiTableSettings_SetWantApply(ImGuiTableSettings *ImGuiTableSettingsPtr, bool v);
+extern bool ImGuiTableSettings_GetWantApply(ImGuiTableSettings *self);
+extern void StbUndoRecord_Setwhere(StbUndoRecord *StbUndoRecordPtr, int v);
+extern int StbUndoRecord_Getwhere(StbUndoRecord *self);
+extern void StbUndoRecord_Setinsert_length(StbUndoRecord *StbUndoRecordPtr, int v);
+extern int StbUndoRecord_Getinsert_length(StbUndoRecord *self);
+extern void StbUndoRecord_Setdelete_length(StbUndoRecord *StbUndoRecordPtr, int v);
+extern int StbUndoRecord_Getdelete_length(StbUndoRecord *self);
+extern void StbUndoRecord_Setchar_storage(StbUndoRecord *StbUndoRecordPtr, int v);
+extern int StbUndoRecord_Getchar_storage(StbUndoRecord *self);
 extern void StbUndoState_Setundo_point(StbUndoState *StbUndoStatePtr, short v);
 extern short StbUndoState_Getundo_point(StbUndoState *self);
 extern void StbUndoState_Setredo_point(StbUndoState *StbUndoStatePtr, short v);
@@ -2408,10 +2372,46 @@ extern void StbUndoState_Setundo_char_point(StbUndoState *StbUndoStatePtr, int v
 extern int StbUndoState_Getundo_char_point(StbUndoState *self);
 extern void StbUndoState_Setredo_char_point(StbUndoState *StbUndoStatePtr, int v);
 extern int StbUndoState_Getredo_char_point(StbUndoState *self);
-extern void ImDrawChannel_Set_CmdBuffer(ImDrawChannel *ImDrawChannelPtr, ImVector_ImDrawCmd v);
-extern ImVector_ImDrawCmd ImDrawChannel_Get_CmdBuffer(ImDrawChannel *self);
-extern void ImDrawChannel_Set_IdxBuffer(ImDrawChannel *ImDrawChannelPtr, ImVector_ImDrawIdx v);
-extern ImVector_ImDrawIdx ImDrawChannel_Get_IdxBuffer(ImDrawChannel *self);
+extern void STB_TexteditState_Setcursor(STB_TexteditState *STB_TexteditStatePtr, int v);
+extern int STB_TexteditState_Getcursor(STB_TexteditState *self);
+extern void STB_TexteditState_Setselect_start(STB_TexteditState *STB_TexteditStatePtr, int v);
+extern int STB_TexteditState_Getselect_start(STB_TexteditState *self);
+extern void STB_TexteditState_Setselect_end(STB_TexteditState *STB_TexteditStatePtr, int v);
+extern int STB_TexteditState_Getselect_end(STB_TexteditState *self);
+extern void STB_TexteditState_Setinsert_mode(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
+extern unsigned char STB_TexteditState_Getinsert_mode(STB_TexteditState *self);
+extern void STB_TexteditState_Setrow_count_per_page(STB_TexteditState *STB_TexteditStatePtr, int v);
+extern int STB_TexteditState_Getrow_count_per_page(STB_TexteditState *self);
+extern void STB_TexteditState_Setcursor_at_end_of_line(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
+extern unsigned char STB_TexteditState_Getcursor_at_end_of_line(STB_TexteditState *self);
+extern void STB_TexteditState_Setinitialized(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
+extern unsigned char STB_TexteditState_Getinitialized(STB_TexteditState *self);
+extern void STB_TexteditState_Sethas_preferred_x(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
+extern unsigned char STB_TexteditState_Gethas_preferred_x(STB_TexteditState *self);
+extern void STB_TexteditState_Setsingle_line(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
+extern unsigned char STB_TexteditState_Getsingle_line(STB_TexteditState *self);
+extern void STB_TexteditState_Setpadding1(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
+extern unsigned char STB_TexteditState_Getpadding1(STB_TexteditState *self);
+extern void STB_TexteditState_Setpadding2(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
+extern unsigned char STB_TexteditState_Getpadding2(STB_TexteditState *self);
+extern void STB_TexteditState_Setpadding3(STB_TexteditState *STB_TexteditStatePtr, unsigned char v);
+extern unsigned char STB_TexteditState_Getpadding3(STB_TexteditState *self);
+extern void STB_TexteditState_Setpreferred_x(STB_TexteditState *STB_TexteditStatePtr, float v);
+extern float STB_TexteditState_Getpreferred_x(STB_TexteditState *self);
+extern void STB_TexteditState_Setundostate(STB_TexteditState *STB_TexteditStatePtr, StbUndoState v);
+extern StbUndoState STB_TexteditState_Getundostate(STB_TexteditState *self);
+extern void StbTexteditRow_Setx0(StbTexteditRow *StbTexteditRowPtr, float v);
+extern float StbTexteditRow_Getx0(StbTexteditRow *self);
+extern void StbTexteditRow_Setx1(StbTexteditRow *StbTexteditRowPtr, float v);
+extern float StbTexteditRow_Getx1(StbTexteditRow *self);
+extern void StbTexteditRow_Setbaseline_y_delta(StbTexteditRow *StbTexteditRowPtr, float v);
+extern float StbTexteditRow_Getbaseline_y_delta(StbTexteditRow *self);
+extern void StbTexteditRow_Setymin(StbTexteditRow *StbTexteditRowPtr, float v);
+extern float StbTexteditRow_Getymin(StbTexteditRow *self);
+extern void StbTexteditRow_Setymax(StbTexteditRow *StbTexteditRowPtr, float v);
+extern float StbTexteditRow_Getymax(StbTexteditRow *self);
+extern void StbTexteditRow_Setnum_chars(StbTexteditRow *StbTexteditRowPtr, int v);
+extern int StbTexteditRow_Getnum_chars(StbTexteditRow *self);
 
 #ifdef __cplusplus
 }
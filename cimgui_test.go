@@ -5,8 +5,8 @@ import (
 )
 
 func TestSetIOCofigFlags(t *testing.T) {
-	CreateContext(0)
-	defer DestroyContext(0)
+	CreateContext()
+	defer DestroyContext()
 
 	io := GetIO()
 	if io == 0 {
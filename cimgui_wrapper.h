@@ -1,640 +1,641 @@
 #pragma once
 
 #include "cimgui/cimgui.h"
+#include <stdint.h>
 
 #ifdef __cplusplus
 extern "C" {
 #endif
 
-extern bool IsMouseHoveringRect(const ImVec2 r_min,const ImVec2 r_max,bool clip);
-extern ImFont* GetFont();
-extern void SetDrawCursorPosX(float local_x);
+extern ImVec2* Vec2_ImVec2_Nil();
+extern ImVec2* Vec2_ImVec2_Float(float _x,float _y);
+extern ImVec4* Vec4_ImVec4_Nil();
+extern ImVec4* Vec4_ImVec4_Float(float _x,float _y,float _z,float _w);
+extern ImGuiContext* CreateContext(ImFontAtlas* shared_font_atlas);
+extern void DestroyContext(ImGuiContext* ctx);
+extern ImGuiContext* GetCurrentContext();
+extern void SetCurrentContext(ImGuiContext* ctx);
+extern ImGuiIO* GetIO();
+extern ImGuiStyle* GetStyle();
+extern void NewFrame();
+extern void EndFrame();
+extern void Render();
+extern ImDrawData* GetDrawData();
+extern void ShowDemoWindow(bool* p_open);
+extern void ShowMetricsWindow(bool* p_open);
+extern void ShowDebugLogWindow(bool* p_open);
+extern void ShowStackToolWindow(bool* p_open);
+extern void ShowAboutWindow(bool* p_open);
+extern void ShowStyleEditor(ImGuiStyle* ref);
+extern bool ShowStyleSelector(const char* label);
+extern void ShowFontSelector(const char* label);
+extern void ShowUserGuide();
+extern const char* GetVersion();
+extern void StyleColorsDark(ImGuiStyle* dst);
+extern void StyleColorsLight(ImGuiStyle* dst);
+extern void StyleColorsClassic(ImGuiStyle* dst);
+extern bool Begin(const char* name,bool* p_open,ImGuiWindowFlags flags);
+extern void End();
+extern bool BeginChild_Str(const char* str_id,const ImVec2 size,bool border,ImGuiWindowFlags flags);
+extern bool BeginChild_ID(ImGuiID id,const ImVec2 size,bool border,ImGuiWindowFlags flags);
+extern void EndChild();
+extern bool IsWindowAppearing();
+extern bool IsWindowCollapsed();
+extern bool IsWindowFocused(ImGuiFocusedFlags flags);
+extern bool IsWindowHovered(ImGuiHoveredFlags flags);
+extern ImDrawList* GetWindowDrawList();
+extern float GetWindowDpiScale();
+extern void GetWindowPos(ImVec2 *pOut);
+extern void GetWindowSize(ImVec2 *pOut);
+extern float GetWindowWidth();
+extern float GetWindowHeight();
+extern ImGuiViewport* GetWindowViewport();
+extern void SetNextWindowPos(const ImVec2 pos,ImGuiCond cond,const ImVec2 pivot);
+extern void SetNextWindowSize(const ImVec2 size,ImGuiCond cond);
+extern void SetNextWindowSizeConstraints(const ImVec2 size_min,const ImVec2 size_max,uintptr_t custom_callback);
+extern void SetNextWindowContentSize(const ImVec2 size);
+extern void SetNextWindowCollapsed(bool collapsed,ImGuiCond cond);
+extern void SetNextWindowFocus();
+extern void SetNextWindowBgAlpha(float alpha);
+extern void SetNextWindowViewport(ImGuiID viewport_id);
+extern void SetWindowPos_Vec2(const ImVec2 pos,ImGuiCond cond);
 extern void SetWindowSize_Vec2(const ImVec2 size,ImGuiCond cond);
+extern void SetWindowCollapsed_Bool(bool collapsed,ImGuiCond cond);
+extern void SetWindowFocus_Nil();
+extern void SetWindowFontScale(float scale);
+extern void SetWindowPos_Str(const char* name,const ImVec2 pos,ImGuiCond cond);
 extern void SetWindowSize_Str(const char* name,const ImVec2 size,ImGuiCond cond);
-extern void TableColumnSettings_Destroy(ImGuiTableColumnSettings* self);
-extern bool SliderAngle(const char* label,float* v_rad,float v_degrees_min,float v_degrees_max,const char* format,ImGuiSliderFlags flags);
-extern ImFontAtlasCustomRect* FontAtlas_GetCustomRectByIndex(ImFontAtlas* self,int index);
-extern bool BeginMenu(const char* label,bool enabled);
-extern void TableSortSpecs_Destroy(ImGuiTableSortSpecs* self);
-extern ImGuiViewport* FindViewportByPlatformHandle(void* platform_handle);
-extern void DrawList_PrimWriteVtx(ImDrawList* self,const ImVec2 pos,const ImVec2 uv,ImU32 col);
-extern void TabBar_Destroy(ImGuiTabBar* self);
-extern bool IsItemHovered(ImGuiHoveredFlags flags);
-extern bool SliderInt4(const char* label,int v[4],int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
-extern void FontGlyphRangesBuilder_BuildRanges(ImFontGlyphRangesBuilder* self,ImVector_ImWchar* out_ranges);
-extern float GetDrawCursorPosY();
+extern void SetWindowCollapsed_Str(const char* name,bool collapsed,ImGuiCond cond);
+extern void SetWindowFocus_Str(const char* name);
+extern void GetContentRegionAvail(ImVec2 *pOut);
+extern void GetContentRegionMax(ImVec2 *pOut);
+extern void GetWindowContentRegionMin(ImVec2 *pOut);
+extern void GetWindowContentRegionMax(ImVec2 *pOut);
+extern float GetScrollX();
+extern float GetScrollY();
+extern void SetScrollX_Float(float scroll_x);
+extern void SetScrollY_Float(float scroll_y);
+extern float GetScrollMaxX();
+extern float GetScrollMaxY();
+extern void SetScrollHereX(float center_x_ratio);
+extern void SetScrollHereY(float center_y_ratio);
+extern void SetScrollFromPosX_Float(float local_x,float center_x_ratio);
+extern void SetScrollFromPosY_Float(float local_y,float center_y_ratio);
+extern void PushFont(ImFont* font);
+extern void PopFont();
+extern void PushStyleColor_U32(ImGuiCol idx,ImU32 col);
+extern void PushStyleColor_Vec4(ImGuiCol idx,const ImVec4 col);
+extern void PopStyleColor(int count);
+extern void PushStyleVar_Float(ImGuiStyleVar idx,float val);
+extern void PushStyleVar_Vec2(ImGuiStyleVar idx,const ImVec2 val);
+extern void PopStyleVar(int count);
+extern void PushAllowKeyboardFocus(bool allow_keyboard_focus);
+extern void PopAllowKeyboardFocus();
+extern void PushButtonRepeat(bool repeat);
+extern void PopButtonRepeat();
+extern void PushItemWidth(float item_width);
+extern void PopItemWidth();
+extern void SetNextItemWidth(float item_width);
+extern float CalcItemWidth();
+extern void PushTextWrapPos(float wrap_local_pos_x);
+extern void PopTextWrapPos();
+extern ImFont* GetFont();
+extern float GetFontSize();
 extern void GetFontTexUvWhitePixel(ImVec2 *pOut);
-extern const ImWchar* FontAtlas_GetGlyphRangesJapanese(ImFontAtlas* self);
-extern ImGuiTableSortSpecs* TableSortSpecs_ImGuiTableSortSpecs();
-extern void ShowStackToolWindow(bool* p_open);
-extern void DrawList_AddRectFilled(ImDrawList* self,const ImVec2 p_min,const ImVec2 p_max,ImU32 col,float rounding,ImDrawFlags flags);
-extern void DrawList_PrimRect(ImDrawList* self,const ImVec2 a,const ImVec2 b,ImU32 col);
-extern bool IsWindowFocused(ImGuiFocusedFlags flags);
-extern void EndTooltip();
-extern void InputTextCallbackData_DeleteChars(ImGuiInputTextCallbackData* self,int pos,int bytes_count);
-extern void Vec2_Destroy(ImVec2* self);
-extern bool SliderFloat4(const char* label,float v[4],float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
-extern void DrawList_AddQuad(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,const ImVec2 p4,ImU32 col,float thickness);
+extern ImU32 GetColorU32_Col(ImGuiCol idx,float alpha_mul);
+extern ImU32 GetColorU32_Vec4(const ImVec4 col);
+extern ImU32 GetColorU32_U32(ImU32 col);
+extern const ImVec4* GetStyleColorVec4(ImGuiCol idx);
+extern void Separator();
+extern void SameLine(float offset_from_start_x,float spacing);
+extern void NewLine();
+extern void Spacing();
+extern void Dummy(const ImVec2 size);
 extern void Indent(float indent_w);
-extern void SetNextWindowViewport(ImGuiID viewport_id);
-extern void Font_CalcTextSizeA(ImVec2 *pOut,ImFont* self,float size,float max_width,float wrap_width,const char* text_begin,const char** remaining);
-extern bool BeginPopupContextItem(const char* str_id,ImGuiPopupFlags popup_flags);
-extern ImGuiIO* GetIO();
-extern void ListClipper_ForceDisplayRangeByIndices(ImGuiListClipper* self,int item_min,int item_max);
-extern void StackLevelInfo_Destroy(ImGuiStackLevelInfo* self);
-extern void NextItemData_Destroy(ImGuiNextItemData* self);
-extern bool IsMousePosValid(const ImVec2* mouse_pos);
-extern void FontConfig_Destroy(ImFontConfig* self);
-extern void DrawList_AddRect(ImDrawList* self,const ImVec2 p_min,const ImVec2 p_max,ImU32 col,float rounding,ImDrawFlags flags,float thickness);
-extern void DrawList_ChannelsMerge(ImDrawList* self);
-extern void TextUnformatted(const char* text);
-extern void FontAtlas_ClearTexData(ImFontAtlas* self);
-extern void TableNextRow(ImGuiTableRowFlags row_flags,float min_row_height);
-extern void DrawList_PathRect(ImDrawList* self,const ImVec2 rect_min,const ImVec2 rect_max,float rounding,ImDrawFlags flags);
-extern int TextBuffer_Size(ImGuiTextBuffer* self);
+extern void Unindent(float indent_w);
+extern void BeginGroup();
+extern void EndGroup();
+extern void GetDrawCursorPos(ImVec2 *pOut);
+extern float GetDrawCursorPosX();
+extern float GetDrawCursorPosY();
+extern void SetDrawCursorPos(const ImVec2 local_pos);
+extern void SetDrawCursorPosX(float local_x);
+extern void SetDrawCursorPosY(float local_y);
+extern void GetDrawCursorStartPos(ImVec2 *pOut);
+extern void GetDrawCursorScreenPos(ImVec2 *pOut);
 extern void SetDrawCursorScreenPos(const ImVec2 pos);
-extern bool CheckboxFlags_IntPtr(const char* label,int* flags,int flags_value);
-extern bool CheckboxFlags_UintPtr(const char* label,unsigned int* flags,unsigned int flags_value);
-extern bool IsRectVisible_Nil(const ImVec2 size);
-extern bool IsRectVisible_Vec2(const ImVec2 rect_min,const ImVec2 rect_max);
+extern void AlignTextToFramePadding();
+extern float GetTextLineHeight();
+extern float GetTextLineHeightWithSpacing();
+extern float GetFrameHeight();
+extern float GetFrameHeightWithSpacing();
+extern void PushID_Str(const char* str_id);
+extern void PushID_StrStr(const char* str_id_begin,const char* str_id_end);
+extern void PushID_Ptr(const void* ptr_id);
+extern void PushID_Int(int int_id);
+extern void PopID();
 extern ImGuiID GetID_Str(const char* str_id);
 extern ImGuiID GetID_StrStr(const char* str_id_begin,const char* str_id_end);
 extern ImGuiID GetID_Ptr(const void* ptr_id);
-extern void SetClipboardText(const char* text);
+extern void TextUnformatted(const char* text);
+extern void Text(const char* fmt);
 extern void TextColored(const ImVec4 col,const char* fmt);
-extern void InputTextCallbackData_InsertChars(ImGuiInputTextCallbackData* self,int pos,const char* text);
-extern ImVec2* Vec2_ImVec2_Nil();
-extern ImVec2* Vec2_ImVec2_Float(float _x,float _y);
-extern float GetDrawCursorPosX();
-extern ImGuiListClipper* ListClipper_ImGuiListClipper();
-extern bool Payload_IsPreview(ImGuiPayload* self);
-extern void OnceUponAFrame_Destroy(ImGuiOnceUponAFrame* self);
-extern void PushTextWrapPos(float wrap_local_pos_x);
-extern void SetDrawCursorPos(const ImVec2 local_pos);
-extern void IO_AddInputCharacter(ImGuiIO* self,unsigned int c);
-extern void IO_AddMouseWheelEvent(ImGuiIO* self,float wh_x,float wh_y);
-extern void PopTextWrapPos();
-extern void Spacing();
-extern int TableGetRowIndex();
-extern void TableSetColumnEnabled(int column_n,bool v);
-extern void DrawList_AddTriangle(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,ImU32 col,float thickness);
-extern void TextFilter_Clear(ImGuiTextFilter* self);
-extern void SetTabItemClosed(const char* tab_or_docked_window_label);
-extern bool IsMouseClicked(ImGuiMouseButton button,bool repeat);
-extern void SetWindowPos_Vec2(const ImVec2 pos,ImGuiCond cond);
-extern void SetWindowPos_Str(const char* name,const ImVec2 pos,ImGuiCond cond);
+extern void TextDisabled(const char* fmt);
+extern void TextWrapped(const char* fmt);
+extern void LabelText(const char* label,const char* fmt);
+extern void BulletText(const char* fmt);
 extern bool Button(const char* label,const ImVec2 size);
-extern void EndDragDropTarget();
-extern void WindowClass_Destroy(ImGuiWindowClass* self);
-extern void Columns(int count,const char* id,bool border);
-extern void EndFrame();
-extern void DrawData_DeIndexAllBuffers(ImDrawData* self);
-extern void Style_ScaleAllSizes(ImGuiStyle* self,float scale_factor);
+extern bool SmallButton(const char* label);
+extern bool InvisibleButton(const char* str_id,const ImVec2 size,ImGuiButtonFlags flags);
+extern bool ArrowButton(const char* str_id,ImGuiDir dir);
 extern bool Checkbox(const char* label,bool* v);
-extern void NextWindowData_Destroy(ImGuiNextWindowData* self);
-extern ImGuiPlatformImeData* PlatformImeData_ImGuiPlatformImeData();
-extern void PopFont();
-extern ImGuiTableColumnFlags TableGetColumnFlags(int column_n);
-extern void DrawList_AddCircleFilled(ImDrawList* self,const ImVec2 center,float radius,ImU32 col,int num_segments);
-extern double GetTime();
-extern ImGuiStyle* Style_ImGuiStyle();
-extern void FontAtlas_GetTexDataAsAlpha8(ImFontAtlas* self,unsigned char** out_pixels,int* out_width,int* out_height,int* out_bytes_per_pixel);
-extern bool Payload_IsDataType(ImGuiPayload* self,const char* type);
-extern const char* TableGetColumnName_Int(int column_n);
-extern void Rect_Destroy(ImRect* self);
-extern void EndDisabled();
-extern void ShowStyleEditor(ImGuiStyle* ref);
-extern void FontGlyphRangesBuilder_Clear(ImFontGlyphRangesBuilder* self);
-extern ImGuiViewport* FindViewportByID(ImGuiID id);
-extern void RenderPlatformWindowsDefault(void* platform_render_arg,void* renderer_render_arg);
+extern bool CheckboxFlags_IntPtr(const char* label,int* flags,int flags_value);
+extern bool CheckboxFlags_UintPtr(const char* label,unsigned int* flags,unsigned int flags_value);
+extern bool RadioButton_Bool(const char* label,bool active);
+extern bool RadioButton_IntPtr(const char* label,int* v,int v_button);
+extern void ProgressBar(float fraction,const ImVec2 size_arg,const char* overlay);
+extern void Bullet();
+extern void Image(uintptr_t user_texture_id,const ImVec2 size,const ImVec2 uv0,const ImVec2 uv1,const ImVec4 tint_col,const ImVec4 border_col);
+extern bool ImageButton(const char* str_id,uintptr_t user_texture_id,const ImVec2 size,const ImVec2 uv0,const ImVec2 uv1,const ImVec4 bg_col,const ImVec4 tint_col);
 extern bool BeginCombo(const char* label,const char* preview_value,ImGuiComboFlags flags);
+extern void EndCombo();
 extern bool Combo_Str_arr(const char* label,int* current_item,const char* const items[],int items_count,int popup_max_height_in_items);
 extern bool Combo_Str(const char* label,int* current_item,const char* items_separated_by_zeros,int popup_max_height_in_items);
-extern bool Combo_FnBoolPtr(const char* label,int* current_item,bool(*items_getter)(void* data,int idx,const char** out_text),void* data,int items_count,int popup_max_height_in_items);
-extern void ColorConvertHSVtoRGB(float h,float s,float v,float* out_r,float* out_g,float* out_b);
-extern void GetDrawCursorScreenPos(ImVec2 *pOut);
-extern void StyleColorsDark(ImGuiStyle* dst);
-extern void EndListBox();
-extern ImFont* FontAtlas_AddFontFromFileTTF(ImFontAtlas* self,const char* filename,float size_pixels,const ImFontConfig* font_cfg,const ImWchar* glyph_ranges);
-extern void FontAtlas_ClearFonts(ImFontAtlas* self);
-extern void IO_AddMouseViewportEvent(ImGuiIO* self,ImGuiID id);
-extern void WindowSettings_Destroy(ImGuiWindowSettings* self);
-extern bool TextFilter_PassFilter(ImGuiTextFilter* self,const char* text);
-extern void TextBuffer_Reserve(ImGuiTextBuffer* self,int capacity);
-extern void DrawList_PushClipRectFullScreen(ImDrawList* self);
-extern void DrawList_AddQuadFilled(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,const ImVec2 p4,ImU32 col);
-extern ImVec4* Vec4_ImVec4_Nil();
-extern ImVec4* Vec4_ImVec4_Float(float _x,float _y,float _z,float _w);
-extern bool IsItemVisible();
-extern void PlatformImeData_Destroy(ImGuiPlatformImeData* self);
-extern void ColorConvertU32ToFloat4(ImVec4 *pOut,ImU32 in);
-extern bool ColorEdit3(const char* label,float col[3],ImGuiColorEditFlags flags);
+extern bool Combo_FnBoolPtr(const char* label,int* current_item,uintptr_t items_getter,int items_count,int popup_max_height_in_items);
+extern bool DragFloat(const char* label,float* v,float v_speed,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
+extern bool DragFloat2(const char* label,float v[2],float v_speed,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
+extern bool DragFloat3(const char* label,float v[3],float v_speed,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
+extern bool DragFloat4(const char* label,float v[4],float v_speed,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
+extern bool DragFloatRange2(const char* label,float* v_current_min,float* v_current_max,float v_speed,float v_min,float v_max,const char* format,const char* format_max,ImGuiSliderFlags flags);
+extern bool DragInt(const char* label,int* v,float v_speed,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
+extern bool DragInt2(const char* label,int v[2],float v_speed,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
+extern bool DragInt3(const char* label,int v[3],float v_speed,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
+extern bool DragInt4(const char* label,int v[4],float v_speed,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
+extern bool DragIntRange2(const char* label,int* v_current_min,int* v_current_max,float v_speed,int v_min,int v_max,const char* format,const char* format_max,ImGuiSliderFlags flags);
 extern bool DragScalar(const char* label,ImGuiDataType data_type,void* p_data,float v_speed,const void* p_min,const void* p_max,const char* format,ImGuiSliderFlags flags);
-extern void SaveIniSettingsToDisk(const char* ini_filename);
-extern void DrawList_AddConvexPolyFilled(ImDrawList* self,const ImVec2* points,int num_points,ImU32 col);
-extern void FontAtlasCustomRect_Destroy(ImFontAtlasCustomRect* self);
-extern void ContextHook_Destroy(ImGuiContextHook* self);
-extern void TableSettings_Destroy(ImGuiTableSettings* self);
-extern void SetNextWindowFocus();
-extern void DrawList_AddCallback(ImDrawList* self,ImDrawCallback callback,void* callback_data);
-extern void PopAllowKeyboardFocus();
-extern void DrawList_AddPolyline(ImDrawList* self,const ImVec2* points,int num_points,ImU32 col,ImDrawFlags flags,float thickness);
-extern void StackSizes_Destroy(ImGuiStackSizes* self);
-extern void SetNextWindowDockID(ImGuiID dock_id,ImGuiCond cond);
-extern void OldColumnData_Destroy(ImGuiOldColumnData* self);
-extern void GetWindowContentRegionMin(ImVec2 *pOut);
+extern bool DragScalarN(const char* label,ImGuiDataType data_type,void* p_data,int components,float v_speed,const void* p_min,const void* p_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderFloat(const char* label,float* v,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderFloat2(const char* label,float v[2],float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderFloat3(const char* label,float v[3],float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderFloat4(const char* label,float v[4],float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderAngle(const char* label,float* v_rad,float v_degrees_min,float v_degrees_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderInt(const char* label,int* v,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderInt2(const char* label,int v[2],int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderInt3(const char* label,int v[3],int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderInt4(const char* label,int v[4],int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderScalar(const char* label,ImGuiDataType data_type,void* p_data,const void* p_min,const void* p_max,const char* format,ImGuiSliderFlags flags);
+extern bool SliderScalarN(const char* label,ImGuiDataType data_type,void* p_data,int components,const void* p_min,const void* p_max,const char* format,ImGuiSliderFlags flags);
+extern bool VSliderFloat(const char* label,const ImVec2 size,float* v,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
+extern bool VSliderInt(const char* label,const ImVec2 size,int* v,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
+extern bool VSliderScalar(const char* label,const ImVec2 size,ImGuiDataType data_type,void* p_data,const void* p_min,const void* p_max,const char* format,ImGuiSliderFlags flags);
+extern bool InputText(const char* label,char* buf,size_t buf_size,ImGuiInputTextFlags flags,ImGuiInputTextCallback callback,void* user_data);
 extern bool InputTextMultiline(const char* label,char* buf,size_t buf_size,const ImVec2 size,ImGuiInputTextFlags flags,ImGuiInputTextCallback callback,void* user_data);
-extern void SetScrollFromPosX_Float(float local_x,float center_x_ratio);
-extern void Color_SetHSV(ImColor* self,float h,float s,float v,float a);
-extern ImDrawList* GetWindowDrawList();
-extern bool IsAnyItemHovered();
-extern const ImWchar* FontAtlas_GetGlyphRangesChineseSimplifiedCommon(ImFontAtlas* self);
+extern bool InputTextWithHint(const char* label,const char* hint,char* buf,size_t buf_size,ImGuiInputTextFlags flags,ImGuiInputTextCallback callback,void* user_data);
+extern bool InputFloat(const char* label,float* v,float step,float step_fast,const char* format,ImGuiInputTextFlags flags);
+extern bool InputFloat2(const char* label,float v[2],const char* format,ImGuiInputTextFlags flags);
+extern bool InputFloat3(const char* label,float v[3],const char* format,ImGuiInputTextFlags flags);
+extern bool InputFloat4(const char* label,float v[4],const char* format,ImGuiInputTextFlags flags);
+extern bool InputInt(const char* label,int* v,int step,int step_fast,ImGuiInputTextFlags flags);
+extern bool InputInt2(const char* label,int v[2],ImGuiInputTextFlags flags);
+extern bool InputInt3(const char* label,int v[3],ImGuiInputTextFlags flags);
+extern bool InputInt4(const char* label,int v[4],ImGuiInputTextFlags flags);
+extern bool InputDouble(const char* label,double* v,double step,double step_fast,const char* format,ImGuiInputTextFlags flags);
+extern bool InputScalar(const char* label,ImGuiDataType data_type,void* p_data,const void* p_step,const void* p_step_fast,const char* format,ImGuiInputTextFlags flags);
+extern bool InputScalarN(const char* label,ImGuiDataType data_type,void* p_data,int components,const void* p_step,const void* p_step_fast,const char* format,ImGuiInputTextFlags flags);
+extern bool ColorEdit3(const char* label,float col[3],ImGuiColorEditFlags flags);
 extern bool ColorEdit4(const char* label,float col[4],ImGuiColorEditFlags flags);
-extern void InputTextState_Destroy(ImGuiInputTextState* self);
-extern ImGuiStyle* GetStyle();
-extern void GetItemRectMin(ImVec2 *pOut);
-extern bool BeginDragDropSource(ImGuiDragDropFlags flags);
-extern void TabItem_Destroy(ImGuiTabItem* self);
-extern void DrawData_ScaleClipRects(ImDrawData* self,const ImVec2 fb_scale);
+extern bool ColorPicker3(const char* label,float col[3],ImGuiColorEditFlags flags);
+extern bool ColorPicker4(const char* label,float col[4],ImGuiColorEditFlags flags,const float* ref_col);
+extern bool ColorButton(const char* desc_id,const ImVec4 col,ImGuiColorEditFlags flags,const ImVec2 size);
+extern void SetColorEditOptions(ImGuiColorEditFlags flags);
 extern bool TreeNode_Str(const char* label);
 extern bool TreeNode_StrStr(const char* str_id,const char* fmt);
 extern bool TreeNode_Ptr(const void* ptr_id,const char* fmt);
-extern const char* GetClipboardText();
-extern void ListClipper_End(ImGuiListClipper* self);
-extern void SetScrollHereY(float center_y_ratio);
-extern const ImWchar* FontAtlas_GetGlyphRangesChineseFull(ImFontAtlas* self);
-extern void IO_AddKeyAnalogEvent(ImGuiIO* self,ImGuiKey key,bool down,float v);
-extern void GetMousePos(ImVec2 *pOut);
+extern bool TreeNodeEx_Str(const char* label,ImGuiTreeNodeFlags flags);
+extern bool TreeNodeEx_StrStr(const char* str_id,ImGuiTreeNodeFlags flags,const char* fmt);
+extern bool TreeNodeEx_Ptr(const void* ptr_id,ImGuiTreeNodeFlags flags,const char* fmt);
+extern void TreePush_Str(const char* str_id);
+extern void TreePush_Ptr(const void* ptr_id);
+extern void TreePop();
 extern float GetTreeNodeToLabelSpacing();
-extern void FontAtlas_CalcCustomRectUV(ImFontAtlas* self,const ImFontAtlasCustomRect* rect,ImVec2* out_uv_min,ImVec2* out_uv_max);
-extern bool ListClipper_Step(ImGuiListClipper* self);
-extern void SettingsHandler_Destroy(ImGuiSettingsHandler* self);
-extern const ImWchar* FontAtlas_GetGlyphRangesDefault(ImFontAtlas* self);
-extern void FontGlyphRangesBuilder_SetBit(ImFontGlyphRangesBuilder* self,size_t n);
-extern void SetKeyboardFocusHere(int offset);
-extern bool BeginPopupContextVoid(const char* str_id,ImGuiPopupFlags popup_flags);
-extern void EndGroup();
-extern const ImGuiPayload* AcceptDragDropPayload(const char* type,ImGuiDragDropFlags flags);
-extern bool InputFloat2(const char* label,float v[2],const char* format,ImGuiInputTextFlags flags);
-extern void IO_AddFocusEvent(ImGuiIO* self,bool focused);
-extern ImGuiViewport* GetWindowViewport();
-extern bool IsKeyPressed(ImGuiKey key,bool repeat);
+extern bool CollapsingHeader_TreeNodeFlags(const char* label,ImGuiTreeNodeFlags flags);
+extern bool CollapsingHeader_BoolPtr(const char* label,bool* p_visible,ImGuiTreeNodeFlags flags);
+extern void SetNextItemOpen(bool is_open,ImGuiCond cond);
+extern bool Selectable_Bool(const char* label,bool selected,ImGuiSelectableFlags flags,const ImVec2 size);
+extern bool Selectable_BoolPtr(const char* label,bool* p_selected,ImGuiSelectableFlags flags,const ImVec2 size);
+extern bool BeginListBox(const char* label,const ImVec2 size);
+extern void EndListBox();
+extern bool ListBox_Str_arr(const char* label,int* current_item,const char* const items[],int items_count,int height_in_items);
+extern bool ListBox_FnBoolPtr(const char* label,int* current_item,uintptr_t items_getter,int items_count,int height_in_items);
+extern void PlotLines_FloatPtr(const char* label,const float* values,int values_count,int values_offset,const char* overlay_text,float scale_min,float scale_max,ImVec2 graph_size,int stride);
+extern void PlotLines_FnFloatPtr(const char* label,uintptr_t values_getter,int values_count,int values_offset,const char* overlay_text,float scale_min,float scale_max,ImVec2 graph_size);
+extern void PlotHistogram_FloatPtr(const char* label,const float* values,int values_count,int values_offset,const char* overlay_text,float scale_min,float scale_max,ImVec2 graph_size,int stride);
+extern void PlotHistogram_FnFloatPtr(const char* label,uintptr_t values_getter,int values_count,int values_offset,const char* overlay_text,float scale_min,float scale_max,ImVec2 graph_size);
+extern void Value_Bool(const char* prefix,bool b);
+extern void Value_Int(const char* prefix,int v);
+extern void Value_Uint(const char* prefix,unsigned int v);
+extern void Value_Float(const char* prefix,float v,const char* float_format);
+extern bool BeginMenuBar();
+extern void EndMenuBar();
+extern bool BeginMainMenuBar();
+extern void EndMainMenuBar();
+extern bool BeginMenu(const char* label,bool enabled);
+extern void EndMenu();
 extern bool MenuItem_Bool(const char* label,const char* shortcut,bool selected,bool enabled);
 extern bool MenuItem_BoolPtr(const char* label,const char* shortcut,bool* p_selected,bool enabled);
-extern void IO_SetKeyEventNativeData(ImGuiIO* self,ImGuiKey key,int native_keycode,int native_scancode,int native_legacy_index);
-extern void SameLine(float offset_from_start_x,float spacing);
-extern void EndCombo();
-extern void LoadIniSettingsFromDisk(const char* ini_filename);
-extern ImGuiTextBuffer* TextBuffer_ImGuiTextBuffer();
-extern ImGuiContext* CreateContext(ImFontAtlas* shared_font_atlas);
-extern float Font_GetCharAdvance(ImFont* self,ImWchar c);
-extern void DrawList_PathBezierQuadraticCurveTo(ImDrawList* self,const ImVec2 p2,const ImVec2 p3,int num_segments);
-extern void FontAtlas_GetTexDataAsRGBA32(ImFontAtlas* self,unsigned char** out_pixels,int* out_width,int* out_height,int* out_bytes_per_pixel);
-extern void Font_Destroy(ImFont* self);
-extern void IO_ClearInputKeys(ImGuiIO* self);
-extern float GetWindowWidth();
-extern void SetNextWindowSize(const ImVec2 size,ImGuiCond cond);
-extern void Color_HSV(ImColor *pOut,float h,float s,float v,float a);
-extern void Vec4_Destroy(ImVec4* self);
-extern void Font_RenderText(ImFont* self,ImDrawList* draw_list,float size,const ImVec2 pos,ImU32 col,const ImVec4 clip_rect,const char* text_begin,float wrap_width,bool cpu_fine_clip);
-extern bool InputFloat4(const char* label,float v[4],const char* format,ImGuiInputTextFlags flags);
-extern bool DragInt(const char* label,int* v,float v_speed,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
-extern bool IsItemEdited();
+extern void BeginTooltip();
+extern void EndTooltip();
+extern void SetTooltip(const char* fmt);
+extern bool BeginPopup(const char* str_id,ImGuiWindowFlags flags);
+extern bool BeginPopupModal(const char* name,bool* p_open,ImGuiWindowFlags flags);
+extern void EndPopup();
 extern void OpenPopup_Str(const char* str_id,ImGuiPopupFlags popup_flags);
 extern void OpenPopup_ID(ImGuiID id,ImGuiPopupFlags popup_flags);
-extern bool SmallButton(const char* label);
-extern void InputTextCallbackData_ClearSelection(ImGuiInputTextCallbackData* self);
-extern void SetWindowFontScale(float scale);
-extern void DrawList_AddDrawCmd(ImDrawList* self);
-extern const char* SaveIniSettingsToMemory(size_t* out_ini_size);
-extern ImGuiIO* IO_ImGuiIO();
-extern ImU32 GetColorU32_Col(ImGuiCol idx,float alpha_mul);
-extern ImU32 GetColorU32_Vec4(const ImVec4 col);
-extern ImU32 GetColorU32_U32(ImU32 col);
-extern void GetDrawCursorPos(ImVec2 *pOut);
-extern void SetDrawCursorPosY(float local_y);
-extern bool SliderScalar(const char* label,ImGuiDataType data_type,void* p_data,const void* p_min,const void* p_max,const char* format,ImGuiSliderFlags flags);
-extern void FontAtlas_Clear(ImFontAtlas* self);
-extern bool TextFilter_IsActive(ImGuiTextFilter* self);
-extern bool ArrowButton(const char* str_id,ImGuiDir dir);
-extern bool DragFloat3(const char* label,float v[3],float v_speed,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
-extern const ImWchar* FontAtlas_GetGlyphRangesCyrillic(ImFontAtlas* self);
-extern void BulletText(const char* fmt);
-extern ImGuiPayload* Payload_ImGuiPayload();
-extern void TextFilter_Build(ImGuiTextFilter* self);
-extern bool Selectable_Bool(const char* label,bool selected,ImGuiSelectableFlags flags,const ImVec2 size);
-extern bool Selectable_BoolPtr(const char* label,bool* p_selected,ImGuiSelectableFlags flags,const ImVec2 size);
-extern void DrawList_AddNgonFilled(ImDrawList* self,const ImVec2 center,float radius,ImU32 col,int num_segments);
-extern void DrawList_AddRectFilledMultiColor(ImDrawList* self,const ImVec2 p_min,const ImVec2 p_max,ImU32 col_upr_left,ImU32 col_upr_right,ImU32 col_bot_right,ImU32 col_bot_left);
-extern ImGuiViewport* GetMainViewport();
-extern void PopClipRect();
-extern void Render();
-extern void SetScrollX_Float(float scroll_x);
-extern void DrawList_PopClipRect(ImDrawList* self);
-extern void DrawList_Destroy(ImDrawList* self);
-extern void ShowAboutWindow(bool* p_open);
-extern void SetItemDefaultFocus();
-extern bool Font_IsGlyphRangeUnused(ImFont* self,unsigned int c_begin,unsigned int c_last);
-extern void DrawList_PushClipRect(ImDrawList* self,const ImVec2 clip_rect_min,const ImVec2 clip_rect_max,bool intersect_with_current_clip_rect);
+extern void OpenPopupOnItemClick(const char* str_id,ImGuiPopupFlags popup_flags);
+extern void CloseCurrentPopup();
+extern bool BeginPopupContextItem(const char* str_id,ImGuiPopupFlags popup_flags);
+extern bool BeginPopupContextWindow(const char* str_id,ImGuiPopupFlags popup_flags);
+extern bool BeginPopupContextVoid(const char* str_id,ImGuiPopupFlags popup_flags);
+extern bool IsPopupOpen_Str(const char* str_id,ImGuiPopupFlags flags);
+extern bool BeginTable(const char* str_id,int column,ImGuiTableFlags flags,const ImVec2 outer_size,float inner_width);
+extern void EndTable();
+extern void TableNextRow(ImGuiTableRowFlags row_flags,float min_row_height);
+extern bool TableNextColumn();
+extern bool TableSetColumnIndex(int column_n);
+extern void TableSetupColumn(const char* label,ImGuiTableColumnFlags flags,float init_width_or_weight,ImGuiID user_id);
+extern void TableSetupScrollFreeze(int cols,int rows);
+extern void TableHeadersRow();
+extern void TableHeader(const char* label);
+extern ImGuiTableSortSpecs* TableGetSortSpecs();
+extern int TableGetColumnCount();
+extern int TableGetColumnIndex();
+extern int TableGetRowIndex();
+extern const char* TableGetColumnName_Int(int column_n);
+extern ImGuiTableColumnFlags TableGetColumnFlags(int column_n);
+extern void TableSetColumnEnabled(int column_n,bool v);
+extern void TableSetBgColor(ImGuiTableBgTarget target,ImU32 color,int column_n);
+extern void Columns(int count,const char* id,bool border);
+extern void NextColumn();
+extern int GetColumnIndex();
+extern float GetColumnWidth(int column_index);
+extern void SetColumnWidth(int column_index,float width);
+extern float GetColumnOffset(int column_index);
+extern void SetColumnOffset(int column_index,float offset_x);
 extern int GetColumnsCount();
-extern void PushItemWidth(float item_width);
-extern ImFontAtlasCustomRect* FontAtlasCustomRect_ImFontAtlasCustomRect();
-extern const ImWchar* FontAtlas_GetGlyphRangesThai(ImFontAtlas* self);
-extern void DrawList_ChannelsSetCurrent(ImDrawList* self,int n);
-extern void DrawList_PathLineTo(ImDrawList* self,const ImVec2 pos);
-extern void Context_Destroy(ImGuiContext* self);
-extern void PushAllowKeyboardFocus(bool allow_keyboard_focus);
-extern ImGuiID DockSpace(ImGuiID id,const ImVec2 size,ImGuiDockNodeFlags flags,const ImGuiWindowClass* window_class);
-extern bool ColorPicker4(const char* label,float col[4],ImGuiColorEditFlags flags,const float* ref_col);
-extern bool IsMouseDragging(ImGuiMouseButton button,float lock_threshold);
+extern bool BeginTabBar(const char* str_id,ImGuiTabBarFlags flags);
+extern void EndTabBar();
+extern bool BeginTabItem(const char* label,bool* p_open,ImGuiTabItemFlags flags);
+extern void EndTabItem();
 extern bool TabItemButton(const char* label,ImGuiTabItemFlags flags);
-extern void Payload_Destroy(ImGuiPayload* self);
-extern bool IsAnyMouseDown();
+extern void SetTabItemClosed(const char* tab_or_docked_window_label);
+extern ImGuiID DockSpace(ImGuiID id,const ImVec2 size,ImGuiDockNodeFlags flags,const ImGuiWindowClass* window_class);
+extern ImGuiID DockSpaceOverViewport(const ImGuiViewport* viewport,ImGuiDockNodeFlags flags,const ImGuiWindowClass* window_class);
+extern void SetNextWindowDockID(ImGuiID dock_id,ImGuiCond cond);
+extern void SetNextWindowClass(const ImGuiWindowClass* window_class);
+extern ImGuiID GetWindowDockID();
+extern bool IsWindowDocked();
+extern void LogToTTY(int auto_open_depth);
+extern void LogToFile(int auto_open_depth,const char* filename);
+extern void LogToClipboard(int auto_open_depth);
+extern void LogFinish();
+extern void LogButtons();
+extern void LogText(const char* fmt);
+extern bool BeginDragDropSource(ImGuiDragDropFlags flags);
 extern bool SetDragDropPayload(const char* type,const void* data,size_t sz,ImGuiCond cond);
-extern void DrawList_AddBezierCubic(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,const ImVec2 p4,ImU32 col,float thickness,int num_segments);
-extern void ListClipper_Destroy(ImGuiListClipper* self);
-extern void GetContentRegionAvail(ImVec2 *pOut);
-extern void FontAtlas_Destroy(ImFontAtlas* self);
-extern void Font_AddRemapChar(ImFont* self,ImWchar dst,ImWchar src,bool overwrite_dst);
+extern void EndDragDropSource();
+extern bool BeginDragDropTarget();
+extern const ImGuiPayload* AcceptDragDropPayload(const char* type,ImGuiDragDropFlags flags);
+extern void EndDragDropTarget();
+extern const ImGuiPayload* GetDragDropPayload();
+extern void BeginDisabled(bool disabled);
+extern void EndDisabled();
+extern void PushClipRect(const ImVec2 clip_rect_min,const ImVec2 clip_rect_max,bool intersect_with_current_clip_rect);
+extern void PopClipRect();
+extern void SetItemDefaultFocus();
+extern void SetKeyboardFocusHere(int offset);
+extern bool IsItemHovered(ImGuiHoveredFlags flags);
 extern bool IsItemActive();
-extern float GetColumnOffset(int column_index);
-extern bool TextFilter_Draw(ImGuiTextFilter* self,const char* label,float width);
-extern void PopButtonRepeat();
-extern void ResetMouseDragDelta(ImGuiMouseButton button);
-extern void DrawList_AddNgon(ImDrawList* self,const ImVec2 center,float radius,ImU32 col,int num_segments,float thickness);
-extern void IO_ClearInputCharacters(ImGuiIO* self);
-extern void EndMenuBar();
-extern bool VSliderInt(const char* label,const ImVec2 size,int* v,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
-extern int FontAtlas_AddCustomRectRegular(ImFontAtlas* self,int width,int height);
-extern void TextBuffer_Clear(ImGuiTextBuffer* self);
-extern void Bullet();
-extern ImGuiTextFilter* TextFilter_ImGuiTextFilter(const char* default_filter);
-extern void PlotHistogram_FloatPtr(const char* label,const float* values,int values_count,int values_offset,const char* overlay_text,float scale_min,float scale_max,ImVec2 graph_size,int stride);
-extern void PlotHistogram_FnFloatPtr(const char* label,float(*values_getter)(void* data,int idx),void* data,int values_count,int values_offset,const char* overlay_text,float scale_min,float scale_max,ImVec2 graph_size);
-extern bool TableNextColumn();
-extern ImDrawListSplitter* DrawListSplitter_ImDrawListSplitter();
-extern void DrawList_PrimQuadUV(ImDrawList* self,const ImVec2 a,const ImVec2 b,const ImVec2 c,const ImVec2 d,const ImVec2 uv_a,const ImVec2 uv_b,const ImVec2 uv_c,const ImVec2 uv_d,ImU32 col);
-extern void SetNextItemOpen(bool is_open,ImGuiCond cond);
-extern void TableColumn_Destroy(ImGuiTableColumn* self);
-extern void NavItemData_Destroy(ImGuiNavItemData* self);
-extern void Text(const char* fmt);
-extern bool DragFloat(const char* label,float* v,float v_speed,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
-extern bool SliderScalarN(const char* label,ImGuiDataType data_type,void* p_data,int components,const void* p_min,const void* p_max,const char* format,ImGuiSliderFlags flags);
-extern bool BeginChildFrame(ImGuiID id,const ImVec2 size,ImGuiWindowFlags flags);
-extern bool InputScalarN(const char* label,ImGuiDataType data_type,void* p_data,int components,const void* p_step,const void* p_step_fast,const char* format,ImGuiInputTextFlags flags);
-extern bool IsWindowCollapsed();
-extern void LogText(const char* fmt);
-extern const ImFontGlyph* Font_FindGlyph(ImFont* self,ImWchar c);
+extern bool IsItemFocused();
+extern bool IsItemClicked(ImGuiMouseButton mouse_button);
+extern bool IsItemVisible();
+extern bool IsItemEdited();
+extern bool IsItemActivated();
+extern bool IsItemDeactivated();
+extern bool IsItemDeactivatedAfterEdit();
+extern bool IsItemToggledOpen();
+extern bool IsAnyItemHovered();
+extern bool IsAnyItemActive();
+extern bool IsAnyItemFocused();
+extern void GetItemRectMin(ImVec2 *pOut);
+extern void GetItemRectMax(ImVec2 *pOut);
+extern void GetItemRectSize(ImVec2 *pOut);
+extern void SetItemAllowOverlap();
+extern ImGuiViewport* GetMainViewport();
 extern ImDrawList* GetBackgroundDrawList_Nil();
+extern ImDrawList* GetForegroundDrawList_Nil();
 extern ImDrawList* GetBackgroundDrawList_ViewportPtr(ImGuiViewport* viewport);
-extern void LogToClipboard(int auto_open_depth);
-extern void Color_Destroy(ImColor* self);
-extern void DrawList_AddText_Vec2(ImDrawList* self,const ImVec2 pos,ImU32 col,const char* text_begin);
-extern void DrawList_AddText_FontPtr(ImDrawList* self,const ImFont* font,float font_size,const ImVec2 pos,ImU32 col,const char* text_begin,float wrap_width,const ImVec4* cpu_fine_clip_rect);
-extern int GetKeyPressedAmount(ImGuiKey key,float repeat_delay,float rate);
-extern void Viewport_GetCenter(ImVec2 *pOut,ImGuiViewport* self);
-extern void PlotLines_FloatPtr(const char* label,const float* values,int values_count,int values_offset,const char* overlay_text,float scale_min,float scale_max,ImVec2 graph_size,int stride);
-extern void PlotLines_FnFloatPtr(const char* label,float(*values_getter)(void* data,int idx),void* data,int values_count,int values_offset,const char* overlay_text,float scale_min,float scale_max,ImVec2 graph_size);
-extern bool SliderInt2(const char* label,int v[2],int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
-extern bool TableSetColumnIndex(int column_n);
-extern void PopupData_Destroy(ImGuiPopupData* self);
-extern bool ColorButton(const char* desc_id,const ImVec4 col,ImGuiColorEditFlags flags,const ImVec2 size);
-extern void PushButtonRepeat(bool repeat);
-extern void SetWindowFocus_Nil();
-extern void SetWindowFocus_Str(const char* name);
-extern bool BeginPopupModal(const char* name,bool* p_open,ImGuiWindowFlags flags);
-extern void Dummy(const ImVec2 size);
+extern ImDrawList* GetForegroundDrawList_ViewportPtr(ImGuiViewport* viewport);
+extern bool IsRectVisible_Nil(const ImVec2 size);
+extern bool IsRectVisible_Vec2(const ImVec2 rect_min,const ImVec2 rect_max);
+extern double GetTime();
+extern int GetFrameCount();
+extern ImDrawListSharedData* GetDrawListSharedData();
+extern const char* GetStyleColorName(ImGuiCol idx);
+extern bool BeginChildFrame(ImGuiID id,const ImVec2 size,ImGuiWindowFlags flags);
+extern void EndChildFrame();
 extern void CalcTextSize(ImVec2 *pOut,const char* text,bool hide_text_after_double_hash,float wrap_width);
+extern void ColorConvertU32ToFloat4(ImVec4 *pOut,ImU32 in);
+extern ImU32 ColorConvertFloat4ToU32(const ImVec4 in);
+extern void ColorConvertRGBtoHSV(float r,float g,float b,float* out_h,float* out_s,float* out_v);
+extern void ColorConvertHSVtoRGB(float h,float s,float v,float* out_r,float* out_g,float* out_b);
+extern bool IsKeyDown(ImGuiKey key);
+extern bool IsKeyPressed(ImGuiKey key,bool repeat);
+extern bool IsKeyReleased(ImGuiKey key);
+extern int GetKeyPressedAmount(ImGuiKey key,float repeat_delay,float rate);
+extern const char* GetKeyName(ImGuiKey key);
+extern void SetNextFrameWantCaptureKeyboard(bool want_capture_keyboard);
+extern bool IsMouseDown(ImGuiMouseButton button);
+extern bool IsMouseClicked(ImGuiMouseButton button,bool repeat);
+extern bool IsMouseReleased(ImGuiMouseButton button);
+extern bool IsMouseDoubleClicked(ImGuiMouseButton button);
+extern int GetMouseClickedCount(ImGuiMouseButton button);
+extern bool IsMouseHoveringRect(const ImVec2 r_min,const ImVec2 r_max,bool clip);
+extern bool IsMousePosValid(const ImVec2* mouse_pos);
+extern bool IsAnyMouseDown();
+extern void GetMousePos(ImVec2 *pOut);
+extern void GetMousePosOnOpeningCurrentPopup(ImVec2 *pOut);
+extern bool IsMouseDragging(ImGuiMouseButton button,float lock_threshold);
+extern void GetMouseDragDelta(ImVec2 *pOut,ImGuiMouseButton button,float lock_threshold);
+extern void ResetMouseDragDelta(ImGuiMouseButton button);
+extern ImGuiMouseCursor GetMouseCursor();
+extern void SetMouseCursor(ImGuiMouseCursor cursor_type);
+extern void SetNextFrameWantCaptureMouse(bool want_capture_mouse);
+extern const char* GetClipboardText();
+extern void SetClipboardText(const char* text);
+extern void LoadIniSettingsFromDisk(const char* ini_filename);
+extern void LoadIniSettingsFromMemory(const char* ini_data,size_t ini_size);
+extern void SaveIniSettingsToDisk(const char* ini_filename);
+extern const char* SaveIniSettingsToMemory(size_t* out_ini_size);
+extern void DebugTextEncoding(const char* text);
+extern bool DebugCheckVersionAndDataLayout(const char* version_str,size_t sz_io,size_t sz_style,size_t sz_vec2,size_t sz_vec4,size_t sz_drawvert,size_t sz_drawidx);
+extern void* MemAlloc(size_t size);
+extern void MemFree(void* ptr);
+extern ImGuiPlatformIO* GetPlatformIO();
+extern void UpdatePlatformWindows();
+extern void RenderPlatformWindowsDefault(void* platform_render_arg,void* renderer_render_arg);
 extern void DestroyPlatformWindows();
-extern void Font_BuildLookupTable(ImFont* self);
-extern void DrawList_PathStroke(ImDrawList* self,ImU32 col,ImDrawFlags flags,float thickness);
-extern void DrawList_PrimReserve(ImDrawList* self,int idx_count,int vtx_count);
-extern float GetWindowDpiScale();
-extern void DrawList_AddImageQuad(ImDrawList* self,ImTextureID user_texture_id,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,const ImVec2 p4,const ImVec2 uv1,const ImVec2 uv2,const ImVec2 uv3,const ImVec2 uv4,ImU32 col);
-extern void ListClipper_Begin(ImGuiListClipper* self,int items_count,float items_height);
-extern bool BeginMenuBar();
-extern void DrawList_PathClear(ImDrawList* self);
-extern void FontGlyphRangesBuilder_Destroy(ImFontGlyphRangesBuilder* self);
-extern ImFont* FontAtlas_AddFont(ImFontAtlas* self,const ImFontConfig* font_cfg);
+extern ImGuiViewport* FindViewportByID(ImGuiID id);
+extern ImGuiViewport* FindViewportByPlatformHandle(void* platform_handle);
+extern ImGuiStyle* Style_ImGuiStyle();
+extern void Style_ScaleAllSizes(ImGuiStyle* self,float scale_factor);
+extern void IO_AddKeyEvent(ImGuiIO* self,ImGuiKey key,bool down);
+extern void IO_AddKeyAnalogEvent(ImGuiIO* self,ImGuiKey key,bool down,float v);
+extern void IO_AddMousePosEvent(ImGuiIO* self,float x,float y);
+extern void IO_AddMouseButtonEvent(ImGuiIO* self,int button,bool down);
+extern void IO_AddMouseWheelEvent(ImGuiIO* self,float wh_x,float wh_y);
+extern void IO_AddMouseViewportEvent(ImGuiIO* self,ImGuiID id);
+extern void IO_AddFocusEvent(ImGuiIO* self,bool focused);
+extern void IO_AddInputCharacter(ImGuiIO* self,unsigned int c);
+extern void IO_AddInputCharacterUTF16(ImGuiIO* self,ImWchar16 c);
+extern void IO_AddInputCharactersUTF8(ImGuiIO* self,const char* str);
+extern void IO_SetKeyEventNativeData(ImGuiIO* self,ImGuiKey key,int native_keycode,int native_scancode,int native_legacy_index);
 extern void IO_SetAppAcceptingEvents(ImGuiIO* self,bool accepting_events);
-extern void EndChild();
-extern bool InputInt4(const char* label,int v[4],ImGuiInputTextFlags flags);
-extern void TextWrapped(const char* fmt);
-extern ImFont* FontAtlas_AddFontFromMemoryCompressedBase85TTF(ImFontAtlas* self,const char* compressed_font_data_base85,float size_pixels,const ImFontConfig* font_cfg,const ImWchar* glyph_ranges);
-extern void TableTempData_Destroy(ImGuiTableTempData* self);
-extern bool IsItemDeactivated();
-extern void LogToTTY(int auto_open_depth);
-extern void StyleColorsLight(ImGuiStyle* dst);
-extern ImDrawCmd* DrawCmd_ImDrawCmd();
-extern void DrawList_PrimRectUV(ImDrawList* self,const ImVec2 a,const ImVec2 b,const ImVec2 uv_a,const ImVec2 uv_b,ImU32 col);
-extern float GetScrollX();
-extern ImFont* FontAtlas_AddFontFromMemoryTTF(ImFontAtlas* self,void* font_data,int font_size,float size_pixels,const ImFontConfig* font_cfg,const ImWchar* glyph_ranges);
-extern bool IsItemToggledOpen();
-extern void TableSetupColumn(const char* label,ImGuiTableColumnFlags flags,float init_width_or_weight,ImGuiID user_id);
-extern void TreePush_Str(const char* str_id);
-extern void TreePush_Ptr(const void* ptr_id);
+extern void IO_ClearInputCharacters(ImGuiIO* self);
+extern void IO_ClearInputKeys(ImGuiIO* self);
+extern ImGuiIO* IO_ImGuiIO();
 extern ImGuiInputTextCallbackData* InputTextCallbackData_ImGuiInputTextCallbackData();
-extern void FontGlyphRangesBuilder_AddRanges(ImFontGlyphRangesBuilder* self,const ImWchar* ranges);
-extern bool DragInt4(const char* label,int v[4],float v_speed,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
-extern void End();
-extern int GetColumnIndex();
-extern void SetNextWindowCollapsed(bool collapsed,ImGuiCond cond);
+extern void InputTextCallbackData_DeleteChars(ImGuiInputTextCallbackData* self,int pos,int bytes_count);
+extern void InputTextCallbackData_InsertChars(ImGuiInputTextCallbackData* self,int pos,const char* text);
 extern void InputTextCallbackData_SelectAll(ImGuiInputTextCallbackData* self);
-extern const char* TextBuffer_c_str(ImGuiTextBuffer* self);
-extern void BeginDisabled(bool disabled);
-extern bool InputFloat(const char* label,float* v,float step,float step_fast,const char* format,ImGuiInputTextFlags flags);
-extern bool IsItemActivated();
-extern void PopItemWidth();
-extern void GetMouseDragDelta(ImVec2 *pOut,ImGuiMouseButton button,float lock_threshold);
-extern const char* Font_CalcWordWrapPositionA(ImFont* self,float scale,const char* text,float wrap_width);
-extern ImDrawData* GetDrawData();
-extern const ImGuiPayload* GetDragDropPayload();
-extern void GetMousePosOnOpeningCurrentPopup(ImVec2 *pOut);
-extern void FontGlyphRangesBuilder_AddText(ImFontGlyphRangesBuilder* self,const char* text);
-extern void TextBuffer_Destroy(ImGuiTextBuffer* self);
-extern bool FontAtlasCustomRect_IsPacked(ImFontAtlasCustomRect* self);
-extern void GetWindowContentRegionMax(ImVec2 *pOut);
-extern void SetTooltip(const char* fmt);
-extern void DrawListSplitter_Merge(ImDrawListSplitter* self,ImDrawList* draw_list);
-extern void DrawList_PathArcTo(ImDrawList* self,const ImVec2 center,float radius,float a_min,float a_max,int num_segments);
-extern ImGuiPlatformIO* GetPlatformIO();
-extern void MenuColumns_Destroy(ImGuiMenuColumns* self);
-extern const char* TextBuffer_End(ImGuiTextBuffer* self);
-extern bool DragScalarN(const char* label,ImGuiDataType data_type,void* p_data,int components,float v_speed,const void* p_min,const void* p_max,const char* format,ImGuiSliderFlags flags);
-extern void ProgressBar(float fraction,const ImVec2 size_arg,const char* overlay);
-extern void SetColumnWidth(int column_index,float width);
+extern void InputTextCallbackData_ClearSelection(ImGuiInputTextCallbackData* self);
+extern bool InputTextCallbackData_HasSelection(ImGuiInputTextCallbackData* self);
 extern ImGuiWindowClass* WindowClass_ImGuiWindowClass();
-extern void GetItemRectMax(ImVec2 *pOut);
+extern ImGuiPayload* Payload_ImGuiPayload();
+extern void Payload_Clear(ImGuiPayload* self);
+extern bool Payload_IsDataType(ImGuiPayload* self,const char* type);
+extern bool Payload_IsPreview(ImGuiPayload* self);
+extern bool Payload_IsDelivery(ImGuiPayload* self);
+extern ImGuiTableColumnSortSpecs* TableColumnSortSpecs_ImGuiTableColumnSortSpecs();
+extern ImGuiTableSortSpecs* TableSortSpecs_ImGuiTableSortSpecs();
+extern ImGuiOnceUponAFrame* OnceUponAFrame_ImGuiOnceUponAFrame();
+extern ImGuiTextFilter* TextFilter_ImGuiTextFilter(const char* default_filter);
+extern bool TextFilter_Draw(ImGuiTextFilter* self,const char* label,float width);
+extern bool TextFilter_PassFilter(ImGuiTextFilter* self,const char* text);
+extern void TextFilter_Build(ImGuiTextFilter* self);
+extern void TextFilter_Clear(ImGuiTextFilter* self);
+extern bool TextFilter_IsActive(ImGuiTextFilter* self);
+extern ImGuiTextBuffer* TextBuffer_ImGuiTextBuffer();
+extern const char* TextBuffer_Begin(ImGuiTextBuffer* self);
+extern const char* TextBuffer_End(ImGuiTextBuffer* self);
+extern int TextBuffer_Size(ImGuiTextBuffer* self);
+extern bool TextBuffer_Empty(ImGuiTextBuffer* self);
+extern void TextBuffer_Clear(ImGuiTextBuffer* self);
+extern void TextBuffer_Reserve(ImGuiTextBuffer* self,int capacity);
+extern const char* TextBuffer_c_str(ImGuiTextBuffer* self);
+extern void TextBuffer_Append(ImGuiTextBuffer* self,const char* str,const char* str_end);
+extern void TextBuffer_Appendf(ImGuiTextBuffer* self,const char* fmt);
+extern ImGuiListClipper* ListClipper_ImGuiListClipper();
+extern void ListClipper_Destroy(ImGuiListClipper* self);
+extern void ListClipper_Begin(ImGuiListClipper* self,int items_count,float items_height);
+extern void ListClipper_End(ImGuiListClipper* self);
+extern bool ListClipper_Step(ImGuiListClipper* self);
+extern void ListClipper_ForceDisplayRangeByIndices(ImGuiListClipper* self,int item_min,int item_max);
+extern ImColor* Color_ImColor_Nil();
+extern ImColor* Color_ImColor_Float(float r,float g,float b,float a);
+extern ImColor* Color_ImColor_Vec4(const ImVec4 col);
+extern ImColor* Color_ImColor_Int(int r,int g,int b,int a);
+extern ImColor* Color_ImColor_U32(ImU32 rgba);
+extern void Color_SetHSV(ImColor* self,float h,float s,float v,float a);
+extern void Color_HSV(ImColor *pOut,float h,float s,float v,float a);
+extern ImDrawCmd* DrawCmd_ImDrawCmd();
+extern ImTextureID DrawCmd_GetTexID(ImDrawCmd* self);
+extern ImDrawListSplitter* DrawListSplitter_ImDrawListSplitter();
 extern void DrawListSplitter_Destroy(ImDrawListSplitter* self);
-extern void DrawList_AddLine(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,ImU32 col,float thickness);
-extern int TableGetColumnCount();
-extern void SetColumnOffset(int column_index,float offset_x);
-extern bool Font_IsLoaded(ImFont* self);
-extern void PlatformIO_Destroy(ImGuiPlatformIO* self);
-extern void DrawList_ChannelsSplit(ImDrawList* self,int count);
-extern void PushFont(ImFont* font);
-extern bool IsKeyReleased(ImGuiKey key);
-extern bool BeginTabItem(const char* label,bool* p_open,ImGuiTabItemFlags flags);
-extern float GetScrollMaxX();
-extern bool ColorPicker3(const char* label,float col[3],ImGuiColorEditFlags flags);
-extern bool IsAnyItemFocused();
-extern bool VSliderScalar(const char* label,const ImVec2 size,ImGuiDataType data_type,void* p_data,const void* p_min,const void* p_max,const char* format,ImGuiSliderFlags flags);
-extern float GetScrollY();
-extern ImDrawList* DrawList_CloneOutput(ImDrawList* self);
 extern void DrawListSplitter_Clear(ImDrawListSplitter* self);
-extern int TableGetColumnIndex();
-extern void Vec2ih_Destroy(ImVec2ih* self);
-extern void ColorConvertRGBtoHSV(float r,float g,float b,float* out_h,float* out_s,float* out_v);
-extern void EndChildFrame();
-extern float GetScrollMaxY();
-extern bool IsMouseReleased(ImGuiMouseButton button);
-extern void Font_AddGlyph(ImFont* self,const ImFontConfig* src_cfg,ImWchar c,float x0,float y0,float x1,float y1,float u0,float v0,float u1,float v1,float advance_x);
-extern bool IsItemClicked(ImGuiMouseButton mouse_button);
-extern bool VSliderFloat(const char* label,const ImVec2 size,float* v,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
-extern void IO_AddMouseButtonEvent(ImGuiIO* self,int button,bool down);
-extern ImFontAtlas* FontAtlas_ImFontAtlas();
-extern void IO_AddInputCharacterUTF16(ImGuiIO* self,ImWchar16 c);
-extern bool InputTextCallbackData_HasSelection(ImGuiInputTextCallbackData* self);
-extern void DrawList_PathFillConvex(ImDrawList* self,ImU32 col);
-extern void SetNextFrameWantCaptureMouse(bool want_capture_mouse);
-extern bool SliderInt3(const char* label,int v[3],int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
-extern void TextBuffer_Appendf(ImGuiTextBuffer* self,const char* fmt);
-extern bool BeginMainMenuBar();
-extern bool IsKeyDown(ImGuiKey key);
-extern void GetContentRegionMax(ImVec2 *pOut);
-extern void GetItemRectSize(ImVec2 *pOut);
-extern void Style_Destroy(ImGuiStyle* self);
-extern float GetTextLineHeight();
-extern void SetScrollHereX(float center_x_ratio);
-extern void TreePop();
-extern void SetScrollFromPosY_Float(float local_y,float center_y_ratio);
-extern void PopStyleVar(int count);
 extern void DrawListSplitter_ClearFreeMemory(ImDrawListSplitter* self);
-extern bool IsItemDeactivatedAfterEdit();
-extern ImGuiTableSortSpecs* TableGetSortSpecs();
-extern void TableSetBgColor(ImGuiTableBgTarget target,ImU32 color,int column_n);
-extern int FontAtlas_AddCustomRectFontGlyph(ImFontAtlas* self,ImFont* font,ImWchar id,int width,int height,float advance_x,const ImVec2 offset);
-extern void* MemAlloc(size_t size);
-extern void Unindent(float indent_w);
-extern ImFont* FontAtlas_AddFontFromMemoryCompressedTTF(ImFontAtlas* self,const void* compressed_font_data,int compressed_font_size,float size_pixels,const ImFontConfig* font_cfg,const ImWchar* glyph_ranges);
-extern void EndPopup();
-extern void Separator();
-extern void TextBuffer_Append(ImGuiTextBuffer* self,const char* str,const char* str_end);
+extern void DrawListSplitter_Split(ImDrawListSplitter* self,ImDrawList* draw_list,int count);
+extern void DrawListSplitter_Merge(ImDrawListSplitter* self,ImDrawList* draw_list);
 extern void DrawListSplitter_SetCurrentChannel(ImDrawListSplitter* self,ImDrawList* draw_list,int channel_idx);
-extern void LogToFile(int auto_open_depth,const char* filename);
-extern ImGuiPlatformIO* PlatformIO_ImGuiPlatformIO();
-extern void BeginGroup();
-extern ImGuiTableColumnSortSpecs* TableColumnSortSpecs_ImGuiTableColumnSortSpecs();
-extern void DrawList_AddBezierQuadratic(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,ImU32 col,float thickness,int num_segments);
-extern void LastItemData_Destroy(ImGuiLastItemData* self);
-extern bool InputInt2(const char* label,int v[2],ImGuiInputTextFlags flags);
-extern bool InputTextWithHint(const char* label,const char* hint,char* buf,size_t buf_size,ImGuiInputTextFlags flags,ImGuiInputTextCallback callback,void* user_data);
-extern bool RadioButton_Bool(const char* label,bool active);
-extern bool RadioButton_IntPtr(const char* label,int* v,int v_button);
-extern ImColor* Color_ImColor_Nil();
-extern ImColor* Color_ImColor_Float(float r,float g,float b,float a);
-extern ImColor* Color_ImColor_Vec4(const ImVec4 col);
-extern ImColor* Color_ImColor_Int(int r,int g,int b,int a);
-extern ImColor* Color_ImColor_U32(ImU32 rgba);
-extern bool InputDouble(const char* label,double* v,double step,double step_fast,const char* format,ImGuiInputTextFlags flags);
-extern bool IsAnyItemActive();
-extern void SetCurrentContext(ImGuiContext* ctx);
-extern bool Begin(const char* name,bool* p_open,ImGuiWindowFlags flags);
-extern ImGuiID GetWindowDockID();
-extern void SetWindowCollapsed_Bool(bool collapsed,ImGuiCond cond);
-extern void SetWindowCollapsed_Str(const char* name,bool collapsed,ImGuiCond cond);
-extern bool SliderFloat(const char* label,float* v,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
-extern bool BeginPopupContextWindow(const char* str_id,ImGuiPopupFlags popup_flags);
-extern const char* GetStyleColorName(ImGuiCol idx);
-extern bool Payload_IsDelivery(ImGuiPayload* self);
+extern ImDrawList* DrawList_ImDrawList(const ImDrawListSharedData* shared_data);
+extern void DrawList_Destroy(ImDrawList* self);
+extern void DrawList_PushClipRect(ImDrawList* self,const ImVec2 clip_rect_min,const ImVec2 clip_rect_max,bool intersect_with_current_clip_rect);
+extern void DrawList_PushClipRectFullScreen(ImDrawList* self);
+extern void DrawList_PopClipRect(ImDrawList* self);
+extern void DrawList_PushTextureID(ImDrawList* self,uintptr_t texture_id);
+extern void DrawList_PopTextureID(ImDrawList* self);
+extern void DrawList_GetClipRectMin(ImVec2 *pOut,ImDrawList* self);
 extern void DrawList_GetClipRectMax(ImVec2 *pOut,ImDrawList* self);
-extern void Image(ImTextureID user_texture_id,const ImVec2 size,const ImVec2 uv0,const ImVec2 uv1,const ImVec4 tint_col,const ImVec4 border_col);
-extern ImGuiContext* GetCurrentContext();
-extern void NextColumn();
-extern ImTextureID DrawCmd_GetTexID(ImDrawCmd* self);
-extern void TextFilter_Destroy(ImGuiTextFilter* self);
-extern void TableSetupScrollFreeze(int cols,int rows);
-extern void DrawList_AddImageRounded(ImDrawList* self,ImTextureID user_texture_id,const ImVec2 p_min,const ImVec2 p_max,const ImVec2 uv_min,const ImVec2 uv_max,ImU32 col,float rounding,ImDrawFlags flags);
-extern void GetWindowPos(ImVec2 *pOut);
-extern void StyleColorsClassic(ImGuiStyle* dst);
-extern bool BeginDragDropTarget();
-extern void SetNextWindowSizeConstraints(const ImVec2 size_min,const ImVec2 size_max,ImGuiSizeCallback custom_callback,void* custom_callback_data);
-extern ImU32 ColorConvertFloat4ToU32(const ImVec4 in);
+extern void DrawList_AddLine(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,ImU32 col,float thickness);
+extern void DrawList_AddRect(ImDrawList* self,const ImVec2 p_min,const ImVec2 p_max,ImU32 col,float rounding,ImDrawFlags flags,float thickness);
+extern void DrawList_AddRectFilled(ImDrawList* self,const ImVec2 p_min,const ImVec2 p_max,ImU32 col,float rounding,ImDrawFlags flags);
+extern void DrawList_AddRectFilledMultiColor(ImDrawList* self,const ImVec2 p_min,const ImVec2 p_max,ImU32 col_upr_left,ImU32 col_upr_right,ImU32 col_bot_right,ImU32 col_bot_left);
+extern void DrawList_AddQuad(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,const ImVec2 p4,ImU32 col,float thickness);
+extern void DrawList_AddQuadFilled(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,const ImVec2 p4,ImU32 col);
+extern void DrawList_AddTriangle(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,ImU32 col,float thickness);
 extern void DrawList_AddTriangleFilled(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,ImU32 col);
-extern void Viewport_GetWorkCenter(ImVec2 *pOut,ImGuiViewport* self);
-extern bool SliderInt(const char* label,int* v,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
-extern const char* TextBuffer_Begin(ImGuiTextBuffer* self);
-extern void ShowDebugLogWindow(bool* p_open);
-extern void DrawListSplitter_Split(ImDrawListSplitter* self,ImDrawList* draw_list,int count);
-extern void IO_AddKeyEvent(ImGuiIO* self,ImGuiKey key,bool down);
-extern const ImFontGlyph* Font_FindGlyphNoFallback(ImFont* self,ImWchar c);
-extern void SetNextWindowClass(const ImGuiWindowClass* window_class);
-extern void PlatformMonitor_Destroy(ImGuiPlatformMonitor* self);
-extern void TableHeadersRow();
-extern void EndMenu();
-extern ImFont* FontAtlas_AddFontDefault(ImFontAtlas* self,const ImFontConfig* font_cfg);
-extern ImDrawListSharedData* GetDrawListSharedData();
-extern void DrawList_GetClipRectMin(ImVec2 *pOut,ImDrawList* self);
-extern void DockContext_Destroy(ImGuiDockContext* self);
-extern ImGuiOnceUponAFrame* OnceUponAFrame_ImGuiOnceUponAFrame();
-extern void Vec1_Destroy(ImVec1* self);
-extern bool ShowStyleSelector(const char* label);
-extern const ImWchar* FontAtlas_GetGlyphRangesVietnamese(ImFontAtlas* self);
-extern void ComboPreviewData_Destroy(ImGuiComboPreviewData* self);
-extern void InputEvent_Destroy(ImGuiInputEvent* self);
-extern void EndMainMenuBar();
-extern bool InputInt(const char* label,int* v,int step,int step_fast,ImGuiInputTextFlags flags);
-extern void NewLine();
-extern ImDrawData* DrawData_ImDrawData();
-extern void SetNextWindowContentSize(const ImVec2 size);
-extern void LogButtons();
-extern void Viewport_Destroy(ImGuiViewport* self);
-extern bool DragIntRange2(const char* label,int* v_current_min,int* v_current_max,float v_speed,int v_min,int v_max,const char* format,const char* format_max,ImGuiSliderFlags flags);
-extern void ShowUserGuide();
-extern bool SliderFloat3(const char* label,float v[3],float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
-extern void InputTextCallbackData_Destroy(ImGuiInputTextCallbackData* self);
-extern const char* GetKeyName(ImGuiKey key);
-extern void UpdatePlatformWindows();
-extern void DrawList_PopTextureID(ImDrawList* self);
-extern bool FontAtlas_IsBuilt(ImFontAtlas* self);
-extern ImGuiViewport* Viewport_ImGuiViewport();
-extern void Font_ClearOutputData(ImFont* self);
-extern bool TextBuffer_Empty(ImGuiTextBuffer* self);
-extern float GetTextLineHeightWithSpacing();
-extern void DrawCmd_Destroy(ImDrawCmd* self);
-extern ImGuiPlatformMonitor* PlatformMonitor_ImGuiPlatformMonitor();
-extern bool InputText(const char* label,char* buf,size_t buf_size,ImGuiInputTextFlags flags,ImGuiInputTextCallback callback,void* user_data);
-extern ImFont* Font_ImFont();
-extern void ShowDemoWindow(bool* p_open);
-extern void TableHeader(const char* label);
-extern void PopID();
-extern void MemFree(void* ptr);
-extern void TextDisabled(const char* fmt);
-extern bool BeginPopup(const char* str_id,ImGuiWindowFlags flags);
-extern float GetColumnWidth(int column_index);
+extern void DrawList_AddCircle(ImDrawList* self,const ImVec2 center,float radius,ImU32 col,int num_segments,float thickness);
+extern void DrawList_AddCircleFilled(ImDrawList* self,const ImVec2 center,float radius,ImU32 col,int num_segments);
+extern void DrawList_AddNgon(ImDrawList* self,const ImVec2 center,float radius,ImU32 col,int num_segments,float thickness);
+extern void DrawList_AddNgonFilled(ImDrawList* self,const ImVec2 center,float radius,ImU32 col,int num_segments);
+extern void DrawList_AddText_Vec2(ImDrawList* self,const ImVec2 pos,ImU32 col,const char* text_begin);
+extern void DrawList_AddText_FontPtr(ImDrawList* self,const ImFont* font,float font_size,const ImVec2 pos,ImU32 col,const char* text_begin,float wrap_width,const ImVec4* cpu_fine_clip_rect);
+extern void DrawList_AddPolyline(ImDrawList* self,const ImVec2* points,int num_points,ImU32 col,ImDrawFlags flags,float thickness);
+extern void DrawList_AddConvexPolyFilled(ImDrawList* self,const ImVec2* points,int num_points,ImU32 col);
+extern void DrawList_AddBezierCubic(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,const ImVec2 p4,ImU32 col,float thickness,int num_segments);
+extern void DrawList_AddBezierQuadratic(ImDrawList* self,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,ImU32 col,float thickness,int num_segments);
+extern void DrawList_AddImage(ImDrawList* self,uintptr_t user_texture_id,const ImVec2 p_min,const ImVec2 p_max,const ImVec2 uv_min,const ImVec2 uv_max,ImU32 col);
+extern void DrawList_AddImageQuad(ImDrawList* self,uintptr_t user_texture_id,const ImVec2 p1,const ImVec2 p2,const ImVec2 p3,const ImVec2 p4,const ImVec2 uv1,const ImVec2 uv2,const ImVec2 uv3,const ImVec2 uv4,ImU32 col);
+extern void DrawList_AddImageRounded(ImDrawList* self,uintptr_t user_texture_id,const ImVec2 p_min,const ImVec2 p_max,const ImVec2 uv_min,const ImVec2 uv_max,ImU32 col,float rounding,ImDrawFlags flags);
+extern void DrawList_PathClear(ImDrawList* self);
+extern void DrawList_PathLineTo(ImDrawList* self,const ImVec2 pos);
+extern void DrawList_PathLineToMergeDuplicate(ImDrawList* self,const ImVec2 pos);
+extern void DrawList_PathFillConvex(ImDrawList* self,ImU32 col);
+extern void DrawList_PathStroke(ImDrawList* self,ImU32 col,ImDrawFlags flags,float thickness);
+extern void DrawList_PathArcTo(ImDrawList* self,const ImVec2 center,float radius,float a_min,float a_max,int num_segments);
+extern void DrawList_PathArcToFast(ImDrawList* self,const ImVec2 center,float radius,int a_min_of_12,int a_max_of_12);
 extern void DrawList_PathBezierCubicCurveTo(ImDrawList* self,const ImVec2 p2,const ImVec2 p3,const ImVec2 p4,int num_segments);
-extern void Font_RenderChar(ImFont* self,ImDrawList* draw_list,float size,const ImVec2 pos,ImU32 col,ImWchar c);
-extern void TableInstanceData_Destroy(ImGuiTableInstanceData* self);
-extern void SetNextItemWidth(float item_width);
-extern const ImVec4* GetStyleColorVec4(ImGuiCol idx);
-extern bool DragFloat4(const char* label,float v[4],float v_speed,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
-extern const char* Font_GetDebugName(ImFont* self);
-extern void SetColorEditOptions(ImGuiColorEditFlags flags);
-extern void Font_SetGlyphVisible(ImFont* self,ImWchar c,bool visible);
-extern void DebugTextEncoding(const char* text);
-extern ImDrawList* GetForegroundDrawList_Nil();
-extern ImDrawList* GetForegroundDrawList_ViewportPtr(ImGuiViewport* viewport);
-extern void PopStyleColor(int count);
-extern void SetNextWindowBgAlpha(float alpha);
-extern void SetScrollY_Float(float scroll_y);
-extern void ShowFontSelector(const char* label);
-extern bool BeginChild_Str(const char* str_id,const ImVec2 size,bool border,ImGuiWindowFlags flags);
-extern bool BeginChild_ID(ImGuiID id,const ImVec2 size,bool border,ImGuiWindowFlags flags);
-extern void LoadIniSettingsFromMemory(const char* ini_data,size_t ini_size);
-extern void DrawList_AddImage(ImDrawList* self,ImTextureID user_texture_id,const ImVec2 p_min,const ImVec2 p_max,const ImVec2 uv_min,const ImVec2 uv_max,ImU32 col);
-extern void Payload_Clear(ImGuiPayload* self);
-extern void DestroyContext(ImGuiContext* ctx);
-extern void IO_AddMousePosEvent(ImGuiIO* self,float x,float y);
-extern void EndTable();
-extern float GetFrameHeight();
+extern void DrawList_PathBezierQuadraticCurveTo(ImDrawList* self,const ImVec2 p2,const ImVec2 p3,int num_segments);
+extern void DrawList_PathRect(ImDrawList* self,const ImVec2 rect_min,const ImVec2 rect_max,float rounding,ImDrawFlags flags);
+extern void DrawList_AddCallback(ImDrawList* self,uintptr_t callback);
+extern void DrawList_AddDrawCmd(ImDrawList* self);
+extern ImDrawList* DrawList_CloneOutput(ImDrawList* self);
+extern void DrawList_ChannelsSplit(ImDrawList* self,int count);
+extern void DrawList_ChannelsMerge(ImDrawList* self);
+extern void DrawList_ChannelsSetCurrent(ImDrawList* self,int n);
+extern void DrawList_PrimReserve(ImDrawList* self,int idx_count,int vtx_count);
 extern void DrawList_PrimUnreserve(ImDrawList* self,int idx_count,int vtx_count);
-extern void AlignTextToFramePadding();
-extern int GetMouseClickedCount(ImGuiMouseButton button);
+extern void DrawList_PrimRect(ImDrawList* self,const ImVec2 a,const ImVec2 b,ImU32 col);
+extern void DrawList_PrimRectUV(ImDrawList* self,const ImVec2 a,const ImVec2 b,const ImVec2 uv_a,const ImVec2 uv_b,ImU32 col);
+extern void DrawList_PrimQuadUV(ImDrawList* self,const ImVec2 a,const ImVec2 b,const ImVec2 c,const ImVec2 d,const ImVec2 uv_a,const ImVec2 uv_b,const ImVec2 uv_c,const ImVec2 uv_d,ImU32 col);
+extern void DrawList_PrimWriteVtx(ImDrawList* self,const ImVec2 pos,const ImVec2 uv,ImU32 col);
 extern void DrawList_PrimWriteIdx(ImDrawList* self,ImDrawIdx idx);
-extern void NewFrame();
-extern void PushID_Str(const char* str_id);
-extern void PushID_StrStr(const char* str_id_begin,const char* str_id_end);
-extern void PushID_Ptr(const void* ptr_id);
-extern void PushID_Int(int int_id);
-extern float GetFontSize();
-extern bool IsMouseDown(ImGuiMouseButton button);
-extern bool DragFloatRange2(const char* label,float* v_current_min,float* v_current_max,float v_speed,float v_min,float v_max,const char* format,const char* format_max,ImGuiSliderFlags flags);
-extern void PushStyleVar_Float(ImGuiStyleVar idx,float val);
-extern void PushStyleVar_Vec2(ImGuiStyleVar idx,const ImVec2 val);
-extern void TableColumnSortSpecs_Destroy(ImGuiTableColumnSortSpecs* self);
-extern int GetFrameCount();
-extern void LogFinish();
-extern bool DragInt2(const char* label,int v[2],float v_speed,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
-extern void IO_AddInputCharactersUTF8(ImGuiIO* self,const char* str);
-extern float GetFrameHeightWithSpacing();
-extern void DrawList_PathArcToFast(ImDrawList* self,const ImVec2 center,float radius,int a_min_of_12,int a_max_of_12);
-extern bool FontGlyphRangesBuilder_GetBit(ImFontGlyphRangesBuilder* self,size_t n);
-extern bool ListBox_Str_arr(const char* label,int* current_item,const char* const items[],int items_count,int height_in_items);
-extern bool ListBox_FnBoolPtr(const char* label,int* current_item,bool(*items_getter)(void* data,int idx,const char** out_text),void* data,int items_count,int height_in_items);
-extern void Font_GrowIndex(ImFont* self,int new_size);
-extern void IO_Destroy(ImGuiIO* self);
-extern void PushStyleColor_U32(ImGuiCol idx,ImU32 col);
-extern void PushStyleColor_Vec4(ImGuiCol idx,const ImVec4 col);
-extern void SetNextFrameWantCaptureKeyboard(bool want_capture_keyboard);
-extern bool SliderFloat2(const char* label,float v[2],float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
-extern float GetWindowHeight();
-extern bool InvisibleButton(const char* str_id,const ImVec2 size,ImGuiButtonFlags flags);
-extern void PushClipRect(const ImVec2 clip_rect_min,const ImVec2 clip_rect_max,bool intersect_with_current_clip_rect);
-extern bool FontAtlas_Build(ImFontAtlas* self);
-extern void StyleMod_Destroy(ImGuiStyleMod* self);
-extern void EndTabBar();
-extern void SetMouseCursor(ImGuiMouseCursor cursor_type);
-extern ImDrawList* DrawList_ImDrawList(const ImDrawListSharedData* shared_data);
 extern void DrawList_PrimVtx(ImDrawList* self,const ImVec2 pos,const ImVec2 uv,ImU32 col);
-extern bool TreeNodeEx_Str(const char* label,ImGuiTreeNodeFlags flags);
-extern bool TreeNodeEx_StrStr(const char* str_id,ImGuiTreeNodeFlags flags,const char* fmt);
-extern bool TreeNodeEx_Ptr(const void* ptr_id,ImGuiTreeNodeFlags flags,const char* fmt);
-extern void PtrOrIndex_Destroy(ImGuiPtrOrIndex* self);
-extern bool BeginTabBar(const char* str_id,ImGuiTabBarFlags flags);
-extern bool IsItemFocused();
-extern void ShowMetricsWindow(bool* p_open);
-extern void DrawList_PathLineToMergeDuplicate(ImDrawList* self,const ImVec2 pos);
-extern void OldColumns_Destroy(ImGuiOldColumns* self);
+extern ImDrawData* DrawData_ImDrawData();
+extern void DrawData_Clear(ImDrawData* self);
+extern void DrawData_DeIndexAllBuffers(ImDrawData* self);
+extern void DrawData_ScaleClipRects(ImDrawData* self,const ImVec2 fb_scale);
+extern ImFontConfig* FontConfig_ImFontConfig();
 extern ImFontGlyphRangesBuilder* FontGlyphRangesBuilder_ImFontGlyphRangesBuilder();
-extern void OpenPopupOnItemClick(const char* str_id,ImGuiPopupFlags popup_flags);
-extern void SetItemAllowOverlap();
-extern void DrawList_PushTextureID(ImDrawList* self,ImTextureID texture_id);
+extern void FontGlyphRangesBuilder_Clear(ImFontGlyphRangesBuilder* self);
+extern bool FontGlyphRangesBuilder_GetBit(ImFontGlyphRangesBuilder* self,size_t n);
+extern void FontGlyphRangesBuilder_SetBit(ImFontGlyphRangesBuilder* self,size_t n);
 extern void FontGlyphRangesBuilder_AddChar(ImFontGlyphRangesBuilder* self,ImWchar c);
-extern int GetKeyIndex(ImGuiKey key);
+extern void FontGlyphRangesBuilder_AddText(ImFontGlyphRangesBuilder* self,const char* text);
+extern void FontGlyphRangesBuilder_AddRanges(ImFontGlyphRangesBuilder* self,const ImWchar* ranges);
+extern void FontGlyphRangesBuilder_BuildRanges(ImFontGlyphRangesBuilder* self,ImVector_ImWchar* out_ranges);
+extern ImFontAtlasCustomRect* FontAtlasCustomRect_ImFontAtlasCustomRect();
+extern bool FontAtlasCustomRect_IsPacked(ImFontAtlasCustomRect* self);
+extern ImFontAtlas* FontAtlas_ImFontAtlas();
+extern void FontAtlas_Destroy(ImFontAtlas* self);
+extern ImFont* FontAtlas_AddFont(ImFontAtlas* self,const ImFontConfig* font_cfg);
+extern ImFont* FontAtlas_AddFontDefault(ImFontAtlas* self,const ImFontConfig* font_cfg);
+extern ImFont* FontAtlas_AddFontFromFileTTF(ImFontAtlas* self,const char* filename,float size_pixels,const ImFontConfig* font_cfg,const ImWchar* glyph_ranges);
+extern ImFont* FontAtlas_AddFontFromMemoryTTF(ImFontAtlas* self,void* font_data,int font_size,float size_pixels,const ImFontConfig* font_cfg,const ImWchar* glyph_ranges);
+extern ImFont* FontAtlas_AddFontFromMemoryCompressedTTF(ImFontAtlas* self,const void* compressed_font_data,int compressed_font_size,float size_pixels,const ImFontConfig* font_cfg,const ImWchar* glyph_ranges);
+extern ImFont* FontAtlas_AddFontFromMemoryCompressedBase85TTF(ImFontAtlas* self,const char* compressed_font_data_base85,float size_pixels,const ImFontConfig* font_cfg,const ImWchar* glyph_ranges);
+extern void FontAtlas_ClearInputData(ImFontAtlas* self);
+extern void FontAtlas_ClearTexData(ImFontAtlas* self);
+extern void FontAtlas_ClearFonts(ImFontAtlas* self);
+extern void FontAtlas_Clear(ImFontAtlas* self);
+extern bool FontAtlas_Build(ImFontAtlas* self);
+extern void FontAtlas_GetTexDataAsAlpha8(ImFontAtlas* self,unsigned char** out_pixels,int* out_width,int* out_height,int* out_bytes_per_pixel);
+extern void FontAtlas_GetTexDataAsRGBA32(ImFontAtlas* self,unsigned char** out_pixels,int* out_width,int* out_height,int* out_bytes_per_pixel);
+extern bool FontAtlas_IsBuilt(ImFontAtlas* self);
+extern void FontAtlas_SetTexID(ImFontAtlas* self,uintptr_t id);
+extern const ImWchar* FontAtlas_GetGlyphRangesDefault(ImFontAtlas* self);
 extern const ImWchar* FontAtlas_GetGlyphRangesKorean(ImFontAtlas* self);
-extern void ListClipperData_Destroy(ImGuiListClipperData* self);
-extern bool IsWindowAppearing();
-extern ImFontConfig* FontConfig_ImFontConfig();
-extern bool IsMouseDoubleClicked(ImGuiMouseButton button);
-extern bool IsWindowDocked();
-extern bool InputFloat3(const char* label,float v[3],const char* format,ImGuiInputTextFlags flags);
-extern void BeginTooltip();
-extern ImGuiID DockSpaceOverViewport(const ImGuiViewport* viewport,ImGuiDockNodeFlags flags,const ImGuiWindowClass* window_class);
-extern bool DragFloat2(const char* label,float v[2],float v_speed,float v_min,float v_max,const char* format,ImGuiSliderFlags flags);
-extern ImGuiMouseCursor GetMouseCursor();
-extern void DrawList_AddCircle(ImDrawList* self,const ImVec2 center,float radius,ImU32 col,int num_segments,float thickness);
+extern const ImWchar* FontAtlas_GetGlyphRangesJapanese(ImFontAtlas* self);
+extern const ImWchar* FontAtlas_GetGlyphRangesChineseFull(ImFontAtlas* self);
+extern const ImWchar* FontAtlas_GetGlyphRangesChineseSimplifiedCommon(ImFontAtlas* self);
+extern const ImWchar* FontAtlas_GetGlyphRangesCyrillic(ImFontAtlas* self);
+extern const ImWchar* FontAtlas_GetGlyphRangesThai(ImFontAtlas* self);
+extern const ImWchar* FontAtlas_GetGlyphRangesVietnamese(ImFontAtlas* self);
+extern int FontAtlas_AddCustomRectRegular(ImFontAtlas* self,int width,int height);
+extern int FontAtlas_AddCustomRectFontGlyph(ImFontAtlas* self,ImFont* font,ImWchar id,int width,int height,float advance_x,const ImVec2 offset);
+extern ImFontAtlasCustomRect* FontAtlas_GetCustomRectByIndex(ImFontAtlas* self,int index);
+extern void FontAtlas_CalcCustomRectUV(ImFontAtlas* self,const ImFontAtlasCustomRect* rect,ImVec2* out_uv_min,ImVec2* out_uv_max);
 extern bool FontAtlas_GetMouseCursorTexData(ImFontAtlas* self,ImGuiMouseCursor cursor,ImVec2* out_offset,ImVec2* out_size,ImVec2 out_uv_border[2],ImVec2 out_uv_fill[2]);
-extern bool ImageButton(const char* str_id,ImTextureID user_texture_id,const ImVec2 size,const ImVec2 uv0,const ImVec2 uv1,const ImVec4 bg_col,const ImVec4 tint_col);
-extern void LabelText(const char* label,const char* fmt);
-extern void SetNextWindowPos(const ImVec2 pos,ImGuiCond cond,const ImVec2 pivot);
-extern bool CollapsingHeader_TreeNodeFlags(const char* label,ImGuiTreeNodeFlags flags);
-extern bool CollapsingHeader_BoolPtr(const char* label,bool* p_visible,ImGuiTreeNodeFlags flags);
-extern float CalcItemWidth();
-extern void EndTabItem();
-extern const char* GetVersion();
-extern bool IsWindowHovered(ImGuiHoveredFlags flags);
-extern bool InputInt3(const char* label,int v[3],ImGuiInputTextFlags flags);
-extern void FontAtlas_SetTexID(ImFontAtlas* self,ImTextureID id);
-extern void CloseCurrentPopup();
-extern bool BeginListBox(const char* label,const ImVec2 size);
-extern bool DebugCheckVersionAndDataLayout(const char* version_str,size_t sz_io,size_t sz_style,size_t sz_vec2,size_t sz_vec4,size_t sz_drawvert,size_t sz_drawidx);
+extern ImFont* Font_ImFont();
+extern void Font_Destroy(ImFont* self);
+extern const ImFontGlyph* Font_FindGlyph(ImFont* self,ImWchar c);
+extern const ImFontGlyph* Font_FindGlyphNoFallback(ImFont* self,ImWchar c);
+extern float Font_GetCharAdvance(ImFont* self,ImWchar c);
+extern bool Font_IsLoaded(ImFont* self);
+extern const char* Font_GetDebugName(ImFont* self);
+extern void Font_CalcTextSizeA(ImVec2 *pOut,ImFont* self,float size,float max_width,float wrap_width,const char* text_begin,const char** remaining);
+extern const char* Font_CalcWordWrapPositionA(ImFont* self,float scale,const char* text,float wrap_width);
+extern void Font_RenderChar(ImFont* self,ImDrawList* draw_list,float size,const ImVec2 pos,ImU32 col,ImWchar c);
+extern void Font_RenderText(ImFont* self,ImDrawList* draw_list,float size,const ImVec2 pos,ImU32 col,const ImVec4 clip_rect,const char* text_begin,float wrap_width,bool cpu_fine_clip);
+extern void Font_BuildLookupTable(ImFont* self);
+extern void Font_ClearOutputData(ImFont* self);
+extern void Font_GrowIndex(ImFont* self,int new_size);
+extern void Font_AddGlyph(ImFont* self,const ImFontConfig* src_cfg,ImWchar c,float x0,float y0,float x1,float y1,float u0,float v0,float u1,float v1,float advance_x);
+extern void Font_AddRemapChar(ImFont* self,ImWchar dst,ImWchar src,bool overwrite_dst);
+extern void Font_SetGlyphVisible(ImFont* self,ImWchar c,bool visible);
+extern bool Font_IsGlyphRangeUnused(ImFont* self,unsigned int c_begin,unsigned int c_last);
+extern ImGuiViewport* Viewport_ImGuiViewport();
+extern void Viewport_Destroy(ImGuiViewport* self);
+extern void Viewport_GetCenter(ImVec2 *pOut,ImGuiViewport* self);
+extern void Viewport_GetWorkCenter(ImVec2 *pOut,ImGuiViewport* self);
+extern ImGuiPlatformIO* PlatformIO_ImGuiPlatformIO();
+extern ImGuiPlatformMonitor* PlatformMonitor_ImGuiPlatformMonitor();
+extern ImGuiPlatformImeData* PlatformImeData_ImGuiPlatformImeData();
+extern int GetKeyIndex(ImGuiKey key);
+extern void Color_Destroy(ImColor* self);
+extern void DrawCmd_Destroy(ImDrawCmd* self);
 extern void DrawData_Destroy(ImDrawData* self);
-extern void StackTool_Destroy(ImGuiStackTool* self);
-extern bool InputScalar(const char* label,ImGuiDataType data_type,void* p_data,const void* p_step,const void* p_step_fast,const char* format,ImGuiInputTextFlags flags);
-extern void MetricsConfig_Destroy(ImGuiMetricsConfig* self);
-extern bool BeginTable(const char* str_id,int column,ImGuiTableFlags flags,const ImVec2 outer_size,float inner_width);
-extern bool DragInt3(const char* label,int v[3],float v_speed,int v_min,int v_max,const char* format,ImGuiSliderFlags flags);
-extern void GetDrawCursorStartPos(ImVec2 *pOut);
-extern void DrawData_Clear(ImDrawData* self);
-extern void FontAtlas_ClearInputData(ImFontAtlas* self);
-extern void EndDragDropSource();
-extern void GetWindowSize(ImVec2 *pOut);
-extern bool IsPopupOpen_Str(const char* str_id,ImGuiPopupFlags flags);
-extern void Value_Bool(const char* prefix,bool b);
-extern void Value_Int(const char* prefix,int v);
-extern void Value_Uint(const char* prefix,unsigned int v);
-extern void Value_Float(const char* prefix,float v,const char* float_format);
 extern void DrawListSharedData_Destroy(ImDrawListSharedData* self);
+extern void FontAtlasCustomRect_Destroy(ImFontAtlasCustomRect* self);
+extern void FontConfig_Destroy(ImFontConfig* self);
+extern void FontGlyphRangesBuilder_Destroy(ImFontGlyphRangesBuilder* self);
+extern void ComboPreviewData_Destroy(ImGuiComboPreviewData* self);
+extern void ContextHook_Destroy(ImGuiContextHook* self);
+extern void Context_Destroy(ImGuiContext* self);
+extern void DockContext_Destroy(ImGuiDockContext* self);
+extern void IO_Destroy(ImGuiIO* self);
+extern void InputEvent_Destroy(ImGuiInputEvent* self);
+extern void InputTextCallbackData_Destroy(ImGuiInputTextCallbackData* self);
+extern void InputTextState_Destroy(ImGuiInputTextState* self);
+extern void LastItemData_Destroy(ImGuiLastItemData* self);
+extern void ListClipperData_Destroy(ImGuiListClipperData* self);
+extern void MenuColumns_Destroy(ImGuiMenuColumns* self);
+extern void MetricsConfig_Destroy(ImGuiMetricsConfig* self);
+extern void NavItemData_Destroy(ImGuiNavItemData* self);
+extern void NextItemData_Destroy(ImGuiNextItemData* self);
+extern void NextWindowData_Destroy(ImGuiNextWindowData* self);
+extern void OldColumnData_Destroy(ImGuiOldColumnData* self);
+extern void OldColumns_Destroy(ImGuiOldColumns* self);
+extern void OnceUponAFrame_Destroy(ImGuiOnceUponAFrame* self);
+extern void Payload_Destroy(ImGuiPayload* self);
+extern void PlatformIO_Destroy(ImGuiPlatformIO* self);
+extern void PlatformImeData_Destroy(ImGuiPlatformImeData* self);
+extern void PlatformMonitor_Destroy(ImGuiPlatformMonitor* self);
+extern void PopupData_Destroy(ImGuiPopupData* self);
+extern void PtrOrIndex_Destroy(ImGuiPtrOrIndex* self);
+extern void SettingsHandler_Destroy(ImGuiSettingsHandler* self);
+extern void StackLevelInfo_Destroy(ImGuiStackLevelInfo* self);
+extern void StackSizes_Destroy(ImGuiStackSizes* self);
+extern void StackTool_Destroy(ImGuiStackTool* self);
+extern void StyleMod_Destroy(ImGuiStyleMod* self);
+extern void Style_Destroy(ImGuiStyle* self);
+extern void TabBar_Destroy(ImGuiTabBar* self);
+extern void TabItem_Destroy(ImGuiTabItem* self);
+extern void TableColumnSettings_Destroy(ImGuiTableColumnSettings* self);
+extern void TableColumnSortSpecs_Destroy(ImGuiTableColumnSortSpecs* self);
+extern void TableColumn_Destroy(ImGuiTableColumn* self);
+extern void TableInstanceData_Destroy(ImGuiTableInstanceData* self);
+extern void TableSettings_Destroy(ImGuiTableSettings* self);
+extern void TableSortSpecs_Destroy(ImGuiTableSortSpecs* self);
+extern void TableTempData_Destroy(ImGuiTableTempData* self);
+extern void TextBuffer_Destroy(ImGuiTextBuffer* self);
+extern void TextFilter_Destroy(ImGuiTextFilter* self);
+extern void WindowClass_Destroy(ImGuiWindowClass* self);
+extern void WindowSettings_Destroy(ImGuiWindowSettings* self);
+extern void Rect_Destroy(ImRect* self);
+extern void Vec1_Destroy(ImVec1* self);
+extern void Vec2_Destroy(ImVec2* self);
+extern void Vec2ih_Destroy(ImVec2ih* self);
+extern void Vec4_Destroy(ImVec4* self);
 
 #ifdef __cplusplus
 }
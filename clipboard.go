@@ -0,0 +1,101 @@
+package cimgui
+
+// #include <stdlib.h>
+// #include "util.h"
+import "C"
+import (
+	"runtime/cgo"
+	"unsafe"
+)
+
+type clipboardHandler struct {
+	get    func() string
+	set    func(text string)
+	text   *C.char
+	handle cgo.Handle
+
+	// the platform backend functions, restored when the handler is removed
+	prevGet      *[0]byte
+	prevSet      *[0]byte
+	prevUserData unsafe.Pointer
+}
+
+var clipboardHandlers = map[ImGuiIO]*clipboardHandler{}
+
+// SetClipboardHandler replaces the clipboard of the platform backend with
+// get and set, e.g. for headless tests, remote sessions or sandboxes.
+// Either may be nil to read an empty clipboard or drop copied text, and both
+// nil restore the clipboard of the platform backend.
+func (io ImGuiIO) SetClipboardHandler(get func() string, set func(text string)) {
+	c := io.handle()
+
+	if h, ok := clipboardHandlers[io]; ok {
+		c.GetClipboardTextFn = h.prevGet
+		c.SetClipboardTextFn = h.prevSet
+		c.ClipboardUserData = h.prevUserData
+		h.release()
+		delete(clipboardHandlers, io)
+	}
+
+	if get == nil && set == nil {
+		return
+	}
+
+	h := &clipboardHandler{
+		get:          get,
+		set:          set,
+		prevGet:      c.GetClipboardTextFn,
+		prevSet:      c.SetClipboardTextFn,
+		prevUserData: c.ClipboardUserData,
+	}
+	h.handle = cgo.NewHandle(h)
+	clipboardHandlers[io] = h
+
+	C.ImGuiIO_SetGoClipboardHandler(c, C.uintptr_t(h.handle))
+}
+
+// useBackendClipboard routes the clipboard of the current context to the
+// backend of w, unless a handler was set with SetClipboardHandler.
+func (w *Window) useBackendClipboard() {
+	io := GetIO()
+	if _, ok := clipboardHandlers[io]; !ok {
+		io.SetClipboardHandler(w.backend.ClipboardText, w.backend.SetClipboardText)
+	}
+}
+
+// releaseClipboardHandler frees the handler of a destroyed context.
+func releaseClipboardHandler(io ImGuiIO) {
+	if h, ok := clipboardHandlers[io]; ok {
+		h.release()
+		delete(clipboardHandlers, io)
+	}
+}
+
+func (h *clipboardHandler) release() {
+	h.handle.Delete()
+	C.free(unsafe.Pointer(h.text))
+}
+
+//export goClipboardGet
+func goClipboardGet(handle C.uintptr_t) *C.char {
+	h := cgo.Handle(handle).Value().(*clipboardHandler)
+
+	text := ""
+	if h.get != nil {
+		text = h.get()
+	}
+
+	// dear imgui reads the text before asking for it again
+	C.free(unsafe.Pointer(h.text))
+	h.text = C.CString(text)
+
+	return h.text
+}
+
+//export goClipboardSet
+func goClipboardSet(handle C.uintptr_t, text *C.char) {
+	h := cgo.Handle(handle).Value().(*clipboardHandler)
+	if h.set != nil {
+		h.set(C.GoString(text))
+	}
+}
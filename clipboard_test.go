@@ -0,0 +1,51 @@
+package cimgui
+
+import "testing"
+
+func TestSetClipboardHandler(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(64, 64))
+	defer window.Close()
+
+	clipboard := "pasted"
+	GetIO().SetClipboardHandler(func() string { return clipboard }, func(text string) { clipboard = text })
+
+	if got := GetClipboardText(); got != "pasted" {
+		t.Errorf("expect %q got %q", "pasted", got)
+	}
+
+	SetClipboardText("copied")
+	if clipboard != "copied" {
+		t.Errorf("expect %q to be copied got %q", "copied", clipboard)
+	}
+
+	GetIO().SetClipboardHandler(nil, nil)
+	SetClipboardText("restored")
+	if clipboard != "copied" {
+		t.Errorf("expect the handler to be removed got %q", clipboard)
+	}
+	if got := GetClipboardText(); got != "restored" {
+		t.Errorf("expect the default clipboard %q got %q", "restored", got)
+	}
+}
+
+func TestWindowBackendClipboard(t *testing.T) {
+	backend := NewHeadlessBackend(64, 64)
+	window := NewWindow(backend)
+	defer window.Close()
+
+	backend.SetClipboardText("from backend")
+
+	var got string
+	window.SetLoop(func() {
+		got = GetClipboardText()
+		SetClipboardText("from imgui")
+	})
+	window.Step()
+
+	if got != "from backend" {
+		t.Errorf("expect %q got %q", "from backend", got)
+	}
+	if text := backend.ClipboardText(); text != "from imgui" {
+		t.Errorf("expect %q to be copied to the backend got %q", "from imgui", text)
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CallbackDef describes a C callback argument. The C wrapper takes a
+// cgo.Handle instead of the callback and the void* user data following it,
+// and passes a trampoline calling back into Go with the handle as user data.
+type CallbackDef struct {
+	// GoType is the Go func type of the argument, declared in callbacks.go
+	GoType string
+	// GoWrapper converts the Go func into a C.uintptr_t handle
+	GoWrapper string
+	// Frame is set when dear imgui keeps the callback after the call returns,
+	// so the handle is released with the frame instead of by the caller
+	Frame bool
+	// Trampoline is the C function passed in place of the callback
+	Trampoline string
+	// TrampolineDef defines Trampoline and the Go function it calls
+	TrampolineDef string
+}
+
+// ImGuiInputTextCallback isn't listed: the InputText functions also need
+// their buffer to grow, they are written by hand in input_text.go.
+// ImGuiMemAllocFunc and ImGuiMemFreeFunc aren't either: SetAllocatorFunctions
+// is skipped with the other Allocator functions in gencpp.go. dear imgui calls
+// the allocator for every allocation of every context, until the last one is
+// freed, so its handle could never be released, and the allocator would have
+// to return C memory anyway.
+var callbackDefs = map[string]CallbackDef{
+	"ImGuiSizeCallback": {
+		GoType:     "ImGuiSizeCallback",
+		GoWrapper:  "retainSizeCallback",
+		Frame:      true,
+		Trampoline: "SizeCallbackTrampoline",
+		TrampolineDef: `extern "C" void goSizeCallback(uintptr_t handle, ImGuiSizeCallbackData* data);
+static void SizeCallbackTrampoline(ImGuiSizeCallbackData* data) { goSizeCallback((uintptr_t)data->UserData, data); }
+`,
+	},
+	"ImDrawCallback": {
+		GoType:     "ImDrawCallback",
+		GoWrapper:  "retainDrawCallback",
+		Frame:      true,
+		Trampoline: "DrawCallbackTrampoline",
+		TrampolineDef: `extern "C" void goDrawCallback(uintptr_t handle, ImDrawList* parent_list, ImDrawCmd* cmd);
+static void DrawCallbackTrampoline(const ImDrawList* parent_list, const ImDrawCmd* cmd) { goDrawCallback((uintptr_t)cmd->UserCallbackData, (ImDrawList*)parent_list, (ImDrawCmd*)cmd); }
+`,
+	},
+	"bool(*)(void* data,int idx,const char** out_text)": {
+		GoType:     "ItemsGetter",
+		GoWrapper:  "wrapItemsGetter",
+		Trampoline: "ItemsGetterTrampoline",
+		TrampolineDef: `extern "C" bool goItemsGetter(uintptr_t handle, int idx, const char** out_text);
+static bool ItemsGetterTrampoline(void* data, int idx, const char** out_text) { return goItemsGetter((uintptr_t)data, idx, out_text); }
+`,
+	},
+	"float(*)(void* data,int idx)": {
+		GoType:     "ValuesGetter",
+		GoWrapper:  "wrapValuesGetter",
+		Trampoline: "ValuesGetterTrampoline",
+		TrampolineDef: `extern "C" float goValuesGetter(uintptr_t handle, int idx);
+static float ValuesGetterTrampoline(void* data, int idx) { return goValuesGetter((uintptr_t)data, idx); }
+`,
+	},
+}
+
+// callbackArg returns the callback of args[i], if it is followed by its
+// user data, the void* argument the C wrapper passes the handle as.
+func callbackArg(args []ArgDef, i int) (CallbackDef, bool) {
+	cb, ok := callbackDefs[args[i].Type]
+	if !ok || i+1 >= len(args) || args[i+1].Type != "void*" {
+		return CallbackDef{}, false
+	}
+
+	return cb, true
+}
+
+// wrapperArgsDecl declares the arguments of a C wrapper, its callbacks
+// taking a uintptr_t handle and its texture IDs a uintptr_t, see imTextureIDW.
+func wrapperArgsDecl(args []ArgDef) string {
+	var decl []string
+	for _, a := range args {
+		switch {
+		case callbackDefs[a.Type].Trampoline != "", a.Type == "ImTextureID":
+			decl = append(decl, fmt.Sprintf("uintptr_t %s", a.Name))
+		case strings.Contains(a.Type, "["):
+			i := strings.Index(a.Type, "[")
+			decl = append(decl, fmt.Sprintf("%s %s%s", a.Type[:i], a.Name, a.Type[i:]))
+		default:
+			decl = append(decl, fmt.Sprintf("%s %s", a.Type, a.Name))
+		}
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(decl, ","))
+}
+
+// trampolineDefs defines the trampolines of all the callbacks.
+func trampolineDefs() string {
+	var types []string
+	for t := range callbackDefs {
+		types = append(types, t)
+	}
+
+	sort.Strings(types)
+
+	var sb strings.Builder
+	for _, t := range types {
+		sb.WriteString(callbackDefs[t].TrampolineDef)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thoas/go-funk"
+)
+
+// GoArgDef is an argument of a generated Go function, with the C++ default
+// value of the argument, if any.
+type GoArgDef struct {
+	Name       string
+	Type       string
+	Default    string
+	HasDefault bool
+}
+
+// goDefaultValue converts the C++ default value of an argument of goType
+// to Go. It reports false for values it can't convert.
+func goDefaultValue(value, goType string, structNames []string) (string, bool) {
+	switch {
+	case value == "NULL":
+		switch {
+		case goType == "string":
+			// NULL strings are passed as "", see wrapNullableString
+			return `""`, true
+		case funk.ContainsString(structNames, goType):
+			return "0", true
+		case strings.HasPrefix(goType, "*"),
+			strings.HasPrefix(goType, "[]"),
+			goType == "unsafe.Pointer",
+			isCallbackGoType(goType):
+			return "nil", true
+		}
+
+		return "", false
+	case goType == "string":
+		return value, strings.HasPrefix(value, `"`)
+	case goType == "bool":
+		return value, value == "true" || value == "false"
+	case goType == "ImVec2" || goType == "ImVec4":
+		if !strings.HasPrefix(value, goType+"(") {
+			return "", false
+		}
+
+		components := strings.TrimPrefix(value, goType+"(")
+
+		var goComponents []string
+		for _, c := range strings.Split(strings.TrimSuffix(components, ")"), ",") {
+			goComponent, ok := goNumber(c)
+			if !ok {
+				return "", false
+			}
+
+			goComponents = append(goComponents, goComponent)
+		}
+
+		return fmt.Sprintf("New%s(%s)", goType, strings.Join(goComponents, ", ")), true
+	default:
+		return goNumber(value)
+	}
+}
+
+// goNumber converts a C++ number literal, e.g. "1.0f", "-FLT_MIN" or "255".
+func goNumber(value string) (string, bool) {
+	number := strings.TrimPrefix(strings.TrimPrefix(value, "-"), "+")
+	switch {
+	case number == "FLT_MIN" || number == "FLT_MAX":
+		return value, true
+	case len(number) == 0 || strings.Trim(number, "0123456789.f") != "":
+		return "", false
+	}
+
+	return strings.TrimSuffix(value, "f"), true
+}
+
+func isCallbackGoType(goType string) bool {
+	for _, cb := range callbackDefs {
+		if cb.GoType == goType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// defaultArgsCount returns how many trailing arguments can be left out
+// in favor of their default value.
+func defaultArgsCount(args []GoArgDef, structNames []string) int {
+	count := 0
+	for i := len(args) - 1; i >= 0; i-- {
+		if !args[i].HasDefault {
+			break
+		}
+
+		if _, ok := goDefaultValue(args[i].Default, args[i].Type, structNames); !ok {
+			break
+		}
+
+		count++
+	}
+
+	return count
+}
+
+// optionsArgsCount is how many default arguments a function has at least
+// to get an options struct, e.g. PlotLines_FloatPtrWithOptions.
+const optionsArgsCount = 4
+
+// goFieldName converts an argument name to an exported field name, e.g.
+// "values_offset" to "ValuesOffset".
+func goFieldName(argName string) string {
+	var name strings.Builder
+	for _, part := range strings.Split(argName, "_") {
+		if len(part) > 0 {
+			name.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+
+	return name.String()
+}
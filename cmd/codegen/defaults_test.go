@@ -0,0 +1,49 @@
+package main
+
+import "testing"
+
+func TestGoDefaultValue(t *testing.T) {
+	structNames := []string{"ImGuiStyle"}
+
+	tests := []struct {
+		value  string
+		goType string
+		want   string
+		ok     bool
+	}{
+		{"1.0f", "float32", "1.0", true},
+		{"+360.0f", "float32", "+360.0", true},
+		{"FLT_MAX", "float32", "FLT_MAX", true},
+		{"ImVec2(-FLT_MIN,0)", "ImVec2", "NewImVec2(-FLT_MIN, 0)", true},
+		{"ImVec4(1,1,1,1)", "ImVec4", "NewImVec4(1, 1, 1, 1)", true},
+		{`"%.3f"`, "string", `"%.3f"`, true},
+		{"NULL", "string", `""`, true},
+		{"NULL", "*bool", "nil", true},
+		{"NULL", "ImGuiStyle", "0", true},
+		{"NULL", "ImGuiSizeCallback", "nil", true},
+		{"true", "bool", "true", true},
+		{"sizeof(float)", "int32", "", false},
+		{"NULL", "ImGuiWindowFlags", "", false},
+	}
+
+	for _, test := range tests {
+		got, ok := goDefaultValue(test.value, test.goType, structNames)
+		if got != test.want || ok != test.ok {
+			t.Errorf("%s of %s: expect %q, %v got %q, %v", test.value, test.goType, test.want, test.ok, got, ok)
+		}
+	}
+}
+
+func TestGoFieldName(t *testing.T) {
+	tests := map[string]string{
+		"values_offset": "ValuesOffset",
+		"uv1":           "Uv1",
+		"typeArg":       "TypeArg",
+	}
+
+	for argName, want := range tests {
+		if got := goFieldName(argName); got != want {
+			t.Errorf("%s: expect %q got %q", argName, want, got)
+		}
+	}
+}
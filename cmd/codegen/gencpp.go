@@ -18,6 +18,7 @@ func generateCppWrapper(funcDefs []FuncDef) []FuncDef {
 	headerSb.WriteString(`#pragma once
 
 #include "cimgui/cimgui.h"
+#include <stdint.h>
 
 #ifdef __cplusplus
 extern "C" {
@@ -30,6 +31,8 @@ extern "C" {
 #include "cimgui/cimgui.h"
 
 `)
+	cppSb.WriteString(trampolineDefs())
+	cppSb.WriteString("\n")
 
 	for _, f := range funcDefs {
 		shouldSkip := false
@@ -91,8 +94,28 @@ extern "C" {
 
 		var argsT []ArgDef
 		var actualCallArgs []string
+		// Whether the wrapper declares other argument types than the function
+		rewriteArgs := false
+
+		for i := 0; i < len(f.ArgsT); i++ {
+			a := f.ArgsT[i]
+
+			if cb, ok := callbackArg(f.ArgsT, i); ok {
+				// The callback and its user data become a single handle
+				argsT = append(argsT, a)
+				actualCallArgs = append(actualCallArgs, fmt.Sprintf("%[1]s ? %[2]s : NULL,(void*)%[1]s", a.Name, cb.Trampoline))
+				rewriteArgs = true
+				i++
+				continue
+			}
+
+			if a.Type == "ImTextureID" {
+				argsT = append(argsT, a)
+				actualCallArgs = append(actualCallArgs, fmt.Sprintf("(ImTextureID)%s", a.Name))
+				rewriteArgs = true
+				continue
+			}
 
-		for _, a := range f.ArgsT {
 			switch {
 			case a.Name == "...":
 				continue
@@ -107,6 +130,10 @@ extern "C" {
 
 		f.ArgsT = argsT
 
+		if rewriteArgs {
+			f.Args = wrapperArgsDecl(f.ArgsT)
+		}
+
 		actualCallArgsStr := fmt.Sprintf("(%s)", strings.Join(actualCallArgs, ","))
 
 		appendValidFunc := func() {
@@ -118,6 +145,7 @@ extern "C" {
 				Constructor: f.Constructor,
 				Destructor:  f.Destructor,
 				Ret:         f.Ret,
+				Defaults:    f.Defaults,
 			})
 		}
 
@@ -269,10 +297,16 @@ extern "C" {
 				Ret:          m.Type,
 			})
 
-			sbHeader.WriteString(fmt.Sprintf("extern void %[1]s_Set%[2]s(%[1]s *%[3]s, %[4]s v);\n", s.Name, m.Name, s.Name+"Ptr", m.Type))
+			// Texture IDs are passed as integers, see imTextureIDW
+			setterType, setterValue := m.Type, "v"
+			if m.Type == "ImTextureID" {
+				setterType, setterValue = "uintptr_t", "(ImTextureID)v"
+			}
+
+			sbHeader.WriteString(fmt.Sprintf("extern void %[1]s_Set%[2]s(%[1]s *%[3]s, %[4]s v);\n", s.Name, m.Name, s.Name+"Ptr", setterType))
 			sbHeader.WriteString(fmt.Sprintf("extern %[4]s %[1]s_Get%[2]s(%[1]s *%[3]s);\n", s.Name, m.Name, "self", m.Type))
 
-			sbCpp.WriteString(fmt.Sprintf("void %[1]s_Set%[2]s(%[1]s *%[3]s, %[4]s v) { %[3]s->%[2]s = v; }\n", s.Name, m.Name, s.Name+"Ptr", m.Type))
+			sbCpp.WriteString(fmt.Sprintf("void %[1]s_Set%[2]s(%[1]s *%[3]s, %[4]s v) { %[3]s->%[2]s = %[5]s; }\n", s.Name, m.Name, s.Name+"Ptr", setterType, setterValue))
 			sbCpp.WriteString(fmt.Sprintf("%[4]s %[1]s_Get%[2]s(%[1]s *%[3]s) { return %[3]s->%[2]s; }\n", s.Name, m.Name, "self", m.Type))
 		}
 	}
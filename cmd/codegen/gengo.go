@@ -134,6 +134,14 @@ defer %[1]sFin()`, arg.Name)
 	return
 }
 
+func nullableConstCharW(arg ArgDef) (argType string, def string, varName string) {
+	argType = "string"
+	def = fmt.Sprintf(`%[1]sArg, %[1]sFin := wrapNullableString(%[1]s)
+defer %[1]sFin()`, arg.Name)
+	varName = fmt.Sprintf("%sArg", arg.Name)
+	return
+}
+
 func ucharW(arg ArgDef) (argType string, def string, varName string) {
 	return simpleValueW(arg.Name, "uint", "uchar")
 }
@@ -291,6 +299,31 @@ func uintW(arg ArgDef) (argType string, def string, varName string) {
 	return simpleValueW(arg.Name, "uint32", "uint")
 }
 
+func constCharArrayW(arg ArgDef) (argType string, def string, varName string) {
+	argType = "[]string"
+	def = fmt.Sprintf(`%[1]sArg, %[1]sFin := wrapStrings(%[1]s)
+defer %[1]sFin()`, arg.Name)
+	varName = fmt.Sprintf("%sArg", arg.Name)
+	return
+}
+
+func float32SliceW(arg ArgDef) (argType string, def string, varName string) {
+	argType = "[]float32"
+	varName = fmt.Sprintf("wrapFloat32Slice(%s)", arg.Name)
+	return
+}
+
+func imVec2SliceW(arg ArgDef) (argType string, def string, varName string) {
+	argType = "[]ImVec2"
+	varName = fmt.Sprintf("wrapImVec2Slice(%s)", arg.Name)
+	return
+}
+
+// isCountArg reports whether a is the length of a slice argument right before it.
+func isCountArg(a ArgDef) bool {
+	return a.Type == "int" && (strings.HasSuffix(a.Name, "_count") || strings.HasPrefix(a.Name, "num_"))
+}
+
 func doubleW(arg ArgDef) (argType string, def string, varName string) {
 	return simpleValueW(arg.Name, "float64", "double")
 }
@@ -305,8 +338,10 @@ func imGuiIDW(arg ArgDef) (argType string, def string, varName string) {
 	return simpleValueW(arg.Name, "ImGuiID", "ImGuiID")
 }
 
+// imTextureIDW passes texture IDs as integers: they are often small
+// integers, which Go must not convert to unsafe.Pointer (e.g. for checkptr).
 func imTextureIDW(arg ArgDef) (argType string, def string, varName string) {
-	return simpleValueW(arg.Name, "ImTextureID", "ImTextureID")
+	return simpleValueW(arg.Name, "ImTextureID", "uintptr_t")
 }
 
 func imDrawIdxW(arg ArgDef) (argType string, def string, varName string) {
@@ -369,9 +404,14 @@ defer %[1]sFin()`, arg.Name)
 	return
 }
 
-func inputeTextCallbackW(arg ArgDef) (argType string, def string, varName string) {
-	argType = "ImGuiInputTextCallback"
-	//TODO: implement me
+func callbackW(cb CallbackDef, arg ArgDef) (argType string, def string, varName string) {
+	argType = cb.GoType
+	if cb.Frame {
+		def = fmt.Sprintf("%[1]sArg := %[2]s(%[1]s)", arg.Name, cb.GoWrapper)
+	} else {
+		def = fmt.Sprintf("%[1]sArg, %[1]sFin := %[2]s(%[1]s)\ndefer %[1]sFin()", arg.Name, cb.GoWrapper)
+	}
+	varName = fmt.Sprintf("%sArg", arg.Name)
 	return
 }
 
@@ -525,6 +565,7 @@ import "unsafe"
 		"double*":                  doublePtrW,
 		"bool":                     boolW,
 		"bool*":                    boolPtrW,
+		"const char* const[]":      constCharArrayW,
 		"int[2]":                   int2W,
 		"int[3]":                   int3W,
 		"int[4]":                   int4W,
@@ -553,6 +594,12 @@ import "unsafe"
 		"ImRect":                   imRectW,
 	}
 
+	// Pointers followed by a count argument are slices
+	sliceArgWrapperMap := map[string]typeWrapper{
+		"const float*":  float32SliceW,
+		"const ImVec2*": imVec2SliceW,
+	}
+
 	returnWrapperMap := map[string]returnWrapper{
 		"bool":                     boolReturnW,
 		"const char*":              constCharReturnW,
@@ -597,15 +644,47 @@ import "unsafe"
 		return false
 	}
 
+	// Go names of the functions whose overloads have a natural Go signature
+	goFuncNames := map[string]string{
+		"Combo_Str_arr":   "Combo",
+		"ListBox_Str_arr": "ListBox",
+	}
+
 	for _, f := range validFuncs {
 		var args []string
+		var goArgs []GoArgDef
 		var argWrappers []argOutput
 
 		shouldGenerate := false
 
+		// The slice whose length is given for the next argument
+		lengthOf := ""
+		// The last slice, whose elements are spaced by stride, if any
+		sliceArg := ""
+
 		for i, a := range f.ArgsT {
 			shouldGenerate = false
 
+			if len(lengthOf) > 0 && isCountArg(a) {
+				argWrappers = append(argWrappers, argOutput{
+					VarName: fmt.Sprintf("C.int(len(%s))", lengthOf),
+				})
+				lengthOf = ""
+				shouldGenerate = true
+				continue
+			}
+
+			if len(sliceArg) > 0 && a.Name == "stride" {
+				argWrappers = append(argWrappers, argOutput{
+					VarName: fmt.Sprintf("C.int(unsafe.Sizeof(%s[0]))", sliceArg),
+				})
+				shouldGenerate = true
+				continue
+			}
+
+			defaultValue, hasDefault := f.Defaults[a.Name]
+			argsCount := len(args)
+
 			if a.Name == "type" {
 				a.Name = "typeArg"
 			}
@@ -622,7 +701,15 @@ import "unsafe"
 				shouldGenerate = true
 			}
 
-			if v, ok := argWrapperMap[a.Type]; ok {
+			v, ok := argWrapperMap[a.Type]
+			if a.Type == "const char*" && hasDefault && defaultValue == "NULL" {
+				v = nullableConstCharW
+			}
+			if sv, isSlice := sliceArgWrapperMap[a.Type]; isSlice && i+1 < len(f.ArgsT) && isCountArg(f.ArgsT[i+1]) {
+				v, ok = sv, true
+			}
+
+			if ok {
 				argType, argDef, varName := v(a)
 				argWrappers = append(argWrappers, argOutput{
 					ArgType: argType,
@@ -632,6 +719,25 @@ import "unsafe"
 
 				args = append(args, fmt.Sprintf("%s %s", a.Name, argType))
 
+				if strings.HasPrefix(argType, "[]") && i+1 < len(f.ArgsT) && isCountArg(f.ArgsT[i+1]) {
+					lengthOf = a.Name
+					sliceArg = a.Name
+				}
+
+				shouldGenerate = true
+			}
+
+			// Struct members keep the C callback, only functions take a handle
+			if cb, ok := callbackDefs[a.Type]; ok && !f.StructSetter {
+				argType, argDef, varName := callbackW(cb, a)
+				argWrappers = append(argWrappers, argOutput{
+					ArgType: argType,
+					ArgDef:  argDef,
+					VarName: varName,
+				})
+
+				args = append(args, fmt.Sprintf("%s %s", a.Name, argType))
+
 				shouldGenerate = true
 			}
 
@@ -662,6 +768,15 @@ import "unsafe"
 				fmt.Println("Unknown arg: ", a.Type)
 				break
 			}
+
+			if len(args) > argsCount {
+				goArgs = append(goArgs, GoArgDef{
+					Name:       a.Name,
+					Type:       strings.TrimPrefix(args[len(args)-1], a.Name+" "),
+					Default:    defaultValue,
+					HasDefault: hasDefault,
+				})
+			}
 		}
 
 		if len(f.ArgsT) == 0 {
@@ -698,7 +813,8 @@ import "unsafe"
 			"StbTexteditRow",
 		}
 
-		funcSignatureFunc := func(funcName string, args []string, returnType string) string {
+		// methodOf returns the method name of funcName, if it is generated as a method of self
+		methodOf := func(funcName string, args []string) (string, bool) {
 			funcParts := strings.Split(funcName, "_")
 			typeName := funcParts[0]
 
@@ -706,17 +822,157 @@ import "unsafe"
 				len(funcParts) > 1 &&
 				len(args) > 0 && strings.Contains(args[0], "self ") &&
 				!funk.ContainsString(skipStructs, typeName) {
-				newFuncName := strings.TrimPrefix(funcName, typeName+"_")
-				newArgs := args
-				if len(newArgs) > 0 {
-					newArgs = args[1:]
+				return strings.TrimPrefix(funcName, typeName+"_"), true
+			}
+
+			return "", false
+		}
+
+		shortFuncName := ""
+
+		// The hint of the defaults left out by the short form of the function, if any
+		defaultsHint := ""
+
+		funcSignatureFunc := func(funcName string, args []string, returnType string) string {
+			hint := ""
+			if len(defaultsHint) > 0 && funcName == shortFuncName+"V" {
+				hint = defaultsHint
+			}
+
+			if methodName, ok := methodOf(funcName, args); ok {
+				typeName := strings.TrimPrefix(args[0], "self ")
+				return fmt.Sprintf("%sfunc (self %s) %s(%s) %s {\n", hint, typeName, methodName, strings.Join(args[1:], ","), returnType)
+			}
+
+			return fmt.Sprintf("%sfunc %s(%s) %s {\n", hint, funcName, strings.Join(args, ","), returnType)
+		}
+
+		goFuncName := f.FuncName
+		if name, ok := goFuncNames[f.FuncName]; ok {
+			goFuncName = name
+		}
+
+		// Functions with default arguments get a short form, e.g. Button(label),
+		// calling the full form with the defaults, e.g. ButtonV(label, size).
+		defaultsCount := 0
+		if !f.StructSetter && !f.StructGetter && !f.Constructor {
+			defaultsCount = defaultArgsCount(goArgs, structNames)
+		}
+
+		if defaultsCount > 0 {
+			shortFuncName = goFuncName
+			goFuncName += "V"
+
+			docName := goFuncName
+			if methodName, ok := methodOf(goFuncName, args); ok {
+				docName = methodName
+			}
+
+			defaultsHint = fmt.Sprintf("// %s parameter default value hint:\n", docName)
+			for _, a := range goArgs {
+				if a.HasDefault {
+					defaultsHint += fmt.Sprintf("// %s: %s\n", a.Name, a.Default)
 				}
+			}
+		}
+
+		// Functions with many default arguments also take them in an options
+		// struct, e.g. PlotLines_FloatPtrWithOptions(label, values, options),
+		// starting from the defaults, e.g. DefaultPlotLines_FloatPtrOptions().
+		// Methods name them after their receiver, e.g. ImDrawListAddImageQuadOptions.
+		optionsFuncFunc := func(returnType string) {
+			optionsType := shortFuncName + "Options"
+			defaultArgs := goArgs[len(goArgs)-defaultsCount:]
+
+			// The V form as referred to in comments, e.g. ImDrawList.AddImageQuadV
+			fullRef := goFuncName
+			if methodName, ok := methodOf(goFuncName, args); ok {
+				typeName := strings.TrimPrefix(args[0], "self ")
+				fullRef = typeName + "." + methodName
+				optionsType = typeName + strings.TrimSuffix(methodName, "V") + "Options"
+			}
+
+			sb.WriteString(fmt.Sprintf("// %s are the default arguments of %s, see Default%[1]s.\n", optionsType, fullRef))
+			sb.WriteString(fmt.Sprintf("type %s struct {\n", optionsType))
+			for _, a := range defaultArgs {
+				sb.WriteString(fmt.Sprintf("%s %s\n", goFieldName(a.Name), a.Type))
+			}
+			sb.WriteString("}\n\n")
+
+			sb.WriteString(fmt.Sprintf("// Default%s returns the C++ default arguments of %s.\n", optionsType, fullRef))
+			sb.WriteString(fmt.Sprintf("func Default%[1]s() %[1]s {\nreturn %[1]s{\n", optionsType))
+			for _, a := range defaultArgs {
+				value, _ := goDefaultValue(a.Default, a.Type, structNames)
+				sb.WriteString(fmt.Sprintf("%s: %s,\n", goFieldName(a.Name), value))
+			}
+			sb.WriteString("}\n}\n\n")
+
+			withOptionsName := shortFuncName + "WithOptions"
+			withOptionsArgs := append(append([]string{}, args[:len(args)-defaultsCount]...), "options "+optionsType)
 
-				typeName = strings.TrimPrefix(args[0], "self ")
-				return fmt.Sprintf("func (self %s) %s(%s) %s {\n", typeName, newFuncName, strings.Join(newArgs, ","), returnType)
+			docName, fullName := withOptionsName, goFuncName
+			if methodName, ok := methodOf(withOptionsName, withOptionsArgs); ok {
+				docName = methodName
+				fullName, _ = methodOf(goFuncName, args)
 			}
 
-			return fmt.Sprintf("func %s(%s) %s {\n", funcName, strings.Join(args, ","), returnType)
+			sb.WriteString(fmt.Sprintf("// %s is %s taking its default arguments in options.\n", docName, fullName))
+			sb.WriteString(funcSignatureFunc(shortFuncName+"WithOptions", withOptionsArgs, returnType))
+
+			var callArgs []string
+			for i, a := range goArgs {
+				if i < len(goArgs)-defaultsCount {
+					callArgs = append(callArgs, a.Name)
+				} else {
+					callArgs = append(callArgs, "options."+goFieldName(a.Name))
+				}
+			}
+
+			call := goFuncName
+			if methodName, ok := methodOf(goFuncName, args); ok {
+				call = "self." + methodName
+				callArgs = callArgs[1:]
+			}
+
+			if len(returnType) > 0 {
+				sb.WriteString("return ")
+			}
+
+			sb.WriteString(fmt.Sprintf("%s(%s)\n}\n\n", call, strings.Join(callArgs, ",")))
+		}
+
+		shortFuncFunc := func(returnType string) {
+			if defaultsCount == 0 {
+				return
+			}
+
+			sb.WriteString(funcSignatureFunc(shortFuncName, args[:len(args)-defaultsCount], returnType))
+
+			var callArgs []string
+			for i, a := range goArgs {
+				if i < len(goArgs)-defaultsCount {
+					callArgs = append(callArgs, a.Name)
+				} else {
+					value, _ := goDefaultValue(a.Default, a.Type, structNames)
+					callArgs = append(callArgs, value)
+				}
+			}
+
+			call := goFuncName
+			if methodName, ok := methodOf(goFuncName, args); ok {
+				call = "self." + methodName
+				callArgs = callArgs[1:]
+			}
+
+			if len(returnType) > 0 {
+				sb.WriteString("return ")
+			}
+
+			sb.WriteString(fmt.Sprintf("%s(%s)\n}\n\n", call, strings.Join(callArgs, ",")))
+
+			if defaultsCount >= optionsArgsCount {
+				optionsFuncFunc(returnType)
+			}
 		}
 
 		if f.Ret == "void" {
@@ -734,12 +990,14 @@ import "unsafe"
 				sb.WriteString(fmt.Sprintf("C.%s(self.handle(), %s)\n", f.FuncName, argInvokeStmt))
 				sb.WriteString("}\n\n")
 			} else {
-				sb.WriteString(funcSignatureFunc(f.FuncName, args, ""))
+				sb.WriteString(funcSignatureFunc(goFuncName, args, ""))
 
 				argInvokeStmt := argStmtFunc()
 
 				sb.WriteString(fmt.Sprintf("C.%s(%s)\n", f.FuncName, argInvokeStmt))
 				sb.WriteString("}\n\n")
+
+				shortFuncFunc("")
 			}
 
 			convertedFuncCount += 1
@@ -747,40 +1005,46 @@ import "unsafe"
 			if rf, ok := returnWrapperMap[f.Ret]; ok {
 				returnType, returnStmt := rf(f)
 
-				sb.WriteString(funcSignatureFunc(f.FuncName, args, returnType))
+				sb.WriteString(funcSignatureFunc(goFuncName, args, returnType))
 
 				argInvokeStmt := argStmtFunc()
 
 				sb.WriteString(fmt.Sprintf(returnStmt, fmt.Sprintf("C.%s(%s)", f.FuncName, argInvokeStmt)))
 				sb.WriteString("}\n\n")
 
+				shortFuncFunc(returnType)
+
 				convertedFuncCount += 1
 			} else if funk.ContainsString(enumNames, f.Ret) {
 				returnType := f.Ret
 
-				sb.WriteString(funcSignatureFunc(f.FuncName, args, returnType))
+				sb.WriteString(funcSignatureFunc(goFuncName, args, returnType))
 
 				argInvokeStmt := argStmtFunc()
 
 				sb.WriteString(fmt.Sprintf("return %s(%s)", f.Ret, fmt.Sprintf("C.%s(%s)", f.FuncName, argInvokeStmt)))
 				sb.WriteString("}\n\n")
 
+				shortFuncFunc(returnType)
+
 				convertedFuncCount += 1
 			} else if strings.HasSuffix(f.Ret, "*") && (funk.Contains(structNames, strings.TrimSuffix(f.Ret, "*")) || funk.Contains(structNames, strings.TrimSuffix(strings.TrimPrefix(f.Ret, "const "), "*"))) {
 				// return Im struct ptr
 				pureReturnType := strings.TrimPrefix(f.Ret, "const ")
 				pureReturnType = strings.TrimSuffix(pureReturnType, "*")
 
-				sb.WriteString(funcSignatureFunc(f.FuncName, args, pureReturnType))
+				sb.WriteString(funcSignatureFunc(goFuncName, args, pureReturnType))
 
 				argInvokeStmt := argStmtFunc()
 
 				sb.WriteString(fmt.Sprintf("return (%s)(unsafe.Pointer(%s))", pureReturnType, fmt.Sprintf("C.%s(%s)", f.FuncName, argInvokeStmt)))
 				sb.WriteString("}\n\n")
 
+				shortFuncFunc(pureReturnType)
+
 				convertedFuncCount += 1
 			} else if f.StructGetter && funk.ContainsString(structNames, f.Ret) {
-				sb.WriteString(funcSignatureFunc(f.FuncName, args, f.Ret))
+				sb.WriteString(funcSignatureFunc(goFuncName, args, f.Ret))
 
 				argInvokeStmt := argStmtFunc()
 
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"flag"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 type ArgDef struct {
@@ -12,15 +15,16 @@ type ArgDef struct {
 }
 
 type FuncDef struct {
-	Args         string   `json:"args"`
-	ArgsT        []ArgDef `json:"argsT"`
-	FuncName     string   `json:"ov_cimguiname"`
-	Location     string   `json:"location"`
-	Constructor  bool     `json:"constructor"`
-	Destructor   bool     `json:"destructor"`
-	StructSetter bool     `json:"struct_setter"`
-	StructGetter bool     `json:"struct_getter"`
-	Ret          string   `json:"ret"`
+	Args         string            `json:"args"`
+	ArgsT        []ArgDef          `json:"argsT"`
+	FuncName     string            `json:"ov_cimguiname"`
+	Location     string            `json:"location"`
+	Constructor  bool              `json:"constructor"`
+	Destructor   bool              `json:"destructor"`
+	StructSetter bool              `json:"struct_setter"`
+	StructGetter bool              `json:"struct_getter"`
+	Ret          string            `json:"ret"`
+	Defaults     map[string]string `json:"defaults"`
 }
 
 type EnumValueDef struct {
@@ -34,7 +38,8 @@ type EnumDef struct {
 }
 
 type EnumsSection struct {
-	Enums json.RawMessage `json:"enums"`
+	Enums     json.RawMessage   `json:"enums"`
+	Locations map[string]string `json:"locations"`
 }
 
 type StructMemberDef struct {
@@ -59,22 +64,29 @@ func main() {
 
 	flag.Parse()
 
-	stat, err := os.Stat(*defJsonPath)
+	generate(*defJsonPath, *enumsJsonpath)
+}
+
+// generate writes the wrappers of the definitions into the current directory.
+// The output only depends on the json files: functions, enums and structs
+// are sorted by source location, then by name.
+func generate(defJsonPath, enumsJsonpath string) {
+	stat, err := os.Stat(defJsonPath)
 	if err != nil || stat.IsDir() {
 		panic("Invalid definitions json file path")
 	}
 
-	stat, err = os.Stat(*enumsJsonpath)
+	stat, err = os.Stat(enumsJsonpath)
 	if err != nil || stat.IsDir() {
 		panic("Invalid enum json file path")
 	}
 
-	defJsonBytes, err := os.ReadFile(*defJsonPath)
+	defJsonBytes, err := os.ReadFile(defJsonPath)
 	if err != nil {
 		panic(err.Error())
 	}
 
-	enumJsonBytes, err := os.ReadFile(*enumsJsonpath)
+	enumJsonBytes, err := os.ReadFile(enumsJsonpath)
 	if err != nil {
 		panic(err.Error())
 	}
@@ -87,13 +99,17 @@ func main() {
 
 	var funcs []FuncDef
 
-	for _, v := range defJson {
+	for _, k := range sortedKeys(defJson) {
 		var funcDefs []FuncDef
-		_ = json.Unmarshal(v, &funcDefs)
+		_ = json.Unmarshal(defJson[k], &funcDefs)
 
 		funcs = append(funcs, funcDefs...)
 	}
 
+	sort.SliceStable(funcs, func(i, j int) bool {
+		return lessByLocation(funcs[i].Location, funcs[i].FuncName, funcs[j].Location, funcs[j].FuncName)
+	})
+
 	var enumSectionJson EnumsSection
 	err = json.Unmarshal(enumJsonBytes, &enumSectionJson)
 	if err != nil {
@@ -108,9 +124,9 @@ func main() {
 		panic(err.Error())
 	}
 
-	for k, v := range enumJson {
+	for _, k := range sortedKeys(enumJson) {
 		var enumValues []EnumValueDef
-		err := json.Unmarshal(v, &enumValues)
+		err := json.Unmarshal(enumJson[k], &enumValues)
 		if err != nil {
 			panic(err.Error())
 		}
@@ -121,6 +137,11 @@ func main() {
 		})
 	}
 
+	locations := enumSectionJson.Locations
+	sort.SliceStable(enums, func(i, j int) bool {
+		return lessByLocation(locations[enums[i].Name], enums[i].Name, locations[enums[j].Name], enums[j].Name)
+	})
+
 	var structSectionJson StructSection
 	err = json.Unmarshal(enumJsonBytes, &structSectionJson)
 	if err != nil {
@@ -134,9 +155,9 @@ func main() {
 	if err != nil {
 		panic(err.Error())
 	}
-	for k, v := range structJson {
+	for _, k := range sortedKeys(structJson) {
 		var memberDefs []StructMemberDef
-		err := json.Unmarshal(v, &memberDefs)
+		err := json.Unmarshal(structJson[k], &memberDefs)
 		if err != nil {
 			panic(err.Error())
 		}
@@ -147,6 +168,10 @@ func main() {
 		})
 	}
 
+	sort.SliceStable(structs, func(i, j int) bool {
+		return lessByLocation(locations[structs[i].Name], structs[i].Name, locations[structs[j].Name], structs[j].Name)
+	})
+
 	validFuncs := generateCppWrapper(funcs)
 
 	enumNames := generateGoEnums(enums)
@@ -157,3 +182,43 @@ func main() {
 
 	generateGoFuncs(validFuncs, enumNames, structNames)
 }
+
+func sortedKeys(m map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// lessByLocation orders definitions by header, then line, then name.
+// Locations look like "imgui:313", definitions without one come last.
+func lessByLocation(locationA, nameA, locationB, nameB string) bool {
+	fileA, lineA := parseLocation(locationA)
+	fileB, lineB := parseLocation(locationB)
+
+	switch {
+	case (locationA == "") != (locationB == ""):
+		return locationB == ""
+	case fileA != fileB:
+		return fileA < fileB
+	case lineA != lineB:
+		return lineA < lineB
+	default:
+		return nameA < nameB
+	}
+}
+
+func parseLocation(location string) (file string, line int) {
+	file, lineStr, found := strings.Cut(location, ":")
+	if !found {
+		return location, 0
+	}
+
+	line, _ = strconv.Atoi(lineStr)
+
+	return file, line
+}
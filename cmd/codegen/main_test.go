@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateIsReproducible(t *testing.T) {
+	definitions, err := filepath.Abs("../../cimgui/generator/output/definitions.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	structsAndEnums, err := filepath.Abs("../../cimgui/generator/output/structs_and_enums.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	dirs := []string{t.TempDir(), t.TempDir()}
+	for _, dir := range dirs {
+		if err := os.Chdir(dir); err != nil {
+			t.Fatal(err)
+		}
+
+		generate(definitions, structsAndEnums)
+	}
+
+	files := []string{
+		"cimgui_wrapper.h",
+		"cimgui_wrapper.cpp",
+		"cimgui_structs_accessor.h",
+		"cimgui_structs_accessor.cpp",
+		"enums.go",
+		"structs.go",
+		"funcs.go",
+	}
+	for _, file := range files {
+		first, err := os.ReadFile(filepath.Join(dirs[0], file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, err := os.ReadFile(filepath.Join(dirs[1], file))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(first, second) {
+			t.Errorf("expect %s to be generated the same twice", file)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package cimgui
+
+// #include "backend.h"
+import "C"
+import (
+	"image"
+	"unsafe"
+)
+
+// CursorMode is how the OS cursor behaves over a window.
+type CursorMode int
+
+const (
+	// CursorModeNormal shows the cursor requested by dear imgui.
+	CursorModeNormal CursorMode = CursorMode(C.CursorModeNormal)
+	// CursorModeHidden hides the cursor while it is over the window.
+	CursorModeHidden CursorMode = CursorMode(C.CursorModeHidden)
+	// CursorModeCaptured hides the cursor and locks it to the window, so the
+	// mouse moves without bounds, e.g. to drag in a 3D viewport.
+	CursorModeCaptured CursorMode = CursorMode(C.CursorModeCaptured)
+)
+
+// GLFWcursor is a custom OS cursor, see GLFWwindow.SetCustomCursor.
+type GLFWcursor uintptr
+
+func (c GLFWcursor) handle() *C.GLFWcursor {
+	return (*C.GLFWcursor)(unsafe.Pointer(c))
+}
+
+// CreateCursor creates a cursor showing img, with its hotspot relative to the
+// top left corner of img. Cursors can only be created once a window was
+// created, and are destroyed with the last window.
+func CreateCursor(img image.Image, hotspot image.Point) GLFWcursor {
+	nrgba := imageToNRGBA(img)
+	if len(nrgba.Pix) == 0 {
+		return 0
+	}
+
+	return GLFWcursor(unsafe.Pointer(C.igCreateCursor(
+		(*C.uchar)(unsafe.Pointer(&nrgba.Pix[0])),
+		C.int(nrgba.Rect.Dx()),
+		C.int(nrgba.Rect.Dy()),
+		C.int(hotspot.X),
+		C.int(hotspot.Y),
+	)))
+}
+
+// Destroy destroys the cursor, it must not be used by any window anymore.
+func (c GLFWcursor) Destroy() {
+	if c != 0 {
+		C.igDestroyCursor(c.handle())
+	}
+}
+
+// SetCustomCursor shows c whenever dear imgui requests cursor, see SetMouseCursor.
+// cursor is either a standard ImGuiMouseCursor whose shape is replaced, or an
+// ID of the application from ImGuiMouseCursor_COUNT on, the arrow until
+// it has a cursor. A 0 c restores the standard shape.
+func (w GLFWwindow) SetCustomCursor(cursor ImGuiMouseCursor, c GLFWcursor) {
+	state := w.state()
+	switch {
+	case state == nil:
+		return
+	case c == 0:
+		delete(state.cursors, cursor)
+		return
+	}
+
+	if state.cursors == nil {
+		state.cursors = map[ImGuiMouseCursor]GLFWcursor{}
+	}
+	state.cursors[cursor] = c
+}
+
+// SetCursorMode shows, hides or captures the cursor over the window.
+func (w GLFWwindow) SetCursorMode(mode CursorMode) {
+	state := w.state()
+	if state == nil {
+		return
+	}
+
+	state.cursorMode = mode
+	C.igGLFWWindow_SetCursorMode(w.handle(), C.CursorMode(mode))
+}
+
+// CursorMode returns the mode set with SetCursorMode.
+func (w GLFWwindow) CursorMode() CursorMode {
+	if state := w.state(); state != nil {
+		return state.cursorMode
+	}
+
+	return CursorModeNormal
+}
+
+// CursorPos returns the position of the cursor relative to the top left
+// corner of the window content area.
+func (w GLFWwindow) CursorPos() (x, y float64) {
+	if w.state() == nil {
+		return 0, 0
+	}
+
+	var cx, cy C.double
+	C.igGLFWWindow_GetCursorPos(w.handle(), &cx, &cy)
+
+	return float64(cx), float64(cy)
+}
+
+// SetCursorPos warps the cursor to x, y relative to the top left corner of
+// the window content area, and moves the dear imgui mouse along. dear imgui
+// itself warps the cursor when it sets io.WantSetMousePos, e.g. for
+// ImGuiConfigFlags_NavEnableSetMousePos.
+func (w GLFWwindow) SetCursorPos(x, y float64) {
+	if w.state() == nil {
+		return
+	}
+
+	C.igGLFWWindow_SetCursorPos(w.handle(), C.double(x), C.double(y))
+}
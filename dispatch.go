@@ -0,0 +1,76 @@
+package cimgui
+
+import "errors"
+
+// ErrWindowClosed is returned by Window.Call when the window is closed
+// before the function could run.
+var ErrWindowClosed = errors.New("cimgui: window closed")
+
+// task is a function queued by Post or Call, done is nil for Post.
+type task struct {
+	fn   func()
+	done chan error
+}
+
+// Post queues fn to run on the UI thread at the start of the next frame,
+// with the window's context current, and wakes the window up.
+// It may be called from any goroutine, fn is dropped once the window is closed.
+func (w *Window) Post(fn func()) {
+	w.enqueue(task{fn: fn})
+}
+
+// Call is Post waiting for fn to return. It returns ErrWindowClosed if the
+// window is closed before fn runs. Call blocks until the next frame, so it
+// must not be called from the UI thread itself, e.g. in the loop or a hook.
+func (w *Window) Call(fn func()) error {
+	done := make(chan error, 1)
+	w.enqueue(task{fn: fn, done: done})
+
+	return <-done
+}
+
+func (w *Window) enqueue(t task) {
+	w.tasksMu.Lock()
+	defer w.tasksMu.Unlock()
+
+	if w.tasksClosed {
+		if t.done != nil {
+			t.done <- ErrWindowClosed
+		}
+		return
+	}
+
+	w.tasks = append(w.tasks, t)
+	w.refresh.Store(true)
+	w.wakeUp()
+}
+
+// runTasks runs the queued functions. The ones they queue wait for the next frame.
+func (w *Window) runTasks() {
+	w.tasksMu.Lock()
+	tasks := w.tasks
+	w.tasks = nil
+	w.tasksMu.Unlock()
+
+	for _, t := range tasks {
+		t.fn()
+		if t.done != nil {
+			t.done <- nil
+		}
+	}
+}
+
+// closeTasks rejects the pending and future functions.
+func (w *Window) closeTasks() {
+	w.tasksMu.Lock()
+	defer w.tasksMu.Unlock()
+
+	for _, t := range w.tasks {
+		if t.done != nil {
+			t.done <- ErrWindowClosed
+		}
+	}
+
+	w.tasks = nil
+	w.tasksClosed = true
+}
@@ -0,0 +1,144 @@
+package cimgui
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// These tests are meant to be run with -race as well.
+
+func TestWindowCallFromGoroutines(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(64, 64))
+	window.SetTargetFPS(1000)
+
+	const workers, calls = 8, 50
+
+	counter := 0
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < calls; j++ {
+				if err := window.Call(func() { counter++ }); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		window.Post(window.Close)
+	}()
+
+	window.Run(func() {
+		Text("counter")
+	})
+
+	if counter != workers*calls {
+		t.Errorf("expect %d calls got %d", workers*calls, counter)
+	}
+}
+
+func TestWindowPostRunsInOrderWithContext(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(64, 64))
+	window.SetTargetFPS(0)
+
+	var got []int
+	go func() {
+		for i := 0; i < 10; i++ {
+			i := i
+			window.Post(func() {
+				if GetCurrentContext() != window.Backend().Context() {
+					t.Error("expect the window context to be current")
+				}
+				got = append(got, i)
+			})
+		}
+		window.Post(window.Close)
+	}()
+
+	window.Run(nil)
+
+	if len(got) != 10 {
+		t.Fatalf("expect 10 posted functions to run got %d", len(got))
+	}
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("expect posted functions to run in order got %v", got)
+		}
+	}
+}
+
+func TestWindowCallAfterClose(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(64, 64))
+	window.Step()
+	window.Close()
+
+	if err := window.Call(func() { t.Error("expect no call on a closed window") }); err != ErrWindowClosed {
+		t.Errorf("expect ErrWindowClosed got %v", err)
+	}
+
+	window.Post(func() { t.Error("expect no posted function on a closed window") })
+	window.Refresh()
+}
+
+func TestWindowCallPendingOnClose(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(64, 64))
+
+	result := make(chan error)
+	go func() {
+		result <- window.Call(func() { t.Error("expect no call on a closed window") })
+	}()
+
+	for queued := false; !queued; runtime.Gosched() {
+		window.tasksMu.Lock()
+		queued = len(window.tasks) > 0
+		window.tasksMu.Unlock()
+	}
+
+	window.Close()
+
+	if err := <-result; err != ErrWindowClosed {
+		t.Errorf("expect ErrWindowClosed got %v", err)
+	}
+}
+
+func TestRunWindowsWakesOnPostToAnyWindow(t *testing.T) {
+	first := NewWindow(NewHeadlessBackend(64, 64))
+	second := NewWindow(NewHeadlessBackend(64, 64))
+
+	done := false
+	for _, w := range []*Window{first, second} {
+		w := w
+		w.SetTargetFPS(0)
+		w.SetOnDemand(true)
+		w.SetLoop(func() {
+			if done {
+				w.Close()
+			}
+		})
+	}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		second.Post(func() { done = true })
+	}()
+
+	// Fail instead of waiting forever if the windows are never woken up
+	timeout := time.AfterFunc(5*time.Second, func() {
+		first.Post(func() {
+			if !done {
+				t.Error("expect a Post to the second window to wake the windows up")
+				done = true
+			}
+		})
+	})
+	defer timeout.Stop()
+
+	RunWindows(first, second)
+}
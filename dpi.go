@@ -0,0 +1,116 @@
+package cimgui
+
+// #include "backend.h"
+import "C"
+
+// DPIScaling configures how a window follows the content scale of its monitor.
+//
+// Where window coordinates are pixels (Windows, Linux), the style and the fonts
+// are scaled by the content scale. Where the framebuffer is already scaled
+// (macOS), only the fonts are rasterized at the content scale, to stay sharp.
+type DPIScaling struct {
+	// BuildFonts adds the fonts of the window to the cleared atlas, rasterized
+	// at scale, e.g. with a size of 13*scale pixels. When nil, the fonts are
+	// stretched with ImGuiIO.FontGlobalScale instead.
+	BuildFonts func(atlas ImFontAtlas, scale float32)
+	// ScaleViewports lets dear imgui scale the viewports moved to other
+	// monitors, with ImGuiConfigFlags_DpiEnableScaleViewports and DpiEnableScaleFonts.
+	ScaleViewports bool
+}
+
+// dpiState is what a window scaled by DPIScaling keeps between frames.
+type dpiState struct {
+	scaling      DPIScaling
+	baseStyle    C.ImGuiStyle
+	hasBaseStyle bool
+	contentScale float32
+	uiScale      float32
+}
+
+// SetDPIScaling makes the window scale its style and fonts to the content scale
+// of its monitor, starting with the next frame. The style at that time is the
+// unscaled one. A nil scaling restores the unscaled style.
+func (w *Window) SetDPIScaling(scaling *DPIScaling) {
+	w.Post(func() {
+		if scaling != nil {
+			if w.dpi == nil {
+				w.dpi = &dpiState{}
+			}
+			w.dpi.scaling = *scaling
+			w.dpi.contentScale = 0
+			return
+		}
+
+		if w.dpi != nil && w.dpi.hasBaseStyle {
+			*GetStyle().handle() = w.dpi.baseStyle
+			io := GetIO()
+			io.SetFontGlobalScale(1)
+			io.SetConfigFlags(io.GetConfigFlags() &^ (ImGuiConfigFlags_DpiEnableScaleViewports | ImGuiConfigFlags_DpiEnableScaleFonts))
+		}
+
+		w.dpi = nil
+	})
+}
+
+// ContentScale returns the content scale the window is scaled to, 1 without DPIScaling.
+func (w *Window) ContentScale() float32 {
+	if w.dpi == nil || w.dpi.contentScale == 0 {
+		return 1
+	}
+
+	return w.dpi.contentScale
+}
+
+// updateDPIScale scales the current context when the content scale of the window changed.
+func (w *Window) updateDPIScale() {
+	dpi := w.dpi
+	if dpi == nil {
+		return
+	}
+
+	contentScale, _ := w.backend.ContentScale()
+	if contentScale <= 0 {
+		contentScale = 1
+	}
+
+	if contentScale == dpi.contentScale {
+		return
+	}
+
+	// The framebuffer scale is 1 where window coordinates are pixels.
+	framebufferScale := float32(1)
+	if displayWidth, _ := w.backend.DisplaySize(); displayWidth > 0 {
+		framebufferWidth, _ := w.backend.FramebufferSize()
+		framebufferScale = float32(framebufferWidth) / float32(displayWidth)
+	}
+
+	dpi.contentScale = contentScale
+	dpi.uiScale = contentScale / framebufferScale
+
+	style := GetStyle()
+	if !dpi.hasBaseStyle {
+		dpi.baseStyle = *style.handle()
+		dpi.hasBaseStyle = true
+	}
+	*style.handle() = dpi.baseStyle
+	style.ScaleAllSizes(dpi.uiScale)
+
+	io := GetIO()
+	flags := io.GetConfigFlags() &^ (ImGuiConfigFlags_DpiEnableScaleViewports | ImGuiConfigFlags_DpiEnableScaleFonts)
+	if dpi.scaling.ScaleViewports {
+		flags |= ImGuiConfigFlags_DpiEnableScaleViewports | ImGuiConfigFlags_DpiEnableScaleFonts
+	}
+	io.SetConfigFlags(flags)
+
+	if dpi.scaling.BuildFonts == nil {
+		io.SetFontGlobalScale(dpi.uiScale)
+		return
+	}
+
+	atlas := io.GetFonts()
+	atlas.Clear()
+	dpi.scaling.BuildFonts(atlas, contentScale)
+	atlas.Build()
+	w.backend.ReloadFontsTexture()
+	io.SetFontGlobalScale(1 / framebufferScale)
+}
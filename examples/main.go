@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"runtime"
 
 	"github.com/AllenDang/cimgui-go"
 )
@@ -22,6 +23,11 @@ var (
 	window         cimgui.GLFWwindow
 )
 
+func init() {
+	// GLFW must be used from the main thread
+	runtime.LockOSThread()
+}
+
 func callback(data cimgui.ImGuiInputTextCallbackData) int {
 	fmt.Println("got call back")
 	return 0
@@ -29,21 +35,21 @@ func callback(data cimgui.ImGuiInputTextCallbackData) int {
 
 func loop() {
 	if showDemoWindow {
-		cimgui.ShowDemoWindow(&showDemoWindow)
+		cimgui.ShowDemoWindowV(&showDemoWindow)
 	}
 
-	cimgui.SetNextWindowSize(cimgui.NewImVec2(300, 300), cimgui.ImGuiCond_Once)
-	cimgui.Begin("Window 1", nil, 0)
-	if cimgui.Button("Click Me", cimgui.NewImVec2(80, 20)) {
+	cimgui.SetNextWindowSizeV(cimgui.NewImVec2(300, 300), cimgui.ImGuiCond_Once)
+	cimgui.Begin("Window 1")
+	if cimgui.ButtonV("Click Me", cimgui.NewImVec2(80, 20)) {
 		w, h := window.DisplaySize()
 		fmt.Println(w, h)
 	}
 	cimgui.TextUnformatted("Unformated text")
 	cimgui.Checkbox("Show demo window", &showDemoWindow)
-	if cimgui.BeginCombo("Combo", "Combo preview", cimgui.ImGuiComboFlags_HeightLarge) {
-		cimgui.Selectable_BoolPtr("Item 1", &selected, 0, cimgui.NewImVec2(100, 20))
-		cimgui.Selectable_Bool("Item 2", false, 0, cimgui.NewImVec2(100, 20))
-		cimgui.Selectable_Bool("Item 3", false, 0, cimgui.NewImVec2(100, 20))
+	if cimgui.BeginComboV("Combo", "Combo preview", cimgui.ImGuiComboFlags_HeightLarge) {
+		cimgui.Selectable_BoolPtrV("Item 1", &selected, 0, cimgui.NewImVec2(100, 20))
+		cimgui.Selectable_BoolV("Item 2", false, 0, cimgui.NewImVec2(100, 20))
+		cimgui.Selectable_BoolV("Item 3", false, 0, cimgui.NewImVec2(100, 20))
 		cimgui.EndCombo()
 	}
 
@@ -51,7 +57,7 @@ func loop() {
 		selected = true
 	}
 
-	cimgui.SameLine(0, 0)
+	cimgui.SameLineV(0, 0)
 
 	if cimgui.RadioButton_Bool("Radio button2", !selected) {
 		selected = false
@@ -59,10 +65,10 @@ func loop() {
 
 	cimgui.InputTextWithHint("Name", "write your name here", &content, 0, callback)
 	cimgui.Text(content)
-	cimgui.SliderInt("Slider int", &value3, 0, 100, "%d", 0)
-	cimgui.DragInt("Drag int", &value1, 1, 0, 100, "%d", 0)
-	cimgui.DragInt2("Drag int2", values, 1, 0, 100, "%d", 0)
-	cimgui.ColorEdit4("Color Edit3", color4, 0)
+	cimgui.SliderInt("Slider int", &value3, 0, 100)
+	cimgui.DragIntV("Drag int", &value1, 1, 0, 100, "%d", 0)
+	cimgui.DragInt2V("Drag int2", values, 1, 0, 100, "%d", 0)
+	cimgui.ColorEdit4("Color Edit3", color4)
 	cimgui.End()
 }
 
@@ -2,6 +2,14 @@ package cimgui
 
 // #include "cimgui_wrapper.h"
 import "C"
+import "math"
+
+// FLT_MIN and FLT_MAX are the limits of float32 dear imgui uses in arguments,
+// e.g. a -FLT_MIN width aligns an item to the right of the window.
+const (
+	FLT_MIN = 1.17549435082228750797e-38
+	FLT_MAX = math.MaxFloat32
+)
 
 type ImWchar C.uint
 type ImGuiID C.ImGuiID
@@ -43,6 +51,20 @@ func (vec *ImVec2) wrap() (out *C.ImVec2, finisher func()) {
 	return
 }
 
+// wrapImVec2Slice copies vecs into an array read by C during the call.
+func wrapImVec2Slice(vecs []ImVec2) *C.ImVec2 {
+	if len(vecs) == 0 {
+		return nil
+	}
+
+	out := make([]C.ImVec2, len(vecs))
+	for i, v := range vecs {
+		out[i] = v.toC()
+	}
+
+	return &out[0]
+}
+
 type ImVec4 struct {
 	X float32
 	Y float32
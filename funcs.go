@@ -6,1130 +6,1240 @@ package cimgui
 import "C"
 import "unsafe"
 
-func IsMouseHoveringRect(r_min ImVec2, r_max ImVec2, clip bool) bool {
-	return C.IsMouseHoveringRect(r_min.toC(), r_max.toC(), C.bool(clip)) == C.bool(true)
+// CreateContextV parameter default value hint:
+// shared_font_atlas: NULL
+func CreateContextV(shared_font_atlas ImFontAtlas) ImGuiContext {
+	return (ImGuiContext)(unsafe.Pointer(C.CreateContext(shared_font_atlas.handle())))
 }
 
-func GetFont() ImFont {
-	return (ImFont)(unsafe.Pointer(C.GetFont()))
+func CreateContext() ImGuiContext {
+	return CreateContextV(0)
 }
 
-func SetDrawCursorPosX(local_x float32) {
-	C.SetDrawCursorPosX(C.float(local_x))
+// DestroyContextV parameter default value hint:
+// ctx: NULL
+func DestroyContextV(ctx ImGuiContext) {
+	C.DestroyContext(ctx.handle())
 }
 
-func SetWindowSize_Vec2(size ImVec2, cond ImGuiCond) {
-	C.SetWindowSize_Vec2(size.toC(), C.ImGuiCond(cond))
+func DestroyContext() {
+	DestroyContextV(0)
 }
 
-func SetWindowSize_Str(name string, size ImVec2, cond ImGuiCond) {
-	nameArg, nameFin := wrapString(name)
-	defer nameFin()
-
-	C.SetWindowSize_Str(nameArg, size.toC(), C.ImGuiCond(cond))
+func GetCurrentContext() ImGuiContext {
+	return (ImGuiContext)(unsafe.Pointer(C.GetCurrentContext()))
 }
 
-func (self ImGuiTableColumnSettings) Destroy() {
-	C.TableColumnSettings_Destroy(self.handle())
+func SetCurrentContext(ctx ImGuiContext) {
+	C.SetCurrentContext(ctx.handle())
 }
 
-func SliderAngle(label string, v_rad *float32, v_degrees_min float32, v_degrees_max float32, format string, flags ImGuiSliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	v_radArg, v_radFin := wrapFloat(v_rad)
-	defer v_radFin()
-
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
-
-	return C.SliderAngle(labelArg, v_radArg, C.float(v_degrees_min), C.float(v_degrees_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+func GetIO() ImGuiIO {
+	return (ImGuiIO)(unsafe.Pointer(C.GetIO()))
 }
 
-func (self ImFontAtlas) GetCustomRectByIndex(index int32) ImFontAtlasCustomRect {
-	return (ImFontAtlasCustomRect)(unsafe.Pointer(C.FontAtlas_GetCustomRectByIndex(self.handle(), C.int(index))))
+func GetStyle() ImGuiStyle {
+	return (ImGuiStyle)(unsafe.Pointer(C.GetStyle()))
 }
 
-func BeginMenu(label string, enabled bool) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	return C.BeginMenu(labelArg, C.bool(enabled)) == C.bool(true)
+func NewFrame() {
+	C.NewFrame()
 }
 
-func (self ImGuiTableSortSpecs) Destroy() {
-	C.TableSortSpecs_Destroy(self.handle())
+func EndFrame() {
+	C.EndFrame()
 }
 
-func FindViewportByPlatformHandle(platform_handle unsafe.Pointer) ImGuiViewport {
-	return (ImGuiViewport)(unsafe.Pointer(C.FindViewportByPlatformHandle(platform_handle)))
+func Render() {
+	C.Render()
 }
 
-func (self ImDrawList) PrimWriteVtx(pos ImVec2, uv ImVec2, col uint32) {
-	C.DrawList_PrimWriteVtx(self.handle(), pos.toC(), uv.toC(), C.ImU32(col))
+func GetDrawData() ImDrawData {
+	return (ImDrawData)(unsafe.Pointer(C.GetDrawData()))
 }
 
-func (self ImGuiTabBar) Destroy() {
-	C.TabBar_Destroy(self.handle())
-}
+// ShowDemoWindowV parameter default value hint:
+// p_open: NULL
+func ShowDemoWindowV(p_open *bool) {
+	p_openArg, p_openFin := wrapBool(p_open)
+	defer p_openFin()
 
-func IsItemHovered(flags ImGuiHoveredFlags) bool {
-	return C.IsItemHovered(C.ImGuiHoveredFlags(flags)) == C.bool(true)
+	C.ShowDemoWindow(p_openArg)
 }
 
-func SliderInt4(label string, v [4]*int32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	vArg := make([]C.int, len(v))
-	for i, vV := range v {
-		vArg[i] = C.int(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = int32(vV)
-		}
-	}()
+func ShowDemoWindow() {
+	ShowDemoWindowV(nil)
+}
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+// ShowMetricsWindowV parameter default value hint:
+// p_open: NULL
+func ShowMetricsWindowV(p_open *bool) {
+	p_openArg, p_openFin := wrapBool(p_open)
+	defer p_openFin()
 
-	return C.SliderInt4(labelArg, (*C.int)(&vArg[0]), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	C.ShowMetricsWindow(p_openArg)
 }
 
-func GetDrawCursorPosY() float32 {
-	return float32(C.GetDrawCursorPosY())
+func ShowMetricsWindow() {
+	ShowMetricsWindowV(nil)
 }
 
-func GetFontTexUvWhitePixel(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
-
-	C.GetFontTexUvWhitePixel(pOutArg)
-}
+// ShowDebugLogWindowV parameter default value hint:
+// p_open: NULL
+func ShowDebugLogWindowV(p_open *bool) {
+	p_openArg, p_openFin := wrapBool(p_open)
+	defer p_openFin()
 
-func (self ImFontAtlas) GetGlyphRangesJapanese() *ImWchar {
-	return (*ImWchar)(C.FontAtlas_GetGlyphRangesJapanese(self.handle()))
+	C.ShowDebugLogWindow(p_openArg)
 }
 
-func NewTableSortSpecs() ImGuiTableSortSpecs {
-	return (ImGuiTableSortSpecs)(unsafe.Pointer(C.TableSortSpecs_ImGuiTableSortSpecs()))
+func ShowDebugLogWindow() {
+	ShowDebugLogWindowV(nil)
 }
 
-func ShowStackToolWindow(p_open *bool) {
+// ShowStackToolWindowV parameter default value hint:
+// p_open: NULL
+func ShowStackToolWindowV(p_open *bool) {
 	p_openArg, p_openFin := wrapBool(p_open)
 	defer p_openFin()
 
 	C.ShowStackToolWindow(p_openArg)
 }
 
-func (self ImDrawList) AddRectFilled(p_min ImVec2, p_max ImVec2, col uint32, rounding float32, flags ImDrawFlags) {
-	C.DrawList_AddRectFilled(self.handle(), p_min.toC(), p_max.toC(), C.ImU32(col), C.float(rounding), C.ImDrawFlags(flags))
+func ShowStackToolWindow() {
+	ShowStackToolWindowV(nil)
 }
 
-func (self ImDrawList) PrimRect(a ImVec2, b ImVec2, col uint32) {
-	C.DrawList_PrimRect(self.handle(), a.toC(), b.toC(), C.ImU32(col))
-}
+// ShowAboutWindowV parameter default value hint:
+// p_open: NULL
+func ShowAboutWindowV(p_open *bool) {
+	p_openArg, p_openFin := wrapBool(p_open)
+	defer p_openFin()
 
-func IsWindowFocused(flags ImGuiFocusedFlags) bool {
-	return C.IsWindowFocused(C.ImGuiFocusedFlags(flags)) == C.bool(true)
+	C.ShowAboutWindow(p_openArg)
 }
 
-func EndTooltip() {
-	C.EndTooltip()
+func ShowAboutWindow() {
+	ShowAboutWindowV(nil)
 }
 
-func (self ImGuiInputTextCallbackData) DeleteChars(pos int32, bytes_count int32) {
-	C.InputTextCallbackData_DeleteChars(self.handle(), C.int(pos), C.int(bytes_count))
+// ShowStyleEditorV parameter default value hint:
+// ref: NULL
+func ShowStyleEditorV(ref ImGuiStyle) {
+	C.ShowStyleEditor(ref.handle())
 }
 
-func (self *ImVec2) Destroy() {
-	selfArg, selfFin := self.wrap()
-	defer selfFin()
-
-	C.Vec2_Destroy(selfArg)
+func ShowStyleEditor() {
+	ShowStyleEditorV(0)
 }
 
-func SliderFloat4(label string, v [4]*float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
+func ShowStyleSelector(label string) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg := make([]C.float, len(v))
-	for i, vV := range v {
-		vArg[i] = C.float(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = float32(vV)
-		}
-	}()
+	return C.ShowStyleSelector(labelArg) == C.bool(true)
+}
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+func ShowFontSelector(label string) {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	return C.SliderFloat4(labelArg, (*C.float)(&vArg[0]), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	C.ShowFontSelector(labelArg)
 }
 
-func (self ImDrawList) AddQuad(p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, col uint32, thickness float32) {
-	C.DrawList_AddQuad(self.handle(), p1.toC(), p2.toC(), p3.toC(), p4.toC(), C.ImU32(col), C.float(thickness))
+func ShowUserGuide() {
+	C.ShowUserGuide()
 }
 
-func Indent(indent_w float32) {
-	C.Indent(C.float(indent_w))
+func GetVersion() string {
+	return C.GoString(C.GetVersion())
 }
 
-func SetNextWindowViewport(viewport_id ImGuiID) {
-	C.SetNextWindowViewport(C.ImGuiID(viewport_id))
+// StyleColorsDarkV parameter default value hint:
+// dst: NULL
+func StyleColorsDarkV(dst ImGuiStyle) {
+	C.StyleColorsDark(dst.handle())
 }
 
-func BeginPopupContextItem(str_id string, popup_flags ImGuiPopupFlags) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
-
-	return C.BeginPopupContextItem(str_idArg, C.ImGuiPopupFlags(popup_flags)) == C.bool(true)
+func StyleColorsDark() {
+	StyleColorsDarkV(0)
 }
 
-func GetIO() ImGuiIO {
-	return (ImGuiIO)(unsafe.Pointer(C.GetIO()))
+// StyleColorsLightV parameter default value hint:
+// dst: NULL
+func StyleColorsLightV(dst ImGuiStyle) {
+	C.StyleColorsLight(dst.handle())
 }
 
-func (self ImGuiListClipper) ForceDisplayRangeByIndices(item_min int32, item_max int32) {
-	C.ListClipper_ForceDisplayRangeByIndices(self.handle(), C.int(item_min), C.int(item_max))
+func StyleColorsLight() {
+	StyleColorsLightV(0)
 }
 
-func (self ImGuiStackLevelInfo) Destroy() {
-	C.StackLevelInfo_Destroy(self.handle())
+// StyleColorsClassicV parameter default value hint:
+// dst: NULL
+func StyleColorsClassicV(dst ImGuiStyle) {
+	C.StyleColorsClassic(dst.handle())
 }
 
-func (self ImGuiNextItemData) Destroy() {
-	C.NextItemData_Destroy(self.handle())
+func StyleColorsClassic() {
+	StyleColorsClassicV(0)
 }
 
-func IsMousePosValid(mouse_pos *ImVec2) bool {
-	mouse_posArg, mouse_posFin := mouse_pos.wrap()
-	defer mouse_posFin()
+// BeginV parameter default value hint:
+// p_open: NULL
+// flags: 0
+func BeginV(name string, p_open *bool, flags ImGuiWindowFlags) bool {
+	nameArg, nameFin := wrapString(name)
+	defer nameFin()
 
-	return C.IsMousePosValid(mouse_posArg) == C.bool(true)
+	p_openArg, p_openFin := wrapBool(p_open)
+	defer p_openFin()
+
+	return C.Begin(nameArg, p_openArg, C.ImGuiWindowFlags(flags)) == C.bool(true)
 }
 
-func (self ImFontConfig) Destroy() {
-	C.FontConfig_Destroy(self.handle())
+func Begin(name string) bool {
+	return BeginV(name, nil, 0)
 }
 
-func (self ImDrawList) AddRect(p_min ImVec2, p_max ImVec2, col uint32, rounding float32, flags ImDrawFlags, thickness float32) {
-	C.DrawList_AddRect(self.handle(), p_min.toC(), p_max.toC(), C.ImU32(col), C.float(rounding), C.ImDrawFlags(flags), C.float(thickness))
+func End() {
+	C.End()
 }
 
-func (self ImDrawList) ChannelsMerge() {
-	C.DrawList_ChannelsMerge(self.handle())
+// BeginChild_StrV parameter default value hint:
+// size: ImVec2(0,0)
+// border: false
+// flags: 0
+func BeginChild_StrV(str_id string, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
+
+	return C.BeginChild_Str(str_idArg, size.toC(), C.bool(border), C.ImGuiWindowFlags(flags)) == C.bool(true)
 }
 
-func TextUnformatted(text string) {
-	textArg, textFin := wrapString(text)
-	defer textFin()
+func BeginChild_Str(str_id string) bool {
+	return BeginChild_StrV(str_id, NewImVec2(0, 0), false, 0)
+}
 
-	C.TextUnformatted(textArg)
+// BeginChild_IDV parameter default value hint:
+// size: ImVec2(0,0)
+// border: false
+// flags: 0
+func BeginChild_IDV(id ImGuiID, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
+	return C.BeginChild_ID(C.ImGuiID(id), size.toC(), C.bool(border), C.ImGuiWindowFlags(flags)) == C.bool(true)
 }
 
-func (self ImFontAtlas) ClearTexData() {
-	C.FontAtlas_ClearTexData(self.handle())
+func BeginChild_ID(id ImGuiID) bool {
+	return BeginChild_IDV(id, NewImVec2(0, 0), false, 0)
 }
 
-func TableNextRow(row_flags ImGuiTableRowFlags, min_row_height float32) {
-	C.TableNextRow(C.ImGuiTableRowFlags(row_flags), C.float(min_row_height))
+func EndChild() {
+	C.EndChild()
 }
 
-func (self ImDrawList) PathRect(rect_min ImVec2, rect_max ImVec2, rounding float32, flags ImDrawFlags) {
-	C.DrawList_PathRect(self.handle(), rect_min.toC(), rect_max.toC(), C.float(rounding), C.ImDrawFlags(flags))
+func IsWindowAppearing() bool {
+	return C.IsWindowAppearing() == C.bool(true)
 }
 
-func (self ImGuiTextBuffer) Size() int {
-	return int(C.TextBuffer_Size(self.handle()))
+func IsWindowCollapsed() bool {
+	return C.IsWindowCollapsed() == C.bool(true)
 }
 
-func SetDrawCursorScreenPos(pos ImVec2) {
-	C.SetDrawCursorScreenPos(pos.toC())
+// IsWindowFocusedV parameter default value hint:
+// flags: 0
+func IsWindowFocusedV(flags ImGuiFocusedFlags) bool {
+	return C.IsWindowFocused(C.ImGuiFocusedFlags(flags)) == C.bool(true)
 }
 
-func CheckboxFlags_IntPtr(label string, flags *int32, flags_value int32) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func IsWindowFocused() bool {
+	return IsWindowFocusedV(0)
+}
 
-	flagsArg, flagsFin := wrapInt32(flags)
-	defer flagsFin()
+// IsWindowHoveredV parameter default value hint:
+// flags: 0
+func IsWindowHoveredV(flags ImGuiHoveredFlags) bool {
+	return C.IsWindowHovered(C.ImGuiHoveredFlags(flags)) == C.bool(true)
+}
 
-	return C.CheckboxFlags_IntPtr(labelArg, flagsArg, C.int(flags_value)) == C.bool(true)
+func IsWindowHovered() bool {
+	return IsWindowHoveredV(0)
 }
 
-func IsRectVisible_Nil(size ImVec2) bool {
-	return C.IsRectVisible_Nil(size.toC()) == C.bool(true)
+func GetWindowDrawList() ImDrawList {
+	return (ImDrawList)(unsafe.Pointer(C.GetWindowDrawList()))
 }
 
-func IsRectVisible_Vec2(rect_min ImVec2, rect_max ImVec2) bool {
-	return C.IsRectVisible_Vec2(rect_min.toC(), rect_max.toC()) == C.bool(true)
+func GetWindowDpiScale() float32 {
+	return float32(C.GetWindowDpiScale())
 }
 
-func GetID_Str(str_id string) ImGuiID {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+func GetWindowPos(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
 
-	return ImGuiID(C.GetID_Str(str_idArg))
+	C.GetWindowPos(pOutArg)
 }
 
-func GetID_StrStr(str_id_begin string, str_id_end string) ImGuiID {
-	str_id_beginArg, str_id_beginFin := wrapString(str_id_begin)
-	defer str_id_beginFin()
+func GetWindowSize(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
 
-	str_id_endArg, str_id_endFin := wrapString(str_id_end)
-	defer str_id_endFin()
+	C.GetWindowSize(pOutArg)
+}
 
-	return ImGuiID(C.GetID_StrStr(str_id_beginArg, str_id_endArg))
+func GetWindowWidth() float32 {
+	return float32(C.GetWindowWidth())
 }
 
-func GetID_Ptr(ptr_id unsafe.Pointer) ImGuiID {
-	return ImGuiID(C.GetID_Ptr(ptr_id))
+func GetWindowHeight() float32 {
+	return float32(C.GetWindowHeight())
 }
 
-func SetClipboardText(text string) {
-	textArg, textFin := wrapString(text)
-	defer textFin()
+func GetWindowViewport() ImGuiViewport {
+	return (ImGuiViewport)(unsafe.Pointer(C.GetWindowViewport()))
+}
 
-	C.SetClipboardText(textArg)
+// SetNextWindowPosV parameter default value hint:
+// cond: 0
+// pivot: ImVec2(0,0)
+func SetNextWindowPosV(pos ImVec2, cond ImGuiCond, pivot ImVec2) {
+	C.SetNextWindowPos(pos.toC(), C.ImGuiCond(cond), pivot.toC())
 }
 
-func TextColored(col ImVec4, fmt string) {
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
+func SetNextWindowPos(pos ImVec2) {
+	SetNextWindowPosV(pos, 0, NewImVec2(0, 0))
+}
 
-	C.TextColored(col.toC(), fmtArg)
+// SetNextWindowSizeV parameter default value hint:
+// cond: 0
+func SetNextWindowSizeV(size ImVec2, cond ImGuiCond) {
+	C.SetNextWindowSize(size.toC(), C.ImGuiCond(cond))
 }
 
-func (self ImGuiInputTextCallbackData) InsertChars(pos int32, text string) {
-	textArg, textFin := wrapString(text)
-	defer textFin()
+func SetNextWindowSize(size ImVec2) {
+	SetNextWindowSizeV(size, 0)
+}
 
-	C.InputTextCallbackData_InsertChars(self.handle(), C.int(pos), textArg)
+// SetNextWindowSizeConstraintsV parameter default value hint:
+// custom_callback: NULL
+func SetNextWindowSizeConstraintsV(size_min ImVec2, size_max ImVec2, custom_callback ImGuiSizeCallback) {
+	custom_callbackArg := retainSizeCallback(custom_callback)
+
+	C.SetNextWindowSizeConstraints(size_min.toC(), size_max.toC(), custom_callbackArg)
 }
 
-func GetDrawCursorPosX() float32 {
-	return float32(C.GetDrawCursorPosX())
+func SetNextWindowSizeConstraints(size_min ImVec2, size_max ImVec2) {
+	SetNextWindowSizeConstraintsV(size_min, size_max, nil)
 }
 
-func NewListClipper() ImGuiListClipper {
-	return (ImGuiListClipper)(unsafe.Pointer(C.ListClipper_ImGuiListClipper()))
+func SetNextWindowContentSize(size ImVec2) {
+	C.SetNextWindowContentSize(size.toC())
 }
 
-func (self ImGuiPayload) IsPreview() bool {
-	return C.Payload_IsPreview(self.handle()) == C.bool(true)
+// SetNextWindowCollapsedV parameter default value hint:
+// cond: 0
+func SetNextWindowCollapsedV(collapsed bool, cond ImGuiCond) {
+	C.SetNextWindowCollapsed(C.bool(collapsed), C.ImGuiCond(cond))
 }
 
-func (self ImGuiOnceUponAFrame) Destroy() {
-	C.OnceUponAFrame_Destroy(self.handle())
+func SetNextWindowCollapsed(collapsed bool) {
+	SetNextWindowCollapsedV(collapsed, 0)
 }
 
-func PushTextWrapPos(wrap_local_pos_x float32) {
-	C.PushTextWrapPos(C.float(wrap_local_pos_x))
+func SetNextWindowFocus() {
+	C.SetNextWindowFocus()
 }
 
-func SetDrawCursorPos(local_pos ImVec2) {
-	C.SetDrawCursorPos(local_pos.toC())
+func SetNextWindowBgAlpha(alpha float32) {
+	C.SetNextWindowBgAlpha(C.float(alpha))
 }
 
-func (self ImGuiIO) AddInputCharacter(c uint32) {
-	C.IO_AddInputCharacter(self.handle(), C.uint(c))
+func SetNextWindowViewport(viewport_id ImGuiID) {
+	C.SetNextWindowViewport(C.ImGuiID(viewport_id))
 }
 
-func (self ImGuiIO) AddMouseWheelEvent(wh_x float32, wh_y float32) {
-	C.IO_AddMouseWheelEvent(self.handle(), C.float(wh_x), C.float(wh_y))
+// SetWindowPos_Vec2V parameter default value hint:
+// cond: 0
+func SetWindowPos_Vec2V(pos ImVec2, cond ImGuiCond) {
+	C.SetWindowPos_Vec2(pos.toC(), C.ImGuiCond(cond))
 }
 
-func PopTextWrapPos() {
-	C.PopTextWrapPos()
+func SetWindowPos_Vec2(pos ImVec2) {
+	SetWindowPos_Vec2V(pos, 0)
 }
 
-func Spacing() {
-	C.Spacing()
+// SetWindowSize_Vec2V parameter default value hint:
+// cond: 0
+func SetWindowSize_Vec2V(size ImVec2, cond ImGuiCond) {
+	C.SetWindowSize_Vec2(size.toC(), C.ImGuiCond(cond))
 }
 
-func TableGetRowIndex() int {
-	return int(C.TableGetRowIndex())
+func SetWindowSize_Vec2(size ImVec2) {
+	SetWindowSize_Vec2V(size, 0)
 }
 
-func TableSetColumnEnabled(column_n int32, v bool) {
-	C.TableSetColumnEnabled(C.int(column_n), C.bool(v))
+// SetWindowCollapsed_BoolV parameter default value hint:
+// cond: 0
+func SetWindowCollapsed_BoolV(collapsed bool, cond ImGuiCond) {
+	C.SetWindowCollapsed_Bool(C.bool(collapsed), C.ImGuiCond(cond))
 }
 
-func (self ImDrawList) AddTriangle(p1 ImVec2, p2 ImVec2, p3 ImVec2, col uint32, thickness float32) {
-	C.DrawList_AddTriangle(self.handle(), p1.toC(), p2.toC(), p3.toC(), C.ImU32(col), C.float(thickness))
+func SetWindowCollapsed_Bool(collapsed bool) {
+	SetWindowCollapsed_BoolV(collapsed, 0)
 }
 
-func (self ImGuiTextFilter) Clear() {
-	C.TextFilter_Clear(self.handle())
+func SetWindowFocus_Nil() {
+	C.SetWindowFocus_Nil()
 }
 
-func SetTabItemClosed(tab_or_docked_window_label string) {
-	tab_or_docked_window_labelArg, tab_or_docked_window_labelFin := wrapString(tab_or_docked_window_label)
-	defer tab_or_docked_window_labelFin()
-
-	C.SetTabItemClosed(tab_or_docked_window_labelArg)
+func SetWindowFontScale(scale float32) {
+	C.SetWindowFontScale(C.float(scale))
 }
 
-func IsMouseClicked(button ImGuiMouseButton, repeat bool) bool {
-	return C.IsMouseClicked(C.ImGuiMouseButton(button), C.bool(repeat)) == C.bool(true)
+// SetWindowPos_StrV parameter default value hint:
+// cond: 0
+func SetWindowPos_StrV(name string, pos ImVec2, cond ImGuiCond) {
+	nameArg, nameFin := wrapString(name)
+	defer nameFin()
+
+	C.SetWindowPos_Str(nameArg, pos.toC(), C.ImGuiCond(cond))
 }
 
-func SetWindowPos_Vec2(pos ImVec2, cond ImGuiCond) {
-	C.SetWindowPos_Vec2(pos.toC(), C.ImGuiCond(cond))
+func SetWindowPos_Str(name string, pos ImVec2) {
+	SetWindowPos_StrV(name, pos, 0)
 }
 
-func SetWindowPos_Str(name string, pos ImVec2, cond ImGuiCond) {
+// SetWindowSize_StrV parameter default value hint:
+// cond: 0
+func SetWindowSize_StrV(name string, size ImVec2, cond ImGuiCond) {
 	nameArg, nameFin := wrapString(name)
 	defer nameFin()
 
-	C.SetWindowPos_Str(nameArg, pos.toC(), C.ImGuiCond(cond))
+	C.SetWindowSize_Str(nameArg, size.toC(), C.ImGuiCond(cond))
 }
 
-func Button(label string, size ImVec2) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	return C.Button(labelArg, size.toC()) == C.bool(true)
+func SetWindowSize_Str(name string, size ImVec2) {
+	SetWindowSize_StrV(name, size, 0)
 }
 
-func EndDragDropTarget() {
-	C.EndDragDropTarget()
+// SetWindowCollapsed_StrV parameter default value hint:
+// cond: 0
+func SetWindowCollapsed_StrV(name string, collapsed bool, cond ImGuiCond) {
+	nameArg, nameFin := wrapString(name)
+	defer nameFin()
+
+	C.SetWindowCollapsed_Str(nameArg, C.bool(collapsed), C.ImGuiCond(cond))
 }
 
-func (self ImGuiWindowClass) Destroy() {
-	C.WindowClass_Destroy(self.handle())
+func SetWindowCollapsed_Str(name string, collapsed bool) {
+	SetWindowCollapsed_StrV(name, collapsed, 0)
 }
 
-func Columns(count int32, id string, border bool) {
-	idArg, idFin := wrapString(id)
-	defer idFin()
+func SetWindowFocus_Str(name string) {
+	nameArg, nameFin := wrapString(name)
+	defer nameFin()
 
-	C.Columns(C.int(count), idArg, C.bool(border))
+	C.SetWindowFocus_Str(nameArg)
 }
 
-func EndFrame() {
-	C.EndFrame()
-}
+func GetContentRegionAvail(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
 
-func (self ImDrawData) DeIndexAllBuffers() {
-	C.DrawData_DeIndexAllBuffers(self.handle())
+	C.GetContentRegionAvail(pOutArg)
 }
 
-func (self ImGuiStyle) ScaleAllSizes(scale_factor float32) {
-	C.Style_ScaleAllSizes(self.handle(), C.float(scale_factor))
-}
+func GetContentRegionMax(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
 
-func Checkbox(label string, v *bool) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+	C.GetContentRegionMax(pOutArg)
+}
 
-	vArg, vFin := wrapBool(v)
-	defer vFin()
+func GetWindowContentRegionMin(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
 
-	return C.Checkbox(labelArg, vArg) == C.bool(true)
+	C.GetWindowContentRegionMin(pOutArg)
 }
 
-func (self ImGuiNextWindowData) Destroy() {
-	C.NextWindowData_Destroy(self.handle())
-}
+func GetWindowContentRegionMax(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
 
-func NewPlatformImeData() ImGuiPlatformImeData {
-	return (ImGuiPlatformImeData)(unsafe.Pointer(C.PlatformImeData_ImGuiPlatformImeData()))
+	C.GetWindowContentRegionMax(pOutArg)
 }
 
-func PopFont() {
-	C.PopFont()
+func GetScrollX() float32 {
+	return float32(C.GetScrollX())
 }
 
-func TableGetColumnFlags(column_n int32) ImGuiTableColumnFlags {
-	return ImGuiTableColumnFlags(C.TableGetColumnFlags(C.int(column_n)))
+func GetScrollY() float32 {
+	return float32(C.GetScrollY())
 }
 
-func (self ImDrawList) AddCircleFilled(center ImVec2, radius float32, col uint32, num_segments int32) {
-	C.DrawList_AddCircleFilled(self.handle(), center.toC(), C.float(radius), C.ImU32(col), C.int(num_segments))
+func SetScrollX_Float(scroll_x float32) {
+	C.SetScrollX_Float(C.float(scroll_x))
 }
 
-func GetTime() float64 {
-	return float64(C.GetTime())
+func SetScrollY_Float(scroll_y float32) {
+	C.SetScrollY_Float(C.float(scroll_y))
 }
 
-func NewStyle() ImGuiStyle {
-	return (ImGuiStyle)(unsafe.Pointer(C.Style_ImGuiStyle()))
+func GetScrollMaxX() float32 {
+	return float32(C.GetScrollMaxX())
 }
 
-func (self ImFontAtlas) GetTexDataAsAlpha8(out_pixels *C.uchar, out_width *int32, out_height *int32, out_bytes_per_pixel *int32) {
-	out_widthArg, out_widthFin := wrapInt32(out_width)
-	defer out_widthFin()
+func GetScrollMaxY() float32 {
+	return float32(C.GetScrollMaxY())
+}
 
-	out_heightArg, out_heightFin := wrapInt32(out_height)
-	defer out_heightFin()
+// SetScrollHereXV parameter default value hint:
+// center_x_ratio: 0.5f
+func SetScrollHereXV(center_x_ratio float32) {
+	C.SetScrollHereX(C.float(center_x_ratio))
+}
 
-	out_bytes_per_pixelArg, out_bytes_per_pixelFin := wrapInt32(out_bytes_per_pixel)
-	defer out_bytes_per_pixelFin()
+func SetScrollHereX() {
+	SetScrollHereXV(0.5)
+}
 
-	C.FontAtlas_GetTexDataAsAlpha8(self.handle(), &out_pixels, out_widthArg, out_heightArg, out_bytes_per_pixelArg)
+// SetScrollHereYV parameter default value hint:
+// center_y_ratio: 0.5f
+func SetScrollHereYV(center_y_ratio float32) {
+	C.SetScrollHereY(C.float(center_y_ratio))
 }
 
-func (self ImGuiPayload) IsDataType(typeArg string) bool {
-	typeArgArg, typeArgFin := wrapString(typeArg)
-	defer typeArgFin()
+func SetScrollHereY() {
+	SetScrollHereYV(0.5)
+}
 
-	return C.Payload_IsDataType(self.handle(), typeArgArg) == C.bool(true)
+// SetScrollFromPosX_FloatV parameter default value hint:
+// center_x_ratio: 0.5f
+func SetScrollFromPosX_FloatV(local_x float32, center_x_ratio float32) {
+	C.SetScrollFromPosX_Float(C.float(local_x), C.float(center_x_ratio))
 }
 
-func TableGetColumnName_Int(column_n int32) string {
-	return C.GoString(C.TableGetColumnName_Int(C.int(column_n)))
+func SetScrollFromPosX_Float(local_x float32) {
+	SetScrollFromPosX_FloatV(local_x, 0.5)
 }
 
-func EndDisabled() {
-	C.EndDisabled()
+// SetScrollFromPosY_FloatV parameter default value hint:
+// center_y_ratio: 0.5f
+func SetScrollFromPosY_FloatV(local_y float32, center_y_ratio float32) {
+	C.SetScrollFromPosY_Float(C.float(local_y), C.float(center_y_ratio))
 }
 
-func ShowStyleEditor(ref ImGuiStyle) {
-	C.ShowStyleEditor(ref.handle())
+func SetScrollFromPosY_Float(local_y float32) {
+	SetScrollFromPosY_FloatV(local_y, 0.5)
 }
 
-func (self ImFontGlyphRangesBuilder) Clear() {
-	C.FontGlyphRangesBuilder_Clear(self.handle())
+func PushFont(font ImFont) {
+	C.PushFont(font.handle())
 }
 
-func FindViewportByID(id ImGuiID) ImGuiViewport {
-	return (ImGuiViewport)(unsafe.Pointer(C.FindViewportByID(C.ImGuiID(id))))
+func PopFont() {
+	C.PopFont()
 }
 
-func RenderPlatformWindowsDefault(platform_render_arg unsafe.Pointer, renderer_render_arg unsafe.Pointer) {
-	C.RenderPlatformWindowsDefault(platform_render_arg, renderer_render_arg)
+func PushStyleColor_U32(idx ImGuiCol, col uint32) {
+	C.PushStyleColor_U32(C.ImGuiCol(idx), C.ImU32(col))
 }
 
-func BeginCombo(label string, preview_value string, flags ImGuiComboFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func PushStyleColor_Vec4(idx ImGuiCol, col ImVec4) {
+	C.PushStyleColor_Vec4(C.ImGuiCol(idx), col.toC())
+}
 
-	preview_valueArg, preview_valueFin := wrapString(preview_value)
-	defer preview_valueFin()
+// PopStyleColorV parameter default value hint:
+// count: 1
+func PopStyleColorV(count int32) {
+	C.PopStyleColor(C.int(count))
+}
 
-	return C.BeginCombo(labelArg, preview_valueArg, C.ImGuiComboFlags(flags)) == C.bool(true)
+func PopStyleColor() {
+	PopStyleColorV(1)
 }
 
-func Combo_Str(label string, current_item *int32, items_separated_by_zeros string, popup_max_height_in_items int32) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func PushStyleVar_Float(idx ImGuiStyleVar, val float32) {
+	C.PushStyleVar_Float(C.ImGuiStyleVar(idx), C.float(val))
+}
 
-	current_itemArg, current_itemFin := wrapInt32(current_item)
-	defer current_itemFin()
+func PushStyleVar_Vec2(idx ImGuiStyleVar, val ImVec2) {
+	C.PushStyleVar_Vec2(C.ImGuiStyleVar(idx), val.toC())
+}
 
-	items_separated_by_zerosArg, items_separated_by_zerosFin := wrapString(items_separated_by_zeros)
-	defer items_separated_by_zerosFin()
+// PopStyleVarV parameter default value hint:
+// count: 1
+func PopStyleVarV(count int32) {
+	C.PopStyleVar(C.int(count))
+}
 
-	return C.Combo_Str(labelArg, current_itemArg, items_separated_by_zerosArg, C.int(popup_max_height_in_items)) == C.bool(true)
+func PopStyleVar() {
+	PopStyleVarV(1)
 }
 
-func ColorConvertHSVtoRGB(h float32, s float32, v float32, out_r *float32, out_g *float32, out_b *float32) {
-	out_rArg, out_rFin := wrapFloat(out_r)
-	defer out_rFin()
+func PushAllowKeyboardFocus(allow_keyboard_focus bool) {
+	C.PushAllowKeyboardFocus(C.bool(allow_keyboard_focus))
+}
 
-	out_gArg, out_gFin := wrapFloat(out_g)
-	defer out_gFin()
+func PopAllowKeyboardFocus() {
+	C.PopAllowKeyboardFocus()
+}
 
-	out_bArg, out_bFin := wrapFloat(out_b)
-	defer out_bFin()
+func PushButtonRepeat(repeat bool) {
+	C.PushButtonRepeat(C.bool(repeat))
+}
 
-	C.ColorConvertHSVtoRGB(C.float(h), C.float(s), C.float(v), out_rArg, out_gArg, out_bArg)
+func PopButtonRepeat() {
+	C.PopButtonRepeat()
 }
 
-func GetDrawCursorScreenPos(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+func PushItemWidth(item_width float32) {
+	C.PushItemWidth(C.float(item_width))
+}
 
-	C.GetDrawCursorScreenPos(pOutArg)
+func PopItemWidth() {
+	C.PopItemWidth()
 }
 
-func StyleColorsDark(dst ImGuiStyle) {
-	C.StyleColorsDark(dst.handle())
+func SetNextItemWidth(item_width float32) {
+	C.SetNextItemWidth(C.float(item_width))
 }
 
-func EndListBox() {
-	C.EndListBox()
+func CalcItemWidth() float32 {
+	return float32(C.CalcItemWidth())
 }
 
-func (self ImFontAtlas) AddFontFromFileTTF(filename string, size_pixels float32, font_cfg ImFontConfig, glyph_ranges *ImWchar) ImFont {
-	filenameArg, filenameFin := wrapString(filename)
-	defer filenameFin()
+// PushTextWrapPosV parameter default value hint:
+// wrap_local_pos_x: 0.0f
+func PushTextWrapPosV(wrap_local_pos_x float32) {
+	C.PushTextWrapPos(C.float(wrap_local_pos_x))
+}
 
-	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFontFromFileTTF(self.handle(), filenameArg, C.float(size_pixels), font_cfg.handle(), (*C.ImWchar)(glyph_ranges))))
+func PushTextWrapPos() {
+	PushTextWrapPosV(0.0)
 }
 
-func (self ImFontAtlas) ClearFonts() {
-	C.FontAtlas_ClearFonts(self.handle())
+func PopTextWrapPos() {
+	C.PopTextWrapPos()
 }
 
-func (self ImGuiIO) AddMouseViewportEvent(id ImGuiID) {
-	C.IO_AddMouseViewportEvent(self.handle(), C.ImGuiID(id))
+func GetFont() ImFont {
+	return (ImFont)(unsafe.Pointer(C.GetFont()))
 }
 
-func (self ImGuiWindowSettings) Destroy() {
-	C.WindowSettings_Destroy(self.handle())
+func GetFontSize() float32 {
+	return float32(C.GetFontSize())
 }
 
-func (self ImGuiTextFilter) PassFilter(text string) bool {
-	textArg, textFin := wrapString(text)
-	defer textFin()
+func GetFontTexUvWhitePixel(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
 
-	return C.TextFilter_PassFilter(self.handle(), textArg) == C.bool(true)
+	C.GetFontTexUvWhitePixel(pOutArg)
 }
 
-func (self ImGuiTextBuffer) Reserve(capacity int32) {
-	C.TextBuffer_Reserve(self.handle(), C.int(capacity))
+// GetColorU32_ColV parameter default value hint:
+// alpha_mul: 1.0f
+func GetColorU32_ColV(idx ImGuiCol, alpha_mul float32) uint32 {
+	return uint32(C.GetColorU32_Col(C.ImGuiCol(idx), C.float(alpha_mul)))
 }
 
-func (self ImDrawList) PushClipRectFullScreen() {
-	C.DrawList_PushClipRectFullScreen(self.handle())
+func GetColorU32_Col(idx ImGuiCol) uint32 {
+	return GetColorU32_ColV(idx, 1.0)
 }
 
-func (self ImDrawList) AddQuadFilled(p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, col uint32) {
-	C.DrawList_AddQuadFilled(self.handle(), p1.toC(), p2.toC(), p3.toC(), p4.toC(), C.ImU32(col))
-}
-
-func IsItemVisible() bool {
-	return C.IsItemVisible() == C.bool(true)
-}
-
-func (self ImGuiPlatformImeData) Destroy() {
-	C.PlatformImeData_Destroy(self.handle())
+func GetColorU32_Vec4(col ImVec4) uint32 {
+	return uint32(C.GetColorU32_Vec4(col.toC()))
 }
 
-func ColorConvertU32ToFloat4(pOut *ImVec4, in uint32) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
-
-	C.ColorConvertU32ToFloat4(pOutArg, C.ImU32(in))
+func GetColorU32_U32(col uint32) uint32 {
+	return uint32(C.GetColorU32_U32(C.ImU32(col)))
 }
 
-func ColorEdit3(label string, col [3]*float32, flags ImGuiColorEditFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	colArg := make([]C.float, len(col))
-	for i, colV := range col {
-		colArg[i] = C.float(*colV)
-	}
-	defer func() {
-		for i, colV := range colArg {
-			*col[i] = float32(colV)
-		}
-	}()
-
-	return C.ColorEdit3(labelArg, (*C.float)(&colArg[0]), C.ImGuiColorEditFlags(flags)) == C.bool(true)
+func GetStyleColorVec4(idx ImGuiCol) ImVec4 {
+	return newImVec4FromCPtr(C.GetStyleColorVec4(C.ImGuiCol(idx)))
 }
 
-func DragScalar(label string, data_type ImGuiDataType, p_data unsafe.Pointer, v_speed float32, p_min unsafe.Pointer, p_max unsafe.Pointer, format string, flags ImGuiSliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
-
-	return C.DragScalar(labelArg, C.ImGuiDataType(data_type), p_data, C.float(v_speed), p_min, p_max, formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+func Separator() {
+	C.Separator()
 }
 
-func SaveIniSettingsToDisk(ini_filename string) {
-	ini_filenameArg, ini_filenameFin := wrapString(ini_filename)
-	defer ini_filenameFin()
-
-	C.SaveIniSettingsToDisk(ini_filenameArg)
+// SameLineV parameter default value hint:
+// offset_from_start_x: 0.0f
+// spacing: -1.0f
+func SameLineV(offset_from_start_x float32, spacing float32) {
+	C.SameLine(C.float(offset_from_start_x), C.float(spacing))
 }
 
-func (self ImDrawList) AddConvexPolyFilled(points *ImVec2, num_points int32, col uint32) {
-	pointsArg, pointsFin := points.wrap()
-	defer pointsFin()
-
-	C.DrawList_AddConvexPolyFilled(self.handle(), pointsArg, C.int(num_points), C.ImU32(col))
+func SameLine() {
+	SameLineV(0.0, -1.0)
 }
 
-func (self ImFontAtlasCustomRect) Destroy() {
-	C.FontAtlasCustomRect_Destroy(self.handle())
+func NewLine() {
+	C.NewLine()
 }
 
-func (self ImGuiContextHook) Destroy() {
-	C.ContextHook_Destroy(self.handle())
+func Spacing() {
+	C.Spacing()
 }
 
-func (self ImGuiTableSettings) Destroy() {
-	C.TableSettings_Destroy(self.handle())
+func Dummy(size ImVec2) {
+	C.Dummy(size.toC())
 }
 
-func SetNextWindowFocus() {
-	C.SetNextWindowFocus()
+// IndentV parameter default value hint:
+// indent_w: 0.0f
+func IndentV(indent_w float32) {
+	C.Indent(C.float(indent_w))
 }
 
-func PopAllowKeyboardFocus() {
-	C.PopAllowKeyboardFocus()
+func Indent() {
+	IndentV(0.0)
 }
 
-func (self ImDrawList) AddPolyline(points *ImVec2, num_points int32, col uint32, flags ImDrawFlags, thickness float32) {
-	pointsArg, pointsFin := points.wrap()
-	defer pointsFin()
-
-	C.DrawList_AddPolyline(self.handle(), pointsArg, C.int(num_points), C.ImU32(col), C.ImDrawFlags(flags), C.float(thickness))
+// UnindentV parameter default value hint:
+// indent_w: 0.0f
+func UnindentV(indent_w float32) {
+	C.Unindent(C.float(indent_w))
 }
 
-func (self ImGuiStackSizes) Destroy() {
-	C.StackSizes_Destroy(self.handle())
+func Unindent() {
+	UnindentV(0.0)
 }
 
-func SetNextWindowDockID(dock_id ImGuiID, cond ImGuiCond) {
-	C.SetNextWindowDockID(C.ImGuiID(dock_id), C.ImGuiCond(cond))
+func BeginGroup() {
+	C.BeginGroup()
 }
 
-func (self ImGuiOldColumnData) Destroy() {
-	C.OldColumnData_Destroy(self.handle())
+func EndGroup() {
+	C.EndGroup()
 }
 
-func GetWindowContentRegionMin(pOut *ImVec2) {
+func GetDrawCursorPos(pOut *ImVec2) {
 	pOutArg, pOutFin := pOut.wrap()
 	defer pOutFin()
 
-	C.GetWindowContentRegionMin(pOutArg)
-}
-
-func SetScrollFromPosX_Float(local_x float32, center_x_ratio float32) {
-	C.SetScrollFromPosX_Float(C.float(local_x), C.float(center_x_ratio))
+	C.GetDrawCursorPos(pOutArg)
 }
 
-func (self *ImColor) SetHSV(h float32, s float32, v float32, a float32) {
-	selfArg, selfFin := self.wrap()
-	defer selfFin()
-
-	C.Color_SetHSV(selfArg, C.float(h), C.float(s), C.float(v), C.float(a))
+func GetDrawCursorPosX() float32 {
+	return float32(C.GetDrawCursorPosX())
 }
 
-func GetWindowDrawList() ImDrawList {
-	return (ImDrawList)(unsafe.Pointer(C.GetWindowDrawList()))
+func GetDrawCursorPosY() float32 {
+	return float32(C.GetDrawCursorPosY())
 }
 
-func IsAnyItemHovered() bool {
-	return C.IsAnyItemHovered() == C.bool(true)
+func SetDrawCursorPos(local_pos ImVec2) {
+	C.SetDrawCursorPos(local_pos.toC())
 }
 
-func (self ImFontAtlas) GetGlyphRangesChineseSimplifiedCommon() *ImWchar {
-	return (*ImWchar)(C.FontAtlas_GetGlyphRangesChineseSimplifiedCommon(self.handle()))
+func SetDrawCursorPosX(local_x float32) {
+	C.SetDrawCursorPosX(C.float(local_x))
 }
 
-func ColorEdit4(label string, col [4]*float32, flags ImGuiColorEditFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	colArg := make([]C.float, len(col))
-	for i, colV := range col {
-		colArg[i] = C.float(*colV)
-	}
-	defer func() {
-		for i, colV := range colArg {
-			*col[i] = float32(colV)
-		}
-	}()
-
-	return C.ColorEdit4(labelArg, (*C.float)(&colArg[0]), C.ImGuiColorEditFlags(flags)) == C.bool(true)
+func SetDrawCursorPosY(local_y float32) {
+	C.SetDrawCursorPosY(C.float(local_y))
 }
 
-func (self ImGuiInputTextState) Destroy() {
-	C.InputTextState_Destroy(self.handle())
-}
+func GetDrawCursorStartPos(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
 
-func GetStyle() ImGuiStyle {
-	return (ImGuiStyle)(unsafe.Pointer(C.GetStyle()))
+	C.GetDrawCursorStartPos(pOutArg)
 }
 
-func GetItemRectMin(pOut *ImVec2) {
+func GetDrawCursorScreenPos(pOut *ImVec2) {
 	pOutArg, pOutFin := pOut.wrap()
 	defer pOutFin()
 
-	C.GetItemRectMin(pOutArg)
+	C.GetDrawCursorScreenPos(pOutArg)
 }
 
-func BeginDragDropSource(flags ImGuiDragDropFlags) bool {
-	return C.BeginDragDropSource(C.ImGuiDragDropFlags(flags)) == C.bool(true)
+func SetDrawCursorScreenPos(pos ImVec2) {
+	C.SetDrawCursorScreenPos(pos.toC())
 }
 
-func (self ImGuiTabItem) Destroy() {
-	C.TabItem_Destroy(self.handle())
+func AlignTextToFramePadding() {
+	C.AlignTextToFramePadding()
 }
 
-func (self ImDrawData) ScaleClipRects(fb_scale ImVec2) {
-	C.DrawData_ScaleClipRects(self.handle(), fb_scale.toC())
+func GetTextLineHeight() float32 {
+	return float32(C.GetTextLineHeight())
 }
 
-func TreeNode_Str(label string) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func GetTextLineHeightWithSpacing() float32 {
+	return float32(C.GetTextLineHeightWithSpacing())
+}
 
-	return C.TreeNode_Str(labelArg) == C.bool(true)
+func GetFrameHeight() float32 {
+	return float32(C.GetFrameHeight())
 }
 
-func TreeNode_StrStr(str_id string, fmt string) bool {
+func GetFrameHeightWithSpacing() float32 {
+	return float32(C.GetFrameHeightWithSpacing())
+}
+
+func PushID_Str(str_id string) {
 	str_idArg, str_idFin := wrapString(str_id)
 	defer str_idFin()
 
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
-
-	return C.TreeNode_StrStr(str_idArg, fmtArg) == C.bool(true)
+	C.PushID_Str(str_idArg)
 }
 
-func TreeNode_Ptr(ptr_id unsafe.Pointer, fmt string) bool {
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
-
-	return C.TreeNode_Ptr(ptr_id, fmtArg) == C.bool(true)
-}
+func PushID_StrStr(str_id_begin string, str_id_end string) {
+	str_id_beginArg, str_id_beginFin := wrapString(str_id_begin)
+	defer str_id_beginFin()
 
-func GetClipboardText() string {
-	return C.GoString(C.GetClipboardText())
-}
+	str_id_endArg, str_id_endFin := wrapString(str_id_end)
+	defer str_id_endFin()
 
-func (self ImGuiListClipper) End() {
-	C.ListClipper_End(self.handle())
+	C.PushID_StrStr(str_id_beginArg, str_id_endArg)
 }
 
-func SetScrollHereY(center_y_ratio float32) {
-	C.SetScrollHereY(C.float(center_y_ratio))
+func PushID_Ptr(ptr_id unsafe.Pointer) {
+	C.PushID_Ptr(ptr_id)
 }
 
-func (self ImFontAtlas) GetGlyphRangesChineseFull() *ImWchar {
-	return (*ImWchar)(C.FontAtlas_GetGlyphRangesChineseFull(self.handle()))
+func PushID_Int(int_id int32) {
+	C.PushID_Int(C.int(int_id))
 }
 
-func (self ImGuiIO) AddKeyAnalogEvent(key ImGuiKey, down bool, v float32) {
-	C.IO_AddKeyAnalogEvent(self.handle(), C.ImGuiKey(key), C.bool(down), C.float(v))
+func PopID() {
+	C.PopID()
 }
 
-func GetMousePos(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
-
-	C.GetMousePos(pOutArg)
-}
+func GetID_Str(str_id string) ImGuiID {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
 
-func GetTreeNodeToLabelSpacing() float32 {
-	return float32(C.GetTreeNodeToLabelSpacing())
+	return ImGuiID(C.GetID_Str(str_idArg))
 }
 
-func (self ImFontAtlas) CalcCustomRectUV(rect ImFontAtlasCustomRect, out_uv_min *ImVec2, out_uv_max *ImVec2) {
-	out_uv_minArg, out_uv_minFin := out_uv_min.wrap()
-	defer out_uv_minFin()
+func GetID_StrStr(str_id_begin string, str_id_end string) ImGuiID {
+	str_id_beginArg, str_id_beginFin := wrapString(str_id_begin)
+	defer str_id_beginFin()
 
-	out_uv_maxArg, out_uv_maxFin := out_uv_max.wrap()
-	defer out_uv_maxFin()
+	str_id_endArg, str_id_endFin := wrapString(str_id_end)
+	defer str_id_endFin()
 
-	C.FontAtlas_CalcCustomRectUV(self.handle(), rect.handle(), out_uv_minArg, out_uv_maxArg)
+	return ImGuiID(C.GetID_StrStr(str_id_beginArg, str_id_endArg))
 }
 
-func (self ImGuiListClipper) Step() bool {
-	return C.ListClipper_Step(self.handle()) == C.bool(true)
+func GetID_Ptr(ptr_id unsafe.Pointer) ImGuiID {
+	return ImGuiID(C.GetID_Ptr(ptr_id))
 }
 
-func (self ImGuiSettingsHandler) Destroy() {
-	C.SettingsHandler_Destroy(self.handle())
-}
+func TextUnformatted(text string) {
+	textArg, textFin := wrapString(text)
+	defer textFin()
 
-func (self ImFontAtlas) GetGlyphRangesDefault() *ImWchar {
-	return (*ImWchar)(C.FontAtlas_GetGlyphRangesDefault(self.handle()))
+	C.TextUnformatted(textArg)
 }
 
-func (self ImFontGlyphRangesBuilder) SetBit(n uint64) {
-	C.FontGlyphRangesBuilder_SetBit(self.handle(), C.xlong(n))
-}
+func Text(fmt string) {
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
 
-func SetKeyboardFocusHere(offset int32) {
-	C.SetKeyboardFocusHere(C.int(offset))
+	C.Text(fmtArg)
 }
 
-func BeginPopupContextVoid(str_id string, popup_flags ImGuiPopupFlags) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+func TextColored(col ImVec4, fmt string) {
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
 
-	return C.BeginPopupContextVoid(str_idArg, C.ImGuiPopupFlags(popup_flags)) == C.bool(true)
+	C.TextColored(col.toC(), fmtArg)
 }
 
-func EndGroup() {
-	C.EndGroup()
+func TextDisabled(fmt string) {
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
+
+	C.TextDisabled(fmtArg)
 }
 
-func AcceptDragDropPayload(typeArg string, flags ImGuiDragDropFlags) ImGuiPayload {
-	typeArgArg, typeArgFin := wrapString(typeArg)
-	defer typeArgFin()
+func TextWrapped(fmt string) {
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
 
-	return (ImGuiPayload)(unsafe.Pointer(C.AcceptDragDropPayload(typeArgArg, C.ImGuiDragDropFlags(flags))))
+	C.TextWrapped(fmtArg)
 }
 
-func InputFloat2(label string, v [2]*float32, format string, flags ImGuiInputTextFlags) bool {
+func LabelText(label string, fmt string) {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg := make([]C.float, len(v))
-	for i, vV := range v {
-		vArg[i] = C.float(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = float32(vV)
-		}
-	}()
-
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
-
-	return C.InputFloat2(labelArg, (*C.float)(&vArg[0]), formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
-}
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
 
-func (self ImGuiIO) AddFocusEvent(focused bool) {
-	C.IO_AddFocusEvent(self.handle(), C.bool(focused))
+	C.LabelText(labelArg, fmtArg)
 }
 
-func GetWindowViewport() ImGuiViewport {
-	return (ImGuiViewport)(unsafe.Pointer(C.GetWindowViewport()))
-}
+func BulletText(fmt string) {
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
 
-func IsKeyPressed(key ImGuiKey, repeat bool) bool {
-	return C.IsKeyPressed(C.ImGuiKey(key), C.bool(repeat)) == C.bool(true)
+	C.BulletText(fmtArg)
 }
 
-func MenuItem_Bool(label string, shortcut string, selected bool, enabled bool) bool {
+// ButtonV parameter default value hint:
+// size: ImVec2(0,0)
+func ButtonV(label string, size ImVec2) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	shortcutArg, shortcutFin := wrapString(shortcut)
-	defer shortcutFin()
+	return C.Button(labelArg, size.toC()) == C.bool(true)
+}
 
-	return C.MenuItem_Bool(labelArg, shortcutArg, C.bool(selected), C.bool(enabled)) == C.bool(true)
+func Button(label string) bool {
+	return ButtonV(label, NewImVec2(0, 0))
 }
 
-func MenuItem_BoolPtr(label string, shortcut string, p_selected *bool, enabled bool) bool {
+func SmallButton(label string) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	shortcutArg, shortcutFin := wrapString(shortcut)
-	defer shortcutFin()
+	return C.SmallButton(labelArg) == C.bool(true)
+}
 
-	p_selectedArg, p_selectedFin := wrapBool(p_selected)
-	defer p_selectedFin()
+// InvisibleButtonV parameter default value hint:
+// flags: 0
+func InvisibleButtonV(str_id string, size ImVec2, flags ImGuiButtonFlags) bool {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
 
-	return C.MenuItem_BoolPtr(labelArg, shortcutArg, p_selectedArg, C.bool(enabled)) == C.bool(true)
+	return C.InvisibleButton(str_idArg, size.toC(), C.ImGuiButtonFlags(flags)) == C.bool(true)
 }
 
-func (self ImGuiIO) SetKeyEventNativeData(key ImGuiKey, native_keycode int32, native_scancode int32, native_legacy_index int32) {
-	C.IO_SetKeyEventNativeData(self.handle(), C.ImGuiKey(key), C.int(native_keycode), C.int(native_scancode), C.int(native_legacy_index))
+func InvisibleButton(str_id string, size ImVec2) bool {
+	return InvisibleButtonV(str_id, size, 0)
 }
 
-func SameLine(offset_from_start_x float32, spacing float32) {
-	C.SameLine(C.float(offset_from_start_x), C.float(spacing))
-}
+func ArrowButton(str_id string, dir ImGuiDir) bool {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
 
-func EndCombo() {
-	C.EndCombo()
+	return C.ArrowButton(str_idArg, C.ImGuiDir(dir)) == C.bool(true)
 }
 
-func LoadIniSettingsFromDisk(ini_filename string) {
-	ini_filenameArg, ini_filenameFin := wrapString(ini_filename)
-	defer ini_filenameFin()
+func Checkbox(label string, v *bool) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	C.LoadIniSettingsFromDisk(ini_filenameArg)
-}
+	vArg, vFin := wrapBool(v)
+	defer vFin()
 
-func NewTextBuffer() ImGuiTextBuffer {
-	return (ImGuiTextBuffer)(unsafe.Pointer(C.TextBuffer_ImGuiTextBuffer()))
+	return C.Checkbox(labelArg, vArg) == C.bool(true)
 }
 
-func CreateContext(shared_font_atlas ImFontAtlas) ImGuiContext {
-	return (ImGuiContext)(unsafe.Pointer(C.CreateContext(shared_font_atlas.handle())))
+func CheckboxFlags_IntPtr(label string, flags *int32, flags_value int32) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
+
+	flagsArg, flagsFin := wrapInt32(flags)
+	defer flagsFin()
+
+	return C.CheckboxFlags_IntPtr(labelArg, flagsArg, C.int(flags_value)) == C.bool(true)
 }
 
-func (self ImFont) GetCharAdvance(c ImWchar) float32 {
-	return float32(C.Font_GetCharAdvance(self.handle(), C.ImWchar(c)))
+func RadioButton_Bool(label string, active bool) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
+
+	return C.RadioButton_Bool(labelArg, C.bool(active)) == C.bool(true)
 }
 
-func (self ImDrawList) PathBezierQuadraticCurveTo(p2 ImVec2, p3 ImVec2, num_segments int32) {
-	C.DrawList_PathBezierQuadraticCurveTo(self.handle(), p2.toC(), p3.toC(), C.int(num_segments))
+func RadioButton_IntPtr(label string, v *int32, v_button int32) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
+
+	vArg, vFin := wrapInt32(v)
+	defer vFin()
+
+	return C.RadioButton_IntPtr(labelArg, vArg, C.int(v_button)) == C.bool(true)
 }
 
-func (self ImFontAtlas) GetTexDataAsRGBA32(out_pixels *C.uchar, out_width *int32, out_height *int32, out_bytes_per_pixel *int32) {
-	out_widthArg, out_widthFin := wrapInt32(out_width)
-	defer out_widthFin()
+// ProgressBarV parameter default value hint:
+// size_arg: ImVec2(-FLT_MIN,0)
+// overlay: NULL
+func ProgressBarV(fraction float32, size_arg ImVec2, overlay string) {
+	overlayArg, overlayFin := wrapNullableString(overlay)
+	defer overlayFin()
 
-	out_heightArg, out_heightFin := wrapInt32(out_height)
-	defer out_heightFin()
+	C.ProgressBar(C.float(fraction), size_arg.toC(), overlayArg)
+}
 
-	out_bytes_per_pixelArg, out_bytes_per_pixelFin := wrapInt32(out_bytes_per_pixel)
-	defer out_bytes_per_pixelFin()
+func ProgressBar(fraction float32) {
+	ProgressBarV(fraction, NewImVec2(-FLT_MIN, 0), "")
+}
 
-	C.FontAtlas_GetTexDataAsRGBA32(self.handle(), &out_pixels, out_widthArg, out_heightArg, out_bytes_per_pixelArg)
+func Bullet() {
+	C.Bullet()
 }
 
-func (self ImFont) Destroy() {
-	C.Font_Destroy(self.handle())
+// ImageV parameter default value hint:
+// uv0: ImVec2(0,0)
+// uv1: ImVec2(1,1)
+// tint_col: ImVec4(1,1,1,1)
+// border_col: ImVec4(0,0,0,0)
+func ImageV(user_texture_id ImTextureID, size ImVec2, uv0 ImVec2, uv1 ImVec2, tint_col ImVec4, border_col ImVec4) {
+	C.Image(C.uintptr_t(user_texture_id), size.toC(), uv0.toC(), uv1.toC(), tint_col.toC(), border_col.toC())
 }
 
-func (self ImGuiIO) ClearInputKeys() {
-	C.IO_ClearInputKeys(self.handle())
+func Image(user_texture_id ImTextureID, size ImVec2) {
+	ImageV(user_texture_id, size, NewImVec2(0, 0), NewImVec2(1, 1), NewImVec4(1, 1, 1, 1), NewImVec4(0, 0, 0, 0))
 }
 
-func GetWindowWidth() float32 {
-	return float32(C.GetWindowWidth())
+// ImageOptions are the default arguments of ImageV, see DefaultImageOptions.
+type ImageOptions struct {
+	Uv0       ImVec2
+	Uv1       ImVec2
+	TintCol   ImVec4
+	BorderCol ImVec4
 }
 
-func SetNextWindowSize(size ImVec2, cond ImGuiCond) {
-	C.SetNextWindowSize(size.toC(), C.ImGuiCond(cond))
+// DefaultImageOptions returns the C++ default arguments of ImageV.
+func DefaultImageOptions() ImageOptions {
+	return ImageOptions{
+		Uv0:       NewImVec2(0, 0),
+		Uv1:       NewImVec2(1, 1),
+		TintCol:   NewImVec4(1, 1, 1, 1),
+		BorderCol: NewImVec4(0, 0, 0, 0),
+	}
 }
 
-func Color_HSV(pOut *ImColor, h float32, s float32, v float32, a float32) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+// ImageWithOptions is ImageV taking its default arguments in options.
+func ImageWithOptions(user_texture_id ImTextureID, size ImVec2, options ImageOptions) {
+	ImageV(user_texture_id, size, options.Uv0, options.Uv1, options.TintCol, options.BorderCol)
+}
 
-	C.Color_HSV(pOutArg, C.float(h), C.float(s), C.float(v), C.float(a))
+// ImageButtonV parameter default value hint:
+// uv0: ImVec2(0,0)
+// uv1: ImVec2(1,1)
+// bg_col: ImVec4(0,0,0,0)
+// tint_col: ImVec4(1,1,1,1)
+func ImageButtonV(str_id string, user_texture_id ImTextureID, size ImVec2, uv0 ImVec2, uv1 ImVec2, bg_col ImVec4, tint_col ImVec4) bool {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
+
+	return C.ImageButton(str_idArg, C.uintptr_t(user_texture_id), size.toC(), uv0.toC(), uv1.toC(), bg_col.toC(), tint_col.toC()) == C.bool(true)
 }
 
-func (self *ImVec4) Destroy() {
-	selfArg, selfFin := self.wrap()
-	defer selfFin()
+func ImageButton(str_id string, user_texture_id ImTextureID, size ImVec2) bool {
+	return ImageButtonV(str_id, user_texture_id, size, NewImVec2(0, 0), NewImVec2(1, 1), NewImVec4(0, 0, 0, 0), NewImVec4(1, 1, 1, 1))
+}
 
-	C.Vec4_Destroy(selfArg)
+// ImageButtonOptions are the default arguments of ImageButtonV, see DefaultImageButtonOptions.
+type ImageButtonOptions struct {
+	Uv0     ImVec2
+	Uv1     ImVec2
+	BgCol   ImVec4
+	TintCol ImVec4
 }
 
-func (self ImFont) RenderText(draw_list ImDrawList, size float32, pos ImVec2, col uint32, clip_rect ImVec4, text_begin string, wrap_width float32, cpu_fine_clip bool) {
-	text_beginArg, text_beginFin := wrapString(text_begin)
-	defer text_beginFin()
+// DefaultImageButtonOptions returns the C++ default arguments of ImageButtonV.
+func DefaultImageButtonOptions() ImageButtonOptions {
+	return ImageButtonOptions{
+		Uv0:     NewImVec2(0, 0),
+		Uv1:     NewImVec2(1, 1),
+		BgCol:   NewImVec4(0, 0, 0, 0),
+		TintCol: NewImVec4(1, 1, 1, 1),
+	}
+}
 
-	C.Font_RenderText(self.handle(), draw_list.handle(), C.float(size), pos.toC(), C.ImU32(col), clip_rect.toC(), text_beginArg, C.float(wrap_width), C.bool(cpu_fine_clip))
+// ImageButtonWithOptions is ImageButtonV taking its default arguments in options.
+func ImageButtonWithOptions(str_id string, user_texture_id ImTextureID, size ImVec2, options ImageButtonOptions) bool {
+	return ImageButtonV(str_id, user_texture_id, size, options.Uv0, options.Uv1, options.BgCol, options.TintCol)
 }
 
-func InputFloat4(label string, v [4]*float32, format string, flags ImGuiInputTextFlags) bool {
+// BeginComboV parameter default value hint:
+// flags: 0
+func BeginComboV(label string, preview_value string, flags ImGuiComboFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg := make([]C.float, len(v))
-	for i, vV := range v {
-		vArg[i] = C.float(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = float32(vV)
-		}
-	}()
+	preview_valueArg, preview_valueFin := wrapString(preview_value)
+	defer preview_valueFin()
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+	return C.BeginCombo(labelArg, preview_valueArg, C.ImGuiComboFlags(flags)) == C.bool(true)
+}
 
-	return C.InputFloat4(labelArg, (*C.float)(&vArg[0]), formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
+func BeginCombo(label string, preview_value string) bool {
+	return BeginComboV(label, preview_value, 0)
+}
+
+func EndCombo() {
+	C.EndCombo()
 }
 
-func DragInt(label string, v *int32, v_speed float32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
+// ComboV parameter default value hint:
+// popup_max_height_in_items: -1
+func ComboV(label string, current_item *int32, items []string, popup_max_height_in_items int32) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg, vFin := wrapInt32(v)
-	defer vFin()
+	current_itemArg, current_itemFin := wrapInt32(current_item)
+	defer current_itemFin()
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+	itemsArg, itemsFin := wrapStrings(items)
+	defer itemsFin()
 
-	return C.DragInt(labelArg, vArg, C.float(v_speed), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	return C.Combo_Str_arr(labelArg, current_itemArg, itemsArg, C.int(len(items)), C.int(popup_max_height_in_items)) == C.bool(true)
 }
 
-func IsItemEdited() bool {
-	return C.IsItemEdited() == C.bool(true)
+func Combo(label string, current_item *int32, items []string) bool {
+	return ComboV(label, current_item, items, -1)
 }
 
-func OpenPopup_Str(str_id string, popup_flags ImGuiPopupFlags) {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+// Combo_StrV parameter default value hint:
+// popup_max_height_in_items: -1
+func Combo_StrV(label string, current_item *int32, items_separated_by_zeros string, popup_max_height_in_items int32) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	C.OpenPopup_Str(str_idArg, C.ImGuiPopupFlags(popup_flags))
+	current_itemArg, current_itemFin := wrapInt32(current_item)
+	defer current_itemFin()
+
+	items_separated_by_zerosArg, items_separated_by_zerosFin := wrapString(items_separated_by_zeros)
+	defer items_separated_by_zerosFin()
+
+	return C.Combo_Str(labelArg, current_itemArg, items_separated_by_zerosArg, C.int(popup_max_height_in_items)) == C.bool(true)
 }
 
-func OpenPopup_ID(id ImGuiID, popup_flags ImGuiPopupFlags) {
-	C.OpenPopup_ID(C.ImGuiID(id), C.ImGuiPopupFlags(popup_flags))
+func Combo_Str(label string, current_item *int32, items_separated_by_zeros string) bool {
+	return Combo_StrV(label, current_item, items_separated_by_zeros, -1)
 }
 
-func SmallButton(label string) bool {
+// Combo_FnBoolPtrV parameter default value hint:
+// popup_max_height_in_items: -1
+func Combo_FnBoolPtrV(label string, current_item *int32, items_getter ItemsGetter, items_count int32, popup_max_height_in_items int32) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	return C.SmallButton(labelArg) == C.bool(true)
-}
+	current_itemArg, current_itemFin := wrapInt32(current_item)
+	defer current_itemFin()
 
-func (self ImGuiInputTextCallbackData) ClearSelection() {
-	C.InputTextCallbackData_ClearSelection(self.handle())
-}
+	items_getterArg, items_getterFin := wrapItemsGetter(items_getter)
+	defer items_getterFin()
 
-func SetWindowFontScale(scale float32) {
-	C.SetWindowFontScale(C.float(scale))
+	return C.Combo_FnBoolPtr(labelArg, current_itemArg, items_getterArg, C.int(items_count), C.int(popup_max_height_in_items)) == C.bool(true)
 }
 
-func (self ImDrawList) AddDrawCmd() {
-	C.DrawList_AddDrawCmd(self.handle())
+func Combo_FnBoolPtr(label string, current_item *int32, items_getter ItemsGetter, items_count int32) bool {
+	return Combo_FnBoolPtrV(label, current_item, items_getter, items_count, -1)
 }
 
-func SaveIniSettingsToMemory(out_ini_size *uint64) string {
-	return C.GoString(C.SaveIniSettingsToMemory((*C.xlong)(out_ini_size)))
-}
+// DragFloatV parameter default value hint:
+// v_speed: 1.0f
+// v_min: 0.0f
+// v_max: 0.0f
+// format: "%.3f"
+// flags: 0
+func DragFloatV(label string, v *float32, v_speed float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func NewIO() ImGuiIO {
-	return (ImGuiIO)(unsafe.Pointer(C.IO_ImGuiIO()))
-}
+	vArg, vFin := wrapFloat(v)
+	defer vFin()
 
-func GetColorU32_Col(idx ImGuiCol, alpha_mul float32) uint32 {
-	return uint32(C.GetColorU32_Col(C.ImGuiCol(idx), C.float(alpha_mul)))
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func GetColorU32_Vec4(col ImVec4) uint32 {
-	return uint32(C.GetColorU32_Vec4(col.toC()))
+	return C.DragFloat(labelArg, vArg, C.float(v_speed), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func GetColorU32_U32(col uint32) uint32 {
-	return uint32(C.GetColorU32_U32(C.ImU32(col)))
+func DragFloat(label string, v *float32) bool {
+	return DragFloatV(label, v, 1.0, 0.0, 0.0, "%.3f", 0)
 }
 
-func GetDrawCursorPos(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+// DragFloatOptions are the default arguments of DragFloatV, see DefaultDragFloatOptions.
+type DragFloatOptions struct {
+	VSpeed float32
+	VMin   float32
+	VMax   float32
+	Format string
+	Flags  ImGuiSliderFlags
+}
 
-	C.GetDrawCursorPos(pOutArg)
+// DefaultDragFloatOptions returns the C++ default arguments of DragFloatV.
+func DefaultDragFloatOptions() DragFloatOptions {
+	return DragFloatOptions{
+		VSpeed: 1.0,
+		VMin:   0.0,
+		VMax:   0.0,
+		Format: "%.3f",
+		Flags:  0,
+	}
 }
 
-func SetDrawCursorPosY(local_y float32) {
-	C.SetDrawCursorPosY(C.float(local_y))
+// DragFloatWithOptions is DragFloatV taking its default arguments in options.
+func DragFloatWithOptions(label string, v *float32, options DragFloatOptions) bool {
+	return DragFloatV(label, v, options.VSpeed, options.VMin, options.VMax, options.Format, options.Flags)
 }
 
-func SliderScalar(label string, data_type ImGuiDataType, p_data unsafe.Pointer, p_min unsafe.Pointer, p_max unsafe.Pointer, format string, flags ImGuiSliderFlags) bool {
+// DragFloat2V parameter default value hint:
+// v_speed: 1.0f
+// v_min: 0.0f
+// v_max: 0.0f
+// format: "%.3f"
+// flags: 0
+func DragFloat2V(label string, v [2]*float32, v_speed float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
+	vArg := make([]C.float, len(v))
+	for i, vV := range v {
+		vArg[i] = C.float(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = float32(vV)
+		}
+	}()
+
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 
-	return C.SliderScalar(labelArg, C.ImGuiDataType(data_type), p_data, p_min, p_max, formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	return C.DragFloat2(labelArg, (*C.float)(&vArg[0]), C.float(v_speed), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func (self ImFontAtlas) Clear() {
-	C.FontAtlas_Clear(self.handle())
+func DragFloat2(label string, v [2]*float32) bool {
+	return DragFloat2V(label, v, 1.0, 0.0, 0.0, "%.3f", 0)
 }
 
-func (self ImGuiTextFilter) IsActive() bool {
-	return C.TextFilter_IsActive(self.handle()) == C.bool(true)
+// DragFloat2Options are the default arguments of DragFloat2V, see DefaultDragFloat2Options.
+type DragFloat2Options struct {
+	VSpeed float32
+	VMin   float32
+	VMax   float32
+	Format string
+	Flags  ImGuiSliderFlags
 }
 
-func ArrowButton(str_id string, dir ImGuiDir) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+// DefaultDragFloat2Options returns the C++ default arguments of DragFloat2V.
+func DefaultDragFloat2Options() DragFloat2Options {
+	return DragFloat2Options{
+		VSpeed: 1.0,
+		VMin:   0.0,
+		VMax:   0.0,
+		Format: "%.3f",
+		Flags:  0,
+	}
+}
 
-	return C.ArrowButton(str_idArg, C.ImGuiDir(dir)) == C.bool(true)
+// DragFloat2WithOptions is DragFloat2V taking its default arguments in options.
+func DragFloat2WithOptions(label string, v [2]*float32, options DragFloat2Options) bool {
+	return DragFloat2V(label, v, options.VSpeed, options.VMin, options.VMax, options.Format, options.Flags)
 }
 
-func DragFloat3(label string, v [3]*float32, v_speed float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
+// DragFloat3V parameter default value hint:
+// v_speed: 1.0f
+// v_min: 0.0f
+// v_max: 0.0f
+// format: "%.3f"
+// flags: 0
+func DragFloat3V(label string, v [3]*float32, v_speed float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
@@ -1149,310 +1259,511 @@ func DragFloat3(label string, v [3]*float32, v_speed float32, v_min float32, v_m
 	return C.DragFloat3(labelArg, (*C.float)(&vArg[0]), C.float(v_speed), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func (self ImFontAtlas) GetGlyphRangesCyrillic() *ImWchar {
-	return (*ImWchar)(C.FontAtlas_GetGlyphRangesCyrillic(self.handle()))
+func DragFloat3(label string, v [3]*float32) bool {
+	return DragFloat3V(label, v, 1.0, 0.0, 0.0, "%.3f", 0)
 }
 
-func BulletText(fmt string) {
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
-
-	C.BulletText(fmtArg)
+// DragFloat3Options are the default arguments of DragFloat3V, see DefaultDragFloat3Options.
+type DragFloat3Options struct {
+	VSpeed float32
+	VMin   float32
+	VMax   float32
+	Format string
+	Flags  ImGuiSliderFlags
 }
 
-func NewPayload() ImGuiPayload {
-	return (ImGuiPayload)(unsafe.Pointer(C.Payload_ImGuiPayload()))
+// DefaultDragFloat3Options returns the C++ default arguments of DragFloat3V.
+func DefaultDragFloat3Options() DragFloat3Options {
+	return DragFloat3Options{
+		VSpeed: 1.0,
+		VMin:   0.0,
+		VMax:   0.0,
+		Format: "%.3f",
+		Flags:  0,
+	}
 }
 
-func (self ImGuiTextFilter) Build() {
-	C.TextFilter_Build(self.handle())
+// DragFloat3WithOptions is DragFloat3V taking its default arguments in options.
+func DragFloat3WithOptions(label string, v [3]*float32, options DragFloat3Options) bool {
+	return DragFloat3V(label, v, options.VSpeed, options.VMin, options.VMax, options.Format, options.Flags)
 }
 
-func Selectable_Bool(label string, selected bool, flags ImGuiSelectableFlags, size ImVec2) bool {
+// DragFloat4V parameter default value hint:
+// v_speed: 1.0f
+// v_min: 0.0f
+// v_max: 0.0f
+// format: "%.3f"
+// flags: 0
+func DragFloat4V(label string, v [4]*float32, v_speed float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	return C.Selectable_Bool(labelArg, C.bool(selected), C.ImGuiSelectableFlags(flags), size.toC()) == C.bool(true)
-}
+	vArg := make([]C.float, len(v))
+	for i, vV := range v {
+		vArg[i] = C.float(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = float32(vV)
+		}
+	}()
 
-func Selectable_BoolPtr(label string, p_selected *bool, flags ImGuiSelectableFlags, size ImVec2) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	p_selectedArg, p_selectedFin := wrapBool(p_selected)
-	defer p_selectedFin()
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-	return C.Selectable_BoolPtr(labelArg, p_selectedArg, C.ImGuiSelectableFlags(flags), size.toC()) == C.bool(true)
+	return C.DragFloat4(labelArg, (*C.float)(&vArg[0]), C.float(v_speed), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func (self ImDrawList) AddNgonFilled(center ImVec2, radius float32, col uint32, num_segments int32) {
-	C.DrawList_AddNgonFilled(self.handle(), center.toC(), C.float(radius), C.ImU32(col), C.int(num_segments))
+func DragFloat4(label string, v [4]*float32) bool {
+	return DragFloat4V(label, v, 1.0, 0.0, 0.0, "%.3f", 0)
 }
 
-func (self ImDrawList) AddRectFilledMultiColor(p_min ImVec2, p_max ImVec2, col_upr_left uint32, col_upr_right uint32, col_bot_right uint32, col_bot_left uint32) {
-	C.DrawList_AddRectFilledMultiColor(self.handle(), p_min.toC(), p_max.toC(), C.ImU32(col_upr_left), C.ImU32(col_upr_right), C.ImU32(col_bot_right), C.ImU32(col_bot_left))
+// DragFloat4Options are the default arguments of DragFloat4V, see DefaultDragFloat4Options.
+type DragFloat4Options struct {
+	VSpeed float32
+	VMin   float32
+	VMax   float32
+	Format string
+	Flags  ImGuiSliderFlags
 }
 
-func GetMainViewport() ImGuiViewport {
-	return (ImGuiViewport)(unsafe.Pointer(C.GetMainViewport()))
+// DefaultDragFloat4Options returns the C++ default arguments of DragFloat4V.
+func DefaultDragFloat4Options() DragFloat4Options {
+	return DragFloat4Options{
+		VSpeed: 1.0,
+		VMin:   0.0,
+		VMax:   0.0,
+		Format: "%.3f",
+		Flags:  0,
+	}
 }
 
-func PopClipRect() {
-	C.PopClipRect()
+// DragFloat4WithOptions is DragFloat4V taking its default arguments in options.
+func DragFloat4WithOptions(label string, v [4]*float32, options DragFloat4Options) bool {
+	return DragFloat4V(label, v, options.VSpeed, options.VMin, options.VMax, options.Format, options.Flags)
 }
 
-func Render() {
-	C.Render()
-}
+// DragFloatRange2V parameter default value hint:
+// v_speed: 1.0f
+// v_min: 0.0f
+// v_max: 0.0f
+// format: "%.3f"
+// format_max: NULL
+// flags: 0
+func DragFloatRange2V(label string, v_current_min *float32, v_current_max *float32, v_speed float32, v_min float32, v_max float32, format string, format_max string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func SetScrollX_Float(scroll_x float32) {
-	C.SetScrollX_Float(C.float(scroll_x))
-}
+	v_current_minArg, v_current_minFin := wrapFloat(v_current_min)
+	defer v_current_minFin()
 
-func (self ImDrawList) PopClipRect() {
-	C.DrawList_PopClipRect(self.handle())
-}
+	v_current_maxArg, v_current_maxFin := wrapFloat(v_current_max)
+	defer v_current_maxFin()
 
-func (self ImDrawList) Destroy() {
-	C.DrawList_Destroy(self.handle())
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func ShowAboutWindow(p_open *bool) {
-	p_openArg, p_openFin := wrapBool(p_open)
-	defer p_openFin()
+	format_maxArg, format_maxFin := wrapNullableString(format_max)
+	defer format_maxFin()
 
-	C.ShowAboutWindow(p_openArg)
+	return C.DragFloatRange2(labelArg, v_current_minArg, v_current_maxArg, C.float(v_speed), C.float(v_min), C.float(v_max), formatArg, format_maxArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func SetItemDefaultFocus() {
-	C.SetItemDefaultFocus()
+func DragFloatRange2(label string, v_current_min *float32, v_current_max *float32) bool {
+	return DragFloatRange2V(label, v_current_min, v_current_max, 1.0, 0.0, 0.0, "%.3f", "", 0)
 }
 
-func (self ImFont) IsGlyphRangeUnused(c_begin uint32, c_last uint32) bool {
-	return C.Font_IsGlyphRangeUnused(self.handle(), C.uint(c_begin), C.uint(c_last)) == C.bool(true)
+// DragFloatRange2Options are the default arguments of DragFloatRange2V, see DefaultDragFloatRange2Options.
+type DragFloatRange2Options struct {
+	VSpeed    float32
+	VMin      float32
+	VMax      float32
+	Format    string
+	FormatMax string
+	Flags     ImGuiSliderFlags
 }
 
-func (self ImDrawList) PushClipRect(clip_rect_min ImVec2, clip_rect_max ImVec2, intersect_with_current_clip_rect bool) {
-	C.DrawList_PushClipRect(self.handle(), clip_rect_min.toC(), clip_rect_max.toC(), C.bool(intersect_with_current_clip_rect))
+// DefaultDragFloatRange2Options returns the C++ default arguments of DragFloatRange2V.
+func DefaultDragFloatRange2Options() DragFloatRange2Options {
+	return DragFloatRange2Options{
+		VSpeed:    1.0,
+		VMin:      0.0,
+		VMax:      0.0,
+		Format:    "%.3f",
+		FormatMax: "",
+		Flags:     0,
+	}
 }
 
-func GetColumnsCount() int {
-	return int(C.GetColumnsCount())
+// DragFloatRange2WithOptions is DragFloatRange2V taking its default arguments in options.
+func DragFloatRange2WithOptions(label string, v_current_min *float32, v_current_max *float32, options DragFloatRange2Options) bool {
+	return DragFloatRange2V(label, v_current_min, v_current_max, options.VSpeed, options.VMin, options.VMax, options.Format, options.FormatMax, options.Flags)
 }
 
-func PushItemWidth(item_width float32) {
-	C.PushItemWidth(C.float(item_width))
-}
+// DragIntV parameter default value hint:
+// v_speed: 1.0f
+// v_min: 0
+// v_max: 0
+// format: "%d"
+// flags: 0
+func DragIntV(label string, v *int32, v_speed float32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func NewFontAtlasCustomRect() ImFontAtlasCustomRect {
-	return (ImFontAtlasCustomRect)(unsafe.Pointer(C.FontAtlasCustomRect_ImFontAtlasCustomRect()))
-}
+	vArg, vFin := wrapInt32(v)
+	defer vFin()
 
-func (self ImFontAtlas) GetGlyphRangesThai() *ImWchar {
-	return (*ImWchar)(C.FontAtlas_GetGlyphRangesThai(self.handle()))
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func (self ImDrawList) ChannelsSetCurrent(n int32) {
-	C.DrawList_ChannelsSetCurrent(self.handle(), C.int(n))
+	return C.DragInt(labelArg, vArg, C.float(v_speed), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func (self ImDrawList) PathLineTo(pos ImVec2) {
-	C.DrawList_PathLineTo(self.handle(), pos.toC())
+func DragInt(label string, v *int32) bool {
+	return DragIntV(label, v, 1.0, 0, 0, "%d", 0)
 }
 
-func (self ImGuiContext) Destroy() {
-	C.Context_Destroy(self.handle())
+// DragIntOptions are the default arguments of DragIntV, see DefaultDragIntOptions.
+type DragIntOptions struct {
+	VSpeed float32
+	VMin   int32
+	VMax   int32
+	Format string
+	Flags  ImGuiSliderFlags
 }
 
-func PushAllowKeyboardFocus(allow_keyboard_focus bool) {
-	C.PushAllowKeyboardFocus(C.bool(allow_keyboard_focus))
+// DefaultDragIntOptions returns the C++ default arguments of DragIntV.
+func DefaultDragIntOptions() DragIntOptions {
+	return DragIntOptions{
+		VSpeed: 1.0,
+		VMin:   0,
+		VMax:   0,
+		Format: "%d",
+		Flags:  0,
+	}
 }
 
-func DockSpace(id ImGuiID, size ImVec2, flags ImGuiDockNodeFlags, window_class ImGuiWindowClass) ImGuiID {
-	return ImGuiID(C.DockSpace(C.ImGuiID(id), size.toC(), C.ImGuiDockNodeFlags(flags), window_class.handle()))
+// DragIntWithOptions is DragIntV taking its default arguments in options.
+func DragIntWithOptions(label string, v *int32, options DragIntOptions) bool {
+	return DragIntV(label, v, options.VSpeed, options.VMin, options.VMax, options.Format, options.Flags)
 }
 
-func ColorPicker4(label string, col [4]*float32, flags ImGuiColorEditFlags, ref_col *float32) bool {
+// DragInt2V parameter default value hint:
+// v_speed: 1.0f
+// v_min: 0
+// v_max: 0
+// format: "%d"
+// flags: 0
+func DragInt2V(label string, v [2]*int32, v_speed float32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	colArg := make([]C.float, len(col))
-	for i, colV := range col {
-		colArg[i] = C.float(*colV)
+	vArg := make([]C.int, len(v))
+	for i, vV := range v {
+		vArg[i] = C.int(*vV)
 	}
 	defer func() {
-		for i, colV := range colArg {
-			*col[i] = float32(colV)
+		for i, vV := range vArg {
+			*v[i] = int32(vV)
 		}
 	}()
 
-	ref_colArg, ref_colFin := wrapFloat(ref_col)
-	defer ref_colFin()
-
-	return C.ColorPicker4(labelArg, (*C.float)(&colArg[0]), C.ImGuiColorEditFlags(flags), ref_colArg) == C.bool(true)
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func IsMouseDragging(button ImGuiMouseButton, lock_threshold float32) bool {
-	return C.IsMouseDragging(C.ImGuiMouseButton(button), C.float(lock_threshold)) == C.bool(true)
+	return C.DragInt2(labelArg, (*C.int)(&vArg[0]), C.float(v_speed), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func TabItemButton(label string, flags ImGuiTabItemFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	return C.TabItemButton(labelArg, C.ImGuiTabItemFlags(flags)) == C.bool(true)
+func DragInt2(label string, v [2]*int32) bool {
+	return DragInt2V(label, v, 1.0, 0, 0, "%d", 0)
 }
 
-func (self ImGuiPayload) Destroy() {
-	C.Payload_Destroy(self.handle())
+// DragInt2Options are the default arguments of DragInt2V, see DefaultDragInt2Options.
+type DragInt2Options struct {
+	VSpeed float32
+	VMin   int32
+	VMax   int32
+	Format string
+	Flags  ImGuiSliderFlags
 }
 
-func IsAnyMouseDown() bool {
-	return C.IsAnyMouseDown() == C.bool(true)
+// DefaultDragInt2Options returns the C++ default arguments of DragInt2V.
+func DefaultDragInt2Options() DragInt2Options {
+	return DragInt2Options{
+		VSpeed: 1.0,
+		VMin:   0,
+		VMax:   0,
+		Format: "%d",
+		Flags:  0,
+	}
 }
 
-func SetDragDropPayload(typeArg string, data unsafe.Pointer, sz uint64, cond ImGuiCond) bool {
-	typeArgArg, typeArgFin := wrapString(typeArg)
-	defer typeArgFin()
-
-	return C.SetDragDropPayload(typeArgArg, data, C.xlong(sz), C.ImGuiCond(cond)) == C.bool(true)
+// DragInt2WithOptions is DragInt2V taking its default arguments in options.
+func DragInt2WithOptions(label string, v [2]*int32, options DragInt2Options) bool {
+	return DragInt2V(label, v, options.VSpeed, options.VMin, options.VMax, options.Format, options.Flags)
 }
 
-func (self ImDrawList) AddBezierCubic(p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, col uint32, thickness float32, num_segments int32) {
-	C.DrawList_AddBezierCubic(self.handle(), p1.toC(), p2.toC(), p3.toC(), p4.toC(), C.ImU32(col), C.float(thickness), C.int(num_segments))
-}
+// DragInt3V parameter default value hint:
+// v_speed: 1.0f
+// v_min: 0
+// v_max: 0
+// format: "%d"
+// flags: 0
+func DragInt3V(label string, v [3]*int32, v_speed float32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func (self ImGuiListClipper) Destroy() {
-	C.ListClipper_Destroy(self.handle())
-}
+	vArg := make([]C.int, len(v))
+	for i, vV := range v {
+		vArg[i] = C.int(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = int32(vV)
+		}
+	}()
 
-func GetContentRegionAvail(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-	C.GetContentRegionAvail(pOutArg)
+	return C.DragInt3(labelArg, (*C.int)(&vArg[0]), C.float(v_speed), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func (self ImFontAtlas) Destroy() {
-	C.FontAtlas_Destroy(self.handle())
+func DragInt3(label string, v [3]*int32) bool {
+	return DragInt3V(label, v, 1.0, 0, 0, "%d", 0)
 }
 
-func (self ImFont) AddRemapChar(dst ImWchar, src ImWchar, overwrite_dst bool) {
-	C.Font_AddRemapChar(self.handle(), C.ImWchar(dst), C.ImWchar(src), C.bool(overwrite_dst))
+// DragInt3Options are the default arguments of DragInt3V, see DefaultDragInt3Options.
+type DragInt3Options struct {
+	VSpeed float32
+	VMin   int32
+	VMax   int32
+	Format string
+	Flags  ImGuiSliderFlags
 }
 
-func IsItemActive() bool {
-	return C.IsItemActive() == C.bool(true)
+// DefaultDragInt3Options returns the C++ default arguments of DragInt3V.
+func DefaultDragInt3Options() DragInt3Options {
+	return DragInt3Options{
+		VSpeed: 1.0,
+		VMin:   0,
+		VMax:   0,
+		Format: "%d",
+		Flags:  0,
+	}
 }
 
-func GetColumnOffset(column_index int32) float32 {
-	return float32(C.GetColumnOffset(C.int(column_index)))
+// DragInt3WithOptions is DragInt3V taking its default arguments in options.
+func DragInt3WithOptions(label string, v [3]*int32, options DragInt3Options) bool {
+	return DragInt3V(label, v, options.VSpeed, options.VMin, options.VMax, options.Format, options.Flags)
 }
 
-func (self ImGuiTextFilter) Draw(label string, width float32) bool {
+// DragInt4V parameter default value hint:
+// v_speed: 1.0f
+// v_min: 0
+// v_max: 0
+// format: "%d"
+// flags: 0
+func DragInt4V(label string, v [4]*int32, v_speed float32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	return C.TextFilter_Draw(self.handle(), labelArg, C.float(width)) == C.bool(true)
-}
+	vArg := make([]C.int, len(v))
+	for i, vV := range v {
+		vArg[i] = C.int(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = int32(vV)
+		}
+	}()
 
-func PopButtonRepeat() {
-	C.PopButtonRepeat()
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
+
+	return C.DragInt4(labelArg, (*C.int)(&vArg[0]), C.float(v_speed), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func ResetMouseDragDelta(button ImGuiMouseButton) {
-	C.ResetMouseDragDelta(C.ImGuiMouseButton(button))
+func DragInt4(label string, v [4]*int32) bool {
+	return DragInt4V(label, v, 1.0, 0, 0, "%d", 0)
 }
 
-func (self ImDrawList) AddNgon(center ImVec2, radius float32, col uint32, num_segments int32, thickness float32) {
-	C.DrawList_AddNgon(self.handle(), center.toC(), C.float(radius), C.ImU32(col), C.int(num_segments), C.float(thickness))
+// DragInt4Options are the default arguments of DragInt4V, see DefaultDragInt4Options.
+type DragInt4Options struct {
+	VSpeed float32
+	VMin   int32
+	VMax   int32
+	Format string
+	Flags  ImGuiSliderFlags
 }
 
-func (self ImGuiIO) ClearInputCharacters() {
-	C.IO_ClearInputCharacters(self.handle())
+// DefaultDragInt4Options returns the C++ default arguments of DragInt4V.
+func DefaultDragInt4Options() DragInt4Options {
+	return DragInt4Options{
+		VSpeed: 1.0,
+		VMin:   0,
+		VMax:   0,
+		Format: "%d",
+		Flags:  0,
+	}
 }
 
-func EndMenuBar() {
-	C.EndMenuBar()
+// DragInt4WithOptions is DragInt4V taking its default arguments in options.
+func DragInt4WithOptions(label string, v [4]*int32, options DragInt4Options) bool {
+	return DragInt4V(label, v, options.VSpeed, options.VMin, options.VMax, options.Format, options.Flags)
 }
 
-func VSliderInt(label string, size ImVec2, v *int32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
+// DragIntRange2V parameter default value hint:
+// v_speed: 1.0f
+// v_min: 0
+// v_max: 0
+// format: "%d"
+// format_max: NULL
+// flags: 0
+func DragIntRange2V(label string, v_current_min *int32, v_current_max *int32, v_speed float32, v_min int32, v_max int32, format string, format_max string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg, vFin := wrapInt32(v)
-	defer vFin()
+	v_current_minArg, v_current_minFin := wrapInt32(v_current_min)
+	defer v_current_minFin()
+
+	v_current_maxArg, v_current_maxFin := wrapInt32(v_current_max)
+	defer v_current_maxFin()
 
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 
-	return C.VSliderInt(labelArg, size.toC(), vArg, C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
-}
+	format_maxArg, format_maxFin := wrapNullableString(format_max)
+	defer format_maxFin()
 
-func (self ImFontAtlas) AddCustomRectRegular(width int32, height int32) int {
-	return int(C.FontAtlas_AddCustomRectRegular(self.handle(), C.int(width), C.int(height)))
+	return C.DragIntRange2(labelArg, v_current_minArg, v_current_maxArg, C.float(v_speed), C.int(v_min), C.int(v_max), formatArg, format_maxArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func (self ImGuiTextBuffer) Clear() {
-	C.TextBuffer_Clear(self.handle())
+func DragIntRange2(label string, v_current_min *int32, v_current_max *int32) bool {
+	return DragIntRange2V(label, v_current_min, v_current_max, 1.0, 0, 0, "%d", "", 0)
 }
 
-func Bullet() {
-	C.Bullet()
+// DragIntRange2Options are the default arguments of DragIntRange2V, see DefaultDragIntRange2Options.
+type DragIntRange2Options struct {
+	VSpeed    float32
+	VMin      int32
+	VMax      int32
+	Format    string
+	FormatMax string
+	Flags     ImGuiSliderFlags
 }
 
-func NewTextFilter(default_filter string) ImGuiTextFilter {
-	default_filterArg, default_filterFin := wrapString(default_filter)
-	defer default_filterFin()
+// DefaultDragIntRange2Options returns the C++ default arguments of DragIntRange2V.
+func DefaultDragIntRange2Options() DragIntRange2Options {
+	return DragIntRange2Options{
+		VSpeed:    1.0,
+		VMin:      0,
+		VMax:      0,
+		Format:    "%d",
+		FormatMax: "",
+		Flags:     0,
+	}
+}
 
-	return (ImGuiTextFilter)(unsafe.Pointer(C.TextFilter_ImGuiTextFilter(default_filterArg)))
+// DragIntRange2WithOptions is DragIntRange2V taking its default arguments in options.
+func DragIntRange2WithOptions(label string, v_current_min *int32, v_current_max *int32, options DragIntRange2Options) bool {
+	return DragIntRange2V(label, v_current_min, v_current_max, options.VSpeed, options.VMin, options.VMax, options.Format, options.FormatMax, options.Flags)
 }
 
-func PlotHistogram_FloatPtr(label string, values *float32, values_count int32, values_offset int32, overlay_text string, scale_min float32, scale_max float32, graph_size ImVec2, stride int32) {
+// DragScalarV parameter default value hint:
+// v_speed: 1.0f
+// p_min: NULL
+// p_max: NULL
+// format: NULL
+// flags: 0
+func DragScalarV(label string, data_type ImGuiDataType, p_data unsafe.Pointer, v_speed float32, p_min unsafe.Pointer, p_max unsafe.Pointer, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	valuesArg, valuesFin := wrapFloat(values)
-	defer valuesFin()
+	formatArg, formatFin := wrapNullableString(format)
+	defer formatFin()
 
-	overlay_textArg, overlay_textFin := wrapString(overlay_text)
-	defer overlay_textFin()
+	return C.DragScalar(labelArg, C.ImGuiDataType(data_type), p_data, C.float(v_speed), p_min, p_max, formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+}
 
-	C.PlotHistogram_FloatPtr(labelArg, valuesArg, C.int(values_count), C.int(values_offset), overlay_textArg, C.float(scale_min), C.float(scale_max), graph_size.toC(), C.int(stride))
+func DragScalar(label string, data_type ImGuiDataType, p_data unsafe.Pointer) bool {
+	return DragScalarV(label, data_type, p_data, 1.0, nil, nil, "", 0)
 }
 
-func TableNextColumn() bool {
-	return C.TableNextColumn() == C.bool(true)
+// DragScalarOptions are the default arguments of DragScalarV, see DefaultDragScalarOptions.
+type DragScalarOptions struct {
+	VSpeed float32
+	PMin   unsafe.Pointer
+	PMax   unsafe.Pointer
+	Format string
+	Flags  ImGuiSliderFlags
 }
 
-func NewDrawListSplitter() ImDrawListSplitter {
-	return (ImDrawListSplitter)(unsafe.Pointer(C.DrawListSplitter_ImDrawListSplitter()))
+// DefaultDragScalarOptions returns the C++ default arguments of DragScalarV.
+func DefaultDragScalarOptions() DragScalarOptions {
+	return DragScalarOptions{
+		VSpeed: 1.0,
+		PMin:   nil,
+		PMax:   nil,
+		Format: "",
+		Flags:  0,
+	}
 }
 
-func (self ImDrawList) PrimQuadUV(a ImVec2, b ImVec2, c ImVec2, d ImVec2, uv_a ImVec2, uv_b ImVec2, uv_c ImVec2, uv_d ImVec2, col uint32) {
-	C.DrawList_PrimQuadUV(self.handle(), a.toC(), b.toC(), c.toC(), d.toC(), uv_a.toC(), uv_b.toC(), uv_c.toC(), uv_d.toC(), C.ImU32(col))
+// DragScalarWithOptions is DragScalarV taking its default arguments in options.
+func DragScalarWithOptions(label string, data_type ImGuiDataType, p_data unsafe.Pointer, options DragScalarOptions) bool {
+	return DragScalarV(label, data_type, p_data, options.VSpeed, options.PMin, options.PMax, options.Format, options.Flags)
 }
 
-func SetNextItemOpen(is_open bool, cond ImGuiCond) {
-	C.SetNextItemOpen(C.bool(is_open), C.ImGuiCond(cond))
+// DragScalarNV parameter default value hint:
+// v_speed: 1.0f
+// p_min: NULL
+// p_max: NULL
+// format: NULL
+// flags: 0
+func DragScalarNV(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32, v_speed float32, p_min unsafe.Pointer, p_max unsafe.Pointer, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
+
+	formatArg, formatFin := wrapNullableString(format)
+	defer formatFin()
+
+	return C.DragScalarN(labelArg, C.ImGuiDataType(data_type), p_data, C.int(components), C.float(v_speed), p_min, p_max, formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) Destroy() {
-	C.TableColumn_Destroy(self.handle())
+func DragScalarN(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32) bool {
+	return DragScalarNV(label, data_type, p_data, components, 1.0, nil, nil, "", 0)
 }
 
-func (self ImGuiNavItemData) Destroy() {
-	C.NavItemData_Destroy(self.handle())
+// DragScalarNOptions are the default arguments of DragScalarNV, see DefaultDragScalarNOptions.
+type DragScalarNOptions struct {
+	VSpeed float32
+	PMin   unsafe.Pointer
+	PMax   unsafe.Pointer
+	Format string
+	Flags  ImGuiSliderFlags
 }
 
-func Text(fmt string) {
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
+// DefaultDragScalarNOptions returns the C++ default arguments of DragScalarNV.
+func DefaultDragScalarNOptions() DragScalarNOptions {
+	return DragScalarNOptions{
+		VSpeed: 1.0,
+		PMin:   nil,
+		PMax:   nil,
+		Format: "",
+		Flags:  0,
+	}
+}
 
-	C.Text(fmtArg)
+// DragScalarNWithOptions is DragScalarNV taking its default arguments in options.
+func DragScalarNWithOptions(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32, options DragScalarNOptions) bool {
+	return DragScalarNV(label, data_type, p_data, components, options.VSpeed, options.PMin, options.PMax, options.Format, options.Flags)
 }
 
-func DragFloat(label string, v *float32, v_speed float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
+// SliderFloatV parameter default value hint:
+// format: "%.3f"
+// flags: 0
+func SliderFloatV(label string, v *float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
@@ -1462,109 +1773,163 @@ func DragFloat(label string, v *float32, v_speed float32, v_min float32, v_max f
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 
-	return C.DragFloat(labelArg, vArg, C.float(v_speed), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	return C.SliderFloat(labelArg, vArg, C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+}
+
+func SliderFloat(label string, v *float32, v_min float32, v_max float32) bool {
+	return SliderFloatV(label, v, v_min, v_max, "%.3f", 0)
 }
 
-func SliderScalarN(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32, p_min unsafe.Pointer, p_max unsafe.Pointer, format string, flags ImGuiSliderFlags) bool {
+// SliderFloat2V parameter default value hint:
+// format: "%.3f"
+// flags: 0
+func SliderFloat2V(label string, v [2]*float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
+	vArg := make([]C.float, len(v))
+	for i, vV := range v {
+		vArg[i] = C.float(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = float32(vV)
+		}
+	}()
+
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 
-	return C.SliderScalarN(labelArg, C.ImGuiDataType(data_type), p_data, C.int(components), p_min, p_max, formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	return C.SliderFloat2(labelArg, (*C.float)(&vArg[0]), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func BeginChildFrame(id ImGuiID, size ImVec2, flags ImGuiWindowFlags) bool {
-	return C.BeginChildFrame(C.ImGuiID(id), size.toC(), C.ImGuiWindowFlags(flags)) == C.bool(true)
+func SliderFloat2(label string, v [2]*float32, v_min float32, v_max float32) bool {
+	return SliderFloat2V(label, v, v_min, v_max, "%.3f", 0)
 }
 
-func InputScalarN(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32, p_step unsafe.Pointer, p_step_fast unsafe.Pointer, format string, flags ImGuiInputTextFlags) bool {
+// SliderFloat3V parameter default value hint:
+// format: "%.3f"
+// flags: 0
+func SliderFloat3V(label string, v [3]*float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
+	vArg := make([]C.float, len(v))
+	for i, vV := range v {
+		vArg[i] = C.float(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = float32(vV)
+		}
+	}()
+
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 
-	return C.InputScalarN(labelArg, C.ImGuiDataType(data_type), p_data, C.int(components), p_step, p_step_fast, formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
-}
-
-func IsWindowCollapsed() bool {
-	return C.IsWindowCollapsed() == C.bool(true)
+	return C.SliderFloat3(labelArg, (*C.float)(&vArg[0]), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func LogText(fmt string) {
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
-
-	C.LogText(fmtArg)
+func SliderFloat3(label string, v [3]*float32, v_min float32, v_max float32) bool {
+	return SliderFloat3V(label, v, v_min, v_max, "%.3f", 0)
 }
 
-func (self ImFont) FindGlyph(c ImWchar) ImFontGlyph {
-	return (ImFontGlyph)(unsafe.Pointer(C.Font_FindGlyph(self.handle(), C.ImWchar(c))))
-}
+// SliderFloat4V parameter default value hint:
+// format: "%.3f"
+// flags: 0
+func SliderFloat4V(label string, v [4]*float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func GetBackgroundDrawList_Nil() ImDrawList {
-	return (ImDrawList)(unsafe.Pointer(C.GetBackgroundDrawList_Nil()))
-}
+	vArg := make([]C.float, len(v))
+	for i, vV := range v {
+		vArg[i] = C.float(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = float32(vV)
+		}
+	}()
 
-func GetBackgroundDrawList_ViewportPtr(viewport ImGuiViewport) ImDrawList {
-	return (ImDrawList)(unsafe.Pointer(C.GetBackgroundDrawList_ViewportPtr(viewport.handle())))
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func LogToClipboard(auto_open_depth int32) {
-	C.LogToClipboard(C.int(auto_open_depth))
+	return C.SliderFloat4(labelArg, (*C.float)(&vArg[0]), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func (self *ImColor) Destroy() {
-	selfArg, selfFin := self.wrap()
-	defer selfFin()
-
-	C.Color_Destroy(selfArg)
+func SliderFloat4(label string, v [4]*float32, v_min float32, v_max float32) bool {
+	return SliderFloat4V(label, v, v_min, v_max, "%.3f", 0)
 }
 
-func (self ImDrawList) AddText_Vec2(pos ImVec2, col uint32, text_begin string) {
-	text_beginArg, text_beginFin := wrapString(text_begin)
-	defer text_beginFin()
+// SliderAngleV parameter default value hint:
+// v_degrees_min: -360.0f
+// v_degrees_max: +360.0f
+// format: "%.0f deg"
+// flags: 0
+func SliderAngleV(label string, v_rad *float32, v_degrees_min float32, v_degrees_max float32, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	C.DrawList_AddText_Vec2(self.handle(), pos.toC(), C.ImU32(col), text_beginArg)
-}
+	v_radArg, v_radFin := wrapFloat(v_rad)
+	defer v_radFin()
 
-func (self ImDrawList) AddText_FontPtr(font ImFont, font_size float32, pos ImVec2, col uint32, text_begin string, wrap_width float32, cpu_fine_clip_rect *ImVec4) {
-	text_beginArg, text_beginFin := wrapString(text_begin)
-	defer text_beginFin()
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-	cpu_fine_clip_rectArg, cpu_fine_clip_rectFin := cpu_fine_clip_rect.wrap()
-	defer cpu_fine_clip_rectFin()
+	return C.SliderAngle(labelArg, v_radArg, C.float(v_degrees_min), C.float(v_degrees_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+}
 
-	C.DrawList_AddText_FontPtr(self.handle(), font.handle(), C.float(font_size), pos.toC(), C.ImU32(col), text_beginArg, C.float(wrap_width), cpu_fine_clip_rectArg)
+func SliderAngle(label string, v_rad *float32) bool {
+	return SliderAngleV(label, v_rad, -360.0, +360.0, "%.0f deg", 0)
 }
 
-func GetKeyPressedAmount(key ImGuiKey, repeat_delay float32, rate float32) int {
-	return int(C.GetKeyPressedAmount(C.ImGuiKey(key), C.float(repeat_delay), C.float(rate)))
+// SliderAngleOptions are the default arguments of SliderAngleV, see DefaultSliderAngleOptions.
+type SliderAngleOptions struct {
+	VDegreesMin float32
+	VDegreesMax float32
+	Format      string
+	Flags       ImGuiSliderFlags
 }
 
-func Viewport_GetCenter(pOut *ImVec2, self ImGuiViewport) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+// DefaultSliderAngleOptions returns the C++ default arguments of SliderAngleV.
+func DefaultSliderAngleOptions() SliderAngleOptions {
+	return SliderAngleOptions{
+		VDegreesMin: -360.0,
+		VDegreesMax: +360.0,
+		Format:      "%.0f deg",
+		Flags:       0,
+	}
+}
 
-	C.Viewport_GetCenter(pOutArg, self.handle())
+// SliderAngleWithOptions is SliderAngleV taking its default arguments in options.
+func SliderAngleWithOptions(label string, v_rad *float32, options SliderAngleOptions) bool {
+	return SliderAngleV(label, v_rad, options.VDegreesMin, options.VDegreesMax, options.Format, options.Flags)
 }
 
-func PlotLines_FloatPtr(label string, values *float32, values_count int32, values_offset int32, overlay_text string, scale_min float32, scale_max float32, graph_size ImVec2, stride int32) {
+// SliderIntV parameter default value hint:
+// format: "%d"
+// flags: 0
+func SliderIntV(label string, v *int32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	valuesArg, valuesFin := wrapFloat(values)
-	defer valuesFin()
+	vArg, vFin := wrapInt32(v)
+	defer vFin()
 
-	overlay_textArg, overlay_textFin := wrapString(overlay_text)
-	defer overlay_textFin()
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
+
+	return C.SliderInt(labelArg, vArg, C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+}
 
-	C.PlotLines_FloatPtr(labelArg, valuesArg, C.int(values_count), C.int(values_offset), overlay_textArg, C.float(scale_min), C.float(scale_max), graph_size.toC(), C.int(stride))
+func SliderInt(label string, v *int32, v_min int32, v_max int32) bool {
+	return SliderIntV(label, v, v_min, v_max, "%d", 0)
 }
 
-func SliderInt2(label string, v [2]*int32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
+// SliderInt2V parameter default value hint:
+// format: "%d"
+// flags: 0
+func SliderInt2V(label string, v [2]*int32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
@@ -1584,431 +1949,543 @@ func SliderInt2(label string, v [2]*int32, v_min int32, v_max int32, format stri
 	return C.SliderInt2(labelArg, (*C.int)(&vArg[0]), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func TableSetColumnIndex(column_n int32) bool {
-	return C.TableSetColumnIndex(C.int(column_n)) == C.bool(true)
-}
-
-func (self ImGuiPopupData) Destroy() {
-	C.PopupData_Destroy(self.handle())
+func SliderInt2(label string, v [2]*int32, v_min int32, v_max int32) bool {
+	return SliderInt2V(label, v, v_min, v_max, "%d", 0)
 }
 
-func ColorButton(desc_id string, col ImVec4, flags ImGuiColorEditFlags, size ImVec2) bool {
-	desc_idArg, desc_idFin := wrapString(desc_id)
-	defer desc_idFin()
+// SliderInt3V parameter default value hint:
+// format: "%d"
+// flags: 0
+func SliderInt3V(label string, v [3]*int32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	return C.ColorButton(desc_idArg, col.toC(), C.ImGuiColorEditFlags(flags), size.toC()) == C.bool(true)
-}
+	vArg := make([]C.int, len(v))
+	for i, vV := range v {
+		vArg[i] = C.int(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = int32(vV)
+		}
+	}()
 
-func PushButtonRepeat(repeat bool) {
-	C.PushButtonRepeat(C.bool(repeat))
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func SetWindowFocus_Nil() {
-	C.SetWindowFocus_Nil()
+	return C.SliderInt3(labelArg, (*C.int)(&vArg[0]), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func SetWindowFocus_Str(name string) {
-	nameArg, nameFin := wrapString(name)
-	defer nameFin()
-
-	C.SetWindowFocus_Str(nameArg)
+func SliderInt3(label string, v [3]*int32, v_min int32, v_max int32) bool {
+	return SliderInt3V(label, v, v_min, v_max, "%d", 0)
 }
 
-func BeginPopupModal(name string, p_open *bool, flags ImGuiWindowFlags) bool {
-	nameArg, nameFin := wrapString(name)
-	defer nameFin()
+// SliderInt4V parameter default value hint:
+// format: "%d"
+// flags: 0
+func SliderInt4V(label string, v [4]*int32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	p_openArg, p_openFin := wrapBool(p_open)
-	defer p_openFin()
+	vArg := make([]C.int, len(v))
+	for i, vV := range v {
+		vArg[i] = C.int(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = int32(vV)
+		}
+	}()
 
-	return C.BeginPopupModal(nameArg, p_openArg, C.ImGuiWindowFlags(flags)) == C.bool(true)
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func Dummy(size ImVec2) {
-	C.Dummy(size.toC())
+	return C.SliderInt4(labelArg, (*C.int)(&vArg[0]), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func CalcTextSize(pOut *ImVec2, text string, hide_text_after_double_hash bool, wrap_width float32) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
-
-	textArg, textFin := wrapString(text)
-	defer textFin()
-
-	C.CalcTextSize(pOutArg, textArg, C.bool(hide_text_after_double_hash), C.float(wrap_width))
+func SliderInt4(label string, v [4]*int32, v_min int32, v_max int32) bool {
+	return SliderInt4V(label, v, v_min, v_max, "%d", 0)
 }
 
-func DestroyPlatformWindows() {
-	C.DestroyPlatformWindows()
-}
+// SliderScalarV parameter default value hint:
+// format: NULL
+// flags: 0
+func SliderScalarV(label string, data_type ImGuiDataType, p_data unsafe.Pointer, p_min unsafe.Pointer, p_max unsafe.Pointer, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func (self ImFont) BuildLookupTable() {
-	C.Font_BuildLookupTable(self.handle())
-}
+	formatArg, formatFin := wrapNullableString(format)
+	defer formatFin()
 
-func (self ImDrawList) PathStroke(col uint32, flags ImDrawFlags, thickness float32) {
-	C.DrawList_PathStroke(self.handle(), C.ImU32(col), C.ImDrawFlags(flags), C.float(thickness))
+	return C.SliderScalar(labelArg, C.ImGuiDataType(data_type), p_data, p_min, p_max, formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func (self ImDrawList) PrimReserve(idx_count int32, vtx_count int32) {
-	C.DrawList_PrimReserve(self.handle(), C.int(idx_count), C.int(vtx_count))
+func SliderScalar(label string, data_type ImGuiDataType, p_data unsafe.Pointer, p_min unsafe.Pointer, p_max unsafe.Pointer) bool {
+	return SliderScalarV(label, data_type, p_data, p_min, p_max, "", 0)
 }
 
-func GetWindowDpiScale() float32 {
-	return float32(C.GetWindowDpiScale())
-}
+// SliderScalarNV parameter default value hint:
+// format: NULL
+// flags: 0
+func SliderScalarNV(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32, p_min unsafe.Pointer, p_max unsafe.Pointer, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func (self ImDrawList) AddImageQuad(user_texture_id ImTextureID, p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, uv1 ImVec2, uv2 ImVec2, uv3 ImVec2, uv4 ImVec2, col uint32) {
-	C.DrawList_AddImageQuad(self.handle(), C.ImTextureID(user_texture_id), p1.toC(), p2.toC(), p3.toC(), p4.toC(), uv1.toC(), uv2.toC(), uv3.toC(), uv4.toC(), C.ImU32(col))
-}
+	formatArg, formatFin := wrapNullableString(format)
+	defer formatFin()
 
-func (self ImGuiListClipper) Begin(items_count int32, items_height float32) {
-	C.ListClipper_Begin(self.handle(), C.int(items_count), C.float(items_height))
+	return C.SliderScalarN(labelArg, C.ImGuiDataType(data_type), p_data, C.int(components), p_min, p_max, formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func BeginMenuBar() bool {
-	return C.BeginMenuBar() == C.bool(true)
+func SliderScalarN(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32, p_min unsafe.Pointer, p_max unsafe.Pointer) bool {
+	return SliderScalarNV(label, data_type, p_data, components, p_min, p_max, "", 0)
 }
 
-func (self ImDrawList) PathClear() {
-	C.DrawList_PathClear(self.handle())
-}
+// VSliderFloatV parameter default value hint:
+// format: "%.3f"
+// flags: 0
+func VSliderFloatV(label string, size ImVec2, v *float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func (self ImFontGlyphRangesBuilder) Destroy() {
-	C.FontGlyphRangesBuilder_Destroy(self.handle())
-}
+	vArg, vFin := wrapFloat(v)
+	defer vFin()
 
-func (self ImFontAtlas) AddFont(font_cfg ImFontConfig) ImFont {
-	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFont(self.handle(), font_cfg.handle())))
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func (self ImGuiIO) SetAppAcceptingEvents(accepting_events bool) {
-	C.IO_SetAppAcceptingEvents(self.handle(), C.bool(accepting_events))
+	return C.VSliderFloat(labelArg, size.toC(), vArg, C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func EndChild() {
-	C.EndChild()
+func VSliderFloat(label string, size ImVec2, v *float32, v_min float32, v_max float32) bool {
+	return VSliderFloatV(label, size, v, v_min, v_max, "%.3f", 0)
 }
 
-func InputInt4(label string, v [4]*int32, flags ImGuiInputTextFlags) bool {
+// VSliderIntV parameter default value hint:
+// format: "%d"
+// flags: 0
+func VSliderIntV(label string, size ImVec2, v *int32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg := make([]C.int, len(v))
-	for i, vV := range v {
-		vArg[i] = C.int(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = int32(vV)
-		}
-	}()
+	vArg, vFin := wrapInt32(v)
+	defer vFin()
 
-	return C.InputInt4(labelArg, (*C.int)(&vArg[0]), C.ImGuiInputTextFlags(flags)) == C.bool(true)
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func TextWrapped(fmt string) {
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
+	return C.VSliderInt(labelArg, size.toC(), vArg, C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+}
 
-	C.TextWrapped(fmtArg)
+func VSliderInt(label string, size ImVec2, v *int32, v_min int32, v_max int32) bool {
+	return VSliderIntV(label, size, v, v_min, v_max, "%d", 0)
 }
 
-func (self ImFontAtlas) AddFontFromMemoryCompressedBase85TTF(compressed_font_data_base85 string, size_pixels float32, font_cfg ImFontConfig, glyph_ranges *ImWchar) ImFont {
-	compressed_font_data_base85Arg, compressed_font_data_base85Fin := wrapString(compressed_font_data_base85)
-	defer compressed_font_data_base85Fin()
+// VSliderScalarV parameter default value hint:
+// format: NULL
+// flags: 0
+func VSliderScalarV(label string, size ImVec2, data_type ImGuiDataType, p_data unsafe.Pointer, p_min unsafe.Pointer, p_max unsafe.Pointer, format string, flags ImGuiSliderFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFontFromMemoryCompressedBase85TTF(self.handle(), compressed_font_data_base85Arg, C.float(size_pixels), font_cfg.handle(), (*C.ImWchar)(glyph_ranges))))
-}
+	formatArg, formatFin := wrapNullableString(format)
+	defer formatFin()
 
-func (self ImGuiTableTempData) Destroy() {
-	C.TableTempData_Destroy(self.handle())
+	return C.VSliderScalar(labelArg, size.toC(), C.ImGuiDataType(data_type), p_data, p_min, p_max, formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
 }
 
-func IsItemDeactivated() bool {
-	return C.IsItemDeactivated() == C.bool(true)
+func VSliderScalar(label string, size ImVec2, data_type ImGuiDataType, p_data unsafe.Pointer, p_min unsafe.Pointer, p_max unsafe.Pointer) bool {
+	return VSliderScalarV(label, size, data_type, p_data, p_min, p_max, "", 0)
 }
 
-func LogToTTY(auto_open_depth int32) {
-	C.LogToTTY(C.int(auto_open_depth))
-}
+// InputFloatV parameter default value hint:
+// step: 0.0f
+// step_fast: 0.0f
+// format: "%.3f"
+// flags: 0
+func InputFloatV(label string, v *float32, step float32, step_fast float32, format string, flags ImGuiInputTextFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func StyleColorsLight(dst ImGuiStyle) {
-	C.StyleColorsLight(dst.handle())
-}
+	vArg, vFin := wrapFloat(v)
+	defer vFin()
 
-func NewDrawCmd() ImDrawCmd {
-	return (ImDrawCmd)(unsafe.Pointer(C.DrawCmd_ImDrawCmd()))
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
+
+	return C.InputFloat(labelArg, vArg, C.float(step), C.float(step_fast), formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func (self ImDrawList) PrimRectUV(a ImVec2, b ImVec2, uv_a ImVec2, uv_b ImVec2, col uint32) {
-	C.DrawList_PrimRectUV(self.handle(), a.toC(), b.toC(), uv_a.toC(), uv_b.toC(), C.ImU32(col))
+func InputFloat(label string, v *float32) bool {
+	return InputFloatV(label, v, 0.0, 0.0, "%.3f", 0)
 }
 
-func GetScrollX() float32 {
-	return float32(C.GetScrollX())
+// InputFloatOptions are the default arguments of InputFloatV, see DefaultInputFloatOptions.
+type InputFloatOptions struct {
+	Step     float32
+	StepFast float32
+	Format   string
+	Flags    ImGuiInputTextFlags
 }
 
-func (self ImFontAtlas) AddFontFromMemoryTTF(font_data unsafe.Pointer, font_size int32, size_pixels float32, font_cfg ImFontConfig, glyph_ranges *ImWchar) ImFont {
-	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFontFromMemoryTTF(self.handle(), font_data, C.int(font_size), C.float(size_pixels), font_cfg.handle(), (*C.ImWchar)(glyph_ranges))))
+// DefaultInputFloatOptions returns the C++ default arguments of InputFloatV.
+func DefaultInputFloatOptions() InputFloatOptions {
+	return InputFloatOptions{
+		Step:     0.0,
+		StepFast: 0.0,
+		Format:   "%.3f",
+		Flags:    0,
+	}
 }
 
-func IsItemToggledOpen() bool {
-	return C.IsItemToggledOpen() == C.bool(true)
+// InputFloatWithOptions is InputFloatV taking its default arguments in options.
+func InputFloatWithOptions(label string, v *float32, options InputFloatOptions) bool {
+	return InputFloatV(label, v, options.Step, options.StepFast, options.Format, options.Flags)
 }
 
-func TableSetupColumn(label string, flags ImGuiTableColumnFlags, init_width_or_weight float32, user_id ImGuiID) {
+// InputFloat2V parameter default value hint:
+// format: "%.3f"
+// flags: 0
+func InputFloat2V(label string, v [2]*float32, format string, flags ImGuiInputTextFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	C.TableSetupColumn(labelArg, C.ImGuiTableColumnFlags(flags), C.float(init_width_or_weight), C.ImGuiID(user_id))
-}
-
-func TreePush_Str(str_id string) {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
-
-	C.TreePush_Str(str_idArg)
-}
+	vArg := make([]C.float, len(v))
+	for i, vV := range v {
+		vArg[i] = C.float(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = float32(vV)
+		}
+	}()
 
-func TreePush_Ptr(ptr_id unsafe.Pointer) {
-	C.TreePush_Ptr(ptr_id)
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func NewInputTextCallbackData() ImGuiInputTextCallbackData {
-	return (ImGuiInputTextCallbackData)(unsafe.Pointer(C.InputTextCallbackData_ImGuiInputTextCallbackData()))
+	return C.InputFloat2(labelArg, (*C.float)(&vArg[0]), formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func (self ImFontGlyphRangesBuilder) AddRanges(ranges *ImWchar) {
-	C.FontGlyphRangesBuilder_AddRanges(self.handle(), (*C.ImWchar)(ranges))
+func InputFloat2(label string, v [2]*float32) bool {
+	return InputFloat2V(label, v, "%.3f", 0)
 }
 
-func DragInt4(label string, v [4]*int32, v_speed float32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
+// InputFloat3V parameter default value hint:
+// format: "%.3f"
+// flags: 0
+func InputFloat3V(label string, v [3]*float32, format string, flags ImGuiInputTextFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg := make([]C.int, len(v))
+	vArg := make([]C.float, len(v))
 	for i, vV := range v {
-		vArg[i] = C.int(*vV)
+		vArg[i] = C.float(*vV)
 	}
 	defer func() {
 		for i, vV := range vArg {
-			*v[i] = int32(vV)
+			*v[i] = float32(vV)
 		}
 	}()
 
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 
-	return C.DragInt4(labelArg, (*C.int)(&vArg[0]), C.float(v_speed), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	return C.InputFloat3(labelArg, (*C.float)(&vArg[0]), formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func End() {
-	C.End()
+func InputFloat3(label string, v [3]*float32) bool {
+	return InputFloat3V(label, v, "%.3f", 0)
 }
 
-func GetColumnIndex() int {
-	return int(C.GetColumnIndex())
-}
+// InputFloat4V parameter default value hint:
+// format: "%.3f"
+// flags: 0
+func InputFloat4V(label string, v [4]*float32, format string, flags ImGuiInputTextFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func SetNextWindowCollapsed(collapsed bool, cond ImGuiCond) {
-	C.SetNextWindowCollapsed(C.bool(collapsed), C.ImGuiCond(cond))
-}
+	vArg := make([]C.float, len(v))
+	for i, vV := range v {
+		vArg[i] = C.float(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = float32(vV)
+		}
+	}()
 
-func (self ImGuiInputTextCallbackData) SelectAll() {
-	C.InputTextCallbackData_SelectAll(self.handle())
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func (self ImGuiTextBuffer) c_str() string {
-	return C.GoString(C.TextBuffer_c_str(self.handle()))
+	return C.InputFloat4(labelArg, (*C.float)(&vArg[0]), formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func BeginDisabled(disabled bool) {
-	C.BeginDisabled(C.bool(disabled))
+func InputFloat4(label string, v [4]*float32) bool {
+	return InputFloat4V(label, v, "%.3f", 0)
 }
 
-func InputFloat(label string, v *float32, step float32, step_fast float32, format string, flags ImGuiInputTextFlags) bool {
+// InputIntV parameter default value hint:
+// step: 1
+// step_fast: 100
+// flags: 0
+func InputIntV(label string, v *int32, step int32, step_fast int32, flags ImGuiInputTextFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg, vFin := wrapFloat(v)
+	vArg, vFin := wrapInt32(v)
 	defer vFin()
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
-
-	return C.InputFloat(labelArg, vArg, C.float(step), C.float(step_fast), formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
-}
-
-func IsItemActivated() bool {
-	return C.IsItemActivated() == C.bool(true)
+	return C.InputInt(labelArg, vArg, C.int(step), C.int(step_fast), C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func PopItemWidth() {
-	C.PopItemWidth()
+func InputInt(label string, v *int32) bool {
+	return InputIntV(label, v, 1, 100, 0)
 }
 
-func GetMouseDragDelta(pOut *ImVec2, button ImGuiMouseButton, lock_threshold float32) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
-
-	C.GetMouseDragDelta(pOutArg, C.ImGuiMouseButton(button), C.float(lock_threshold))
-}
+// InputInt2V parameter default value hint:
+// flags: 0
+func InputInt2V(label string, v [2]*int32, flags ImGuiInputTextFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func (self ImFont) CalcWordWrapPositionA(scale float32, text string, wrap_width float32) string {
-	textArg, textFin := wrapString(text)
-	defer textFin()
+	vArg := make([]C.int, len(v))
+	for i, vV := range v {
+		vArg[i] = C.int(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = int32(vV)
+		}
+	}()
 
-	return C.GoString(C.Font_CalcWordWrapPositionA(self.handle(), C.float(scale), textArg, C.float(wrap_width)))
+	return C.InputInt2(labelArg, (*C.int)(&vArg[0]), C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func GetDrawData() ImDrawData {
-	return (ImDrawData)(unsafe.Pointer(C.GetDrawData()))
+func InputInt2(label string, v [2]*int32) bool {
+	return InputInt2V(label, v, 0)
 }
 
-func GetDragDropPayload() ImGuiPayload {
-	return (ImGuiPayload)(unsafe.Pointer(C.GetDragDropPayload()))
-}
+// InputInt3V parameter default value hint:
+// flags: 0
+func InputInt3V(label string, v [3]*int32, flags ImGuiInputTextFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func GetMousePosOnOpeningCurrentPopup(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+	vArg := make([]C.int, len(v))
+	for i, vV := range v {
+		vArg[i] = C.int(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = int32(vV)
+		}
+	}()
 
-	C.GetMousePosOnOpeningCurrentPopup(pOutArg)
+	return C.InputInt3(labelArg, (*C.int)(&vArg[0]), C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func (self ImFontGlyphRangesBuilder) AddText(text string) {
-	textArg, textFin := wrapString(text)
-	defer textFin()
-
-	C.FontGlyphRangesBuilder_AddText(self.handle(), textArg)
+func InputInt3(label string, v [3]*int32) bool {
+	return InputInt3V(label, v, 0)
 }
 
-func (self ImGuiTextBuffer) Destroy() {
-	C.TextBuffer_Destroy(self.handle())
-}
+// InputInt4V parameter default value hint:
+// flags: 0
+func InputInt4V(label string, v [4]*int32, flags ImGuiInputTextFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func (self ImFontAtlasCustomRect) IsPacked() bool {
-	return C.FontAtlasCustomRect_IsPacked(self.handle()) == C.bool(true)
-}
+	vArg := make([]C.int, len(v))
+	for i, vV := range v {
+		vArg[i] = C.int(*vV)
+	}
+	defer func() {
+		for i, vV := range vArg {
+			*v[i] = int32(vV)
+		}
+	}()
 
-func GetWindowContentRegionMax(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+	return C.InputInt4(labelArg, (*C.int)(&vArg[0]), C.ImGuiInputTextFlags(flags)) == C.bool(true)
+}
 
-	C.GetWindowContentRegionMax(pOutArg)
+func InputInt4(label string, v [4]*int32) bool {
+	return InputInt4V(label, v, 0)
 }
 
-func SetTooltip(fmt string) {
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
+// InputDoubleV parameter default value hint:
+// step: 0.0
+// step_fast: 0.0
+// format: "%.6f"
+// flags: 0
+func InputDoubleV(label string, v *float64, step float64, step_fast float64, format string, flags ImGuiInputTextFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	C.SetTooltip(fmtArg)
-}
+	formatArg, formatFin := wrapString(format)
+	defer formatFin()
 
-func (self ImDrawListSplitter) Merge(draw_list ImDrawList) {
-	C.DrawListSplitter_Merge(self.handle(), draw_list.handle())
+	return C.InputDouble(labelArg, (*C.double)(v), C.double(step), C.double(step_fast), formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func (self ImDrawList) PathArcTo(center ImVec2, radius float32, a_min float32, a_max float32, num_segments int32) {
-	C.DrawList_PathArcTo(self.handle(), center.toC(), C.float(radius), C.float(a_min), C.float(a_max), C.int(num_segments))
+func InputDouble(label string, v *float64) bool {
+	return InputDoubleV(label, v, 0.0, 0.0, "%.6f", 0)
 }
 
-func GetPlatformIO() ImGuiPlatformIO {
-	return (ImGuiPlatformIO)(unsafe.Pointer(C.GetPlatformIO()))
+// InputDoubleOptions are the default arguments of InputDoubleV, see DefaultInputDoubleOptions.
+type InputDoubleOptions struct {
+	Step     float64
+	StepFast float64
+	Format   string
+	Flags    ImGuiInputTextFlags
 }
 
-func (self ImGuiMenuColumns) Destroy() {
-	C.MenuColumns_Destroy(self.handle())
+// DefaultInputDoubleOptions returns the C++ default arguments of InputDoubleV.
+func DefaultInputDoubleOptions() InputDoubleOptions {
+	return InputDoubleOptions{
+		Step:     0.0,
+		StepFast: 0.0,
+		Format:   "%.6f",
+		Flags:    0,
+	}
 }
 
-func (self ImGuiTextBuffer) End() string {
-	return C.GoString(C.TextBuffer_End(self.handle()))
+// InputDoubleWithOptions is InputDoubleV taking its default arguments in options.
+func InputDoubleWithOptions(label string, v *float64, options InputDoubleOptions) bool {
+	return InputDoubleV(label, v, options.Step, options.StepFast, options.Format, options.Flags)
 }
 
-func DragScalarN(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32, v_speed float32, p_min unsafe.Pointer, p_max unsafe.Pointer, format string, flags ImGuiSliderFlags) bool {
+// InputScalarV parameter default value hint:
+// p_step: NULL
+// p_step_fast: NULL
+// format: NULL
+// flags: 0
+func InputScalarV(label string, data_type ImGuiDataType, p_data unsafe.Pointer, p_step unsafe.Pointer, p_step_fast unsafe.Pointer, format string, flags ImGuiInputTextFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	formatArg, formatFin := wrapString(format)
+	formatArg, formatFin := wrapNullableString(format)
 	defer formatFin()
 
-	return C.DragScalarN(labelArg, C.ImGuiDataType(data_type), p_data, C.int(components), C.float(v_speed), p_min, p_max, formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	return C.InputScalar(labelArg, C.ImGuiDataType(data_type), p_data, p_step, p_step_fast, formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func ProgressBar(fraction float32, size_arg ImVec2, overlay string) {
-	overlayArg, overlayFin := wrapString(overlay)
-	defer overlayFin()
+func InputScalar(label string, data_type ImGuiDataType, p_data unsafe.Pointer) bool {
+	return InputScalarV(label, data_type, p_data, nil, nil, "", 0)
+}
 
-	C.ProgressBar(C.float(fraction), size_arg.toC(), overlayArg)
+// InputScalarOptions are the default arguments of InputScalarV, see DefaultInputScalarOptions.
+type InputScalarOptions struct {
+	PStep     unsafe.Pointer
+	PStepFast unsafe.Pointer
+	Format    string
+	Flags     ImGuiInputTextFlags
 }
 
-func SetColumnWidth(column_index int32, width float32) {
-	C.SetColumnWidth(C.int(column_index), C.float(width))
+// DefaultInputScalarOptions returns the C++ default arguments of InputScalarV.
+func DefaultInputScalarOptions() InputScalarOptions {
+	return InputScalarOptions{
+		PStep:     nil,
+		PStepFast: nil,
+		Format:    "",
+		Flags:     0,
+	}
 }
 
-func NewWindowClass() ImGuiWindowClass {
-	return (ImGuiWindowClass)(unsafe.Pointer(C.WindowClass_ImGuiWindowClass()))
+// InputScalarWithOptions is InputScalarV taking its default arguments in options.
+func InputScalarWithOptions(label string, data_type ImGuiDataType, p_data unsafe.Pointer, options InputScalarOptions) bool {
+	return InputScalarV(label, data_type, p_data, options.PStep, options.PStepFast, options.Format, options.Flags)
 }
 
-func GetItemRectMax(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+// InputScalarNV parameter default value hint:
+// p_step: NULL
+// p_step_fast: NULL
+// format: NULL
+// flags: 0
+func InputScalarNV(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32, p_step unsafe.Pointer, p_step_fast unsafe.Pointer, format string, flags ImGuiInputTextFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	C.GetItemRectMax(pOutArg)
-}
+	formatArg, formatFin := wrapNullableString(format)
+	defer formatFin()
 
-func (self ImDrawListSplitter) Destroy() {
-	C.DrawListSplitter_Destroy(self.handle())
+	return C.InputScalarN(labelArg, C.ImGuiDataType(data_type), p_data, C.int(components), p_step, p_step_fast, formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func (self ImDrawList) AddLine(p1 ImVec2, p2 ImVec2, col uint32, thickness float32) {
-	C.DrawList_AddLine(self.handle(), p1.toC(), p2.toC(), C.ImU32(col), C.float(thickness))
+func InputScalarN(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32) bool {
+	return InputScalarNV(label, data_type, p_data, components, nil, nil, "", 0)
 }
 
-func TableGetColumnCount() int {
-	return int(C.TableGetColumnCount())
+// InputScalarNOptions are the default arguments of InputScalarNV, see DefaultInputScalarNOptions.
+type InputScalarNOptions struct {
+	PStep     unsafe.Pointer
+	PStepFast unsafe.Pointer
+	Format    string
+	Flags     ImGuiInputTextFlags
 }
 
-func SetColumnOffset(column_index int32, offset_x float32) {
-	C.SetColumnOffset(C.int(column_index), C.float(offset_x))
+// DefaultInputScalarNOptions returns the C++ default arguments of InputScalarNV.
+func DefaultInputScalarNOptions() InputScalarNOptions {
+	return InputScalarNOptions{
+		PStep:     nil,
+		PStepFast: nil,
+		Format:    "",
+		Flags:     0,
+	}
 }
 
-func (self ImFont) IsLoaded() bool {
-	return C.Font_IsLoaded(self.handle()) == C.bool(true)
+// InputScalarNWithOptions is InputScalarNV taking its default arguments in options.
+func InputScalarNWithOptions(label string, data_type ImGuiDataType, p_data unsafe.Pointer, components int32, options InputScalarNOptions) bool {
+	return InputScalarNV(label, data_type, p_data, components, options.PStep, options.PStepFast, options.Format, options.Flags)
 }
 
-func (self ImGuiPlatformIO) Destroy() {
-	C.PlatformIO_Destroy(self.handle())
-}
+// ColorEdit3V parameter default value hint:
+// flags: 0
+func ColorEdit3V(label string, col [3]*float32, flags ImGuiColorEditFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func (self ImDrawList) ChannelsSplit(count int32) {
-	C.DrawList_ChannelsSplit(self.handle(), C.int(count))
-}
+	colArg := make([]C.float, len(col))
+	for i, colV := range col {
+		colArg[i] = C.float(*colV)
+	}
+	defer func() {
+		for i, colV := range colArg {
+			*col[i] = float32(colV)
+		}
+	}()
 
-func PushFont(font ImFont) {
-	C.PushFont(font.handle())
+	return C.ColorEdit3(labelArg, (*C.float)(&colArg[0]), C.ImGuiColorEditFlags(flags)) == C.bool(true)
 }
 
-func IsKeyReleased(key ImGuiKey) bool {
-	return C.IsKeyReleased(C.ImGuiKey(key)) == C.bool(true)
+func ColorEdit3(label string, col [3]*float32) bool {
+	return ColorEdit3V(label, col, 0)
 }
 
-func BeginTabItem(label string, p_open *bool, flags ImGuiTabItemFlags) bool {
+// ColorEdit4V parameter default value hint:
+// flags: 0
+func ColorEdit4V(label string, col [4]*float32, flags ImGuiColorEditFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	p_openArg, p_openFin := wrapBool(p_open)
-	defer p_openFin()
+	colArg := make([]C.float, len(col))
+	for i, colV := range col {
+		colArg[i] = C.float(*colV)
+	}
+	defer func() {
+		for i, colV := range colArg {
+			*col[i] = float32(colV)
+		}
+	}()
 
-	return C.BeginTabItem(labelArg, p_openArg, C.ImGuiTabItemFlags(flags)) == C.bool(true)
+	return C.ColorEdit4(labelArg, (*C.float)(&colArg[0]), C.ImGuiColorEditFlags(flags)) == C.bool(true)
 }
 
-func GetScrollMaxX() float32 {
-	return float32(C.GetScrollMaxX())
+func ColorEdit4(label string, col [4]*float32) bool {
+	return ColorEdit4V(label, col, 0)
 }
 
-func ColorPicker3(label string, col [3]*float32, flags ImGuiColorEditFlags) bool {
+// ColorPicker3V parameter default value hint:
+// flags: 0
+func ColorPicker3V(label string, col [3]*float32, flags ImGuiColorEditFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
@@ -2025,1268 +2502,1509 @@ func ColorPicker3(label string, col [3]*float32, flags ImGuiColorEditFlags) bool
 	return C.ColorPicker3(labelArg, (*C.float)(&colArg[0]), C.ImGuiColorEditFlags(flags)) == C.bool(true)
 }
 
-func IsAnyItemFocused() bool {
-	return C.IsAnyItemFocused() == C.bool(true)
+func ColorPicker3(label string, col [3]*float32) bool {
+	return ColorPicker3V(label, col, 0)
 }
 
-func VSliderScalar(label string, size ImVec2, data_type ImGuiDataType, p_data unsafe.Pointer, p_min unsafe.Pointer, p_max unsafe.Pointer, format string, flags ImGuiSliderFlags) bool {
+// ColorPicker4V parameter default value hint:
+// flags: 0
+// ref_col: NULL
+func ColorPicker4V(label string, col [4]*float32, flags ImGuiColorEditFlags, ref_col *float32) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+	colArg := make([]C.float, len(col))
+	for i, colV := range col {
+		colArg[i] = C.float(*colV)
+	}
+	defer func() {
+		for i, colV := range colArg {
+			*col[i] = float32(colV)
+		}
+	}()
 
-	return C.VSliderScalar(labelArg, size.toC(), C.ImGuiDataType(data_type), p_data, p_min, p_max, formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	ref_colArg, ref_colFin := wrapFloat(ref_col)
+	defer ref_colFin()
+
+	return C.ColorPicker4(labelArg, (*C.float)(&colArg[0]), C.ImGuiColorEditFlags(flags), ref_colArg) == C.bool(true)
 }
 
-func GetScrollY() float32 {
-	return float32(C.GetScrollY())
+func ColorPicker4(label string, col [4]*float32) bool {
+	return ColorPicker4V(label, col, 0, nil)
 }
 
-func (self ImDrawList) CloneOutput() ImDrawList {
-	return (ImDrawList)(unsafe.Pointer(C.DrawList_CloneOutput(self.handle())))
+// ColorButtonV parameter default value hint:
+// flags: 0
+// size: ImVec2(0,0)
+func ColorButtonV(desc_id string, col ImVec4, flags ImGuiColorEditFlags, size ImVec2) bool {
+	desc_idArg, desc_idFin := wrapString(desc_id)
+	defer desc_idFin()
+
+	return C.ColorButton(desc_idArg, col.toC(), C.ImGuiColorEditFlags(flags), size.toC()) == C.bool(true)
 }
 
-func (self ImDrawListSplitter) Clear() {
-	C.DrawListSplitter_Clear(self.handle())
+func ColorButton(desc_id string, col ImVec4) bool {
+	return ColorButtonV(desc_id, col, 0, NewImVec2(0, 0))
 }
 
-func TableGetColumnIndex() int {
-	return int(C.TableGetColumnIndex())
+func SetColorEditOptions(flags ImGuiColorEditFlags) {
+	C.SetColorEditOptions(C.ImGuiColorEditFlags(flags))
 }
 
-func ColorConvertRGBtoHSV(r float32, g float32, b float32, out_h *float32, out_s *float32, out_v *float32) {
-	out_hArg, out_hFin := wrapFloat(out_h)
-	defer out_hFin()
+func TreeNode_Str(label string) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	out_sArg, out_sFin := wrapFloat(out_s)
-	defer out_sFin()
+	return C.TreeNode_Str(labelArg) == C.bool(true)
+}
 
-	out_vArg, out_vFin := wrapFloat(out_v)
-	defer out_vFin()
+func TreeNode_StrStr(str_id string, fmt string) bool {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
 
-	C.ColorConvertRGBtoHSV(C.float(r), C.float(g), C.float(b), out_hArg, out_sArg, out_vArg)
-}
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
 
-func EndChildFrame() {
-	C.EndChildFrame()
+	return C.TreeNode_StrStr(str_idArg, fmtArg) == C.bool(true)
 }
 
-func GetScrollMaxY() float32 {
-	return float32(C.GetScrollMaxY())
+func TreeNode_Ptr(ptr_id unsafe.Pointer, fmt string) bool {
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
+
+	return C.TreeNode_Ptr(ptr_id, fmtArg) == C.bool(true)
 }
 
-func IsMouseReleased(button ImGuiMouseButton) bool {
-	return C.IsMouseReleased(C.ImGuiMouseButton(button)) == C.bool(true)
-}
+// TreeNodeEx_StrV parameter default value hint:
+// flags: 0
+func TreeNodeEx_StrV(label string, flags ImGuiTreeNodeFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func (self ImFont) AddGlyph(src_cfg ImFontConfig, c ImWchar, x0 float32, y0 float32, x1 float32, y1 float32, u0 float32, v0 float32, u1 float32, v1 float32, advance_x float32) {
-	C.Font_AddGlyph(self.handle(), src_cfg.handle(), C.ImWchar(c), C.float(x0), C.float(y0), C.float(x1), C.float(y1), C.float(u0), C.float(v0), C.float(u1), C.float(v1), C.float(advance_x))
+	return C.TreeNodeEx_Str(labelArg, C.ImGuiTreeNodeFlags(flags)) == C.bool(true)
 }
 
-func IsItemClicked(mouse_button ImGuiMouseButton) bool {
-	return C.IsItemClicked(C.ImGuiMouseButton(mouse_button)) == C.bool(true)
+func TreeNodeEx_Str(label string) bool {
+	return TreeNodeEx_StrV(label, 0)
 }
 
-func VSliderFloat(label string, size ImVec2, v *float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func TreeNodeEx_StrStr(str_id string, flags ImGuiTreeNodeFlags, fmt string) bool {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
 
-	vArg, vFin := wrapFloat(v)
-	defer vFin()
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+	return C.TreeNodeEx_StrStr(str_idArg, C.ImGuiTreeNodeFlags(flags), fmtArg) == C.bool(true)
+}
 
-	return C.VSliderFloat(labelArg, size.toC(), vArg, C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+func TreeNodeEx_Ptr(ptr_id unsafe.Pointer, flags ImGuiTreeNodeFlags, fmt string) bool {
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
+
+	return C.TreeNodeEx_Ptr(ptr_id, C.ImGuiTreeNodeFlags(flags), fmtArg) == C.bool(true)
 }
 
-func (self ImGuiIO) AddMouseButtonEvent(button int32, down bool) {
-	C.IO_AddMouseButtonEvent(self.handle(), C.int(button), C.bool(down))
+func TreePush_Str(str_id string) {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
+
+	C.TreePush_Str(str_idArg)
 }
 
-func NewFontAtlas() ImFontAtlas {
-	return (ImFontAtlas)(unsafe.Pointer(C.FontAtlas_ImFontAtlas()))
+// TreePush_PtrV parameter default value hint:
+// ptr_id: NULL
+func TreePush_PtrV(ptr_id unsafe.Pointer) {
+	C.TreePush_Ptr(ptr_id)
 }
 
-func (self ImGuiInputTextCallbackData) HasSelection() bool {
-	return C.InputTextCallbackData_HasSelection(self.handle()) == C.bool(true)
+func TreePush_Ptr() {
+	TreePush_PtrV(nil)
 }
 
-func (self ImDrawList) PathFillConvex(col uint32) {
-	C.DrawList_PathFillConvex(self.handle(), C.ImU32(col))
+func TreePop() {
+	C.TreePop()
 }
 
-func SetNextFrameWantCaptureMouse(want_capture_mouse bool) {
-	C.SetNextFrameWantCaptureMouse(C.bool(want_capture_mouse))
+func GetTreeNodeToLabelSpacing() float32 {
+	return float32(C.GetTreeNodeToLabelSpacing())
 }
 
-func SliderInt3(label string, v [3]*int32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
+// CollapsingHeader_TreeNodeFlagsV parameter default value hint:
+// flags: 0
+func CollapsingHeader_TreeNodeFlagsV(label string, flags ImGuiTreeNodeFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg := make([]C.int, len(v))
-	for i, vV := range v {
-		vArg[i] = C.int(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = int32(vV)
-		}
-	}()
-
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+	return C.CollapsingHeader_TreeNodeFlags(labelArg, C.ImGuiTreeNodeFlags(flags)) == C.bool(true)
+}
 
-	return C.SliderInt3(labelArg, (*C.int)(&vArg[0]), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+func CollapsingHeader_TreeNodeFlags(label string) bool {
+	return CollapsingHeader_TreeNodeFlagsV(label, 0)
 }
 
-func (self ImGuiTextBuffer) Appendf(fmt string) {
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
+// CollapsingHeader_BoolPtrV parameter default value hint:
+// flags: 0
+func CollapsingHeader_BoolPtrV(label string, p_visible *bool, flags ImGuiTreeNodeFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	C.TextBuffer_Appendf(self.handle(), fmtArg)
-}
+	p_visibleArg, p_visibleFin := wrapBool(p_visible)
+	defer p_visibleFin()
 
-func BeginMainMenuBar() bool {
-	return C.BeginMainMenuBar() == C.bool(true)
+	return C.CollapsingHeader_BoolPtr(labelArg, p_visibleArg, C.ImGuiTreeNodeFlags(flags)) == C.bool(true)
 }
 
-func IsKeyDown(key ImGuiKey) bool {
-	return C.IsKeyDown(C.ImGuiKey(key)) == C.bool(true)
+func CollapsingHeader_BoolPtr(label string, p_visible *bool) bool {
+	return CollapsingHeader_BoolPtrV(label, p_visible, 0)
 }
 
-func GetContentRegionMax(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
-
-	C.GetContentRegionMax(pOutArg)
+// SetNextItemOpenV parameter default value hint:
+// cond: 0
+func SetNextItemOpenV(is_open bool, cond ImGuiCond) {
+	C.SetNextItemOpen(C.bool(is_open), C.ImGuiCond(cond))
 }
 
-func GetItemRectSize(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
-
-	C.GetItemRectSize(pOutArg)
+func SetNextItemOpen(is_open bool) {
+	SetNextItemOpenV(is_open, 0)
 }
 
-func (self ImGuiStyle) Destroy() {
-	C.Style_Destroy(self.handle())
-}
+// Selectable_BoolV parameter default value hint:
+// selected: false
+// flags: 0
+// size: ImVec2(0,0)
+func Selectable_BoolV(label string, selected bool, flags ImGuiSelectableFlags, size ImVec2) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func GetTextLineHeight() float32 {
-	return float32(C.GetTextLineHeight())
+	return C.Selectable_Bool(labelArg, C.bool(selected), C.ImGuiSelectableFlags(flags), size.toC()) == C.bool(true)
 }
 
-func SetScrollHereX(center_x_ratio float32) {
-	C.SetScrollHereX(C.float(center_x_ratio))
+func Selectable_Bool(label string) bool {
+	return Selectable_BoolV(label, false, 0, NewImVec2(0, 0))
 }
 
-func TreePop() {
-	C.TreePop()
-}
+// Selectable_BoolPtrV parameter default value hint:
+// flags: 0
+// size: ImVec2(0,0)
+func Selectable_BoolPtrV(label string, p_selected *bool, flags ImGuiSelectableFlags, size ImVec2) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func SetScrollFromPosY_Float(local_y float32, center_y_ratio float32) {
-	C.SetScrollFromPosY_Float(C.float(local_y), C.float(center_y_ratio))
-}
+	p_selectedArg, p_selectedFin := wrapBool(p_selected)
+	defer p_selectedFin()
 
-func PopStyleVar(count int32) {
-	C.PopStyleVar(C.int(count))
+	return C.Selectable_BoolPtr(labelArg, p_selectedArg, C.ImGuiSelectableFlags(flags), size.toC()) == C.bool(true)
 }
 
-func (self ImDrawListSplitter) ClearFreeMemory() {
-	C.DrawListSplitter_ClearFreeMemory(self.handle())
+func Selectable_BoolPtr(label string, p_selected *bool) bool {
+	return Selectable_BoolPtrV(label, p_selected, 0, NewImVec2(0, 0))
 }
 
-func IsItemDeactivatedAfterEdit() bool {
-	return C.IsItemDeactivatedAfterEdit() == C.bool(true)
-}
+// BeginListBoxV parameter default value hint:
+// size: ImVec2(0,0)
+func BeginListBoxV(label string, size ImVec2) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func TableGetSortSpecs() ImGuiTableSortSpecs {
-	return (ImGuiTableSortSpecs)(unsafe.Pointer(C.TableGetSortSpecs()))
+	return C.BeginListBox(labelArg, size.toC()) == C.bool(true)
 }
 
-func TableSetBgColor(target ImGuiTableBgTarget, color uint32, column_n int32) {
-	C.TableSetBgColor(C.ImGuiTableBgTarget(target), C.ImU32(color), C.int(column_n))
+func BeginListBox(label string) bool {
+	return BeginListBoxV(label, NewImVec2(0, 0))
 }
 
-func (self ImFontAtlas) AddCustomRectFontGlyph(font ImFont, id ImWchar, width int32, height int32, advance_x float32, offset ImVec2) int {
-	return int(C.FontAtlas_AddCustomRectFontGlyph(self.handle(), font.handle(), C.ImWchar(id), C.int(width), C.int(height), C.float(advance_x), offset.toC()))
+func EndListBox() {
+	C.EndListBox()
 }
 
-func MemAlloc(size uint64) unsafe.Pointer {
-	return unsafe.Pointer(C.MemAlloc(C.xlong(size)))
-}
+// ListBoxV parameter default value hint:
+// height_in_items: -1
+func ListBoxV(label string, current_item *int32, items []string, height_in_items int32) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func Unindent(indent_w float32) {
-	C.Unindent(C.float(indent_w))
-}
+	current_itemArg, current_itemFin := wrapInt32(current_item)
+	defer current_itemFin()
 
-func (self ImFontAtlas) AddFontFromMemoryCompressedTTF(compressed_font_data unsafe.Pointer, compressed_font_size int32, size_pixels float32, font_cfg ImFontConfig, glyph_ranges *ImWchar) ImFont {
-	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFontFromMemoryCompressedTTF(self.handle(), compressed_font_data, C.int(compressed_font_size), C.float(size_pixels), font_cfg.handle(), (*C.ImWchar)(glyph_ranges))))
-}
+	itemsArg, itemsFin := wrapStrings(items)
+	defer itemsFin()
 
-func EndPopup() {
-	C.EndPopup()
+	return C.ListBox_Str_arr(labelArg, current_itemArg, itemsArg, C.int(len(items)), C.int(height_in_items)) == C.bool(true)
 }
 
-func Separator() {
-	C.Separator()
+func ListBox(label string, current_item *int32, items []string) bool {
+	return ListBoxV(label, current_item, items, -1)
 }
 
-func (self ImGuiTextBuffer) Append(str string, str_end string) {
-	strArg, strFin := wrapString(str)
-	defer strFin()
+// ListBox_FnBoolPtrV parameter default value hint:
+// height_in_items: -1
+func ListBox_FnBoolPtrV(label string, current_item *int32, items_getter ItemsGetter, items_count int32, height_in_items int32) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	str_endArg, str_endFin := wrapString(str_end)
-	defer str_endFin()
+	current_itemArg, current_itemFin := wrapInt32(current_item)
+	defer current_itemFin()
 
-	C.TextBuffer_Append(self.handle(), strArg, str_endArg)
-}
+	items_getterArg, items_getterFin := wrapItemsGetter(items_getter)
+	defer items_getterFin()
 
-func (self ImDrawListSplitter) SetCurrentChannel(draw_list ImDrawList, channel_idx int32) {
-	C.DrawListSplitter_SetCurrentChannel(self.handle(), draw_list.handle(), C.int(channel_idx))
+	return C.ListBox_FnBoolPtr(labelArg, current_itemArg, items_getterArg, C.int(items_count), C.int(height_in_items)) == C.bool(true)
 }
 
-func LogToFile(auto_open_depth int32, filename string) {
-	filenameArg, filenameFin := wrapString(filename)
-	defer filenameFin()
-
-	C.LogToFile(C.int(auto_open_depth), filenameArg)
+func ListBox_FnBoolPtr(label string, current_item *int32, items_getter ItemsGetter, items_count int32) bool {
+	return ListBox_FnBoolPtrV(label, current_item, items_getter, items_count, -1)
 }
 
-func NewPlatformIO() ImGuiPlatformIO {
-	return (ImGuiPlatformIO)(unsafe.Pointer(C.PlatformIO_ImGuiPlatformIO()))
-}
+// PlotLines_FloatPtrV parameter default value hint:
+// values_offset: 0
+// overlay_text: NULL
+// scale_min: FLT_MAX
+// scale_max: FLT_MAX
+// graph_size: ImVec2(0,0)
+func PlotLines_FloatPtrV(label string, values []float32, values_offset int32, overlay_text string, scale_min float32, scale_max float32, graph_size ImVec2) {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-func BeginGroup() {
-	C.BeginGroup()
-}
+	overlay_textArg, overlay_textFin := wrapNullableString(overlay_text)
+	defer overlay_textFin()
 
-func NewTableColumnSortSpecs() ImGuiTableColumnSortSpecs {
-	return (ImGuiTableColumnSortSpecs)(unsafe.Pointer(C.TableColumnSortSpecs_ImGuiTableColumnSortSpecs()))
+	C.PlotLines_FloatPtr(labelArg, wrapFloat32Slice(values), C.int(len(values)), C.int(values_offset), overlay_textArg, C.float(scale_min), C.float(scale_max), graph_size.toC(), C.int(unsafe.Sizeof(values[0])))
 }
 
-func (self ImDrawList) AddBezierQuadratic(p1 ImVec2, p2 ImVec2, p3 ImVec2, col uint32, thickness float32, num_segments int32) {
-	C.DrawList_AddBezierQuadratic(self.handle(), p1.toC(), p2.toC(), p3.toC(), C.ImU32(col), C.float(thickness), C.int(num_segments))
+func PlotLines_FloatPtr(label string, values []float32) {
+	PlotLines_FloatPtrV(label, values, 0, "", FLT_MAX, FLT_MAX, NewImVec2(0, 0))
 }
 
-func (self ImGuiLastItemData) Destroy() {
-	C.LastItemData_Destroy(self.handle())
+// PlotLines_FloatPtrOptions are the default arguments of PlotLines_FloatPtrV, see DefaultPlotLines_FloatPtrOptions.
+type PlotLines_FloatPtrOptions struct {
+	ValuesOffset int32
+	OverlayText  string
+	ScaleMin     float32
+	ScaleMax     float32
+	GraphSize    ImVec2
 }
 
-func InputInt2(label string, v [2]*int32, flags ImGuiInputTextFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	vArg := make([]C.int, len(v))
-	for i, vV := range v {
-		vArg[i] = C.int(*vV)
+// DefaultPlotLines_FloatPtrOptions returns the C++ default arguments of PlotLines_FloatPtrV.
+func DefaultPlotLines_FloatPtrOptions() PlotLines_FloatPtrOptions {
+	return PlotLines_FloatPtrOptions{
+		ValuesOffset: 0,
+		OverlayText:  "",
+		ScaleMin:     FLT_MAX,
+		ScaleMax:     FLT_MAX,
+		GraphSize:    NewImVec2(0, 0),
 	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = int32(vV)
-		}
-	}()
-
-	return C.InputInt2(labelArg, (*C.int)(&vArg[0]), C.ImGuiInputTextFlags(flags)) == C.bool(true)
 }
 
-func RadioButton_Bool(label string, active bool) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	return C.RadioButton_Bool(labelArg, C.bool(active)) == C.bool(true)
+// PlotLines_FloatPtrWithOptions is PlotLines_FloatPtrV taking its default arguments in options.
+func PlotLines_FloatPtrWithOptions(label string, values []float32, options PlotLines_FloatPtrOptions) {
+	PlotLines_FloatPtrV(label, values, options.ValuesOffset, options.OverlayText, options.ScaleMin, options.ScaleMax, options.GraphSize)
 }
 
-func RadioButton_IntPtr(label string, v *int32, v_button int32) bool {
+// PlotLines_FnFloatPtrV parameter default value hint:
+// values_offset: 0
+// overlay_text: NULL
+// scale_min: FLT_MAX
+// scale_max: FLT_MAX
+// graph_size: ImVec2(0,0)
+func PlotLines_FnFloatPtrV(label string, values_getter ValuesGetter, values_count int32, values_offset int32, overlay_text string, scale_min float32, scale_max float32, graph_size ImVec2) {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg, vFin := wrapInt32(v)
-	defer vFin()
+	values_getterArg, values_getterFin := wrapValuesGetter(values_getter)
+	defer values_getterFin()
 
-	return C.RadioButton_IntPtr(labelArg, vArg, C.int(v_button)) == C.bool(true)
-}
+	overlay_textArg, overlay_textFin := wrapNullableString(overlay_text)
+	defer overlay_textFin()
 
-func InputDouble(label string, v *float64, step float64, step_fast float64, format string, flags ImGuiInputTextFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+	C.PlotLines_FnFloatPtr(labelArg, values_getterArg, C.int(values_count), C.int(values_offset), overlay_textArg, C.float(scale_min), C.float(scale_max), graph_size.toC())
+}
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+func PlotLines_FnFloatPtr(label string, values_getter ValuesGetter, values_count int32) {
+	PlotLines_FnFloatPtrV(label, values_getter, values_count, 0, "", FLT_MAX, FLT_MAX, NewImVec2(0, 0))
+}
 
-	return C.InputDouble(labelArg, (*C.double)(v), C.double(step), C.double(step_fast), formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
+// PlotLines_FnFloatPtrOptions are the default arguments of PlotLines_FnFloatPtrV, see DefaultPlotLines_FnFloatPtrOptions.
+type PlotLines_FnFloatPtrOptions struct {
+	ValuesOffset int32
+	OverlayText  string
+	ScaleMin     float32
+	ScaleMax     float32
+	GraphSize    ImVec2
 }
 
-func IsAnyItemActive() bool {
-	return C.IsAnyItemActive() == C.bool(true)
+// DefaultPlotLines_FnFloatPtrOptions returns the C++ default arguments of PlotLines_FnFloatPtrV.
+func DefaultPlotLines_FnFloatPtrOptions() PlotLines_FnFloatPtrOptions {
+	return PlotLines_FnFloatPtrOptions{
+		ValuesOffset: 0,
+		OverlayText:  "",
+		ScaleMin:     FLT_MAX,
+		ScaleMax:     FLT_MAX,
+		GraphSize:    NewImVec2(0, 0),
+	}
 }
 
-func SetCurrentContext(ctx ImGuiContext) {
-	C.SetCurrentContext(ctx.handle())
+// PlotLines_FnFloatPtrWithOptions is PlotLines_FnFloatPtrV taking its default arguments in options.
+func PlotLines_FnFloatPtrWithOptions(label string, values_getter ValuesGetter, values_count int32, options PlotLines_FnFloatPtrOptions) {
+	PlotLines_FnFloatPtrV(label, values_getter, values_count, options.ValuesOffset, options.OverlayText, options.ScaleMin, options.ScaleMax, options.GraphSize)
 }
 
-func Begin(name string, p_open *bool, flags ImGuiWindowFlags) bool {
-	nameArg, nameFin := wrapString(name)
-	defer nameFin()
+// PlotHistogram_FloatPtrV parameter default value hint:
+// values_offset: 0
+// overlay_text: NULL
+// scale_min: FLT_MAX
+// scale_max: FLT_MAX
+// graph_size: ImVec2(0,0)
+func PlotHistogram_FloatPtrV(label string, values []float32, values_offset int32, overlay_text string, scale_min float32, scale_max float32, graph_size ImVec2) {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	p_openArg, p_openFin := wrapBool(p_open)
-	defer p_openFin()
+	overlay_textArg, overlay_textFin := wrapNullableString(overlay_text)
+	defer overlay_textFin()
 
-	return C.Begin(nameArg, p_openArg, C.ImGuiWindowFlags(flags)) == C.bool(true)
+	C.PlotHistogram_FloatPtr(labelArg, wrapFloat32Slice(values), C.int(len(values)), C.int(values_offset), overlay_textArg, C.float(scale_min), C.float(scale_max), graph_size.toC(), C.int(unsafe.Sizeof(values[0])))
 }
 
-func GetWindowDockID() ImGuiID {
-	return ImGuiID(C.GetWindowDockID())
+func PlotHistogram_FloatPtr(label string, values []float32) {
+	PlotHistogram_FloatPtrV(label, values, 0, "", FLT_MAX, FLT_MAX, NewImVec2(0, 0))
 }
 
-func SetWindowCollapsed_Bool(collapsed bool, cond ImGuiCond) {
-	C.SetWindowCollapsed_Bool(C.bool(collapsed), C.ImGuiCond(cond))
+// PlotHistogram_FloatPtrOptions are the default arguments of PlotHistogram_FloatPtrV, see DefaultPlotHistogram_FloatPtrOptions.
+type PlotHistogram_FloatPtrOptions struct {
+	ValuesOffset int32
+	OverlayText  string
+	ScaleMin     float32
+	ScaleMax     float32
+	GraphSize    ImVec2
 }
 
-func SetWindowCollapsed_Str(name string, collapsed bool, cond ImGuiCond) {
-	nameArg, nameFin := wrapString(name)
-	defer nameFin()
+// DefaultPlotHistogram_FloatPtrOptions returns the C++ default arguments of PlotHistogram_FloatPtrV.
+func DefaultPlotHistogram_FloatPtrOptions() PlotHistogram_FloatPtrOptions {
+	return PlotHistogram_FloatPtrOptions{
+		ValuesOffset: 0,
+		OverlayText:  "",
+		ScaleMin:     FLT_MAX,
+		ScaleMax:     FLT_MAX,
+		GraphSize:    NewImVec2(0, 0),
+	}
+}
 
-	C.SetWindowCollapsed_Str(nameArg, C.bool(collapsed), C.ImGuiCond(cond))
+// PlotHistogram_FloatPtrWithOptions is PlotHistogram_FloatPtrV taking its default arguments in options.
+func PlotHistogram_FloatPtrWithOptions(label string, values []float32, options PlotHistogram_FloatPtrOptions) {
+	PlotHistogram_FloatPtrV(label, values, options.ValuesOffset, options.OverlayText, options.ScaleMin, options.ScaleMax, options.GraphSize)
 }
 
-func SliderFloat(label string, v *float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
+// PlotHistogram_FnFloatPtrV parameter default value hint:
+// values_offset: 0
+// overlay_text: NULL
+// scale_min: FLT_MAX
+// scale_max: FLT_MAX
+// graph_size: ImVec2(0,0)
+func PlotHistogram_FnFloatPtrV(label string, values_getter ValuesGetter, values_count int32, values_offset int32, overlay_text string, scale_min float32, scale_max float32, graph_size ImVec2) {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg, vFin := wrapFloat(v)
-	defer vFin()
+	values_getterArg, values_getterFin := wrapValuesGetter(values_getter)
+	defer values_getterFin()
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+	overlay_textArg, overlay_textFin := wrapNullableString(overlay_text)
+	defer overlay_textFin()
 
-	return C.SliderFloat(labelArg, vArg, C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	C.PlotHistogram_FnFloatPtr(labelArg, values_getterArg, C.int(values_count), C.int(values_offset), overlay_textArg, C.float(scale_min), C.float(scale_max), graph_size.toC())
 }
 
-func BeginPopupContextWindow(str_id string, popup_flags ImGuiPopupFlags) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+func PlotHistogram_FnFloatPtr(label string, values_getter ValuesGetter, values_count int32) {
+	PlotHistogram_FnFloatPtrV(label, values_getter, values_count, 0, "", FLT_MAX, FLT_MAX, NewImVec2(0, 0))
+}
 
-	return C.BeginPopupContextWindow(str_idArg, C.ImGuiPopupFlags(popup_flags)) == C.bool(true)
+// PlotHistogram_FnFloatPtrOptions are the default arguments of PlotHistogram_FnFloatPtrV, see DefaultPlotHistogram_FnFloatPtrOptions.
+type PlotHistogram_FnFloatPtrOptions struct {
+	ValuesOffset int32
+	OverlayText  string
+	ScaleMin     float32
+	ScaleMax     float32
+	GraphSize    ImVec2
 }
 
-func GetStyleColorName(idx ImGuiCol) string {
-	return C.GoString(C.GetStyleColorName(C.ImGuiCol(idx)))
+// DefaultPlotHistogram_FnFloatPtrOptions returns the C++ default arguments of PlotHistogram_FnFloatPtrV.
+func DefaultPlotHistogram_FnFloatPtrOptions() PlotHistogram_FnFloatPtrOptions {
+	return PlotHistogram_FnFloatPtrOptions{
+		ValuesOffset: 0,
+		OverlayText:  "",
+		ScaleMin:     FLT_MAX,
+		ScaleMax:     FLT_MAX,
+		GraphSize:    NewImVec2(0, 0),
+	}
 }
 
-func (self ImGuiPayload) IsDelivery() bool {
-	return C.Payload_IsDelivery(self.handle()) == C.bool(true)
+// PlotHistogram_FnFloatPtrWithOptions is PlotHistogram_FnFloatPtrV taking its default arguments in options.
+func PlotHistogram_FnFloatPtrWithOptions(label string, values_getter ValuesGetter, values_count int32, options PlotHistogram_FnFloatPtrOptions) {
+	PlotHistogram_FnFloatPtrV(label, values_getter, values_count, options.ValuesOffset, options.OverlayText, options.ScaleMin, options.ScaleMax, options.GraphSize)
 }
 
-func DrawList_GetClipRectMax(pOut *ImVec2, self ImDrawList) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+func Value_Bool(prefix string, b bool) {
+	prefixArg, prefixFin := wrapString(prefix)
+	defer prefixFin()
 
-	C.DrawList_GetClipRectMax(pOutArg, self.handle())
+	C.Value_Bool(prefixArg, C.bool(b))
 }
 
-func Image(user_texture_id ImTextureID, size ImVec2, uv0 ImVec2, uv1 ImVec2, tint_col ImVec4, border_col ImVec4) {
-	C.Image(C.ImTextureID(user_texture_id), size.toC(), uv0.toC(), uv1.toC(), tint_col.toC(), border_col.toC())
-}
+func Value_Int(prefix string, v int32) {
+	prefixArg, prefixFin := wrapString(prefix)
+	defer prefixFin()
 
-func GetCurrentContext() ImGuiContext {
-	return (ImGuiContext)(unsafe.Pointer(C.GetCurrentContext()))
+	C.Value_Int(prefixArg, C.int(v))
 }
 
-func NextColumn() {
-	C.NextColumn()
+func Value_Uint(prefix string, v uint32) {
+	prefixArg, prefixFin := wrapString(prefix)
+	defer prefixFin()
+
+	C.Value_Uint(prefixArg, C.uint(v))
 }
 
-func (self ImDrawCmd) GetTexID() ImTextureID {
-	return ImTextureID(C.DrawCmd_GetTexID(self.handle()))
-}
+// Value_FloatV parameter default value hint:
+// float_format: NULL
+func Value_FloatV(prefix string, v float32, float_format string) {
+	prefixArg, prefixFin := wrapString(prefix)
+	defer prefixFin()
 
-func (self ImGuiTextFilter) Destroy() {
-	C.TextFilter_Destroy(self.handle())
+	float_formatArg, float_formatFin := wrapNullableString(float_format)
+	defer float_formatFin()
+
+	C.Value_Float(prefixArg, C.float(v), float_formatArg)
 }
 
-func TableSetupScrollFreeze(cols int32, rows int32) {
-	C.TableSetupScrollFreeze(C.int(cols), C.int(rows))
+func Value_Float(prefix string, v float32) {
+	Value_FloatV(prefix, v, "")
 }
 
-func (self ImDrawList) AddImageRounded(user_texture_id ImTextureID, p_min ImVec2, p_max ImVec2, uv_min ImVec2, uv_max ImVec2, col uint32, rounding float32, flags ImDrawFlags) {
-	C.DrawList_AddImageRounded(self.handle(), C.ImTextureID(user_texture_id), p_min.toC(), p_max.toC(), uv_min.toC(), uv_max.toC(), C.ImU32(col), C.float(rounding), C.ImDrawFlags(flags))
+func BeginMenuBar() bool {
+	return C.BeginMenuBar() == C.bool(true)
 }
 
-func GetWindowPos(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+func EndMenuBar() {
+	C.EndMenuBar()
+}
 
-	C.GetWindowPos(pOutArg)
+func BeginMainMenuBar() bool {
+	return C.BeginMainMenuBar() == C.bool(true)
 }
 
-func StyleColorsClassic(dst ImGuiStyle) {
-	C.StyleColorsClassic(dst.handle())
+func EndMainMenuBar() {
+	C.EndMainMenuBar()
 }
 
-func BeginDragDropTarget() bool {
-	return C.BeginDragDropTarget() == C.bool(true)
+// BeginMenuV parameter default value hint:
+// enabled: true
+func BeginMenuV(label string, enabled bool) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
+
+	return C.BeginMenu(labelArg, C.bool(enabled)) == C.bool(true)
 }
 
-func ColorConvertFloat4ToU32(in ImVec4) uint32 {
-	return uint32(C.ColorConvertFloat4ToU32(in.toC()))
+func BeginMenu(label string) bool {
+	return BeginMenuV(label, true)
 }
 
-func (self ImDrawList) AddTriangleFilled(p1 ImVec2, p2 ImVec2, p3 ImVec2, col uint32) {
-	C.DrawList_AddTriangleFilled(self.handle(), p1.toC(), p2.toC(), p3.toC(), C.ImU32(col))
+func EndMenu() {
+	C.EndMenu()
 }
 
-func Viewport_GetWorkCenter(pOut *ImVec2, self ImGuiViewport) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+// MenuItem_BoolV parameter default value hint:
+// shortcut: NULL
+// selected: false
+// enabled: true
+func MenuItem_BoolV(label string, shortcut string, selected bool, enabled bool) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	C.Viewport_GetWorkCenter(pOutArg, self.handle())
+	shortcutArg, shortcutFin := wrapNullableString(shortcut)
+	defer shortcutFin()
+
+	return C.MenuItem_Bool(labelArg, shortcutArg, C.bool(selected), C.bool(enabled)) == C.bool(true)
+}
+
+func MenuItem_Bool(label string) bool {
+	return MenuItem_BoolV(label, "", false, true)
 }
 
-func SliderInt(label string, v *int32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
+// MenuItem_BoolPtrV parameter default value hint:
+// enabled: true
+func MenuItem_BoolPtrV(label string, shortcut string, p_selected *bool, enabled bool) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg, vFin := wrapInt32(v)
-	defer vFin()
+	shortcutArg, shortcutFin := wrapString(shortcut)
+	defer shortcutFin()
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+	p_selectedArg, p_selectedFin := wrapBool(p_selected)
+	defer p_selectedFin()
 
-	return C.SliderInt(labelArg, vArg, C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	return C.MenuItem_BoolPtr(labelArg, shortcutArg, p_selectedArg, C.bool(enabled)) == C.bool(true)
 }
 
-func (self ImGuiTextBuffer) Begin() string {
-	return C.GoString(C.TextBuffer_Begin(self.handle()))
+func MenuItem_BoolPtr(label string, shortcut string, p_selected *bool) bool {
+	return MenuItem_BoolPtrV(label, shortcut, p_selected, true)
+}
+
+func BeginTooltip() {
+	C.BeginTooltip()
 }
 
-func ShowDebugLogWindow(p_open *bool) {
+func EndTooltip() {
+	C.EndTooltip()
+}
+
+func SetTooltip(fmt string) {
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
+
+	C.SetTooltip(fmtArg)
+}
+
+// BeginPopupV parameter default value hint:
+// flags: 0
+func BeginPopupV(str_id string, flags ImGuiWindowFlags) bool {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
+
+	return C.BeginPopup(str_idArg, C.ImGuiWindowFlags(flags)) == C.bool(true)
+}
+
+func BeginPopup(str_id string) bool {
+	return BeginPopupV(str_id, 0)
+}
+
+// BeginPopupModalV parameter default value hint:
+// p_open: NULL
+// flags: 0
+func BeginPopupModalV(name string, p_open *bool, flags ImGuiWindowFlags) bool {
+	nameArg, nameFin := wrapString(name)
+	defer nameFin()
+
 	p_openArg, p_openFin := wrapBool(p_open)
 	defer p_openFin()
 
-	C.ShowDebugLogWindow(p_openArg)
+	return C.BeginPopupModal(nameArg, p_openArg, C.ImGuiWindowFlags(flags)) == C.bool(true)
 }
 
-func (self ImDrawListSplitter) Split(draw_list ImDrawList, count int32) {
-	C.DrawListSplitter_Split(self.handle(), draw_list.handle(), C.int(count))
+func BeginPopupModal(name string) bool {
+	return BeginPopupModalV(name, nil, 0)
 }
 
-func (self ImGuiIO) AddKeyEvent(key ImGuiKey, down bool) {
-	C.IO_AddKeyEvent(self.handle(), C.ImGuiKey(key), C.bool(down))
+func EndPopup() {
+	C.EndPopup()
 }
 
-func (self ImFont) FindGlyphNoFallback(c ImWchar) ImFontGlyph {
-	return (ImFontGlyph)(unsafe.Pointer(C.Font_FindGlyphNoFallback(self.handle(), C.ImWchar(c))))
+// OpenPopup_StrV parameter default value hint:
+// popup_flags: 0
+func OpenPopup_StrV(str_id string, popup_flags ImGuiPopupFlags) {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
+
+	C.OpenPopup_Str(str_idArg, C.ImGuiPopupFlags(popup_flags))
 }
 
-func SetNextWindowClass(window_class ImGuiWindowClass) {
-	C.SetNextWindowClass(window_class.handle())
+func OpenPopup_Str(str_id string) {
+	OpenPopup_StrV(str_id, 0)
 }
 
-func (self ImGuiPlatformMonitor) Destroy() {
-	C.PlatformMonitor_Destroy(self.handle())
+// OpenPopup_IDV parameter default value hint:
+// popup_flags: 0
+func OpenPopup_IDV(id ImGuiID, popup_flags ImGuiPopupFlags) {
+	C.OpenPopup_ID(C.ImGuiID(id), C.ImGuiPopupFlags(popup_flags))
 }
 
-func TableHeadersRow() {
-	C.TableHeadersRow()
+func OpenPopup_ID(id ImGuiID) {
+	OpenPopup_IDV(id, 0)
 }
 
-func EndMenu() {
-	C.EndMenu()
+// OpenPopupOnItemClickV parameter default value hint:
+// str_id: NULL
+// popup_flags: 1
+func OpenPopupOnItemClickV(str_id string, popup_flags ImGuiPopupFlags) {
+	str_idArg, str_idFin := wrapNullableString(str_id)
+	defer str_idFin()
+
+	C.OpenPopupOnItemClick(str_idArg, C.ImGuiPopupFlags(popup_flags))
 }
 
-func (self ImFontAtlas) AddFontDefault(font_cfg ImFontConfig) ImFont {
-	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFontDefault(self.handle(), font_cfg.handle())))
+func OpenPopupOnItemClick() {
+	OpenPopupOnItemClickV("", 1)
 }
 
-func GetDrawListSharedData() ImDrawListSharedData {
-	return (ImDrawListSharedData)(unsafe.Pointer(C.GetDrawListSharedData()))
+func CloseCurrentPopup() {
+	C.CloseCurrentPopup()
 }
 
-func DrawList_GetClipRectMin(pOut *ImVec2, self ImDrawList) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+// BeginPopupContextItemV parameter default value hint:
+// str_id: NULL
+// popup_flags: 1
+func BeginPopupContextItemV(str_id string, popup_flags ImGuiPopupFlags) bool {
+	str_idArg, str_idFin := wrapNullableString(str_id)
+	defer str_idFin()
 
-	C.DrawList_GetClipRectMin(pOutArg, self.handle())
+	return C.BeginPopupContextItem(str_idArg, C.ImGuiPopupFlags(popup_flags)) == C.bool(true)
 }
 
-func (self ImGuiDockContext) Destroy() {
-	C.DockContext_Destroy(self.handle())
+func BeginPopupContextItem() bool {
+	return BeginPopupContextItemV("", 1)
 }
 
-func NewOnceUponAFrame() ImGuiOnceUponAFrame {
-	return (ImGuiOnceUponAFrame)(unsafe.Pointer(C.OnceUponAFrame_ImGuiOnceUponAFrame()))
-}
+// BeginPopupContextWindowV parameter default value hint:
+// str_id: NULL
+// popup_flags: 1
+func BeginPopupContextWindowV(str_id string, popup_flags ImGuiPopupFlags) bool {
+	str_idArg, str_idFin := wrapNullableString(str_id)
+	defer str_idFin()
 
-func ShowStyleSelector(label string) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+	return C.BeginPopupContextWindow(str_idArg, C.ImGuiPopupFlags(popup_flags)) == C.bool(true)
+}
 
-	return C.ShowStyleSelector(labelArg) == C.bool(true)
+func BeginPopupContextWindow() bool {
+	return BeginPopupContextWindowV("", 1)
 }
 
-func (self ImFontAtlas) GetGlyphRangesVietnamese() *ImWchar {
-	return (*ImWchar)(C.FontAtlas_GetGlyphRangesVietnamese(self.handle()))
+// BeginPopupContextVoidV parameter default value hint:
+// str_id: NULL
+// popup_flags: 1
+func BeginPopupContextVoidV(str_id string, popup_flags ImGuiPopupFlags) bool {
+	str_idArg, str_idFin := wrapNullableString(str_id)
+	defer str_idFin()
+
+	return C.BeginPopupContextVoid(str_idArg, C.ImGuiPopupFlags(popup_flags)) == C.bool(true)
 }
 
-func (self ImGuiComboPreviewData) Destroy() {
-	C.ComboPreviewData_Destroy(self.handle())
+func BeginPopupContextVoid() bool {
+	return BeginPopupContextVoidV("", 1)
 }
 
-func (self ImGuiInputEvent) Destroy() {
-	C.InputEvent_Destroy(self.handle())
+// IsPopupOpen_StrV parameter default value hint:
+// flags: 0
+func IsPopupOpen_StrV(str_id string, flags ImGuiPopupFlags) bool {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
+
+	return C.IsPopupOpen_Str(str_idArg, C.ImGuiPopupFlags(flags)) == C.bool(true)
 }
 
-func EndMainMenuBar() {
-	C.EndMainMenuBar()
+func IsPopupOpen_Str(str_id string) bool {
+	return IsPopupOpen_StrV(str_id, 0)
 }
 
-func InputInt(label string, v *int32, step int32, step_fast int32, flags ImGuiInputTextFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+// BeginTableV parameter default value hint:
+// flags: 0
+// outer_size: ImVec2(0.0f,0.0f)
+// inner_width: 0.0f
+func BeginTableV(str_id string, column int32, flags ImGuiTableFlags, outer_size ImVec2, inner_width float32) bool {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
 
-	vArg, vFin := wrapInt32(v)
-	defer vFin()
+	return C.BeginTable(str_idArg, C.int(column), C.ImGuiTableFlags(flags), outer_size.toC(), C.float(inner_width)) == C.bool(true)
+}
 
-	return C.InputInt(labelArg, vArg, C.int(step), C.int(step_fast), C.ImGuiInputTextFlags(flags)) == C.bool(true)
+func BeginTable(str_id string, column int32) bool {
+	return BeginTableV(str_id, column, 0, NewImVec2(0.0, 0.0), 0.0)
 }
 
-func NewLine() {
-	C.NewLine()
+func EndTable() {
+	C.EndTable()
 }
 
-func NewDrawData() ImDrawData {
-	return (ImDrawData)(unsafe.Pointer(C.DrawData_ImDrawData()))
+// TableNextRowV parameter default value hint:
+// row_flags: 0
+// min_row_height: 0.0f
+func TableNextRowV(row_flags ImGuiTableRowFlags, min_row_height float32) {
+	C.TableNextRow(C.ImGuiTableRowFlags(row_flags), C.float(min_row_height))
 }
 
-func SetNextWindowContentSize(size ImVec2) {
-	C.SetNextWindowContentSize(size.toC())
+func TableNextRow() {
+	TableNextRowV(0, 0.0)
 }
 
-func LogButtons() {
-	C.LogButtons()
+func TableNextColumn() bool {
+	return C.TableNextColumn() == C.bool(true)
 }
 
-func (self ImGuiViewport) Destroy() {
-	C.Viewport_Destroy(self.handle())
+func TableSetColumnIndex(column_n int32) bool {
+	return C.TableSetColumnIndex(C.int(column_n)) == C.bool(true)
 }
 
-func DragIntRange2(label string, v_current_min *int32, v_current_max *int32, v_speed float32, v_min int32, v_max int32, format string, format_max string, flags ImGuiSliderFlags) bool {
+// TableSetupColumnV parameter default value hint:
+// flags: 0
+// init_width_or_weight: 0.0f
+// user_id: 0
+func TableSetupColumnV(label string, flags ImGuiTableColumnFlags, init_width_or_weight float32, user_id ImGuiID) {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	v_current_minArg, v_current_minFin := wrapInt32(v_current_min)
-	defer v_current_minFin()
-
-	v_current_maxArg, v_current_maxFin := wrapInt32(v_current_max)
-	defer v_current_maxFin()
-
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+	C.TableSetupColumn(labelArg, C.ImGuiTableColumnFlags(flags), C.float(init_width_or_weight), C.ImGuiID(user_id))
+}
 
-	format_maxArg, format_maxFin := wrapString(format_max)
-	defer format_maxFin()
+func TableSetupColumn(label string) {
+	TableSetupColumnV(label, 0, 0.0, 0)
+}
 
-	return C.DragIntRange2(labelArg, v_current_minArg, v_current_maxArg, C.float(v_speed), C.int(v_min), C.int(v_max), formatArg, format_maxArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+func TableSetupScrollFreeze(cols int32, rows int32) {
+	C.TableSetupScrollFreeze(C.int(cols), C.int(rows))
 }
 
-func ShowUserGuide() {
-	C.ShowUserGuide()
+func TableHeadersRow() {
+	C.TableHeadersRow()
 }
 
-func SliderFloat3(label string, v [3]*float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
+func TableHeader(label string) {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg := make([]C.float, len(v))
-	for i, vV := range v {
-		vArg[i] = C.float(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = float32(vV)
-		}
-	}()
-
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
-
-	return C.SliderFloat3(labelArg, (*C.float)(&vArg[0]), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+	C.TableHeader(labelArg)
 }
 
-func (self ImGuiInputTextCallbackData) Destroy() {
-	C.InputTextCallbackData_Destroy(self.handle())
+func TableGetSortSpecs() ImGuiTableSortSpecs {
+	return (ImGuiTableSortSpecs)(unsafe.Pointer(C.TableGetSortSpecs()))
 }
 
-func GetKeyName(key ImGuiKey) string {
-	return C.GoString(C.GetKeyName(C.ImGuiKey(key)))
+func TableGetColumnCount() int {
+	return int(C.TableGetColumnCount())
 }
 
-func UpdatePlatformWindows() {
-	C.UpdatePlatformWindows()
+func TableGetColumnIndex() int {
+	return int(C.TableGetColumnIndex())
 }
 
-func (self ImDrawList) PopTextureID() {
-	C.DrawList_PopTextureID(self.handle())
+func TableGetRowIndex() int {
+	return int(C.TableGetRowIndex())
 }
 
-func (self ImFontAtlas) IsBuilt() bool {
-	return C.FontAtlas_IsBuilt(self.handle()) == C.bool(true)
+// TableGetColumnName_IntV parameter default value hint:
+// column_n: -1
+func TableGetColumnName_IntV(column_n int32) string {
+	return C.GoString(C.TableGetColumnName_Int(C.int(column_n)))
 }
 
-func NewViewport() ImGuiViewport {
-	return (ImGuiViewport)(unsafe.Pointer(C.Viewport_ImGuiViewport()))
+func TableGetColumnName_Int() string {
+	return TableGetColumnName_IntV(-1)
 }
 
-func (self ImFont) ClearOutputData() {
-	C.Font_ClearOutputData(self.handle())
+// TableGetColumnFlagsV parameter default value hint:
+// column_n: -1
+func TableGetColumnFlagsV(column_n int32) ImGuiTableColumnFlags {
+	return ImGuiTableColumnFlags(C.TableGetColumnFlags(C.int(column_n)))
 }
 
-func (self ImGuiTextBuffer) Empty() bool {
-	return C.TextBuffer_Empty(self.handle()) == C.bool(true)
+func TableGetColumnFlags() ImGuiTableColumnFlags {
+	return TableGetColumnFlagsV(-1)
 }
 
-func GetTextLineHeightWithSpacing() float32 {
-	return float32(C.GetTextLineHeightWithSpacing())
+func TableSetColumnEnabled(column_n int32, v bool) {
+	C.TableSetColumnEnabled(C.int(column_n), C.bool(v))
 }
 
-func (self ImDrawCmd) Destroy() {
-	C.DrawCmd_Destroy(self.handle())
+// TableSetBgColorV parameter default value hint:
+// column_n: -1
+func TableSetBgColorV(target ImGuiTableBgTarget, color uint32, column_n int32) {
+	C.TableSetBgColor(C.ImGuiTableBgTarget(target), C.ImU32(color), C.int(column_n))
 }
 
-func NewPlatformMonitor() ImGuiPlatformMonitor {
-	return (ImGuiPlatformMonitor)(unsafe.Pointer(C.PlatformMonitor_ImGuiPlatformMonitor()))
+func TableSetBgColor(target ImGuiTableBgTarget, color uint32) {
+	TableSetBgColorV(target, color, -1)
 }
 
-func NewFont() ImFont {
-	return (ImFont)(unsafe.Pointer(C.Font_ImFont()))
-}
+// ColumnsV parameter default value hint:
+// count: 1
+// id: NULL
+// border: true
+func ColumnsV(count int32, id string, border bool) {
+	idArg, idFin := wrapNullableString(id)
+	defer idFin()
 
-func ShowDemoWindow(p_open *bool) {
-	p_openArg, p_openFin := wrapBool(p_open)
-	defer p_openFin()
+	C.Columns(C.int(count), idArg, C.bool(border))
+}
 
-	C.ShowDemoWindow(p_openArg)
+func Columns() {
+	ColumnsV(1, "", true)
 }
 
-func TableHeader(label string) {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	C.TableHeader(labelArg)
+func NextColumn() {
+	C.NextColumn()
 }
 
-func PopID() {
-	C.PopID()
+func GetColumnIndex() int {
+	return int(C.GetColumnIndex())
 }
 
-func MemFree(ptr unsafe.Pointer) {
-	C.MemFree(ptr)
+// GetColumnWidthV parameter default value hint:
+// column_index: -1
+func GetColumnWidthV(column_index int32) float32 {
+	return float32(C.GetColumnWidth(C.int(column_index)))
 }
 
-func TextDisabled(fmt string) {
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
-
-	C.TextDisabled(fmtArg)
+func GetColumnWidth() float32 {
+	return GetColumnWidthV(-1)
 }
 
-func BeginPopup(str_id string, flags ImGuiWindowFlags) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+func SetColumnWidth(column_index int32, width float32) {
+	C.SetColumnWidth(C.int(column_index), C.float(width))
+}
 
-	return C.BeginPopup(str_idArg, C.ImGuiWindowFlags(flags)) == C.bool(true)
+// GetColumnOffsetV parameter default value hint:
+// column_index: -1
+func GetColumnOffsetV(column_index int32) float32 {
+	return float32(C.GetColumnOffset(C.int(column_index)))
 }
 
-func GetColumnWidth(column_index int32) float32 {
-	return float32(C.GetColumnWidth(C.int(column_index)))
+func GetColumnOffset() float32 {
+	return GetColumnOffsetV(-1)
 }
 
-func (self ImDrawList) PathBezierCubicCurveTo(p2 ImVec2, p3 ImVec2, p4 ImVec2, num_segments int32) {
-	C.DrawList_PathBezierCubicCurveTo(self.handle(), p2.toC(), p3.toC(), p4.toC(), C.int(num_segments))
+func SetColumnOffset(column_index int32, offset_x float32) {
+	C.SetColumnOffset(C.int(column_index), C.float(offset_x))
 }
 
-func (self ImFont) RenderChar(draw_list ImDrawList, size float32, pos ImVec2, col uint32, c ImWchar) {
-	C.Font_RenderChar(self.handle(), draw_list.handle(), C.float(size), pos.toC(), C.ImU32(col), C.ImWchar(c))
+func GetColumnsCount() int {
+	return int(C.GetColumnsCount())
 }
 
-func (self ImGuiTableInstanceData) Destroy() {
-	C.TableInstanceData_Destroy(self.handle())
+// BeginTabBarV parameter default value hint:
+// flags: 0
+func BeginTabBarV(str_id string, flags ImGuiTabBarFlags) bool {
+	str_idArg, str_idFin := wrapString(str_id)
+	defer str_idFin()
+
+	return C.BeginTabBar(str_idArg, C.ImGuiTabBarFlags(flags)) == C.bool(true)
 }
 
-func SetNextItemWidth(item_width float32) {
-	C.SetNextItemWidth(C.float(item_width))
+func BeginTabBar(str_id string) bool {
+	return BeginTabBarV(str_id, 0)
 }
 
-func GetStyleColorVec4(idx ImGuiCol) ImVec4 {
-	return newImVec4FromCPtr(C.GetStyleColorVec4(C.ImGuiCol(idx)))
+func EndTabBar() {
+	C.EndTabBar()
 }
 
-func DragFloat4(label string, v [4]*float32, v_speed float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
+// BeginTabItemV parameter default value hint:
+// p_open: NULL
+// flags: 0
+func BeginTabItemV(label string, p_open *bool, flags ImGuiTabItemFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 
-	vArg := make([]C.float, len(v))
-	for i, vV := range v {
-		vArg[i] = C.float(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = float32(vV)
-		}
-	}()
-
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
-
-	return C.DragFloat4(labelArg, (*C.float)(&vArg[0]), C.float(v_speed), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
-}
+	p_openArg, p_openFin := wrapBool(p_open)
+	defer p_openFin()
 
-func (self ImFont) GetDebugName() string {
-	return C.GoString(C.Font_GetDebugName(self.handle()))
+	return C.BeginTabItem(labelArg, p_openArg, C.ImGuiTabItemFlags(flags)) == C.bool(true)
 }
 
-func SetColorEditOptions(flags ImGuiColorEditFlags) {
-	C.SetColorEditOptions(C.ImGuiColorEditFlags(flags))
+func BeginTabItem(label string) bool {
+	return BeginTabItemV(label, nil, 0)
 }
 
-func (self ImFont) SetGlyphVisible(c ImWchar, visible bool) {
-	C.Font_SetGlyphVisible(self.handle(), C.ImWchar(c), C.bool(visible))
+func EndTabItem() {
+	C.EndTabItem()
 }
 
-func DebugTextEncoding(text string) {
-	textArg, textFin := wrapString(text)
-	defer textFin()
+// TabItemButtonV parameter default value hint:
+// flags: 0
+func TabItemButtonV(label string, flags ImGuiTabItemFlags) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
 
-	C.DebugTextEncoding(textArg)
+	return C.TabItemButton(labelArg, C.ImGuiTabItemFlags(flags)) == C.bool(true)
 }
 
-func GetForegroundDrawList_Nil() ImDrawList {
-	return (ImDrawList)(unsafe.Pointer(C.GetForegroundDrawList_Nil()))
+func TabItemButton(label string) bool {
+	return TabItemButtonV(label, 0)
 }
 
-func GetForegroundDrawList_ViewportPtr(viewport ImGuiViewport) ImDrawList {
-	return (ImDrawList)(unsafe.Pointer(C.GetForegroundDrawList_ViewportPtr(viewport.handle())))
-}
+func SetTabItemClosed(tab_or_docked_window_label string) {
+	tab_or_docked_window_labelArg, tab_or_docked_window_labelFin := wrapString(tab_or_docked_window_label)
+	defer tab_or_docked_window_labelFin()
 
-func PopStyleColor(count int32) {
-	C.PopStyleColor(C.int(count))
+	C.SetTabItemClosed(tab_or_docked_window_labelArg)
 }
 
-func SetNextWindowBgAlpha(alpha float32) {
-	C.SetNextWindowBgAlpha(C.float(alpha))
+// DockSpaceV parameter default value hint:
+// size: ImVec2(0,0)
+// flags: 0
+// window_class: NULL
+func DockSpaceV(id ImGuiID, size ImVec2, flags ImGuiDockNodeFlags, window_class ImGuiWindowClass) ImGuiID {
+	return ImGuiID(C.DockSpace(C.ImGuiID(id), size.toC(), C.ImGuiDockNodeFlags(flags), window_class.handle()))
 }
 
-func SetScrollY_Float(scroll_y float32) {
-	C.SetScrollY_Float(C.float(scroll_y))
+func DockSpace(id ImGuiID) ImGuiID {
+	return DockSpaceV(id, NewImVec2(0, 0), 0, 0)
 }
 
-func ShowFontSelector(label string) {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	C.ShowFontSelector(labelArg)
+// DockSpaceOverViewportV parameter default value hint:
+// viewport: NULL
+// flags: 0
+// window_class: NULL
+func DockSpaceOverViewportV(viewport ImGuiViewport, flags ImGuiDockNodeFlags, window_class ImGuiWindowClass) ImGuiID {
+	return ImGuiID(C.DockSpaceOverViewport(viewport.handle(), C.ImGuiDockNodeFlags(flags), window_class.handle()))
 }
 
-func BeginChild_Str(str_id string, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
-
-	return C.BeginChild_Str(str_idArg, size.toC(), C.bool(border), C.ImGuiWindowFlags(flags)) == C.bool(true)
+func DockSpaceOverViewport() ImGuiID {
+	return DockSpaceOverViewportV(0, 0, 0)
 }
 
-func BeginChild_ID(id ImGuiID, size ImVec2, border bool, flags ImGuiWindowFlags) bool {
-	return C.BeginChild_ID(C.ImGuiID(id), size.toC(), C.bool(border), C.ImGuiWindowFlags(flags)) == C.bool(true)
+// SetNextWindowDockIDV parameter default value hint:
+// cond: 0
+func SetNextWindowDockIDV(dock_id ImGuiID, cond ImGuiCond) {
+	C.SetNextWindowDockID(C.ImGuiID(dock_id), C.ImGuiCond(cond))
 }
 
-func LoadIniSettingsFromMemory(ini_data string, ini_size uint64) {
-	ini_dataArg, ini_dataFin := wrapString(ini_data)
-	defer ini_dataFin()
-
-	C.LoadIniSettingsFromMemory(ini_dataArg, C.xlong(ini_size))
+func SetNextWindowDockID(dock_id ImGuiID) {
+	SetNextWindowDockIDV(dock_id, 0)
 }
 
-func (self ImDrawList) AddImage(user_texture_id ImTextureID, p_min ImVec2, p_max ImVec2, uv_min ImVec2, uv_max ImVec2, col uint32) {
-	C.DrawList_AddImage(self.handle(), C.ImTextureID(user_texture_id), p_min.toC(), p_max.toC(), uv_min.toC(), uv_max.toC(), C.ImU32(col))
+func SetNextWindowClass(window_class ImGuiWindowClass) {
+	C.SetNextWindowClass(window_class.handle())
 }
 
-func (self ImGuiPayload) Clear() {
-	C.Payload_Clear(self.handle())
+func GetWindowDockID() ImGuiID {
+	return ImGuiID(C.GetWindowDockID())
 }
 
-func DestroyContext(ctx ImGuiContext) {
-	C.DestroyContext(ctx.handle())
+func IsWindowDocked() bool {
+	return C.IsWindowDocked() == C.bool(true)
 }
 
-func (self ImGuiIO) AddMousePosEvent(x float32, y float32) {
-	C.IO_AddMousePosEvent(self.handle(), C.float(x), C.float(y))
+// LogToTTYV parameter default value hint:
+// auto_open_depth: -1
+func LogToTTYV(auto_open_depth int32) {
+	C.LogToTTY(C.int(auto_open_depth))
 }
 
-func EndTable() {
-	C.EndTable()
+func LogToTTY() {
+	LogToTTYV(-1)
 }
 
-func GetFrameHeight() float32 {
-	return float32(C.GetFrameHeight())
-}
+// LogToFileV parameter default value hint:
+// auto_open_depth: -1
+// filename: NULL
+func LogToFileV(auto_open_depth int32, filename string) {
+	filenameArg, filenameFin := wrapNullableString(filename)
+	defer filenameFin()
 
-func (self ImDrawList) PrimUnreserve(idx_count int32, vtx_count int32) {
-	C.DrawList_PrimUnreserve(self.handle(), C.int(idx_count), C.int(vtx_count))
+	C.LogToFile(C.int(auto_open_depth), filenameArg)
 }
 
-func AlignTextToFramePadding() {
-	C.AlignTextToFramePadding()
+func LogToFile() {
+	LogToFileV(-1, "")
 }
 
-func GetMouseClickedCount(button ImGuiMouseButton) int {
-	return int(C.GetMouseClickedCount(C.ImGuiMouseButton(button)))
+// LogToClipboardV parameter default value hint:
+// auto_open_depth: -1
+func LogToClipboardV(auto_open_depth int32) {
+	C.LogToClipboard(C.int(auto_open_depth))
 }
 
-func (self ImDrawList) PrimWriteIdx(idx ImDrawIdx) {
-	C.DrawList_PrimWriteIdx(self.handle(), C.ImDrawIdx(idx))
+func LogToClipboard() {
+	LogToClipboardV(-1)
 }
 
-func NewFrame() {
-	C.NewFrame()
+func LogFinish() {
+	C.LogFinish()
 }
 
-func PushID_Str(str_id string) {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
-
-	C.PushID_Str(str_idArg)
+func LogButtons() {
+	C.LogButtons()
 }
 
-func PushID_StrStr(str_id_begin string, str_id_end string) {
-	str_id_beginArg, str_id_beginFin := wrapString(str_id_begin)
-	defer str_id_beginFin()
-
-	str_id_endArg, str_id_endFin := wrapString(str_id_end)
-	defer str_id_endFin()
+func LogText(fmt string) {
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
 
-	C.PushID_StrStr(str_id_beginArg, str_id_endArg)
+	C.LogText(fmtArg)
 }
 
-func PushID_Ptr(ptr_id unsafe.Pointer) {
-	C.PushID_Ptr(ptr_id)
+// BeginDragDropSourceV parameter default value hint:
+// flags: 0
+func BeginDragDropSourceV(flags ImGuiDragDropFlags) bool {
+	return C.BeginDragDropSource(C.ImGuiDragDropFlags(flags)) == C.bool(true)
 }
 
-func PushID_Int(int_id int32) {
-	C.PushID_Int(C.int(int_id))
+func BeginDragDropSource() bool {
+	return BeginDragDropSourceV(0)
 }
 
-func GetFontSize() float32 {
-	return float32(C.GetFontSize())
-}
+// SetDragDropPayloadV parameter default value hint:
+// cond: 0
+func SetDragDropPayloadV(typeArg string, data unsafe.Pointer, sz uint64, cond ImGuiCond) bool {
+	typeArgArg, typeArgFin := wrapString(typeArg)
+	defer typeArgFin()
 
-func IsMouseDown(button ImGuiMouseButton) bool {
-	return C.IsMouseDown(C.ImGuiMouseButton(button)) == C.bool(true)
+	return C.SetDragDropPayload(typeArgArg, data, C.xlong(sz), C.ImGuiCond(cond)) == C.bool(true)
 }
 
-func DragFloatRange2(label string, v_current_min *float32, v_current_max *float32, v_speed float32, v_min float32, v_max float32, format string, format_max string, flags ImGuiSliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func SetDragDropPayload(typeArg string, data unsafe.Pointer, sz uint64) bool {
+	return SetDragDropPayloadV(typeArg, data, sz, 0)
+}
 
-	v_current_minArg, v_current_minFin := wrapFloat(v_current_min)
-	defer v_current_minFin()
+func EndDragDropSource() {
+	C.EndDragDropSource()
+}
 
-	v_current_maxArg, v_current_maxFin := wrapFloat(v_current_max)
-	defer v_current_maxFin()
+func BeginDragDropTarget() bool {
+	return C.BeginDragDropTarget() == C.bool(true)
+}
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+// AcceptDragDropPayloadV parameter default value hint:
+// flags: 0
+func AcceptDragDropPayloadV(typeArg string, flags ImGuiDragDropFlags) ImGuiPayload {
+	typeArgArg, typeArgFin := wrapString(typeArg)
+	defer typeArgFin()
 
-	format_maxArg, format_maxFin := wrapString(format_max)
-	defer format_maxFin()
+	return (ImGuiPayload)(unsafe.Pointer(C.AcceptDragDropPayload(typeArgArg, C.ImGuiDragDropFlags(flags))))
+}
 
-	return C.DragFloatRange2(labelArg, v_current_minArg, v_current_maxArg, C.float(v_speed), C.float(v_min), C.float(v_max), formatArg, format_maxArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+func AcceptDragDropPayload(typeArg string) ImGuiPayload {
+	return AcceptDragDropPayloadV(typeArg, 0)
 }
 
-func PushStyleVar_Float(idx ImGuiStyleVar, val float32) {
-	C.PushStyleVar_Float(C.ImGuiStyleVar(idx), C.float(val))
+func EndDragDropTarget() {
+	C.EndDragDropTarget()
 }
 
-func PushStyleVar_Vec2(idx ImGuiStyleVar, val ImVec2) {
-	C.PushStyleVar_Vec2(C.ImGuiStyleVar(idx), val.toC())
+func GetDragDropPayload() ImGuiPayload {
+	return (ImGuiPayload)(unsafe.Pointer(C.GetDragDropPayload()))
 }
 
-func (self ImGuiTableColumnSortSpecs) Destroy() {
-	C.TableColumnSortSpecs_Destroy(self.handle())
+// BeginDisabledV parameter default value hint:
+// disabled: true
+func BeginDisabledV(disabled bool) {
+	C.BeginDisabled(C.bool(disabled))
 }
 
-func GetFrameCount() int {
-	return int(C.GetFrameCount())
+func BeginDisabled() {
+	BeginDisabledV(true)
 }
 
-func LogFinish() {
-	C.LogFinish()
+func EndDisabled() {
+	C.EndDisabled()
 }
 
-func DragInt2(label string, v [2]*int32, v_speed float32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func PushClipRect(clip_rect_min ImVec2, clip_rect_max ImVec2, intersect_with_current_clip_rect bool) {
+	C.PushClipRect(clip_rect_min.toC(), clip_rect_max.toC(), C.bool(intersect_with_current_clip_rect))
+}
 
-	vArg := make([]C.int, len(v))
-	for i, vV := range v {
-		vArg[i] = C.int(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = int32(vV)
-		}
-	}()
+func PopClipRect() {
+	C.PopClipRect()
+}
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+func SetItemDefaultFocus() {
+	C.SetItemDefaultFocus()
+}
 
-	return C.DragInt2(labelArg, (*C.int)(&vArg[0]), C.float(v_speed), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+// SetKeyboardFocusHereV parameter default value hint:
+// offset: 0
+func SetKeyboardFocusHereV(offset int32) {
+	C.SetKeyboardFocusHere(C.int(offset))
 }
 
-func (self ImGuiIO) AddInputCharactersUTF8(str string) {
-	strArg, strFin := wrapString(str)
-	defer strFin()
+func SetKeyboardFocusHere() {
+	SetKeyboardFocusHereV(0)
+}
 
-	C.IO_AddInputCharactersUTF8(self.handle(), strArg)
+// IsItemHoveredV parameter default value hint:
+// flags: 0
+func IsItemHoveredV(flags ImGuiHoveredFlags) bool {
+	return C.IsItemHovered(C.ImGuiHoveredFlags(flags)) == C.bool(true)
 }
 
-func GetFrameHeightWithSpacing() float32 {
-	return float32(C.GetFrameHeightWithSpacing())
+func IsItemHovered() bool {
+	return IsItemHoveredV(0)
 }
 
-func (self ImDrawList) PathArcToFast(center ImVec2, radius float32, a_min_of_12 int32, a_max_of_12 int32) {
-	C.DrawList_PathArcToFast(self.handle(), center.toC(), C.float(radius), C.int(a_min_of_12), C.int(a_max_of_12))
+func IsItemActive() bool {
+	return C.IsItemActive() == C.bool(true)
 }
 
-func (self ImFontGlyphRangesBuilder) GetBit(n uint64) bool {
-	return C.FontGlyphRangesBuilder_GetBit(self.handle(), C.xlong(n)) == C.bool(true)
+func IsItemFocused() bool {
+	return C.IsItemFocused() == C.bool(true)
 }
 
-func (self ImFont) GrowIndex(new_size int32) {
-	C.Font_GrowIndex(self.handle(), C.int(new_size))
+// IsItemClickedV parameter default value hint:
+// mouse_button: 0
+func IsItemClickedV(mouse_button ImGuiMouseButton) bool {
+	return C.IsItemClicked(C.ImGuiMouseButton(mouse_button)) == C.bool(true)
 }
 
-func (self ImGuiIO) Destroy() {
-	C.IO_Destroy(self.handle())
+func IsItemClicked() bool {
+	return IsItemClickedV(0)
 }
 
-func PushStyleColor_U32(idx ImGuiCol, col uint32) {
-	C.PushStyleColor_U32(C.ImGuiCol(idx), C.ImU32(col))
+func IsItemVisible() bool {
+	return C.IsItemVisible() == C.bool(true)
 }
 
-func PushStyleColor_Vec4(idx ImGuiCol, col ImVec4) {
-	C.PushStyleColor_Vec4(C.ImGuiCol(idx), col.toC())
+func IsItemEdited() bool {
+	return C.IsItemEdited() == C.bool(true)
 }
 
-func SetNextFrameWantCaptureKeyboard(want_capture_keyboard bool) {
-	C.SetNextFrameWantCaptureKeyboard(C.bool(want_capture_keyboard))
+func IsItemActivated() bool {
+	return C.IsItemActivated() == C.bool(true)
 }
 
-func SliderFloat2(label string, v [2]*float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
-
-	vArg := make([]C.float, len(v))
-	for i, vV := range v {
-		vArg[i] = C.float(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = float32(vV)
-		}
-	}()
+func IsItemDeactivated() bool {
+	return C.IsItemDeactivated() == C.bool(true)
+}
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+func IsItemDeactivatedAfterEdit() bool {
+	return C.IsItemDeactivatedAfterEdit() == C.bool(true)
+}
 
-	return C.SliderFloat2(labelArg, (*C.float)(&vArg[0]), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+func IsItemToggledOpen() bool {
+	return C.IsItemToggledOpen() == C.bool(true)
 }
 
-func GetWindowHeight() float32 {
-	return float32(C.GetWindowHeight())
+func IsAnyItemHovered() bool {
+	return C.IsAnyItemHovered() == C.bool(true)
 }
 
-func InvisibleButton(str_id string, size ImVec2, flags ImGuiButtonFlags) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+func IsAnyItemActive() bool {
+	return C.IsAnyItemActive() == C.bool(true)
+}
 
-	return C.InvisibleButton(str_idArg, size.toC(), C.ImGuiButtonFlags(flags)) == C.bool(true)
+func IsAnyItemFocused() bool {
+	return C.IsAnyItemFocused() == C.bool(true)
 }
 
-func PushClipRect(clip_rect_min ImVec2, clip_rect_max ImVec2, intersect_with_current_clip_rect bool) {
-	C.PushClipRect(clip_rect_min.toC(), clip_rect_max.toC(), C.bool(intersect_with_current_clip_rect))
+func GetItemRectMin(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.GetItemRectMin(pOutArg)
 }
 
-func (self ImFontAtlas) Build() bool {
-	return C.FontAtlas_Build(self.handle()) == C.bool(true)
+func GetItemRectMax(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.GetItemRectMax(pOutArg)
 }
 
-func (self ImGuiStyleMod) Destroy() {
-	C.StyleMod_Destroy(self.handle())
+func GetItemRectSize(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.GetItemRectSize(pOutArg)
 }
 
-func EndTabBar() {
-	C.EndTabBar()
+func SetItemAllowOverlap() {
+	C.SetItemAllowOverlap()
 }
 
-func SetMouseCursor(cursor_type ImGuiMouseCursor) {
-	C.SetMouseCursor(C.ImGuiMouseCursor(cursor_type))
+func GetMainViewport() ImGuiViewport {
+	return (ImGuiViewport)(unsafe.Pointer(C.GetMainViewport()))
 }
 
-func NewDrawList(shared_data ImDrawListSharedData) ImDrawList {
-	return (ImDrawList)(unsafe.Pointer(C.DrawList_ImDrawList(shared_data.handle())))
+func GetBackgroundDrawList_Nil() ImDrawList {
+	return (ImDrawList)(unsafe.Pointer(C.GetBackgroundDrawList_Nil()))
 }
 
-func (self ImDrawList) PrimVtx(pos ImVec2, uv ImVec2, col uint32) {
-	C.DrawList_PrimVtx(self.handle(), pos.toC(), uv.toC(), C.ImU32(col))
+func GetForegroundDrawList_Nil() ImDrawList {
+	return (ImDrawList)(unsafe.Pointer(C.GetForegroundDrawList_Nil()))
 }
 
-func TreeNodeEx_Str(label string, flags ImGuiTreeNodeFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func GetBackgroundDrawList_ViewportPtr(viewport ImGuiViewport) ImDrawList {
+	return (ImDrawList)(unsafe.Pointer(C.GetBackgroundDrawList_ViewportPtr(viewport.handle())))
+}
 
-	return C.TreeNodeEx_Str(labelArg, C.ImGuiTreeNodeFlags(flags)) == C.bool(true)
+func GetForegroundDrawList_ViewportPtr(viewport ImGuiViewport) ImDrawList {
+	return (ImDrawList)(unsafe.Pointer(C.GetForegroundDrawList_ViewportPtr(viewport.handle())))
 }
 
-func TreeNodeEx_StrStr(str_id string, flags ImGuiTreeNodeFlags, fmt string) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+func IsRectVisible_Nil(size ImVec2) bool {
+	return C.IsRectVisible_Nil(size.toC()) == C.bool(true)
+}
 
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
+func IsRectVisible_Vec2(rect_min ImVec2, rect_max ImVec2) bool {
+	return C.IsRectVisible_Vec2(rect_min.toC(), rect_max.toC()) == C.bool(true)
+}
 
-	return C.TreeNodeEx_StrStr(str_idArg, C.ImGuiTreeNodeFlags(flags), fmtArg) == C.bool(true)
+func GetTime() float64 {
+	return float64(C.GetTime())
 }
 
-func TreeNodeEx_Ptr(ptr_id unsafe.Pointer, flags ImGuiTreeNodeFlags, fmt string) bool {
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
+func GetFrameCount() int {
+	return int(C.GetFrameCount())
+}
 
-	return C.TreeNodeEx_Ptr(ptr_id, C.ImGuiTreeNodeFlags(flags), fmtArg) == C.bool(true)
+func GetDrawListSharedData() ImDrawListSharedData {
+	return (ImDrawListSharedData)(unsafe.Pointer(C.GetDrawListSharedData()))
 }
 
-func (self ImGuiPtrOrIndex) Destroy() {
-	C.PtrOrIndex_Destroy(self.handle())
+func GetStyleColorName(idx ImGuiCol) string {
+	return C.GoString(C.GetStyleColorName(C.ImGuiCol(idx)))
 }
 
-func BeginTabBar(str_id string, flags ImGuiTabBarFlags) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+// BeginChildFrameV parameter default value hint:
+// flags: 0
+func BeginChildFrameV(id ImGuiID, size ImVec2, flags ImGuiWindowFlags) bool {
+	return C.BeginChildFrame(C.ImGuiID(id), size.toC(), C.ImGuiWindowFlags(flags)) == C.bool(true)
+}
 
-	return C.BeginTabBar(str_idArg, C.ImGuiTabBarFlags(flags)) == C.bool(true)
+func BeginChildFrame(id ImGuiID, size ImVec2) bool {
+	return BeginChildFrameV(id, size, 0)
 }
 
-func IsItemFocused() bool {
-	return C.IsItemFocused() == C.bool(true)
+func EndChildFrame() {
+	C.EndChildFrame()
 }
 
-func ShowMetricsWindow(p_open *bool) {
-	p_openArg, p_openFin := wrapBool(p_open)
-	defer p_openFin()
+// CalcTextSizeV parameter default value hint:
+// hide_text_after_double_hash: false
+// wrap_width: -1.0f
+func CalcTextSizeV(pOut *ImVec2, text string, hide_text_after_double_hash bool, wrap_width float32) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
 
-	C.ShowMetricsWindow(p_openArg)
+	textArg, textFin := wrapString(text)
+	defer textFin()
+
+	C.CalcTextSize(pOutArg, textArg, C.bool(hide_text_after_double_hash), C.float(wrap_width))
 }
 
-func (self ImDrawList) PathLineToMergeDuplicate(pos ImVec2) {
-	C.DrawList_PathLineToMergeDuplicate(self.handle(), pos.toC())
+func CalcTextSize(pOut *ImVec2, text string) {
+	CalcTextSizeV(pOut, text, false, -1.0)
 }
 
-func (self ImGuiOldColumns) Destroy() {
-	C.OldColumns_Destroy(self.handle())
+func ColorConvertU32ToFloat4(pOut *ImVec4, in uint32) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.ColorConvertU32ToFloat4(pOutArg, C.ImU32(in))
 }
 
-func NewFontGlyphRangesBuilder() ImFontGlyphRangesBuilder {
-	return (ImFontGlyphRangesBuilder)(unsafe.Pointer(C.FontGlyphRangesBuilder_ImFontGlyphRangesBuilder()))
+func ColorConvertFloat4ToU32(in ImVec4) uint32 {
+	return uint32(C.ColorConvertFloat4ToU32(in.toC()))
 }
 
-func OpenPopupOnItemClick(str_id string, popup_flags ImGuiPopupFlags) {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+func ColorConvertRGBtoHSV(r float32, g float32, b float32, out_h *float32, out_s *float32, out_v *float32) {
+	out_hArg, out_hFin := wrapFloat(out_h)
+	defer out_hFin()
 
-	C.OpenPopupOnItemClick(str_idArg, C.ImGuiPopupFlags(popup_flags))
-}
+	out_sArg, out_sFin := wrapFloat(out_s)
+	defer out_sFin()
 
-func SetItemAllowOverlap() {
-	C.SetItemAllowOverlap()
+	out_vArg, out_vFin := wrapFloat(out_v)
+	defer out_vFin()
+
+	C.ColorConvertRGBtoHSV(C.float(r), C.float(g), C.float(b), out_hArg, out_sArg, out_vArg)
 }
 
-func (self ImDrawList) PushTextureID(texture_id ImTextureID) {
-	C.DrawList_PushTextureID(self.handle(), C.ImTextureID(texture_id))
+func ColorConvertHSVtoRGB(h float32, s float32, v float32, out_r *float32, out_g *float32, out_b *float32) {
+	out_rArg, out_rFin := wrapFloat(out_r)
+	defer out_rFin()
+
+	out_gArg, out_gFin := wrapFloat(out_g)
+	defer out_gFin()
+
+	out_bArg, out_bFin := wrapFloat(out_b)
+	defer out_bFin()
+
+	C.ColorConvertHSVtoRGB(C.float(h), C.float(s), C.float(v), out_rArg, out_gArg, out_bArg)
 }
 
-func (self ImFontGlyphRangesBuilder) AddChar(c ImWchar) {
-	C.FontGlyphRangesBuilder_AddChar(self.handle(), C.ImWchar(c))
+func IsKeyDown(key ImGuiKey) bool {
+	return C.IsKeyDown(C.ImGuiKey(key)) == C.bool(true)
 }
 
-func GetKeyIndex(key ImGuiKey) int {
-	return int(C.GetKeyIndex(C.ImGuiKey(key)))
+// IsKeyPressedV parameter default value hint:
+// repeat: true
+func IsKeyPressedV(key ImGuiKey, repeat bool) bool {
+	return C.IsKeyPressed(C.ImGuiKey(key), C.bool(repeat)) == C.bool(true)
 }
 
-func (self ImFontAtlas) GetGlyphRangesKorean() *ImWchar {
-	return (*ImWchar)(C.FontAtlas_GetGlyphRangesKorean(self.handle()))
+func IsKeyPressed(key ImGuiKey) bool {
+	return IsKeyPressedV(key, true)
 }
 
-func (self ImGuiListClipperData) Destroy() {
-	C.ListClipperData_Destroy(self.handle())
+func IsKeyReleased(key ImGuiKey) bool {
+	return C.IsKeyReleased(C.ImGuiKey(key)) == C.bool(true)
 }
 
-func IsWindowAppearing() bool {
-	return C.IsWindowAppearing() == C.bool(true)
+func GetKeyPressedAmount(key ImGuiKey, repeat_delay float32, rate float32) int {
+	return int(C.GetKeyPressedAmount(C.ImGuiKey(key), C.float(repeat_delay), C.float(rate)))
 }
 
-func NewFontConfig() ImFontConfig {
-	return (ImFontConfig)(unsafe.Pointer(C.FontConfig_ImFontConfig()))
+func GetKeyName(key ImGuiKey) string {
+	return C.GoString(C.GetKeyName(C.ImGuiKey(key)))
 }
 
-func IsMouseDoubleClicked(button ImGuiMouseButton) bool {
-	return C.IsMouseDoubleClicked(C.ImGuiMouseButton(button)) == C.bool(true)
+func SetNextFrameWantCaptureKeyboard(want_capture_keyboard bool) {
+	C.SetNextFrameWantCaptureKeyboard(C.bool(want_capture_keyboard))
 }
 
-func IsWindowDocked() bool {
-	return C.IsWindowDocked() == C.bool(true)
+func IsMouseDown(button ImGuiMouseButton) bool {
+	return C.IsMouseDown(C.ImGuiMouseButton(button)) == C.bool(true)
 }
 
-func InputFloat3(label string, v [3]*float32, format string, flags ImGuiInputTextFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+// IsMouseClickedV parameter default value hint:
+// repeat: false
+func IsMouseClickedV(button ImGuiMouseButton, repeat bool) bool {
+	return C.IsMouseClicked(C.ImGuiMouseButton(button), C.bool(repeat)) == C.bool(true)
+}
 
-	vArg := make([]C.float, len(v))
-	for i, vV := range v {
-		vArg[i] = C.float(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = float32(vV)
-		}
-	}()
+func IsMouseClicked(button ImGuiMouseButton) bool {
+	return IsMouseClickedV(button, false)
+}
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+func IsMouseReleased(button ImGuiMouseButton) bool {
+	return C.IsMouseReleased(C.ImGuiMouseButton(button)) == C.bool(true)
+}
 
-	return C.InputFloat3(labelArg, (*C.float)(&vArg[0]), formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
+func IsMouseDoubleClicked(button ImGuiMouseButton) bool {
+	return C.IsMouseDoubleClicked(C.ImGuiMouseButton(button)) == C.bool(true)
 }
 
-func BeginTooltip() {
-	C.BeginTooltip()
+func GetMouseClickedCount(button ImGuiMouseButton) int {
+	return int(C.GetMouseClickedCount(C.ImGuiMouseButton(button)))
 }
 
-func DockSpaceOverViewport(viewport ImGuiViewport, flags ImGuiDockNodeFlags, window_class ImGuiWindowClass) ImGuiID {
-	return ImGuiID(C.DockSpaceOverViewport(viewport.handle(), C.ImGuiDockNodeFlags(flags), window_class.handle()))
+// IsMouseHoveringRectV parameter default value hint:
+// clip: true
+func IsMouseHoveringRectV(r_min ImVec2, r_max ImVec2, clip bool) bool {
+	return C.IsMouseHoveringRect(r_min.toC(), r_max.toC(), C.bool(clip)) == C.bool(true)
 }
 
-func DragFloat2(label string, v [2]*float32, v_speed float32, v_min float32, v_max float32, format string, flags ImGuiSliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func IsMouseHoveringRect(r_min ImVec2, r_max ImVec2) bool {
+	return IsMouseHoveringRectV(r_min, r_max, true)
+}
 
-	vArg := make([]C.float, len(v))
-	for i, vV := range v {
-		vArg[i] = C.float(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = float32(vV)
-		}
-	}()
+// IsMousePosValidV parameter default value hint:
+// mouse_pos: NULL
+func IsMousePosValidV(mouse_pos *ImVec2) bool {
+	mouse_posArg, mouse_posFin := mouse_pos.wrap()
+	defer mouse_posFin()
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+	return C.IsMousePosValid(mouse_posArg) == C.bool(true)
+}
 
-	return C.DragFloat2(labelArg, (*C.float)(&vArg[0]), C.float(v_speed), C.float(v_min), C.float(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+func IsMousePosValid() bool {
+	return IsMousePosValidV(nil)
 }
 
-func GetMouseCursor() ImGuiMouseCursor {
-	return ImGuiMouseCursor(C.GetMouseCursor())
+func IsAnyMouseDown() bool {
+	return C.IsAnyMouseDown() == C.bool(true)
 }
 
-func (self ImDrawList) AddCircle(center ImVec2, radius float32, col uint32, num_segments int32, thickness float32) {
-	C.DrawList_AddCircle(self.handle(), center.toC(), C.float(radius), C.ImU32(col), C.int(num_segments), C.float(thickness))
+func GetMousePos(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.GetMousePos(pOutArg)
 }
 
-func ImageButton(str_id string, user_texture_id ImTextureID, size ImVec2, uv0 ImVec2, uv1 ImVec2, bg_col ImVec4, tint_col ImVec4) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+func GetMousePosOnOpeningCurrentPopup(pOut *ImVec2) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
 
-	return C.ImageButton(str_idArg, C.ImTextureID(user_texture_id), size.toC(), uv0.toC(), uv1.toC(), bg_col.toC(), tint_col.toC()) == C.bool(true)
+	C.GetMousePosOnOpeningCurrentPopup(pOutArg)
 }
 
-func LabelText(label string, fmt string) {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+// IsMouseDraggingV parameter default value hint:
+// lock_threshold: -1.0f
+func IsMouseDraggingV(button ImGuiMouseButton, lock_threshold float32) bool {
+	return C.IsMouseDragging(C.ImGuiMouseButton(button), C.float(lock_threshold)) == C.bool(true)
+}
 
-	fmtArg, fmtFin := wrapString(fmt)
-	defer fmtFin()
+func IsMouseDragging(button ImGuiMouseButton) bool {
+	return IsMouseDraggingV(button, -1.0)
+}
 
-	C.LabelText(labelArg, fmtArg)
+// GetMouseDragDeltaV parameter default value hint:
+// button: 0
+// lock_threshold: -1.0f
+func GetMouseDragDeltaV(pOut *ImVec2, button ImGuiMouseButton, lock_threshold float32) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.GetMouseDragDelta(pOutArg, C.ImGuiMouseButton(button), C.float(lock_threshold))
 }
 
-func SetNextWindowPos(pos ImVec2, cond ImGuiCond, pivot ImVec2) {
-	C.SetNextWindowPos(pos.toC(), C.ImGuiCond(cond), pivot.toC())
+func GetMouseDragDelta(pOut *ImVec2) {
+	GetMouseDragDeltaV(pOut, 0, -1.0)
 }
 
-func CollapsingHeader_TreeNodeFlags(label string, flags ImGuiTreeNodeFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+// ResetMouseDragDeltaV parameter default value hint:
+// button: 0
+func ResetMouseDragDeltaV(button ImGuiMouseButton) {
+	C.ResetMouseDragDelta(C.ImGuiMouseButton(button))
+}
 
-	return C.CollapsingHeader_TreeNodeFlags(labelArg, C.ImGuiTreeNodeFlags(flags)) == C.bool(true)
+func ResetMouseDragDelta() {
+	ResetMouseDragDeltaV(0)
 }
 
-func CollapsingHeader_BoolPtr(label string, p_visible *bool, flags ImGuiTreeNodeFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func GetMouseCursor() ImGuiMouseCursor {
+	return ImGuiMouseCursor(C.GetMouseCursor())
+}
 
-	p_visibleArg, p_visibleFin := wrapBool(p_visible)
-	defer p_visibleFin()
+func SetMouseCursor(cursor_type ImGuiMouseCursor) {
+	C.SetMouseCursor(C.ImGuiMouseCursor(cursor_type))
+}
 
-	return C.CollapsingHeader_BoolPtr(labelArg, p_visibleArg, C.ImGuiTreeNodeFlags(flags)) == C.bool(true)
+func SetNextFrameWantCaptureMouse(want_capture_mouse bool) {
+	C.SetNextFrameWantCaptureMouse(C.bool(want_capture_mouse))
 }
 
-func CalcItemWidth() float32 {
-	return float32(C.CalcItemWidth())
+func GetClipboardText() string {
+	return C.GoString(C.GetClipboardText())
 }
 
-func EndTabItem() {
-	C.EndTabItem()
+func SetClipboardText(text string) {
+	textArg, textFin := wrapString(text)
+	defer textFin()
+
+	C.SetClipboardText(textArg)
 }
 
-func GetVersion() string {
-	return C.GoString(C.GetVersion())
+func LoadIniSettingsFromDisk(ini_filename string) {
+	ini_filenameArg, ini_filenameFin := wrapString(ini_filename)
+	defer ini_filenameFin()
+
+	C.LoadIniSettingsFromDisk(ini_filenameArg)
 }
 
-func IsWindowHovered(flags ImGuiHoveredFlags) bool {
-	return C.IsWindowHovered(C.ImGuiHoveredFlags(flags)) == C.bool(true)
+// LoadIniSettingsFromMemoryV parameter default value hint:
+// ini_size: 0
+func LoadIniSettingsFromMemoryV(ini_data string, ini_size uint64) {
+	ini_dataArg, ini_dataFin := wrapString(ini_data)
+	defer ini_dataFin()
+
+	C.LoadIniSettingsFromMemory(ini_dataArg, C.xlong(ini_size))
 }
 
-func InputInt3(label string, v [3]*int32, flags ImGuiInputTextFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func LoadIniSettingsFromMemory(ini_data string) {
+	LoadIniSettingsFromMemoryV(ini_data, 0)
+}
 
-	vArg := make([]C.int, len(v))
-	for i, vV := range v {
-		vArg[i] = C.int(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = int32(vV)
-		}
-	}()
+func SaveIniSettingsToDisk(ini_filename string) {
+	ini_filenameArg, ini_filenameFin := wrapString(ini_filename)
+	defer ini_filenameFin()
 
-	return C.InputInt3(labelArg, (*C.int)(&vArg[0]), C.ImGuiInputTextFlags(flags)) == C.bool(true)
+	C.SaveIniSettingsToDisk(ini_filenameArg)
 }
 
-func (self ImFontAtlas) SetTexID(id ImTextureID) {
-	C.FontAtlas_SetTexID(self.handle(), C.ImTextureID(id))
+// SaveIniSettingsToMemoryV parameter default value hint:
+// out_ini_size: NULL
+func SaveIniSettingsToMemoryV(out_ini_size *uint64) string {
+	return C.GoString(C.SaveIniSettingsToMemory((*C.xlong)(out_ini_size)))
 }
 
-func CloseCurrentPopup() {
-	C.CloseCurrentPopup()
+func SaveIniSettingsToMemory() string {
+	return SaveIniSettingsToMemoryV(nil)
 }
 
-func BeginListBox(label string, size ImVec2) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func DebugTextEncoding(text string) {
+	textArg, textFin := wrapString(text)
+	defer textFin()
 
-	return C.BeginListBox(labelArg, size.toC()) == C.bool(true)
+	C.DebugTextEncoding(textArg)
 }
 
 func DebugCheckVersionAndDataLayout(version_str string, sz_io uint64, sz_style uint64, sz_vec2 uint64, sz_vec4 uint64, sz_drawvert uint64, sz_drawidx uint64) bool {
@@ -3296,2741 +4014,4075 @@ func DebugCheckVersionAndDataLayout(version_str string, sz_io uint64, sz_style u
 	return C.DebugCheckVersionAndDataLayout(version_strArg, C.xlong(sz_io), C.xlong(sz_style), C.xlong(sz_vec2), C.xlong(sz_vec4), C.xlong(sz_drawvert), C.xlong(sz_drawidx)) == C.bool(true)
 }
 
-func (self ImDrawData) Destroy() {
-	C.DrawData_Destroy(self.handle())
+func MemAlloc(size uint64) unsafe.Pointer {
+	return unsafe.Pointer(C.MemAlloc(C.xlong(size)))
 }
 
-func (self ImGuiStackTool) Destroy() {
-	C.StackTool_Destroy(self.handle())
+func MemFree(ptr unsafe.Pointer) {
+	C.MemFree(ptr)
 }
 
-func InputScalar(label string, data_type ImGuiDataType, p_data unsafe.Pointer, p_step unsafe.Pointer, p_step_fast unsafe.Pointer, format string, flags ImGuiInputTextFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func GetPlatformIO() ImGuiPlatformIO {
+	return (ImGuiPlatformIO)(unsafe.Pointer(C.GetPlatformIO()))
+}
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+func UpdatePlatformWindows() {
+	C.UpdatePlatformWindows()
+}
 
-	return C.InputScalar(labelArg, C.ImGuiDataType(data_type), p_data, p_step, p_step_fast, formatArg, C.ImGuiInputTextFlags(flags)) == C.bool(true)
+// RenderPlatformWindowsDefaultV parameter default value hint:
+// platform_render_arg: NULL
+// renderer_render_arg: NULL
+func RenderPlatformWindowsDefaultV(platform_render_arg unsafe.Pointer, renderer_render_arg unsafe.Pointer) {
+	C.RenderPlatformWindowsDefault(platform_render_arg, renderer_render_arg)
 }
 
-func (self ImGuiMetricsConfig) Destroy() {
-	C.MetricsConfig_Destroy(self.handle())
+func RenderPlatformWindowsDefault() {
+	RenderPlatformWindowsDefaultV(nil, nil)
 }
 
-func BeginTable(str_id string, column int32, flags ImGuiTableFlags, outer_size ImVec2, inner_width float32) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+func DestroyPlatformWindows() {
+	C.DestroyPlatformWindows()
+}
 
-	return C.BeginTable(str_idArg, C.int(column), C.ImGuiTableFlags(flags), outer_size.toC(), C.float(inner_width)) == C.bool(true)
+func FindViewportByID(id ImGuiID) ImGuiViewport {
+	return (ImGuiViewport)(unsafe.Pointer(C.FindViewportByID(C.ImGuiID(id))))
 }
 
-func DragInt3(label string, v [3]*int32, v_speed float32, v_min int32, v_max int32, format string, flags ImGuiSliderFlags) bool {
-	labelArg, labelFin := wrapString(label)
-	defer labelFin()
+func FindViewportByPlatformHandle(platform_handle unsafe.Pointer) ImGuiViewport {
+	return (ImGuiViewport)(unsafe.Pointer(C.FindViewportByPlatformHandle(platform_handle)))
+}
 
-	vArg := make([]C.int, len(v))
-	for i, vV := range v {
-		vArg[i] = C.int(*vV)
-	}
-	defer func() {
-		for i, vV := range vArg {
-			*v[i] = int32(vV)
-		}
-	}()
+func NewStyle() ImGuiStyle {
+	return (ImGuiStyle)(unsafe.Pointer(C.Style_ImGuiStyle()))
+}
 
-	formatArg, formatFin := wrapString(format)
-	defer formatFin()
+func (self ImGuiStyle) ScaleAllSizes(scale_factor float32) {
+	C.Style_ScaleAllSizes(self.handle(), C.float(scale_factor))
+}
 
-	return C.DragInt3(labelArg, (*C.int)(&vArg[0]), C.float(v_speed), C.int(v_min), C.int(v_max), formatArg, C.ImGuiSliderFlags(flags)) == C.bool(true)
+func (self ImGuiIO) AddKeyEvent(key ImGuiKey, down bool) {
+	C.IO_AddKeyEvent(self.handle(), C.ImGuiKey(key), C.bool(down))
 }
 
-func GetDrawCursorStartPos(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+func (self ImGuiIO) AddKeyAnalogEvent(key ImGuiKey, down bool, v float32) {
+	C.IO_AddKeyAnalogEvent(self.handle(), C.ImGuiKey(key), C.bool(down), C.float(v))
+}
 
-	C.GetDrawCursorStartPos(pOutArg)
+func (self ImGuiIO) AddMousePosEvent(x float32, y float32) {
+	C.IO_AddMousePosEvent(self.handle(), C.float(x), C.float(y))
 }
 
-func (self ImDrawData) Clear() {
-	C.DrawData_Clear(self.handle())
+func (self ImGuiIO) AddMouseButtonEvent(button int32, down bool) {
+	C.IO_AddMouseButtonEvent(self.handle(), C.int(button), C.bool(down))
 }
 
-func (self ImFontAtlas) ClearInputData() {
-	C.FontAtlas_ClearInputData(self.handle())
+func (self ImGuiIO) AddMouseWheelEvent(wh_x float32, wh_y float32) {
+	C.IO_AddMouseWheelEvent(self.handle(), C.float(wh_x), C.float(wh_y))
 }
 
-func EndDragDropSource() {
-	C.EndDragDropSource()
+func (self ImGuiIO) AddMouseViewportEvent(id ImGuiID) {
+	C.IO_AddMouseViewportEvent(self.handle(), C.ImGuiID(id))
+}
+
+func (self ImGuiIO) AddFocusEvent(focused bool) {
+	C.IO_AddFocusEvent(self.handle(), C.bool(focused))
+}
+
+func (self ImGuiIO) AddInputCharacter(c uint32) {
+	C.IO_AddInputCharacter(self.handle(), C.uint(c))
+}
+
+func (self ImGuiIO) AddInputCharactersUTF8(str string) {
+	strArg, strFin := wrapString(str)
+	defer strFin()
+
+	C.IO_AddInputCharactersUTF8(self.handle(), strArg)
+}
+
+// SetKeyEventNativeDataV parameter default value hint:
+// native_legacy_index: -1
+func (self ImGuiIO) SetKeyEventNativeDataV(key ImGuiKey, native_keycode int32, native_scancode int32, native_legacy_index int32) {
+	C.IO_SetKeyEventNativeData(self.handle(), C.ImGuiKey(key), C.int(native_keycode), C.int(native_scancode), C.int(native_legacy_index))
+}
+
+func (self ImGuiIO) SetKeyEventNativeData(key ImGuiKey, native_keycode int32, native_scancode int32) {
+	self.SetKeyEventNativeDataV(key, native_keycode, native_scancode, -1)
+}
+
+func (self ImGuiIO) SetAppAcceptingEvents(accepting_events bool) {
+	C.IO_SetAppAcceptingEvents(self.handle(), C.bool(accepting_events))
+}
+
+func (self ImGuiIO) ClearInputCharacters() {
+	C.IO_ClearInputCharacters(self.handle())
+}
+
+func (self ImGuiIO) ClearInputKeys() {
+	C.IO_ClearInputKeys(self.handle())
+}
+
+func NewIO() ImGuiIO {
+	return (ImGuiIO)(unsafe.Pointer(C.IO_ImGuiIO()))
+}
+
+func NewInputTextCallbackData() ImGuiInputTextCallbackData {
+	return (ImGuiInputTextCallbackData)(unsafe.Pointer(C.InputTextCallbackData_ImGuiInputTextCallbackData()))
+}
+
+func (self ImGuiInputTextCallbackData) DeleteChars(pos int32, bytes_count int32) {
+	C.InputTextCallbackData_DeleteChars(self.handle(), C.int(pos), C.int(bytes_count))
+}
+
+func (self ImGuiInputTextCallbackData) InsertChars(pos int32, text string) {
+	textArg, textFin := wrapString(text)
+	defer textFin()
+
+	C.InputTextCallbackData_InsertChars(self.handle(), C.int(pos), textArg)
+}
+
+func (self ImGuiInputTextCallbackData) SelectAll() {
+	C.InputTextCallbackData_SelectAll(self.handle())
+}
+
+func (self ImGuiInputTextCallbackData) ClearSelection() {
+	C.InputTextCallbackData_ClearSelection(self.handle())
+}
+
+func (self ImGuiInputTextCallbackData) HasSelection() bool {
+	return C.InputTextCallbackData_HasSelection(self.handle()) == C.bool(true)
+}
+
+func NewWindowClass() ImGuiWindowClass {
+	return (ImGuiWindowClass)(unsafe.Pointer(C.WindowClass_ImGuiWindowClass()))
+}
+
+func NewPayload() ImGuiPayload {
+	return (ImGuiPayload)(unsafe.Pointer(C.Payload_ImGuiPayload()))
+}
+
+func (self ImGuiPayload) Clear() {
+	C.Payload_Clear(self.handle())
+}
+
+func (self ImGuiPayload) IsDataType(typeArg string) bool {
+	typeArgArg, typeArgFin := wrapString(typeArg)
+	defer typeArgFin()
+
+	return C.Payload_IsDataType(self.handle(), typeArgArg) == C.bool(true)
+}
+
+func (self ImGuiPayload) IsPreview() bool {
+	return C.Payload_IsPreview(self.handle()) == C.bool(true)
+}
+
+func (self ImGuiPayload) IsDelivery() bool {
+	return C.Payload_IsDelivery(self.handle()) == C.bool(true)
+}
+
+func NewTableColumnSortSpecs() ImGuiTableColumnSortSpecs {
+	return (ImGuiTableColumnSortSpecs)(unsafe.Pointer(C.TableColumnSortSpecs_ImGuiTableColumnSortSpecs()))
+}
+
+func NewTableSortSpecs() ImGuiTableSortSpecs {
+	return (ImGuiTableSortSpecs)(unsafe.Pointer(C.TableSortSpecs_ImGuiTableSortSpecs()))
+}
+
+func NewOnceUponAFrame() ImGuiOnceUponAFrame {
+	return (ImGuiOnceUponAFrame)(unsafe.Pointer(C.OnceUponAFrame_ImGuiOnceUponAFrame()))
+}
+
+func NewTextFilter(default_filter string) ImGuiTextFilter {
+	default_filterArg, default_filterFin := wrapString(default_filter)
+	defer default_filterFin()
+
+	return (ImGuiTextFilter)(unsafe.Pointer(C.TextFilter_ImGuiTextFilter(default_filterArg)))
+}
+
+// DrawV parameter default value hint:
+// label: "Filter(inc,-exc)"
+// width: 0.0f
+func (self ImGuiTextFilter) DrawV(label string, width float32) bool {
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
+
+	return C.TextFilter_Draw(self.handle(), labelArg, C.float(width)) == C.bool(true)
+}
+
+func (self ImGuiTextFilter) Draw() bool {
+	return self.DrawV("Filter(inc,-exc)", 0.0)
+}
+
+func (self ImGuiTextFilter) PassFilter(text string) bool {
+	textArg, textFin := wrapString(text)
+	defer textFin()
+
+	return C.TextFilter_PassFilter(self.handle(), textArg) == C.bool(true)
+}
+
+func (self ImGuiTextFilter) Build() {
+	C.TextFilter_Build(self.handle())
+}
+
+func (self ImGuiTextFilter) Clear() {
+	C.TextFilter_Clear(self.handle())
+}
+
+func (self ImGuiTextFilter) IsActive() bool {
+	return C.TextFilter_IsActive(self.handle()) == C.bool(true)
+}
+
+func NewTextBuffer() ImGuiTextBuffer {
+	return (ImGuiTextBuffer)(unsafe.Pointer(C.TextBuffer_ImGuiTextBuffer()))
+}
+
+func (self ImGuiTextBuffer) Begin() string {
+	return C.GoString(C.TextBuffer_Begin(self.handle()))
+}
+
+func (self ImGuiTextBuffer) End() string {
+	return C.GoString(C.TextBuffer_End(self.handle()))
+}
+
+func (self ImGuiTextBuffer) Size() int {
+	return int(C.TextBuffer_Size(self.handle()))
+}
+
+func (self ImGuiTextBuffer) Empty() bool {
+	return C.TextBuffer_Empty(self.handle()) == C.bool(true)
+}
+
+func (self ImGuiTextBuffer) Clear() {
+	C.TextBuffer_Clear(self.handle())
+}
+
+func (self ImGuiTextBuffer) Reserve(capacity int32) {
+	C.TextBuffer_Reserve(self.handle(), C.int(capacity))
+}
+
+func (self ImGuiTextBuffer) c_str() string {
+	return C.GoString(C.TextBuffer_c_str(self.handle()))
+}
+
+// AppendV parameter default value hint:
+// str_end: NULL
+func (self ImGuiTextBuffer) AppendV(str string, str_end string) {
+	strArg, strFin := wrapString(str)
+	defer strFin()
+
+	str_endArg, str_endFin := wrapNullableString(str_end)
+	defer str_endFin()
+
+	C.TextBuffer_Append(self.handle(), strArg, str_endArg)
+}
+
+func (self ImGuiTextBuffer) Append(str string) {
+	self.AppendV(str, "")
+}
+
+func (self ImGuiTextBuffer) Appendf(fmt string) {
+	fmtArg, fmtFin := wrapString(fmt)
+	defer fmtFin()
+
+	C.TextBuffer_Appendf(self.handle(), fmtArg)
+}
+
+func NewListClipper() ImGuiListClipper {
+	return (ImGuiListClipper)(unsafe.Pointer(C.ListClipper_ImGuiListClipper()))
+}
+
+func (self ImGuiListClipper) Destroy() {
+	C.ListClipper_Destroy(self.handle())
+}
+
+// BeginV parameter default value hint:
+// items_height: -1.0f
+func (self ImGuiListClipper) BeginV(items_count int32, items_height float32) {
+	C.ListClipper_Begin(self.handle(), C.int(items_count), C.float(items_height))
+}
+
+func (self ImGuiListClipper) Begin(items_count int32) {
+	self.BeginV(items_count, -1.0)
+}
+
+func (self ImGuiListClipper) End() {
+	C.ListClipper_End(self.handle())
+}
+
+func (self ImGuiListClipper) Step() bool {
+	return C.ListClipper_Step(self.handle()) == C.bool(true)
+}
+
+func (self ImGuiListClipper) ForceDisplayRangeByIndices(item_min int32, item_max int32) {
+	C.ListClipper_ForceDisplayRangeByIndices(self.handle(), C.int(item_min), C.int(item_max))
+}
+
+// SetHSVV parameter default value hint:
+// a: 1.0f
+func (self *ImColor) SetHSVV(h float32, s float32, v float32, a float32) {
+	selfArg, selfFin := self.wrap()
+	defer selfFin()
+
+	C.Color_SetHSV(selfArg, C.float(h), C.float(s), C.float(v), C.float(a))
+}
+
+func (self *ImColor) SetHSV(h float32, s float32, v float32) {
+	self.SetHSVV(h, s, v, 1.0)
+}
+
+// Color_HSVV parameter default value hint:
+// a: 1.0f
+func Color_HSVV(pOut *ImColor, h float32, s float32, v float32, a float32) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.Color_HSV(pOutArg, C.float(h), C.float(s), C.float(v), C.float(a))
+}
+
+func Color_HSV(pOut *ImColor, h float32, s float32, v float32) {
+	Color_HSVV(pOut, h, s, v, 1.0)
+}
+
+func NewDrawCmd() ImDrawCmd {
+	return (ImDrawCmd)(unsafe.Pointer(C.DrawCmd_ImDrawCmd()))
+}
+
+func (self ImDrawCmd) GetTexID() ImTextureID {
+	return ImTextureID(C.DrawCmd_GetTexID(self.handle()))
+}
+
+func NewDrawListSplitter() ImDrawListSplitter {
+	return (ImDrawListSplitter)(unsafe.Pointer(C.DrawListSplitter_ImDrawListSplitter()))
+}
+
+func (self ImDrawListSplitter) Destroy() {
+	C.DrawListSplitter_Destroy(self.handle())
+}
+
+func (self ImDrawListSplitter) Clear() {
+	C.DrawListSplitter_Clear(self.handle())
+}
+
+func (self ImDrawListSplitter) ClearFreeMemory() {
+	C.DrawListSplitter_ClearFreeMemory(self.handle())
+}
+
+func (self ImDrawListSplitter) Split(draw_list ImDrawList, count int32) {
+	C.DrawListSplitter_Split(self.handle(), draw_list.handle(), C.int(count))
+}
+
+func (self ImDrawListSplitter) Merge(draw_list ImDrawList) {
+	C.DrawListSplitter_Merge(self.handle(), draw_list.handle())
+}
+
+func (self ImDrawListSplitter) SetCurrentChannel(draw_list ImDrawList, channel_idx int32) {
+	C.DrawListSplitter_SetCurrentChannel(self.handle(), draw_list.handle(), C.int(channel_idx))
+}
+
+func NewDrawList(shared_data ImDrawListSharedData) ImDrawList {
+	return (ImDrawList)(unsafe.Pointer(C.DrawList_ImDrawList(shared_data.handle())))
+}
+
+func (self ImDrawList) Destroy() {
+	C.DrawList_Destroy(self.handle())
+}
+
+// PushClipRectV parameter default value hint:
+// intersect_with_current_clip_rect: false
+func (self ImDrawList) PushClipRectV(clip_rect_min ImVec2, clip_rect_max ImVec2, intersect_with_current_clip_rect bool) {
+	C.DrawList_PushClipRect(self.handle(), clip_rect_min.toC(), clip_rect_max.toC(), C.bool(intersect_with_current_clip_rect))
+}
+
+func (self ImDrawList) PushClipRect(clip_rect_min ImVec2, clip_rect_max ImVec2) {
+	self.PushClipRectV(clip_rect_min, clip_rect_max, false)
+}
+
+func (self ImDrawList) PushClipRectFullScreen() {
+	C.DrawList_PushClipRectFullScreen(self.handle())
+}
+
+func (self ImDrawList) PopClipRect() {
+	C.DrawList_PopClipRect(self.handle())
+}
+
+func (self ImDrawList) PushTextureID(texture_id ImTextureID) {
+	C.DrawList_PushTextureID(self.handle(), C.uintptr_t(texture_id))
+}
+
+func (self ImDrawList) PopTextureID() {
+	C.DrawList_PopTextureID(self.handle())
+}
+
+func DrawList_GetClipRectMin(pOut *ImVec2, self ImDrawList) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.DrawList_GetClipRectMin(pOutArg, self.handle())
+}
+
+func DrawList_GetClipRectMax(pOut *ImVec2, self ImDrawList) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.DrawList_GetClipRectMax(pOutArg, self.handle())
+}
+
+// AddLineV parameter default value hint:
+// thickness: 1.0f
+func (self ImDrawList) AddLineV(p1 ImVec2, p2 ImVec2, col uint32, thickness float32) {
+	C.DrawList_AddLine(self.handle(), p1.toC(), p2.toC(), C.ImU32(col), C.float(thickness))
+}
+
+func (self ImDrawList) AddLine(p1 ImVec2, p2 ImVec2, col uint32) {
+	self.AddLineV(p1, p2, col, 1.0)
+}
+
+// AddRectV parameter default value hint:
+// rounding: 0.0f
+// flags: 0
+// thickness: 1.0f
+func (self ImDrawList) AddRectV(p_min ImVec2, p_max ImVec2, col uint32, rounding float32, flags ImDrawFlags, thickness float32) {
+	C.DrawList_AddRect(self.handle(), p_min.toC(), p_max.toC(), C.ImU32(col), C.float(rounding), C.ImDrawFlags(flags), C.float(thickness))
+}
+
+func (self ImDrawList) AddRect(p_min ImVec2, p_max ImVec2, col uint32) {
+	self.AddRectV(p_min, p_max, col, 0.0, 0, 1.0)
+}
+
+// AddRectFilledV parameter default value hint:
+// rounding: 0.0f
+// flags: 0
+func (self ImDrawList) AddRectFilledV(p_min ImVec2, p_max ImVec2, col uint32, rounding float32, flags ImDrawFlags) {
+	C.DrawList_AddRectFilled(self.handle(), p_min.toC(), p_max.toC(), C.ImU32(col), C.float(rounding), C.ImDrawFlags(flags))
+}
+
+func (self ImDrawList) AddRectFilled(p_min ImVec2, p_max ImVec2, col uint32) {
+	self.AddRectFilledV(p_min, p_max, col, 0.0, 0)
+}
+
+func (self ImDrawList) AddRectFilledMultiColor(p_min ImVec2, p_max ImVec2, col_upr_left uint32, col_upr_right uint32, col_bot_right uint32, col_bot_left uint32) {
+	C.DrawList_AddRectFilledMultiColor(self.handle(), p_min.toC(), p_max.toC(), C.ImU32(col_upr_left), C.ImU32(col_upr_right), C.ImU32(col_bot_right), C.ImU32(col_bot_left))
+}
+
+// AddQuadV parameter default value hint:
+// thickness: 1.0f
+func (self ImDrawList) AddQuadV(p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, col uint32, thickness float32) {
+	C.DrawList_AddQuad(self.handle(), p1.toC(), p2.toC(), p3.toC(), p4.toC(), C.ImU32(col), C.float(thickness))
+}
+
+func (self ImDrawList) AddQuad(p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, col uint32) {
+	self.AddQuadV(p1, p2, p3, p4, col, 1.0)
+}
+
+func (self ImDrawList) AddQuadFilled(p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, col uint32) {
+	C.DrawList_AddQuadFilled(self.handle(), p1.toC(), p2.toC(), p3.toC(), p4.toC(), C.ImU32(col))
+}
+
+// AddTriangleV parameter default value hint:
+// thickness: 1.0f
+func (self ImDrawList) AddTriangleV(p1 ImVec2, p2 ImVec2, p3 ImVec2, col uint32, thickness float32) {
+	C.DrawList_AddTriangle(self.handle(), p1.toC(), p2.toC(), p3.toC(), C.ImU32(col), C.float(thickness))
+}
+
+func (self ImDrawList) AddTriangle(p1 ImVec2, p2 ImVec2, p3 ImVec2, col uint32) {
+	self.AddTriangleV(p1, p2, p3, col, 1.0)
+}
+
+func (self ImDrawList) AddTriangleFilled(p1 ImVec2, p2 ImVec2, p3 ImVec2, col uint32) {
+	C.DrawList_AddTriangleFilled(self.handle(), p1.toC(), p2.toC(), p3.toC(), C.ImU32(col))
+}
+
+// AddCircleV parameter default value hint:
+// num_segments: 0
+// thickness: 1.0f
+func (self ImDrawList) AddCircleV(center ImVec2, radius float32, col uint32, num_segments int32, thickness float32) {
+	C.DrawList_AddCircle(self.handle(), center.toC(), C.float(radius), C.ImU32(col), C.int(num_segments), C.float(thickness))
+}
+
+func (self ImDrawList) AddCircle(center ImVec2, radius float32, col uint32) {
+	self.AddCircleV(center, radius, col, 0, 1.0)
+}
+
+// AddCircleFilledV parameter default value hint:
+// num_segments: 0
+func (self ImDrawList) AddCircleFilledV(center ImVec2, radius float32, col uint32, num_segments int32) {
+	C.DrawList_AddCircleFilled(self.handle(), center.toC(), C.float(radius), C.ImU32(col), C.int(num_segments))
+}
+
+func (self ImDrawList) AddCircleFilled(center ImVec2, radius float32, col uint32) {
+	self.AddCircleFilledV(center, radius, col, 0)
+}
+
+// AddNgonV parameter default value hint:
+// thickness: 1.0f
+func (self ImDrawList) AddNgonV(center ImVec2, radius float32, col uint32, num_segments int32, thickness float32) {
+	C.DrawList_AddNgon(self.handle(), center.toC(), C.float(radius), C.ImU32(col), C.int(num_segments), C.float(thickness))
+}
+
+func (self ImDrawList) AddNgon(center ImVec2, radius float32, col uint32, num_segments int32) {
+	self.AddNgonV(center, radius, col, num_segments, 1.0)
+}
+
+func (self ImDrawList) AddNgonFilled(center ImVec2, radius float32, col uint32, num_segments int32) {
+	C.DrawList_AddNgonFilled(self.handle(), center.toC(), C.float(radius), C.ImU32(col), C.int(num_segments))
+}
+
+func (self ImDrawList) AddText_Vec2(pos ImVec2, col uint32, text_begin string) {
+	text_beginArg, text_beginFin := wrapString(text_begin)
+	defer text_beginFin()
+
+	C.DrawList_AddText_Vec2(self.handle(), pos.toC(), C.ImU32(col), text_beginArg)
+}
+
+// AddText_FontPtrV parameter default value hint:
+// wrap_width: 0.0f
+// cpu_fine_clip_rect: NULL
+func (self ImDrawList) AddText_FontPtrV(font ImFont, font_size float32, pos ImVec2, col uint32, text_begin string, wrap_width float32, cpu_fine_clip_rect *ImVec4) {
+	text_beginArg, text_beginFin := wrapString(text_begin)
+	defer text_beginFin()
+
+	cpu_fine_clip_rectArg, cpu_fine_clip_rectFin := cpu_fine_clip_rect.wrap()
+	defer cpu_fine_clip_rectFin()
+
+	C.DrawList_AddText_FontPtr(self.handle(), font.handle(), C.float(font_size), pos.toC(), C.ImU32(col), text_beginArg, C.float(wrap_width), cpu_fine_clip_rectArg)
+}
+
+func (self ImDrawList) AddText_FontPtr(font ImFont, font_size float32, pos ImVec2, col uint32, text_begin string) {
+	self.AddText_FontPtrV(font, font_size, pos, col, text_begin, 0.0, nil)
+}
+
+func (self ImDrawList) AddPolyline(points []ImVec2, col uint32, flags ImDrawFlags, thickness float32) {
+	C.DrawList_AddPolyline(self.handle(), wrapImVec2Slice(points), C.int(len(points)), C.ImU32(col), C.ImDrawFlags(flags), C.float(thickness))
+}
+
+func (self ImDrawList) AddConvexPolyFilled(points []ImVec2, col uint32) {
+	C.DrawList_AddConvexPolyFilled(self.handle(), wrapImVec2Slice(points), C.int(len(points)), C.ImU32(col))
+}
+
+// AddBezierCubicV parameter default value hint:
+// num_segments: 0
+func (self ImDrawList) AddBezierCubicV(p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, col uint32, thickness float32, num_segments int32) {
+	C.DrawList_AddBezierCubic(self.handle(), p1.toC(), p2.toC(), p3.toC(), p4.toC(), C.ImU32(col), C.float(thickness), C.int(num_segments))
+}
+
+func (self ImDrawList) AddBezierCubic(p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, col uint32, thickness float32) {
+	self.AddBezierCubicV(p1, p2, p3, p4, col, thickness, 0)
+}
+
+// AddBezierQuadraticV parameter default value hint:
+// num_segments: 0
+func (self ImDrawList) AddBezierQuadraticV(p1 ImVec2, p2 ImVec2, p3 ImVec2, col uint32, thickness float32, num_segments int32) {
+	C.DrawList_AddBezierQuadratic(self.handle(), p1.toC(), p2.toC(), p3.toC(), C.ImU32(col), C.float(thickness), C.int(num_segments))
+}
+
+func (self ImDrawList) AddBezierQuadratic(p1 ImVec2, p2 ImVec2, p3 ImVec2, col uint32, thickness float32) {
+	self.AddBezierQuadraticV(p1, p2, p3, col, thickness, 0)
+}
+
+// AddImageV parameter default value hint:
+// uv_min: ImVec2(0,0)
+// uv_max: ImVec2(1,1)
+// col: 4294967295
+func (self ImDrawList) AddImageV(user_texture_id ImTextureID, p_min ImVec2, p_max ImVec2, uv_min ImVec2, uv_max ImVec2, col uint32) {
+	C.DrawList_AddImage(self.handle(), C.uintptr_t(user_texture_id), p_min.toC(), p_max.toC(), uv_min.toC(), uv_max.toC(), C.ImU32(col))
+}
+
+func (self ImDrawList) AddImage(user_texture_id ImTextureID, p_min ImVec2, p_max ImVec2) {
+	self.AddImageV(user_texture_id, p_min, p_max, NewImVec2(0, 0), NewImVec2(1, 1), 4294967295)
+}
+
+// AddImageQuadV parameter default value hint:
+// uv1: ImVec2(0,0)
+// uv2: ImVec2(1,0)
+// uv3: ImVec2(1,1)
+// uv4: ImVec2(0,1)
+// col: 4294967295
+func (self ImDrawList) AddImageQuadV(user_texture_id ImTextureID, p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, uv1 ImVec2, uv2 ImVec2, uv3 ImVec2, uv4 ImVec2, col uint32) {
+	C.DrawList_AddImageQuad(self.handle(), C.uintptr_t(user_texture_id), p1.toC(), p2.toC(), p3.toC(), p4.toC(), uv1.toC(), uv2.toC(), uv3.toC(), uv4.toC(), C.ImU32(col))
+}
+
+func (self ImDrawList) AddImageQuad(user_texture_id ImTextureID, p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2) {
+	self.AddImageQuadV(user_texture_id, p1, p2, p3, p4, NewImVec2(0, 0), NewImVec2(1, 0), NewImVec2(1, 1), NewImVec2(0, 1), 4294967295)
+}
+
+// ImDrawListAddImageQuadOptions are the default arguments of ImDrawList.AddImageQuadV, see DefaultImDrawListAddImageQuadOptions.
+type ImDrawListAddImageQuadOptions struct {
+	Uv1 ImVec2
+	Uv2 ImVec2
+	Uv3 ImVec2
+	Uv4 ImVec2
+	Col uint32
+}
+
+// DefaultImDrawListAddImageQuadOptions returns the C++ default arguments of ImDrawList.AddImageQuadV.
+func DefaultImDrawListAddImageQuadOptions() ImDrawListAddImageQuadOptions {
+	return ImDrawListAddImageQuadOptions{
+		Uv1: NewImVec2(0, 0),
+		Uv2: NewImVec2(1, 0),
+		Uv3: NewImVec2(1, 1),
+		Uv4: NewImVec2(0, 1),
+		Col: 4294967295,
+	}
+}
+
+// AddImageQuadWithOptions is AddImageQuadV taking its default arguments in options.
+func (self ImDrawList) AddImageQuadWithOptions(user_texture_id ImTextureID, p1 ImVec2, p2 ImVec2, p3 ImVec2, p4 ImVec2, options ImDrawListAddImageQuadOptions) {
+	self.AddImageQuadV(user_texture_id, p1, p2, p3, p4, options.Uv1, options.Uv2, options.Uv3, options.Uv4, options.Col)
+}
+
+// AddImageRoundedV parameter default value hint:
+// flags: 0
+func (self ImDrawList) AddImageRoundedV(user_texture_id ImTextureID, p_min ImVec2, p_max ImVec2, uv_min ImVec2, uv_max ImVec2, col uint32, rounding float32, flags ImDrawFlags) {
+	C.DrawList_AddImageRounded(self.handle(), C.uintptr_t(user_texture_id), p_min.toC(), p_max.toC(), uv_min.toC(), uv_max.toC(), C.ImU32(col), C.float(rounding), C.ImDrawFlags(flags))
+}
+
+func (self ImDrawList) AddImageRounded(user_texture_id ImTextureID, p_min ImVec2, p_max ImVec2, uv_min ImVec2, uv_max ImVec2, col uint32, rounding float32) {
+	self.AddImageRoundedV(user_texture_id, p_min, p_max, uv_min, uv_max, col, rounding, 0)
+}
+
+func (self ImDrawList) PathClear() {
+	C.DrawList_PathClear(self.handle())
+}
+
+func (self ImDrawList) PathLineTo(pos ImVec2) {
+	C.DrawList_PathLineTo(self.handle(), pos.toC())
+}
+
+func (self ImDrawList) PathLineToMergeDuplicate(pos ImVec2) {
+	C.DrawList_PathLineToMergeDuplicate(self.handle(), pos.toC())
+}
+
+func (self ImDrawList) PathFillConvex(col uint32) {
+	C.DrawList_PathFillConvex(self.handle(), C.ImU32(col))
+}
+
+// PathStrokeV parameter default value hint:
+// flags: 0
+// thickness: 1.0f
+func (self ImDrawList) PathStrokeV(col uint32, flags ImDrawFlags, thickness float32) {
+	C.DrawList_PathStroke(self.handle(), C.ImU32(col), C.ImDrawFlags(flags), C.float(thickness))
+}
+
+func (self ImDrawList) PathStroke(col uint32) {
+	self.PathStrokeV(col, 0, 1.0)
+}
+
+// PathArcToV parameter default value hint:
+// num_segments: 0
+func (self ImDrawList) PathArcToV(center ImVec2, radius float32, a_min float32, a_max float32, num_segments int32) {
+	C.DrawList_PathArcTo(self.handle(), center.toC(), C.float(radius), C.float(a_min), C.float(a_max), C.int(num_segments))
+}
+
+func (self ImDrawList) PathArcTo(center ImVec2, radius float32, a_min float32, a_max float32) {
+	self.PathArcToV(center, radius, a_min, a_max, 0)
+}
+
+func (self ImDrawList) PathArcToFast(center ImVec2, radius float32, a_min_of_12 int32, a_max_of_12 int32) {
+	C.DrawList_PathArcToFast(self.handle(), center.toC(), C.float(radius), C.int(a_min_of_12), C.int(a_max_of_12))
+}
+
+// PathBezierCubicCurveToV parameter default value hint:
+// num_segments: 0
+func (self ImDrawList) PathBezierCubicCurveToV(p2 ImVec2, p3 ImVec2, p4 ImVec2, num_segments int32) {
+	C.DrawList_PathBezierCubicCurveTo(self.handle(), p2.toC(), p3.toC(), p4.toC(), C.int(num_segments))
+}
+
+func (self ImDrawList) PathBezierCubicCurveTo(p2 ImVec2, p3 ImVec2, p4 ImVec2) {
+	self.PathBezierCubicCurveToV(p2, p3, p4, 0)
+}
+
+// PathBezierQuadraticCurveToV parameter default value hint:
+// num_segments: 0
+func (self ImDrawList) PathBezierQuadraticCurveToV(p2 ImVec2, p3 ImVec2, num_segments int32) {
+	C.DrawList_PathBezierQuadraticCurveTo(self.handle(), p2.toC(), p3.toC(), C.int(num_segments))
+}
+
+func (self ImDrawList) PathBezierQuadraticCurveTo(p2 ImVec2, p3 ImVec2) {
+	self.PathBezierQuadraticCurveToV(p2, p3, 0)
+}
+
+// PathRectV parameter default value hint:
+// rounding: 0.0f
+// flags: 0
+func (self ImDrawList) PathRectV(rect_min ImVec2, rect_max ImVec2, rounding float32, flags ImDrawFlags) {
+	C.DrawList_PathRect(self.handle(), rect_min.toC(), rect_max.toC(), C.float(rounding), C.ImDrawFlags(flags))
+}
+
+func (self ImDrawList) PathRect(rect_min ImVec2, rect_max ImVec2) {
+	self.PathRectV(rect_min, rect_max, 0.0, 0)
+}
+
+func (self ImDrawList) AddCallback(callback ImDrawCallback) {
+	callbackArg := retainDrawCallback(callback)
+
+	C.DrawList_AddCallback(self.handle(), callbackArg)
+}
+
+func (self ImDrawList) AddDrawCmd() {
+	C.DrawList_AddDrawCmd(self.handle())
+}
+
+func (self ImDrawList) CloneOutput() ImDrawList {
+	return (ImDrawList)(unsafe.Pointer(C.DrawList_CloneOutput(self.handle())))
+}
+
+func (self ImDrawList) ChannelsSplit(count int32) {
+	C.DrawList_ChannelsSplit(self.handle(), C.int(count))
+}
+
+func (self ImDrawList) ChannelsMerge() {
+	C.DrawList_ChannelsMerge(self.handle())
+}
+
+func (self ImDrawList) ChannelsSetCurrent(n int32) {
+	C.DrawList_ChannelsSetCurrent(self.handle(), C.int(n))
+}
+
+func (self ImDrawList) PrimReserve(idx_count int32, vtx_count int32) {
+	C.DrawList_PrimReserve(self.handle(), C.int(idx_count), C.int(vtx_count))
+}
+
+func (self ImDrawList) PrimUnreserve(idx_count int32, vtx_count int32) {
+	C.DrawList_PrimUnreserve(self.handle(), C.int(idx_count), C.int(vtx_count))
+}
+
+func (self ImDrawList) PrimRect(a ImVec2, b ImVec2, col uint32) {
+	C.DrawList_PrimRect(self.handle(), a.toC(), b.toC(), C.ImU32(col))
+}
+
+func (self ImDrawList) PrimRectUV(a ImVec2, b ImVec2, uv_a ImVec2, uv_b ImVec2, col uint32) {
+	C.DrawList_PrimRectUV(self.handle(), a.toC(), b.toC(), uv_a.toC(), uv_b.toC(), C.ImU32(col))
+}
+
+func (self ImDrawList) PrimQuadUV(a ImVec2, b ImVec2, c ImVec2, d ImVec2, uv_a ImVec2, uv_b ImVec2, uv_c ImVec2, uv_d ImVec2, col uint32) {
+	C.DrawList_PrimQuadUV(self.handle(), a.toC(), b.toC(), c.toC(), d.toC(), uv_a.toC(), uv_b.toC(), uv_c.toC(), uv_d.toC(), C.ImU32(col))
+}
+
+func (self ImDrawList) PrimWriteVtx(pos ImVec2, uv ImVec2, col uint32) {
+	C.DrawList_PrimWriteVtx(self.handle(), pos.toC(), uv.toC(), C.ImU32(col))
+}
+
+func (self ImDrawList) PrimWriteIdx(idx ImDrawIdx) {
+	C.DrawList_PrimWriteIdx(self.handle(), C.ImDrawIdx(idx))
+}
+
+func (self ImDrawList) PrimVtx(pos ImVec2, uv ImVec2, col uint32) {
+	C.DrawList_PrimVtx(self.handle(), pos.toC(), uv.toC(), C.ImU32(col))
+}
+
+func NewDrawData() ImDrawData {
+	return (ImDrawData)(unsafe.Pointer(C.DrawData_ImDrawData()))
+}
+
+func (self ImDrawData) Clear() {
+	C.DrawData_Clear(self.handle())
+}
+
+func (self ImDrawData) DeIndexAllBuffers() {
+	C.DrawData_DeIndexAllBuffers(self.handle())
+}
+
+func (self ImDrawData) ScaleClipRects(fb_scale ImVec2) {
+	C.DrawData_ScaleClipRects(self.handle(), fb_scale.toC())
+}
+
+func NewFontConfig() ImFontConfig {
+	return (ImFontConfig)(unsafe.Pointer(C.FontConfig_ImFontConfig()))
+}
+
+func NewFontGlyphRangesBuilder() ImFontGlyphRangesBuilder {
+	return (ImFontGlyphRangesBuilder)(unsafe.Pointer(C.FontGlyphRangesBuilder_ImFontGlyphRangesBuilder()))
+}
+
+func (self ImFontGlyphRangesBuilder) Clear() {
+	C.FontGlyphRangesBuilder_Clear(self.handle())
+}
+
+func (self ImFontGlyphRangesBuilder) GetBit(n uint64) bool {
+	return C.FontGlyphRangesBuilder_GetBit(self.handle(), C.xlong(n)) == C.bool(true)
+}
+
+func (self ImFontGlyphRangesBuilder) SetBit(n uint64) {
+	C.FontGlyphRangesBuilder_SetBit(self.handle(), C.xlong(n))
+}
+
+func (self ImFontGlyphRangesBuilder) AddChar(c ImWchar) {
+	C.FontGlyphRangesBuilder_AddChar(self.handle(), C.ImWchar(c))
+}
+
+func (self ImFontGlyphRangesBuilder) AddText(text string) {
+	textArg, textFin := wrapString(text)
+	defer textFin()
+
+	C.FontGlyphRangesBuilder_AddText(self.handle(), textArg)
+}
+
+func (self ImFontGlyphRangesBuilder) AddRanges(ranges *ImWchar) {
+	C.FontGlyphRangesBuilder_AddRanges(self.handle(), (*C.ImWchar)(ranges))
+}
+
+func NewFontAtlasCustomRect() ImFontAtlasCustomRect {
+	return (ImFontAtlasCustomRect)(unsafe.Pointer(C.FontAtlasCustomRect_ImFontAtlasCustomRect()))
+}
+
+func (self ImFontAtlasCustomRect) IsPacked() bool {
+	return C.FontAtlasCustomRect_IsPacked(self.handle()) == C.bool(true)
+}
+
+func NewFontAtlas() ImFontAtlas {
+	return (ImFontAtlas)(unsafe.Pointer(C.FontAtlas_ImFontAtlas()))
+}
+
+func (self ImFontAtlas) Destroy() {
+	C.FontAtlas_Destroy(self.handle())
+}
+
+func (self ImFontAtlas) AddFont(font_cfg ImFontConfig) ImFont {
+	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFont(self.handle(), font_cfg.handle())))
+}
+
+// AddFontDefaultV parameter default value hint:
+// font_cfg: NULL
+func (self ImFontAtlas) AddFontDefaultV(font_cfg ImFontConfig) ImFont {
+	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFontDefault(self.handle(), font_cfg.handle())))
+}
+
+func (self ImFontAtlas) AddFontDefault() ImFont {
+	return self.AddFontDefaultV(0)
+}
+
+// AddFontFromFileTTFV parameter default value hint:
+// font_cfg: NULL
+// glyph_ranges: NULL
+func (self ImFontAtlas) AddFontFromFileTTFV(filename string, size_pixels float32, font_cfg ImFontConfig, glyph_ranges *ImWchar) ImFont {
+	filenameArg, filenameFin := wrapString(filename)
+	defer filenameFin()
+
+	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFontFromFileTTF(self.handle(), filenameArg, C.float(size_pixels), font_cfg.handle(), (*C.ImWchar)(glyph_ranges))))
+}
+
+func (self ImFontAtlas) AddFontFromFileTTF(filename string, size_pixels float32) ImFont {
+	return self.AddFontFromFileTTFV(filename, size_pixels, 0, nil)
+}
+
+// AddFontFromMemoryTTFV parameter default value hint:
+// font_cfg: NULL
+// glyph_ranges: NULL
+func (self ImFontAtlas) AddFontFromMemoryTTFV(font_data unsafe.Pointer, font_size int32, size_pixels float32, font_cfg ImFontConfig, glyph_ranges *ImWchar) ImFont {
+	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFontFromMemoryTTF(self.handle(), font_data, C.int(font_size), C.float(size_pixels), font_cfg.handle(), (*C.ImWchar)(glyph_ranges))))
+}
+
+func (self ImFontAtlas) AddFontFromMemoryTTF(font_data unsafe.Pointer, font_size int32, size_pixels float32) ImFont {
+	return self.AddFontFromMemoryTTFV(font_data, font_size, size_pixels, 0, nil)
+}
+
+// AddFontFromMemoryCompressedTTFV parameter default value hint:
+// font_cfg: NULL
+// glyph_ranges: NULL
+func (self ImFontAtlas) AddFontFromMemoryCompressedTTFV(compressed_font_data unsafe.Pointer, compressed_font_size int32, size_pixels float32, font_cfg ImFontConfig, glyph_ranges *ImWchar) ImFont {
+	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFontFromMemoryCompressedTTF(self.handle(), compressed_font_data, C.int(compressed_font_size), C.float(size_pixels), font_cfg.handle(), (*C.ImWchar)(glyph_ranges))))
+}
+
+func (self ImFontAtlas) AddFontFromMemoryCompressedTTF(compressed_font_data unsafe.Pointer, compressed_font_size int32, size_pixels float32) ImFont {
+	return self.AddFontFromMemoryCompressedTTFV(compressed_font_data, compressed_font_size, size_pixels, 0, nil)
+}
+
+// AddFontFromMemoryCompressedBase85TTFV parameter default value hint:
+// font_cfg: NULL
+// glyph_ranges: NULL
+func (self ImFontAtlas) AddFontFromMemoryCompressedBase85TTFV(compressed_font_data_base85 string, size_pixels float32, font_cfg ImFontConfig, glyph_ranges *ImWchar) ImFont {
+	compressed_font_data_base85Arg, compressed_font_data_base85Fin := wrapString(compressed_font_data_base85)
+	defer compressed_font_data_base85Fin()
+
+	return (ImFont)(unsafe.Pointer(C.FontAtlas_AddFontFromMemoryCompressedBase85TTF(self.handle(), compressed_font_data_base85Arg, C.float(size_pixels), font_cfg.handle(), (*C.ImWchar)(glyph_ranges))))
+}
+
+func (self ImFontAtlas) AddFontFromMemoryCompressedBase85TTF(compressed_font_data_base85 string, size_pixels float32) ImFont {
+	return self.AddFontFromMemoryCompressedBase85TTFV(compressed_font_data_base85, size_pixels, 0, nil)
+}
+
+func (self ImFontAtlas) ClearInputData() {
+	C.FontAtlas_ClearInputData(self.handle())
+}
+
+func (self ImFontAtlas) ClearTexData() {
+	C.FontAtlas_ClearTexData(self.handle())
+}
+
+func (self ImFontAtlas) ClearFonts() {
+	C.FontAtlas_ClearFonts(self.handle())
+}
+
+func (self ImFontAtlas) Clear() {
+	C.FontAtlas_Clear(self.handle())
+}
+
+func (self ImFontAtlas) Build() bool {
+	return C.FontAtlas_Build(self.handle()) == C.bool(true)
+}
+
+// GetTexDataAsAlpha8V parameter default value hint:
+// out_bytes_per_pixel: NULL
+func (self ImFontAtlas) GetTexDataAsAlpha8V(out_pixels *C.uchar, out_width *int32, out_height *int32, out_bytes_per_pixel *int32) {
+	out_widthArg, out_widthFin := wrapInt32(out_width)
+	defer out_widthFin()
+
+	out_heightArg, out_heightFin := wrapInt32(out_height)
+	defer out_heightFin()
+
+	out_bytes_per_pixelArg, out_bytes_per_pixelFin := wrapInt32(out_bytes_per_pixel)
+	defer out_bytes_per_pixelFin()
+
+	C.FontAtlas_GetTexDataAsAlpha8(self.handle(), &out_pixels, out_widthArg, out_heightArg, out_bytes_per_pixelArg)
+}
+
+func (self ImFontAtlas) GetTexDataAsAlpha8(out_pixels *C.uchar, out_width *int32, out_height *int32) {
+	self.GetTexDataAsAlpha8V(out_pixels, out_width, out_height, nil)
+}
+
+// GetTexDataAsRGBA32V parameter default value hint:
+// out_bytes_per_pixel: NULL
+func (self ImFontAtlas) GetTexDataAsRGBA32V(out_pixels *C.uchar, out_width *int32, out_height *int32, out_bytes_per_pixel *int32) {
+	out_widthArg, out_widthFin := wrapInt32(out_width)
+	defer out_widthFin()
+
+	out_heightArg, out_heightFin := wrapInt32(out_height)
+	defer out_heightFin()
+
+	out_bytes_per_pixelArg, out_bytes_per_pixelFin := wrapInt32(out_bytes_per_pixel)
+	defer out_bytes_per_pixelFin()
+
+	C.FontAtlas_GetTexDataAsRGBA32(self.handle(), &out_pixels, out_widthArg, out_heightArg, out_bytes_per_pixelArg)
+}
+
+func (self ImFontAtlas) GetTexDataAsRGBA32(out_pixels *C.uchar, out_width *int32, out_height *int32) {
+	self.GetTexDataAsRGBA32V(out_pixels, out_width, out_height, nil)
+}
+
+func (self ImFontAtlas) IsBuilt() bool {
+	return C.FontAtlas_IsBuilt(self.handle()) == C.bool(true)
+}
+
+func (self ImFontAtlas) SetTexID(id ImTextureID) {
+	C.FontAtlas_SetTexID(self.handle(), C.uintptr_t(id))
+}
+
+func (self ImFontAtlas) GetGlyphRangesDefault() *ImWchar {
+	return (*ImWchar)(C.FontAtlas_GetGlyphRangesDefault(self.handle()))
+}
+
+func (self ImFontAtlas) GetGlyphRangesKorean() *ImWchar {
+	return (*ImWchar)(C.FontAtlas_GetGlyphRangesKorean(self.handle()))
+}
+
+func (self ImFontAtlas) GetGlyphRangesJapanese() *ImWchar {
+	return (*ImWchar)(C.FontAtlas_GetGlyphRangesJapanese(self.handle()))
+}
+
+func (self ImFontAtlas) GetGlyphRangesChineseFull() *ImWchar {
+	return (*ImWchar)(C.FontAtlas_GetGlyphRangesChineseFull(self.handle()))
+}
+
+func (self ImFontAtlas) GetGlyphRangesChineseSimplifiedCommon() *ImWchar {
+	return (*ImWchar)(C.FontAtlas_GetGlyphRangesChineseSimplifiedCommon(self.handle()))
+}
+
+func (self ImFontAtlas) GetGlyphRangesCyrillic() *ImWchar {
+	return (*ImWchar)(C.FontAtlas_GetGlyphRangesCyrillic(self.handle()))
+}
+
+func (self ImFontAtlas) GetGlyphRangesThai() *ImWchar {
+	return (*ImWchar)(C.FontAtlas_GetGlyphRangesThai(self.handle()))
+}
+
+func (self ImFontAtlas) GetGlyphRangesVietnamese() *ImWchar {
+	return (*ImWchar)(C.FontAtlas_GetGlyphRangesVietnamese(self.handle()))
+}
+
+func (self ImFontAtlas) AddCustomRectRegular(width int32, height int32) int {
+	return int(C.FontAtlas_AddCustomRectRegular(self.handle(), C.int(width), C.int(height)))
+}
+
+// AddCustomRectFontGlyphV parameter default value hint:
+// offset: ImVec2(0,0)
+func (self ImFontAtlas) AddCustomRectFontGlyphV(font ImFont, id ImWchar, width int32, height int32, advance_x float32, offset ImVec2) int {
+	return int(C.FontAtlas_AddCustomRectFontGlyph(self.handle(), font.handle(), C.ImWchar(id), C.int(width), C.int(height), C.float(advance_x), offset.toC()))
+}
+
+func (self ImFontAtlas) AddCustomRectFontGlyph(font ImFont, id ImWchar, width int32, height int32, advance_x float32) int {
+	return self.AddCustomRectFontGlyphV(font, id, width, height, advance_x, NewImVec2(0, 0))
+}
+
+func (self ImFontAtlas) GetCustomRectByIndex(index int32) ImFontAtlasCustomRect {
+	return (ImFontAtlasCustomRect)(unsafe.Pointer(C.FontAtlas_GetCustomRectByIndex(self.handle(), C.int(index))))
+}
+
+func (self ImFontAtlas) CalcCustomRectUV(rect ImFontAtlasCustomRect, out_uv_min *ImVec2, out_uv_max *ImVec2) {
+	out_uv_minArg, out_uv_minFin := out_uv_min.wrap()
+	defer out_uv_minFin()
+
+	out_uv_maxArg, out_uv_maxFin := out_uv_max.wrap()
+	defer out_uv_maxFin()
+
+	C.FontAtlas_CalcCustomRectUV(self.handle(), rect.handle(), out_uv_minArg, out_uv_maxArg)
+}
+
+func NewFont() ImFont {
+	return (ImFont)(unsafe.Pointer(C.Font_ImFont()))
+}
+
+func (self ImFont) Destroy() {
+	C.Font_Destroy(self.handle())
+}
+
+func (self ImFont) FindGlyph(c ImWchar) ImFontGlyph {
+	return (ImFontGlyph)(unsafe.Pointer(C.Font_FindGlyph(self.handle(), C.ImWchar(c))))
+}
+
+func (self ImFont) FindGlyphNoFallback(c ImWchar) ImFontGlyph {
+	return (ImFontGlyph)(unsafe.Pointer(C.Font_FindGlyphNoFallback(self.handle(), C.ImWchar(c))))
+}
+
+func (self ImFont) GetCharAdvance(c ImWchar) float32 {
+	return float32(C.Font_GetCharAdvance(self.handle(), C.ImWchar(c)))
+}
+
+func (self ImFont) IsLoaded() bool {
+	return C.Font_IsLoaded(self.handle()) == C.bool(true)
+}
+
+func (self ImFont) GetDebugName() string {
+	return C.GoString(C.Font_GetDebugName(self.handle()))
+}
+
+func (self ImFont) CalcWordWrapPositionA(scale float32, text string, wrap_width float32) string {
+	textArg, textFin := wrapString(text)
+	defer textFin()
+
+	return C.GoString(C.Font_CalcWordWrapPositionA(self.handle(), C.float(scale), textArg, C.float(wrap_width)))
+}
+
+func (self ImFont) RenderChar(draw_list ImDrawList, size float32, pos ImVec2, col uint32, c ImWchar) {
+	C.Font_RenderChar(self.handle(), draw_list.handle(), C.float(size), pos.toC(), C.ImU32(col), C.ImWchar(c))
+}
+
+// RenderTextV parameter default value hint:
+// wrap_width: 0.0f
+// cpu_fine_clip: false
+func (self ImFont) RenderTextV(draw_list ImDrawList, size float32, pos ImVec2, col uint32, clip_rect ImVec4, text_begin string, wrap_width float32, cpu_fine_clip bool) {
+	text_beginArg, text_beginFin := wrapString(text_begin)
+	defer text_beginFin()
+
+	C.Font_RenderText(self.handle(), draw_list.handle(), C.float(size), pos.toC(), C.ImU32(col), clip_rect.toC(), text_beginArg, C.float(wrap_width), C.bool(cpu_fine_clip))
+}
+
+func (self ImFont) RenderText(draw_list ImDrawList, size float32, pos ImVec2, col uint32, clip_rect ImVec4, text_begin string) {
+	self.RenderTextV(draw_list, size, pos, col, clip_rect, text_begin, 0.0, false)
+}
+
+func (self ImFont) BuildLookupTable() {
+	C.Font_BuildLookupTable(self.handle())
+}
+
+func (self ImFont) ClearOutputData() {
+	C.Font_ClearOutputData(self.handle())
+}
+
+func (self ImFont) GrowIndex(new_size int32) {
+	C.Font_GrowIndex(self.handle(), C.int(new_size))
+}
+
+func (self ImFont) AddGlyph(src_cfg ImFontConfig, c ImWchar, x0 float32, y0 float32, x1 float32, y1 float32, u0 float32, v0 float32, u1 float32, v1 float32, advance_x float32) {
+	C.Font_AddGlyph(self.handle(), src_cfg.handle(), C.ImWchar(c), C.float(x0), C.float(y0), C.float(x1), C.float(y1), C.float(u0), C.float(v0), C.float(u1), C.float(v1), C.float(advance_x))
+}
+
+// AddRemapCharV parameter default value hint:
+// overwrite_dst: true
+func (self ImFont) AddRemapCharV(dst ImWchar, src ImWchar, overwrite_dst bool) {
+	C.Font_AddRemapChar(self.handle(), C.ImWchar(dst), C.ImWchar(src), C.bool(overwrite_dst))
+}
+
+func (self ImFont) AddRemapChar(dst ImWchar, src ImWchar) {
+	self.AddRemapCharV(dst, src, true)
+}
+
+func (self ImFont) SetGlyphVisible(c ImWchar, visible bool) {
+	C.Font_SetGlyphVisible(self.handle(), C.ImWchar(c), C.bool(visible))
+}
+
+func (self ImFont) IsGlyphRangeUnused(c_begin uint32, c_last uint32) bool {
+	return C.Font_IsGlyphRangeUnused(self.handle(), C.uint(c_begin), C.uint(c_last)) == C.bool(true)
+}
+
+func NewViewport() ImGuiViewport {
+	return (ImGuiViewport)(unsafe.Pointer(C.Viewport_ImGuiViewport()))
+}
+
+func (self ImGuiViewport) Destroy() {
+	C.Viewport_Destroy(self.handle())
+}
+
+func Viewport_GetCenter(pOut *ImVec2, self ImGuiViewport) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.Viewport_GetCenter(pOutArg, self.handle())
+}
+
+func Viewport_GetWorkCenter(pOut *ImVec2, self ImGuiViewport) {
+	pOutArg, pOutFin := pOut.wrap()
+	defer pOutFin()
+
+	C.Viewport_GetWorkCenter(pOutArg, self.handle())
+}
+
+func NewPlatformIO() ImGuiPlatformIO {
+	return (ImGuiPlatformIO)(unsafe.Pointer(C.PlatformIO_ImGuiPlatformIO()))
+}
+
+func NewPlatformMonitor() ImGuiPlatformMonitor {
+	return (ImGuiPlatformMonitor)(unsafe.Pointer(C.PlatformMonitor_ImGuiPlatformMonitor()))
+}
+
+func NewPlatformImeData() ImGuiPlatformImeData {
+	return (ImGuiPlatformImeData)(unsafe.Pointer(C.PlatformImeData_ImGuiPlatformImeData()))
+}
+
+func GetKeyIndex(key ImGuiKey) int {
+	return int(C.GetKeyIndex(C.ImGuiKey(key)))
+}
+
+func (self *ImColor) Destroy() {
+	selfArg, selfFin := self.wrap()
+	defer selfFin()
+
+	C.Color_Destroy(selfArg)
+}
+
+func (self ImDrawCmd) Destroy() {
+	C.DrawCmd_Destroy(self.handle())
+}
+
+func (self ImDrawData) Destroy() {
+	C.DrawData_Destroy(self.handle())
+}
+
+func (self ImDrawListSharedData) Destroy() {
+	C.DrawListSharedData_Destroy(self.handle())
+}
+
+func (self ImFontAtlasCustomRect) Destroy() {
+	C.FontAtlasCustomRect_Destroy(self.handle())
+}
+
+func (self ImFontConfig) Destroy() {
+	C.FontConfig_Destroy(self.handle())
+}
+
+func (self ImFontGlyphRangesBuilder) Destroy() {
+	C.FontGlyphRangesBuilder_Destroy(self.handle())
+}
+
+func (self ImGuiComboPreviewData) Destroy() {
+	C.ComboPreviewData_Destroy(self.handle())
+}
+
+func (self ImGuiContextHook) Destroy() {
+	C.ContextHook_Destroy(self.handle())
+}
+
+func (self ImGuiContext) Destroy() {
+	C.Context_Destroy(self.handle())
+}
+
+func (self ImGuiDockContext) Destroy() {
+	C.DockContext_Destroy(self.handle())
+}
+
+func (self ImGuiIO) Destroy() {
+	C.IO_Destroy(self.handle())
+}
+
+func (self ImGuiInputEvent) Destroy() {
+	C.InputEvent_Destroy(self.handle())
+}
+
+func (self ImGuiInputTextCallbackData) Destroy() {
+	C.InputTextCallbackData_Destroy(self.handle())
+}
+
+func (self ImGuiInputTextState) Destroy() {
+	C.InputTextState_Destroy(self.handle())
+}
+
+func (self ImGuiLastItemData) Destroy() {
+	C.LastItemData_Destroy(self.handle())
+}
+
+func (self ImGuiListClipperData) Destroy() {
+	C.ListClipperData_Destroy(self.handle())
+}
+
+func (self ImGuiMenuColumns) Destroy() {
+	C.MenuColumns_Destroy(self.handle())
+}
+
+func (self ImGuiMetricsConfig) Destroy() {
+	C.MetricsConfig_Destroy(self.handle())
+}
+
+func (self ImGuiNavItemData) Destroy() {
+	C.NavItemData_Destroy(self.handle())
+}
+
+func (self ImGuiNextItemData) Destroy() {
+	C.NextItemData_Destroy(self.handle())
+}
+
+func (self ImGuiNextWindowData) Destroy() {
+	C.NextWindowData_Destroy(self.handle())
+}
+
+func (self ImGuiOldColumnData) Destroy() {
+	C.OldColumnData_Destroy(self.handle())
+}
+
+func (self ImGuiOldColumns) Destroy() {
+	C.OldColumns_Destroy(self.handle())
+}
+
+func (self ImGuiOnceUponAFrame) Destroy() {
+	C.OnceUponAFrame_Destroy(self.handle())
+}
+
+func (self ImGuiPayload) Destroy() {
+	C.Payload_Destroy(self.handle())
+}
+
+func (self ImGuiPlatformIO) Destroy() {
+	C.PlatformIO_Destroy(self.handle())
+}
+
+func (self ImGuiPlatformImeData) Destroy() {
+	C.PlatformImeData_Destroy(self.handle())
+}
+
+func (self ImGuiPlatformMonitor) Destroy() {
+	C.PlatformMonitor_Destroy(self.handle())
+}
+
+func (self ImGuiPopupData) Destroy() {
+	C.PopupData_Destroy(self.handle())
+}
+
+func (self ImGuiPtrOrIndex) Destroy() {
+	C.PtrOrIndex_Destroy(self.handle())
+}
+
+func (self ImGuiSettingsHandler) Destroy() {
+	C.SettingsHandler_Destroy(self.handle())
+}
+
+func (self ImGuiStackLevelInfo) Destroy() {
+	C.StackLevelInfo_Destroy(self.handle())
+}
+
+func (self ImGuiStackSizes) Destroy() {
+	C.StackSizes_Destroy(self.handle())
+}
+
+func (self ImGuiStackTool) Destroy() {
+	C.StackTool_Destroy(self.handle())
+}
+
+func (self ImGuiStyleMod) Destroy() {
+	C.StyleMod_Destroy(self.handle())
+}
+
+func (self ImGuiStyle) Destroy() {
+	C.Style_Destroy(self.handle())
+}
+
+func (self ImGuiTabBar) Destroy() {
+	C.TabBar_Destroy(self.handle())
+}
+
+func (self ImGuiTabItem) Destroy() {
+	C.TabItem_Destroy(self.handle())
+}
+
+func (self ImGuiTableColumnSettings) Destroy() {
+	C.TableColumnSettings_Destroy(self.handle())
+}
+
+func (self ImGuiTableColumnSortSpecs) Destroy() {
+	C.TableColumnSortSpecs_Destroy(self.handle())
+}
+
+func (self ImGuiTableColumn) Destroy() {
+	C.TableColumn_Destroy(self.handle())
+}
+
+func (self ImGuiTableInstanceData) Destroy() {
+	C.TableInstanceData_Destroy(self.handle())
+}
+
+func (self ImGuiTableSettings) Destroy() {
+	C.TableSettings_Destroy(self.handle())
+}
+
+func (self ImGuiTableSortSpecs) Destroy() {
+	C.TableSortSpecs_Destroy(self.handle())
+}
+
+func (self ImGuiTableTempData) Destroy() {
+	C.TableTempData_Destroy(self.handle())
+}
+
+func (self ImGuiTextBuffer) Destroy() {
+	C.TextBuffer_Destroy(self.handle())
+}
+
+func (self ImGuiTextFilter) Destroy() {
+	C.TextFilter_Destroy(self.handle())
+}
+
+func (self ImGuiWindowClass) Destroy() {
+	C.WindowClass_Destroy(self.handle())
+}
+
+func (self ImGuiWindowSettings) Destroy() {
+	C.WindowSettings_Destroy(self.handle())
+}
+
+func (self *ImVec2) Destroy() {
+	selfArg, selfFin := self.wrap()
+	defer selfFin()
+
+	C.Vec2_Destroy(selfArg)
+}
+
+func (self *ImVec4) Destroy() {
+	selfArg, selfFin := self.wrap()
+	defer selfFin()
+
+	C.Vec4_Destroy(selfArg)
+}
+
+func (self ImGuiStyle) SetAlpha(v float32) {
+	C.ImGuiStyle_SetAlpha(self.handle(), C.float(v))
+}
+
+func (self ImGuiStyle) GetAlpha() float32 {
+	return float32(C.ImGuiStyle_GetAlpha(self.handle()))
+}
+
+func (self ImGuiStyle) SetDisabledAlpha(v float32) {
+	C.ImGuiStyle_SetDisabledAlpha(self.handle(), C.float(v))
+}
+
+func (self ImGuiStyle) GetDisabledAlpha() float32 {
+	return float32(C.ImGuiStyle_GetDisabledAlpha(self.handle()))
+}
+
+func (self ImGuiStyle) SetWindowPadding(v ImVec2) {
+	C.ImGuiStyle_SetWindowPadding(self.handle(), v.toC())
+}
+
+func (self ImGuiStyle) GetWindowPadding() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetWindowPadding(self.handle()))
 }
 
-func GetWindowSize(pOut *ImVec2) {
-	pOutArg, pOutFin := pOut.wrap()
-	defer pOutFin()
+func (self ImGuiStyle) SetWindowRounding(v float32) {
+	C.ImGuiStyle_SetWindowRounding(self.handle(), C.float(v))
+}
 
-	C.GetWindowSize(pOutArg)
+func (self ImGuiStyle) GetWindowRounding() float32 {
+	return float32(C.ImGuiStyle_GetWindowRounding(self.handle()))
 }
 
-func IsPopupOpen_Str(str_id string, flags ImGuiPopupFlags) bool {
-	str_idArg, str_idFin := wrapString(str_id)
-	defer str_idFin()
+func (self ImGuiStyle) SetWindowBorderSize(v float32) {
+	C.ImGuiStyle_SetWindowBorderSize(self.handle(), C.float(v))
+}
 
-	return C.IsPopupOpen_Str(str_idArg, C.ImGuiPopupFlags(flags)) == C.bool(true)
+func (self ImGuiStyle) GetWindowBorderSize() float32 {
+	return float32(C.ImGuiStyle_GetWindowBorderSize(self.handle()))
 }
 
-func Value_Bool(prefix string, b bool) {
-	prefixArg, prefixFin := wrapString(prefix)
-	defer prefixFin()
+func (self ImGuiStyle) SetWindowMinSize(v ImVec2) {
+	C.ImGuiStyle_SetWindowMinSize(self.handle(), v.toC())
+}
 
-	C.Value_Bool(prefixArg, C.bool(b))
+func (self ImGuiStyle) GetWindowMinSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetWindowMinSize(self.handle()))
 }
 
-func Value_Int(prefix string, v int32) {
-	prefixArg, prefixFin := wrapString(prefix)
-	defer prefixFin()
+func (self ImGuiStyle) SetWindowTitleAlign(v ImVec2) {
+	C.ImGuiStyle_SetWindowTitleAlign(self.handle(), v.toC())
+}
 
-	C.Value_Int(prefixArg, C.int(v))
+func (self ImGuiStyle) GetWindowTitleAlign() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetWindowTitleAlign(self.handle()))
 }
 
-func Value_Uint(prefix string, v uint32) {
-	prefixArg, prefixFin := wrapString(prefix)
-	defer prefixFin()
+func (self ImGuiStyle) SetWindowMenuButtonPosition(v ImGuiDir) {
+	C.ImGuiStyle_SetWindowMenuButtonPosition(self.handle(), C.ImGuiDir(v))
+}
 
-	C.Value_Uint(prefixArg, C.uint(v))
+func (self ImGuiStyle) GetWindowMenuButtonPosition() ImGuiDir {
+	return ImGuiDir(C.ImGuiStyle_GetWindowMenuButtonPosition(self.handle()))
 }
 
-func Value_Float(prefix string, v float32, float_format string) {
-	prefixArg, prefixFin := wrapString(prefix)
-	defer prefixFin()
+func (self ImGuiStyle) SetChildRounding(v float32) {
+	C.ImGuiStyle_SetChildRounding(self.handle(), C.float(v))
+}
 
-	float_formatArg, float_formatFin := wrapString(float_format)
-	defer float_formatFin()
+func (self ImGuiStyle) GetChildRounding() float32 {
+	return float32(C.ImGuiStyle_GetChildRounding(self.handle()))
+}
 
-	C.Value_Float(prefixArg, C.float(v), float_formatArg)
+func (self ImGuiStyle) SetChildBorderSize(v float32) {
+	C.ImGuiStyle_SetChildBorderSize(self.handle(), C.float(v))
 }
 
-func (self ImDrawListSharedData) Destroy() {
-	C.DrawListSharedData_Destroy(self.handle())
+func (self ImGuiStyle) GetChildBorderSize() float32 {
+	return float32(C.ImGuiStyle_GetChildBorderSize(self.handle()))
 }
 
-func (self ImDrawList) SetFlags(v ImDrawListFlags) {
-	C.ImDrawList_SetFlags(self.handle(), C.ImDrawListFlags(v))
+func (self ImGuiStyle) SetPopupRounding(v float32) {
+	C.ImGuiStyle_SetPopupRounding(self.handle(), C.float(v))
 }
 
-func (self ImDrawList) GetFlags() ImDrawListFlags {
-	return ImDrawListFlags(C.ImDrawList_GetFlags(self.handle()))
+func (self ImGuiStyle) GetPopupRounding() float32 {
+	return float32(C.ImGuiStyle_GetPopupRounding(self.handle()))
 }
 
-func (self ImDrawList) Set_VtxCurrentIdx(v uint32) {
-	C.ImDrawList_Set_VtxCurrentIdx(self.handle(), C.uint(v))
+func (self ImGuiStyle) SetPopupBorderSize(v float32) {
+	C.ImGuiStyle_SetPopupBorderSize(self.handle(), C.float(v))
 }
 
-func (self ImDrawList) Get_VtxCurrentIdx() uint32 {
-	return uint32(C.ImDrawList_Get_VtxCurrentIdx(self.handle()))
+func (self ImGuiStyle) GetPopupBorderSize() float32 {
+	return float32(C.ImGuiStyle_GetPopupBorderSize(self.handle()))
 }
 
-func (self ImDrawList) Set_Data(v ImDrawListSharedData) {
-	C.ImDrawList_Set_Data(self.handle(), v.handle())
+func (self ImGuiStyle) SetFramePadding(v ImVec2) {
+	C.ImGuiStyle_SetFramePadding(self.handle(), v.toC())
 }
 
-func (self ImDrawList) Get_Data() ImDrawListSharedData {
-	return (ImDrawListSharedData)(unsafe.Pointer(C.ImDrawList_Get_Data(self.handle())))
+func (self ImGuiStyle) GetFramePadding() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetFramePadding(self.handle()))
 }
 
-func (self ImDrawList) Set_OwnerName(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiStyle) SetFrameRounding(v float32) {
+	C.ImGuiStyle_SetFrameRounding(self.handle(), C.float(v))
+}
 
-	C.ImDrawList_Set_OwnerName(self.handle(), vArg)
+func (self ImGuiStyle) GetFrameRounding() float32 {
+	return float32(C.ImGuiStyle_GetFrameRounding(self.handle()))
 }
 
-func (self ImDrawList) Get_OwnerName() string {
-	return C.GoString(C.ImDrawList_Get_OwnerName(self.handle()))
+func (self ImGuiStyle) SetFrameBorderSize(v float32) {
+	C.ImGuiStyle_SetFrameBorderSize(self.handle(), C.float(v))
 }
 
-func (self ImDrawList) Set_VtxWritePtr(v ImDrawVert) {
-	C.ImDrawList_Set_VtxWritePtr(self.handle(), v.handle())
+func (self ImGuiStyle) GetFrameBorderSize() float32 {
+	return float32(C.ImGuiStyle_GetFrameBorderSize(self.handle()))
 }
 
-func (self ImDrawList) Get_VtxWritePtr() ImDrawVert {
-	return (ImDrawVert)(unsafe.Pointer(C.ImDrawList_Get_VtxWritePtr(self.handle())))
+func (self ImGuiStyle) SetItemSpacing(v ImVec2) {
+	C.ImGuiStyle_SetItemSpacing(self.handle(), v.toC())
 }
 
-func (self ImDrawList) Get_CmdHeader() ImDrawCmdHeader {
-	return newImDrawCmdHeaderFromC(C.ImDrawList_Get_CmdHeader(self.handle()))
+func (self ImGuiStyle) GetItemSpacing() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetItemSpacing(self.handle()))
 }
 
-func (self ImDrawList) Get_Splitter() ImDrawListSplitter {
-	return newImDrawListSplitterFromC(C.ImDrawList_Get_Splitter(self.handle()))
+func (self ImGuiStyle) SetItemInnerSpacing(v ImVec2) {
+	C.ImGuiStyle_SetItemInnerSpacing(self.handle(), v.toC())
 }
 
-func (self ImDrawList) Set_FringeScale(v float32) {
-	C.ImDrawList_Set_FringeScale(self.handle(), C.float(v))
+func (self ImGuiStyle) GetItemInnerSpacing() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetItemInnerSpacing(self.handle()))
 }
 
-func (self ImDrawList) Get_FringeScale() float32 {
-	return float32(C.ImDrawList_Get_FringeScale(self.handle()))
+func (self ImGuiStyle) SetCellPadding(v ImVec2) {
+	C.ImGuiStyle_SetCellPadding(self.handle(), v.toC())
 }
 
-func (self ImFontAtlasCustomRect) SetWidth(v uint) {
-	C.ImFontAtlasCustomRect_SetWidth(self.handle(), C.ushort(v))
+func (self ImGuiStyle) GetCellPadding() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetCellPadding(self.handle()))
 }
 
-func (self ImFontAtlasCustomRect) SetHeight(v uint) {
-	C.ImFontAtlasCustomRect_SetHeight(self.handle(), C.ushort(v))
+func (self ImGuiStyle) SetTouchExtraPadding(v ImVec2) {
+	C.ImGuiStyle_SetTouchExtraPadding(self.handle(), v.toC())
 }
 
-func (self ImFontAtlasCustomRect) SetX(v uint) {
-	C.ImFontAtlasCustomRect_SetX(self.handle(), C.ushort(v))
+func (self ImGuiStyle) GetTouchExtraPadding() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetTouchExtraPadding(self.handle()))
 }
 
-func (self ImFontAtlasCustomRect) SetY(v uint) {
-	C.ImFontAtlasCustomRect_SetY(self.handle(), C.ushort(v))
+func (self ImGuiStyle) SetIndentSpacing(v float32) {
+	C.ImGuiStyle_SetIndentSpacing(self.handle(), C.float(v))
 }
 
-func (self ImFontAtlasCustomRect) SetGlyphID(v uint32) {
-	C.ImFontAtlasCustomRect_SetGlyphID(self.handle(), C.uint(v))
+func (self ImGuiStyle) GetIndentSpacing() float32 {
+	return float32(C.ImGuiStyle_GetIndentSpacing(self.handle()))
 }
 
-func (self ImFontAtlasCustomRect) GetGlyphID() uint32 {
-	return uint32(C.ImFontAtlasCustomRect_GetGlyphID(self.handle()))
+func (self ImGuiStyle) SetColumnsMinSpacing(v float32) {
+	C.ImGuiStyle_SetColumnsMinSpacing(self.handle(), C.float(v))
 }
 
-func (self ImFontAtlasCustomRect) SetGlyphAdvanceX(v float32) {
-	C.ImFontAtlasCustomRect_SetGlyphAdvanceX(self.handle(), C.float(v))
+func (self ImGuiStyle) GetColumnsMinSpacing() float32 {
+	return float32(C.ImGuiStyle_GetColumnsMinSpacing(self.handle()))
 }
 
-func (self ImFontAtlasCustomRect) GetGlyphAdvanceX() float32 {
-	return float32(C.ImFontAtlasCustomRect_GetGlyphAdvanceX(self.handle()))
+func (self ImGuiStyle) SetScrollbarSize(v float32) {
+	C.ImGuiStyle_SetScrollbarSize(self.handle(), C.float(v))
 }
 
-func (self ImFontAtlasCustomRect) SetGlyphOffset(v ImVec2) {
-	C.ImFontAtlasCustomRect_SetGlyphOffset(self.handle(), v.toC())
+func (self ImGuiStyle) GetScrollbarSize() float32 {
+	return float32(C.ImGuiStyle_GetScrollbarSize(self.handle()))
 }
 
-func (self ImFontAtlasCustomRect) GetGlyphOffset() ImVec2 {
-	return newImVec2FromC(C.ImFontAtlasCustomRect_GetGlyphOffset(self.handle()))
+func (self ImGuiStyle) SetScrollbarRounding(v float32) {
+	C.ImGuiStyle_SetScrollbarRounding(self.handle(), C.float(v))
 }
 
-func (self ImFontAtlasCustomRect) SetFont(v ImFont) {
-	C.ImFontAtlasCustomRect_SetFont(self.handle(), v.handle())
+func (self ImGuiStyle) GetScrollbarRounding() float32 {
+	return float32(C.ImGuiStyle_GetScrollbarRounding(self.handle()))
 }
 
-func (self ImFontAtlasCustomRect) GetFont() ImFont {
-	return (ImFont)(unsafe.Pointer(C.ImFontAtlasCustomRect_GetFont(self.handle())))
+func (self ImGuiStyle) SetGrabMinSize(v float32) {
+	C.ImGuiStyle_SetGrabMinSize(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetFlags(v ImGuiTabBarFlags) {
-	C.ImGuiTabBar_SetFlags(self.handle(), C.ImGuiTabBarFlags(v))
+func (self ImGuiStyle) GetGrabMinSize() float32 {
+	return float32(C.ImGuiStyle_GetGrabMinSize(self.handle()))
 }
 
-func (self ImGuiTabBar) GetFlags() ImGuiTabBarFlags {
-	return ImGuiTabBarFlags(C.ImGuiTabBar_GetFlags(self.handle()))
+func (self ImGuiStyle) SetGrabRounding(v float32) {
+	C.ImGuiStyle_SetGrabRounding(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetID(v ImGuiID) {
-	C.ImGuiTabBar_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiStyle) GetGrabRounding() float32 {
+	return float32(C.ImGuiStyle_GetGrabRounding(self.handle()))
 }
 
-func (self ImGuiTabBar) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiTabBar_GetID(self.handle()))
+func (self ImGuiStyle) SetLogSliderDeadzone(v float32) {
+	C.ImGuiStyle_SetLogSliderDeadzone(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetSelectedTabId(v ImGuiID) {
-	C.ImGuiTabBar_SetSelectedTabId(self.handle(), C.ImGuiID(v))
+func (self ImGuiStyle) GetLogSliderDeadzone() float32 {
+	return float32(C.ImGuiStyle_GetLogSliderDeadzone(self.handle()))
 }
 
-func (self ImGuiTabBar) GetSelectedTabId() ImGuiID {
-	return ImGuiID(C.ImGuiTabBar_GetSelectedTabId(self.handle()))
+func (self ImGuiStyle) SetTabRounding(v float32) {
+	C.ImGuiStyle_SetTabRounding(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetNextSelectedTabId(v ImGuiID) {
-	C.ImGuiTabBar_SetNextSelectedTabId(self.handle(), C.ImGuiID(v))
+func (self ImGuiStyle) GetTabRounding() float32 {
+	return float32(C.ImGuiStyle_GetTabRounding(self.handle()))
 }
 
-func (self ImGuiTabBar) GetNextSelectedTabId() ImGuiID {
-	return ImGuiID(C.ImGuiTabBar_GetNextSelectedTabId(self.handle()))
+func (self ImGuiStyle) SetTabBorderSize(v float32) {
+	C.ImGuiStyle_SetTabBorderSize(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetVisibleTabId(v ImGuiID) {
-	C.ImGuiTabBar_SetVisibleTabId(self.handle(), C.ImGuiID(v))
+func (self ImGuiStyle) GetTabBorderSize() float32 {
+	return float32(C.ImGuiStyle_GetTabBorderSize(self.handle()))
 }
 
-func (self ImGuiTabBar) GetVisibleTabId() ImGuiID {
-	return ImGuiID(C.ImGuiTabBar_GetVisibleTabId(self.handle()))
+func (self ImGuiStyle) SetTabMinWidthForCloseButton(v float32) {
+	C.ImGuiStyle_SetTabMinWidthForCloseButton(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetCurrFrameVisible(v int32) {
-	C.ImGuiTabBar_SetCurrFrameVisible(self.handle(), C.int(v))
+func (self ImGuiStyle) GetTabMinWidthForCloseButton() float32 {
+	return float32(C.ImGuiStyle_GetTabMinWidthForCloseButton(self.handle()))
 }
 
-func (self ImGuiTabBar) GetCurrFrameVisible() int {
-	return int(C.ImGuiTabBar_GetCurrFrameVisible(self.handle()))
+func (self ImGuiStyle) SetColorButtonPosition(v ImGuiDir) {
+	C.ImGuiStyle_SetColorButtonPosition(self.handle(), C.ImGuiDir(v))
 }
 
-func (self ImGuiTabBar) SetPrevFrameVisible(v int32) {
-	C.ImGuiTabBar_SetPrevFrameVisible(self.handle(), C.int(v))
+func (self ImGuiStyle) GetColorButtonPosition() ImGuiDir {
+	return ImGuiDir(C.ImGuiStyle_GetColorButtonPosition(self.handle()))
 }
 
-func (self ImGuiTabBar) GetPrevFrameVisible() int {
-	return int(C.ImGuiTabBar_GetPrevFrameVisible(self.handle()))
+func (self ImGuiStyle) SetButtonTextAlign(v ImVec2) {
+	C.ImGuiStyle_SetButtonTextAlign(self.handle(), v.toC())
 }
 
-func (self ImGuiTabBar) SetBarRect(v ImRect) {
-	C.ImGuiTabBar_SetBarRect(self.handle(), v.toC())
+func (self ImGuiStyle) GetButtonTextAlign() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetButtonTextAlign(self.handle()))
 }
 
-func (self ImGuiTabBar) GetBarRect() ImRect {
-	return newImRectFromC(C.ImGuiTabBar_GetBarRect(self.handle()))
+func (self ImGuiStyle) SetSelectableTextAlign(v ImVec2) {
+	C.ImGuiStyle_SetSelectableTextAlign(self.handle(), v.toC())
 }
 
-func (self ImGuiTabBar) SetCurrTabsContentsHeight(v float32) {
-	C.ImGuiTabBar_SetCurrTabsContentsHeight(self.handle(), C.float(v))
+func (self ImGuiStyle) GetSelectableTextAlign() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetSelectableTextAlign(self.handle()))
 }
 
-func (self ImGuiTabBar) GetCurrTabsContentsHeight() float32 {
-	return float32(C.ImGuiTabBar_GetCurrTabsContentsHeight(self.handle()))
+func (self ImGuiStyle) SetDisplayWindowPadding(v ImVec2) {
+	C.ImGuiStyle_SetDisplayWindowPadding(self.handle(), v.toC())
 }
 
-func (self ImGuiTabBar) SetPrevTabsContentsHeight(v float32) {
-	C.ImGuiTabBar_SetPrevTabsContentsHeight(self.handle(), C.float(v))
+func (self ImGuiStyle) GetDisplayWindowPadding() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetDisplayWindowPadding(self.handle()))
 }
 
-func (self ImGuiTabBar) GetPrevTabsContentsHeight() float32 {
-	return float32(C.ImGuiTabBar_GetPrevTabsContentsHeight(self.handle()))
+func (self ImGuiStyle) SetDisplaySafeAreaPadding(v ImVec2) {
+	C.ImGuiStyle_SetDisplaySafeAreaPadding(self.handle(), v.toC())
 }
 
-func (self ImGuiTabBar) SetWidthAllTabs(v float32) {
-	C.ImGuiTabBar_SetWidthAllTabs(self.handle(), C.float(v))
+func (self ImGuiStyle) GetDisplaySafeAreaPadding() ImVec2 {
+	return newImVec2FromC(C.ImGuiStyle_GetDisplaySafeAreaPadding(self.handle()))
 }
 
-func (self ImGuiTabBar) GetWidthAllTabs() float32 {
-	return float32(C.ImGuiTabBar_GetWidthAllTabs(self.handle()))
+func (self ImGuiStyle) SetMouseCursorScale(v float32) {
+	C.ImGuiStyle_SetMouseCursorScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetWidthAllTabsIdeal(v float32) {
-	C.ImGuiTabBar_SetWidthAllTabsIdeal(self.handle(), C.float(v))
+func (self ImGuiStyle) GetMouseCursorScale() float32 {
+	return float32(C.ImGuiStyle_GetMouseCursorScale(self.handle()))
 }
 
-func (self ImGuiTabBar) GetWidthAllTabsIdeal() float32 {
-	return float32(C.ImGuiTabBar_GetWidthAllTabsIdeal(self.handle()))
+func (self ImGuiStyle) SetAntiAliasedLines(v bool) {
+	C.ImGuiStyle_SetAntiAliasedLines(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTabBar) SetScrollingAnim(v float32) {
-	C.ImGuiTabBar_SetScrollingAnim(self.handle(), C.float(v))
+func (self ImGuiStyle) GetAntiAliasedLines() bool {
+	return C.ImGuiStyle_GetAntiAliasedLines(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTabBar) GetScrollingAnim() float32 {
-	return float32(C.ImGuiTabBar_GetScrollingAnim(self.handle()))
+func (self ImGuiStyle) SetAntiAliasedLinesUseTex(v bool) {
+	C.ImGuiStyle_SetAntiAliasedLinesUseTex(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTabBar) SetScrollingTarget(v float32) {
-	C.ImGuiTabBar_SetScrollingTarget(self.handle(), C.float(v))
+func (self ImGuiStyle) GetAntiAliasedLinesUseTex() bool {
+	return C.ImGuiStyle_GetAntiAliasedLinesUseTex(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTabBar) GetScrollingTarget() float32 {
-	return float32(C.ImGuiTabBar_GetScrollingTarget(self.handle()))
+func (self ImGuiStyle) SetAntiAliasedFill(v bool) {
+	C.ImGuiStyle_SetAntiAliasedFill(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTabBar) SetScrollingTargetDistToVisibility(v float32) {
-	C.ImGuiTabBar_SetScrollingTargetDistToVisibility(self.handle(), C.float(v))
+func (self ImGuiStyle) GetAntiAliasedFill() bool {
+	return C.ImGuiStyle_GetAntiAliasedFill(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTabBar) GetScrollingTargetDistToVisibility() float32 {
-	return float32(C.ImGuiTabBar_GetScrollingTargetDistToVisibility(self.handle()))
+func (self ImGuiStyle) SetCurveTessellationTol(v float32) {
+	C.ImGuiStyle_SetCurveTessellationTol(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetScrollingSpeed(v float32) {
-	C.ImGuiTabBar_SetScrollingSpeed(self.handle(), C.float(v))
+func (self ImGuiStyle) GetCurveTessellationTol() float32 {
+	return float32(C.ImGuiStyle_GetCurveTessellationTol(self.handle()))
 }
 
-func (self ImGuiTabBar) GetScrollingSpeed() float32 {
-	return float32(C.ImGuiTabBar_GetScrollingSpeed(self.handle()))
+func (self ImGuiStyle) SetCircleTessellationMaxError(v float32) {
+	C.ImGuiStyle_SetCircleTessellationMaxError(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetScrollingRectMinX(v float32) {
-	C.ImGuiTabBar_SetScrollingRectMinX(self.handle(), C.float(v))
+func (self ImGuiStyle) GetCircleTessellationMaxError() float32 {
+	return float32(C.ImGuiStyle_GetCircleTessellationMaxError(self.handle()))
 }
 
-func (self ImGuiTabBar) GetScrollingRectMinX() float32 {
-	return float32(C.ImGuiTabBar_GetScrollingRectMinX(self.handle()))
+func (self ImGuiKeyData) SetDown(v bool) {
+	C.ImGuiKeyData_SetDown(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTabBar) SetScrollingRectMaxX(v float32) {
-	C.ImGuiTabBar_SetScrollingRectMaxX(self.handle(), C.float(v))
+func (self ImGuiKeyData) GetDown() bool {
+	return C.ImGuiKeyData_GetDown(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTabBar) GetScrollingRectMaxX() float32 {
-	return float32(C.ImGuiTabBar_GetScrollingRectMaxX(self.handle()))
+func (self ImGuiKeyData) SetDownDuration(v float32) {
+	C.ImGuiKeyData_SetDownDuration(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetReorderRequestTabId(v ImGuiID) {
-	C.ImGuiTabBar_SetReorderRequestTabId(self.handle(), C.ImGuiID(v))
+func (self ImGuiKeyData) GetDownDuration() float32 {
+	return float32(C.ImGuiKeyData_GetDownDuration(self.handle()))
 }
 
-func (self ImGuiTabBar) GetReorderRequestTabId() ImGuiID {
-	return ImGuiID(C.ImGuiTabBar_GetReorderRequestTabId(self.handle()))
+func (self ImGuiKeyData) SetDownDurationPrev(v float32) {
+	C.ImGuiKeyData_SetDownDurationPrev(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetReorderRequestOffset(v int) {
-	C.ImGuiTabBar_SetReorderRequestOffset(self.handle(), C.ImS16(v))
+func (self ImGuiKeyData) GetDownDurationPrev() float32 {
+	return float32(C.ImGuiKeyData_GetDownDurationPrev(self.handle()))
 }
 
-func (self ImGuiTabBar) GetReorderRequestOffset() int {
-	return int(C.ImGuiTabBar_GetReorderRequestOffset(self.handle()))
+func (self ImGuiKeyData) SetAnalogValue(v float32) {
+	C.ImGuiKeyData_SetAnalogValue(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetBeginCount(v int) {
-	C.ImGuiTabBar_SetBeginCount(self.handle(), C.ImS8(v))
+func (self ImGuiKeyData) GetAnalogValue() float32 {
+	return float32(C.ImGuiKeyData_GetAnalogValue(self.handle()))
 }
 
-func (self ImGuiTabBar) GetBeginCount() int {
-	return int(C.ImGuiTabBar_GetBeginCount(self.handle()))
+func (self ImGuiIO) SetConfigFlags(v ImGuiConfigFlags) {
+	C.ImGuiIO_SetConfigFlags(self.handle(), C.ImGuiConfigFlags(v))
 }
 
-func (self ImGuiTabBar) SetWantLayout(v bool) {
-	C.ImGuiTabBar_SetWantLayout(self.handle(), C.bool(v))
+func (self ImGuiIO) GetConfigFlags() ImGuiConfigFlags {
+	return ImGuiConfigFlags(C.ImGuiIO_GetConfigFlags(self.handle()))
 }
 
-func (self ImGuiTabBar) GetWantLayout() bool {
-	return C.ImGuiTabBar_GetWantLayout(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetBackendFlags(v ImGuiBackendFlags) {
+	C.ImGuiIO_SetBackendFlags(self.handle(), C.ImGuiBackendFlags(v))
 }
 
-func (self ImGuiTabBar) SetVisibleTabWasSubmitted(v bool) {
-	C.ImGuiTabBar_SetVisibleTabWasSubmitted(self.handle(), C.bool(v))
+func (self ImGuiIO) GetBackendFlags() ImGuiBackendFlags {
+	return ImGuiBackendFlags(C.ImGuiIO_GetBackendFlags(self.handle()))
 }
 
-func (self ImGuiTabBar) GetVisibleTabWasSubmitted() bool {
-	return C.ImGuiTabBar_GetVisibleTabWasSubmitted(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetDisplaySize(v ImVec2) {
+	C.ImGuiIO_SetDisplaySize(self.handle(), v.toC())
 }
 
-func (self ImGuiTabBar) SetTabsAddedNew(v bool) {
-	C.ImGuiTabBar_SetTabsAddedNew(self.handle(), C.bool(v))
+func (self ImGuiIO) GetDisplaySize() ImVec2 {
+	return newImVec2FromC(C.ImGuiIO_GetDisplaySize(self.handle()))
 }
 
-func (self ImGuiTabBar) GetTabsAddedNew() bool {
-	return C.ImGuiTabBar_GetTabsAddedNew(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetDeltaTime(v float32) {
+	C.ImGuiIO_SetDeltaTime(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetTabsActiveCount(v int) {
-	C.ImGuiTabBar_SetTabsActiveCount(self.handle(), C.ImS16(v))
+func (self ImGuiIO) GetDeltaTime() float32 {
+	return float32(C.ImGuiIO_GetDeltaTime(self.handle()))
 }
 
-func (self ImGuiTabBar) GetTabsActiveCount() int {
-	return int(C.ImGuiTabBar_GetTabsActiveCount(self.handle()))
+func (self ImGuiIO) SetIniSavingRate(v float32) {
+	C.ImGuiIO_SetIniSavingRate(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) SetLastTabItemIdx(v int) {
-	C.ImGuiTabBar_SetLastTabItemIdx(self.handle(), C.ImS16(v))
+func (self ImGuiIO) GetIniSavingRate() float32 {
+	return float32(C.ImGuiIO_GetIniSavingRate(self.handle()))
 }
 
-func (self ImGuiTabBar) GetLastTabItemIdx() int {
-	return int(C.ImGuiTabBar_GetLastTabItemIdx(self.handle()))
-}
+func (self ImGuiIO) SetIniFilename(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
 
-func (self ImGuiTabBar) SetItemSpacingY(v float32) {
-	C.ImGuiTabBar_SetItemSpacingY(self.handle(), C.float(v))
+	C.ImGuiIO_SetIniFilename(self.handle(), vArg)
 }
 
-func (self ImGuiTabBar) GetItemSpacingY() float32 {
-	return float32(C.ImGuiTabBar_GetItemSpacingY(self.handle()))
+func (self ImGuiIO) GetIniFilename() string {
+	return C.GoString(C.ImGuiIO_GetIniFilename(self.handle()))
 }
 
-func (self ImGuiTabBar) SetFramePadding(v ImVec2) {
-	C.ImGuiTabBar_SetFramePadding(self.handle(), v.toC())
+func (self ImGuiIO) SetLogFilename(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
+
+	C.ImGuiIO_SetLogFilename(self.handle(), vArg)
 }
 
-func (self ImGuiTabBar) GetFramePadding() ImVec2 {
-	return newImVec2FromC(C.ImGuiTabBar_GetFramePadding(self.handle()))
+func (self ImGuiIO) GetLogFilename() string {
+	return C.GoString(C.ImGuiIO_GetLogFilename(self.handle()))
 }
 
-func (self ImGuiTabBar) SetBackupCursorPos(v ImVec2) {
-	C.ImGuiTabBar_SetBackupCursorPos(self.handle(), v.toC())
+func (self ImGuiIO) SetMouseDoubleClickTime(v float32) {
+	C.ImGuiIO_SetMouseDoubleClickTime(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabBar) GetBackupCursorPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiTabBar_GetBackupCursorPos(self.handle()))
+func (self ImGuiIO) GetMouseDoubleClickTime() float32 {
+	return float32(C.ImGuiIO_GetMouseDoubleClickTime(self.handle()))
 }
 
-func (self ImGuiTabBar) GetTabsNames() ImGuiTextBuffer {
-	return newImGuiTextBufferFromC(C.ImGuiTabBar_GetTabsNames(self.handle()))
+func (self ImGuiIO) SetMouseDoubleClickMaxDist(v float32) {
+	C.ImGuiIO_SetMouseDoubleClickMaxDist(self.handle(), C.float(v))
 }
 
-func (self ImGuiNavItemData) SetWindow(v ImGuiWindow) {
-	C.ImGuiNavItemData_SetWindow(self.handle(), v.handle())
+func (self ImGuiIO) GetMouseDoubleClickMaxDist() float32 {
+	return float32(C.ImGuiIO_GetMouseDoubleClickMaxDist(self.handle()))
 }
 
-func (self ImGuiNavItemData) GetWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiNavItemData_GetWindow(self.handle())))
+func (self ImGuiIO) SetMouseDragThreshold(v float32) {
+	C.ImGuiIO_SetMouseDragThreshold(self.handle(), C.float(v))
 }
 
-func (self ImGuiNavItemData) SetID(v ImGuiID) {
-	C.ImGuiNavItemData_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiIO) GetMouseDragThreshold() float32 {
+	return float32(C.ImGuiIO_GetMouseDragThreshold(self.handle()))
 }
 
-func (self ImGuiNavItemData) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiNavItemData_GetID(self.handle()))
+func (self ImGuiIO) SetKeyRepeatDelay(v float32) {
+	C.ImGuiIO_SetKeyRepeatDelay(self.handle(), C.float(v))
 }
 
-func (self ImGuiNavItemData) SetFocusScopeId(v ImGuiID) {
-	C.ImGuiNavItemData_SetFocusScopeId(self.handle(), C.ImGuiID(v))
+func (self ImGuiIO) GetKeyRepeatDelay() float32 {
+	return float32(C.ImGuiIO_GetKeyRepeatDelay(self.handle()))
 }
 
-func (self ImGuiNavItemData) GetFocusScopeId() ImGuiID {
-	return ImGuiID(C.ImGuiNavItemData_GetFocusScopeId(self.handle()))
+func (self ImGuiIO) SetKeyRepeatRate(v float32) {
+	C.ImGuiIO_SetKeyRepeatRate(self.handle(), C.float(v))
 }
 
-func (self ImGuiNavItemData) SetRectRel(v ImRect) {
-	C.ImGuiNavItemData_SetRectRel(self.handle(), v.toC())
+func (self ImGuiIO) GetKeyRepeatRate() float32 {
+	return float32(C.ImGuiIO_GetKeyRepeatRate(self.handle()))
 }
 
-func (self ImGuiNavItemData) GetRectRel() ImRect {
-	return newImRectFromC(C.ImGuiNavItemData_GetRectRel(self.handle()))
+func (self ImGuiIO) SetUserData(v unsafe.Pointer) {
+	C.ImGuiIO_SetUserData(self.handle(), v)
 }
 
-func (self ImGuiNavItemData) SetInFlags(v ImGuiItemFlags) {
-	C.ImGuiNavItemData_SetInFlags(self.handle(), C.ImGuiItemFlags(v))
+func (self ImGuiIO) GetUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiIO_GetUserData(self.handle()))
 }
 
-func (self ImGuiNavItemData) GetInFlags() ImGuiItemFlags {
-	return ImGuiItemFlags(C.ImGuiNavItemData_GetInFlags(self.handle()))
+func (self ImGuiIO) SetFonts(v ImFontAtlas) {
+	C.ImGuiIO_SetFonts(self.handle(), v.handle())
 }
 
-func (self ImGuiNavItemData) SetDistBox(v float32) {
-	C.ImGuiNavItemData_SetDistBox(self.handle(), C.float(v))
+func (self ImGuiIO) GetFonts() ImFontAtlas {
+	return (ImFontAtlas)(unsafe.Pointer(C.ImGuiIO_GetFonts(self.handle())))
 }
 
-func (self ImGuiNavItemData) GetDistBox() float32 {
-	return float32(C.ImGuiNavItemData_GetDistBox(self.handle()))
+func (self ImGuiIO) SetFontGlobalScale(v float32) {
+	C.ImGuiIO_SetFontGlobalScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiNavItemData) SetDistCenter(v float32) {
-	C.ImGuiNavItemData_SetDistCenter(self.handle(), C.float(v))
+func (self ImGuiIO) GetFontGlobalScale() float32 {
+	return float32(C.ImGuiIO_GetFontGlobalScale(self.handle()))
 }
 
-func (self ImGuiNavItemData) GetDistCenter() float32 {
-	return float32(C.ImGuiNavItemData_GetDistCenter(self.handle()))
+func (self ImGuiIO) SetFontAllowUserScaling(v bool) {
+	C.ImGuiIO_SetFontAllowUserScaling(self.handle(), C.bool(v))
 }
 
-func (self ImGuiNavItemData) SetDistAxial(v float32) {
-	C.ImGuiNavItemData_SetDistAxial(self.handle(), C.float(v))
+func (self ImGuiIO) GetFontAllowUserScaling() bool {
+	return C.ImGuiIO_GetFontAllowUserScaling(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiNavItemData) GetDistAxial() float32 {
-	return float32(C.ImGuiNavItemData_GetDistAxial(self.handle()))
+func (self ImGuiIO) SetFontDefault(v ImFont) {
+	C.ImGuiIO_SetFontDefault(self.handle(), v.handle())
 }
 
-func (self ImGuiOldColumns) SetID(v ImGuiID) {
-	C.ImGuiOldColumns_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiIO) GetFontDefault() ImFont {
+	return (ImFont)(unsafe.Pointer(C.ImGuiIO_GetFontDefault(self.handle())))
 }
 
-func (self ImGuiOldColumns) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiOldColumns_GetID(self.handle()))
+func (self ImGuiIO) SetDisplayFramebufferScale(v ImVec2) {
+	C.ImGuiIO_SetDisplayFramebufferScale(self.handle(), v.toC())
 }
 
-func (self ImGuiOldColumns) SetFlags(v ImGuiOldColumnFlags) {
-	C.ImGuiOldColumns_SetFlags(self.handle(), C.ImGuiOldColumnFlags(v))
+func (self ImGuiIO) GetDisplayFramebufferScale() ImVec2 {
+	return newImVec2FromC(C.ImGuiIO_GetDisplayFramebufferScale(self.handle()))
 }
 
-func (self ImGuiOldColumns) GetFlags() ImGuiOldColumnFlags {
-	return ImGuiOldColumnFlags(C.ImGuiOldColumns_GetFlags(self.handle()))
+func (self ImGuiIO) SetConfigDockingNoSplit(v bool) {
+	C.ImGuiIO_SetConfigDockingNoSplit(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetIsFirstFrame(v bool) {
-	C.ImGuiOldColumns_SetIsFirstFrame(self.handle(), C.bool(v))
+func (self ImGuiIO) GetConfigDockingNoSplit() bool {
+	return C.ImGuiIO_GetConfigDockingNoSplit(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetIsFirstFrame() bool {
-	return C.ImGuiOldColumns_GetIsFirstFrame(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetConfigDockingWithShift(v bool) {
+	C.ImGuiIO_SetConfigDockingWithShift(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetIsBeingResized(v bool) {
-	C.ImGuiOldColumns_SetIsBeingResized(self.handle(), C.bool(v))
+func (self ImGuiIO) GetConfigDockingWithShift() bool {
+	return C.ImGuiIO_GetConfigDockingWithShift(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetIsBeingResized() bool {
-	return C.ImGuiOldColumns_GetIsBeingResized(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetConfigDockingAlwaysTabBar(v bool) {
+	C.ImGuiIO_SetConfigDockingAlwaysTabBar(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetCurrent(v int32) {
-	C.ImGuiOldColumns_SetCurrent(self.handle(), C.int(v))
+func (self ImGuiIO) GetConfigDockingAlwaysTabBar() bool {
+	return C.ImGuiIO_GetConfigDockingAlwaysTabBar(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetCurrent() int {
-	return int(C.ImGuiOldColumns_GetCurrent(self.handle()))
+func (self ImGuiIO) SetConfigDockingTransparentPayload(v bool) {
+	C.ImGuiIO_SetConfigDockingTransparentPayload(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetCount(v int32) {
-	C.ImGuiOldColumns_SetCount(self.handle(), C.int(v))
+func (self ImGuiIO) GetConfigDockingTransparentPayload() bool {
+	return C.ImGuiIO_GetConfigDockingTransparentPayload(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetCount() int {
-	return int(C.ImGuiOldColumns_GetCount(self.handle()))
+func (self ImGuiIO) SetConfigViewportsNoAutoMerge(v bool) {
+	C.ImGuiIO_SetConfigViewportsNoAutoMerge(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetOffMinX(v float32) {
-	C.ImGuiOldColumns_SetOffMinX(self.handle(), C.float(v))
+func (self ImGuiIO) GetConfigViewportsNoAutoMerge() bool {
+	return C.ImGuiIO_GetConfigViewportsNoAutoMerge(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetOffMinX() float32 {
-	return float32(C.ImGuiOldColumns_GetOffMinX(self.handle()))
+func (self ImGuiIO) SetConfigViewportsNoTaskBarIcon(v bool) {
+	C.ImGuiIO_SetConfigViewportsNoTaskBarIcon(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetOffMaxX(v float32) {
-	C.ImGuiOldColumns_SetOffMaxX(self.handle(), C.float(v))
+func (self ImGuiIO) GetConfigViewportsNoTaskBarIcon() bool {
+	return C.ImGuiIO_GetConfigViewportsNoTaskBarIcon(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetOffMaxX() float32 {
-	return float32(C.ImGuiOldColumns_GetOffMaxX(self.handle()))
+func (self ImGuiIO) SetConfigViewportsNoDecoration(v bool) {
+	C.ImGuiIO_SetConfigViewportsNoDecoration(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetLineMinY(v float32) {
-	C.ImGuiOldColumns_SetLineMinY(self.handle(), C.float(v))
+func (self ImGuiIO) GetConfigViewportsNoDecoration() bool {
+	return C.ImGuiIO_GetConfigViewportsNoDecoration(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetLineMinY() float32 {
-	return float32(C.ImGuiOldColumns_GetLineMinY(self.handle()))
+func (self ImGuiIO) SetConfigViewportsNoDefaultParent(v bool) {
+	C.ImGuiIO_SetConfigViewportsNoDefaultParent(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetLineMaxY(v float32) {
-	C.ImGuiOldColumns_SetLineMaxY(self.handle(), C.float(v))
+func (self ImGuiIO) GetConfigViewportsNoDefaultParent() bool {
+	return C.ImGuiIO_GetConfigViewportsNoDefaultParent(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetLineMaxY() float32 {
-	return float32(C.ImGuiOldColumns_GetLineMaxY(self.handle()))
+func (self ImGuiIO) SetMouseDrawCursor(v bool) {
+	C.ImGuiIO_SetMouseDrawCursor(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetHostCursorPosY(v float32) {
-	C.ImGuiOldColumns_SetHostCursorPosY(self.handle(), C.float(v))
+func (self ImGuiIO) GetMouseDrawCursor() bool {
+	return C.ImGuiIO_GetMouseDrawCursor(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetHostCursorPosY() float32 {
-	return float32(C.ImGuiOldColumns_GetHostCursorPosY(self.handle()))
+func (self ImGuiIO) SetConfigMacOSXBehaviors(v bool) {
+	C.ImGuiIO_SetConfigMacOSXBehaviors(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetHostCursorMaxPosX(v float32) {
-	C.ImGuiOldColumns_SetHostCursorMaxPosX(self.handle(), C.float(v))
+func (self ImGuiIO) GetConfigMacOSXBehaviors() bool {
+	return C.ImGuiIO_GetConfigMacOSXBehaviors(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetHostCursorMaxPosX() float32 {
-	return float32(C.ImGuiOldColumns_GetHostCursorMaxPosX(self.handle()))
+func (self ImGuiIO) SetConfigInputTrickleEventQueue(v bool) {
+	C.ImGuiIO_SetConfigInputTrickleEventQueue(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetHostInitialClipRect(v ImRect) {
-	C.ImGuiOldColumns_SetHostInitialClipRect(self.handle(), v.toC())
+func (self ImGuiIO) GetConfigInputTrickleEventQueue() bool {
+	return C.ImGuiIO_GetConfigInputTrickleEventQueue(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetHostInitialClipRect() ImRect {
-	return newImRectFromC(C.ImGuiOldColumns_GetHostInitialClipRect(self.handle()))
+func (self ImGuiIO) SetConfigInputTextCursorBlink(v bool) {
+	C.ImGuiIO_SetConfigInputTextCursorBlink(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetHostBackupClipRect(v ImRect) {
-	C.ImGuiOldColumns_SetHostBackupClipRect(self.handle(), v.toC())
+func (self ImGuiIO) GetConfigInputTextCursorBlink() bool {
+	return C.ImGuiIO_GetConfigInputTextCursorBlink(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetHostBackupClipRect() ImRect {
-	return newImRectFromC(C.ImGuiOldColumns_GetHostBackupClipRect(self.handle()))
+func (self ImGuiIO) SetConfigInputTextEnterKeepActive(v bool) {
+	C.ImGuiIO_SetConfigInputTextEnterKeepActive(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) SetHostBackupParentWorkRect(v ImRect) {
-	C.ImGuiOldColumns_SetHostBackupParentWorkRect(self.handle(), v.toC())
+func (self ImGuiIO) GetConfigInputTextEnterKeepActive() bool {
+	return C.ImGuiIO_GetConfigInputTextEnterKeepActive(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiOldColumns) GetHostBackupParentWorkRect() ImRect {
-	return newImRectFromC(C.ImGuiOldColumns_GetHostBackupParentWorkRect(self.handle()))
+func (self ImGuiIO) SetConfigDragClickToInputText(v bool) {
+	C.ImGuiIO_SetConfigDragClickToInputText(self.handle(), C.bool(v))
 }
 
-func (self ImGuiOldColumns) GetSplitter() ImDrawListSplitter {
-	return newImDrawListSplitterFromC(C.ImGuiOldColumns_GetSplitter(self.handle()))
+func (self ImGuiIO) GetConfigDragClickToInputText() bool {
+	return C.ImGuiIO_GetConfigDragClickToInputText(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableCellData) SetBgColor(v uint32) {
-	C.ImGuiTableCellData_SetBgColor(self.handle(), C.ImU32(v))
+func (self ImGuiIO) SetConfigWindowsResizeFromEdges(v bool) {
+	C.ImGuiIO_SetConfigWindowsResizeFromEdges(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableCellData) GetBgColor() uint32 {
-	return uint32(C.ImGuiTableCellData_GetBgColor(self.handle()))
+func (self ImGuiIO) GetConfigWindowsResizeFromEdges() bool {
+	return C.ImGuiIO_GetConfigWindowsResizeFromEdges(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableCellData) SetColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTableCellData_SetColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiIO) SetConfigWindowsMoveFromTitleBarOnly(v bool) {
+	C.ImGuiIO_SetConfigWindowsMoveFromTitleBarOnly(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableCellData) GetColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableCellData_GetColumn(self.handle()))
+func (self ImGuiIO) GetConfigWindowsMoveFromTitleBarOnly() bool {
+	return C.ImGuiIO_GetConfigWindowsMoveFromTitleBarOnly(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) SetFlags(v ImGuiTableColumnFlags) {
-	C.ImGuiTableColumn_SetFlags(self.handle(), C.ImGuiTableColumnFlags(v))
+func (self ImGuiIO) SetConfigMemoryCompactTimer(v float32) {
+	C.ImGuiIO_SetConfigMemoryCompactTimer(self.handle(), C.float(v))
 }
 
-func (self ImGuiTableColumn) GetFlags() ImGuiTableColumnFlags {
-	return ImGuiTableColumnFlags(C.ImGuiTableColumn_GetFlags(self.handle()))
+func (self ImGuiIO) GetConfigMemoryCompactTimer() float32 {
+	return float32(C.ImGuiIO_GetConfigMemoryCompactTimer(self.handle()))
 }
 
-func (self ImGuiTableColumn) SetWidthGiven(v float32) {
-	C.ImGuiTableColumn_SetWidthGiven(self.handle(), C.float(v))
-}
+func (self ImGuiIO) SetBackendPlatformName(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
 
-func (self ImGuiTableColumn) GetWidthGiven() float32 {
-	return float32(C.ImGuiTableColumn_GetWidthGiven(self.handle()))
+	C.ImGuiIO_SetBackendPlatformName(self.handle(), vArg)
 }
 
-func (self ImGuiTableColumn) SetMinX(v float32) {
-	C.ImGuiTableColumn_SetMinX(self.handle(), C.float(v))
+func (self ImGuiIO) GetBackendPlatformName() string {
+	return C.GoString(C.ImGuiIO_GetBackendPlatformName(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetMinX() float32 {
-	return float32(C.ImGuiTableColumn_GetMinX(self.handle()))
+func (self ImGuiIO) SetBackendRendererName(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
+
+	C.ImGuiIO_SetBackendRendererName(self.handle(), vArg)
 }
 
-func (self ImGuiTableColumn) SetMaxX(v float32) {
-	C.ImGuiTableColumn_SetMaxX(self.handle(), C.float(v))
+func (self ImGuiIO) GetBackendRendererName() string {
+	return C.GoString(C.ImGuiIO_GetBackendRendererName(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetMaxX() float32 {
-	return float32(C.ImGuiTableColumn_GetMaxX(self.handle()))
+func (self ImGuiIO) SetBackendPlatformUserData(v unsafe.Pointer) {
+	C.ImGuiIO_SetBackendPlatformUserData(self.handle(), v)
 }
 
-func (self ImGuiTableColumn) SetWidthRequest(v float32) {
-	C.ImGuiTableColumn_SetWidthRequest(self.handle(), C.float(v))
+func (self ImGuiIO) GetBackendPlatformUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiIO_GetBackendPlatformUserData(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetWidthRequest() float32 {
-	return float32(C.ImGuiTableColumn_GetWidthRequest(self.handle()))
+func (self ImGuiIO) SetBackendRendererUserData(v unsafe.Pointer) {
+	C.ImGuiIO_SetBackendRendererUserData(self.handle(), v)
 }
 
-func (self ImGuiTableColumn) SetWidthAuto(v float32) {
-	C.ImGuiTableColumn_SetWidthAuto(self.handle(), C.float(v))
+func (self ImGuiIO) GetBackendRendererUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiIO_GetBackendRendererUserData(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetWidthAuto() float32 {
-	return float32(C.ImGuiTableColumn_GetWidthAuto(self.handle()))
+func (self ImGuiIO) SetBackendLanguageUserData(v unsafe.Pointer) {
+	C.ImGuiIO_SetBackendLanguageUserData(self.handle(), v)
 }
 
-func (self ImGuiTableColumn) SetStretchWeight(v float32) {
-	C.ImGuiTableColumn_SetStretchWeight(self.handle(), C.float(v))
+func (self ImGuiIO) GetBackendLanguageUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiIO_GetBackendLanguageUserData(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetStretchWeight() float32 {
-	return float32(C.ImGuiTableColumn_GetStretchWeight(self.handle()))
+func (self ImGuiIO) SetClipboardUserData(v unsafe.Pointer) {
+	C.ImGuiIO_SetClipboardUserData(self.handle(), v)
 }
 
-func (self ImGuiTableColumn) SetInitStretchWeightOrWidth(v float32) {
-	C.ImGuiTableColumn_SetInitStretchWeightOrWidth(self.handle(), C.float(v))
+func (self ImGuiIO) GetClipboardUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiIO_GetClipboardUserData(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetInitStretchWeightOrWidth() float32 {
-	return float32(C.ImGuiTableColumn_GetInitStretchWeightOrWidth(self.handle()))
+func (self ImGuiIO) Set_UnusedPadding(v unsafe.Pointer) {
+	C.ImGuiIO_Set_UnusedPadding(self.handle(), v)
 }
 
-func (self ImGuiTableColumn) SetClipRect(v ImRect) {
-	C.ImGuiTableColumn_SetClipRect(self.handle(), v.toC())
+func (self ImGuiIO) Get_UnusedPadding() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiIO_Get_UnusedPadding(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetClipRect() ImRect {
-	return newImRectFromC(C.ImGuiTableColumn_GetClipRect(self.handle()))
+func (self ImGuiIO) SetWantCaptureMouse(v bool) {
+	C.ImGuiIO_SetWantCaptureMouse(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetUserID(v ImGuiID) {
-	C.ImGuiTableColumn_SetUserID(self.handle(), C.ImGuiID(v))
+func (self ImGuiIO) GetWantCaptureMouse() bool {
+	return C.ImGuiIO_GetWantCaptureMouse(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetUserID() ImGuiID {
-	return ImGuiID(C.ImGuiTableColumn_GetUserID(self.handle()))
+func (self ImGuiIO) SetWantCaptureKeyboard(v bool) {
+	C.ImGuiIO_SetWantCaptureKeyboard(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetWorkMinX(v float32) {
-	C.ImGuiTableColumn_SetWorkMinX(self.handle(), C.float(v))
+func (self ImGuiIO) GetWantCaptureKeyboard() bool {
+	return C.ImGuiIO_GetWantCaptureKeyboard(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetWorkMinX() float32 {
-	return float32(C.ImGuiTableColumn_GetWorkMinX(self.handle()))
+func (self ImGuiIO) SetWantTextInput(v bool) {
+	C.ImGuiIO_SetWantTextInput(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetWorkMaxX(v float32) {
-	C.ImGuiTableColumn_SetWorkMaxX(self.handle(), C.float(v))
+func (self ImGuiIO) GetWantTextInput() bool {
+	return C.ImGuiIO_GetWantTextInput(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetWorkMaxX() float32 {
-	return float32(C.ImGuiTableColumn_GetWorkMaxX(self.handle()))
+func (self ImGuiIO) SetWantSetMousePos(v bool) {
+	C.ImGuiIO_SetWantSetMousePos(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetItemWidth(v float32) {
-	C.ImGuiTableColumn_SetItemWidth(self.handle(), C.float(v))
+func (self ImGuiIO) GetWantSetMousePos() bool {
+	return C.ImGuiIO_GetWantSetMousePos(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetItemWidth() float32 {
-	return float32(C.ImGuiTableColumn_GetItemWidth(self.handle()))
+func (self ImGuiIO) SetWantSaveIniSettings(v bool) {
+	C.ImGuiIO_SetWantSaveIniSettings(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetContentMaxXFrozen(v float32) {
-	C.ImGuiTableColumn_SetContentMaxXFrozen(self.handle(), C.float(v))
+func (self ImGuiIO) GetWantSaveIniSettings() bool {
+	return C.ImGuiIO_GetWantSaveIniSettings(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetContentMaxXFrozen() float32 {
-	return float32(C.ImGuiTableColumn_GetContentMaxXFrozen(self.handle()))
+func (self ImGuiIO) SetNavActive(v bool) {
+	C.ImGuiIO_SetNavActive(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetContentMaxXUnfrozen(v float32) {
-	C.ImGuiTableColumn_SetContentMaxXUnfrozen(self.handle(), C.float(v))
+func (self ImGuiIO) GetNavActive() bool {
+	return C.ImGuiIO_GetNavActive(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetContentMaxXUnfrozen() float32 {
-	return float32(C.ImGuiTableColumn_GetContentMaxXUnfrozen(self.handle()))
+func (self ImGuiIO) SetNavVisible(v bool) {
+	C.ImGuiIO_SetNavVisible(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetContentMaxXHeadersUsed(v float32) {
-	C.ImGuiTableColumn_SetContentMaxXHeadersUsed(self.handle(), C.float(v))
+func (self ImGuiIO) GetNavVisible() bool {
+	return C.ImGuiIO_GetNavVisible(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetContentMaxXHeadersUsed() float32 {
-	return float32(C.ImGuiTableColumn_GetContentMaxXHeadersUsed(self.handle()))
+func (self ImGuiIO) SetFramerate(v float32) {
+	C.ImGuiIO_SetFramerate(self.handle(), C.float(v))
 }
 
-func (self ImGuiTableColumn) SetContentMaxXHeadersIdeal(v float32) {
-	C.ImGuiTableColumn_SetContentMaxXHeadersIdeal(self.handle(), C.float(v))
+func (self ImGuiIO) GetFramerate() float32 {
+	return float32(C.ImGuiIO_GetFramerate(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetContentMaxXHeadersIdeal() float32 {
-	return float32(C.ImGuiTableColumn_GetContentMaxXHeadersIdeal(self.handle()))
+func (self ImGuiIO) SetMetricsRenderVertices(v int32) {
+	C.ImGuiIO_SetMetricsRenderVertices(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableColumn) SetNameOffset(v int) {
-	C.ImGuiTableColumn_SetNameOffset(self.handle(), C.ImS16(v))
+func (self ImGuiIO) GetMetricsRenderVertices() int {
+	return int(C.ImGuiIO_GetMetricsRenderVertices(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetNameOffset() int {
-	return int(C.ImGuiTableColumn_GetNameOffset(self.handle()))
+func (self ImGuiIO) SetMetricsRenderIndices(v int32) {
+	C.ImGuiIO_SetMetricsRenderIndices(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableColumn) SetDisplayOrder(v ImGuiTableColumnIdx) {
-	C.ImGuiTableColumn_SetDisplayOrder(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiIO) GetMetricsRenderIndices() int {
+	return int(C.ImGuiIO_GetMetricsRenderIndices(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetDisplayOrder() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableColumn_GetDisplayOrder(self.handle()))
+func (self ImGuiIO) SetMetricsRenderWindows(v int32) {
+	C.ImGuiIO_SetMetricsRenderWindows(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableColumn) SetIndexWithinEnabledSet(v ImGuiTableColumnIdx) {
-	C.ImGuiTableColumn_SetIndexWithinEnabledSet(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiIO) GetMetricsRenderWindows() int {
+	return int(C.ImGuiIO_GetMetricsRenderWindows(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetIndexWithinEnabledSet() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableColumn_GetIndexWithinEnabledSet(self.handle()))
+func (self ImGuiIO) SetMetricsActiveWindows(v int32) {
+	C.ImGuiIO_SetMetricsActiveWindows(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableColumn) SetPrevEnabledColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTableColumn_SetPrevEnabledColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiIO) GetMetricsActiveWindows() int {
+	return int(C.ImGuiIO_GetMetricsActiveWindows(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetPrevEnabledColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableColumn_GetPrevEnabledColumn(self.handle()))
+func (self ImGuiIO) SetMetricsActiveAllocations(v int32) {
+	C.ImGuiIO_SetMetricsActiveAllocations(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableColumn) SetNextEnabledColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTableColumn_SetNextEnabledColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiIO) GetMetricsActiveAllocations() int {
+	return int(C.ImGuiIO_GetMetricsActiveAllocations(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetNextEnabledColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableColumn_GetNextEnabledColumn(self.handle()))
+func (self ImGuiIO) SetMouseDelta(v ImVec2) {
+	C.ImGuiIO_SetMouseDelta(self.handle(), v.toC())
 }
 
-func (self ImGuiTableColumn) SetSortOrder(v ImGuiTableColumnIdx) {
-	C.ImGuiTableColumn_SetSortOrder(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiIO) GetMouseDelta() ImVec2 {
+	return newImVec2FromC(C.ImGuiIO_GetMouseDelta(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetSortOrder() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableColumn_GetSortOrder(self.handle()))
+func (self ImGuiIO) SetMousePos(v ImVec2) {
+	C.ImGuiIO_SetMousePos(self.handle(), v.toC())
 }
 
-func (self ImGuiTableColumn) SetDrawChannelCurrent(v ImGuiTableDrawChannelIdx) {
-	C.ImGuiTableColumn_SetDrawChannelCurrent(self.handle(), C.ImGuiTableDrawChannelIdx(v))
+func (self ImGuiIO) GetMousePos() ImVec2 {
+	return newImVec2FromC(C.ImGuiIO_GetMousePos(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetDrawChannelCurrent() ImGuiTableDrawChannelIdx {
-	return ImGuiTableDrawChannelIdx(C.ImGuiTableColumn_GetDrawChannelCurrent(self.handle()))
+func (self ImGuiIO) SetMouseWheel(v float32) {
+	C.ImGuiIO_SetMouseWheel(self.handle(), C.float(v))
 }
 
-func (self ImGuiTableColumn) SetDrawChannelFrozen(v ImGuiTableDrawChannelIdx) {
-	C.ImGuiTableColumn_SetDrawChannelFrozen(self.handle(), C.ImGuiTableDrawChannelIdx(v))
+func (self ImGuiIO) GetMouseWheel() float32 {
+	return float32(C.ImGuiIO_GetMouseWheel(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetDrawChannelFrozen() ImGuiTableDrawChannelIdx {
-	return ImGuiTableDrawChannelIdx(C.ImGuiTableColumn_GetDrawChannelFrozen(self.handle()))
+func (self ImGuiIO) SetMouseWheelH(v float32) {
+	C.ImGuiIO_SetMouseWheelH(self.handle(), C.float(v))
 }
 
-func (self ImGuiTableColumn) SetDrawChannelUnfrozen(v ImGuiTableDrawChannelIdx) {
-	C.ImGuiTableColumn_SetDrawChannelUnfrozen(self.handle(), C.ImGuiTableDrawChannelIdx(v))
+func (self ImGuiIO) GetMouseWheelH() float32 {
+	return float32(C.ImGuiIO_GetMouseWheelH(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetDrawChannelUnfrozen() ImGuiTableDrawChannelIdx {
-	return ImGuiTableDrawChannelIdx(C.ImGuiTableColumn_GetDrawChannelUnfrozen(self.handle()))
+func (self ImGuiIO) SetMouseHoveredViewport(v ImGuiID) {
+	C.ImGuiIO_SetMouseHoveredViewport(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTableColumn) SetIsEnabled(v bool) {
-	C.ImGuiTableColumn_SetIsEnabled(self.handle(), C.bool(v))
+func (self ImGuiIO) GetMouseHoveredViewport() ImGuiID {
+	return ImGuiID(C.ImGuiIO_GetMouseHoveredViewport(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetIsEnabled() bool {
-	return C.ImGuiTableColumn_GetIsEnabled(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetKeyCtrl(v bool) {
+	C.ImGuiIO_SetKeyCtrl(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetIsUserEnabled(v bool) {
-	C.ImGuiTableColumn_SetIsUserEnabled(self.handle(), C.bool(v))
+func (self ImGuiIO) GetKeyCtrl() bool {
+	return C.ImGuiIO_GetKeyCtrl(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetIsUserEnabled() bool {
-	return C.ImGuiTableColumn_GetIsUserEnabled(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetKeyShift(v bool) {
+	C.ImGuiIO_SetKeyShift(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetIsUserEnabledNextFrame(v bool) {
-	C.ImGuiTableColumn_SetIsUserEnabledNextFrame(self.handle(), C.bool(v))
+func (self ImGuiIO) GetKeyShift() bool {
+	return C.ImGuiIO_GetKeyShift(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetIsUserEnabledNextFrame() bool {
-	return C.ImGuiTableColumn_GetIsUserEnabledNextFrame(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetKeyAlt(v bool) {
+	C.ImGuiIO_SetKeyAlt(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetIsVisibleX(v bool) {
-	C.ImGuiTableColumn_SetIsVisibleX(self.handle(), C.bool(v))
+func (self ImGuiIO) GetKeyAlt() bool {
+	return C.ImGuiIO_GetKeyAlt(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetIsVisibleX() bool {
-	return C.ImGuiTableColumn_GetIsVisibleX(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetKeySuper(v bool) {
+	C.ImGuiIO_SetKeySuper(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetIsVisibleY(v bool) {
-	C.ImGuiTableColumn_SetIsVisibleY(self.handle(), C.bool(v))
+func (self ImGuiIO) GetKeySuper() bool {
+	return C.ImGuiIO_GetKeySuper(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetIsVisibleY() bool {
-	return C.ImGuiTableColumn_GetIsVisibleY(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetKeyMods(v ImGuiModFlags) {
+	C.ImGuiIO_SetKeyMods(self.handle(), C.ImGuiModFlags(v))
 }
 
-func (self ImGuiTableColumn) SetIsRequestOutput(v bool) {
-	C.ImGuiTableColumn_SetIsRequestOutput(self.handle(), C.bool(v))
+func (self ImGuiIO) GetKeyMods() ImGuiModFlags {
+	return ImGuiModFlags(C.ImGuiIO_GetKeyMods(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetIsRequestOutput() bool {
-	return C.ImGuiTableColumn_GetIsRequestOutput(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetWantCaptureMouseUnlessPopupClose(v bool) {
+	C.ImGuiIO_SetWantCaptureMouseUnlessPopupClose(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetIsSkipItems(v bool) {
-	C.ImGuiTableColumn_SetIsSkipItems(self.handle(), C.bool(v))
+func (self ImGuiIO) GetWantCaptureMouseUnlessPopupClose() bool {
+	return C.ImGuiIO_GetWantCaptureMouseUnlessPopupClose(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetIsSkipItems() bool {
-	return C.ImGuiTableColumn_GetIsSkipItems(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetMousePosPrev(v ImVec2) {
+	C.ImGuiIO_SetMousePosPrev(self.handle(), v.toC())
 }
 
-func (self ImGuiTableColumn) SetIsPreserveWidthAuto(v bool) {
-	C.ImGuiTableColumn_SetIsPreserveWidthAuto(self.handle(), C.bool(v))
+func (self ImGuiIO) GetMousePosPrev() ImVec2 {
+	return newImVec2FromC(C.ImGuiIO_GetMousePosPrev(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetIsPreserveWidthAuto() bool {
-	return C.ImGuiTableColumn_GetIsPreserveWidthAuto(self.handle()) == C.bool(true)
+func (self ImGuiIO) SetPenPressure(v float32) {
+	C.ImGuiIO_SetPenPressure(self.handle(), C.float(v))
 }
 
-func (self ImGuiTableColumn) SetNavLayerCurrent(v int) {
-	C.ImGuiTableColumn_SetNavLayerCurrent(self.handle(), C.ImS8(v))
+func (self ImGuiIO) GetPenPressure() float32 {
+	return float32(C.ImGuiIO_GetPenPressure(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetNavLayerCurrent() int {
-	return int(C.ImGuiTableColumn_GetNavLayerCurrent(self.handle()))
+func (self ImGuiIO) SetAppFocusLost(v bool) {
+	C.ImGuiIO_SetAppFocusLost(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetAutoFitQueue(v uint) {
-	C.ImGuiTableColumn_SetAutoFitQueue(self.handle(), C.ImU8(v))
+func (self ImGuiIO) GetAppFocusLost() bool {
+	return C.ImGuiIO_GetAppFocusLost(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetAutoFitQueue() uint32 {
-	return uint32(C.ImGuiTableColumn_GetAutoFitQueue(self.handle()))
+func (self ImGuiIO) SetBackendUsingLegacyKeyArrays(v int) {
+	C.ImGuiIO_SetBackendUsingLegacyKeyArrays(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiTableColumn) SetCannotSkipItemsQueue(v uint) {
-	C.ImGuiTableColumn_SetCannotSkipItemsQueue(self.handle(), C.ImU8(v))
+func (self ImGuiIO) GetBackendUsingLegacyKeyArrays() int {
+	return int(C.ImGuiIO_GetBackendUsingLegacyKeyArrays(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetCannotSkipItemsQueue() uint32 {
-	return uint32(C.ImGuiTableColumn_GetCannotSkipItemsQueue(self.handle()))
+func (self ImGuiIO) SetBackendUsingLegacyNavInputArray(v bool) {
+	C.ImGuiIO_SetBackendUsingLegacyNavInputArray(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumn) SetSortDirection(v uint) {
-	C.ImGuiTableColumn_SetSortDirection(self.handle(), C.ImU8(v))
+func (self ImGuiIO) GetBackendUsingLegacyNavInputArray() bool {
+	return C.ImGuiIO_GetBackendUsingLegacyNavInputArray(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumn) GetSortDirection() uint32 {
-	return uint32(C.ImGuiTableColumn_GetSortDirection(self.handle()))
+func (self ImGuiInputTextCallbackData) SetEventFlag(v ImGuiInputTextFlags) {
+	C.ImGuiInputTextCallbackData_SetEventFlag(self.handle(), C.ImGuiInputTextFlags(v))
 }
 
-func (self ImGuiTableColumn) SetSortDirectionsAvailCount(v uint) {
-	C.ImGuiTableColumn_SetSortDirectionsAvailCount(self.handle(), C.ImU8(v))
+func (self ImGuiInputTextCallbackData) GetEventFlag() ImGuiInputTextFlags {
+	return ImGuiInputTextFlags(C.ImGuiInputTextCallbackData_GetEventFlag(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetSortDirectionsAvailCount() uint32 {
-	return uint32(C.ImGuiTableColumn_GetSortDirectionsAvailCount(self.handle()))
+func (self ImGuiInputTextCallbackData) SetFlags(v ImGuiInputTextFlags) {
+	C.ImGuiInputTextCallbackData_SetFlags(self.handle(), C.ImGuiInputTextFlags(v))
 }
 
-func (self ImGuiTableColumn) SetSortDirectionsAvailMask(v uint) {
-	C.ImGuiTableColumn_SetSortDirectionsAvailMask(self.handle(), C.ImU8(v))
+func (self ImGuiInputTextCallbackData) GetFlags() ImGuiInputTextFlags {
+	return ImGuiInputTextFlags(C.ImGuiInputTextCallbackData_GetFlags(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetSortDirectionsAvailMask() uint32 {
-	return uint32(C.ImGuiTableColumn_GetSortDirectionsAvailMask(self.handle()))
+func (self ImGuiInputTextCallbackData) SetUserData(v unsafe.Pointer) {
+	C.ImGuiInputTextCallbackData_SetUserData(self.handle(), v)
 }
 
-func (self ImGuiTableColumn) SetSortDirectionsAvailList(v uint) {
-	C.ImGuiTableColumn_SetSortDirectionsAvailList(self.handle(), C.ImU8(v))
+func (self ImGuiInputTextCallbackData) GetUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiInputTextCallbackData_GetUserData(self.handle()))
 }
 
-func (self ImGuiTableColumn) GetSortDirectionsAvailList() uint32 {
-	return uint32(C.ImGuiTableColumn_GetSortDirectionsAvailList(self.handle()))
+func (self ImGuiInputTextCallbackData) SetEventChar(v ImWchar) {
+	C.ImGuiInputTextCallbackData_SetEventChar(self.handle(), C.ImWchar(v))
 }
 
-func (self ImGuiTableTempData) SetTableIndex(v int32) {
-	C.ImGuiTableTempData_SetTableIndex(self.handle(), C.int(v))
+func (self ImGuiInputTextCallbackData) SetEventKey(v ImGuiKey) {
+	C.ImGuiInputTextCallbackData_SetEventKey(self.handle(), C.ImGuiKey(v))
 }
 
-func (self ImGuiTableTempData) GetTableIndex() int {
-	return int(C.ImGuiTableTempData_GetTableIndex(self.handle()))
+func (self ImGuiInputTextCallbackData) GetEventKey() ImGuiKey {
+	return ImGuiKey(C.ImGuiInputTextCallbackData_GetEventKey(self.handle()))
 }
 
-func (self ImGuiTableTempData) SetLastTimeActive(v float32) {
-	C.ImGuiTableTempData_SetLastTimeActive(self.handle(), C.float(v))
+func (self ImGuiInputTextCallbackData) SetBuf(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
+
+	C.ImGuiInputTextCallbackData_SetBuf(self.handle(), vArg)
 }
 
-func (self ImGuiTableTempData) GetLastTimeActive() float32 {
-	return float32(C.ImGuiTableTempData_GetLastTimeActive(self.handle()))
+func (self ImGuiInputTextCallbackData) SetBufTextLen(v int32) {
+	C.ImGuiInputTextCallbackData_SetBufTextLen(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableTempData) SetUserOuterSize(v ImVec2) {
-	C.ImGuiTableTempData_SetUserOuterSize(self.handle(), v.toC())
+func (self ImGuiInputTextCallbackData) GetBufTextLen() int {
+	return int(C.ImGuiInputTextCallbackData_GetBufTextLen(self.handle()))
 }
 
-func (self ImGuiTableTempData) GetUserOuterSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiTableTempData_GetUserOuterSize(self.handle()))
+func (self ImGuiInputTextCallbackData) SetBufSize(v int32) {
+	C.ImGuiInputTextCallbackData_SetBufSize(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableTempData) GetDrawSplitter() ImDrawListSplitter {
-	return newImDrawListSplitterFromC(C.ImGuiTableTempData_GetDrawSplitter(self.handle()))
+func (self ImGuiInputTextCallbackData) GetBufSize() int {
+	return int(C.ImGuiInputTextCallbackData_GetBufSize(self.handle()))
 }
 
-func (self ImGuiTableTempData) SetHostBackupWorkRect(v ImRect) {
-	C.ImGuiTableTempData_SetHostBackupWorkRect(self.handle(), v.toC())
+func (self ImGuiInputTextCallbackData) SetBufDirty(v bool) {
+	C.ImGuiInputTextCallbackData_SetBufDirty(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableTempData) GetHostBackupWorkRect() ImRect {
-	return newImRectFromC(C.ImGuiTableTempData_GetHostBackupWorkRect(self.handle()))
+func (self ImGuiInputTextCallbackData) GetBufDirty() bool {
+	return C.ImGuiInputTextCallbackData_GetBufDirty(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableTempData) SetHostBackupParentWorkRect(v ImRect) {
-	C.ImGuiTableTempData_SetHostBackupParentWorkRect(self.handle(), v.toC())
+func (self ImGuiInputTextCallbackData) SetCursorPos(v int32) {
+	C.ImGuiInputTextCallbackData_SetCursorPos(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableTempData) GetHostBackupParentWorkRect() ImRect {
-	return newImRectFromC(C.ImGuiTableTempData_GetHostBackupParentWorkRect(self.handle()))
+func (self ImGuiInputTextCallbackData) GetCursorPos() int {
+	return int(C.ImGuiInputTextCallbackData_GetCursorPos(self.handle()))
 }
 
-func (self ImGuiTableTempData) SetHostBackupPrevLineSize(v ImVec2) {
-	C.ImGuiTableTempData_SetHostBackupPrevLineSize(self.handle(), v.toC())
+func (self ImGuiInputTextCallbackData) SetSelectionStart(v int32) {
+	C.ImGuiInputTextCallbackData_SetSelectionStart(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableTempData) GetHostBackupPrevLineSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiTableTempData_GetHostBackupPrevLineSize(self.handle()))
+func (self ImGuiInputTextCallbackData) GetSelectionStart() int {
+	return int(C.ImGuiInputTextCallbackData_GetSelectionStart(self.handle()))
 }
 
-func (self ImGuiTableTempData) SetHostBackupCurrLineSize(v ImVec2) {
-	C.ImGuiTableTempData_SetHostBackupCurrLineSize(self.handle(), v.toC())
+func (self ImGuiInputTextCallbackData) SetSelectionEnd(v int32) {
+	C.ImGuiInputTextCallbackData_SetSelectionEnd(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableTempData) GetHostBackupCurrLineSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiTableTempData_GetHostBackupCurrLineSize(self.handle()))
+func (self ImGuiInputTextCallbackData) GetSelectionEnd() int {
+	return int(C.ImGuiInputTextCallbackData_GetSelectionEnd(self.handle()))
 }
 
-func (self ImGuiTableTempData) SetHostBackupCursorMaxPos(v ImVec2) {
-	C.ImGuiTableTempData_SetHostBackupCursorMaxPos(self.handle(), v.toC())
+func (self ImGuiSizeCallbackData) SetUserData(v unsafe.Pointer) {
+	C.ImGuiSizeCallbackData_SetUserData(self.handle(), v)
 }
 
-func (self ImGuiTableTempData) GetHostBackupCursorMaxPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiTableTempData_GetHostBackupCursorMaxPos(self.handle()))
+func (self ImGuiSizeCallbackData) GetUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiSizeCallbackData_GetUserData(self.handle()))
 }
 
-func (self ImGuiTableTempData) SetHostBackupItemWidth(v float32) {
-	C.ImGuiTableTempData_SetHostBackupItemWidth(self.handle(), C.float(v))
+func (self ImGuiSizeCallbackData) SetPos(v ImVec2) {
+	C.ImGuiSizeCallbackData_SetPos(self.handle(), v.toC())
 }
 
-func (self ImGuiTableTempData) GetHostBackupItemWidth() float32 {
-	return float32(C.ImGuiTableTempData_GetHostBackupItemWidth(self.handle()))
+func (self ImGuiSizeCallbackData) GetPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiSizeCallbackData_GetPos(self.handle()))
 }
 
-func (self ImGuiTableTempData) SetHostBackupItemWidthStackSize(v int32) {
-	C.ImGuiTableTempData_SetHostBackupItemWidthStackSize(self.handle(), C.int(v))
+func (self ImGuiSizeCallbackData) SetCurrentSize(v ImVec2) {
+	C.ImGuiSizeCallbackData_SetCurrentSize(self.handle(), v.toC())
 }
 
-func (self ImGuiTableTempData) GetHostBackupItemWidthStackSize() int {
-	return int(C.ImGuiTableTempData_GetHostBackupItemWidthStackSize(self.handle()))
+func (self ImGuiSizeCallbackData) GetCurrentSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiSizeCallbackData_GetCurrentSize(self.handle()))
 }
 
-func (self ImGuiInputEvent) SetType(v ImGuiInputEventType) {
-	C.ImGuiInputEvent_SetType(self.handle(), C.ImGuiInputEventType(v))
+func (self ImGuiSizeCallbackData) SetDesiredSize(v ImVec2) {
+	C.ImGuiSizeCallbackData_SetDesiredSize(self.handle(), v.toC())
 }
 
-func (self ImGuiInputEvent) GetType() ImGuiInputEventType {
-	return ImGuiInputEventType(C.ImGuiInputEvent_GetType(self.handle()))
+func (self ImGuiSizeCallbackData) GetDesiredSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiSizeCallbackData_GetDesiredSize(self.handle()))
 }
 
-func (self ImGuiInputEvent) SetSource(v ImGuiInputSource) {
-	C.ImGuiInputEvent_SetSource(self.handle(), C.ImGuiInputSource(v))
+func (self ImGuiWindowClass) SetClassId(v ImGuiID) {
+	C.ImGuiWindowClass_SetClassId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiInputEvent) GetSource() ImGuiInputSource {
-	return ImGuiInputSource(C.ImGuiInputEvent_GetSource(self.handle()))
+func (self ImGuiWindowClass) GetClassId() ImGuiID {
+	return ImGuiID(C.ImGuiWindowClass_GetClassId(self.handle()))
 }
 
-func (self ImGuiInputEvent) SetAddedByTestEngine(v bool) {
-	C.ImGuiInputEvent_SetAddedByTestEngine(self.handle(), C.bool(v))
+func (self ImGuiWindowClass) SetParentViewportId(v ImGuiID) {
+	C.ImGuiWindowClass_SetParentViewportId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiInputEvent) GetAddedByTestEngine() bool {
-	return C.ImGuiInputEvent_GetAddedByTestEngine(self.handle()) == C.bool(true)
+func (self ImGuiWindowClass) GetParentViewportId() ImGuiID {
+	return ImGuiID(C.ImGuiWindowClass_GetParentViewportId(self.handle()))
 }
 
-func (self ImDrawVert) Setpos(v ImVec2) {
-	C.ImDrawVert_Setpos(self.handle(), v.toC())
+func (self ImGuiWindowClass) SetViewportFlagsOverrideSet(v ImGuiViewportFlags) {
+	C.ImGuiWindowClass_SetViewportFlagsOverrideSet(self.handle(), C.ImGuiViewportFlags(v))
 }
 
-func (self ImDrawVert) Getpos() ImVec2 {
-	return newImVec2FromC(C.ImDrawVert_Getpos(self.handle()))
+func (self ImGuiWindowClass) GetViewportFlagsOverrideSet() ImGuiViewportFlags {
+	return ImGuiViewportFlags(C.ImGuiWindowClass_GetViewportFlagsOverrideSet(self.handle()))
 }
 
-func (self ImDrawVert) Setuv(v ImVec2) {
-	C.ImDrawVert_Setuv(self.handle(), v.toC())
+func (self ImGuiWindowClass) SetViewportFlagsOverrideClear(v ImGuiViewportFlags) {
+	C.ImGuiWindowClass_SetViewportFlagsOverrideClear(self.handle(), C.ImGuiViewportFlags(v))
 }
 
-func (self ImDrawVert) Getuv() ImVec2 {
-	return newImVec2FromC(C.ImDrawVert_Getuv(self.handle()))
+func (self ImGuiWindowClass) GetViewportFlagsOverrideClear() ImGuiViewportFlags {
+	return ImGuiViewportFlags(C.ImGuiWindowClass_GetViewportFlagsOverrideClear(self.handle()))
 }
 
-func (self ImDrawVert) Setcol(v uint32) {
-	C.ImDrawVert_Setcol(self.handle(), C.ImU32(v))
+func (self ImGuiWindowClass) SetTabItemFlagsOverrideSet(v ImGuiTabItemFlags) {
+	C.ImGuiWindowClass_SetTabItemFlagsOverrideSet(self.handle(), C.ImGuiTabItemFlags(v))
 }
 
-func (self ImDrawVert) Getcol() uint32 {
-	return uint32(C.ImDrawVert_Getcol(self.handle()))
+func (self ImGuiWindowClass) GetTabItemFlagsOverrideSet() ImGuiTabItemFlags {
+	return ImGuiTabItemFlags(C.ImGuiWindowClass_GetTabItemFlagsOverrideSet(self.handle()))
 }
 
-func (self ImGuiContext) SetInitialized(v bool) {
-	C.ImGuiContext_SetInitialized(self.handle(), C.bool(v))
+func (self ImGuiWindowClass) SetDockNodeFlagsOverrideSet(v ImGuiDockNodeFlags) {
+	C.ImGuiWindowClass_SetDockNodeFlagsOverrideSet(self.handle(), C.ImGuiDockNodeFlags(v))
 }
 
-func (self ImGuiContext) GetInitialized() bool {
-	return C.ImGuiContext_GetInitialized(self.handle()) == C.bool(true)
+func (self ImGuiWindowClass) GetDockNodeFlagsOverrideSet() ImGuiDockNodeFlags {
+	return ImGuiDockNodeFlags(C.ImGuiWindowClass_GetDockNodeFlagsOverrideSet(self.handle()))
 }
 
-func (self ImGuiContext) SetFontAtlasOwnedByContext(v bool) {
-	C.ImGuiContext_SetFontAtlasOwnedByContext(self.handle(), C.bool(v))
+func (self ImGuiWindowClass) SetDockingAlwaysTabBar(v bool) {
+	C.ImGuiWindowClass_SetDockingAlwaysTabBar(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetFontAtlasOwnedByContext() bool {
-	return C.ImGuiContext_GetFontAtlasOwnedByContext(self.handle()) == C.bool(true)
+func (self ImGuiWindowClass) GetDockingAlwaysTabBar() bool {
+	return C.ImGuiWindowClass_GetDockingAlwaysTabBar(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetIO() ImGuiIO {
-	return newImGuiIOFromC(C.ImGuiContext_GetIO(self.handle()))
+func (self ImGuiWindowClass) SetDockingAllowUnclassed(v bool) {
+	C.ImGuiWindowClass_SetDockingAllowUnclassed(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetPlatformIO() ImGuiPlatformIO {
-	return newImGuiPlatformIOFromC(C.ImGuiContext_GetPlatformIO(self.handle()))
+func (self ImGuiWindowClass) GetDockingAllowUnclassed() bool {
+	return C.ImGuiWindowClass_GetDockingAllowUnclassed(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetStyle() ImGuiStyle {
-	return newImGuiStyleFromC(C.ImGuiContext_GetStyle(self.handle()))
+func (self ImGuiPayload) SetData(v unsafe.Pointer) {
+	C.ImGuiPayload_SetData(self.handle(), v)
 }
 
-func (self ImGuiContext) SetConfigFlagsCurrFrame(v ImGuiConfigFlags) {
-	C.ImGuiContext_SetConfigFlagsCurrFrame(self.handle(), C.ImGuiConfigFlags(v))
+func (self ImGuiPayload) GetData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiPayload_GetData(self.handle()))
 }
 
-func (self ImGuiContext) GetConfigFlagsCurrFrame() ImGuiConfigFlags {
-	return ImGuiConfigFlags(C.ImGuiContext_GetConfigFlagsCurrFrame(self.handle()))
+func (self ImGuiPayload) SetDataSize(v int32) {
+	C.ImGuiPayload_SetDataSize(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetConfigFlagsLastFrame(v ImGuiConfigFlags) {
-	C.ImGuiContext_SetConfigFlagsLastFrame(self.handle(), C.ImGuiConfigFlags(v))
+func (self ImGuiPayload) GetDataSize() int {
+	return int(C.ImGuiPayload_GetDataSize(self.handle()))
 }
 
-func (self ImGuiContext) GetConfigFlagsLastFrame() ImGuiConfigFlags {
-	return ImGuiConfigFlags(C.ImGuiContext_GetConfigFlagsLastFrame(self.handle()))
+func (self ImGuiPayload) SetSourceId(v ImGuiID) {
+	C.ImGuiPayload_SetSourceId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiContext) SetFont(v ImFont) {
-	C.ImGuiContext_SetFont(self.handle(), v.handle())
+func (self ImGuiPayload) GetSourceId() ImGuiID {
+	return ImGuiID(C.ImGuiPayload_GetSourceId(self.handle()))
 }
 
-func (self ImGuiContext) GetFont() ImFont {
-	return (ImFont)(unsafe.Pointer(C.ImGuiContext_GetFont(self.handle())))
+func (self ImGuiPayload) SetSourceParentId(v ImGuiID) {
+	C.ImGuiPayload_SetSourceParentId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiContext) SetFontSize(v float32) {
-	C.ImGuiContext_SetFontSize(self.handle(), C.float(v))
+func (self ImGuiPayload) GetSourceParentId() ImGuiID {
+	return ImGuiID(C.ImGuiPayload_GetSourceParentId(self.handle()))
 }
 
-func (self ImGuiContext) GetFontSize() float32 {
-	return float32(C.ImGuiContext_GetFontSize(self.handle()))
+func (self ImGuiPayload) SetDataFrameCount(v int32) {
+	C.ImGuiPayload_SetDataFrameCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetFontBaseSize(v float32) {
-	C.ImGuiContext_SetFontBaseSize(self.handle(), C.float(v))
+func (self ImGuiPayload) GetDataFrameCount() int {
+	return int(C.ImGuiPayload_GetDataFrameCount(self.handle()))
 }
 
-func (self ImGuiContext) GetFontBaseSize() float32 {
-	return float32(C.ImGuiContext_GetFontBaseSize(self.handle()))
+func (self ImGuiPayload) SetPreview(v bool) {
+	C.ImGuiPayload_SetPreview(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetDrawListSharedData() ImDrawListSharedData {
-	return newImDrawListSharedDataFromC(C.ImGuiContext_GetDrawListSharedData(self.handle()))
+func (self ImGuiPayload) GetPreview() bool {
+	return C.ImGuiPayload_GetPreview(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) SetTime(v float64) {
-	C.ImGuiContext_SetTime(self.handle(), C.double(v))
+func (self ImGuiPayload) SetDelivery(v bool) {
+	C.ImGuiPayload_SetDelivery(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetTime() float64 {
-	return float64(C.ImGuiContext_GetTime(self.handle()))
+func (self ImGuiPayload) GetDelivery() bool {
+	return C.ImGuiPayload_GetDelivery(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) SetFrameCount(v int32) {
-	C.ImGuiContext_SetFrameCount(self.handle(), C.int(v))
+func (self ImGuiTableColumnSortSpecs) SetColumnUserID(v ImGuiID) {
+	C.ImGuiTableColumnSortSpecs_SetColumnUserID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiContext) GetFrameCount() int {
-	return int(C.ImGuiContext_GetFrameCount(self.handle()))
+func (self ImGuiTableColumnSortSpecs) GetColumnUserID() ImGuiID {
+	return ImGuiID(C.ImGuiTableColumnSortSpecs_GetColumnUserID(self.handle()))
 }
 
-func (self ImGuiContext) SetFrameCountEnded(v int32) {
-	C.ImGuiContext_SetFrameCountEnded(self.handle(), C.int(v))
+func (self ImGuiTableColumnSortSpecs) SetColumnIndex(v int) {
+	C.ImGuiTableColumnSortSpecs_SetColumnIndex(self.handle(), C.ImS16(v))
 }
 
-func (self ImGuiContext) GetFrameCountEnded() int {
-	return int(C.ImGuiContext_GetFrameCountEnded(self.handle()))
+func (self ImGuiTableColumnSortSpecs) GetColumnIndex() int {
+	return int(C.ImGuiTableColumnSortSpecs_GetColumnIndex(self.handle()))
 }
 
-func (self ImGuiContext) SetFrameCountPlatformEnded(v int32) {
-	C.ImGuiContext_SetFrameCountPlatformEnded(self.handle(), C.int(v))
+func (self ImGuiTableColumnSortSpecs) SetSortOrder(v int) {
+	C.ImGuiTableColumnSortSpecs_SetSortOrder(self.handle(), C.ImS16(v))
 }
 
-func (self ImGuiContext) GetFrameCountPlatformEnded() int {
-	return int(C.ImGuiContext_GetFrameCountPlatformEnded(self.handle()))
+func (self ImGuiTableColumnSortSpecs) GetSortOrder() int {
+	return int(C.ImGuiTableColumnSortSpecs_GetSortOrder(self.handle()))
 }
 
-func (self ImGuiContext) SetFrameCountRendered(v int32) {
-	C.ImGuiContext_SetFrameCountRendered(self.handle(), C.int(v))
+func (self ImGuiTableColumnSortSpecs) SetSortDirection(v ImGuiSortDirection) {
+	C.ImGuiTableColumnSortSpecs_SetSortDirection(self.handle(), C.ImGuiSortDirection(v))
 }
 
-func (self ImGuiContext) GetFrameCountRendered() int {
-	return int(C.ImGuiContext_GetFrameCountRendered(self.handle()))
+func (self ImGuiTableColumnSortSpecs) GetSortDirection() ImGuiSortDirection {
+	return ImGuiSortDirection(C.ImGuiTableColumnSortSpecs_GetSortDirection(self.handle()))
 }
 
-func (self ImGuiContext) SetWithinFrameScope(v bool) {
-	C.ImGuiContext_SetWithinFrameScope(self.handle(), C.bool(v))
+func (self ImGuiTableSortSpecs) SetSpecs(v ImGuiTableColumnSortSpecs) {
+	C.ImGuiTableSortSpecs_SetSpecs(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) GetWithinFrameScope() bool {
-	return C.ImGuiContext_GetWithinFrameScope(self.handle()) == C.bool(true)
+func (self ImGuiTableSortSpecs) GetSpecs() ImGuiTableColumnSortSpecs {
+	return (ImGuiTableColumnSortSpecs)(unsafe.Pointer(C.ImGuiTableSortSpecs_GetSpecs(self.handle())))
 }
 
-func (self ImGuiContext) SetWithinFrameScopeWithImplicitWindow(v bool) {
-	C.ImGuiContext_SetWithinFrameScopeWithImplicitWindow(self.handle(), C.bool(v))
+func (self ImGuiTableSortSpecs) SetSpecsCount(v int32) {
+	C.ImGuiTableSortSpecs_SetSpecsCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetWithinFrameScopeWithImplicitWindow() bool {
-	return C.ImGuiContext_GetWithinFrameScopeWithImplicitWindow(self.handle()) == C.bool(true)
+func (self ImGuiTableSortSpecs) GetSpecsCount() int {
+	return int(C.ImGuiTableSortSpecs_GetSpecsCount(self.handle()))
 }
 
-func (self ImGuiContext) SetWithinEndChild(v bool) {
-	C.ImGuiContext_SetWithinEndChild(self.handle(), C.bool(v))
+func (self ImGuiTableSortSpecs) SetSpecsDirty(v bool) {
+	C.ImGuiTableSortSpecs_SetSpecsDirty(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetWithinEndChild() bool {
-	return C.ImGuiContext_GetWithinEndChild(self.handle()) == C.bool(true)
+func (self ImGuiTableSortSpecs) GetSpecsDirty() bool {
+	return C.ImGuiTableSortSpecs_GetSpecsDirty(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) SetGcCompactAll(v bool) {
-	C.ImGuiContext_SetGcCompactAll(self.handle(), C.bool(v))
+func (self ImGuiOnceUponAFrame) SetRefFrame(v int32) {
+	C.ImGuiOnceUponAFrame_SetRefFrame(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetGcCompactAll() bool {
-	return C.ImGuiContext_GetGcCompactAll(self.handle()) == C.bool(true)
+func (self ImGuiOnceUponAFrame) GetRefFrame() int {
+	return int(C.ImGuiOnceUponAFrame_GetRefFrame(self.handle()))
 }
 
-func (self ImGuiContext) SetTestEngineHookItems(v bool) {
-	C.ImGuiContext_SetTestEngineHookItems(self.handle(), C.bool(v))
+func (self ImGuiTextFilter) SetCountGrep(v int32) {
+	C.ImGuiTextFilter_SetCountGrep(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetTestEngineHookItems() bool {
-	return C.ImGuiContext_GetTestEngineHookItems(self.handle()) == C.bool(true)
+func (self ImGuiTextFilter) GetCountGrep() int {
+	return int(C.ImGuiTextFilter_GetCountGrep(self.handle()))
 }
 
-func (self ImGuiContext) SetTestEngine(v unsafe.Pointer) {
-	C.ImGuiContext_SetTestEngine(self.handle(), v)
-}
+func (self ImGuiTextRange) Setb(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
 
-func (self ImGuiContext) GetTestEngine() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiContext_GetTestEngine(self.handle()))
+	C.ImGuiTextRange_Setb(self.handle(), vArg)
 }
 
-func (self ImGuiContext) GetWindowsById() ImGuiStorage {
-	return newImGuiStorageFromC(C.ImGuiContext_GetWindowsById(self.handle()))
+func (self ImGuiTextRange) Getb() string {
+	return C.GoString(C.ImGuiTextRange_Getb(self.handle()))
 }
 
-func (self ImGuiContext) SetWindowsActiveCount(v int32) {
-	C.ImGuiContext_SetWindowsActiveCount(self.handle(), C.int(v))
+func (self ImGuiTextRange) Sete(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
+
+	C.ImGuiTextRange_Sete(self.handle(), vArg)
 }
 
-func (self ImGuiContext) GetWindowsActiveCount() int {
-	return int(C.ImGuiContext_GetWindowsActiveCount(self.handle()))
+func (self ImGuiTextRange) Gete() string {
+	return C.GoString(C.ImGuiTextRange_Gete(self.handle()))
 }
 
-func (self ImGuiContext) SetWindowsHoverPadding(v ImVec2) {
-	C.ImGuiContext_SetWindowsHoverPadding(self.handle(), v.toC())
+func (self ImGuiStoragePair) Setkey(v ImGuiID) {
+	C.ImGuiStoragePair_Setkey(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiContext) GetWindowsHoverPadding() ImVec2 {
-	return newImVec2FromC(C.ImGuiContext_GetWindowsHoverPadding(self.handle()))
+func (self ImGuiStoragePair) Getkey() ImGuiID {
+	return ImGuiID(C.ImGuiStoragePair_Getkey(self.handle()))
 }
 
-func (self ImGuiContext) SetCurrentWindow(v ImGuiWindow) {
-	C.ImGuiContext_SetCurrentWindow(self.handle(), v.handle())
+func (self ImGuiListClipper) SetDisplayStart(v int32) {
+	C.ImGuiListClipper_SetDisplayStart(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetCurrentWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetCurrentWindow(self.handle())))
+func (self ImGuiListClipper) GetDisplayStart() int {
+	return int(C.ImGuiListClipper_GetDisplayStart(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredWindow(v ImGuiWindow) {
-	C.ImGuiContext_SetHoveredWindow(self.handle(), v.handle())
+func (self ImGuiListClipper) SetDisplayEnd(v int32) {
+	C.ImGuiListClipper_SetDisplayEnd(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetHoveredWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetHoveredWindow(self.handle())))
+func (self ImGuiListClipper) GetDisplayEnd() int {
+	return int(C.ImGuiListClipper_GetDisplayEnd(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredWindowUnderMovingWindow(v ImGuiWindow) {
-	C.ImGuiContext_SetHoveredWindowUnderMovingWindow(self.handle(), v.handle())
+func (self ImGuiListClipper) SetItemsCount(v int32) {
+	C.ImGuiListClipper_SetItemsCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetHoveredWindowUnderMovingWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetHoveredWindowUnderMovingWindow(self.handle())))
+func (self ImGuiListClipper) GetItemsCount() int {
+	return int(C.ImGuiListClipper_GetItemsCount(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredDockNode(v ImGuiDockNode) {
-	C.ImGuiContext_SetHoveredDockNode(self.handle(), v.handle())
+func (self ImGuiListClipper) SetItemsHeight(v float32) {
+	C.ImGuiListClipper_SetItemsHeight(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetHoveredDockNode() ImGuiDockNode {
-	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiContext_GetHoveredDockNode(self.handle())))
+func (self ImGuiListClipper) GetItemsHeight() float32 {
+	return float32(C.ImGuiListClipper_GetItemsHeight(self.handle()))
 }
 
-func (self ImGuiContext) SetMovingWindow(v ImGuiWindow) {
-	C.ImGuiContext_SetMovingWindow(self.handle(), v.handle())
+func (self ImGuiListClipper) SetStartPosY(v float32) {
+	C.ImGuiListClipper_SetStartPosY(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetMovingWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetMovingWindow(self.handle())))
+func (self ImGuiListClipper) GetStartPosY() float32 {
+	return float32(C.ImGuiListClipper_GetStartPosY(self.handle()))
 }
 
-func (self ImGuiContext) SetWheelingWindow(v ImGuiWindow) {
-	C.ImGuiContext_SetWheelingWindow(self.handle(), v.handle())
+func (self ImGuiListClipper) SetTempData(v unsafe.Pointer) {
+	C.ImGuiListClipper_SetTempData(self.handle(), v)
 }
 
-func (self ImGuiContext) GetWheelingWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetWheelingWindow(self.handle())))
+func (self ImGuiListClipper) GetTempData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiListClipper_GetTempData(self.handle()))
 }
 
-func (self ImGuiContext) SetWheelingWindowRefMousePos(v ImVec2) {
-	C.ImGuiContext_SetWheelingWindowRefMousePos(self.handle(), v.toC())
+func (self ImDrawCmd) SetClipRect(v ImVec4) {
+	C.ImDrawCmd_SetClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetWheelingWindowRefMousePos() ImVec2 {
-	return newImVec2FromC(C.ImGuiContext_GetWheelingWindowRefMousePos(self.handle()))
+func (self ImDrawCmd) GetClipRect() ImVec4 {
+	return newImVec4FromC(C.ImDrawCmd_GetClipRect(self.handle()))
 }
 
-func (self ImGuiContext) SetWheelingWindowTimer(v float32) {
-	C.ImGuiContext_SetWheelingWindowTimer(self.handle(), C.float(v))
+func (self ImDrawCmd) SetTextureId(v ImTextureID) {
+	C.ImDrawCmd_SetTextureId(self.handle(), C.uintptr_t(v))
 }
 
-func (self ImGuiContext) GetWheelingWindowTimer() float32 {
-	return float32(C.ImGuiContext_GetWheelingWindowTimer(self.handle()))
+func (self ImDrawCmd) GetTextureId() ImTextureID {
+	return ImTextureID(C.ImDrawCmd_GetTextureId(self.handle()))
 }
 
-func (self ImGuiContext) SetDebugHookIdInfo(v ImGuiID) {
-	C.ImGuiContext_SetDebugHookIdInfo(self.handle(), C.ImGuiID(v))
+func (self ImDrawCmd) SetVtxOffset(v uint32) {
+	C.ImDrawCmd_SetVtxOffset(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) GetDebugHookIdInfo() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetDebugHookIdInfo(self.handle()))
+func (self ImDrawCmd) GetVtxOffset() uint32 {
+	return uint32(C.ImDrawCmd_GetVtxOffset(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredId(v ImGuiID) {
-	C.ImGuiContext_SetHoveredId(self.handle(), C.ImGuiID(v))
+func (self ImDrawCmd) SetIdxOffset(v uint32) {
+	C.ImDrawCmd_SetIdxOffset(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) GetHoveredId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetHoveredId(self.handle()))
+func (self ImDrawCmd) GetIdxOffset() uint32 {
+	return uint32(C.ImDrawCmd_GetIdxOffset(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredIdPreviousFrame(v ImGuiID) {
-	C.ImGuiContext_SetHoveredIdPreviousFrame(self.handle(), C.ImGuiID(v))
+func (self ImDrawCmd) SetElemCount(v uint32) {
+	C.ImDrawCmd_SetElemCount(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) GetHoveredIdPreviousFrame() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetHoveredIdPreviousFrame(self.handle()))
+func (self ImDrawCmd) GetElemCount() uint32 {
+	return uint32(C.ImDrawCmd_GetElemCount(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredIdAllowOverlap(v bool) {
-	C.ImGuiContext_SetHoveredIdAllowOverlap(self.handle(), C.bool(v))
+func (self ImDrawCmd) SetUserCallbackData(v unsafe.Pointer) {
+	C.ImDrawCmd_SetUserCallbackData(self.handle(), v)
 }
 
-func (self ImGuiContext) GetHoveredIdAllowOverlap() bool {
-	return C.ImGuiContext_GetHoveredIdAllowOverlap(self.handle()) == C.bool(true)
+func (self ImDrawCmd) GetUserCallbackData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImDrawCmd_GetUserCallbackData(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredIdUsingMouseWheel(v bool) {
-	C.ImGuiContext_SetHoveredIdUsingMouseWheel(self.handle(), C.bool(v))
+func (self ImDrawVert) Setpos(v ImVec2) {
+	C.ImDrawVert_Setpos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetHoveredIdUsingMouseWheel() bool {
-	return C.ImGuiContext_GetHoveredIdUsingMouseWheel(self.handle()) == C.bool(true)
+func (self ImDrawVert) Getpos() ImVec2 {
+	return newImVec2FromC(C.ImDrawVert_Getpos(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredIdPreviousFrameUsingMouseWheel(v bool) {
-	C.ImGuiContext_SetHoveredIdPreviousFrameUsingMouseWheel(self.handle(), C.bool(v))
+func (self ImDrawVert) Setuv(v ImVec2) {
+	C.ImDrawVert_Setuv(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetHoveredIdPreviousFrameUsingMouseWheel() bool {
-	return C.ImGuiContext_GetHoveredIdPreviousFrameUsingMouseWheel(self.handle()) == C.bool(true)
+func (self ImDrawVert) Getuv() ImVec2 {
+	return newImVec2FromC(C.ImDrawVert_Getuv(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredIdDisabled(v bool) {
-	C.ImGuiContext_SetHoveredIdDisabled(self.handle(), C.bool(v))
+func (self ImDrawVert) Setcol(v uint32) {
+	C.ImDrawVert_Setcol(self.handle(), C.ImU32(v))
 }
 
-func (self ImGuiContext) GetHoveredIdDisabled() bool {
-	return C.ImGuiContext_GetHoveredIdDisabled(self.handle()) == C.bool(true)
+func (self ImDrawVert) Getcol() uint32 {
+	return uint32(C.ImDrawVert_Getcol(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredIdTimer(v float32) {
-	C.ImGuiContext_SetHoveredIdTimer(self.handle(), C.float(v))
+func (self ImDrawCmdHeader) SetClipRect(v ImVec4) {
+	C.ImDrawCmdHeader_SetClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetHoveredIdTimer() float32 {
-	return float32(C.ImGuiContext_GetHoveredIdTimer(self.handle()))
+func (self ImDrawCmdHeader) GetClipRect() ImVec4 {
+	return newImVec4FromC(C.ImDrawCmdHeader_GetClipRect(self.handle()))
 }
 
-func (self ImGuiContext) SetHoveredIdNotActiveTimer(v float32) {
-	C.ImGuiContext_SetHoveredIdNotActiveTimer(self.handle(), C.float(v))
+func (self ImDrawCmdHeader) SetTextureId(v ImTextureID) {
+	C.ImDrawCmdHeader_SetTextureId(self.handle(), C.uintptr_t(v))
 }
 
-func (self ImGuiContext) GetHoveredIdNotActiveTimer() float32 {
-	return float32(C.ImGuiContext_GetHoveredIdNotActiveTimer(self.handle()))
+func (self ImDrawCmdHeader) GetTextureId() ImTextureID {
+	return ImTextureID(C.ImDrawCmdHeader_GetTextureId(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveId(v ImGuiID) {
-	C.ImGuiContext_SetActiveId(self.handle(), C.ImGuiID(v))
+func (self ImDrawCmdHeader) SetVtxOffset(v uint32) {
+	C.ImDrawCmdHeader_SetVtxOffset(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) GetActiveId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetActiveId(self.handle()))
+func (self ImDrawCmdHeader) GetVtxOffset() uint32 {
+	return uint32(C.ImDrawCmdHeader_GetVtxOffset(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdIsAlive(v ImGuiID) {
-	C.ImGuiContext_SetActiveIdIsAlive(self.handle(), C.ImGuiID(v))
+func (self ImDrawListSplitter) Set_Current(v int32) {
+	C.ImDrawListSplitter_Set_Current(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetActiveIdIsAlive() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetActiveIdIsAlive(self.handle()))
+func (self ImDrawListSplitter) Get_Current() int {
+	return int(C.ImDrawListSplitter_Get_Current(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdTimer(v float32) {
-	C.ImGuiContext_SetActiveIdTimer(self.handle(), C.float(v))
+func (self ImDrawListSplitter) Set_Count(v int32) {
+	C.ImDrawListSplitter_Set_Count(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetActiveIdTimer() float32 {
-	return float32(C.ImGuiContext_GetActiveIdTimer(self.handle()))
+func (self ImDrawListSplitter) Get_Count() int {
+	return int(C.ImDrawListSplitter_Get_Count(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdIsJustActivated(v bool) {
-	C.ImGuiContext_SetActiveIdIsJustActivated(self.handle(), C.bool(v))
+func (self ImDrawList) SetFlags(v ImDrawListFlags) {
+	C.ImDrawList_SetFlags(self.handle(), C.ImDrawListFlags(v))
 }
 
-func (self ImGuiContext) GetActiveIdIsJustActivated() bool {
-	return C.ImGuiContext_GetActiveIdIsJustActivated(self.handle()) == C.bool(true)
+func (self ImDrawList) GetFlags() ImDrawListFlags {
+	return ImDrawListFlags(C.ImDrawList_GetFlags(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdAllowOverlap(v bool) {
-	C.ImGuiContext_SetActiveIdAllowOverlap(self.handle(), C.bool(v))
+func (self ImDrawList) Set_VtxCurrentIdx(v uint32) {
+	C.ImDrawList_Set_VtxCurrentIdx(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) GetActiveIdAllowOverlap() bool {
-	return C.ImGuiContext_GetActiveIdAllowOverlap(self.handle()) == C.bool(true)
+func (self ImDrawList) Get_VtxCurrentIdx() uint32 {
+	return uint32(C.ImDrawList_Get_VtxCurrentIdx(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdNoClearOnFocusLoss(v bool) {
-	C.ImGuiContext_SetActiveIdNoClearOnFocusLoss(self.handle(), C.bool(v))
+func (self ImDrawList) Set_Data(v ImDrawListSharedData) {
+	C.ImDrawList_Set_Data(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) GetActiveIdNoClearOnFocusLoss() bool {
-	return C.ImGuiContext_GetActiveIdNoClearOnFocusLoss(self.handle()) == C.bool(true)
+func (self ImDrawList) Get_Data() ImDrawListSharedData {
+	return (ImDrawListSharedData)(unsafe.Pointer(C.ImDrawList_Get_Data(self.handle())))
 }
 
-func (self ImGuiContext) SetActiveIdHasBeenPressedBefore(v bool) {
-	C.ImGuiContext_SetActiveIdHasBeenPressedBefore(self.handle(), C.bool(v))
+func (self ImDrawList) Set_OwnerName(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
+
+	C.ImDrawList_Set_OwnerName(self.handle(), vArg)
 }
 
-func (self ImGuiContext) GetActiveIdHasBeenPressedBefore() bool {
-	return C.ImGuiContext_GetActiveIdHasBeenPressedBefore(self.handle()) == C.bool(true)
+func (self ImDrawList) Get_OwnerName() string {
+	return C.GoString(C.ImDrawList_Get_OwnerName(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdHasBeenEditedBefore(v bool) {
-	C.ImGuiContext_SetActiveIdHasBeenEditedBefore(self.handle(), C.bool(v))
+func (self ImDrawList) Set_VtxWritePtr(v ImDrawVert) {
+	C.ImDrawList_Set_VtxWritePtr(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) GetActiveIdHasBeenEditedBefore() bool {
-	return C.ImGuiContext_GetActiveIdHasBeenEditedBefore(self.handle()) == C.bool(true)
+func (self ImDrawList) Get_VtxWritePtr() ImDrawVert {
+	return (ImDrawVert)(unsafe.Pointer(C.ImDrawList_Get_VtxWritePtr(self.handle())))
 }
 
-func (self ImGuiContext) SetActiveIdHasBeenEditedThisFrame(v bool) {
-	C.ImGuiContext_SetActiveIdHasBeenEditedThisFrame(self.handle(), C.bool(v))
+func (self ImDrawList) Get_CmdHeader() ImDrawCmdHeader {
+	return newImDrawCmdHeaderFromC(C.ImDrawList_Get_CmdHeader(self.handle()))
 }
 
-func (self ImGuiContext) GetActiveIdHasBeenEditedThisFrame() bool {
-	return C.ImGuiContext_GetActiveIdHasBeenEditedThisFrame(self.handle()) == C.bool(true)
+func (self ImDrawList) Get_Splitter() ImDrawListSplitter {
+	return newImDrawListSplitterFromC(C.ImDrawList_Get_Splitter(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdClickOffset(v ImVec2) {
-	C.ImGuiContext_SetActiveIdClickOffset(self.handle(), v.toC())
+func (self ImDrawList) Set_FringeScale(v float32) {
+	C.ImDrawList_Set_FringeScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetActiveIdClickOffset() ImVec2 {
-	return newImVec2FromC(C.ImGuiContext_GetActiveIdClickOffset(self.handle()))
+func (self ImDrawList) Get_FringeScale() float32 {
+	return float32(C.ImDrawList_Get_FringeScale(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdWindow(v ImGuiWindow) {
-	C.ImGuiContext_SetActiveIdWindow(self.handle(), v.handle())
+func (self ImDrawData) SetValid(v bool) {
+	C.ImDrawData_SetValid(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetActiveIdWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetActiveIdWindow(self.handle())))
+func (self ImDrawData) GetValid() bool {
+	return C.ImDrawData_GetValid(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) SetActiveIdSource(v ImGuiInputSource) {
-	C.ImGuiContext_SetActiveIdSource(self.handle(), C.ImGuiInputSource(v))
+func (self ImDrawData) SetCmdListsCount(v int32) {
+	C.ImDrawData_SetCmdListsCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetActiveIdSource() ImGuiInputSource {
-	return ImGuiInputSource(C.ImGuiContext_GetActiveIdSource(self.handle()))
+func (self ImDrawData) GetCmdListsCount() int {
+	return int(C.ImDrawData_GetCmdListsCount(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdMouseButton(v int32) {
-	C.ImGuiContext_SetActiveIdMouseButton(self.handle(), C.int(v))
+func (self ImDrawData) SetTotalIdxCount(v int32) {
+	C.ImDrawData_SetTotalIdxCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetActiveIdMouseButton() int {
-	return int(C.ImGuiContext_GetActiveIdMouseButton(self.handle()))
+func (self ImDrawData) GetTotalIdxCount() int {
+	return int(C.ImDrawData_GetTotalIdxCount(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdPreviousFrame(v ImGuiID) {
-	C.ImGuiContext_SetActiveIdPreviousFrame(self.handle(), C.ImGuiID(v))
+func (self ImDrawData) SetTotalVtxCount(v int32) {
+	C.ImDrawData_SetTotalVtxCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetActiveIdPreviousFrame() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetActiveIdPreviousFrame(self.handle()))
+func (self ImDrawData) GetTotalVtxCount() int {
+	return int(C.ImDrawData_GetTotalVtxCount(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdPreviousFrameIsAlive(v bool) {
-	C.ImGuiContext_SetActiveIdPreviousFrameIsAlive(self.handle(), C.bool(v))
+func (self ImDrawData) SetDisplayPos(v ImVec2) {
+	C.ImDrawData_SetDisplayPos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetActiveIdPreviousFrameIsAlive() bool {
-	return C.ImGuiContext_GetActiveIdPreviousFrameIsAlive(self.handle()) == C.bool(true)
+func (self ImDrawData) GetDisplayPos() ImVec2 {
+	return newImVec2FromC(C.ImDrawData_GetDisplayPos(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdPreviousFrameHasBeenEditedBefore(v bool) {
-	C.ImGuiContext_SetActiveIdPreviousFrameHasBeenEditedBefore(self.handle(), C.bool(v))
+func (self ImDrawData) SetDisplaySize(v ImVec2) {
+	C.ImDrawData_SetDisplaySize(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetActiveIdPreviousFrameHasBeenEditedBefore() bool {
-	return C.ImGuiContext_GetActiveIdPreviousFrameHasBeenEditedBefore(self.handle()) == C.bool(true)
+func (self ImDrawData) GetDisplaySize() ImVec2 {
+	return newImVec2FromC(C.ImDrawData_GetDisplaySize(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdPreviousFrameWindow(v ImGuiWindow) {
-	C.ImGuiContext_SetActiveIdPreviousFrameWindow(self.handle(), v.handle())
+func (self ImDrawData) SetFramebufferScale(v ImVec2) {
+	C.ImDrawData_SetFramebufferScale(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetActiveIdPreviousFrameWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetActiveIdPreviousFrameWindow(self.handle())))
+func (self ImDrawData) GetFramebufferScale() ImVec2 {
+	return newImVec2FromC(C.ImDrawData_GetFramebufferScale(self.handle()))
 }
 
-func (self ImGuiContext) SetLastActiveId(v ImGuiID) {
-	C.ImGuiContext_SetLastActiveId(self.handle(), C.ImGuiID(v))
+func (self ImDrawData) SetOwnerViewport(v ImGuiViewport) {
+	C.ImDrawData_SetOwnerViewport(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) GetLastActiveId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetLastActiveId(self.handle()))
+func (self ImDrawData) GetOwnerViewport() ImGuiViewport {
+	return (ImGuiViewport)(unsafe.Pointer(C.ImDrawData_GetOwnerViewport(self.handle())))
 }
 
-func (self ImGuiContext) SetLastActiveIdTimer(v float32) {
-	C.ImGuiContext_SetLastActiveIdTimer(self.handle(), C.float(v))
+func (self ImFontConfig) SetFontData(v unsafe.Pointer) {
+	C.ImFontConfig_SetFontData(self.handle(), v)
 }
 
-func (self ImGuiContext) GetLastActiveIdTimer() float32 {
-	return float32(C.ImGuiContext_GetLastActiveIdTimer(self.handle()))
+func (self ImFontConfig) GetFontData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImFontConfig_GetFontData(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdUsingNavDirMask(v uint32) {
-	C.ImGuiContext_SetActiveIdUsingNavDirMask(self.handle(), C.ImU32(v))
+func (self ImFontConfig) SetFontDataSize(v int32) {
+	C.ImFontConfig_SetFontDataSize(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetActiveIdUsingNavDirMask() uint32 {
-	return uint32(C.ImGuiContext_GetActiveIdUsingNavDirMask(self.handle()))
+func (self ImFontConfig) GetFontDataSize() int {
+	return int(C.ImFontConfig_GetFontDataSize(self.handle()))
 }
 
-func (self ImGuiContext) SetActiveIdUsingNavInputMask(v uint32) {
-	C.ImGuiContext_SetActiveIdUsingNavInputMask(self.handle(), C.ImU32(v))
+func (self ImFontConfig) SetFontDataOwnedByAtlas(v bool) {
+	C.ImFontConfig_SetFontDataOwnedByAtlas(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetActiveIdUsingNavInputMask() uint32 {
-	return uint32(C.ImGuiContext_GetActiveIdUsingNavInputMask(self.handle()))
+func (self ImFontConfig) GetFontDataOwnedByAtlas() bool {
+	return C.ImFontConfig_GetFontDataOwnedByAtlas(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) SetCurrentItemFlags(v ImGuiItemFlags) {
-	C.ImGuiContext_SetCurrentItemFlags(self.handle(), C.ImGuiItemFlags(v))
+func (self ImFontConfig) SetFontNo(v int32) {
+	C.ImFontConfig_SetFontNo(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetCurrentItemFlags() ImGuiItemFlags {
-	return ImGuiItemFlags(C.ImGuiContext_GetCurrentItemFlags(self.handle()))
+func (self ImFontConfig) GetFontNo() int {
+	return int(C.ImFontConfig_GetFontNo(self.handle()))
 }
 
-func (self ImGuiContext) GetNextItemData() ImGuiNextItemData {
-	return newImGuiNextItemDataFromC(C.ImGuiContext_GetNextItemData(self.handle()))
+func (self ImFontConfig) SetSizePixels(v float32) {
+	C.ImFontConfig_SetSizePixels(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetLastItemData() ImGuiLastItemData {
-	return newImGuiLastItemDataFromC(C.ImGuiContext_GetLastItemData(self.handle()))
+func (self ImFontConfig) GetSizePixels() float32 {
+	return float32(C.ImFontConfig_GetSizePixels(self.handle()))
 }
 
-func (self ImGuiContext) GetNextWindowData() ImGuiNextWindowData {
-	return newImGuiNextWindowDataFromC(C.ImGuiContext_GetNextWindowData(self.handle()))
+func (self ImFontConfig) SetOversampleH(v int32) {
+	C.ImFontConfig_SetOversampleH(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetBeginMenuCount(v int32) {
-	C.ImGuiContext_SetBeginMenuCount(self.handle(), C.int(v))
+func (self ImFontConfig) GetOversampleH() int {
+	return int(C.ImFontConfig_GetOversampleH(self.handle()))
 }
 
-func (self ImGuiContext) GetBeginMenuCount() int {
-	return int(C.ImGuiContext_GetBeginMenuCount(self.handle()))
+func (self ImFontConfig) SetOversampleV(v int32) {
+	C.ImFontConfig_SetOversampleV(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetCurrentDpiScale(v float32) {
-	C.ImGuiContext_SetCurrentDpiScale(self.handle(), C.float(v))
+func (self ImFontConfig) GetOversampleV() int {
+	return int(C.ImFontConfig_GetOversampleV(self.handle()))
 }
 
-func (self ImGuiContext) GetCurrentDpiScale() float32 {
-	return float32(C.ImGuiContext_GetCurrentDpiScale(self.handle()))
+func (self ImFontConfig) SetPixelSnapH(v bool) {
+	C.ImFontConfig_SetPixelSnapH(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) SetCurrentViewport(v ImGuiViewportP) {
-	C.ImGuiContext_SetCurrentViewport(self.handle(), v.handle())
+func (self ImFontConfig) GetPixelSnapH() bool {
+	return C.ImFontConfig_GetPixelSnapH(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetCurrentViewport() ImGuiViewportP {
-	return (ImGuiViewportP)(unsafe.Pointer(C.ImGuiContext_GetCurrentViewport(self.handle())))
+func (self ImFontConfig) SetGlyphExtraSpacing(v ImVec2) {
+	C.ImFontConfig_SetGlyphExtraSpacing(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetMouseViewport(v ImGuiViewportP) {
-	C.ImGuiContext_SetMouseViewport(self.handle(), v.handle())
+func (self ImFontConfig) GetGlyphExtraSpacing() ImVec2 {
+	return newImVec2FromC(C.ImFontConfig_GetGlyphExtraSpacing(self.handle()))
 }
 
-func (self ImGuiContext) GetMouseViewport() ImGuiViewportP {
-	return (ImGuiViewportP)(unsafe.Pointer(C.ImGuiContext_GetMouseViewport(self.handle())))
+func (self ImFontConfig) SetGlyphOffset(v ImVec2) {
+	C.ImFontConfig_SetGlyphOffset(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetMouseLastHoveredViewport(v ImGuiViewportP) {
-	C.ImGuiContext_SetMouseLastHoveredViewport(self.handle(), v.handle())
+func (self ImFontConfig) GetGlyphOffset() ImVec2 {
+	return newImVec2FromC(C.ImFontConfig_GetGlyphOffset(self.handle()))
 }
 
-func (self ImGuiContext) GetMouseLastHoveredViewport() ImGuiViewportP {
-	return (ImGuiViewportP)(unsafe.Pointer(C.ImGuiContext_GetMouseLastHoveredViewport(self.handle())))
+func (self ImFontConfig) SetGlyphRanges(v *ImWchar) {
+	C.ImFontConfig_SetGlyphRanges(self.handle(), (*C.ImWchar)(v))
 }
 
-func (self ImGuiContext) SetPlatformLastFocusedViewportId(v ImGuiID) {
-	C.ImGuiContext_SetPlatformLastFocusedViewportId(self.handle(), C.ImGuiID(v))
+func (self ImFontConfig) GetGlyphRanges() *ImWchar {
+	return (*ImWchar)(C.ImFontConfig_GetGlyphRanges(self.handle()))
 }
 
-func (self ImGuiContext) GetPlatformLastFocusedViewportId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetPlatformLastFocusedViewportId(self.handle()))
+func (self ImFontConfig) SetGlyphMinAdvanceX(v float32) {
+	C.ImFontConfig_SetGlyphMinAdvanceX(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetFallbackMonitor() ImGuiPlatformMonitor {
-	return newImGuiPlatformMonitorFromC(C.ImGuiContext_GetFallbackMonitor(self.handle()))
+func (self ImFontConfig) GetGlyphMinAdvanceX() float32 {
+	return float32(C.ImFontConfig_GetGlyphMinAdvanceX(self.handle()))
 }
 
-func (self ImGuiContext) SetViewportFrontMostStampCount(v int32) {
-	C.ImGuiContext_SetViewportFrontMostStampCount(self.handle(), C.int(v))
+func (self ImFontConfig) SetGlyphMaxAdvanceX(v float32) {
+	C.ImFontConfig_SetGlyphMaxAdvanceX(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetViewportFrontMostStampCount() int {
-	return int(C.ImGuiContext_GetViewportFrontMostStampCount(self.handle()))
+func (self ImFontConfig) GetGlyphMaxAdvanceX() float32 {
+	return float32(C.ImFontConfig_GetGlyphMaxAdvanceX(self.handle()))
 }
 
-func (self ImGuiContext) SetNavWindow(v ImGuiWindow) {
-	C.ImGuiContext_SetNavWindow(self.handle(), v.handle())
+func (self ImFontConfig) SetMergeMode(v bool) {
+	C.ImFontConfig_SetMergeMode(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetNavWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetNavWindow(self.handle())))
+func (self ImFontConfig) GetMergeMode() bool {
+	return C.ImFontConfig_GetMergeMode(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) SetNavId(v ImGuiID) {
-	C.ImGuiContext_SetNavId(self.handle(), C.ImGuiID(v))
+func (self ImFontConfig) SetFontBuilderFlags(v uint32) {
+	C.ImFontConfig_SetFontBuilderFlags(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) GetNavId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetNavId(self.handle()))
+func (self ImFontConfig) GetFontBuilderFlags() uint32 {
+	return uint32(C.ImFontConfig_GetFontBuilderFlags(self.handle()))
 }
 
-func (self ImGuiContext) SetNavFocusScopeId(v ImGuiID) {
-	C.ImGuiContext_SetNavFocusScopeId(self.handle(), C.ImGuiID(v))
+func (self ImFontConfig) SetRasterizerMultiply(v float32) {
+	C.ImFontConfig_SetRasterizerMultiply(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetNavFocusScopeId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetNavFocusScopeId(self.handle()))
+func (self ImFontConfig) GetRasterizerMultiply() float32 {
+	return float32(C.ImFontConfig_GetRasterizerMultiply(self.handle()))
 }
 
-func (self ImGuiContext) SetNavActivateId(v ImGuiID) {
-	C.ImGuiContext_SetNavActivateId(self.handle(), C.ImGuiID(v))
+func (self ImFontConfig) SetEllipsisChar(v ImWchar) {
+	C.ImFontConfig_SetEllipsisChar(self.handle(), C.ImWchar(v))
 }
 
-func (self ImGuiContext) GetNavActivateId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetNavActivateId(self.handle()))
+func (self ImFontConfig) SetDstFont(v ImFont) {
+	C.ImFontConfig_SetDstFont(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) SetNavActivateDownId(v ImGuiID) {
-	C.ImGuiContext_SetNavActivateDownId(self.handle(), C.ImGuiID(v))
+func (self ImFontConfig) GetDstFont() ImFont {
+	return (ImFont)(unsafe.Pointer(C.ImFontConfig_GetDstFont(self.handle())))
 }
 
-func (self ImGuiContext) GetNavActivateDownId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetNavActivateDownId(self.handle()))
+func (self ImFontGlyph) SetColored(v uint32) {
+	C.ImFontGlyph_SetColored(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) SetNavActivatePressedId(v ImGuiID) {
-	C.ImGuiContext_SetNavActivatePressedId(self.handle(), C.ImGuiID(v))
+func (self ImFontGlyph) GetColored() uint32 {
+	return uint32(C.ImFontGlyph_GetColored(self.handle()))
 }
 
-func (self ImGuiContext) GetNavActivatePressedId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetNavActivatePressedId(self.handle()))
+func (self ImFontGlyph) SetVisible(v uint32) {
+	C.ImFontGlyph_SetVisible(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) SetNavActivateInputId(v ImGuiID) {
-	C.ImGuiContext_SetNavActivateInputId(self.handle(), C.ImGuiID(v))
+func (self ImFontGlyph) GetVisible() uint32 {
+	return uint32(C.ImFontGlyph_GetVisible(self.handle()))
 }
 
-func (self ImGuiContext) GetNavActivateInputId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetNavActivateInputId(self.handle()))
+func (self ImFontGlyph) SetCodepoint(v uint32) {
+	C.ImFontGlyph_SetCodepoint(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) SetNavActivateFlags(v ImGuiActivateFlags) {
-	C.ImGuiContext_SetNavActivateFlags(self.handle(), C.ImGuiActivateFlags(v))
+func (self ImFontGlyph) GetCodepoint() uint32 {
+	return uint32(C.ImFontGlyph_GetCodepoint(self.handle()))
 }
 
-func (self ImGuiContext) GetNavActivateFlags() ImGuiActivateFlags {
-	return ImGuiActivateFlags(C.ImGuiContext_GetNavActivateFlags(self.handle()))
+func (self ImFontGlyph) SetAdvanceX(v float32) {
+	C.ImFontGlyph_SetAdvanceX(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavJustMovedToId(v ImGuiID) {
-	C.ImGuiContext_SetNavJustMovedToId(self.handle(), C.ImGuiID(v))
+func (self ImFontGlyph) GetAdvanceX() float32 {
+	return float32(C.ImFontGlyph_GetAdvanceX(self.handle()))
 }
 
-func (self ImGuiContext) GetNavJustMovedToId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetNavJustMovedToId(self.handle()))
+func (self ImFontGlyph) SetX0(v float32) {
+	C.ImFontGlyph_SetX0(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavJustMovedToFocusScopeId(v ImGuiID) {
-	C.ImGuiContext_SetNavJustMovedToFocusScopeId(self.handle(), C.ImGuiID(v))
+func (self ImFontGlyph) GetX0() float32 {
+	return float32(C.ImFontGlyph_GetX0(self.handle()))
 }
 
-func (self ImGuiContext) GetNavJustMovedToFocusScopeId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetNavJustMovedToFocusScopeId(self.handle()))
+func (self ImFontGlyph) SetY0(v float32) {
+	C.ImFontGlyph_SetY0(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavJustMovedToKeyMods(v ImGuiModFlags) {
-	C.ImGuiContext_SetNavJustMovedToKeyMods(self.handle(), C.ImGuiModFlags(v))
+func (self ImFontGlyph) GetY0() float32 {
+	return float32(C.ImFontGlyph_GetY0(self.handle()))
 }
 
-func (self ImGuiContext) GetNavJustMovedToKeyMods() ImGuiModFlags {
-	return ImGuiModFlags(C.ImGuiContext_GetNavJustMovedToKeyMods(self.handle()))
+func (self ImFontGlyph) SetX1(v float32) {
+	C.ImFontGlyph_SetX1(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavNextActivateId(v ImGuiID) {
-	C.ImGuiContext_SetNavNextActivateId(self.handle(), C.ImGuiID(v))
+func (self ImFontGlyph) GetX1() float32 {
+	return float32(C.ImFontGlyph_GetX1(self.handle()))
 }
 
-func (self ImGuiContext) GetNavNextActivateId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetNavNextActivateId(self.handle()))
+func (self ImFontGlyph) SetY1(v float32) {
+	C.ImFontGlyph_SetY1(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavNextActivateFlags(v ImGuiActivateFlags) {
-	C.ImGuiContext_SetNavNextActivateFlags(self.handle(), C.ImGuiActivateFlags(v))
+func (self ImFontGlyph) GetY1() float32 {
+	return float32(C.ImFontGlyph_GetY1(self.handle()))
 }
 
-func (self ImGuiContext) GetNavNextActivateFlags() ImGuiActivateFlags {
-	return ImGuiActivateFlags(C.ImGuiContext_GetNavNextActivateFlags(self.handle()))
+func (self ImFontGlyph) SetU0(v float32) {
+	C.ImFontGlyph_SetU0(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavInputSource(v ImGuiInputSource) {
-	C.ImGuiContext_SetNavInputSource(self.handle(), C.ImGuiInputSource(v))
+func (self ImFontGlyph) GetU0() float32 {
+	return float32(C.ImFontGlyph_GetU0(self.handle()))
 }
 
-func (self ImGuiContext) GetNavInputSource() ImGuiInputSource {
-	return ImGuiInputSource(C.ImGuiContext_GetNavInputSource(self.handle()))
+func (self ImFontGlyph) SetV0(v float32) {
+	C.ImFontGlyph_SetV0(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavLayer(v ImGuiNavLayer) {
-	C.ImGuiContext_SetNavLayer(self.handle(), C.ImGuiNavLayer(v))
+func (self ImFontGlyph) GetV0() float32 {
+	return float32(C.ImFontGlyph_GetV0(self.handle()))
 }
 
-func (self ImGuiContext) GetNavLayer() ImGuiNavLayer {
-	return ImGuiNavLayer(C.ImGuiContext_GetNavLayer(self.handle()))
+func (self ImFontGlyph) SetU1(v float32) {
+	C.ImFontGlyph_SetU1(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavIdIsAlive(v bool) {
-	C.ImGuiContext_SetNavIdIsAlive(self.handle(), C.bool(v))
+func (self ImFontGlyph) GetU1() float32 {
+	return float32(C.ImFontGlyph_GetU1(self.handle()))
 }
 
-func (self ImGuiContext) GetNavIdIsAlive() bool {
-	return C.ImGuiContext_GetNavIdIsAlive(self.handle()) == C.bool(true)
+func (self ImFontGlyph) SetV1(v float32) {
+	C.ImFontGlyph_SetV1(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavMousePosDirty(v bool) {
-	C.ImGuiContext_SetNavMousePosDirty(self.handle(), C.bool(v))
+func (self ImFontGlyph) GetV1() float32 {
+	return float32(C.ImFontGlyph_GetV1(self.handle()))
 }
 
-func (self ImGuiContext) GetNavMousePosDirty() bool {
-	return C.ImGuiContext_GetNavMousePosDirty(self.handle()) == C.bool(true)
+func (self ImFontAtlasCustomRect) SetWidth(v uint) {
+	C.ImFontAtlasCustomRect_SetWidth(self.handle(), C.ushort(v))
 }
 
-func (self ImGuiContext) SetNavDisableHighlight(v bool) {
-	C.ImGuiContext_SetNavDisableHighlight(self.handle(), C.bool(v))
+func (self ImFontAtlasCustomRect) SetHeight(v uint) {
+	C.ImFontAtlasCustomRect_SetHeight(self.handle(), C.ushort(v))
 }
 
-func (self ImGuiContext) GetNavDisableHighlight() bool {
-	return C.ImGuiContext_GetNavDisableHighlight(self.handle()) == C.bool(true)
+func (self ImFontAtlasCustomRect) SetX(v uint) {
+	C.ImFontAtlasCustomRect_SetX(self.handle(), C.ushort(v))
 }
 
-func (self ImGuiContext) SetNavDisableMouseHover(v bool) {
-	C.ImGuiContext_SetNavDisableMouseHover(self.handle(), C.bool(v))
+func (self ImFontAtlasCustomRect) SetY(v uint) {
+	C.ImFontAtlasCustomRect_SetY(self.handle(), C.ushort(v))
 }
 
-func (self ImGuiContext) GetNavDisableMouseHover() bool {
-	return C.ImGuiContext_GetNavDisableMouseHover(self.handle()) == C.bool(true)
+func (self ImFontAtlasCustomRect) SetGlyphID(v uint32) {
+	C.ImFontAtlasCustomRect_SetGlyphID(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) SetNavAnyRequest(v bool) {
-	C.ImGuiContext_SetNavAnyRequest(self.handle(), C.bool(v))
+func (self ImFontAtlasCustomRect) GetGlyphID() uint32 {
+	return uint32(C.ImFontAtlasCustomRect_GetGlyphID(self.handle()))
 }
 
-func (self ImGuiContext) GetNavAnyRequest() bool {
-	return C.ImGuiContext_GetNavAnyRequest(self.handle()) == C.bool(true)
+func (self ImFontAtlasCustomRect) SetGlyphAdvanceX(v float32) {
+	C.ImFontAtlasCustomRect_SetGlyphAdvanceX(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavInitRequest(v bool) {
-	C.ImGuiContext_SetNavInitRequest(self.handle(), C.bool(v))
+func (self ImFontAtlasCustomRect) GetGlyphAdvanceX() float32 {
+	return float32(C.ImFontAtlasCustomRect_GetGlyphAdvanceX(self.handle()))
 }
 
-func (self ImGuiContext) GetNavInitRequest() bool {
-	return C.ImGuiContext_GetNavInitRequest(self.handle()) == C.bool(true)
+func (self ImFontAtlasCustomRect) SetGlyphOffset(v ImVec2) {
+	C.ImFontAtlasCustomRect_SetGlyphOffset(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetNavInitRequestFromMove(v bool) {
-	C.ImGuiContext_SetNavInitRequestFromMove(self.handle(), C.bool(v))
+func (self ImFontAtlasCustomRect) GetGlyphOffset() ImVec2 {
+	return newImVec2FromC(C.ImFontAtlasCustomRect_GetGlyphOffset(self.handle()))
 }
 
-func (self ImGuiContext) GetNavInitRequestFromMove() bool {
-	return C.ImGuiContext_GetNavInitRequestFromMove(self.handle()) == C.bool(true)
+func (self ImFontAtlasCustomRect) SetFont(v ImFont) {
+	C.ImFontAtlasCustomRect_SetFont(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) SetNavInitResultId(v ImGuiID) {
-	C.ImGuiContext_SetNavInitResultId(self.handle(), C.ImGuiID(v))
+func (self ImFontAtlasCustomRect) GetFont() ImFont {
+	return (ImFont)(unsafe.Pointer(C.ImFontAtlasCustomRect_GetFont(self.handle())))
 }
 
-func (self ImGuiContext) GetNavInitResultId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetNavInitResultId(self.handle()))
+func (self ImFontAtlas) SetFlags(v ImFontAtlasFlags) {
+	C.ImFontAtlas_SetFlags(self.handle(), C.ImFontAtlasFlags(v))
 }
 
-func (self ImGuiContext) SetNavInitResultRectRel(v ImRect) {
-	C.ImGuiContext_SetNavInitResultRectRel(self.handle(), v.toC())
+func (self ImFontAtlas) GetFlags() ImFontAtlasFlags {
+	return ImFontAtlasFlags(C.ImFontAtlas_GetFlags(self.handle()))
 }
 
-func (self ImGuiContext) GetNavInitResultRectRel() ImRect {
-	return newImRectFromC(C.ImGuiContext_GetNavInitResultRectRel(self.handle()))
+func (self ImFontAtlas) SetTexDesiredWidth(v int32) {
+	C.ImFontAtlas_SetTexDesiredWidth(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetNavMoveSubmitted(v bool) {
-	C.ImGuiContext_SetNavMoveSubmitted(self.handle(), C.bool(v))
+func (self ImFontAtlas) GetTexDesiredWidth() int {
+	return int(C.ImFontAtlas_GetTexDesiredWidth(self.handle()))
 }
 
-func (self ImGuiContext) GetNavMoveSubmitted() bool {
-	return C.ImGuiContext_GetNavMoveSubmitted(self.handle()) == C.bool(true)
+func (self ImFontAtlas) SetTexGlyphPadding(v int32) {
+	C.ImFontAtlas_SetTexGlyphPadding(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetNavMoveScoringItems(v bool) {
-	C.ImGuiContext_SetNavMoveScoringItems(self.handle(), C.bool(v))
+func (self ImFontAtlas) GetTexGlyphPadding() int {
+	return int(C.ImFontAtlas_GetTexGlyphPadding(self.handle()))
 }
 
-func (self ImGuiContext) GetNavMoveScoringItems() bool {
-	return C.ImGuiContext_GetNavMoveScoringItems(self.handle()) == C.bool(true)
+func (self ImFontAtlas) SetLocked(v bool) {
+	C.ImFontAtlas_SetLocked(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) SetNavMoveForwardToNextFrame(v bool) {
-	C.ImGuiContext_SetNavMoveForwardToNextFrame(self.handle(), C.bool(v))
+func (self ImFontAtlas) GetLocked() bool {
+	return C.ImFontAtlas_GetLocked(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetNavMoveForwardToNextFrame() bool {
-	return C.ImGuiContext_GetNavMoveForwardToNextFrame(self.handle()) == C.bool(true)
+func (self ImFontAtlas) SetTexReady(v bool) {
+	C.ImFontAtlas_SetTexReady(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) SetNavMoveFlags(v ImGuiNavMoveFlags) {
-	C.ImGuiContext_SetNavMoveFlags(self.handle(), C.ImGuiNavMoveFlags(v))
+func (self ImFontAtlas) GetTexReady() bool {
+	return C.ImFontAtlas_GetTexReady(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetNavMoveFlags() ImGuiNavMoveFlags {
-	return ImGuiNavMoveFlags(C.ImGuiContext_GetNavMoveFlags(self.handle()))
+func (self ImFontAtlas) SetTexPixelsUseColors(v bool) {
+	C.ImFontAtlas_SetTexPixelsUseColors(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) SetNavMoveScrollFlags(v ImGuiScrollFlags) {
-	C.ImGuiContext_SetNavMoveScrollFlags(self.handle(), C.ImGuiScrollFlags(v))
+func (self ImFontAtlas) GetTexPixelsUseColors() bool {
+	return C.ImFontAtlas_GetTexPixelsUseColors(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetNavMoveScrollFlags() ImGuiScrollFlags {
-	return ImGuiScrollFlags(C.ImGuiContext_GetNavMoveScrollFlags(self.handle()))
+func (self ImFontAtlas) SetTexWidth(v int32) {
+	C.ImFontAtlas_SetTexWidth(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetNavMoveKeyMods(v ImGuiModFlags) {
-	C.ImGuiContext_SetNavMoveKeyMods(self.handle(), C.ImGuiModFlags(v))
+func (self ImFontAtlas) GetTexWidth() int {
+	return int(C.ImFontAtlas_GetTexWidth(self.handle()))
 }
 
-func (self ImGuiContext) GetNavMoveKeyMods() ImGuiModFlags {
-	return ImGuiModFlags(C.ImGuiContext_GetNavMoveKeyMods(self.handle()))
+func (self ImFontAtlas) SetTexHeight(v int32) {
+	C.ImFontAtlas_SetTexHeight(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetNavMoveDir(v ImGuiDir) {
-	C.ImGuiContext_SetNavMoveDir(self.handle(), C.ImGuiDir(v))
+func (self ImFontAtlas) GetTexHeight() int {
+	return int(C.ImFontAtlas_GetTexHeight(self.handle()))
 }
 
-func (self ImGuiContext) GetNavMoveDir() ImGuiDir {
-	return ImGuiDir(C.ImGuiContext_GetNavMoveDir(self.handle()))
+func (self ImFontAtlas) SetTexUvScale(v ImVec2) {
+	C.ImFontAtlas_SetTexUvScale(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetNavMoveDirForDebug(v ImGuiDir) {
-	C.ImGuiContext_SetNavMoveDirForDebug(self.handle(), C.ImGuiDir(v))
+func (self ImFontAtlas) GetTexUvScale() ImVec2 {
+	return newImVec2FromC(C.ImFontAtlas_GetTexUvScale(self.handle()))
 }
 
-func (self ImGuiContext) GetNavMoveDirForDebug() ImGuiDir {
-	return ImGuiDir(C.ImGuiContext_GetNavMoveDirForDebug(self.handle()))
+func (self ImFontAtlas) SetTexUvWhitePixel(v ImVec2) {
+	C.ImFontAtlas_SetTexUvWhitePixel(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetNavMoveClipDir(v ImGuiDir) {
-	C.ImGuiContext_SetNavMoveClipDir(self.handle(), C.ImGuiDir(v))
+func (self ImFontAtlas) GetTexUvWhitePixel() ImVec2 {
+	return newImVec2FromC(C.ImFontAtlas_GetTexUvWhitePixel(self.handle()))
 }
 
-func (self ImGuiContext) GetNavMoveClipDir() ImGuiDir {
-	return ImGuiDir(C.ImGuiContext_GetNavMoveClipDir(self.handle()))
+func (self ImFontAtlas) SetFontBuilderIO(v ImFontBuilderIO) {
+	C.ImFontAtlas_SetFontBuilderIO(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) SetNavScoringRect(v ImRect) {
-	C.ImGuiContext_SetNavScoringRect(self.handle(), v.toC())
+func (self ImFontAtlas) GetFontBuilderIO() ImFontBuilderIO {
+	return (ImFontBuilderIO)(unsafe.Pointer(C.ImFontAtlas_GetFontBuilderIO(self.handle())))
 }
 
-func (self ImGuiContext) GetNavScoringRect() ImRect {
-	return newImRectFromC(C.ImGuiContext_GetNavScoringRect(self.handle()))
+func (self ImFontAtlas) SetFontBuilderFlags(v uint32) {
+	C.ImFontAtlas_SetFontBuilderFlags(self.handle(), C.uint(v))
 }
 
-func (self ImGuiContext) SetNavScoringNoClipRect(v ImRect) {
-	C.ImGuiContext_SetNavScoringNoClipRect(self.handle(), v.toC())
+func (self ImFontAtlas) GetFontBuilderFlags() uint32 {
+	return uint32(C.ImFontAtlas_GetFontBuilderFlags(self.handle()))
 }
 
-func (self ImGuiContext) GetNavScoringNoClipRect() ImRect {
-	return newImRectFromC(C.ImGuiContext_GetNavScoringNoClipRect(self.handle()))
+func (self ImFontAtlas) SetPackIdMouseCursors(v int32) {
+	C.ImFontAtlas_SetPackIdMouseCursors(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetNavScoringDebugCount(v int32) {
-	C.ImGuiContext_SetNavScoringDebugCount(self.handle(), C.int(v))
+func (self ImFontAtlas) GetPackIdMouseCursors() int {
+	return int(C.ImFontAtlas_GetPackIdMouseCursors(self.handle()))
 }
 
-func (self ImGuiContext) GetNavScoringDebugCount() int {
-	return int(C.ImGuiContext_GetNavScoringDebugCount(self.handle()))
+func (self ImFontAtlas) SetPackIdLines(v int32) {
+	C.ImFontAtlas_SetPackIdLines(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetNavTabbingDir(v int32) {
-	C.ImGuiContext_SetNavTabbingDir(self.handle(), C.int(v))
+func (self ImFontAtlas) GetPackIdLines() int {
+	return int(C.ImFontAtlas_GetPackIdLines(self.handle()))
 }
 
-func (self ImGuiContext) GetNavTabbingDir() int {
-	return int(C.ImGuiContext_GetNavTabbingDir(self.handle()))
+func (self ImFont) SetFallbackAdvanceX(v float32) {
+	C.ImFont_SetFallbackAdvanceX(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetNavTabbingCounter(v int32) {
-	C.ImGuiContext_SetNavTabbingCounter(self.handle(), C.int(v))
+func (self ImFont) GetFallbackAdvanceX() float32 {
+	return float32(C.ImFont_GetFallbackAdvanceX(self.handle()))
 }
 
-func (self ImGuiContext) GetNavTabbingCounter() int {
-	return int(C.ImGuiContext_GetNavTabbingCounter(self.handle()))
+func (self ImFont) SetFontSize(v float32) {
+	C.ImFont_SetFontSize(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetNavMoveResultLocal() ImGuiNavItemData {
-	return newImGuiNavItemDataFromC(C.ImGuiContext_GetNavMoveResultLocal(self.handle()))
+func (self ImFont) GetFontSize() float32 {
+	return float32(C.ImFont_GetFontSize(self.handle()))
 }
 
-func (self ImGuiContext) GetNavMoveResultLocalVisible() ImGuiNavItemData {
-	return newImGuiNavItemDataFromC(C.ImGuiContext_GetNavMoveResultLocalVisible(self.handle()))
+func (self ImFont) SetFallbackGlyph(v ImFontGlyph) {
+	C.ImFont_SetFallbackGlyph(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) GetNavMoveResultOther() ImGuiNavItemData {
-	return newImGuiNavItemDataFromC(C.ImGuiContext_GetNavMoveResultOther(self.handle()))
+func (self ImFont) GetFallbackGlyph() ImFontGlyph {
+	return (ImFontGlyph)(unsafe.Pointer(C.ImFont_GetFallbackGlyph(self.handle())))
 }
 
-func (self ImGuiContext) GetNavTabbingResultFirst() ImGuiNavItemData {
-	return newImGuiNavItemDataFromC(C.ImGuiContext_GetNavTabbingResultFirst(self.handle()))
+func (self ImFont) SetContainerAtlas(v ImFontAtlas) {
+	C.ImFont_SetContainerAtlas(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) SetNavWindowingTarget(v ImGuiWindow) {
-	C.ImGuiContext_SetNavWindowingTarget(self.handle(), v.handle())
+func (self ImFont) GetContainerAtlas() ImFontAtlas {
+	return (ImFontAtlas)(unsafe.Pointer(C.ImFont_GetContainerAtlas(self.handle())))
 }
 
-func (self ImGuiContext) GetNavWindowingTarget() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetNavWindowingTarget(self.handle())))
+func (self ImFont) SetConfigData(v ImFontConfig) {
+	C.ImFont_SetConfigData(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) SetNavWindowingTargetAnim(v ImGuiWindow) {
-	C.ImGuiContext_SetNavWindowingTargetAnim(self.handle(), v.handle())
+func (self ImFont) GetConfigData() ImFontConfig {
+	return (ImFontConfig)(unsafe.Pointer(C.ImFont_GetConfigData(self.handle())))
 }
 
-func (self ImGuiContext) GetNavWindowingTargetAnim() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetNavWindowingTargetAnim(self.handle())))
+func (self ImFont) SetConfigDataCount(v int) {
+	C.ImFont_SetConfigDataCount(self.handle(), C.short(v))
 }
 
-func (self ImGuiContext) SetNavWindowingListWindow(v ImGuiWindow) {
-	C.ImGuiContext_SetNavWindowingListWindow(self.handle(), v.handle())
+func (self ImFont) GetConfigDataCount() int {
+	return int(C.ImFont_GetConfigDataCount(self.handle()))
 }
 
-func (self ImGuiContext) GetNavWindowingListWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetNavWindowingListWindow(self.handle())))
+func (self ImFont) SetFallbackChar(v ImWchar) {
+	C.ImFont_SetFallbackChar(self.handle(), C.ImWchar(v))
 }
 
-func (self ImGuiContext) SetNavWindowingTimer(v float32) {
-	C.ImGuiContext_SetNavWindowingTimer(self.handle(), C.float(v))
+func (self ImFont) SetEllipsisChar(v ImWchar) {
+	C.ImFont_SetEllipsisChar(self.handle(), C.ImWchar(v))
 }
 
-func (self ImGuiContext) GetNavWindowingTimer() float32 {
-	return float32(C.ImGuiContext_GetNavWindowingTimer(self.handle()))
+func (self ImFont) SetDotChar(v ImWchar) {
+	C.ImFont_SetDotChar(self.handle(), C.ImWchar(v))
 }
 
-func (self ImGuiContext) SetNavWindowingHighlightAlpha(v float32) {
-	C.ImGuiContext_SetNavWindowingHighlightAlpha(self.handle(), C.float(v))
+func (self ImFont) SetDirtyLookupTables(v bool) {
+	C.ImFont_SetDirtyLookupTables(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetNavWindowingHighlightAlpha() float32 {
-	return float32(C.ImGuiContext_GetNavWindowingHighlightAlpha(self.handle()))
+func (self ImFont) GetDirtyLookupTables() bool {
+	return C.ImFont_GetDirtyLookupTables(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) SetNavWindowingToggleLayer(v bool) {
-	C.ImGuiContext_SetNavWindowingToggleLayer(self.handle(), C.bool(v))
+func (self ImFont) SetScale(v float32) {
+	C.ImFont_SetScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetNavWindowingToggleLayer() bool {
-	return C.ImGuiContext_GetNavWindowingToggleLayer(self.handle()) == C.bool(true)
+func (self ImFont) GetScale() float32 {
+	return float32(C.ImFont_GetScale(self.handle()))
 }
 
-func (self ImGuiContext) SetNavWindowingAccumDeltaPos(v ImVec2) {
-	C.ImGuiContext_SetNavWindowingAccumDeltaPos(self.handle(), v.toC())
+func (self ImFont) SetAscent(v float32) {
+	C.ImFont_SetAscent(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetNavWindowingAccumDeltaPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiContext_GetNavWindowingAccumDeltaPos(self.handle()))
+func (self ImFont) GetAscent() float32 {
+	return float32(C.ImFont_GetAscent(self.handle()))
 }
 
-func (self ImGuiContext) SetNavWindowingAccumDeltaSize(v ImVec2) {
-	C.ImGuiContext_SetNavWindowingAccumDeltaSize(self.handle(), v.toC())
+func (self ImFont) SetDescent(v float32) {
+	C.ImFont_SetDescent(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetNavWindowingAccumDeltaSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiContext_GetNavWindowingAccumDeltaSize(self.handle()))
+func (self ImFont) GetDescent() float32 {
+	return float32(C.ImFont_GetDescent(self.handle()))
 }
 
-func (self ImGuiContext) SetDimBgRatio(v float32) {
-	C.ImGuiContext_SetDimBgRatio(self.handle(), C.float(v))
+func (self ImFont) SetMetricsTotalSurface(v int32) {
+	C.ImFont_SetMetricsTotalSurface(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) GetDimBgRatio() float32 {
-	return float32(C.ImGuiContext_GetDimBgRatio(self.handle()))
+func (self ImFont) GetMetricsTotalSurface() int {
+	return int(C.ImFont_GetMetricsTotalSurface(self.handle()))
 }
 
-func (self ImGuiContext) SetMouseCursor(v ImGuiMouseCursor) {
-	C.ImGuiContext_SetMouseCursor(self.handle(), C.ImGuiMouseCursor(v))
+func (self ImGuiViewport) SetID(v ImGuiID) {
+	C.ImGuiViewport_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiContext) GetMouseCursor() ImGuiMouseCursor {
-	return ImGuiMouseCursor(C.ImGuiContext_GetMouseCursor(self.handle()))
+func (self ImGuiViewport) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiViewport_GetID(self.handle()))
 }
 
-func (self ImGuiContext) SetDragDropActive(v bool) {
-	C.ImGuiContext_SetDragDropActive(self.handle(), C.bool(v))
+func (self ImGuiViewport) SetFlags(v ImGuiViewportFlags) {
+	C.ImGuiViewport_SetFlags(self.handle(), C.ImGuiViewportFlags(v))
 }
 
-func (self ImGuiContext) GetDragDropActive() bool {
-	return C.ImGuiContext_GetDragDropActive(self.handle()) == C.bool(true)
+func (self ImGuiViewport) GetFlags() ImGuiViewportFlags {
+	return ImGuiViewportFlags(C.ImGuiViewport_GetFlags(self.handle()))
 }
 
-func (self ImGuiContext) SetDragDropWithinSource(v bool) {
-	C.ImGuiContext_SetDragDropWithinSource(self.handle(), C.bool(v))
+func (self ImGuiViewport) SetPos(v ImVec2) {
+	C.ImGuiViewport_SetPos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetDragDropWithinSource() bool {
-	return C.ImGuiContext_GetDragDropWithinSource(self.handle()) == C.bool(true)
+func (self ImGuiViewport) GetPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewport_GetPos(self.handle()))
 }
 
-func (self ImGuiContext) SetDragDropWithinTarget(v bool) {
-	C.ImGuiContext_SetDragDropWithinTarget(self.handle(), C.bool(v))
+func (self ImGuiViewport) SetSize(v ImVec2) {
+	C.ImGuiViewport_SetSize(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetDragDropWithinTarget() bool {
-	return C.ImGuiContext_GetDragDropWithinTarget(self.handle()) == C.bool(true)
+func (self ImGuiViewport) GetSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewport_GetSize(self.handle()))
 }
 
-func (self ImGuiContext) SetDragDropSourceFlags(v ImGuiDragDropFlags) {
-	C.ImGuiContext_SetDragDropSourceFlags(self.handle(), C.ImGuiDragDropFlags(v))
+func (self ImGuiViewport) SetWorkPos(v ImVec2) {
+	C.ImGuiViewport_SetWorkPos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetDragDropSourceFlags() ImGuiDragDropFlags {
-	return ImGuiDragDropFlags(C.ImGuiContext_GetDragDropSourceFlags(self.handle()))
+func (self ImGuiViewport) GetWorkPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewport_GetWorkPos(self.handle()))
 }
 
-func (self ImGuiContext) SetDragDropSourceFrameCount(v int32) {
-	C.ImGuiContext_SetDragDropSourceFrameCount(self.handle(), C.int(v))
+func (self ImGuiViewport) SetWorkSize(v ImVec2) {
+	C.ImGuiViewport_SetWorkSize(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetDragDropSourceFrameCount() int {
-	return int(C.ImGuiContext_GetDragDropSourceFrameCount(self.handle()))
+func (self ImGuiViewport) GetWorkSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewport_GetWorkSize(self.handle()))
 }
 
-func (self ImGuiContext) SetDragDropMouseButton(v int32) {
-	C.ImGuiContext_SetDragDropMouseButton(self.handle(), C.int(v))
+func (self ImGuiViewport) SetDpiScale(v float32) {
+	C.ImGuiViewport_SetDpiScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetDragDropMouseButton() int {
-	return int(C.ImGuiContext_GetDragDropMouseButton(self.handle()))
+func (self ImGuiViewport) GetDpiScale() float32 {
+	return float32(C.ImGuiViewport_GetDpiScale(self.handle()))
 }
 
-func (self ImGuiContext) GetDragDropPayload() ImGuiPayload {
-	return newImGuiPayloadFromC(C.ImGuiContext_GetDragDropPayload(self.handle()))
+func (self ImGuiViewport) SetParentViewportId(v ImGuiID) {
+	C.ImGuiViewport_SetParentViewportId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiContext) SetDragDropTargetRect(v ImRect) {
-	C.ImGuiContext_SetDragDropTargetRect(self.handle(), v.toC())
+func (self ImGuiViewport) GetParentViewportId() ImGuiID {
+	return ImGuiID(C.ImGuiViewport_GetParentViewportId(self.handle()))
 }
 
-func (self ImGuiContext) GetDragDropTargetRect() ImRect {
-	return newImRectFromC(C.ImGuiContext_GetDragDropTargetRect(self.handle()))
+func (self ImGuiViewport) SetDrawData(v ImDrawData) {
+	C.ImGuiViewport_SetDrawData(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) SetDragDropTargetId(v ImGuiID) {
-	C.ImGuiContext_SetDragDropTargetId(self.handle(), C.ImGuiID(v))
+func (self ImGuiViewport) GetDrawData() ImDrawData {
+	return (ImDrawData)(unsafe.Pointer(C.ImGuiViewport_GetDrawData(self.handle())))
 }
 
-func (self ImGuiContext) GetDragDropTargetId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetDragDropTargetId(self.handle()))
+func (self ImGuiViewport) SetRendererUserData(v unsafe.Pointer) {
+	C.ImGuiViewport_SetRendererUserData(self.handle(), v)
 }
 
-func (self ImGuiContext) SetDragDropAcceptFlags(v ImGuiDragDropFlags) {
-	C.ImGuiContext_SetDragDropAcceptFlags(self.handle(), C.ImGuiDragDropFlags(v))
+func (self ImGuiViewport) GetRendererUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiViewport_GetRendererUserData(self.handle()))
 }
 
-func (self ImGuiContext) GetDragDropAcceptFlags() ImGuiDragDropFlags {
-	return ImGuiDragDropFlags(C.ImGuiContext_GetDragDropAcceptFlags(self.handle()))
+func (self ImGuiViewport) SetPlatformUserData(v unsafe.Pointer) {
+	C.ImGuiViewport_SetPlatformUserData(self.handle(), v)
 }
 
-func (self ImGuiContext) SetDragDropAcceptIdCurrRectSurface(v float32) {
-	C.ImGuiContext_SetDragDropAcceptIdCurrRectSurface(self.handle(), C.float(v))
+func (self ImGuiViewport) GetPlatformUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiViewport_GetPlatformUserData(self.handle()))
 }
 
-func (self ImGuiContext) GetDragDropAcceptIdCurrRectSurface() float32 {
-	return float32(C.ImGuiContext_GetDragDropAcceptIdCurrRectSurface(self.handle()))
+func (self ImGuiViewport) SetPlatformHandle(v unsafe.Pointer) {
+	C.ImGuiViewport_SetPlatformHandle(self.handle(), v)
 }
 
-func (self ImGuiContext) SetDragDropAcceptIdCurr(v ImGuiID) {
-	C.ImGuiContext_SetDragDropAcceptIdCurr(self.handle(), C.ImGuiID(v))
+func (self ImGuiViewport) GetPlatformHandle() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiViewport_GetPlatformHandle(self.handle()))
 }
 
-func (self ImGuiContext) GetDragDropAcceptIdCurr() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetDragDropAcceptIdCurr(self.handle()))
+func (self ImGuiViewport) SetPlatformHandleRaw(v unsafe.Pointer) {
+	C.ImGuiViewport_SetPlatformHandleRaw(self.handle(), v)
 }
 
-func (self ImGuiContext) SetDragDropAcceptIdPrev(v ImGuiID) {
-	C.ImGuiContext_SetDragDropAcceptIdPrev(self.handle(), C.ImGuiID(v))
+func (self ImGuiViewport) GetPlatformHandleRaw() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiViewport_GetPlatformHandleRaw(self.handle()))
 }
 
-func (self ImGuiContext) GetDragDropAcceptIdPrev() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetDragDropAcceptIdPrev(self.handle()))
+func (self ImGuiViewport) SetPlatformRequestMove(v bool) {
+	C.ImGuiViewport_SetPlatformRequestMove(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) SetDragDropAcceptFrameCount(v int32) {
-	C.ImGuiContext_SetDragDropAcceptFrameCount(self.handle(), C.int(v))
+func (self ImGuiViewport) GetPlatformRequestMove() bool {
+	return C.ImGuiViewport_GetPlatformRequestMove(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetDragDropAcceptFrameCount() int {
-	return int(C.ImGuiContext_GetDragDropAcceptFrameCount(self.handle()))
+func (self ImGuiViewport) SetPlatformRequestResize(v bool) {
+	C.ImGuiViewport_SetPlatformRequestResize(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) SetDragDropHoldJustPressedId(v ImGuiID) {
-	C.ImGuiContext_SetDragDropHoldJustPressedId(self.handle(), C.ImGuiID(v))
+func (self ImGuiViewport) GetPlatformRequestResize() bool {
+	return C.ImGuiViewport_GetPlatformRequestResize(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetDragDropHoldJustPressedId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetDragDropHoldJustPressedId(self.handle()))
+func (self ImGuiViewport) SetPlatformRequestClose(v bool) {
+	C.ImGuiViewport_SetPlatformRequestClose(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) SetClipperTempDataStacked(v int32) {
-	C.ImGuiContext_SetClipperTempDataStacked(self.handle(), C.int(v))
+func (self ImGuiViewport) GetPlatformRequestClose() bool {
+	return C.ImGuiViewport_GetPlatformRequestClose(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetClipperTempDataStacked() int {
-	return int(C.ImGuiContext_GetClipperTempDataStacked(self.handle()))
+func (self ImGuiPlatformMonitor) SetMainPos(v ImVec2) {
+	C.ImGuiPlatformMonitor_SetMainPos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetCurrentTable(v ImGuiTable) {
-	C.ImGuiContext_SetCurrentTable(self.handle(), v.handle())
+func (self ImGuiPlatformMonitor) GetMainPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiPlatformMonitor_GetMainPos(self.handle()))
 }
 
-func (self ImGuiContext) GetCurrentTable() ImGuiTable {
-	return (ImGuiTable)(unsafe.Pointer(C.ImGuiContext_GetCurrentTable(self.handle())))
+func (self ImGuiPlatformMonitor) SetMainSize(v ImVec2) {
+	C.ImGuiPlatformMonitor_SetMainSize(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetTablesTempDataStacked(v int32) {
-	C.ImGuiContext_SetTablesTempDataStacked(self.handle(), C.int(v))
+func (self ImGuiPlatformMonitor) GetMainSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiPlatformMonitor_GetMainSize(self.handle()))
 }
 
-func (self ImGuiContext) GetTablesTempDataStacked() int {
-	return int(C.ImGuiContext_GetTablesTempDataStacked(self.handle()))
+func (self ImGuiPlatformMonitor) SetWorkPos(v ImVec2) {
+	C.ImGuiPlatformMonitor_SetWorkPos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetCurrentTabBar(v ImGuiTabBar) {
-	C.ImGuiContext_SetCurrentTabBar(self.handle(), v.handle())
+func (self ImGuiPlatformMonitor) GetWorkPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiPlatformMonitor_GetWorkPos(self.handle()))
 }
 
-func (self ImGuiContext) GetCurrentTabBar() ImGuiTabBar {
-	return (ImGuiTabBar)(unsafe.Pointer(C.ImGuiContext_GetCurrentTabBar(self.handle())))
+func (self ImGuiPlatformMonitor) SetWorkSize(v ImVec2) {
+	C.ImGuiPlatformMonitor_SetWorkSize(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetMouseLastValidPos(v ImVec2) {
-	C.ImGuiContext_SetMouseLastValidPos(self.handle(), v.toC())
+func (self ImGuiPlatformMonitor) GetWorkSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiPlatformMonitor_GetWorkSize(self.handle()))
 }
 
-func (self ImGuiContext) GetMouseLastValidPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiContext_GetMouseLastValidPos(self.handle()))
+func (self ImGuiPlatformMonitor) SetDpiScale(v float32) {
+	C.ImGuiPlatformMonitor_SetDpiScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetInputTextState() ImGuiInputTextState {
-	return newImGuiInputTextStateFromC(C.ImGuiContext_GetInputTextState(self.handle()))
+func (self ImGuiPlatformMonitor) GetDpiScale() float32 {
+	return float32(C.ImGuiPlatformMonitor_GetDpiScale(self.handle()))
 }
 
-func (self ImGuiContext) GetInputTextPasswordFont() ImFont {
-	return newImFontFromC(C.ImGuiContext_GetInputTextPasswordFont(self.handle()))
+func (self ImGuiPlatformImeData) SetWantVisible(v bool) {
+	C.ImGuiPlatformImeData_SetWantVisible(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) SetTempInputId(v ImGuiID) {
-	C.ImGuiContext_SetTempInputId(self.handle(), C.ImGuiID(v))
+func (self ImGuiPlatformImeData) GetWantVisible() bool {
+	return C.ImGuiPlatformImeData_GetWantVisible(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetTempInputId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetTempInputId(self.handle()))
+func (self ImGuiPlatformImeData) SetInputPos(v ImVec2) {
+	C.ImGuiPlatformImeData_SetInputPos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetColorEditOptions(v ImGuiColorEditFlags) {
-	C.ImGuiContext_SetColorEditOptions(self.handle(), C.ImGuiColorEditFlags(v))
+func (self ImGuiPlatformImeData) GetInputPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiPlatformImeData_GetInputPos(self.handle()))
 }
 
-func (self ImGuiContext) GetColorEditOptions() ImGuiColorEditFlags {
-	return ImGuiColorEditFlags(C.ImGuiContext_GetColorEditOptions(self.handle()))
+func (self ImGuiPlatformImeData) SetInputLineHeight(v float32) {
+	C.ImGuiPlatformImeData_SetInputLineHeight(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetColorEditLastHue(v float32) {
-	C.ImGuiContext_SetColorEditLastHue(self.handle(), C.float(v))
+func (self ImGuiPlatformImeData) GetInputLineHeight() float32 {
+	return float32(C.ImGuiPlatformImeData_GetInputLineHeight(self.handle()))
 }
 
-func (self ImGuiContext) GetColorEditLastHue() float32 {
-	return float32(C.ImGuiContext_GetColorEditLastHue(self.handle()))
+func (self ImDrawListSharedData) SetTexUvWhitePixel(v ImVec2) {
+	C.ImDrawListSharedData_SetTexUvWhitePixel(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetColorEditLastSat(v float32) {
-	C.ImGuiContext_SetColorEditLastSat(self.handle(), C.float(v))
+func (self ImDrawListSharedData) GetTexUvWhitePixel() ImVec2 {
+	return newImVec2FromC(C.ImDrawListSharedData_GetTexUvWhitePixel(self.handle()))
 }
 
-func (self ImGuiContext) GetColorEditLastSat() float32 {
-	return float32(C.ImGuiContext_GetColorEditLastSat(self.handle()))
+func (self ImDrawListSharedData) SetFont(v ImFont) {
+	C.ImDrawListSharedData_SetFont(self.handle(), v.handle())
 }
 
-func (self ImGuiContext) SetColorEditLastColor(v uint32) {
-	C.ImGuiContext_SetColorEditLastColor(self.handle(), C.ImU32(v))
+func (self ImDrawListSharedData) GetFont() ImFont {
+	return (ImFont)(unsafe.Pointer(C.ImDrawListSharedData_GetFont(self.handle())))
 }
 
-func (self ImGuiContext) GetColorEditLastColor() uint32 {
-	return uint32(C.ImGuiContext_GetColorEditLastColor(self.handle()))
+func (self ImDrawListSharedData) SetFontSize(v float32) {
+	C.ImDrawListSharedData_SetFontSize(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetColorPickerRef(v ImVec4) {
-	C.ImGuiContext_SetColorPickerRef(self.handle(), v.toC())
+func (self ImDrawListSharedData) GetFontSize() float32 {
+	return float32(C.ImDrawListSharedData_GetFontSize(self.handle()))
 }
 
-func (self ImGuiContext) GetColorPickerRef() ImVec4 {
-	return newImVec4FromC(C.ImGuiContext_GetColorPickerRef(self.handle()))
+func (self ImDrawListSharedData) SetCurveTessellationTol(v float32) {
+	C.ImDrawListSharedData_SetCurveTessellationTol(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetComboPreviewData() ImGuiComboPreviewData {
-	return newImGuiComboPreviewDataFromC(C.ImGuiContext_GetComboPreviewData(self.handle()))
+func (self ImDrawListSharedData) GetCurveTessellationTol() float32 {
+	return float32(C.ImDrawListSharedData_GetCurveTessellationTol(self.handle()))
 }
 
-func (self ImGuiContext) SetSliderGrabClickOffset(v float32) {
-	C.ImGuiContext_SetSliderGrabClickOffset(self.handle(), C.float(v))
+func (self ImDrawListSharedData) SetCircleSegmentMaxError(v float32) {
+	C.ImDrawListSharedData_SetCircleSegmentMaxError(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetSliderGrabClickOffset() float32 {
-	return float32(C.ImGuiContext_GetSliderGrabClickOffset(self.handle()))
+func (self ImDrawListSharedData) GetCircleSegmentMaxError() float32 {
+	return float32(C.ImDrawListSharedData_GetCircleSegmentMaxError(self.handle()))
 }
 
-func (self ImGuiContext) SetSliderCurrentAccum(v float32) {
-	C.ImGuiContext_SetSliderCurrentAccum(self.handle(), C.float(v))
+func (self ImDrawListSharedData) SetClipRectFullscreen(v ImVec4) {
+	C.ImDrawListSharedData_SetClipRectFullscreen(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetSliderCurrentAccum() float32 {
-	return float32(C.ImGuiContext_GetSliderCurrentAccum(self.handle()))
+func (self ImDrawListSharedData) GetClipRectFullscreen() ImVec4 {
+	return newImVec4FromC(C.ImDrawListSharedData_GetClipRectFullscreen(self.handle()))
 }
 
-func (self ImGuiContext) SetSliderCurrentAccumDirty(v bool) {
-	C.ImGuiContext_SetSliderCurrentAccumDirty(self.handle(), C.bool(v))
+func (self ImDrawListSharedData) SetInitialFlags(v ImDrawListFlags) {
+	C.ImDrawListSharedData_SetInitialFlags(self.handle(), C.ImDrawListFlags(v))
 }
 
-func (self ImGuiContext) GetSliderCurrentAccumDirty() bool {
-	return C.ImGuiContext_GetSliderCurrentAccumDirty(self.handle()) == C.bool(true)
+func (self ImDrawListSharedData) GetInitialFlags() ImDrawListFlags {
+	return ImDrawListFlags(C.ImDrawListSharedData_GetInitialFlags(self.handle()))
 }
 
-func (self ImGuiContext) SetDragCurrentAccumDirty(v bool) {
-	C.ImGuiContext_SetDragCurrentAccumDirty(self.handle(), C.bool(v))
+func (self ImDrawListSharedData) SetArcFastRadiusCutoff(v float32) {
+	C.ImDrawListSharedData_SetArcFastRadiusCutoff(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetDragCurrentAccumDirty() bool {
-	return C.ImGuiContext_GetDragCurrentAccumDirty(self.handle()) == C.bool(true)
+func (self ImDrawListSharedData) GetArcFastRadiusCutoff() float32 {
+	return float32(C.ImDrawListSharedData_GetArcFastRadiusCutoff(self.handle()))
 }
 
-func (self ImGuiContext) SetDragCurrentAccum(v float32) {
-	C.ImGuiContext_SetDragCurrentAccum(self.handle(), C.float(v))
+func (self ImDrawListSharedData) SetTexUvLines(v *ImVec4) {
+	vArg, vFin := v.wrap()
+	defer vFin()
+
+	C.ImDrawListSharedData_SetTexUvLines(self.handle(), vArg)
 }
 
-func (self ImGuiContext) GetDragCurrentAccum() float32 {
-	return float32(C.ImGuiContext_GetDragCurrentAccum(self.handle()))
+func (self ImDrawListSharedData) GetTexUvLines() ImVec4 {
+	return newImVec4FromCPtr(C.ImDrawListSharedData_GetTexUvLines(self.handle()))
 }
 
-func (self ImGuiContext) SetDragSpeedDefaultRatio(v float32) {
-	C.ImGuiContext_SetDragSpeedDefaultRatio(self.handle(), C.float(v))
+func (self ImGuiDataTypeInfo) SetSize(v uint64) {
+	C.ImGuiDataTypeInfo_SetSize(self.handle(), C.xlong(v))
 }
 
-func (self ImGuiContext) GetDragSpeedDefaultRatio() float32 {
-	return float32(C.ImGuiContext_GetDragSpeedDefaultRatio(self.handle()))
+func (self ImGuiDataTypeInfo) GetSize() float64 {
+	return float64(C.ImGuiDataTypeInfo_GetSize(self.handle()))
 }
 
-func (self ImGuiContext) SetScrollbarClickDeltaToGrabCenter(v float32) {
-	C.ImGuiContext_SetScrollbarClickDeltaToGrabCenter(self.handle(), C.float(v))
-}
+func (self ImGuiDataTypeInfo) SetName(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
 
-func (self ImGuiContext) GetScrollbarClickDeltaToGrabCenter() float32 {
-	return float32(C.ImGuiContext_GetScrollbarClickDeltaToGrabCenter(self.handle()))
+	C.ImGuiDataTypeInfo_SetName(self.handle(), vArg)
 }
 
-func (self ImGuiContext) SetDisabledAlphaBackup(v float32) {
-	C.ImGuiContext_SetDisabledAlphaBackup(self.handle(), C.float(v))
+func (self ImGuiDataTypeInfo) GetName() string {
+	return C.GoString(C.ImGuiDataTypeInfo_GetName(self.handle()))
 }
 
-func (self ImGuiContext) GetDisabledAlphaBackup() float32 {
-	return float32(C.ImGuiContext_GetDisabledAlphaBackup(self.handle()))
-}
+func (self ImGuiDataTypeInfo) SetPrintFmt(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
 
-func (self ImGuiContext) SetDisabledStackSize(v int) {
-	C.ImGuiContext_SetDisabledStackSize(self.handle(), C.short(v))
+	C.ImGuiDataTypeInfo_SetPrintFmt(self.handle(), vArg)
 }
 
-func (self ImGuiContext) GetDisabledStackSize() int {
-	return int(C.ImGuiContext_GetDisabledStackSize(self.handle()))
+func (self ImGuiDataTypeInfo) GetPrintFmt() string {
+	return C.GoString(C.ImGuiDataTypeInfo_GetPrintFmt(self.handle()))
 }
 
-func (self ImGuiContext) SetTooltipOverrideCount(v int) {
-	C.ImGuiContext_SetTooltipOverrideCount(self.handle(), C.short(v))
-}
+func (self ImGuiDataTypeInfo) SetScanFmt(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
 
-func (self ImGuiContext) GetTooltipOverrideCount() int {
-	return int(C.ImGuiContext_GetTooltipOverrideCount(self.handle()))
+	C.ImGuiDataTypeInfo_SetScanFmt(self.handle(), vArg)
 }
 
-func (self ImGuiContext) SetTooltipSlowDelay(v float32) {
-	C.ImGuiContext_SetTooltipSlowDelay(self.handle(), C.float(v))
+func (self ImGuiDataTypeInfo) GetScanFmt() string {
+	return C.GoString(C.ImGuiDataTypeInfo_GetScanFmt(self.handle()))
 }
 
-func (self ImGuiContext) GetTooltipSlowDelay() float32 {
-	return float32(C.ImGuiContext_GetTooltipSlowDelay(self.handle()))
+func (self ImGuiColorMod) SetCol(v ImGuiCol) {
+	C.ImGuiColorMod_SetCol(self.handle(), C.ImGuiCol(v))
 }
 
-func (self ImGuiContext) GetPlatformImeData() ImGuiPlatformImeData {
-	return newImGuiPlatformImeDataFromC(C.ImGuiContext_GetPlatformImeData(self.handle()))
+func (self ImGuiColorMod) GetCol() ImGuiCol {
+	return ImGuiCol(C.ImGuiColorMod_GetCol(self.handle()))
 }
 
-func (self ImGuiContext) GetPlatformImeDataPrev() ImGuiPlatformImeData {
-	return newImGuiPlatformImeDataFromC(C.ImGuiContext_GetPlatformImeDataPrev(self.handle()))
+func (self ImGuiColorMod) SetBackupValue(v ImVec4) {
+	C.ImGuiColorMod_SetBackupValue(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetPlatformImeViewport(v ImGuiID) {
-	C.ImGuiContext_SetPlatformImeViewport(self.handle(), C.ImGuiID(v))
+func (self ImGuiColorMod) GetBackupValue() ImVec4 {
+	return newImVec4FromC(C.ImGuiColorMod_GetBackupValue(self.handle()))
 }
 
-func (self ImGuiContext) GetPlatformImeViewport() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetPlatformImeViewport(self.handle()))
+func (self ImGuiStyleMod) SetVarIdx(v ImGuiStyleVar) {
+	C.ImGuiStyleMod_SetVarIdx(self.handle(), C.ImGuiStyleVar(v))
 }
 
-func (self ImGuiContext) GetDockContext() ImGuiDockContext {
-	return newImGuiDockContextFromC(C.ImGuiContext_GetDockContext(self.handle()))
+func (self ImGuiStyleMod) GetVarIdx() ImGuiStyleVar {
+	return ImGuiStyleVar(C.ImGuiStyleMod_GetVarIdx(self.handle()))
 }
 
-func (self ImGuiContext) SetSettingsLoaded(v bool) {
-	C.ImGuiContext_SetSettingsLoaded(self.handle(), C.bool(v))
+func (self ImGuiComboPreviewData) SetPreviewRect(v ImRect) {
+	C.ImGuiComboPreviewData_SetPreviewRect(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetSettingsLoaded() bool {
-	return C.ImGuiContext_GetSettingsLoaded(self.handle()) == C.bool(true)
+func (self ImGuiComboPreviewData) GetPreviewRect() ImRect {
+	return newImRectFromC(C.ImGuiComboPreviewData_GetPreviewRect(self.handle()))
 }
 
-func (self ImGuiContext) SetSettingsDirtyTimer(v float32) {
-	C.ImGuiContext_SetSettingsDirtyTimer(self.handle(), C.float(v))
+func (self ImGuiComboPreviewData) SetBackupCursorPos(v ImVec2) {
+	C.ImGuiComboPreviewData_SetBackupCursorPos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetSettingsDirtyTimer() float32 {
-	return float32(C.ImGuiContext_GetSettingsDirtyTimer(self.handle()))
+func (self ImGuiComboPreviewData) GetBackupCursorPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiComboPreviewData_GetBackupCursorPos(self.handle()))
 }
 
-func (self ImGuiContext) GetSettingsIniData() ImGuiTextBuffer {
-	return newImGuiTextBufferFromC(C.ImGuiContext_GetSettingsIniData(self.handle()))
+func (self ImGuiComboPreviewData) SetBackupCursorMaxPos(v ImVec2) {
+	C.ImGuiComboPreviewData_SetBackupCursorMaxPos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetHookIdNext(v ImGuiID) {
-	C.ImGuiContext_SetHookIdNext(self.handle(), C.ImGuiID(v))
+func (self ImGuiComboPreviewData) GetBackupCursorMaxPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiComboPreviewData_GetBackupCursorMaxPos(self.handle()))
 }
 
-func (self ImGuiContext) GetHookIdNext() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetHookIdNext(self.handle()))
+func (self ImGuiComboPreviewData) SetBackupCursorPosPrevLine(v ImVec2) {
+	C.ImGuiComboPreviewData_SetBackupCursorPosPrevLine(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetLogEnabled(v bool) {
-	C.ImGuiContext_SetLogEnabled(self.handle(), C.bool(v))
+func (self ImGuiComboPreviewData) GetBackupCursorPosPrevLine() ImVec2 {
+	return newImVec2FromC(C.ImGuiComboPreviewData_GetBackupCursorPosPrevLine(self.handle()))
 }
 
-func (self ImGuiContext) GetLogEnabled() bool {
-	return C.ImGuiContext_GetLogEnabled(self.handle()) == C.bool(true)
+func (self ImGuiComboPreviewData) SetBackupPrevLineTextBaseOffset(v float32) {
+	C.ImGuiComboPreviewData_SetBackupPrevLineTextBaseOffset(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) SetLogType(v ImGuiLogType) {
-	C.ImGuiContext_SetLogType(self.handle(), C.ImGuiLogType(v))
+func (self ImGuiComboPreviewData) GetBackupPrevLineTextBaseOffset() float32 {
+	return float32(C.ImGuiComboPreviewData_GetBackupPrevLineTextBaseOffset(self.handle()))
 }
 
-func (self ImGuiContext) GetLogType() ImGuiLogType {
-	return ImGuiLogType(C.ImGuiContext_GetLogType(self.handle()))
+func (self ImGuiComboPreviewData) SetBackupLayout(v ImGuiLayoutType) {
+	C.ImGuiComboPreviewData_SetBackupLayout(self.handle(), C.ImGuiLayoutType(v))
 }
 
-func (self ImGuiContext) GetLogBuffer() ImGuiTextBuffer {
-	return newImGuiTextBufferFromC(C.ImGuiContext_GetLogBuffer(self.handle()))
+func (self ImGuiComboPreviewData) GetBackupLayout() ImGuiLayoutType {
+	return ImGuiLayoutType(C.ImGuiComboPreviewData_GetBackupLayout(self.handle()))
 }
 
-func (self ImGuiContext) SetLogNextPrefix(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiGroupData) SetWindowID(v ImGuiID) {
+	C.ImGuiGroupData_SetWindowID(self.handle(), C.ImGuiID(v))
+}
 
-	C.ImGuiContext_SetLogNextPrefix(self.handle(), vArg)
+func (self ImGuiGroupData) GetWindowID() ImGuiID {
+	return ImGuiID(C.ImGuiGroupData_GetWindowID(self.handle()))
 }
 
-func (self ImGuiContext) GetLogNextPrefix() string {
-	return C.GoString(C.ImGuiContext_GetLogNextPrefix(self.handle()))
+func (self ImGuiGroupData) SetBackupCursorPos(v ImVec2) {
+	C.ImGuiGroupData_SetBackupCursorPos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) SetLogNextSuffix(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiGroupData) GetBackupCursorPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiGroupData_GetBackupCursorPos(self.handle()))
+}
 
-	C.ImGuiContext_SetLogNextSuffix(self.handle(), vArg)
+func (self ImGuiGroupData) SetBackupCursorMaxPos(v ImVec2) {
+	C.ImGuiGroupData_SetBackupCursorMaxPos(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetLogNextSuffix() string {
-	return C.GoString(C.ImGuiContext_GetLogNextSuffix(self.handle()))
+func (self ImGuiGroupData) GetBackupCursorMaxPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiGroupData_GetBackupCursorMaxPos(self.handle()))
 }
 
-func (self ImGuiContext) SetLogLinePosY(v float32) {
-	C.ImGuiContext_SetLogLinePosY(self.handle(), C.float(v))
+func (self ImGuiGroupData) SetBackupCurrLineSize(v ImVec2) {
+	C.ImGuiGroupData_SetBackupCurrLineSize(self.handle(), v.toC())
 }
 
-func (self ImGuiContext) GetLogLinePosY() float32 {
-	return float32(C.ImGuiContext_GetLogLinePosY(self.handle()))
+func (self ImGuiGroupData) GetBackupCurrLineSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiGroupData_GetBackupCurrLineSize(self.handle()))
 }
 
-func (self ImGuiContext) SetLogLineFirstItem(v bool) {
-	C.ImGuiContext_SetLogLineFirstItem(self.handle(), C.bool(v))
+func (self ImGuiGroupData) SetBackupCurrLineTextBaseOffset(v float32) {
+	C.ImGuiGroupData_SetBackupCurrLineTextBaseOffset(self.handle(), C.float(v))
 }
 
-func (self ImGuiContext) GetLogLineFirstItem() bool {
-	return C.ImGuiContext_GetLogLineFirstItem(self.handle()) == C.bool(true)
+func (self ImGuiGroupData) GetBackupCurrLineTextBaseOffset() float32 {
+	return float32(C.ImGuiGroupData_GetBackupCurrLineTextBaseOffset(self.handle()))
 }
 
-func (self ImGuiContext) SetLogDepthRef(v int32) {
-	C.ImGuiContext_SetLogDepthRef(self.handle(), C.int(v))
+func (self ImGuiGroupData) SetBackupActiveIdIsAlive(v ImGuiID) {
+	C.ImGuiGroupData_SetBackupActiveIdIsAlive(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiContext) GetLogDepthRef() int {
-	return int(C.ImGuiContext_GetLogDepthRef(self.handle()))
+func (self ImGuiGroupData) GetBackupActiveIdIsAlive() ImGuiID {
+	return ImGuiID(C.ImGuiGroupData_GetBackupActiveIdIsAlive(self.handle()))
 }
 
-func (self ImGuiContext) SetLogDepthToExpand(v int32) {
-	C.ImGuiContext_SetLogDepthToExpand(self.handle(), C.int(v))
+func (self ImGuiGroupData) SetBackupActiveIdPreviousFrameIsAlive(v bool) {
+	C.ImGuiGroupData_SetBackupActiveIdPreviousFrameIsAlive(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetLogDepthToExpand() int {
-	return int(C.ImGuiContext_GetLogDepthToExpand(self.handle()))
+func (self ImGuiGroupData) GetBackupActiveIdPreviousFrameIsAlive() bool {
+	return C.ImGuiGroupData_GetBackupActiveIdPreviousFrameIsAlive(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) SetLogDepthToExpandDefault(v int32) {
-	C.ImGuiContext_SetLogDepthToExpandDefault(self.handle(), C.int(v))
+func (self ImGuiGroupData) SetBackupHoveredIdIsAlive(v bool) {
+	C.ImGuiGroupData_SetBackupHoveredIdIsAlive(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetLogDepthToExpandDefault() int {
-	return int(C.ImGuiContext_GetLogDepthToExpandDefault(self.handle()))
+func (self ImGuiGroupData) GetBackupHoveredIdIsAlive() bool {
+	return C.ImGuiGroupData_GetBackupHoveredIdIsAlive(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) SetDebugLogFlags(v ImGuiDebugLogFlags) {
-	C.ImGuiContext_SetDebugLogFlags(self.handle(), C.ImGuiDebugLogFlags(v))
+func (self ImGuiGroupData) SetEmitItem(v bool) {
+	C.ImGuiGroupData_SetEmitItem(self.handle(), C.bool(v))
 }
 
-func (self ImGuiContext) GetDebugLogFlags() ImGuiDebugLogFlags {
-	return ImGuiDebugLogFlags(C.ImGuiContext_GetDebugLogFlags(self.handle()))
+func (self ImGuiGroupData) GetEmitItem() bool {
+	return C.ImGuiGroupData_GetEmitItem(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiContext) GetDebugLogBuf() ImGuiTextBuffer {
-	return newImGuiTextBufferFromC(C.ImGuiContext_GetDebugLogBuf(self.handle()))
+func (self ImGuiMenuColumns) SetTotalWidth(v uint32) {
+	C.ImGuiMenuColumns_SetTotalWidth(self.handle(), C.ImU32(v))
 }
 
-func (self ImGuiContext) SetDebugItemPickerActive(v bool) {
-	C.ImGuiContext_SetDebugItemPickerActive(self.handle(), C.bool(v))
+func (self ImGuiMenuColumns) GetTotalWidth() uint32 {
+	return uint32(C.ImGuiMenuColumns_GetTotalWidth(self.handle()))
 }
 
-func (self ImGuiContext) GetDebugItemPickerActive() bool {
-	return C.ImGuiContext_GetDebugItemPickerActive(self.handle()) == C.bool(true)
+func (self ImGuiMenuColumns) SetNextTotalWidth(v uint32) {
+	C.ImGuiMenuColumns_SetNextTotalWidth(self.handle(), C.ImU32(v))
 }
 
-func (self ImGuiContext) SetDebugItemPickerMouseButton(v uint) {
-	C.ImGuiContext_SetDebugItemPickerMouseButton(self.handle(), C.ImU8(v))
+func (self ImGuiMenuColumns) GetNextTotalWidth() uint32 {
+	return uint32(C.ImGuiMenuColumns_GetNextTotalWidth(self.handle()))
 }
 
-func (self ImGuiContext) GetDebugItemPickerMouseButton() uint32 {
-	return uint32(C.ImGuiContext_GetDebugItemPickerMouseButton(self.handle()))
+func (self ImGuiMenuColumns) SetSpacing(v uint) {
+	C.ImGuiMenuColumns_SetSpacing(self.handle(), C.ImU16(v))
 }
 
-func (self ImGuiContext) SetDebugItemPickerBreakId(v ImGuiID) {
-	C.ImGuiContext_SetDebugItemPickerBreakId(self.handle(), C.ImGuiID(v))
+func (self ImGuiMenuColumns) GetSpacing() uint32 {
+	return uint32(C.ImGuiMenuColumns_GetSpacing(self.handle()))
 }
 
-func (self ImGuiContext) GetDebugItemPickerBreakId() ImGuiID {
-	return ImGuiID(C.ImGuiContext_GetDebugItemPickerBreakId(self.handle()))
+func (self ImGuiMenuColumns) SetOffsetIcon(v uint) {
+	C.ImGuiMenuColumns_SetOffsetIcon(self.handle(), C.ImU16(v))
 }
 
-func (self ImGuiContext) GetDebugMetricsConfig() ImGuiMetricsConfig {
-	return newImGuiMetricsConfigFromC(C.ImGuiContext_GetDebugMetricsConfig(self.handle()))
+func (self ImGuiMenuColumns) GetOffsetIcon() uint32 {
+	return uint32(C.ImGuiMenuColumns_GetOffsetIcon(self.handle()))
 }
 
-func (self ImGuiContext) GetDebugStackTool() ImGuiStackTool {
-	return newImGuiStackToolFromC(C.ImGuiContext_GetDebugStackTool(self.handle()))
+func (self ImGuiMenuColumns) SetOffsetLabel(v uint) {
+	C.ImGuiMenuColumns_SetOffsetLabel(self.handle(), C.ImU16(v))
 }
 
-func (self ImGuiContext) SetFramerateSecPerFrameIdx(v int32) {
-	C.ImGuiContext_SetFramerateSecPerFrameIdx(self.handle(), C.int(v))
+func (self ImGuiMenuColumns) GetOffsetLabel() uint32 {
+	return uint32(C.ImGuiMenuColumns_GetOffsetLabel(self.handle()))
 }
 
-func (self ImGuiContext) GetFramerateSecPerFrameIdx() int {
-	return int(C.ImGuiContext_GetFramerateSecPerFrameIdx(self.handle()))
+func (self ImGuiMenuColumns) SetOffsetShortcut(v uint) {
+	C.ImGuiMenuColumns_SetOffsetShortcut(self.handle(), C.ImU16(v))
 }
 
-func (self ImGuiContext) SetFramerateSecPerFrameCount(v int32) {
-	C.ImGuiContext_SetFramerateSecPerFrameCount(self.handle(), C.int(v))
+func (self ImGuiMenuColumns) GetOffsetShortcut() uint32 {
+	return uint32(C.ImGuiMenuColumns_GetOffsetShortcut(self.handle()))
 }
 
-func (self ImGuiContext) GetFramerateSecPerFrameCount() int {
-	return int(C.ImGuiContext_GetFramerateSecPerFrameCount(self.handle()))
+func (self ImGuiMenuColumns) SetOffsetMark(v uint) {
+	C.ImGuiMenuColumns_SetOffsetMark(self.handle(), C.ImU16(v))
 }
 
-func (self ImGuiContext) SetFramerateSecPerFrameAccum(v float32) {
-	C.ImGuiContext_SetFramerateSecPerFrameAccum(self.handle(), C.float(v))
+func (self ImGuiMenuColumns) GetOffsetMark() uint32 {
+	return uint32(C.ImGuiMenuColumns_GetOffsetMark(self.handle()))
 }
 
-func (self ImGuiContext) GetFramerateSecPerFrameAccum() float32 {
-	return float32(C.ImGuiContext_GetFramerateSecPerFrameAccum(self.handle()))
+func (self ImGuiInputTextState) SetID(v ImGuiID) {
+	C.ImGuiInputTextState_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiContext) SetWantCaptureMouseNextFrame(v int32) {
-	C.ImGuiContext_SetWantCaptureMouseNextFrame(self.handle(), C.int(v))
+func (self ImGuiInputTextState) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiInputTextState_GetID(self.handle()))
 }
 
-func (self ImGuiContext) GetWantCaptureMouseNextFrame() int {
-	return int(C.ImGuiContext_GetWantCaptureMouseNextFrame(self.handle()))
+func (self ImGuiInputTextState) SetCurLenW(v int32) {
+	C.ImGuiInputTextState_SetCurLenW(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetWantCaptureKeyboardNextFrame(v int32) {
-	C.ImGuiContext_SetWantCaptureKeyboardNextFrame(self.handle(), C.int(v))
+func (self ImGuiInputTextState) GetCurLenW() int {
+	return int(C.ImGuiInputTextState_GetCurLenW(self.handle()))
 }
 
-func (self ImGuiContext) GetWantCaptureKeyboardNextFrame() int {
-	return int(C.ImGuiContext_GetWantCaptureKeyboardNextFrame(self.handle()))
+func (self ImGuiInputTextState) SetCurLenA(v int32) {
+	C.ImGuiInputTextState_SetCurLenA(self.handle(), C.int(v))
 }
 
-func (self ImGuiContext) SetWantTextInputNextFrame(v int32) {
-	C.ImGuiContext_SetWantTextInputNextFrame(self.handle(), C.int(v))
+func (self ImGuiInputTextState) GetCurLenA() int {
+	return int(C.ImGuiInputTextState_GetCurLenA(self.handle()))
 }
 
-func (self ImGuiContext) GetWantTextInputNextFrame() int {
-	return int(C.ImGuiContext_GetWantTextInputNextFrame(self.handle()))
+func (self ImGuiInputTextState) SetTextAIsValid(v bool) {
+	C.ImGuiInputTextState_SetTextAIsValid(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextCallbackData) SetEventFlag(v ImGuiInputTextFlags) {
-	C.ImGuiInputTextCallbackData_SetEventFlag(self.handle(), C.ImGuiInputTextFlags(v))
+func (self ImGuiInputTextState) GetTextAIsValid() bool {
+	return C.ImGuiInputTextState_GetTextAIsValid(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextCallbackData) GetEventFlag() ImGuiInputTextFlags {
-	return ImGuiInputTextFlags(C.ImGuiInputTextCallbackData_GetEventFlag(self.handle()))
+func (self ImGuiInputTextState) SetBufCapacityA(v int32) {
+	C.ImGuiInputTextState_SetBufCapacityA(self.handle(), C.int(v))
 }
 
-func (self ImGuiInputTextCallbackData) SetFlags(v ImGuiInputTextFlags) {
-	C.ImGuiInputTextCallbackData_SetFlags(self.handle(), C.ImGuiInputTextFlags(v))
+func (self ImGuiInputTextState) GetBufCapacityA() int {
+	return int(C.ImGuiInputTextState_GetBufCapacityA(self.handle()))
 }
 
-func (self ImGuiInputTextCallbackData) GetFlags() ImGuiInputTextFlags {
-	return ImGuiInputTextFlags(C.ImGuiInputTextCallbackData_GetFlags(self.handle()))
+func (self ImGuiInputTextState) SetScrollX(v float32) {
+	C.ImGuiInputTextState_SetScrollX(self.handle(), C.float(v))
 }
 
-func (self ImGuiInputTextCallbackData) SetUserData(v unsafe.Pointer) {
-	C.ImGuiInputTextCallbackData_SetUserData(self.handle(), v)
+func (self ImGuiInputTextState) GetScrollX() float32 {
+	return float32(C.ImGuiInputTextState_GetScrollX(self.handle()))
 }
 
-func (self ImGuiInputTextCallbackData) GetUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiInputTextCallbackData_GetUserData(self.handle()))
+func (self ImGuiInputTextState) SetCursorAnim(v float32) {
+	C.ImGuiInputTextState_SetCursorAnim(self.handle(), C.float(v))
 }
 
-func (self ImGuiInputTextCallbackData) SetEventChar(v ImWchar) {
-	C.ImGuiInputTextCallbackData_SetEventChar(self.handle(), C.ImWchar(v))
+func (self ImGuiInputTextState) GetCursorAnim() float32 {
+	return float32(C.ImGuiInputTextState_GetCursorAnim(self.handle()))
 }
 
-func (self ImGuiInputTextCallbackData) SetEventKey(v ImGuiKey) {
-	C.ImGuiInputTextCallbackData_SetEventKey(self.handle(), C.ImGuiKey(v))
+func (self ImGuiInputTextState) SetCursorFollow(v bool) {
+	C.ImGuiInputTextState_SetCursorFollow(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextCallbackData) GetEventKey() ImGuiKey {
-	return ImGuiKey(C.ImGuiInputTextCallbackData_GetEventKey(self.handle()))
+func (self ImGuiInputTextState) GetCursorFollow() bool {
+	return C.ImGuiInputTextState_GetCursorFollow(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextCallbackData) SetBuf(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiInputTextState) SetSelectedAllMouseLock(v bool) {
+	C.ImGuiInputTextState_SetSelectedAllMouseLock(self.handle(), C.bool(v))
+}
 
-	C.ImGuiInputTextCallbackData_SetBuf(self.handle(), vArg)
+func (self ImGuiInputTextState) GetSelectedAllMouseLock() bool {
+	return C.ImGuiInputTextState_GetSelectedAllMouseLock(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextCallbackData) SetBufTextLen(v int32) {
-	C.ImGuiInputTextCallbackData_SetBufTextLen(self.handle(), C.int(v))
+func (self ImGuiInputTextState) SetEdited(v bool) {
+	C.ImGuiInputTextState_SetEdited(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextCallbackData) GetBufTextLen() int {
-	return int(C.ImGuiInputTextCallbackData_GetBufTextLen(self.handle()))
+func (self ImGuiInputTextState) GetEdited() bool {
+	return C.ImGuiInputTextState_GetEdited(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextCallbackData) SetBufSize(v int32) {
-	C.ImGuiInputTextCallbackData_SetBufSize(self.handle(), C.int(v))
+func (self ImGuiInputTextState) SetFlags(v ImGuiInputTextFlags) {
+	C.ImGuiInputTextState_SetFlags(self.handle(), C.ImGuiInputTextFlags(v))
 }
 
-func (self ImGuiInputTextCallbackData) GetBufSize() int {
-	return int(C.ImGuiInputTextCallbackData_GetBufSize(self.handle()))
+func (self ImGuiInputTextState) GetFlags() ImGuiInputTextFlags {
+	return ImGuiInputTextFlags(C.ImGuiInputTextState_GetFlags(self.handle()))
 }
 
-func (self ImGuiInputTextCallbackData) SetBufDirty(v bool) {
-	C.ImGuiInputTextCallbackData_SetBufDirty(self.handle(), C.bool(v))
+func (self ImGuiPopupData) SetPopupId(v ImGuiID) {
+	C.ImGuiPopupData_SetPopupId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiInputTextCallbackData) GetBufDirty() bool {
-	return C.ImGuiInputTextCallbackData_GetBufDirty(self.handle()) == C.bool(true)
+func (self ImGuiPopupData) GetPopupId() ImGuiID {
+	return ImGuiID(C.ImGuiPopupData_GetPopupId(self.handle()))
 }
 
-func (self ImGuiInputTextCallbackData) SetCursorPos(v int32) {
-	C.ImGuiInputTextCallbackData_SetCursorPos(self.handle(), C.int(v))
+func (self ImGuiPopupData) SetWindow(v ImGuiWindow) {
+	C.ImGuiPopupData_SetWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiInputTextCallbackData) GetCursorPos() int {
-	return int(C.ImGuiInputTextCallbackData_GetCursorPos(self.handle()))
+func (self ImGuiPopupData) GetWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiPopupData_GetWindow(self.handle())))
 }
 
-func (self ImGuiInputTextCallbackData) SetSelectionStart(v int32) {
-	C.ImGuiInputTextCallbackData_SetSelectionStart(self.handle(), C.int(v))
+func (self ImGuiPopupData) SetSourceWindow(v ImGuiWindow) {
+	C.ImGuiPopupData_SetSourceWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiInputTextCallbackData) GetSelectionStart() int {
-	return int(C.ImGuiInputTextCallbackData_GetSelectionStart(self.handle()))
+func (self ImGuiPopupData) GetSourceWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiPopupData_GetSourceWindow(self.handle())))
 }
 
-func (self ImGuiInputTextCallbackData) SetSelectionEnd(v int32) {
-	C.ImGuiInputTextCallbackData_SetSelectionEnd(self.handle(), C.int(v))
+func (self ImGuiPopupData) SetParentNavLayer(v int32) {
+	C.ImGuiPopupData_SetParentNavLayer(self.handle(), C.int(v))
 }
 
-func (self ImGuiInputTextCallbackData) GetSelectionEnd() int {
-	return int(C.ImGuiInputTextCallbackData_GetSelectionEnd(self.handle()))
+func (self ImGuiPopupData) GetParentNavLayer() int {
+	return int(C.ImGuiPopupData_GetParentNavLayer(self.handle()))
 }
 
-func (self ImGuiKeyData) SetDown(v bool) {
-	C.ImGuiKeyData_SetDown(self.handle(), C.bool(v))
+func (self ImGuiPopupData) SetOpenFrameCount(v int32) {
+	C.ImGuiPopupData_SetOpenFrameCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiKeyData) GetDown() bool {
-	return C.ImGuiKeyData_GetDown(self.handle()) == C.bool(true)
+func (self ImGuiPopupData) GetOpenFrameCount() int {
+	return int(C.ImGuiPopupData_GetOpenFrameCount(self.handle()))
 }
 
-func (self ImGuiKeyData) SetDownDuration(v float32) {
-	C.ImGuiKeyData_SetDownDuration(self.handle(), C.float(v))
+func (self ImGuiPopupData) SetOpenParentId(v ImGuiID) {
+	C.ImGuiPopupData_SetOpenParentId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiKeyData) GetDownDuration() float32 {
-	return float32(C.ImGuiKeyData_GetDownDuration(self.handle()))
+func (self ImGuiPopupData) GetOpenParentId() ImGuiID {
+	return ImGuiID(C.ImGuiPopupData_GetOpenParentId(self.handle()))
 }
 
-func (self ImGuiKeyData) SetDownDurationPrev(v float32) {
-	C.ImGuiKeyData_SetDownDurationPrev(self.handle(), C.float(v))
+func (self ImGuiPopupData) SetOpenPopupPos(v ImVec2) {
+	C.ImGuiPopupData_SetOpenPopupPos(self.handle(), v.toC())
 }
 
-func (self ImGuiKeyData) GetDownDurationPrev() float32 {
-	return float32(C.ImGuiKeyData_GetDownDurationPrev(self.handle()))
+func (self ImGuiPopupData) GetOpenPopupPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiPopupData_GetOpenPopupPos(self.handle()))
 }
 
-func (self ImGuiKeyData) SetAnalogValue(v float32) {
-	C.ImGuiKeyData_SetAnalogValue(self.handle(), C.float(v))
+func (self ImGuiPopupData) SetOpenMousePos(v ImVec2) {
+	C.ImGuiPopupData_SetOpenMousePos(self.handle(), v.toC())
 }
 
-func (self ImGuiKeyData) GetAnalogValue() float32 {
-	return float32(C.ImGuiKeyData_GetAnalogValue(self.handle()))
+func (self ImGuiPopupData) GetOpenMousePos() ImVec2 {
+	return newImVec2FromC(C.ImGuiPopupData_GetOpenMousePos(self.handle()))
 }
 
 func (self ImGuiNextWindowData) SetFlags(v ImGuiNextWindowDataFlags) {
@@ -6097,5714 +8149,5618 @@ func (self ImGuiNextWindowData) GetSizeVal() ImVec2 {
 	return newImVec2FromC(C.ImGuiNextWindowData_GetSizeVal(self.handle()))
 }
 
-func (self ImGuiNextWindowData) SetContentSizeVal(v ImVec2) {
-	C.ImGuiNextWindowData_SetContentSizeVal(self.handle(), v.toC())
-}
-
-func (self ImGuiNextWindowData) GetContentSizeVal() ImVec2 {
-	return newImVec2FromC(C.ImGuiNextWindowData_GetContentSizeVal(self.handle()))
-}
-
-func (self ImGuiNextWindowData) SetScrollVal(v ImVec2) {
-	C.ImGuiNextWindowData_SetScrollVal(self.handle(), v.toC())
-}
-
-func (self ImGuiNextWindowData) GetScrollVal() ImVec2 {
-	return newImVec2FromC(C.ImGuiNextWindowData_GetScrollVal(self.handle()))
-}
-
-func (self ImGuiNextWindowData) SetPosUndock(v bool) {
-	C.ImGuiNextWindowData_SetPosUndock(self.handle(), C.bool(v))
-}
-
-func (self ImGuiNextWindowData) GetPosUndock() bool {
-	return C.ImGuiNextWindowData_GetPosUndock(self.handle()) == C.bool(true)
-}
-
-func (self ImGuiNextWindowData) SetCollapsedVal(v bool) {
-	C.ImGuiNextWindowData_SetCollapsedVal(self.handle(), C.bool(v))
-}
-
-func (self ImGuiNextWindowData) GetCollapsedVal() bool {
-	return C.ImGuiNextWindowData_GetCollapsedVal(self.handle()) == C.bool(true)
-}
-
-func (self ImGuiNextWindowData) SetSizeConstraintRect(v ImRect) {
-	C.ImGuiNextWindowData_SetSizeConstraintRect(self.handle(), v.toC())
-}
-
-func (self ImGuiNextWindowData) GetSizeConstraintRect() ImRect {
-	return newImRectFromC(C.ImGuiNextWindowData_GetSizeConstraintRect(self.handle()))
-}
-
-func (self ImGuiNextWindowData) SetSizeCallbackUserData(v unsafe.Pointer) {
-	C.ImGuiNextWindowData_SetSizeCallbackUserData(self.handle(), v)
-}
-
-func (self ImGuiNextWindowData) GetSizeCallbackUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiNextWindowData_GetSizeCallbackUserData(self.handle()))
-}
-
-func (self ImGuiNextWindowData) SetBgAlphaVal(v float32) {
-	C.ImGuiNextWindowData_SetBgAlphaVal(self.handle(), C.float(v))
-}
-
-func (self ImGuiNextWindowData) GetBgAlphaVal() float32 {
-	return float32(C.ImGuiNextWindowData_GetBgAlphaVal(self.handle()))
-}
-
-func (self ImGuiNextWindowData) SetViewportId(v ImGuiID) {
-	C.ImGuiNextWindowData_SetViewportId(self.handle(), C.ImGuiID(v))
-}
-
-func (self ImGuiNextWindowData) GetViewportId() ImGuiID {
-	return ImGuiID(C.ImGuiNextWindowData_GetViewportId(self.handle()))
-}
-
-func (self ImGuiNextWindowData) SetDockId(v ImGuiID) {
-	C.ImGuiNextWindowData_SetDockId(self.handle(), C.ImGuiID(v))
-}
-
-func (self ImGuiNextWindowData) GetDockId() ImGuiID {
-	return ImGuiID(C.ImGuiNextWindowData_GetDockId(self.handle()))
-}
-
-func (self ImGuiNextWindowData) GetWindowClass() ImGuiWindowClass {
-	return newImGuiWindowClassFromC(C.ImGuiNextWindowData_GetWindowClass(self.handle()))
-}
-
-func (self ImGuiNextWindowData) SetMenuBarOffsetMinVal(v ImVec2) {
-	C.ImGuiNextWindowData_SetMenuBarOffsetMinVal(self.handle(), v.toC())
-}
-
-func (self ImGuiNextWindowData) GetMenuBarOffsetMinVal() ImVec2 {
-	return newImVec2FromC(C.ImGuiNextWindowData_GetMenuBarOffsetMinVal(self.handle()))
-}
-
-func (self ImGuiStackTool) SetLastActiveFrame(v int32) {
-	C.ImGuiStackTool_SetLastActiveFrame(self.handle(), C.int(v))
-}
-
-func (self ImGuiStackTool) GetLastActiveFrame() int {
-	return int(C.ImGuiStackTool_GetLastActiveFrame(self.handle()))
-}
-
-func (self ImGuiStackTool) SetStackLevel(v int32) {
-	C.ImGuiStackTool_SetStackLevel(self.handle(), C.int(v))
-}
-
-func (self ImGuiStackTool) GetStackLevel() int {
-	return int(C.ImGuiStackTool_GetStackLevel(self.handle()))
-}
-
-func (self ImGuiStackTool) SetQueryId(v ImGuiID) {
-	C.ImGuiStackTool_SetQueryId(self.handle(), C.ImGuiID(v))
-}
-
-func (self ImGuiStackTool) GetQueryId() ImGuiID {
-	return ImGuiID(C.ImGuiStackTool_GetQueryId(self.handle()))
+func (self ImGuiNextWindowData) SetContentSizeVal(v ImVec2) {
+	C.ImGuiNextWindowData_SetContentSizeVal(self.handle(), v.toC())
 }
 
-func (self ImGuiStackTool) SetCopyToClipboardOnCtrlC(v bool) {
-	C.ImGuiStackTool_SetCopyToClipboardOnCtrlC(self.handle(), C.bool(v))
+func (self ImGuiNextWindowData) GetContentSizeVal() ImVec2 {
+	return newImVec2FromC(C.ImGuiNextWindowData_GetContentSizeVal(self.handle()))
 }
 
-func (self ImGuiStackTool) GetCopyToClipboardOnCtrlC() bool {
-	return C.ImGuiStackTool_GetCopyToClipboardOnCtrlC(self.handle()) == C.bool(true)
+func (self ImGuiNextWindowData) SetScrollVal(v ImVec2) {
+	C.ImGuiNextWindowData_SetScrollVal(self.handle(), v.toC())
 }
 
-func (self ImGuiStackTool) SetCopyToClipboardLastTime(v float32) {
-	C.ImGuiStackTool_SetCopyToClipboardLastTime(self.handle(), C.float(v))
+func (self ImGuiNextWindowData) GetScrollVal() ImVec2 {
+	return newImVec2FromC(C.ImGuiNextWindowData_GetScrollVal(self.handle()))
 }
 
-func (self ImGuiStackTool) GetCopyToClipboardLastTime() float32 {
-	return float32(C.ImGuiStackTool_GetCopyToClipboardLastTime(self.handle()))
+func (self ImGuiNextWindowData) SetPosUndock(v bool) {
+	C.ImGuiNextWindowData_SetPosUndock(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTable) SetID(v ImGuiID) {
-	C.ImGuiTable_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiNextWindowData) GetPosUndock() bool {
+	return C.ImGuiNextWindowData_GetPosUndock(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTable) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiTable_GetID(self.handle()))
+func (self ImGuiNextWindowData) SetCollapsedVal(v bool) {
+	C.ImGuiNextWindowData_SetCollapsedVal(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTable) SetFlags(v ImGuiTableFlags) {
-	C.ImGuiTable_SetFlags(self.handle(), C.ImGuiTableFlags(v))
+func (self ImGuiNextWindowData) GetCollapsedVal() bool {
+	return C.ImGuiNextWindowData_GetCollapsedVal(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTable) GetFlags() ImGuiTableFlags {
-	return ImGuiTableFlags(C.ImGuiTable_GetFlags(self.handle()))
+func (self ImGuiNextWindowData) SetSizeConstraintRect(v ImRect) {
+	C.ImGuiNextWindowData_SetSizeConstraintRect(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) SetRawData(v unsafe.Pointer) {
-	C.ImGuiTable_SetRawData(self.handle(), v)
+func (self ImGuiNextWindowData) GetSizeConstraintRect() ImRect {
+	return newImRectFromC(C.ImGuiNextWindowData_GetSizeConstraintRect(self.handle()))
 }
 
-func (self ImGuiTable) GetRawData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiTable_GetRawData(self.handle()))
+func (self ImGuiNextWindowData) SetSizeCallbackUserData(v unsafe.Pointer) {
+	C.ImGuiNextWindowData_SetSizeCallbackUserData(self.handle(), v)
 }
 
-func (self ImGuiTable) SetTempData(v ImGuiTableTempData) {
-	C.ImGuiTable_SetTempData(self.handle(), v.handle())
+func (self ImGuiNextWindowData) GetSizeCallbackUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiNextWindowData_GetSizeCallbackUserData(self.handle()))
 }
 
-func (self ImGuiTable) GetTempData() ImGuiTableTempData {
-	return (ImGuiTableTempData)(unsafe.Pointer(C.ImGuiTable_GetTempData(self.handle())))
+func (self ImGuiNextWindowData) SetBgAlphaVal(v float32) {
+	C.ImGuiNextWindowData_SetBgAlphaVal(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) SetEnabledMaskByDisplayOrder(v uint64) {
-	C.ImGuiTable_SetEnabledMaskByDisplayOrder(self.handle(), C.ImU64(v))
+func (self ImGuiNextWindowData) GetBgAlphaVal() float32 {
+	return float32(C.ImGuiNextWindowData_GetBgAlphaVal(self.handle()))
 }
 
-func (self ImGuiTable) GetEnabledMaskByDisplayOrder() uint64 {
-	return uint64(C.ImGuiTable_GetEnabledMaskByDisplayOrder(self.handle()))
+func (self ImGuiNextWindowData) SetViewportId(v ImGuiID) {
+	C.ImGuiNextWindowData_SetViewportId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTable) SetEnabledMaskByIndex(v uint64) {
-	C.ImGuiTable_SetEnabledMaskByIndex(self.handle(), C.ImU64(v))
+func (self ImGuiNextWindowData) GetViewportId() ImGuiID {
+	return ImGuiID(C.ImGuiNextWindowData_GetViewportId(self.handle()))
 }
 
-func (self ImGuiTable) GetEnabledMaskByIndex() uint64 {
-	return uint64(C.ImGuiTable_GetEnabledMaskByIndex(self.handle()))
+func (self ImGuiNextWindowData) SetDockId(v ImGuiID) {
+	C.ImGuiNextWindowData_SetDockId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTable) SetVisibleMaskByIndex(v uint64) {
-	C.ImGuiTable_SetVisibleMaskByIndex(self.handle(), C.ImU64(v))
+func (self ImGuiNextWindowData) GetDockId() ImGuiID {
+	return ImGuiID(C.ImGuiNextWindowData_GetDockId(self.handle()))
 }
 
-func (self ImGuiTable) GetVisibleMaskByIndex() uint64 {
-	return uint64(C.ImGuiTable_GetVisibleMaskByIndex(self.handle()))
+func (self ImGuiNextWindowData) GetWindowClass() ImGuiWindowClass {
+	return newImGuiWindowClassFromC(C.ImGuiNextWindowData_GetWindowClass(self.handle()))
 }
 
-func (self ImGuiTable) SetRequestOutputMaskByIndex(v uint64) {
-	C.ImGuiTable_SetRequestOutputMaskByIndex(self.handle(), C.ImU64(v))
+func (self ImGuiNextWindowData) SetMenuBarOffsetMinVal(v ImVec2) {
+	C.ImGuiNextWindowData_SetMenuBarOffsetMinVal(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) GetRequestOutputMaskByIndex() uint64 {
-	return uint64(C.ImGuiTable_GetRequestOutputMaskByIndex(self.handle()))
+func (self ImGuiNextWindowData) GetMenuBarOffsetMinVal() ImVec2 {
+	return newImVec2FromC(C.ImGuiNextWindowData_GetMenuBarOffsetMinVal(self.handle()))
 }
 
-func (self ImGuiTable) SetSettingsLoadedFlags(v ImGuiTableFlags) {
-	C.ImGuiTable_SetSettingsLoadedFlags(self.handle(), C.ImGuiTableFlags(v))
+func (self ImGuiNextItemData) SetFlags(v ImGuiNextItemDataFlags) {
+	C.ImGuiNextItemData_SetFlags(self.handle(), C.ImGuiNextItemDataFlags(v))
 }
 
-func (self ImGuiTable) GetSettingsLoadedFlags() ImGuiTableFlags {
-	return ImGuiTableFlags(C.ImGuiTable_GetSettingsLoadedFlags(self.handle()))
+func (self ImGuiNextItemData) GetFlags() ImGuiNextItemDataFlags {
+	return ImGuiNextItemDataFlags(C.ImGuiNextItemData_GetFlags(self.handle()))
 }
 
-func (self ImGuiTable) SetSettingsOffset(v int32) {
-	C.ImGuiTable_SetSettingsOffset(self.handle(), C.int(v))
+func (self ImGuiNextItemData) SetWidth(v float32) {
+	C.ImGuiNextItemData_SetWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetSettingsOffset() int {
-	return int(C.ImGuiTable_GetSettingsOffset(self.handle()))
+func (self ImGuiNextItemData) GetWidth() float32 {
+	return float32(C.ImGuiNextItemData_GetWidth(self.handle()))
 }
 
-func (self ImGuiTable) SetLastFrameActive(v int32) {
-	C.ImGuiTable_SetLastFrameActive(self.handle(), C.int(v))
+func (self ImGuiNextItemData) SetFocusScopeId(v ImGuiID) {
+	C.ImGuiNextItemData_SetFocusScopeId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTable) GetLastFrameActive() int {
-	return int(C.ImGuiTable_GetLastFrameActive(self.handle()))
+func (self ImGuiNextItemData) GetFocusScopeId() ImGuiID {
+	return ImGuiID(C.ImGuiNextItemData_GetFocusScopeId(self.handle()))
 }
 
-func (self ImGuiTable) SetColumnsCount(v int32) {
-	C.ImGuiTable_SetColumnsCount(self.handle(), C.int(v))
+func (self ImGuiNextItemData) SetOpenCond(v ImGuiCond) {
+	C.ImGuiNextItemData_SetOpenCond(self.handle(), C.ImGuiCond(v))
 }
 
-func (self ImGuiTable) GetColumnsCount() int {
-	return int(C.ImGuiTable_GetColumnsCount(self.handle()))
+func (self ImGuiNextItemData) GetOpenCond() ImGuiCond {
+	return ImGuiCond(C.ImGuiNextItemData_GetOpenCond(self.handle()))
 }
 
-func (self ImGuiTable) SetCurrentRow(v int32) {
-	C.ImGuiTable_SetCurrentRow(self.handle(), C.int(v))
+func (self ImGuiNextItemData) SetOpenVal(v bool) {
+	C.ImGuiNextItemData_SetOpenVal(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTable) GetCurrentRow() int {
-	return int(C.ImGuiTable_GetCurrentRow(self.handle()))
+func (self ImGuiNextItemData) GetOpenVal() bool {
+	return C.ImGuiNextItemData_GetOpenVal(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTable) SetCurrentColumn(v int32) {
-	C.ImGuiTable_SetCurrentColumn(self.handle(), C.int(v))
+func (self ImGuiLastItemData) SetID(v ImGuiID) {
+	C.ImGuiLastItemData_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTable) GetCurrentColumn() int {
-	return int(C.ImGuiTable_GetCurrentColumn(self.handle()))
+func (self ImGuiLastItemData) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiLastItemData_GetID(self.handle()))
 }
 
-func (self ImGuiTable) SetInstanceCurrent(v int) {
-	C.ImGuiTable_SetInstanceCurrent(self.handle(), C.ImS16(v))
+func (self ImGuiLastItemData) SetInFlags(v ImGuiItemFlags) {
+	C.ImGuiLastItemData_SetInFlags(self.handle(), C.ImGuiItemFlags(v))
 }
 
-func (self ImGuiTable) GetInstanceCurrent() int {
-	return int(C.ImGuiTable_GetInstanceCurrent(self.handle()))
+func (self ImGuiLastItemData) GetInFlags() ImGuiItemFlags {
+	return ImGuiItemFlags(C.ImGuiLastItemData_GetInFlags(self.handle()))
 }
 
-func (self ImGuiTable) SetInstanceInteracted(v int) {
-	C.ImGuiTable_SetInstanceInteracted(self.handle(), C.ImS16(v))
+func (self ImGuiLastItemData) SetStatusFlags(v ImGuiItemStatusFlags) {
+	C.ImGuiLastItemData_SetStatusFlags(self.handle(), C.ImGuiItemStatusFlags(v))
 }
 
-func (self ImGuiTable) GetInstanceInteracted() int {
-	return int(C.ImGuiTable_GetInstanceInteracted(self.handle()))
+func (self ImGuiLastItemData) GetStatusFlags() ImGuiItemStatusFlags {
+	return ImGuiItemStatusFlags(C.ImGuiLastItemData_GetStatusFlags(self.handle()))
 }
 
-func (self ImGuiTable) SetRowPosY1(v float32) {
-	C.ImGuiTable_SetRowPosY1(self.handle(), C.float(v))
+func (self ImGuiLastItemData) SetRect(v ImRect) {
+	C.ImGuiLastItemData_SetRect(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) GetRowPosY1() float32 {
-	return float32(C.ImGuiTable_GetRowPosY1(self.handle()))
+func (self ImGuiLastItemData) GetRect() ImRect {
+	return newImRectFromC(C.ImGuiLastItemData_GetRect(self.handle()))
 }
 
-func (self ImGuiTable) SetRowPosY2(v float32) {
-	C.ImGuiTable_SetRowPosY2(self.handle(), C.float(v))
+func (self ImGuiLastItemData) SetNavRect(v ImRect) {
+	C.ImGuiLastItemData_SetNavRect(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) GetRowPosY2() float32 {
-	return float32(C.ImGuiTable_GetRowPosY2(self.handle()))
+func (self ImGuiLastItemData) GetNavRect() ImRect {
+	return newImRectFromC(C.ImGuiLastItemData_GetNavRect(self.handle()))
 }
 
-func (self ImGuiTable) SetRowMinHeight(v float32) {
-	C.ImGuiTable_SetRowMinHeight(self.handle(), C.float(v))
+func (self ImGuiLastItemData) SetDisplayRect(v ImRect) {
+	C.ImGuiLastItemData_SetDisplayRect(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) GetRowMinHeight() float32 {
-	return float32(C.ImGuiTable_GetRowMinHeight(self.handle()))
+func (self ImGuiLastItemData) GetDisplayRect() ImRect {
+	return newImRectFromC(C.ImGuiLastItemData_GetDisplayRect(self.handle()))
 }
 
-func (self ImGuiTable) SetRowTextBaseline(v float32) {
-	C.ImGuiTable_SetRowTextBaseline(self.handle(), C.float(v))
+func (self ImGuiStackSizes) SetSizeOfIDStack(v int) {
+	C.ImGuiStackSizes_SetSizeOfIDStack(self.handle(), C.short(v))
 }
 
-func (self ImGuiTable) GetRowTextBaseline() float32 {
-	return float32(C.ImGuiTable_GetRowTextBaseline(self.handle()))
+func (self ImGuiStackSizes) GetSizeOfIDStack() int {
+	return int(C.ImGuiStackSizes_GetSizeOfIDStack(self.handle()))
 }
 
-func (self ImGuiTable) SetRowIndentOffsetX(v float32) {
-	C.ImGuiTable_SetRowIndentOffsetX(self.handle(), C.float(v))
+func (self ImGuiStackSizes) SetSizeOfColorStack(v int) {
+	C.ImGuiStackSizes_SetSizeOfColorStack(self.handle(), C.short(v))
 }
 
-func (self ImGuiTable) GetRowIndentOffsetX() float32 {
-	return float32(C.ImGuiTable_GetRowIndentOffsetX(self.handle()))
+func (self ImGuiStackSizes) GetSizeOfColorStack() int {
+	return int(C.ImGuiStackSizes_GetSizeOfColorStack(self.handle()))
 }
 
-func (self ImGuiTable) SetRowFlags(v ImGuiTableRowFlags) {
-	C.ImGuiTable_SetRowFlags(self.handle(), C.ImGuiTableRowFlags(v))
+func (self ImGuiStackSizes) SetSizeOfStyleVarStack(v int) {
+	C.ImGuiStackSizes_SetSizeOfStyleVarStack(self.handle(), C.short(v))
 }
 
-func (self ImGuiTable) GetRowFlags() ImGuiTableRowFlags {
-	return ImGuiTableRowFlags(C.ImGuiTable_GetRowFlags(self.handle()))
+func (self ImGuiStackSizes) GetSizeOfStyleVarStack() int {
+	return int(C.ImGuiStackSizes_GetSizeOfStyleVarStack(self.handle()))
 }
 
-func (self ImGuiTable) SetLastRowFlags(v ImGuiTableRowFlags) {
-	C.ImGuiTable_SetLastRowFlags(self.handle(), C.ImGuiTableRowFlags(v))
+func (self ImGuiStackSizes) SetSizeOfFontStack(v int) {
+	C.ImGuiStackSizes_SetSizeOfFontStack(self.handle(), C.short(v))
 }
 
-func (self ImGuiTable) GetLastRowFlags() ImGuiTableRowFlags {
-	return ImGuiTableRowFlags(C.ImGuiTable_GetLastRowFlags(self.handle()))
+func (self ImGuiStackSizes) GetSizeOfFontStack() int {
+	return int(C.ImGuiStackSizes_GetSizeOfFontStack(self.handle()))
 }
 
-func (self ImGuiTable) SetRowBgColorCounter(v int32) {
-	C.ImGuiTable_SetRowBgColorCounter(self.handle(), C.int(v))
+func (self ImGuiStackSizes) SetSizeOfFocusScopeStack(v int) {
+	C.ImGuiStackSizes_SetSizeOfFocusScopeStack(self.handle(), C.short(v))
 }
 
-func (self ImGuiTable) GetRowBgColorCounter() int {
-	return int(C.ImGuiTable_GetRowBgColorCounter(self.handle()))
+func (self ImGuiStackSizes) GetSizeOfFocusScopeStack() int {
+	return int(C.ImGuiStackSizes_GetSizeOfFocusScopeStack(self.handle()))
 }
 
-func (self ImGuiTable) SetBorderColorStrong(v uint32) {
-	C.ImGuiTable_SetBorderColorStrong(self.handle(), C.ImU32(v))
+func (self ImGuiStackSizes) SetSizeOfGroupStack(v int) {
+	C.ImGuiStackSizes_SetSizeOfGroupStack(self.handle(), C.short(v))
 }
 
-func (self ImGuiTable) GetBorderColorStrong() uint32 {
-	return uint32(C.ImGuiTable_GetBorderColorStrong(self.handle()))
+func (self ImGuiStackSizes) GetSizeOfGroupStack() int {
+	return int(C.ImGuiStackSizes_GetSizeOfGroupStack(self.handle()))
 }
 
-func (self ImGuiTable) SetBorderColorLight(v uint32) {
-	C.ImGuiTable_SetBorderColorLight(self.handle(), C.ImU32(v))
+func (self ImGuiStackSizes) SetSizeOfItemFlagsStack(v int) {
+	C.ImGuiStackSizes_SetSizeOfItemFlagsStack(self.handle(), C.short(v))
 }
 
-func (self ImGuiTable) GetBorderColorLight() uint32 {
-	return uint32(C.ImGuiTable_GetBorderColorLight(self.handle()))
+func (self ImGuiStackSizes) GetSizeOfItemFlagsStack() int {
+	return int(C.ImGuiStackSizes_GetSizeOfItemFlagsStack(self.handle()))
 }
 
-func (self ImGuiTable) SetBorderX1(v float32) {
-	C.ImGuiTable_SetBorderX1(self.handle(), C.float(v))
+func (self ImGuiStackSizes) SetSizeOfBeginPopupStack(v int) {
+	C.ImGuiStackSizes_SetSizeOfBeginPopupStack(self.handle(), C.short(v))
 }
 
-func (self ImGuiTable) GetBorderX1() float32 {
-	return float32(C.ImGuiTable_GetBorderX1(self.handle()))
+func (self ImGuiStackSizes) GetSizeOfBeginPopupStack() int {
+	return int(C.ImGuiStackSizes_GetSizeOfBeginPopupStack(self.handle()))
 }
 
-func (self ImGuiTable) SetBorderX2(v float32) {
-	C.ImGuiTable_SetBorderX2(self.handle(), C.float(v))
+func (self ImGuiStackSizes) SetSizeOfDisabledStack(v int) {
+	C.ImGuiStackSizes_SetSizeOfDisabledStack(self.handle(), C.short(v))
 }
 
-func (self ImGuiTable) GetBorderX2() float32 {
-	return float32(C.ImGuiTable_GetBorderX2(self.handle()))
+func (self ImGuiStackSizes) GetSizeOfDisabledStack() int {
+	return int(C.ImGuiStackSizes_GetSizeOfDisabledStack(self.handle()))
 }
 
-func (self ImGuiTable) SetHostIndentX(v float32) {
-	C.ImGuiTable_SetHostIndentX(self.handle(), C.float(v))
+func (self ImGuiWindowStackData) SetWindow(v ImGuiWindow) {
+	C.ImGuiWindowStackData_SetWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiTable) GetHostIndentX() float32 {
-	return float32(C.ImGuiTable_GetHostIndentX(self.handle()))
+func (self ImGuiWindowStackData) GetWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindowStackData_GetWindow(self.handle())))
 }
 
-func (self ImGuiTable) SetMinColumnWidth(v float32) {
-	C.ImGuiTable_SetMinColumnWidth(self.handle(), C.float(v))
+func (self ImGuiWindowStackData) GetParentLastItemDataBackup() ImGuiLastItemData {
+	return newImGuiLastItemDataFromC(C.ImGuiWindowStackData_GetParentLastItemDataBackup(self.handle()))
 }
 
-func (self ImGuiTable) GetMinColumnWidth() float32 {
-	return float32(C.ImGuiTable_GetMinColumnWidth(self.handle()))
+func (self ImGuiWindowStackData) GetStackSizesOnBegin() ImGuiStackSizes {
+	return newImGuiStackSizesFromC(C.ImGuiWindowStackData_GetStackSizesOnBegin(self.handle()))
 }
 
-func (self ImGuiTable) SetOuterPaddingX(v float32) {
-	C.ImGuiTable_SetOuterPaddingX(self.handle(), C.float(v))
+func (self ImGuiShrinkWidthItem) SetIndex(v int32) {
+	C.ImGuiShrinkWidthItem_SetIndex(self.handle(), C.int(v))
 }
 
-func (self ImGuiTable) GetOuterPaddingX() float32 {
-	return float32(C.ImGuiTable_GetOuterPaddingX(self.handle()))
+func (self ImGuiShrinkWidthItem) GetIndex() int {
+	return int(C.ImGuiShrinkWidthItem_GetIndex(self.handle()))
 }
 
-func (self ImGuiTable) SetCellPaddingX(v float32) {
-	C.ImGuiTable_SetCellPaddingX(self.handle(), C.float(v))
+func (self ImGuiShrinkWidthItem) SetWidth(v float32) {
+	C.ImGuiShrinkWidthItem_SetWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetCellPaddingX() float32 {
-	return float32(C.ImGuiTable_GetCellPaddingX(self.handle()))
+func (self ImGuiShrinkWidthItem) GetWidth() float32 {
+	return float32(C.ImGuiShrinkWidthItem_GetWidth(self.handle()))
 }
 
-func (self ImGuiTable) SetCellPaddingY(v float32) {
-	C.ImGuiTable_SetCellPaddingY(self.handle(), C.float(v))
+func (self ImGuiShrinkWidthItem) SetInitialWidth(v float32) {
+	C.ImGuiShrinkWidthItem_SetInitialWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetCellPaddingY() float32 {
-	return float32(C.ImGuiTable_GetCellPaddingY(self.handle()))
+func (self ImGuiShrinkWidthItem) GetInitialWidth() float32 {
+	return float32(C.ImGuiShrinkWidthItem_GetInitialWidth(self.handle()))
 }
 
-func (self ImGuiTable) SetCellSpacingX1(v float32) {
-	C.ImGuiTable_SetCellSpacingX1(self.handle(), C.float(v))
+func (self ImGuiPtrOrIndex) SetPtr(v unsafe.Pointer) {
+	C.ImGuiPtrOrIndex_SetPtr(self.handle(), v)
 }
 
-func (self ImGuiTable) GetCellSpacingX1() float32 {
-	return float32(C.ImGuiTable_GetCellSpacingX1(self.handle()))
+func (self ImGuiPtrOrIndex) GetPtr() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiPtrOrIndex_GetPtr(self.handle()))
 }
 
-func (self ImGuiTable) SetCellSpacingX2(v float32) {
-	C.ImGuiTable_SetCellSpacingX2(self.handle(), C.float(v))
+func (self ImGuiPtrOrIndex) SetIndex(v int32) {
+	C.ImGuiPtrOrIndex_SetIndex(self.handle(), C.int(v))
 }
 
-func (self ImGuiTable) GetCellSpacingX2() float32 {
-	return float32(C.ImGuiTable_GetCellSpacingX2(self.handle()))
+func (self ImGuiPtrOrIndex) GetIndex() int {
+	return int(C.ImGuiPtrOrIndex_GetIndex(self.handle()))
 }
 
-func (self ImGuiTable) SetInnerWidth(v float32) {
-	C.ImGuiTable_SetInnerWidth(self.handle(), C.float(v))
+func (self ImGuiInputEventMousePos) SetPosX(v float32) {
+	C.ImGuiInputEventMousePos_SetPosX(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetInnerWidth() float32 {
-	return float32(C.ImGuiTable_GetInnerWidth(self.handle()))
+func (self ImGuiInputEventMousePos) GetPosX() float32 {
+	return float32(C.ImGuiInputEventMousePos_GetPosX(self.handle()))
 }
 
-func (self ImGuiTable) SetColumnsGivenWidth(v float32) {
-	C.ImGuiTable_SetColumnsGivenWidth(self.handle(), C.float(v))
+func (self ImGuiInputEventMousePos) SetPosY(v float32) {
+	C.ImGuiInputEventMousePos_SetPosY(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetColumnsGivenWidth() float32 {
-	return float32(C.ImGuiTable_GetColumnsGivenWidth(self.handle()))
+func (self ImGuiInputEventMousePos) GetPosY() float32 {
+	return float32(C.ImGuiInputEventMousePos_GetPosY(self.handle()))
 }
 
-func (self ImGuiTable) SetColumnsAutoFitWidth(v float32) {
-	C.ImGuiTable_SetColumnsAutoFitWidth(self.handle(), C.float(v))
+func (self ImGuiInputEventMouseWheel) SetWheelX(v float32) {
+	C.ImGuiInputEventMouseWheel_SetWheelX(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetColumnsAutoFitWidth() float32 {
-	return float32(C.ImGuiTable_GetColumnsAutoFitWidth(self.handle()))
+func (self ImGuiInputEventMouseWheel) GetWheelX() float32 {
+	return float32(C.ImGuiInputEventMouseWheel_GetWheelX(self.handle()))
 }
 
-func (self ImGuiTable) SetColumnsStretchSumWeights(v float32) {
-	C.ImGuiTable_SetColumnsStretchSumWeights(self.handle(), C.float(v))
+func (self ImGuiInputEventMouseWheel) SetWheelY(v float32) {
+	C.ImGuiInputEventMouseWheel_SetWheelY(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetColumnsStretchSumWeights() float32 {
-	return float32(C.ImGuiTable_GetColumnsStretchSumWeights(self.handle()))
+func (self ImGuiInputEventMouseWheel) GetWheelY() float32 {
+	return float32(C.ImGuiInputEventMouseWheel_GetWheelY(self.handle()))
 }
 
-func (self ImGuiTable) SetResizedColumnNextWidth(v float32) {
-	C.ImGuiTable_SetResizedColumnNextWidth(self.handle(), C.float(v))
+func (self ImGuiInputEventMouseButton) SetButton(v int32) {
+	C.ImGuiInputEventMouseButton_SetButton(self.handle(), C.int(v))
 }
 
-func (self ImGuiTable) GetResizedColumnNextWidth() float32 {
-	return float32(C.ImGuiTable_GetResizedColumnNextWidth(self.handle()))
+func (self ImGuiInputEventMouseButton) GetButton() int {
+	return int(C.ImGuiInputEventMouseButton_GetButton(self.handle()))
 }
 
-func (self ImGuiTable) SetResizeLockMinContentsX2(v float32) {
-	C.ImGuiTable_SetResizeLockMinContentsX2(self.handle(), C.float(v))
+func (self ImGuiInputEventMouseButton) SetDown(v bool) {
+	C.ImGuiInputEventMouseButton_SetDown(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTable) GetResizeLockMinContentsX2() float32 {
-	return float32(C.ImGuiTable_GetResizeLockMinContentsX2(self.handle()))
+func (self ImGuiInputEventMouseButton) GetDown() bool {
+	return C.ImGuiInputEventMouseButton_GetDown(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTable) SetRefScale(v float32) {
-	C.ImGuiTable_SetRefScale(self.handle(), C.float(v))
+func (self ImGuiInputEventMouseViewport) SetHoveredViewportID(v ImGuiID) {
+	C.ImGuiInputEventMouseViewport_SetHoveredViewportID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTable) GetRefScale() float32 {
-	return float32(C.ImGuiTable_GetRefScale(self.handle()))
+func (self ImGuiInputEventMouseViewport) GetHoveredViewportID() ImGuiID {
+	return ImGuiID(C.ImGuiInputEventMouseViewport_GetHoveredViewportID(self.handle()))
 }
 
-func (self ImGuiTable) SetOuterRect(v ImRect) {
-	C.ImGuiTable_SetOuterRect(self.handle(), v.toC())
+func (self ImGuiInputEventKey) SetKey(v ImGuiKey) {
+	C.ImGuiInputEventKey_SetKey(self.handle(), C.ImGuiKey(v))
 }
 
-func (self ImGuiTable) GetOuterRect() ImRect {
-	return newImRectFromC(C.ImGuiTable_GetOuterRect(self.handle()))
+func (self ImGuiInputEventKey) GetKey() ImGuiKey {
+	return ImGuiKey(C.ImGuiInputEventKey_GetKey(self.handle()))
 }
 
-func (self ImGuiTable) SetInnerRect(v ImRect) {
-	C.ImGuiTable_SetInnerRect(self.handle(), v.toC())
+func (self ImGuiInputEventKey) SetDown(v bool) {
+	C.ImGuiInputEventKey_SetDown(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTable) GetInnerRect() ImRect {
-	return newImRectFromC(C.ImGuiTable_GetInnerRect(self.handle()))
+func (self ImGuiInputEventKey) GetDown() bool {
+	return C.ImGuiInputEventKey_GetDown(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTable) SetWorkRect(v ImRect) {
-	C.ImGuiTable_SetWorkRect(self.handle(), v.toC())
+func (self ImGuiInputEventKey) SetAnalogValue(v float32) {
+	C.ImGuiInputEventKey_SetAnalogValue(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetWorkRect() ImRect {
-	return newImRectFromC(C.ImGuiTable_GetWorkRect(self.handle()))
+func (self ImGuiInputEventKey) GetAnalogValue() float32 {
+	return float32(C.ImGuiInputEventKey_GetAnalogValue(self.handle()))
 }
 
-func (self ImGuiTable) SetInnerClipRect(v ImRect) {
-	C.ImGuiTable_SetInnerClipRect(self.handle(), v.toC())
+func (self ImGuiInputEventText) SetChar(v uint32) {
+	C.ImGuiInputEventText_SetChar(self.handle(), C.uint(v))
 }
 
-func (self ImGuiTable) GetInnerClipRect() ImRect {
-	return newImRectFromC(C.ImGuiTable_GetInnerClipRect(self.handle()))
+func (self ImGuiInputEventText) GetChar() uint32 {
+	return uint32(C.ImGuiInputEventText_GetChar(self.handle()))
 }
 
-func (self ImGuiTable) SetBgClipRect(v ImRect) {
-	C.ImGuiTable_SetBgClipRect(self.handle(), v.toC())
+func (self ImGuiInputEventAppFocused) SetFocused(v bool) {
+	C.ImGuiInputEventAppFocused_SetFocused(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTable) GetBgClipRect() ImRect {
-	return newImRectFromC(C.ImGuiTable_GetBgClipRect(self.handle()))
+func (self ImGuiInputEventAppFocused) GetFocused() bool {
+	return C.ImGuiInputEventAppFocused_GetFocused(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTable) SetBg0ClipRectForDrawCmd(v ImRect) {
-	C.ImGuiTable_SetBg0ClipRectForDrawCmd(self.handle(), v.toC())
+func (self ImGuiInputEvent) SetType(v ImGuiInputEventType) {
+	C.ImGuiInputEvent_SetType(self.handle(), C.ImGuiInputEventType(v))
 }
 
-func (self ImGuiTable) GetBg0ClipRectForDrawCmd() ImRect {
-	return newImRectFromC(C.ImGuiTable_GetBg0ClipRectForDrawCmd(self.handle()))
+func (self ImGuiInputEvent) GetType() ImGuiInputEventType {
+	return ImGuiInputEventType(C.ImGuiInputEvent_GetType(self.handle()))
 }
 
-func (self ImGuiTable) SetBg2ClipRectForDrawCmd(v ImRect) {
-	C.ImGuiTable_SetBg2ClipRectForDrawCmd(self.handle(), v.toC())
+func (self ImGuiInputEvent) SetSource(v ImGuiInputSource) {
+	C.ImGuiInputEvent_SetSource(self.handle(), C.ImGuiInputSource(v))
 }
 
-func (self ImGuiTable) GetBg2ClipRectForDrawCmd() ImRect {
-	return newImRectFromC(C.ImGuiTable_GetBg2ClipRectForDrawCmd(self.handle()))
+func (self ImGuiInputEvent) GetSource() ImGuiInputSource {
+	return ImGuiInputSource(C.ImGuiInputEvent_GetSource(self.handle()))
 }
 
-func (self ImGuiTable) SetHostClipRect(v ImRect) {
-	C.ImGuiTable_SetHostClipRect(self.handle(), v.toC())
+func (self ImGuiInputEvent) SetAddedByTestEngine(v bool) {
+	C.ImGuiInputEvent_SetAddedByTestEngine(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTable) GetHostClipRect() ImRect {
-	return newImRectFromC(C.ImGuiTable_GetHostClipRect(self.handle()))
+func (self ImGuiInputEvent) GetAddedByTestEngine() bool {
+	return C.ImGuiInputEvent_GetAddedByTestEngine(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTable) SetHostBackupInnerClipRect(v ImRect) {
-	C.ImGuiTable_SetHostBackupInnerClipRect(self.handle(), v.toC())
+func (self ImGuiListClipperRange) SetMin(v int32) {
+	C.ImGuiListClipperRange_SetMin(self.handle(), C.int(v))
 }
 
-func (self ImGuiTable) GetHostBackupInnerClipRect() ImRect {
-	return newImRectFromC(C.ImGuiTable_GetHostBackupInnerClipRect(self.handle()))
+func (self ImGuiListClipperRange) GetMin() int {
+	return int(C.ImGuiListClipperRange_GetMin(self.handle()))
 }
 
-func (self ImGuiTable) SetOuterWindow(v ImGuiWindow) {
-	C.ImGuiTable_SetOuterWindow(self.handle(), v.handle())
+func (self ImGuiListClipperRange) SetMax(v int32) {
+	C.ImGuiListClipperRange_SetMax(self.handle(), C.int(v))
 }
 
-func (self ImGuiTable) GetOuterWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiTable_GetOuterWindow(self.handle())))
+func (self ImGuiListClipperRange) GetMax() int {
+	return int(C.ImGuiListClipperRange_GetMax(self.handle()))
 }
 
-func (self ImGuiTable) SetInnerWindow(v ImGuiWindow) {
-	C.ImGuiTable_SetInnerWindow(self.handle(), v.handle())
+func (self ImGuiListClipperRange) SetPosToIndexConvert(v bool) {
+	C.ImGuiListClipperRange_SetPosToIndexConvert(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTable) GetInnerWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiTable_GetInnerWindow(self.handle())))
+func (self ImGuiListClipperRange) GetPosToIndexConvert() bool {
+	return C.ImGuiListClipperRange_GetPosToIndexConvert(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTable) GetColumnsNames() ImGuiTextBuffer {
-	return newImGuiTextBufferFromC(C.ImGuiTable_GetColumnsNames(self.handle()))
+func (self ImGuiListClipperRange) SetPosToIndexOffsetMin(v int) {
+	C.ImGuiListClipperRange_SetPosToIndexOffsetMin(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiTable) SetDrawSplitter(v ImDrawListSplitter) {
-	C.ImGuiTable_SetDrawSplitter(self.handle(), v.handle())
+func (self ImGuiListClipperRange) GetPosToIndexOffsetMin() int {
+	return int(C.ImGuiListClipperRange_GetPosToIndexOffsetMin(self.handle()))
 }
 
-func (self ImGuiTable) GetDrawSplitter() ImDrawListSplitter {
-	return (ImDrawListSplitter)(unsafe.Pointer(C.ImGuiTable_GetDrawSplitter(self.handle())))
+func (self ImGuiListClipperRange) SetPosToIndexOffsetMax(v int) {
+	C.ImGuiListClipperRange_SetPosToIndexOffsetMax(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiTable) GetInstanceDataFirst() ImGuiTableInstanceData {
-	return newImGuiTableInstanceDataFromC(C.ImGuiTable_GetInstanceDataFirst(self.handle()))
+func (self ImGuiListClipperRange) GetPosToIndexOffsetMax() int {
+	return int(C.ImGuiListClipperRange_GetPosToIndexOffsetMax(self.handle()))
 }
 
-func (self ImGuiTable) GetSortSpecsSingle() ImGuiTableColumnSortSpecs {
-	return newImGuiTableColumnSortSpecsFromC(C.ImGuiTable_GetSortSpecsSingle(self.handle()))
+func (self ImGuiListClipperData) SetListClipper(v ImGuiListClipper) {
+	C.ImGuiListClipperData_SetListClipper(self.handle(), v.handle())
 }
 
-func (self ImGuiTable) GetSortSpecs() ImGuiTableSortSpecs {
-	return newImGuiTableSortSpecsFromC(C.ImGuiTable_GetSortSpecs(self.handle()))
+func (self ImGuiListClipperData) GetListClipper() ImGuiListClipper {
+	return (ImGuiListClipper)(unsafe.Pointer(C.ImGuiListClipperData_GetListClipper(self.handle())))
 }
 
-func (self ImGuiTable) SetSortSpecsCount(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetSortSpecsCount(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiListClipperData) SetLossynessOffset(v float32) {
+	C.ImGuiListClipperData_SetLossynessOffset(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetSortSpecsCount() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetSortSpecsCount(self.handle()))
+func (self ImGuiListClipperData) GetLossynessOffset() float32 {
+	return float32(C.ImGuiListClipperData_GetLossynessOffset(self.handle()))
 }
 
-func (self ImGuiTable) SetColumnsEnabledCount(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetColumnsEnabledCount(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiListClipperData) SetStepNo(v int32) {
+	C.ImGuiListClipperData_SetStepNo(self.handle(), C.int(v))
 }
 
-func (self ImGuiTable) GetColumnsEnabledCount() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetColumnsEnabledCount(self.handle()))
+func (self ImGuiListClipperData) GetStepNo() int {
+	return int(C.ImGuiListClipperData_GetStepNo(self.handle()))
 }
 
-func (self ImGuiTable) SetColumnsEnabledFixedCount(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetColumnsEnabledFixedCount(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiListClipperData) SetItemsFrozen(v int32) {
+	C.ImGuiListClipperData_SetItemsFrozen(self.handle(), C.int(v))
 }
 
-func (self ImGuiTable) GetColumnsEnabledFixedCount() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetColumnsEnabledFixedCount(self.handle()))
+func (self ImGuiListClipperData) GetItemsFrozen() int {
+	return int(C.ImGuiListClipperData_GetItemsFrozen(self.handle()))
 }
 
-func (self ImGuiTable) SetDeclColumnsCount(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetDeclColumnsCount(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiNavItemData) SetWindow(v ImGuiWindow) {
+	C.ImGuiNavItemData_SetWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiTable) GetDeclColumnsCount() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetDeclColumnsCount(self.handle()))
+func (self ImGuiNavItemData) GetWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiNavItemData_GetWindow(self.handle())))
 }
 
-func (self ImGuiTable) SetHoveredColumnBody(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetHoveredColumnBody(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiNavItemData) SetID(v ImGuiID) {
+	C.ImGuiNavItemData_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTable) GetHoveredColumnBody() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetHoveredColumnBody(self.handle()))
+func (self ImGuiNavItemData) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiNavItemData_GetID(self.handle()))
 }
 
-func (self ImGuiTable) SetHoveredColumnBorder(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetHoveredColumnBorder(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiNavItemData) SetFocusScopeId(v ImGuiID) {
+	C.ImGuiNavItemData_SetFocusScopeId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTable) GetHoveredColumnBorder() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetHoveredColumnBorder(self.handle()))
+func (self ImGuiNavItemData) GetFocusScopeId() ImGuiID {
+	return ImGuiID(C.ImGuiNavItemData_GetFocusScopeId(self.handle()))
 }
 
-func (self ImGuiTable) SetAutoFitSingleColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetAutoFitSingleColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiNavItemData) SetRectRel(v ImRect) {
+	C.ImGuiNavItemData_SetRectRel(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) GetAutoFitSingleColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetAutoFitSingleColumn(self.handle()))
+func (self ImGuiNavItemData) GetRectRel() ImRect {
+	return newImRectFromC(C.ImGuiNavItemData_GetRectRel(self.handle()))
 }
 
-func (self ImGuiTable) SetResizedColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetResizedColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiNavItemData) SetInFlags(v ImGuiItemFlags) {
+	C.ImGuiNavItemData_SetInFlags(self.handle(), C.ImGuiItemFlags(v))
 }
 
-func (self ImGuiTable) GetResizedColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetResizedColumn(self.handle()))
+func (self ImGuiNavItemData) GetInFlags() ImGuiItemFlags {
+	return ImGuiItemFlags(C.ImGuiNavItemData_GetInFlags(self.handle()))
 }
 
-func (self ImGuiTable) SetLastResizedColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetLastResizedColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiNavItemData) SetDistBox(v float32) {
+	C.ImGuiNavItemData_SetDistBox(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetLastResizedColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetLastResizedColumn(self.handle()))
+func (self ImGuiNavItemData) GetDistBox() float32 {
+	return float32(C.ImGuiNavItemData_GetDistBox(self.handle()))
 }
 
-func (self ImGuiTable) SetHeldHeaderColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetHeldHeaderColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiNavItemData) SetDistCenter(v float32) {
+	C.ImGuiNavItemData_SetDistCenter(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetHeldHeaderColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetHeldHeaderColumn(self.handle()))
+func (self ImGuiNavItemData) GetDistCenter() float32 {
+	return float32(C.ImGuiNavItemData_GetDistCenter(self.handle()))
 }
 
-func (self ImGuiTable) SetReorderColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetReorderColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiNavItemData) SetDistAxial(v float32) {
+	C.ImGuiNavItemData_SetDistAxial(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetReorderColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetReorderColumn(self.handle()))
+func (self ImGuiNavItemData) GetDistAxial() float32 {
+	return float32(C.ImGuiNavItemData_GetDistAxial(self.handle()))
 }
 
-func (self ImGuiTable) SetReorderColumnDir(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetReorderColumnDir(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumnData) SetOffsetNorm(v float32) {
+	C.ImGuiOldColumnData_SetOffsetNorm(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetReorderColumnDir() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetReorderColumnDir(self.handle()))
+func (self ImGuiOldColumnData) GetOffsetNorm() float32 {
+	return float32(C.ImGuiOldColumnData_GetOffsetNorm(self.handle()))
 }
 
-func (self ImGuiTable) SetLeftMostEnabledColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetLeftMostEnabledColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumnData) SetOffsetNormBeforeResize(v float32) {
+	C.ImGuiOldColumnData_SetOffsetNormBeforeResize(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetLeftMostEnabledColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetLeftMostEnabledColumn(self.handle()))
+func (self ImGuiOldColumnData) GetOffsetNormBeforeResize() float32 {
+	return float32(C.ImGuiOldColumnData_GetOffsetNormBeforeResize(self.handle()))
 }
 
-func (self ImGuiTable) SetRightMostEnabledColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetRightMostEnabledColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumnData) SetFlags(v ImGuiOldColumnFlags) {
+	C.ImGuiOldColumnData_SetFlags(self.handle(), C.ImGuiOldColumnFlags(v))
 }
 
-func (self ImGuiTable) GetRightMostEnabledColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetRightMostEnabledColumn(self.handle()))
+func (self ImGuiOldColumnData) GetFlags() ImGuiOldColumnFlags {
+	return ImGuiOldColumnFlags(C.ImGuiOldColumnData_GetFlags(self.handle()))
 }
 
-func (self ImGuiTable) SetLeftMostStretchedColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetLeftMostStretchedColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumnData) SetClipRect(v ImRect) {
+	C.ImGuiOldColumnData_SetClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) GetLeftMostStretchedColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetLeftMostStretchedColumn(self.handle()))
+func (self ImGuiOldColumnData) GetClipRect() ImRect {
+	return newImRectFromC(C.ImGuiOldColumnData_GetClipRect(self.handle()))
 }
 
-func (self ImGuiTable) SetRightMostStretchedColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetRightMostStretchedColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumns) SetID(v ImGuiID) {
+	C.ImGuiOldColumns_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTable) GetRightMostStretchedColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetRightMostStretchedColumn(self.handle()))
+func (self ImGuiOldColumns) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiOldColumns_GetID(self.handle()))
 }
 
-func (self ImGuiTable) SetContextPopupColumn(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetContextPopupColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumns) SetFlags(v ImGuiOldColumnFlags) {
+	C.ImGuiOldColumns_SetFlags(self.handle(), C.ImGuiOldColumnFlags(v))
 }
 
-func (self ImGuiTable) GetContextPopupColumn() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetContextPopupColumn(self.handle()))
+func (self ImGuiOldColumns) GetFlags() ImGuiOldColumnFlags {
+	return ImGuiOldColumnFlags(C.ImGuiOldColumns_GetFlags(self.handle()))
 }
 
-func (self ImGuiTable) SetFreezeRowsRequest(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetFreezeRowsRequest(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumns) SetIsFirstFrame(v bool) {
+	C.ImGuiOldColumns_SetIsFirstFrame(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTable) GetFreezeRowsRequest() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetFreezeRowsRequest(self.handle()))
+func (self ImGuiOldColumns) GetIsFirstFrame() bool {
+	return C.ImGuiOldColumns_GetIsFirstFrame(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTable) SetFreezeRowsCount(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetFreezeRowsCount(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumns) SetIsBeingResized(v bool) {
+	C.ImGuiOldColumns_SetIsBeingResized(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTable) GetFreezeRowsCount() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetFreezeRowsCount(self.handle()))
+func (self ImGuiOldColumns) GetIsBeingResized() bool {
+	return C.ImGuiOldColumns_GetIsBeingResized(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTable) SetFreezeColumnsRequest(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetFreezeColumnsRequest(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumns) SetCurrent(v int32) {
+	C.ImGuiOldColumns_SetCurrent(self.handle(), C.int(v))
 }
 
-func (self ImGuiTable) GetFreezeColumnsRequest() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetFreezeColumnsRequest(self.handle()))
+func (self ImGuiOldColumns) GetCurrent() int {
+	return int(C.ImGuiOldColumns_GetCurrent(self.handle()))
 }
 
-func (self ImGuiTable) SetFreezeColumnsCount(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetFreezeColumnsCount(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumns) SetCount(v int32) {
+	C.ImGuiOldColumns_SetCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiTable) GetFreezeColumnsCount() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetFreezeColumnsCount(self.handle()))
+func (self ImGuiOldColumns) GetCount() int {
+	return int(C.ImGuiOldColumns_GetCount(self.handle()))
 }
 
-func (self ImGuiTable) SetRowCellDataCurrent(v ImGuiTableColumnIdx) {
-	C.ImGuiTable_SetRowCellDataCurrent(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiOldColumns) SetOffMinX(v float32) {
+	C.ImGuiOldColumns_SetOffMinX(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetRowCellDataCurrent() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTable_GetRowCellDataCurrent(self.handle()))
+func (self ImGuiOldColumns) GetOffMinX() float32 {
+	return float32(C.ImGuiOldColumns_GetOffMinX(self.handle()))
 }
 
-func (self ImGuiTable) SetDummyDrawChannel(v ImGuiTableDrawChannelIdx) {
-	C.ImGuiTable_SetDummyDrawChannel(self.handle(), C.ImGuiTableDrawChannelIdx(v))
+func (self ImGuiOldColumns) SetOffMaxX(v float32) {
+	C.ImGuiOldColumns_SetOffMaxX(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetDummyDrawChannel() ImGuiTableDrawChannelIdx {
-	return ImGuiTableDrawChannelIdx(C.ImGuiTable_GetDummyDrawChannel(self.handle()))
+func (self ImGuiOldColumns) GetOffMaxX() float32 {
+	return float32(C.ImGuiOldColumns_GetOffMaxX(self.handle()))
 }
 
-func (self ImGuiTable) SetBg2DrawChannelCurrent(v ImGuiTableDrawChannelIdx) {
-	C.ImGuiTable_SetBg2DrawChannelCurrent(self.handle(), C.ImGuiTableDrawChannelIdx(v))
+func (self ImGuiOldColumns) SetLineMinY(v float32) {
+	C.ImGuiOldColumns_SetLineMinY(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetBg2DrawChannelCurrent() ImGuiTableDrawChannelIdx {
-	return ImGuiTableDrawChannelIdx(C.ImGuiTable_GetBg2DrawChannelCurrent(self.handle()))
+func (self ImGuiOldColumns) GetLineMinY() float32 {
+	return float32(C.ImGuiOldColumns_GetLineMinY(self.handle()))
 }
 
-func (self ImGuiTable) SetBg2DrawChannelUnfrozen(v ImGuiTableDrawChannelIdx) {
-	C.ImGuiTable_SetBg2DrawChannelUnfrozen(self.handle(), C.ImGuiTableDrawChannelIdx(v))
+func (self ImGuiOldColumns) SetLineMaxY(v float32) {
+	C.ImGuiOldColumns_SetLineMaxY(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetBg2DrawChannelUnfrozen() ImGuiTableDrawChannelIdx {
-	return ImGuiTableDrawChannelIdx(C.ImGuiTable_GetBg2DrawChannelUnfrozen(self.handle()))
+func (self ImGuiOldColumns) GetLineMaxY() float32 {
+	return float32(C.ImGuiOldColumns_GetLineMaxY(self.handle()))
 }
 
-func (self ImGuiTable) SetIsLayoutLocked(v bool) {
-	C.ImGuiTable_SetIsLayoutLocked(self.handle(), C.bool(v))
+func (self ImGuiOldColumns) SetHostCursorPosY(v float32) {
+	C.ImGuiOldColumns_SetHostCursorPosY(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetIsLayoutLocked() bool {
-	return C.ImGuiTable_GetIsLayoutLocked(self.handle()) == C.bool(true)
+func (self ImGuiOldColumns) GetHostCursorPosY() float32 {
+	return float32(C.ImGuiOldColumns_GetHostCursorPosY(self.handle()))
 }
 
-func (self ImGuiTable) SetIsInsideRow(v bool) {
-	C.ImGuiTable_SetIsInsideRow(self.handle(), C.bool(v))
+func (self ImGuiOldColumns) SetHostCursorMaxPosX(v float32) {
+	C.ImGuiOldColumns_SetHostCursorMaxPosX(self.handle(), C.float(v))
 }
 
-func (self ImGuiTable) GetIsInsideRow() bool {
-	return C.ImGuiTable_GetIsInsideRow(self.handle()) == C.bool(true)
+func (self ImGuiOldColumns) GetHostCursorMaxPosX() float32 {
+	return float32(C.ImGuiOldColumns_GetHostCursorMaxPosX(self.handle()))
 }
 
-func (self ImGuiTable) SetIsInitializing(v bool) {
-	C.ImGuiTable_SetIsInitializing(self.handle(), C.bool(v))
+func (self ImGuiOldColumns) SetHostInitialClipRect(v ImRect) {
+	C.ImGuiOldColumns_SetHostInitialClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) GetIsInitializing() bool {
-	return C.ImGuiTable_GetIsInitializing(self.handle()) == C.bool(true)
+func (self ImGuiOldColumns) GetHostInitialClipRect() ImRect {
+	return newImRectFromC(C.ImGuiOldColumns_GetHostInitialClipRect(self.handle()))
 }
 
-func (self ImGuiTable) SetIsSortSpecsDirty(v bool) {
-	C.ImGuiTable_SetIsSortSpecsDirty(self.handle(), C.bool(v))
+func (self ImGuiOldColumns) SetHostBackupClipRect(v ImRect) {
+	C.ImGuiOldColumns_SetHostBackupClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) GetIsSortSpecsDirty() bool {
-	return C.ImGuiTable_GetIsSortSpecsDirty(self.handle()) == C.bool(true)
+func (self ImGuiOldColumns) GetHostBackupClipRect() ImRect {
+	return newImRectFromC(C.ImGuiOldColumns_GetHostBackupClipRect(self.handle()))
 }
 
-func (self ImGuiTable) SetIsUsingHeaders(v bool) {
-	C.ImGuiTable_SetIsUsingHeaders(self.handle(), C.bool(v))
+func (self ImGuiOldColumns) SetHostBackupParentWorkRect(v ImRect) {
+	C.ImGuiOldColumns_SetHostBackupParentWorkRect(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) GetIsUsingHeaders() bool {
-	return C.ImGuiTable_GetIsUsingHeaders(self.handle()) == C.bool(true)
+func (self ImGuiOldColumns) GetHostBackupParentWorkRect() ImRect {
+	return newImRectFromC(C.ImGuiOldColumns_GetHostBackupParentWorkRect(self.handle()))
 }
 
-func (self ImGuiTable) SetIsContextPopupOpen(v bool) {
-	C.ImGuiTable_SetIsContextPopupOpen(self.handle(), C.bool(v))
+func (self ImGuiOldColumns) GetSplitter() ImDrawListSplitter {
+	return newImDrawListSplitterFromC(C.ImGuiOldColumns_GetSplitter(self.handle()))
 }
 
-func (self ImGuiTable) GetIsContextPopupOpen() bool {
-	return C.ImGuiTable_GetIsContextPopupOpen(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) SetID(v ImGuiID) {
+	C.ImGuiDockNode_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTable) SetIsSettingsRequestLoad(v bool) {
-	C.ImGuiTable_SetIsSettingsRequestLoad(self.handle(), C.bool(v))
+func (self ImGuiDockNode) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiDockNode_GetID(self.handle()))
 }
 
-func (self ImGuiTable) GetIsSettingsRequestLoad() bool {
-	return C.ImGuiTable_GetIsSettingsRequestLoad(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) SetSharedFlags(v ImGuiDockNodeFlags) {
+	C.ImGuiDockNode_SetSharedFlags(self.handle(), C.ImGuiDockNodeFlags(v))
 }
 
-func (self ImGuiTable) SetIsSettingsDirty(v bool) {
-	C.ImGuiTable_SetIsSettingsDirty(self.handle(), C.bool(v))
+func (self ImGuiDockNode) GetSharedFlags() ImGuiDockNodeFlags {
+	return ImGuiDockNodeFlags(C.ImGuiDockNode_GetSharedFlags(self.handle()))
 }
 
-func (self ImGuiTable) GetIsSettingsDirty() bool {
-	return C.ImGuiTable_GetIsSettingsDirty(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) SetLocalFlagsInWindows(v ImGuiDockNodeFlags) {
+	C.ImGuiDockNode_SetLocalFlagsInWindows(self.handle(), C.ImGuiDockNodeFlags(v))
 }
 
-func (self ImGuiTable) SetIsDefaultDisplayOrder(v bool) {
-	C.ImGuiTable_SetIsDefaultDisplayOrder(self.handle(), C.bool(v))
+func (self ImGuiDockNode) GetLocalFlagsInWindows() ImGuiDockNodeFlags {
+	return ImGuiDockNodeFlags(C.ImGuiDockNode_GetLocalFlagsInWindows(self.handle()))
 }
 
-func (self ImGuiTable) GetIsDefaultDisplayOrder() bool {
-	return C.ImGuiTable_GetIsDefaultDisplayOrder(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) SetMergedFlags(v ImGuiDockNodeFlags) {
+	C.ImGuiDockNode_SetMergedFlags(self.handle(), C.ImGuiDockNodeFlags(v))
 }
 
-func (self ImGuiTable) SetIsResetAllRequest(v bool) {
-	C.ImGuiTable_SetIsResetAllRequest(self.handle(), C.bool(v))
+func (self ImGuiDockNode) GetMergedFlags() ImGuiDockNodeFlags {
+	return ImGuiDockNodeFlags(C.ImGuiDockNode_GetMergedFlags(self.handle()))
 }
 
-func (self ImGuiTable) GetIsResetAllRequest() bool {
-	return C.ImGuiTable_GetIsResetAllRequest(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) SetState(v ImGuiDockNodeState) {
+	C.ImGuiDockNode_SetState(self.handle(), C.ImGuiDockNodeState(v))
 }
 
-func (self ImGuiTable) SetIsResetDisplayOrderRequest(v bool) {
-	C.ImGuiTable_SetIsResetDisplayOrderRequest(self.handle(), C.bool(v))
+func (self ImGuiDockNode) GetState() ImGuiDockNodeState {
+	return ImGuiDockNodeState(C.ImGuiDockNode_GetState(self.handle()))
 }
 
-func (self ImGuiTable) GetIsResetDisplayOrderRequest() bool {
-	return C.ImGuiTable_GetIsResetDisplayOrderRequest(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) SetParentNode(v ImGuiDockNode) {
+	C.ImGuiDockNode_SetParentNode(self.handle(), v.handle())
 }
 
-func (self ImGuiTable) SetIsUnfrozenRows(v bool) {
-	C.ImGuiTable_SetIsUnfrozenRows(self.handle(), C.bool(v))
+func (self ImGuiDockNode) GetParentNode() ImGuiDockNode {
+	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiDockNode_GetParentNode(self.handle())))
 }
 
-func (self ImGuiTable) GetIsUnfrozenRows() bool {
-	return C.ImGuiTable_GetIsUnfrozenRows(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) SetTabBar(v ImGuiTabBar) {
+	C.ImGuiDockNode_SetTabBar(self.handle(), v.handle())
 }
 
-func (self ImGuiTable) SetIsDefaultSizingPolicy(v bool) {
-	C.ImGuiTable_SetIsDefaultSizingPolicy(self.handle(), C.bool(v))
+func (self ImGuiDockNode) GetTabBar() ImGuiTabBar {
+	return (ImGuiTabBar)(unsafe.Pointer(C.ImGuiDockNode_GetTabBar(self.handle())))
 }
 
-func (self ImGuiTable) GetIsDefaultSizingPolicy() bool {
-	return C.ImGuiTable_GetIsDefaultSizingPolicy(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) SetPos(v ImVec2) {
+	C.ImGuiDockNode_SetPos(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) SetMemoryCompacted(v bool) {
-	C.ImGuiTable_SetMemoryCompacted(self.handle(), C.bool(v))
+func (self ImGuiDockNode) GetPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiDockNode_GetPos(self.handle()))
 }
 
-func (self ImGuiTable) GetMemoryCompacted() bool {
-	return C.ImGuiTable_GetMemoryCompacted(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) SetSize(v ImVec2) {
+	C.ImGuiDockNode_SetSize(self.handle(), v.toC())
 }
 
-func (self ImGuiTable) SetHostSkipItems(v bool) {
-	C.ImGuiTable_SetHostSkipItems(self.handle(), C.bool(v))
+func (self ImGuiDockNode) GetSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiDockNode_GetSize(self.handle()))
 }
 
-func (self ImGuiTable) GetHostSkipItems() bool {
-	return C.ImGuiTable_GetHostSkipItems(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) SetSizeRef(v ImVec2) {
+	C.ImGuiDockNode_SetSizeRef(self.handle(), v.toC())
 }
 
-func (self ImDrawCmd) SetClipRect(v ImVec4) {
-	C.ImDrawCmd_SetClipRect(self.handle(), v.toC())
+func (self ImGuiDockNode) GetSizeRef() ImVec2 {
+	return newImVec2FromC(C.ImGuiDockNode_GetSizeRef(self.handle()))
 }
 
-func (self ImDrawCmd) GetClipRect() ImVec4 {
-	return newImVec4FromC(C.ImDrawCmd_GetClipRect(self.handle()))
+func (self ImGuiDockNode) SetSplitAxis(v ImGuiAxis) {
+	C.ImGuiDockNode_SetSplitAxis(self.handle(), C.ImGuiAxis(v))
 }
 
-func (self ImDrawCmd) SetTextureId(v ImTextureID) {
-	C.ImDrawCmd_SetTextureId(self.handle(), C.ImTextureID(v))
+func (self ImGuiDockNode) GetSplitAxis() ImGuiAxis {
+	return ImGuiAxis(C.ImGuiDockNode_GetSplitAxis(self.handle()))
 }
 
-func (self ImDrawCmd) GetTextureId() ImTextureID {
-	return ImTextureID(C.ImDrawCmd_GetTextureId(self.handle()))
+func (self ImGuiDockNode) GetWindowClass() ImGuiWindowClass {
+	return newImGuiWindowClassFromC(C.ImGuiDockNode_GetWindowClass(self.handle()))
 }
 
-func (self ImDrawCmd) SetVtxOffset(v uint32) {
-	C.ImDrawCmd_SetVtxOffset(self.handle(), C.uint(v))
+func (self ImGuiDockNode) SetLastBgColor(v uint32) {
+	C.ImGuiDockNode_SetLastBgColor(self.handle(), C.ImU32(v))
 }
 
-func (self ImDrawCmd) GetVtxOffset() uint32 {
-	return uint32(C.ImDrawCmd_GetVtxOffset(self.handle()))
+func (self ImGuiDockNode) GetLastBgColor() uint32 {
+	return uint32(C.ImGuiDockNode_GetLastBgColor(self.handle()))
 }
 
-func (self ImDrawCmd) SetIdxOffset(v uint32) {
-	C.ImDrawCmd_SetIdxOffset(self.handle(), C.uint(v))
+func (self ImGuiDockNode) SetHostWindow(v ImGuiWindow) {
+	C.ImGuiDockNode_SetHostWindow(self.handle(), v.handle())
 }
 
-func (self ImDrawCmd) GetIdxOffset() uint32 {
-	return uint32(C.ImDrawCmd_GetIdxOffset(self.handle()))
+func (self ImGuiDockNode) GetHostWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiDockNode_GetHostWindow(self.handle())))
 }
 
-func (self ImDrawCmd) SetElemCount(v uint32) {
-	C.ImDrawCmd_SetElemCount(self.handle(), C.uint(v))
+func (self ImGuiDockNode) SetVisibleWindow(v ImGuiWindow) {
+	C.ImGuiDockNode_SetVisibleWindow(self.handle(), v.handle())
 }
 
-func (self ImDrawCmd) GetElemCount() uint32 {
-	return uint32(C.ImDrawCmd_GetElemCount(self.handle()))
+func (self ImGuiDockNode) GetVisibleWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiDockNode_GetVisibleWindow(self.handle())))
 }
 
-func (self ImDrawCmd) SetUserCallbackData(v unsafe.Pointer) {
-	C.ImDrawCmd_SetUserCallbackData(self.handle(), v)
+func (self ImGuiDockNode) SetCentralNode(v ImGuiDockNode) {
+	C.ImGuiDockNode_SetCentralNode(self.handle(), v.handle())
 }
 
-func (self ImDrawCmd) GetUserCallbackData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImDrawCmd_GetUserCallbackData(self.handle()))
+func (self ImGuiDockNode) GetCentralNode() ImGuiDockNode {
+	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiDockNode_GetCentralNode(self.handle())))
 }
 
-func (self ImGuiTableSettings) SetID(v ImGuiID) {
-	C.ImGuiTableSettings_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiDockNode) SetOnlyNodeWithWindows(v ImGuiDockNode) {
+	C.ImGuiDockNode_SetOnlyNodeWithWindows(self.handle(), v.handle())
 }
 
-func (self ImGuiTableSettings) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiTableSettings_GetID(self.handle()))
+func (self ImGuiDockNode) GetOnlyNodeWithWindows() ImGuiDockNode {
+	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiDockNode_GetOnlyNodeWithWindows(self.handle())))
 }
 
-func (self ImGuiTableSettings) SetSaveFlags(v ImGuiTableFlags) {
-	C.ImGuiTableSettings_SetSaveFlags(self.handle(), C.ImGuiTableFlags(v))
+func (self ImGuiDockNode) SetCountNodeWithWindows(v int32) {
+	C.ImGuiDockNode_SetCountNodeWithWindows(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableSettings) GetSaveFlags() ImGuiTableFlags {
-	return ImGuiTableFlags(C.ImGuiTableSettings_GetSaveFlags(self.handle()))
+func (self ImGuiDockNode) GetCountNodeWithWindows() int {
+	return int(C.ImGuiDockNode_GetCountNodeWithWindows(self.handle()))
 }
 
-func (self ImGuiTableSettings) SetRefScale(v float32) {
-	C.ImGuiTableSettings_SetRefScale(self.handle(), C.float(v))
+func (self ImGuiDockNode) SetLastFrameAlive(v int32) {
+	C.ImGuiDockNode_SetLastFrameAlive(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableSettings) GetRefScale() float32 {
-	return float32(C.ImGuiTableSettings_GetRefScale(self.handle()))
+func (self ImGuiDockNode) GetLastFrameAlive() int {
+	return int(C.ImGuiDockNode_GetLastFrameAlive(self.handle()))
 }
 
-func (self ImGuiTableSettings) SetColumnsCount(v ImGuiTableColumnIdx) {
-	C.ImGuiTableSettings_SetColumnsCount(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiDockNode) SetLastFrameActive(v int32) {
+	C.ImGuiDockNode_SetLastFrameActive(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableSettings) GetColumnsCount() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableSettings_GetColumnsCount(self.handle()))
+func (self ImGuiDockNode) GetLastFrameActive() int {
+	return int(C.ImGuiDockNode_GetLastFrameActive(self.handle()))
 }
 
-func (self ImGuiTableSettings) SetColumnsCountMax(v ImGuiTableColumnIdx) {
-	C.ImGuiTableSettings_SetColumnsCountMax(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiDockNode) SetLastFrameFocused(v int32) {
+	C.ImGuiDockNode_SetLastFrameFocused(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableSettings) GetColumnsCountMax() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableSettings_GetColumnsCountMax(self.handle()))
+func (self ImGuiDockNode) GetLastFrameFocused() int {
+	return int(C.ImGuiDockNode_GetLastFrameFocused(self.handle()))
 }
 
-func (self ImGuiTableSettings) SetWantApply(v bool) {
-	C.ImGuiTableSettings_SetWantApply(self.handle(), C.bool(v))
+func (self ImGuiDockNode) SetLastFocusedNodeId(v ImGuiID) {
+	C.ImGuiDockNode_SetLastFocusedNodeId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTableSettings) GetWantApply() bool {
-	return C.ImGuiTableSettings_GetWantApply(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) GetLastFocusedNodeId() ImGuiID {
+	return ImGuiID(C.ImGuiDockNode_GetLastFocusedNodeId(self.handle()))
 }
 
-func (self ImGuiWindowSettings) SetID(v ImGuiID) {
-	C.ImGuiWindowSettings_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiDockNode) SetSelectedTabId(v ImGuiID) {
+	C.ImGuiDockNode_SetSelectedTabId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindowSettings) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiWindowSettings_GetID(self.handle()))
+func (self ImGuiDockNode) GetSelectedTabId() ImGuiID {
+	return ImGuiID(C.ImGuiDockNode_GetSelectedTabId(self.handle()))
 }
 
-func (self ImGuiWindowSettings) SetViewportId(v ImGuiID) {
-	C.ImGuiWindowSettings_SetViewportId(self.handle(), C.ImGuiID(v))
+func (self ImGuiDockNode) SetWantCloseTabId(v ImGuiID) {
+	C.ImGuiDockNode_SetWantCloseTabId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindowSettings) GetViewportId() ImGuiID {
-	return ImGuiID(C.ImGuiWindowSettings_GetViewportId(self.handle()))
+func (self ImGuiDockNode) GetWantCloseTabId() ImGuiID {
+	return ImGuiID(C.ImGuiDockNode_GetWantCloseTabId(self.handle()))
 }
 
-func (self ImGuiWindowSettings) SetDockId(v ImGuiID) {
-	C.ImGuiWindowSettings_SetDockId(self.handle(), C.ImGuiID(v))
+func (self ImGuiDockNode) SetAuthorityForPos(v ImGuiDataAuthority) {
+	C.ImGuiDockNode_SetAuthorityForPos(self.handle(), C.ImGuiDataAuthority(v))
 }
 
-func (self ImGuiWindowSettings) GetDockId() ImGuiID {
-	return ImGuiID(C.ImGuiWindowSettings_GetDockId(self.handle()))
+func (self ImGuiDockNode) GetAuthorityForPos() ImGuiDataAuthority {
+	return ImGuiDataAuthority(C.ImGuiDockNode_GetAuthorityForPos(self.handle()))
 }
 
-func (self ImGuiWindowSettings) SetClassId(v ImGuiID) {
-	C.ImGuiWindowSettings_SetClassId(self.handle(), C.ImGuiID(v))
+func (self ImGuiDockNode) SetAuthorityForSize(v ImGuiDataAuthority) {
+	C.ImGuiDockNode_SetAuthorityForSize(self.handle(), C.ImGuiDataAuthority(v))
 }
 
-func (self ImGuiWindowSettings) GetClassId() ImGuiID {
-	return ImGuiID(C.ImGuiWindowSettings_GetClassId(self.handle()))
+func (self ImGuiDockNode) GetAuthorityForSize() ImGuiDataAuthority {
+	return ImGuiDataAuthority(C.ImGuiDockNode_GetAuthorityForSize(self.handle()))
 }
 
-func (self ImGuiWindowSettings) SetDockOrder(v int) {
-	C.ImGuiWindowSettings_SetDockOrder(self.handle(), C.short(v))
+func (self ImGuiDockNode) SetAuthorityForViewport(v ImGuiDataAuthority) {
+	C.ImGuiDockNode_SetAuthorityForViewport(self.handle(), C.ImGuiDataAuthority(v))
 }
 
-func (self ImGuiWindowSettings) GetDockOrder() int {
-	return int(C.ImGuiWindowSettings_GetDockOrder(self.handle()))
+func (self ImGuiDockNode) GetAuthorityForViewport() ImGuiDataAuthority {
+	return ImGuiDataAuthority(C.ImGuiDockNode_GetAuthorityForViewport(self.handle()))
 }
 
-func (self ImGuiWindowSettings) SetCollapsed(v bool) {
-	C.ImGuiWindowSettings_SetCollapsed(self.handle(), C.bool(v))
+func (self ImGuiDockNode) SetIsVisible(v bool) {
+	C.ImGuiDockNode_SetIsVisible(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindowSettings) GetCollapsed() bool {
-	return C.ImGuiWindowSettings_GetCollapsed(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) GetIsVisible() bool {
+	return C.ImGuiDockNode_GetIsVisible(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindowSettings) SetWantApply(v bool) {
-	C.ImGuiWindowSettings_SetWantApply(self.handle(), C.bool(v))
+func (self ImGuiDockNode) SetIsFocused(v bool) {
+	C.ImGuiDockNode_SetIsFocused(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindowSettings) GetWantApply() bool {
-	return C.ImGuiWindowSettings_GetWantApply(self.handle()) == C.bool(true)
+func (self ImGuiDockNode) GetIsFocused() bool {
+	return C.ImGuiDockNode_GetIsFocused(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableInstanceData) SetLastOuterHeight(v float32) {
-	C.ImGuiTableInstanceData_SetLastOuterHeight(self.handle(), C.float(v))
+func (self ImGuiDockNode) SetIsBgDrawnThisFrame(v bool) {
+	C.ImGuiDockNode_SetIsBgDrawnThisFrame(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableInstanceData) GetLastOuterHeight() float32 {
-	return float32(C.ImGuiTableInstanceData_GetLastOuterHeight(self.handle()))
+func (self ImGuiDockNode) GetIsBgDrawnThisFrame() bool {
+	return C.ImGuiDockNode_GetIsBgDrawnThisFrame(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableInstanceData) SetLastFirstRowHeight(v float32) {
-	C.ImGuiTableInstanceData_SetLastFirstRowHeight(self.handle(), C.float(v))
+func (self ImGuiDockNode) SetHasCloseButton(v bool) {
+	C.ImGuiDockNode_SetHasCloseButton(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableInstanceData) GetLastFirstRowHeight() float32 {
-	return float32(C.ImGuiTableInstanceData_GetLastFirstRowHeight(self.handle()))
+func (self ImGuiDockNode) GetHasCloseButton() bool {
+	return C.ImGuiDockNode_GetHasCloseButton(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputEventMousePos) SetPosX(v float32) {
-	C.ImGuiInputEventMousePos_SetPosX(self.handle(), C.float(v))
+func (self ImGuiDockNode) SetHasWindowMenuButton(v bool) {
+	C.ImGuiDockNode_SetHasWindowMenuButton(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputEventMousePos) GetPosX() float32 {
-	return float32(C.ImGuiInputEventMousePos_GetPosX(self.handle()))
+func (self ImGuiDockNode) GetHasWindowMenuButton() bool {
+	return C.ImGuiDockNode_GetHasWindowMenuButton(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputEventMousePos) SetPosY(v float32) {
-	C.ImGuiInputEventMousePos_SetPosY(self.handle(), C.float(v))
+func (self ImGuiDockNode) SetHasCentralNodeChild(v bool) {
+	C.ImGuiDockNode_SetHasCentralNodeChild(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputEventMousePos) GetPosY() float32 {
-	return float32(C.ImGuiInputEventMousePos_GetPosY(self.handle()))
+func (self ImGuiDockNode) GetHasCentralNodeChild() bool {
+	return C.ImGuiDockNode_GetHasCentralNodeChild(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiMenuColumns) SetTotalWidth(v uint32) {
-	C.ImGuiMenuColumns_SetTotalWidth(self.handle(), C.ImU32(v))
+func (self ImGuiDockNode) SetWantCloseAll(v bool) {
+	C.ImGuiDockNode_SetWantCloseAll(self.handle(), C.bool(v))
 }
 
-func (self ImGuiMenuColumns) GetTotalWidth() uint32 {
-	return uint32(C.ImGuiMenuColumns_GetTotalWidth(self.handle()))
+func (self ImGuiDockNode) GetWantCloseAll() bool {
+	return C.ImGuiDockNode_GetWantCloseAll(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiMenuColumns) SetNextTotalWidth(v uint32) {
-	C.ImGuiMenuColumns_SetNextTotalWidth(self.handle(), C.ImU32(v))
+func (self ImGuiDockNode) SetWantLockSizeOnce(v bool) {
+	C.ImGuiDockNode_SetWantLockSizeOnce(self.handle(), C.bool(v))
 }
 
-func (self ImGuiMenuColumns) GetNextTotalWidth() uint32 {
-	return uint32(C.ImGuiMenuColumns_GetNextTotalWidth(self.handle()))
+func (self ImGuiDockNode) GetWantLockSizeOnce() bool {
+	return C.ImGuiDockNode_GetWantLockSizeOnce(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiMenuColumns) SetSpacing(v uint) {
-	C.ImGuiMenuColumns_SetSpacing(self.handle(), C.ImU16(v))
+func (self ImGuiDockNode) SetWantMouseMove(v bool) {
+	C.ImGuiDockNode_SetWantMouseMove(self.handle(), C.bool(v))
 }
 
-func (self ImGuiMenuColumns) GetSpacing() uint32 {
-	return uint32(C.ImGuiMenuColumns_GetSpacing(self.handle()))
+func (self ImGuiDockNode) GetWantMouseMove() bool {
+	return C.ImGuiDockNode_GetWantMouseMove(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiMenuColumns) SetOffsetIcon(v uint) {
-	C.ImGuiMenuColumns_SetOffsetIcon(self.handle(), C.ImU16(v))
+func (self ImGuiDockNode) SetWantHiddenTabBarUpdate(v bool) {
+	C.ImGuiDockNode_SetWantHiddenTabBarUpdate(self.handle(), C.bool(v))
 }
 
-func (self ImGuiMenuColumns) GetOffsetIcon() uint32 {
-	return uint32(C.ImGuiMenuColumns_GetOffsetIcon(self.handle()))
+func (self ImGuiDockNode) GetWantHiddenTabBarUpdate() bool {
+	return C.ImGuiDockNode_GetWantHiddenTabBarUpdate(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiMenuColumns) SetOffsetLabel(v uint) {
-	C.ImGuiMenuColumns_SetOffsetLabel(self.handle(), C.ImU16(v))
+func (self ImGuiDockNode) SetWantHiddenTabBarToggle(v bool) {
+	C.ImGuiDockNode_SetWantHiddenTabBarToggle(self.handle(), C.bool(v))
 }
 
-func (self ImGuiMenuColumns) GetOffsetLabel() uint32 {
-	return uint32(C.ImGuiMenuColumns_GetOffsetLabel(self.handle()))
+func (self ImGuiDockNode) GetWantHiddenTabBarToggle() bool {
+	return C.ImGuiDockNode_GetWantHiddenTabBarToggle(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiMenuColumns) SetOffsetShortcut(v uint) {
-	C.ImGuiMenuColumns_SetOffsetShortcut(self.handle(), C.ImU16(v))
+func (self ImGuiDockContext) GetNodes() ImGuiStorage {
+	return newImGuiStorageFromC(C.ImGuiDockContext_GetNodes(self.handle()))
 }
 
-func (self ImGuiMenuColumns) GetOffsetShortcut() uint32 {
-	return uint32(C.ImGuiMenuColumns_GetOffsetShortcut(self.handle()))
+func (self ImGuiDockContext) SetWantFullRebuild(v bool) {
+	C.ImGuiDockContext_SetWantFullRebuild(self.handle(), C.bool(v))
 }
 
-func (self ImGuiMenuColumns) SetOffsetMark(v uint) {
-	C.ImGuiMenuColumns_SetOffsetMark(self.handle(), C.ImU16(v))
+func (self ImGuiDockContext) GetWantFullRebuild() bool {
+	return C.ImGuiDockContext_GetWantFullRebuild(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiMenuColumns) GetOffsetMark() uint32 {
-	return uint32(C.ImGuiMenuColumns_GetOffsetMark(self.handle()))
+func (self ImGuiViewportP) Get_ImGuiViewport() ImGuiViewport {
+	return newImGuiViewportFromC(C.ImGuiViewportP_Get_ImGuiViewport(self.handle()))
 }
 
-func (self ImGuiTableColumnSettings) SetWidthOrWeight(v float32) {
-	C.ImGuiTableColumnSettings_SetWidthOrWeight(self.handle(), C.float(v))
+func (self ImGuiViewportP) SetIdx(v int32) {
+	C.ImGuiViewportP_SetIdx(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableColumnSettings) GetWidthOrWeight() float32 {
-	return float32(C.ImGuiTableColumnSettings_GetWidthOrWeight(self.handle()))
+func (self ImGuiViewportP) GetIdx() int {
+	return int(C.ImGuiViewportP_GetIdx(self.handle()))
 }
 
-func (self ImGuiTableColumnSettings) SetUserID(v ImGuiID) {
-	C.ImGuiTableColumnSettings_SetUserID(self.handle(), C.ImGuiID(v))
+func (self ImGuiViewportP) SetLastFrameActive(v int32) {
+	C.ImGuiViewportP_SetLastFrameActive(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableColumnSettings) GetUserID() ImGuiID {
-	return ImGuiID(C.ImGuiTableColumnSettings_GetUserID(self.handle()))
+func (self ImGuiViewportP) GetLastFrameActive() int {
+	return int(C.ImGuiViewportP_GetLastFrameActive(self.handle()))
 }
 
-func (self ImGuiTableColumnSettings) SetIndex(v ImGuiTableColumnIdx) {
-	C.ImGuiTableColumnSettings_SetIndex(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiViewportP) SetLastFrontMostStampCount(v int32) {
+	C.ImGuiViewportP_SetLastFrontMostStampCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableColumnSettings) GetIndex() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableColumnSettings_GetIndex(self.handle()))
+func (self ImGuiViewportP) GetLastFrontMostStampCount() int {
+	return int(C.ImGuiViewportP_GetLastFrontMostStampCount(self.handle()))
 }
 
-func (self ImGuiTableColumnSettings) SetDisplayOrder(v ImGuiTableColumnIdx) {
-	C.ImGuiTableColumnSettings_SetDisplayOrder(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiViewportP) SetLastNameHash(v ImGuiID) {
+	C.ImGuiViewportP_SetLastNameHash(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTableColumnSettings) GetDisplayOrder() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableColumnSettings_GetDisplayOrder(self.handle()))
+func (self ImGuiViewportP) GetLastNameHash() ImGuiID {
+	return ImGuiID(C.ImGuiViewportP_GetLastNameHash(self.handle()))
 }
 
-func (self ImGuiTableColumnSettings) SetSortOrder(v ImGuiTableColumnIdx) {
-	C.ImGuiTableColumnSettings_SetSortOrder(self.handle(), C.ImGuiTableColumnIdx(v))
+func (self ImGuiViewportP) SetLastPos(v ImVec2) {
+	C.ImGuiViewportP_SetLastPos(self.handle(), v.toC())
 }
 
-func (self ImGuiTableColumnSettings) GetSortOrder() ImGuiTableColumnIdx {
-	return ImGuiTableColumnIdx(C.ImGuiTableColumnSettings_GetSortOrder(self.handle()))
+func (self ImGuiViewportP) GetLastPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewportP_GetLastPos(self.handle()))
 }
 
-func (self ImGuiTableColumnSettings) SetSortDirection(v uint) {
-	C.ImGuiTableColumnSettings_SetSortDirection(self.handle(), C.ImU8(v))
+func (self ImGuiViewportP) SetAlpha(v float32) {
+	C.ImGuiViewportP_SetAlpha(self.handle(), C.float(v))
 }
 
-func (self ImGuiTableColumnSettings) GetSortDirection() uint32 {
-	return uint32(C.ImGuiTableColumnSettings_GetSortDirection(self.handle()))
+func (self ImGuiViewportP) GetAlpha() float32 {
+	return float32(C.ImGuiViewportP_GetAlpha(self.handle()))
 }
 
-func (self ImGuiTableColumnSettings) SetIsEnabled(v uint) {
-	C.ImGuiTableColumnSettings_SetIsEnabled(self.handle(), C.ImU8(v))
+func (self ImGuiViewportP) SetLastAlpha(v float32) {
+	C.ImGuiViewportP_SetLastAlpha(self.handle(), C.float(v))
 }
 
-func (self ImGuiTableColumnSettings) GetIsEnabled() uint32 {
-	return uint32(C.ImGuiTableColumnSettings_GetIsEnabled(self.handle()))
+func (self ImGuiViewportP) GetLastAlpha() float32 {
+	return float32(C.ImGuiViewportP_GetLastAlpha(self.handle()))
 }
 
-func (self ImGuiTableColumnSettings) SetIsStretch(v uint) {
-	C.ImGuiTableColumnSettings_SetIsStretch(self.handle(), C.ImU8(v))
+func (self ImGuiViewportP) SetPlatformMonitor(v int) {
+	C.ImGuiViewportP_SetPlatformMonitor(self.handle(), C.short(v))
 }
 
-func (self ImGuiTableColumnSettings) GetIsStretch() uint32 {
-	return uint32(C.ImGuiTableColumnSettings_GetIsStretch(self.handle()))
+func (self ImGuiViewportP) GetPlatformMonitor() int {
+	return int(C.ImGuiViewportP_GetPlatformMonitor(self.handle()))
 }
 
-func (self ImDrawListSharedData) SetTexUvWhitePixel(v ImVec2) {
-	C.ImDrawListSharedData_SetTexUvWhitePixel(self.handle(), v.toC())
+func (self ImGuiViewportP) SetPlatformWindowCreated(v bool) {
+	C.ImGuiViewportP_SetPlatformWindowCreated(self.handle(), C.bool(v))
 }
 
-func (self ImDrawListSharedData) GetTexUvWhitePixel() ImVec2 {
-	return newImVec2FromC(C.ImDrawListSharedData_GetTexUvWhitePixel(self.handle()))
+func (self ImGuiViewportP) GetPlatformWindowCreated() bool {
+	return C.ImGuiViewportP_GetPlatformWindowCreated(self.handle()) == C.bool(true)
 }
 
-func (self ImDrawListSharedData) SetFont(v ImFont) {
-	C.ImDrawListSharedData_SetFont(self.handle(), v.handle())
+func (self ImGuiViewportP) SetWindow(v ImGuiWindow) {
+	C.ImGuiViewportP_SetWindow(self.handle(), v.handle())
 }
 
-func (self ImDrawListSharedData) GetFont() ImFont {
-	return (ImFont)(unsafe.Pointer(C.ImDrawListSharedData_GetFont(self.handle())))
+func (self ImGuiViewportP) GetWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiViewportP_GetWindow(self.handle())))
 }
 
-func (self ImDrawListSharedData) SetFontSize(v float32) {
-	C.ImDrawListSharedData_SetFontSize(self.handle(), C.float(v))
+func (self ImGuiViewportP) GetDrawDataP() ImDrawData {
+	return newImDrawDataFromC(C.ImGuiViewportP_GetDrawDataP(self.handle()))
 }
 
-func (self ImDrawListSharedData) GetFontSize() float32 {
-	return float32(C.ImDrawListSharedData_GetFontSize(self.handle()))
+func (self ImGuiViewportP) GetDrawDataBuilder() ImDrawDataBuilder {
+	return newImDrawDataBuilderFromC(C.ImGuiViewportP_GetDrawDataBuilder(self.handle()))
 }
 
-func (self ImDrawListSharedData) SetCurveTessellationTol(v float32) {
-	C.ImDrawListSharedData_SetCurveTessellationTol(self.handle(), C.float(v))
+func (self ImGuiViewportP) SetLastPlatformPos(v ImVec2) {
+	C.ImGuiViewportP_SetLastPlatformPos(self.handle(), v.toC())
 }
 
-func (self ImDrawListSharedData) GetCurveTessellationTol() float32 {
-	return float32(C.ImDrawListSharedData_GetCurveTessellationTol(self.handle()))
+func (self ImGuiViewportP) GetLastPlatformPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewportP_GetLastPlatformPos(self.handle()))
 }
 
-func (self ImDrawListSharedData) SetCircleSegmentMaxError(v float32) {
-	C.ImDrawListSharedData_SetCircleSegmentMaxError(self.handle(), C.float(v))
+func (self ImGuiViewportP) SetLastPlatformSize(v ImVec2) {
+	C.ImGuiViewportP_SetLastPlatformSize(self.handle(), v.toC())
 }
 
-func (self ImDrawListSharedData) GetCircleSegmentMaxError() float32 {
-	return float32(C.ImDrawListSharedData_GetCircleSegmentMaxError(self.handle()))
+func (self ImGuiViewportP) GetLastPlatformSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewportP_GetLastPlatformSize(self.handle()))
 }
 
-func (self ImDrawListSharedData) SetClipRectFullscreen(v ImVec4) {
-	C.ImDrawListSharedData_SetClipRectFullscreen(self.handle(), v.toC())
+func (self ImGuiViewportP) SetLastRendererSize(v ImVec2) {
+	C.ImGuiViewportP_SetLastRendererSize(self.handle(), v.toC())
 }
 
-func (self ImDrawListSharedData) GetClipRectFullscreen() ImVec4 {
-	return newImVec4FromC(C.ImDrawListSharedData_GetClipRectFullscreen(self.handle()))
+func (self ImGuiViewportP) GetLastRendererSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewportP_GetLastRendererSize(self.handle()))
 }
 
-func (self ImDrawListSharedData) SetInitialFlags(v ImDrawListFlags) {
-	C.ImDrawListSharedData_SetInitialFlags(self.handle(), C.ImDrawListFlags(v))
+func (self ImGuiViewportP) SetWorkOffsetMin(v ImVec2) {
+	C.ImGuiViewportP_SetWorkOffsetMin(self.handle(), v.toC())
 }
 
-func (self ImDrawListSharedData) GetInitialFlags() ImDrawListFlags {
-	return ImDrawListFlags(C.ImDrawListSharedData_GetInitialFlags(self.handle()))
+func (self ImGuiViewportP) GetWorkOffsetMin() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewportP_GetWorkOffsetMin(self.handle()))
 }
 
-func (self ImDrawListSharedData) SetArcFastRadiusCutoff(v float32) {
-	C.ImDrawListSharedData_SetArcFastRadiusCutoff(self.handle(), C.float(v))
+func (self ImGuiViewportP) SetWorkOffsetMax(v ImVec2) {
+	C.ImGuiViewportP_SetWorkOffsetMax(self.handle(), v.toC())
 }
 
-func (self ImDrawListSharedData) GetArcFastRadiusCutoff() float32 {
-	return float32(C.ImDrawListSharedData_GetArcFastRadiusCutoff(self.handle()))
+func (self ImGuiViewportP) GetWorkOffsetMax() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewportP_GetWorkOffsetMax(self.handle()))
 }
 
-func (self ImDrawListSharedData) SetTexUvLines(v *ImVec4) {
-	vArg, vFin := v.wrap()
-	defer vFin()
+func (self ImGuiViewportP) SetBuildWorkOffsetMin(v ImVec2) {
+	C.ImGuiViewportP_SetBuildWorkOffsetMin(self.handle(), v.toC())
+}
 
-	C.ImDrawListSharedData_SetTexUvLines(self.handle(), vArg)
+func (self ImGuiViewportP) GetBuildWorkOffsetMin() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewportP_GetBuildWorkOffsetMin(self.handle()))
 }
 
-func (self ImDrawListSharedData) GetTexUvLines() ImVec4 {
-	return newImVec4FromCPtr(C.ImDrawListSharedData_GetTexUvLines(self.handle()))
+func (self ImGuiViewportP) SetBuildWorkOffsetMax(v ImVec2) {
+	C.ImGuiViewportP_SetBuildWorkOffsetMax(self.handle(), v.toC())
 }
 
-func (self ImGuiStackSizes) SetSizeOfIDStack(v int) {
-	C.ImGuiStackSizes_SetSizeOfIDStack(self.handle(), C.short(v))
+func (self ImGuiViewportP) GetBuildWorkOffsetMax() ImVec2 {
+	return newImVec2FromC(C.ImGuiViewportP_GetBuildWorkOffsetMax(self.handle()))
 }
 
-func (self ImGuiStackSizes) GetSizeOfIDStack() int {
-	return int(C.ImGuiStackSizes_GetSizeOfIDStack(self.handle()))
+func (self ImGuiWindowSettings) SetID(v ImGuiID) {
+	C.ImGuiWindowSettings_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiStackSizes) SetSizeOfColorStack(v int) {
-	C.ImGuiStackSizes_SetSizeOfColorStack(self.handle(), C.short(v))
+func (self ImGuiWindowSettings) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiWindowSettings_GetID(self.handle()))
 }
 
-func (self ImGuiStackSizes) GetSizeOfColorStack() int {
-	return int(C.ImGuiStackSizes_GetSizeOfColorStack(self.handle()))
+func (self ImGuiWindowSettings) SetViewportId(v ImGuiID) {
+	C.ImGuiWindowSettings_SetViewportId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiStackSizes) SetSizeOfStyleVarStack(v int) {
-	C.ImGuiStackSizes_SetSizeOfStyleVarStack(self.handle(), C.short(v))
+func (self ImGuiWindowSettings) GetViewportId() ImGuiID {
+	return ImGuiID(C.ImGuiWindowSettings_GetViewportId(self.handle()))
 }
 
-func (self ImGuiStackSizes) GetSizeOfStyleVarStack() int {
-	return int(C.ImGuiStackSizes_GetSizeOfStyleVarStack(self.handle()))
+func (self ImGuiWindowSettings) SetDockId(v ImGuiID) {
+	C.ImGuiWindowSettings_SetDockId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiStackSizes) SetSizeOfFontStack(v int) {
-	C.ImGuiStackSizes_SetSizeOfFontStack(self.handle(), C.short(v))
+func (self ImGuiWindowSettings) GetDockId() ImGuiID {
+	return ImGuiID(C.ImGuiWindowSettings_GetDockId(self.handle()))
 }
 
-func (self ImGuiStackSizes) GetSizeOfFontStack() int {
-	return int(C.ImGuiStackSizes_GetSizeOfFontStack(self.handle()))
+func (self ImGuiWindowSettings) SetClassId(v ImGuiID) {
+	C.ImGuiWindowSettings_SetClassId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiStackSizes) SetSizeOfFocusScopeStack(v int) {
-	C.ImGuiStackSizes_SetSizeOfFocusScopeStack(self.handle(), C.short(v))
+func (self ImGuiWindowSettings) GetClassId() ImGuiID {
+	return ImGuiID(C.ImGuiWindowSettings_GetClassId(self.handle()))
 }
 
-func (self ImGuiStackSizes) GetSizeOfFocusScopeStack() int {
-	return int(C.ImGuiStackSizes_GetSizeOfFocusScopeStack(self.handle()))
+func (self ImGuiWindowSettings) SetDockOrder(v int) {
+	C.ImGuiWindowSettings_SetDockOrder(self.handle(), C.short(v))
 }
 
-func (self ImGuiStackSizes) SetSizeOfGroupStack(v int) {
-	C.ImGuiStackSizes_SetSizeOfGroupStack(self.handle(), C.short(v))
+func (self ImGuiWindowSettings) GetDockOrder() int {
+	return int(C.ImGuiWindowSettings_GetDockOrder(self.handle()))
 }
 
-func (self ImGuiStackSizes) GetSizeOfGroupStack() int {
-	return int(C.ImGuiStackSizes_GetSizeOfGroupStack(self.handle()))
+func (self ImGuiWindowSettings) SetCollapsed(v bool) {
+	C.ImGuiWindowSettings_SetCollapsed(self.handle(), C.bool(v))
 }
 
-func (self ImGuiStackSizes) SetSizeOfItemFlagsStack(v int) {
-	C.ImGuiStackSizes_SetSizeOfItemFlagsStack(self.handle(), C.short(v))
+func (self ImGuiWindowSettings) GetCollapsed() bool {
+	return C.ImGuiWindowSettings_GetCollapsed(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiStackSizes) GetSizeOfItemFlagsStack() int {
-	return int(C.ImGuiStackSizes_GetSizeOfItemFlagsStack(self.handle()))
+func (self ImGuiWindowSettings) SetWantApply(v bool) {
+	C.ImGuiWindowSettings_SetWantApply(self.handle(), C.bool(v))
 }
 
-func (self ImGuiStackSizes) SetSizeOfBeginPopupStack(v int) {
-	C.ImGuiStackSizes_SetSizeOfBeginPopupStack(self.handle(), C.short(v))
+func (self ImGuiWindowSettings) GetWantApply() bool {
+	return C.ImGuiWindowSettings_GetWantApply(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiStackSizes) GetSizeOfBeginPopupStack() int {
-	return int(C.ImGuiStackSizes_GetSizeOfBeginPopupStack(self.handle()))
+func (self ImGuiSettingsHandler) SetTypeName(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
+
+	C.ImGuiSettingsHandler_SetTypeName(self.handle(), vArg)
 }
 
-func (self ImGuiStackSizes) SetSizeOfDisabledStack(v int) {
-	C.ImGuiStackSizes_SetSizeOfDisabledStack(self.handle(), C.short(v))
+func (self ImGuiSettingsHandler) GetTypeName() string {
+	return C.GoString(C.ImGuiSettingsHandler_GetTypeName(self.handle()))
 }
 
-func (self ImGuiStackSizes) GetSizeOfDisabledStack() int {
-	return int(C.ImGuiStackSizes_GetSizeOfDisabledStack(self.handle()))
+func (self ImGuiSettingsHandler) SetTypeHash(v ImGuiID) {
+	C.ImGuiSettingsHandler_SetTypeHash(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiStyleMod) SetVarIdx(v ImGuiStyleVar) {
-	C.ImGuiStyleMod_SetVarIdx(self.handle(), C.ImGuiStyleVar(v))
+func (self ImGuiSettingsHandler) GetTypeHash() ImGuiID {
+	return ImGuiID(C.ImGuiSettingsHandler_GetTypeHash(self.handle()))
 }
 
-func (self ImGuiStyleMod) GetVarIdx() ImGuiStyleVar {
-	return ImGuiStyleVar(C.ImGuiStyleMod_GetVarIdx(self.handle()))
+func (self ImGuiSettingsHandler) SetUserData(v unsafe.Pointer) {
+	C.ImGuiSettingsHandler_SetUserData(self.handle(), v)
 }
 
-func (self ImGuiTableColumnSortSpecs) SetColumnUserID(v ImGuiID) {
-	C.ImGuiTableColumnSortSpecs_SetColumnUserID(self.handle(), C.ImGuiID(v))
+func (self ImGuiSettingsHandler) GetUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiSettingsHandler_GetUserData(self.handle()))
 }
 
-func (self ImGuiTableColumnSortSpecs) GetColumnUserID() ImGuiID {
-	return ImGuiID(C.ImGuiTableColumnSortSpecs_GetColumnUserID(self.handle()))
+func (self ImGuiMetricsConfig) SetShowDebugLog(v bool) {
+	C.ImGuiMetricsConfig_SetShowDebugLog(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumnSortSpecs) SetColumnIndex(v int) {
-	C.ImGuiTableColumnSortSpecs_SetColumnIndex(self.handle(), C.ImS16(v))
+func (self ImGuiMetricsConfig) GetShowDebugLog() bool {
+	return C.ImGuiMetricsConfig_GetShowDebugLog(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumnSortSpecs) GetColumnIndex() int {
-	return int(C.ImGuiTableColumnSortSpecs_GetColumnIndex(self.handle()))
+func (self ImGuiMetricsConfig) SetShowStackTool(v bool) {
+	C.ImGuiMetricsConfig_SetShowStackTool(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumnSortSpecs) SetSortOrder(v int) {
-	C.ImGuiTableColumnSortSpecs_SetSortOrder(self.handle(), C.ImS16(v))
+func (self ImGuiMetricsConfig) GetShowStackTool() bool {
+	return C.ImGuiMetricsConfig_GetShowStackTool(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumnSortSpecs) GetSortOrder() int {
-	return int(C.ImGuiTableColumnSortSpecs_GetSortOrder(self.handle()))
+func (self ImGuiMetricsConfig) SetShowWindowsRects(v bool) {
+	C.ImGuiMetricsConfig_SetShowWindowsRects(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTableColumnSortSpecs) SetSortDirection(v ImGuiSortDirection) {
-	C.ImGuiTableColumnSortSpecs_SetSortDirection(self.handle(), C.ImGuiSortDirection(v))
+func (self ImGuiMetricsConfig) GetShowWindowsRects() bool {
+	return C.ImGuiMetricsConfig_GetShowWindowsRects(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTableColumnSortSpecs) GetSortDirection() ImGuiSortDirection {
-	return ImGuiSortDirection(C.ImGuiTableColumnSortSpecs_GetSortDirection(self.handle()))
+func (self ImGuiMetricsConfig) SetShowWindowsBeginOrder(v bool) {
+	C.ImGuiMetricsConfig_SetShowWindowsBeginOrder(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTextFilter) SetCountGrep(v int32) {
-	C.ImGuiTextFilter_SetCountGrep(self.handle(), C.int(v))
+func (self ImGuiMetricsConfig) GetShowWindowsBeginOrder() bool {
+	return C.ImGuiMetricsConfig_GetShowWindowsBeginOrder(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTextFilter) GetCountGrep() int {
-	return int(C.ImGuiTextFilter_GetCountGrep(self.handle()))
+func (self ImGuiMetricsConfig) SetShowTablesRects(v bool) {
+	C.ImGuiMetricsConfig_SetShowTablesRects(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetName(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
-
-	C.ImGuiWindow_SetName(self.handle(), vArg)
+func (self ImGuiMetricsConfig) GetShowTablesRects() bool {
+	return C.ImGuiMetricsConfig_GetShowTablesRects(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) SetID(v ImGuiID) {
-	C.ImGuiWindow_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiMetricsConfig) SetShowDrawCmdMesh(v bool) {
+	C.ImGuiMetricsConfig_SetShowDrawCmdMesh(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiWindow_GetID(self.handle()))
+func (self ImGuiMetricsConfig) GetShowDrawCmdMesh() bool {
+	return C.ImGuiMetricsConfig_GetShowDrawCmdMesh(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) SetFlags(v ImGuiWindowFlags) {
-	C.ImGuiWindow_SetFlags(self.handle(), C.ImGuiWindowFlags(v))
+func (self ImGuiMetricsConfig) SetShowDrawCmdBoundingBoxes(v bool) {
+	C.ImGuiMetricsConfig_SetShowDrawCmdBoundingBoxes(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) GetFlags() ImGuiWindowFlags {
-	return ImGuiWindowFlags(C.ImGuiWindow_GetFlags(self.handle()))
+func (self ImGuiMetricsConfig) GetShowDrawCmdBoundingBoxes() bool {
+	return C.ImGuiMetricsConfig_GetShowDrawCmdBoundingBoxes(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) SetFlagsPreviousFrame(v ImGuiWindowFlags) {
-	C.ImGuiWindow_SetFlagsPreviousFrame(self.handle(), C.ImGuiWindowFlags(v))
+func (self ImGuiMetricsConfig) SetShowDockingNodes(v bool) {
+	C.ImGuiMetricsConfig_SetShowDockingNodes(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) GetFlagsPreviousFrame() ImGuiWindowFlags {
-	return ImGuiWindowFlags(C.ImGuiWindow_GetFlagsPreviousFrame(self.handle()))
+func (self ImGuiMetricsConfig) GetShowDockingNodes() bool {
+	return C.ImGuiMetricsConfig_GetShowDockingNodes(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetWindowClass() ImGuiWindowClass {
-	return newImGuiWindowClassFromC(C.ImGuiWindow_GetWindowClass(self.handle()))
+func (self ImGuiMetricsConfig) SetShowWindowsRectsType(v int32) {
+	C.ImGuiMetricsConfig_SetShowWindowsRectsType(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) SetViewport(v ImGuiViewportP) {
-	C.ImGuiWindow_SetViewport(self.handle(), v.handle())
+func (self ImGuiMetricsConfig) GetShowWindowsRectsType() int {
+	return int(C.ImGuiMetricsConfig_GetShowWindowsRectsType(self.handle()))
 }
 
-func (self ImGuiWindow) GetViewport() ImGuiViewportP {
-	return (ImGuiViewportP)(unsafe.Pointer(C.ImGuiWindow_GetViewport(self.handle())))
+func (self ImGuiMetricsConfig) SetShowTablesRectsType(v int32) {
+	C.ImGuiMetricsConfig_SetShowTablesRectsType(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) SetViewportId(v ImGuiID) {
-	C.ImGuiWindow_SetViewportId(self.handle(), C.ImGuiID(v))
+func (self ImGuiMetricsConfig) GetShowTablesRectsType() int {
+	return int(C.ImGuiMetricsConfig_GetShowTablesRectsType(self.handle()))
 }
 
-func (self ImGuiWindow) GetViewportId() ImGuiID {
-	return ImGuiID(C.ImGuiWindow_GetViewportId(self.handle()))
+func (self ImGuiStackLevelInfo) SetID(v ImGuiID) {
+	C.ImGuiStackLevelInfo_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) SetViewportPos(v ImVec2) {
-	C.ImGuiWindow_SetViewportPos(self.handle(), v.toC())
+func (self ImGuiStackLevelInfo) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiStackLevelInfo_GetID(self.handle()))
 }
 
-func (self ImGuiWindow) GetViewportPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetViewportPos(self.handle()))
+func (self ImGuiStackLevelInfo) SetQueryFrameCount(v int) {
+	C.ImGuiStackLevelInfo_SetQueryFrameCount(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiWindow) SetViewportAllowPlatformMonitorExtend(v int32) {
-	C.ImGuiWindow_SetViewportAllowPlatformMonitorExtend(self.handle(), C.int(v))
+func (self ImGuiStackLevelInfo) GetQueryFrameCount() int {
+	return int(C.ImGuiStackLevelInfo_GetQueryFrameCount(self.handle()))
 }
 
-func (self ImGuiWindow) GetViewportAllowPlatformMonitorExtend() int {
-	return int(C.ImGuiWindow_GetViewportAllowPlatformMonitorExtend(self.handle()))
+func (self ImGuiStackLevelInfo) SetQuerySuccess(v bool) {
+	C.ImGuiStackLevelInfo_SetQuerySuccess(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetPos(v ImVec2) {
-	C.ImGuiWindow_SetPos(self.handle(), v.toC())
+func (self ImGuiStackLevelInfo) GetQuerySuccess() bool {
+	return C.ImGuiStackLevelInfo_GetQuerySuccess(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetPos(self.handle()))
+func (self ImGuiStackLevelInfo) SetDataType(v ImGuiDataType) {
+	C.ImGuiStackLevelInfo_SetDataType(self.handle(), C.ImGuiDataType(v))
 }
 
-func (self ImGuiWindow) SetSize(v ImVec2) {
-	C.ImGuiWindow_SetSize(self.handle(), v.toC())
+func (self ImGuiStackLevelInfo) GetDataType() ImGuiDataType {
+	return ImGuiDataType(C.ImGuiStackLevelInfo_GetDataType(self.handle()))
 }
 
-func (self ImGuiWindow) GetSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetSize(self.handle()))
+func (self ImGuiStackTool) SetLastActiveFrame(v int32) {
+	C.ImGuiStackTool_SetLastActiveFrame(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) SetSizeFull(v ImVec2) {
-	C.ImGuiWindow_SetSizeFull(self.handle(), v.toC())
+func (self ImGuiStackTool) GetLastActiveFrame() int {
+	return int(C.ImGuiStackTool_GetLastActiveFrame(self.handle()))
 }
 
-func (self ImGuiWindow) GetSizeFull() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetSizeFull(self.handle()))
+func (self ImGuiStackTool) SetStackLevel(v int32) {
+	C.ImGuiStackTool_SetStackLevel(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) SetContentSize(v ImVec2) {
-	C.ImGuiWindow_SetContentSize(self.handle(), v.toC())
+func (self ImGuiStackTool) GetStackLevel() int {
+	return int(C.ImGuiStackTool_GetStackLevel(self.handle()))
 }
 
-func (self ImGuiWindow) GetContentSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetContentSize(self.handle()))
+func (self ImGuiStackTool) SetQueryId(v ImGuiID) {
+	C.ImGuiStackTool_SetQueryId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) SetContentSizeIdeal(v ImVec2) {
-	C.ImGuiWindow_SetContentSizeIdeal(self.handle(), v.toC())
+func (self ImGuiStackTool) GetQueryId() ImGuiID {
+	return ImGuiID(C.ImGuiStackTool_GetQueryId(self.handle()))
 }
 
-func (self ImGuiWindow) GetContentSizeIdeal() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetContentSizeIdeal(self.handle()))
+func (self ImGuiStackTool) SetCopyToClipboardOnCtrlC(v bool) {
+	C.ImGuiStackTool_SetCopyToClipboardOnCtrlC(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetContentSizeExplicit(v ImVec2) {
-	C.ImGuiWindow_SetContentSizeExplicit(self.handle(), v.toC())
+func (self ImGuiStackTool) GetCopyToClipboardOnCtrlC() bool {
+	return C.ImGuiStackTool_GetCopyToClipboardOnCtrlC(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetContentSizeExplicit() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetContentSizeExplicit(self.handle()))
+func (self ImGuiStackTool) SetCopyToClipboardLastTime(v float32) {
+	C.ImGuiStackTool_SetCopyToClipboardLastTime(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindow) SetWindowPadding(v ImVec2) {
-	C.ImGuiWindow_SetWindowPadding(self.handle(), v.toC())
+func (self ImGuiStackTool) GetCopyToClipboardLastTime() float32 {
+	return float32(C.ImGuiStackTool_GetCopyToClipboardLastTime(self.handle()))
 }
 
-func (self ImGuiWindow) GetWindowPadding() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetWindowPadding(self.handle()))
+func (self ImGuiContextHook) SetHookId(v ImGuiID) {
+	C.ImGuiContextHook_SetHookId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) SetWindowRounding(v float32) {
-	C.ImGuiWindow_SetWindowRounding(self.handle(), C.float(v))
+func (self ImGuiContextHook) GetHookId() ImGuiID {
+	return ImGuiID(C.ImGuiContextHook_GetHookId(self.handle()))
 }
 
-func (self ImGuiWindow) GetWindowRounding() float32 {
-	return float32(C.ImGuiWindow_GetWindowRounding(self.handle()))
+func (self ImGuiContextHook) SetType(v ImGuiContextHookType) {
+	C.ImGuiContextHook_SetType(self.handle(), C.ImGuiContextHookType(v))
 }
 
-func (self ImGuiWindow) SetWindowBorderSize(v float32) {
-	C.ImGuiWindow_SetWindowBorderSize(self.handle(), C.float(v))
+func (self ImGuiContextHook) GetType() ImGuiContextHookType {
+	return ImGuiContextHookType(C.ImGuiContextHook_GetType(self.handle()))
 }
 
-func (self ImGuiWindow) GetWindowBorderSize() float32 {
-	return float32(C.ImGuiWindow_GetWindowBorderSize(self.handle()))
+func (self ImGuiContextHook) SetOwner(v ImGuiID) {
+	C.ImGuiContextHook_SetOwner(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) SetNameBufLen(v int32) {
-	C.ImGuiWindow_SetNameBufLen(self.handle(), C.int(v))
+func (self ImGuiContextHook) GetOwner() ImGuiID {
+	return ImGuiID(C.ImGuiContextHook_GetOwner(self.handle()))
 }
 
-func (self ImGuiWindow) GetNameBufLen() int {
-	return int(C.ImGuiWindow_GetNameBufLen(self.handle()))
+func (self ImGuiContextHook) SetUserData(v unsafe.Pointer) {
+	C.ImGuiContextHook_SetUserData(self.handle(), v)
 }
 
-func (self ImGuiWindow) SetMoveId(v ImGuiID) {
-	C.ImGuiWindow_SetMoveId(self.handle(), C.ImGuiID(v))
+func (self ImGuiContextHook) GetUserData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiContextHook_GetUserData(self.handle()))
 }
 
-func (self ImGuiWindow) GetMoveId() ImGuiID {
-	return ImGuiID(C.ImGuiWindow_GetMoveId(self.handle()))
+func (self ImGuiContext) SetInitialized(v bool) {
+	C.ImGuiContext_SetInitialized(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetTabId(v ImGuiID) {
-	C.ImGuiWindow_SetTabId(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) GetInitialized() bool {
+	return C.ImGuiContext_GetInitialized(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetTabId() ImGuiID {
-	return ImGuiID(C.ImGuiWindow_GetTabId(self.handle()))
+func (self ImGuiContext) SetFontAtlasOwnedByContext(v bool) {
+	C.ImGuiContext_SetFontAtlasOwnedByContext(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetChildId(v ImGuiID) {
-	C.ImGuiWindow_SetChildId(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) GetFontAtlasOwnedByContext() bool {
+	return C.ImGuiContext_GetFontAtlasOwnedByContext(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetChildId() ImGuiID {
-	return ImGuiID(C.ImGuiWindow_GetChildId(self.handle()))
+func (self ImGuiContext) GetIO() ImGuiIO {
+	return newImGuiIOFromC(C.ImGuiContext_GetIO(self.handle()))
 }
 
-func (self ImGuiWindow) SetScroll(v ImVec2) {
-	C.ImGuiWindow_SetScroll(self.handle(), v.toC())
+func (self ImGuiContext) GetPlatformIO() ImGuiPlatformIO {
+	return newImGuiPlatformIOFromC(C.ImGuiContext_GetPlatformIO(self.handle()))
 }
 
-func (self ImGuiWindow) GetScroll() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetScroll(self.handle()))
+func (self ImGuiContext) GetStyle() ImGuiStyle {
+	return newImGuiStyleFromC(C.ImGuiContext_GetStyle(self.handle()))
 }
 
-func (self ImGuiWindow) SetScrollMax(v ImVec2) {
-	C.ImGuiWindow_SetScrollMax(self.handle(), v.toC())
+func (self ImGuiContext) SetConfigFlagsCurrFrame(v ImGuiConfigFlags) {
+	C.ImGuiContext_SetConfigFlagsCurrFrame(self.handle(), C.ImGuiConfigFlags(v))
 }
 
-func (self ImGuiWindow) GetScrollMax() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetScrollMax(self.handle()))
+func (self ImGuiContext) GetConfigFlagsCurrFrame() ImGuiConfigFlags {
+	return ImGuiConfigFlags(C.ImGuiContext_GetConfigFlagsCurrFrame(self.handle()))
 }
 
-func (self ImGuiWindow) SetScrollTarget(v ImVec2) {
-	C.ImGuiWindow_SetScrollTarget(self.handle(), v.toC())
+func (self ImGuiContext) SetConfigFlagsLastFrame(v ImGuiConfigFlags) {
+	C.ImGuiContext_SetConfigFlagsLastFrame(self.handle(), C.ImGuiConfigFlags(v))
 }
 
-func (self ImGuiWindow) GetScrollTarget() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetScrollTarget(self.handle()))
+func (self ImGuiContext) GetConfigFlagsLastFrame() ImGuiConfigFlags {
+	return ImGuiConfigFlags(C.ImGuiContext_GetConfigFlagsLastFrame(self.handle()))
 }
 
-func (self ImGuiWindow) SetScrollTargetCenterRatio(v ImVec2) {
-	C.ImGuiWindow_SetScrollTargetCenterRatio(self.handle(), v.toC())
+func (self ImGuiContext) SetFont(v ImFont) {
+	C.ImGuiContext_SetFont(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetScrollTargetCenterRatio() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetScrollTargetCenterRatio(self.handle()))
+func (self ImGuiContext) GetFont() ImFont {
+	return (ImFont)(unsafe.Pointer(C.ImGuiContext_GetFont(self.handle())))
 }
 
-func (self ImGuiWindow) SetScrollTargetEdgeSnapDist(v ImVec2) {
-	C.ImGuiWindow_SetScrollTargetEdgeSnapDist(self.handle(), v.toC())
+func (self ImGuiContext) SetFontSize(v float32) {
+	C.ImGuiContext_SetFontSize(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindow) GetScrollTargetEdgeSnapDist() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetScrollTargetEdgeSnapDist(self.handle()))
+func (self ImGuiContext) GetFontSize() float32 {
+	return float32(C.ImGuiContext_GetFontSize(self.handle()))
 }
 
-func (self ImGuiWindow) SetScrollbarSizes(v ImVec2) {
-	C.ImGuiWindow_SetScrollbarSizes(self.handle(), v.toC())
+func (self ImGuiContext) SetFontBaseSize(v float32) {
+	C.ImGuiContext_SetFontBaseSize(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindow) GetScrollbarSizes() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetScrollbarSizes(self.handle()))
+func (self ImGuiContext) GetFontBaseSize() float32 {
+	return float32(C.ImGuiContext_GetFontBaseSize(self.handle()))
 }
 
-func (self ImGuiWindow) SetScrollbarX(v bool) {
-	C.ImGuiWindow_SetScrollbarX(self.handle(), C.bool(v))
+func (self ImGuiContext) GetDrawListSharedData() ImDrawListSharedData {
+	return newImDrawListSharedDataFromC(C.ImGuiContext_GetDrawListSharedData(self.handle()))
 }
 
-func (self ImGuiWindow) GetScrollbarX() bool {
-	return C.ImGuiWindow_GetScrollbarX(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetTime(v float64) {
+	C.ImGuiContext_SetTime(self.handle(), C.double(v))
 }
 
-func (self ImGuiWindow) SetScrollbarY(v bool) {
-	C.ImGuiWindow_SetScrollbarY(self.handle(), C.bool(v))
+func (self ImGuiContext) GetTime() float64 {
+	return float64(C.ImGuiContext_GetTime(self.handle()))
 }
 
-func (self ImGuiWindow) GetScrollbarY() bool {
-	return C.ImGuiWindow_GetScrollbarY(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetFrameCount(v int32) {
+	C.ImGuiContext_SetFrameCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) SetViewportOwned(v bool) {
-	C.ImGuiWindow_SetViewportOwned(self.handle(), C.bool(v))
+func (self ImGuiContext) GetFrameCount() int {
+	return int(C.ImGuiContext_GetFrameCount(self.handle()))
 }
 
-func (self ImGuiWindow) GetViewportOwned() bool {
-	return C.ImGuiWindow_GetViewportOwned(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetFrameCountEnded(v int32) {
+	C.ImGuiContext_SetFrameCountEnded(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) SetActive(v bool) {
-	C.ImGuiWindow_SetActive(self.handle(), C.bool(v))
+func (self ImGuiContext) GetFrameCountEnded() int {
+	return int(C.ImGuiContext_GetFrameCountEnded(self.handle()))
 }
 
-func (self ImGuiWindow) GetActive() bool {
-	return C.ImGuiWindow_GetActive(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetFrameCountPlatformEnded(v int32) {
+	C.ImGuiContext_SetFrameCountPlatformEnded(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) SetWasActive(v bool) {
-	C.ImGuiWindow_SetWasActive(self.handle(), C.bool(v))
+func (self ImGuiContext) GetFrameCountPlatformEnded() int {
+	return int(C.ImGuiContext_GetFrameCountPlatformEnded(self.handle()))
 }
 
-func (self ImGuiWindow) GetWasActive() bool {
-	return C.ImGuiWindow_GetWasActive(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetFrameCountRendered(v int32) {
+	C.ImGuiContext_SetFrameCountRendered(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) SetWriteAccessed(v bool) {
-	C.ImGuiWindow_SetWriteAccessed(self.handle(), C.bool(v))
+func (self ImGuiContext) GetFrameCountRendered() int {
+	return int(C.ImGuiContext_GetFrameCountRendered(self.handle()))
 }
 
-func (self ImGuiWindow) GetWriteAccessed() bool {
-	return C.ImGuiWindow_GetWriteAccessed(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetWithinFrameScope(v bool) {
+	C.ImGuiContext_SetWithinFrameScope(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetCollapsed(v bool) {
-	C.ImGuiWindow_SetCollapsed(self.handle(), C.bool(v))
+func (self ImGuiContext) GetWithinFrameScope() bool {
+	return C.ImGuiContext_GetWithinFrameScope(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetCollapsed() bool {
-	return C.ImGuiWindow_GetCollapsed(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetWithinFrameScopeWithImplicitWindow(v bool) {
+	C.ImGuiContext_SetWithinFrameScopeWithImplicitWindow(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetWantCollapseToggle(v bool) {
-	C.ImGuiWindow_SetWantCollapseToggle(self.handle(), C.bool(v))
+func (self ImGuiContext) GetWithinFrameScopeWithImplicitWindow() bool {
+	return C.ImGuiContext_GetWithinFrameScopeWithImplicitWindow(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetWantCollapseToggle() bool {
-	return C.ImGuiWindow_GetWantCollapseToggle(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetWithinEndChild(v bool) {
+	C.ImGuiContext_SetWithinEndChild(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetSkipItems(v bool) {
-	C.ImGuiWindow_SetSkipItems(self.handle(), C.bool(v))
+func (self ImGuiContext) GetWithinEndChild() bool {
+	return C.ImGuiContext_GetWithinEndChild(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetSkipItems() bool {
-	return C.ImGuiWindow_GetSkipItems(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetGcCompactAll(v bool) {
+	C.ImGuiContext_SetGcCompactAll(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetAppearing(v bool) {
-	C.ImGuiWindow_SetAppearing(self.handle(), C.bool(v))
+func (self ImGuiContext) GetGcCompactAll() bool {
+	return C.ImGuiContext_GetGcCompactAll(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetAppearing() bool {
-	return C.ImGuiWindow_GetAppearing(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetTestEngineHookItems(v bool) {
+	C.ImGuiContext_SetTestEngineHookItems(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetHidden(v bool) {
-	C.ImGuiWindow_SetHidden(self.handle(), C.bool(v))
+func (self ImGuiContext) GetTestEngineHookItems() bool {
+	return C.ImGuiContext_GetTestEngineHookItems(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetHidden() bool {
-	return C.ImGuiWindow_GetHidden(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetTestEngine(v unsafe.Pointer) {
+	C.ImGuiContext_SetTestEngine(self.handle(), v)
 }
 
-func (self ImGuiWindow) SetIsFallbackWindow(v bool) {
-	C.ImGuiWindow_SetIsFallbackWindow(self.handle(), C.bool(v))
+func (self ImGuiContext) GetTestEngine() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiContext_GetTestEngine(self.handle()))
 }
 
-func (self ImGuiWindow) GetIsFallbackWindow() bool {
-	return C.ImGuiWindow_GetIsFallbackWindow(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetWindowsById() ImGuiStorage {
+	return newImGuiStorageFromC(C.ImGuiContext_GetWindowsById(self.handle()))
 }
 
-func (self ImGuiWindow) SetIsExplicitChild(v bool) {
-	C.ImGuiWindow_SetIsExplicitChild(self.handle(), C.bool(v))
+func (self ImGuiContext) SetWindowsActiveCount(v int32) {
+	C.ImGuiContext_SetWindowsActiveCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) GetIsExplicitChild() bool {
-	return C.ImGuiWindow_GetIsExplicitChild(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetWindowsActiveCount() int {
+	return int(C.ImGuiContext_GetWindowsActiveCount(self.handle()))
 }
 
-func (self ImGuiWindow) SetHasCloseButton(v bool) {
-	C.ImGuiWindow_SetHasCloseButton(self.handle(), C.bool(v))
+func (self ImGuiContext) SetWindowsHoverPadding(v ImVec2) {
+	C.ImGuiContext_SetWindowsHoverPadding(self.handle(), v.toC())
 }
 
-func (self ImGuiWindow) GetHasCloseButton() bool {
-	return C.ImGuiWindow_GetHasCloseButton(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetWindowsHoverPadding() ImVec2 {
+	return newImVec2FromC(C.ImGuiContext_GetWindowsHoverPadding(self.handle()))
 }
 
-func (self ImGuiWindow) SetBeginCount(v int) {
-	C.ImGuiWindow_SetBeginCount(self.handle(), C.short(v))
+func (self ImGuiContext) SetCurrentWindow(v ImGuiWindow) {
+	C.ImGuiContext_SetCurrentWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetBeginCount() int {
-	return int(C.ImGuiWindow_GetBeginCount(self.handle()))
+func (self ImGuiContext) GetCurrentWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetCurrentWindow(self.handle())))
 }
 
-func (self ImGuiWindow) SetBeginOrderWithinParent(v int) {
-	C.ImGuiWindow_SetBeginOrderWithinParent(self.handle(), C.short(v))
+func (self ImGuiContext) SetHoveredWindow(v ImGuiWindow) {
+	C.ImGuiContext_SetHoveredWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetBeginOrderWithinParent() int {
-	return int(C.ImGuiWindow_GetBeginOrderWithinParent(self.handle()))
+func (self ImGuiContext) GetHoveredWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetHoveredWindow(self.handle())))
 }
 
-func (self ImGuiWindow) SetBeginOrderWithinContext(v int) {
-	C.ImGuiWindow_SetBeginOrderWithinContext(self.handle(), C.short(v))
+func (self ImGuiContext) SetHoveredWindowUnderMovingWindow(v ImGuiWindow) {
+	C.ImGuiContext_SetHoveredWindowUnderMovingWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetBeginOrderWithinContext() int {
-	return int(C.ImGuiWindow_GetBeginOrderWithinContext(self.handle()))
+func (self ImGuiContext) GetHoveredWindowUnderMovingWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetHoveredWindowUnderMovingWindow(self.handle())))
 }
 
-func (self ImGuiWindow) SetFocusOrder(v int) {
-	C.ImGuiWindow_SetFocusOrder(self.handle(), C.short(v))
+func (self ImGuiContext) SetHoveredDockNode(v ImGuiDockNode) {
+	C.ImGuiContext_SetHoveredDockNode(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetFocusOrder() int {
-	return int(C.ImGuiWindow_GetFocusOrder(self.handle()))
+func (self ImGuiContext) GetHoveredDockNode() ImGuiDockNode {
+	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiContext_GetHoveredDockNode(self.handle())))
 }
 
-func (self ImGuiWindow) SetPopupId(v ImGuiID) {
-	C.ImGuiWindow_SetPopupId(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) SetMovingWindow(v ImGuiWindow) {
+	C.ImGuiContext_SetMovingWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetPopupId() ImGuiID {
-	return ImGuiID(C.ImGuiWindow_GetPopupId(self.handle()))
+func (self ImGuiContext) GetMovingWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetMovingWindow(self.handle())))
 }
 
-func (self ImGuiWindow) SetAutoFitFramesX(v int) {
-	C.ImGuiWindow_SetAutoFitFramesX(self.handle(), C.ImS8(v))
+func (self ImGuiContext) SetWheelingWindow(v ImGuiWindow) {
+	C.ImGuiContext_SetWheelingWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetAutoFitFramesX() int {
-	return int(C.ImGuiWindow_GetAutoFitFramesX(self.handle()))
+func (self ImGuiContext) GetWheelingWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetWheelingWindow(self.handle())))
 }
 
-func (self ImGuiWindow) SetAutoFitFramesY(v int) {
-	C.ImGuiWindow_SetAutoFitFramesY(self.handle(), C.ImS8(v))
+func (self ImGuiContext) SetWheelingWindowRefMousePos(v ImVec2) {
+	C.ImGuiContext_SetWheelingWindowRefMousePos(self.handle(), v.toC())
 }
 
-func (self ImGuiWindow) GetAutoFitFramesY() int {
-	return int(C.ImGuiWindow_GetAutoFitFramesY(self.handle()))
+func (self ImGuiContext) GetWheelingWindowRefMousePos() ImVec2 {
+	return newImVec2FromC(C.ImGuiContext_GetWheelingWindowRefMousePos(self.handle()))
 }
 
-func (self ImGuiWindow) SetAutoFitChildAxises(v int) {
-	C.ImGuiWindow_SetAutoFitChildAxises(self.handle(), C.ImS8(v))
+func (self ImGuiContext) SetWheelingWindowTimer(v float32) {
+	C.ImGuiContext_SetWheelingWindowTimer(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindow) GetAutoFitChildAxises() int {
-	return int(C.ImGuiWindow_GetAutoFitChildAxises(self.handle()))
+func (self ImGuiContext) GetWheelingWindowTimer() float32 {
+	return float32(C.ImGuiContext_GetWheelingWindowTimer(self.handle()))
 }
 
-func (self ImGuiWindow) SetAutoFitOnlyGrows(v bool) {
-	C.ImGuiWindow_SetAutoFitOnlyGrows(self.handle(), C.bool(v))
+func (self ImGuiContext) SetDebugHookIdInfo(v ImGuiID) {
+	C.ImGuiContext_SetDebugHookIdInfo(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetAutoFitOnlyGrows() bool {
-	return C.ImGuiWindow_GetAutoFitOnlyGrows(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetDebugHookIdInfo() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetDebugHookIdInfo(self.handle()))
 }
 
-func (self ImGuiWindow) SetAutoPosLastDirection(v ImGuiDir) {
-	C.ImGuiWindow_SetAutoPosLastDirection(self.handle(), C.ImGuiDir(v))
+func (self ImGuiContext) SetHoveredId(v ImGuiID) {
+	C.ImGuiContext_SetHoveredId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetAutoPosLastDirection() ImGuiDir {
-	return ImGuiDir(C.ImGuiWindow_GetAutoPosLastDirection(self.handle()))
+func (self ImGuiContext) GetHoveredId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetHoveredId(self.handle()))
 }
 
-func (self ImGuiWindow) SetHiddenFramesCanSkipItems(v int) {
-	C.ImGuiWindow_SetHiddenFramesCanSkipItems(self.handle(), C.ImS8(v))
+func (self ImGuiContext) SetHoveredIdPreviousFrame(v ImGuiID) {
+	C.ImGuiContext_SetHoveredIdPreviousFrame(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetHiddenFramesCanSkipItems() int {
-	return int(C.ImGuiWindow_GetHiddenFramesCanSkipItems(self.handle()))
+func (self ImGuiContext) GetHoveredIdPreviousFrame() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetHoveredIdPreviousFrame(self.handle()))
 }
 
-func (self ImGuiWindow) SetHiddenFramesCannotSkipItems(v int) {
-	C.ImGuiWindow_SetHiddenFramesCannotSkipItems(self.handle(), C.ImS8(v))
+func (self ImGuiContext) SetHoveredIdAllowOverlap(v bool) {
+	C.ImGuiContext_SetHoveredIdAllowOverlap(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) GetHiddenFramesCannotSkipItems() int {
-	return int(C.ImGuiWindow_GetHiddenFramesCannotSkipItems(self.handle()))
+func (self ImGuiContext) GetHoveredIdAllowOverlap() bool {
+	return C.ImGuiContext_GetHoveredIdAllowOverlap(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) SetHiddenFramesForRenderOnly(v int) {
-	C.ImGuiWindow_SetHiddenFramesForRenderOnly(self.handle(), C.ImS8(v))
+func (self ImGuiContext) SetHoveredIdUsingMouseWheel(v bool) {
+	C.ImGuiContext_SetHoveredIdUsingMouseWheel(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) GetHiddenFramesForRenderOnly() int {
-	return int(C.ImGuiWindow_GetHiddenFramesForRenderOnly(self.handle()))
+func (self ImGuiContext) GetHoveredIdUsingMouseWheel() bool {
+	return C.ImGuiContext_GetHoveredIdUsingMouseWheel(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) SetDisableInputsFrames(v int) {
-	C.ImGuiWindow_SetDisableInputsFrames(self.handle(), C.ImS8(v))
+func (self ImGuiContext) SetHoveredIdPreviousFrameUsingMouseWheel(v bool) {
+	C.ImGuiContext_SetHoveredIdPreviousFrameUsingMouseWheel(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) GetDisableInputsFrames() int {
-	return int(C.ImGuiWindow_GetDisableInputsFrames(self.handle()))
+func (self ImGuiContext) GetHoveredIdPreviousFrameUsingMouseWheel() bool {
+	return C.ImGuiContext_GetHoveredIdPreviousFrameUsingMouseWheel(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) SetSetWindowPosAllowFlags(v ImGuiCond) {
-	C.ImGuiWindow_SetSetWindowPosAllowFlags(self.handle(), C.ImGuiCond(v))
+func (self ImGuiContext) SetHoveredIdDisabled(v bool) {
+	C.ImGuiContext_SetHoveredIdDisabled(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) GetSetWindowPosAllowFlags() ImGuiCond {
-	return ImGuiCond(C.ImGuiWindow_GetSetWindowPosAllowFlags(self.handle()))
+func (self ImGuiContext) GetHoveredIdDisabled() bool {
+	return C.ImGuiContext_GetHoveredIdDisabled(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) SetSetWindowSizeAllowFlags(v ImGuiCond) {
-	C.ImGuiWindow_SetSetWindowSizeAllowFlags(self.handle(), C.ImGuiCond(v))
+func (self ImGuiContext) SetHoveredIdTimer(v float32) {
+	C.ImGuiContext_SetHoveredIdTimer(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindow) GetSetWindowSizeAllowFlags() ImGuiCond {
-	return ImGuiCond(C.ImGuiWindow_GetSetWindowSizeAllowFlags(self.handle()))
+func (self ImGuiContext) GetHoveredIdTimer() float32 {
+	return float32(C.ImGuiContext_GetHoveredIdTimer(self.handle()))
 }
 
-func (self ImGuiWindow) SetSetWindowCollapsedAllowFlags(v ImGuiCond) {
-	C.ImGuiWindow_SetSetWindowCollapsedAllowFlags(self.handle(), C.ImGuiCond(v))
+func (self ImGuiContext) SetHoveredIdNotActiveTimer(v float32) {
+	C.ImGuiContext_SetHoveredIdNotActiveTimer(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindow) GetSetWindowCollapsedAllowFlags() ImGuiCond {
-	return ImGuiCond(C.ImGuiWindow_GetSetWindowCollapsedAllowFlags(self.handle()))
+func (self ImGuiContext) GetHoveredIdNotActiveTimer() float32 {
+	return float32(C.ImGuiContext_GetHoveredIdNotActiveTimer(self.handle()))
 }
 
-func (self ImGuiWindow) SetSetWindowDockAllowFlags(v ImGuiCond) {
-	C.ImGuiWindow_SetSetWindowDockAllowFlags(self.handle(), C.ImGuiCond(v))
+func (self ImGuiContext) SetActiveId(v ImGuiID) {
+	C.ImGuiContext_SetActiveId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetSetWindowDockAllowFlags() ImGuiCond {
-	return ImGuiCond(C.ImGuiWindow_GetSetWindowDockAllowFlags(self.handle()))
+func (self ImGuiContext) GetActiveId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetActiveId(self.handle()))
 }
 
-func (self ImGuiWindow) SetSetWindowPosVal(v ImVec2) {
-	C.ImGuiWindow_SetSetWindowPosVal(self.handle(), v.toC())
+func (self ImGuiContext) SetActiveIdIsAlive(v ImGuiID) {
+	C.ImGuiContext_SetActiveIdIsAlive(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetSetWindowPosVal() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetSetWindowPosVal(self.handle()))
+func (self ImGuiContext) GetActiveIdIsAlive() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetActiveIdIsAlive(self.handle()))
 }
 
-func (self ImGuiWindow) SetSetWindowPosPivot(v ImVec2) {
-	C.ImGuiWindow_SetSetWindowPosPivot(self.handle(), v.toC())
+func (self ImGuiContext) SetActiveIdTimer(v float32) {
+	C.ImGuiContext_SetActiveIdTimer(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindow) GetSetWindowPosPivot() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindow_GetSetWindowPosPivot(self.handle()))
+func (self ImGuiContext) GetActiveIdTimer() float32 {
+	return float32(C.ImGuiContext_GetActiveIdTimer(self.handle()))
 }
 
-func (self ImGuiWindow) GetDC() ImGuiWindowTempData {
-	return newImGuiWindowTempDataFromC(C.ImGuiWindow_GetDC(self.handle()))
+func (self ImGuiContext) SetActiveIdIsJustActivated(v bool) {
+	C.ImGuiContext_SetActiveIdIsJustActivated(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetOuterRectClipped(v ImRect) {
-	C.ImGuiWindow_SetOuterRectClipped(self.handle(), v.toC())
+func (self ImGuiContext) GetActiveIdIsJustActivated() bool {
+	return C.ImGuiContext_GetActiveIdIsJustActivated(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetOuterRectClipped() ImRect {
-	return newImRectFromC(C.ImGuiWindow_GetOuterRectClipped(self.handle()))
+func (self ImGuiContext) SetActiveIdAllowOverlap(v bool) {
+	C.ImGuiContext_SetActiveIdAllowOverlap(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetInnerRect(v ImRect) {
-	C.ImGuiWindow_SetInnerRect(self.handle(), v.toC())
+func (self ImGuiContext) GetActiveIdAllowOverlap() bool {
+	return C.ImGuiContext_GetActiveIdAllowOverlap(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetInnerRect() ImRect {
-	return newImRectFromC(C.ImGuiWindow_GetInnerRect(self.handle()))
+func (self ImGuiContext) SetActiveIdNoClearOnFocusLoss(v bool) {
+	C.ImGuiContext_SetActiveIdNoClearOnFocusLoss(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetInnerClipRect(v ImRect) {
-	C.ImGuiWindow_SetInnerClipRect(self.handle(), v.toC())
+func (self ImGuiContext) GetActiveIdNoClearOnFocusLoss() bool {
+	return C.ImGuiContext_GetActiveIdNoClearOnFocusLoss(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetInnerClipRect() ImRect {
-	return newImRectFromC(C.ImGuiWindow_GetInnerClipRect(self.handle()))
+func (self ImGuiContext) SetActiveIdHasBeenPressedBefore(v bool) {
+	C.ImGuiContext_SetActiveIdHasBeenPressedBefore(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetWorkRect(v ImRect) {
-	C.ImGuiWindow_SetWorkRect(self.handle(), v.toC())
+func (self ImGuiContext) GetActiveIdHasBeenPressedBefore() bool {
+	return C.ImGuiContext_GetActiveIdHasBeenPressedBefore(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetWorkRect() ImRect {
-	return newImRectFromC(C.ImGuiWindow_GetWorkRect(self.handle()))
+func (self ImGuiContext) SetActiveIdHasBeenEditedBefore(v bool) {
+	C.ImGuiContext_SetActiveIdHasBeenEditedBefore(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetParentWorkRect(v ImRect) {
-	C.ImGuiWindow_SetParentWorkRect(self.handle(), v.toC())
+func (self ImGuiContext) GetActiveIdHasBeenEditedBefore() bool {
+	return C.ImGuiContext_GetActiveIdHasBeenEditedBefore(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetParentWorkRect() ImRect {
-	return newImRectFromC(C.ImGuiWindow_GetParentWorkRect(self.handle()))
+func (self ImGuiContext) SetActiveIdHasBeenEditedThisFrame(v bool) {
+	C.ImGuiContext_SetActiveIdHasBeenEditedThisFrame(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) SetClipRect(v ImRect) {
-	C.ImGuiWindow_SetClipRect(self.handle(), v.toC())
+func (self ImGuiContext) GetActiveIdHasBeenEditedThisFrame() bool {
+	return C.ImGuiContext_GetActiveIdHasBeenEditedThisFrame(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) GetClipRect() ImRect {
-	return newImRectFromC(C.ImGuiWindow_GetClipRect(self.handle()))
+func (self ImGuiContext) SetActiveIdClickOffset(v ImVec2) {
+	C.ImGuiContext_SetActiveIdClickOffset(self.handle(), v.toC())
 }
 
-func (self ImGuiWindow) SetContentRegionRect(v ImRect) {
-	C.ImGuiWindow_SetContentRegionRect(self.handle(), v.toC())
+func (self ImGuiContext) GetActiveIdClickOffset() ImVec2 {
+	return newImVec2FromC(C.ImGuiContext_GetActiveIdClickOffset(self.handle()))
 }
 
-func (self ImGuiWindow) GetContentRegionRect() ImRect {
-	return newImRectFromC(C.ImGuiWindow_GetContentRegionRect(self.handle()))
+func (self ImGuiContext) SetActiveIdWindow(v ImGuiWindow) {
+	C.ImGuiContext_SetActiveIdWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) SetLastFrameActive(v int32) {
-	C.ImGuiWindow_SetLastFrameActive(self.handle(), C.int(v))
+func (self ImGuiContext) GetActiveIdWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetActiveIdWindow(self.handle())))
 }
 
-func (self ImGuiWindow) GetLastFrameActive() int {
-	return int(C.ImGuiWindow_GetLastFrameActive(self.handle()))
+func (self ImGuiContext) SetActiveIdSource(v ImGuiInputSource) {
+	C.ImGuiContext_SetActiveIdSource(self.handle(), C.ImGuiInputSource(v))
 }
 
-func (self ImGuiWindow) SetLastFrameJustFocused(v int32) {
-	C.ImGuiWindow_SetLastFrameJustFocused(self.handle(), C.int(v))
+func (self ImGuiContext) GetActiveIdSource() ImGuiInputSource {
+	return ImGuiInputSource(C.ImGuiContext_GetActiveIdSource(self.handle()))
 }
 
-func (self ImGuiWindow) GetLastFrameJustFocused() int {
-	return int(C.ImGuiWindow_GetLastFrameJustFocused(self.handle()))
+func (self ImGuiContext) SetActiveIdMouseButton(v int32) {
+	C.ImGuiContext_SetActiveIdMouseButton(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) SetLastTimeActive(v float32) {
-	C.ImGuiWindow_SetLastTimeActive(self.handle(), C.float(v))
+func (self ImGuiContext) GetActiveIdMouseButton() int {
+	return int(C.ImGuiContext_GetActiveIdMouseButton(self.handle()))
 }
 
-func (self ImGuiWindow) GetLastTimeActive() float32 {
-	return float32(C.ImGuiWindow_GetLastTimeActive(self.handle()))
+func (self ImGuiContext) SetActiveIdPreviousFrame(v ImGuiID) {
+	C.ImGuiContext_SetActiveIdPreviousFrame(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) SetItemWidthDefault(v float32) {
-	C.ImGuiWindow_SetItemWidthDefault(self.handle(), C.float(v))
+func (self ImGuiContext) GetActiveIdPreviousFrame() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetActiveIdPreviousFrame(self.handle()))
 }
 
-func (self ImGuiWindow) GetItemWidthDefault() float32 {
-	return float32(C.ImGuiWindow_GetItemWidthDefault(self.handle()))
+func (self ImGuiContext) SetActiveIdPreviousFrameIsAlive(v bool) {
+	C.ImGuiContext_SetActiveIdPreviousFrameIsAlive(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) GetStateStorage() ImGuiStorage {
-	return newImGuiStorageFromC(C.ImGuiWindow_GetStateStorage(self.handle()))
+func (self ImGuiContext) GetActiveIdPreviousFrameIsAlive() bool {
+	return C.ImGuiContext_GetActiveIdPreviousFrameIsAlive(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) SetFontWindowScale(v float32) {
-	C.ImGuiWindow_SetFontWindowScale(self.handle(), C.float(v))
+func (self ImGuiContext) SetActiveIdPreviousFrameHasBeenEditedBefore(v bool) {
+	C.ImGuiContext_SetActiveIdPreviousFrameHasBeenEditedBefore(self.handle(), C.bool(v))
 }
 
-func (self ImGuiWindow) GetFontWindowScale() float32 {
-	return float32(C.ImGuiWindow_GetFontWindowScale(self.handle()))
+func (self ImGuiContext) GetActiveIdPreviousFrameHasBeenEditedBefore() bool {
+	return C.ImGuiContext_GetActiveIdPreviousFrameHasBeenEditedBefore(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiWindow) SetFontDpiScale(v float32) {
-	C.ImGuiWindow_SetFontDpiScale(self.handle(), C.float(v))
+func (self ImGuiContext) SetActiveIdPreviousFrameWindow(v ImGuiWindow) {
+	C.ImGuiContext_SetActiveIdPreviousFrameWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetFontDpiScale() float32 {
-	return float32(C.ImGuiWindow_GetFontDpiScale(self.handle()))
+func (self ImGuiContext) GetActiveIdPreviousFrameWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetActiveIdPreviousFrameWindow(self.handle())))
 }
 
-func (self ImGuiWindow) SetSettingsOffset(v int32) {
-	C.ImGuiWindow_SetSettingsOffset(self.handle(), C.int(v))
+func (self ImGuiContext) SetLastActiveId(v ImGuiID) {
+	C.ImGuiContext_SetLastActiveId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetSettingsOffset() int {
-	return int(C.ImGuiWindow_GetSettingsOffset(self.handle()))
+func (self ImGuiContext) GetLastActiveId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetLastActiveId(self.handle()))
 }
 
-func (self ImGuiWindow) SetDrawList(v ImDrawList) {
-	C.ImGuiWindow_SetDrawList(self.handle(), v.handle())
+func (self ImGuiContext) SetLastActiveIdTimer(v float32) {
+	C.ImGuiContext_SetLastActiveIdTimer(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindow) GetDrawList() ImDrawList {
-	return (ImDrawList)(unsafe.Pointer(C.ImGuiWindow_GetDrawList(self.handle())))
+func (self ImGuiContext) GetLastActiveIdTimer() float32 {
+	return float32(C.ImGuiContext_GetLastActiveIdTimer(self.handle()))
 }
 
-func (self ImGuiWindow) GetDrawListInst() ImDrawList {
-	return newImDrawListFromC(C.ImGuiWindow_GetDrawListInst(self.handle()))
+func (self ImGuiContext) SetActiveIdUsingNavDirMask(v uint32) {
+	C.ImGuiContext_SetActiveIdUsingNavDirMask(self.handle(), C.ImU32(v))
 }
 
-func (self ImGuiWindow) SetParentWindow(v ImGuiWindow) {
-	C.ImGuiWindow_SetParentWindow(self.handle(), v.handle())
+func (self ImGuiContext) GetActiveIdUsingNavDirMask() uint32 {
+	return uint32(C.ImGuiContext_GetActiveIdUsingNavDirMask(self.handle()))
 }
 
-func (self ImGuiWindow) GetParentWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetParentWindow(self.handle())))
+func (self ImGuiContext) SetActiveIdUsingNavInputMask(v uint32) {
+	C.ImGuiContext_SetActiveIdUsingNavInputMask(self.handle(), C.ImU32(v))
 }
 
-func (self ImGuiWindow) SetParentWindowInBeginStack(v ImGuiWindow) {
-	C.ImGuiWindow_SetParentWindowInBeginStack(self.handle(), v.handle())
+func (self ImGuiContext) GetActiveIdUsingNavInputMask() uint32 {
+	return uint32(C.ImGuiContext_GetActiveIdUsingNavInputMask(self.handle()))
 }
 
-func (self ImGuiWindow) GetParentWindowInBeginStack() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetParentWindowInBeginStack(self.handle())))
+func (self ImGuiContext) SetCurrentItemFlags(v ImGuiItemFlags) {
+	C.ImGuiContext_SetCurrentItemFlags(self.handle(), C.ImGuiItemFlags(v))
 }
 
-func (self ImGuiWindow) SetRootWindow(v ImGuiWindow) {
-	C.ImGuiWindow_SetRootWindow(self.handle(), v.handle())
+func (self ImGuiContext) GetCurrentItemFlags() ImGuiItemFlags {
+	return ImGuiItemFlags(C.ImGuiContext_GetCurrentItemFlags(self.handle()))
 }
 
-func (self ImGuiWindow) GetRootWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetRootWindow(self.handle())))
+func (self ImGuiContext) GetNextItemData() ImGuiNextItemData {
+	return newImGuiNextItemDataFromC(C.ImGuiContext_GetNextItemData(self.handle()))
 }
 
-func (self ImGuiWindow) SetRootWindowPopupTree(v ImGuiWindow) {
-	C.ImGuiWindow_SetRootWindowPopupTree(self.handle(), v.handle())
+func (self ImGuiContext) GetLastItemData() ImGuiLastItemData {
+	return newImGuiLastItemDataFromC(C.ImGuiContext_GetLastItemData(self.handle()))
 }
 
-func (self ImGuiWindow) GetRootWindowPopupTree() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetRootWindowPopupTree(self.handle())))
+func (self ImGuiContext) GetNextWindowData() ImGuiNextWindowData {
+	return newImGuiNextWindowDataFromC(C.ImGuiContext_GetNextWindowData(self.handle()))
 }
 
-func (self ImGuiWindow) SetRootWindowDockTree(v ImGuiWindow) {
-	C.ImGuiWindow_SetRootWindowDockTree(self.handle(), v.handle())
+func (self ImGuiContext) SetBeginMenuCount(v int32) {
+	C.ImGuiContext_SetBeginMenuCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) GetRootWindowDockTree() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetRootWindowDockTree(self.handle())))
+func (self ImGuiContext) GetBeginMenuCount() int {
+	return int(C.ImGuiContext_GetBeginMenuCount(self.handle()))
 }
 
-func (self ImGuiWindow) SetRootWindowForTitleBarHighlight(v ImGuiWindow) {
-	C.ImGuiWindow_SetRootWindowForTitleBarHighlight(self.handle(), v.handle())
+func (self ImGuiContext) SetCurrentDpiScale(v float32) {
+	C.ImGuiContext_SetCurrentDpiScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindow) GetRootWindowForTitleBarHighlight() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetRootWindowForTitleBarHighlight(self.handle())))
+func (self ImGuiContext) GetCurrentDpiScale() float32 {
+	return float32(C.ImGuiContext_GetCurrentDpiScale(self.handle()))
 }
 
-func (self ImGuiWindow) SetRootWindowForNav(v ImGuiWindow) {
-	C.ImGuiWindow_SetRootWindowForNav(self.handle(), v.handle())
+func (self ImGuiContext) SetCurrentViewport(v ImGuiViewportP) {
+	C.ImGuiContext_SetCurrentViewport(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetRootWindowForNav() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetRootWindowForNav(self.handle())))
+func (self ImGuiContext) GetCurrentViewport() ImGuiViewportP {
+	return (ImGuiViewportP)(unsafe.Pointer(C.ImGuiContext_GetCurrentViewport(self.handle())))
 }
 
-func (self ImGuiWindow) SetNavLastChildNavWindow(v ImGuiWindow) {
-	C.ImGuiWindow_SetNavLastChildNavWindow(self.handle(), v.handle())
+func (self ImGuiContext) SetMouseViewport(v ImGuiViewportP) {
+	C.ImGuiContext_SetMouseViewport(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetNavLastChildNavWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetNavLastChildNavWindow(self.handle())))
+func (self ImGuiContext) GetMouseViewport() ImGuiViewportP {
+	return (ImGuiViewportP)(unsafe.Pointer(C.ImGuiContext_GetMouseViewport(self.handle())))
 }
 
-func (self ImGuiWindow) SetMemoryDrawListIdxCapacity(v int32) {
-	C.ImGuiWindow_SetMemoryDrawListIdxCapacity(self.handle(), C.int(v))
+func (self ImGuiContext) SetMouseLastHoveredViewport(v ImGuiViewportP) {
+	C.ImGuiContext_SetMouseLastHoveredViewport(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) GetMemoryDrawListIdxCapacity() int {
-	return int(C.ImGuiWindow_GetMemoryDrawListIdxCapacity(self.handle()))
+func (self ImGuiContext) GetMouseLastHoveredViewport() ImGuiViewportP {
+	return (ImGuiViewportP)(unsafe.Pointer(C.ImGuiContext_GetMouseLastHoveredViewport(self.handle())))
 }
 
-func (self ImGuiWindow) SetMemoryDrawListVtxCapacity(v int32) {
-	C.ImGuiWindow_SetMemoryDrawListVtxCapacity(self.handle(), C.int(v))
+func (self ImGuiContext) SetPlatformLastFocusedViewportId(v ImGuiID) {
+	C.ImGuiContext_SetPlatformLastFocusedViewportId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetMemoryDrawListVtxCapacity() int {
-	return int(C.ImGuiWindow_GetMemoryDrawListVtxCapacity(self.handle()))
+func (self ImGuiContext) GetPlatformLastFocusedViewportId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetPlatformLastFocusedViewportId(self.handle()))
 }
 
-func (self ImGuiWindow) SetMemoryCompacted(v bool) {
-	C.ImGuiWindow_SetMemoryCompacted(self.handle(), C.bool(v))
+func (self ImGuiContext) GetFallbackMonitor() ImGuiPlatformMonitor {
+	return newImGuiPlatformMonitorFromC(C.ImGuiContext_GetFallbackMonitor(self.handle()))
 }
 
-func (self ImGuiWindow) GetMemoryCompacted() bool {
-	return C.ImGuiWindow_GetMemoryCompacted(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetViewportFrontMostStampCount(v int32) {
+	C.ImGuiContext_SetViewportFrontMostStampCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindow) SetDockIsActive(v bool) {
-	C.ImGuiWindow_SetDockIsActive(self.handle(), C.bool(v))
+func (self ImGuiContext) GetViewportFrontMostStampCount() int {
+	return int(C.ImGuiContext_GetViewportFrontMostStampCount(self.handle()))
 }
 
-func (self ImGuiWindow) GetDockIsActive() bool {
-	return C.ImGuiWindow_GetDockIsActive(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetNavWindow(v ImGuiWindow) {
+	C.ImGuiContext_SetNavWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiWindow) SetDockNodeIsVisible(v bool) {
-	C.ImGuiWindow_SetDockNodeIsVisible(self.handle(), C.bool(v))
+func (self ImGuiContext) GetNavWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetNavWindow(self.handle())))
 }
 
-func (self ImGuiWindow) GetDockNodeIsVisible() bool {
-	return C.ImGuiWindow_GetDockNodeIsVisible(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetNavId(v ImGuiID) {
+	C.ImGuiContext_SetNavId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) SetDockTabIsVisible(v bool) {
-	C.ImGuiWindow_SetDockTabIsVisible(self.handle(), C.bool(v))
+func (self ImGuiContext) GetNavId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetNavId(self.handle()))
 }
 
-func (self ImGuiWindow) GetDockTabIsVisible() bool {
-	return C.ImGuiWindow_GetDockTabIsVisible(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetNavFocusScopeId(v ImGuiID) {
+	C.ImGuiContext_SetNavFocusScopeId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) SetDockTabWantClose(v bool) {
-	C.ImGuiWindow_SetDockTabWantClose(self.handle(), C.bool(v))
+func (self ImGuiContext) GetNavFocusScopeId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetNavFocusScopeId(self.handle()))
 }
 
-func (self ImGuiWindow) GetDockTabWantClose() bool {
-	return C.ImGuiWindow_GetDockTabWantClose(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetNavActivateId(v ImGuiID) {
+	C.ImGuiContext_SetNavActivateId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) SetDockOrder(v int) {
-	C.ImGuiWindow_SetDockOrder(self.handle(), C.short(v))
+func (self ImGuiContext) GetNavActivateId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetNavActivateId(self.handle()))
 }
 
-func (self ImGuiWindow) GetDockOrder() int {
-	return int(C.ImGuiWindow_GetDockOrder(self.handle()))
+func (self ImGuiContext) SetNavActivateDownId(v ImGuiID) {
+	C.ImGuiContext_SetNavActivateDownId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetDockStyle() ImGuiWindowDockStyle {
-	return newImGuiWindowDockStyleFromC(C.ImGuiWindow_GetDockStyle(self.handle()))
+func (self ImGuiContext) GetNavActivateDownId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetNavActivateDownId(self.handle()))
 }
 
-func (self ImGuiWindow) SetDockNode(v ImGuiDockNode) {
-	C.ImGuiWindow_SetDockNode(self.handle(), v.handle())
+func (self ImGuiContext) SetNavActivatePressedId(v ImGuiID) {
+	C.ImGuiContext_SetNavActivatePressedId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetDockNode() ImGuiDockNode {
-	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiWindow_GetDockNode(self.handle())))
+func (self ImGuiContext) GetNavActivatePressedId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetNavActivatePressedId(self.handle()))
 }
 
-func (self ImGuiWindow) SetDockNodeAsHost(v ImGuiDockNode) {
-	C.ImGuiWindow_SetDockNodeAsHost(self.handle(), v.handle())
+func (self ImGuiContext) SetNavActivateInputId(v ImGuiID) {
+	C.ImGuiContext_SetNavActivateInputId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetDockNodeAsHost() ImGuiDockNode {
-	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiWindow_GetDockNodeAsHost(self.handle())))
+func (self ImGuiContext) GetNavActivateInputId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetNavActivateInputId(self.handle()))
 }
 
-func (self ImGuiWindow) SetDockId(v ImGuiID) {
-	C.ImGuiWindow_SetDockId(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) SetNavActivateFlags(v ImGuiActivateFlags) {
+	C.ImGuiContext_SetNavActivateFlags(self.handle(), C.ImGuiActivateFlags(v))
 }
 
-func (self ImGuiWindow) GetDockId() ImGuiID {
-	return ImGuiID(C.ImGuiWindow_GetDockId(self.handle()))
+func (self ImGuiContext) GetNavActivateFlags() ImGuiActivateFlags {
+	return ImGuiActivateFlags(C.ImGuiContext_GetNavActivateFlags(self.handle()))
 }
 
-func (self ImGuiWindow) SetDockTabItemStatusFlags(v ImGuiItemStatusFlags) {
-	C.ImGuiWindow_SetDockTabItemStatusFlags(self.handle(), C.ImGuiItemStatusFlags(v))
+func (self ImGuiContext) SetNavJustMovedToId(v ImGuiID) {
+	C.ImGuiContext_SetNavJustMovedToId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetDockTabItemStatusFlags() ImGuiItemStatusFlags {
-	return ImGuiItemStatusFlags(C.ImGuiWindow_GetDockTabItemStatusFlags(self.handle()))
+func (self ImGuiContext) GetNavJustMovedToId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetNavJustMovedToId(self.handle()))
 }
 
-func (self ImGuiWindow) SetDockTabItemRect(v ImRect) {
-	C.ImGuiWindow_SetDockTabItemRect(self.handle(), v.toC())
+func (self ImGuiContext) SetNavJustMovedToFocusScopeId(v ImGuiID) {
+	C.ImGuiContext_SetNavJustMovedToFocusScopeId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindow) GetDockTabItemRect() ImRect {
-	return newImRectFromC(C.ImGuiWindow_GetDockTabItemRect(self.handle()))
+func (self ImGuiContext) GetNavJustMovedToFocusScopeId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetNavJustMovedToFocusScopeId(self.handle()))
 }
 
-func (self ImGuiComboPreviewData) SetPreviewRect(v ImRect) {
-	C.ImGuiComboPreviewData_SetPreviewRect(self.handle(), v.toC())
+func (self ImGuiContext) SetNavJustMovedToKeyMods(v ImGuiModFlags) {
+	C.ImGuiContext_SetNavJustMovedToKeyMods(self.handle(), C.ImGuiModFlags(v))
 }
 
-func (self ImGuiComboPreviewData) GetPreviewRect() ImRect {
-	return newImRectFromC(C.ImGuiComboPreviewData_GetPreviewRect(self.handle()))
+func (self ImGuiContext) GetNavJustMovedToKeyMods() ImGuiModFlags {
+	return ImGuiModFlags(C.ImGuiContext_GetNavJustMovedToKeyMods(self.handle()))
 }
 
-func (self ImGuiComboPreviewData) SetBackupCursorPos(v ImVec2) {
-	C.ImGuiComboPreviewData_SetBackupCursorPos(self.handle(), v.toC())
+func (self ImGuiContext) SetNavNextActivateId(v ImGuiID) {
+	C.ImGuiContext_SetNavNextActivateId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiComboPreviewData) GetBackupCursorPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiComboPreviewData_GetBackupCursorPos(self.handle()))
+func (self ImGuiContext) GetNavNextActivateId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetNavNextActivateId(self.handle()))
 }
 
-func (self ImGuiComboPreviewData) SetBackupCursorMaxPos(v ImVec2) {
-	C.ImGuiComboPreviewData_SetBackupCursorMaxPos(self.handle(), v.toC())
+func (self ImGuiContext) SetNavNextActivateFlags(v ImGuiActivateFlags) {
+	C.ImGuiContext_SetNavNextActivateFlags(self.handle(), C.ImGuiActivateFlags(v))
 }
 
-func (self ImGuiComboPreviewData) GetBackupCursorMaxPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiComboPreviewData_GetBackupCursorMaxPos(self.handle()))
+func (self ImGuiContext) GetNavNextActivateFlags() ImGuiActivateFlags {
+	return ImGuiActivateFlags(C.ImGuiContext_GetNavNextActivateFlags(self.handle()))
 }
 
-func (self ImGuiComboPreviewData) SetBackupCursorPosPrevLine(v ImVec2) {
-	C.ImGuiComboPreviewData_SetBackupCursorPosPrevLine(self.handle(), v.toC())
+func (self ImGuiContext) SetNavInputSource(v ImGuiInputSource) {
+	C.ImGuiContext_SetNavInputSource(self.handle(), C.ImGuiInputSource(v))
 }
 
-func (self ImGuiComboPreviewData) GetBackupCursorPosPrevLine() ImVec2 {
-	return newImVec2FromC(C.ImGuiComboPreviewData_GetBackupCursorPosPrevLine(self.handle()))
+func (self ImGuiContext) GetNavInputSource() ImGuiInputSource {
+	return ImGuiInputSource(C.ImGuiContext_GetNavInputSource(self.handle()))
 }
 
-func (self ImGuiComboPreviewData) SetBackupPrevLineTextBaseOffset(v float32) {
-	C.ImGuiComboPreviewData_SetBackupPrevLineTextBaseOffset(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavLayer(v ImGuiNavLayer) {
+	C.ImGuiContext_SetNavLayer(self.handle(), C.ImGuiNavLayer(v))
 }
 
-func (self ImGuiComboPreviewData) GetBackupPrevLineTextBaseOffset() float32 {
-	return float32(C.ImGuiComboPreviewData_GetBackupPrevLineTextBaseOffset(self.handle()))
+func (self ImGuiContext) GetNavLayer() ImGuiNavLayer {
+	return ImGuiNavLayer(C.ImGuiContext_GetNavLayer(self.handle()))
 }
 
-func (self ImGuiComboPreviewData) SetBackupLayout(v ImGuiLayoutType) {
-	C.ImGuiComboPreviewData_SetBackupLayout(self.handle(), C.ImGuiLayoutType(v))
+func (self ImGuiContext) SetNavIdIsAlive(v bool) {
+	C.ImGuiContext_SetNavIdIsAlive(self.handle(), C.bool(v))
 }
 
-func (self ImGuiComboPreviewData) GetBackupLayout() ImGuiLayoutType {
-	return ImGuiLayoutType(C.ImGuiComboPreviewData_GetBackupLayout(self.handle()))
+func (self ImGuiContext) GetNavIdIsAlive() bool {
+	return C.ImGuiContext_GetNavIdIsAlive(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextState) SetID(v ImGuiID) {
-	C.ImGuiInputTextState_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) SetNavMousePosDirty(v bool) {
+	C.ImGuiContext_SetNavMousePosDirty(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextState) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiInputTextState_GetID(self.handle()))
+func (self ImGuiContext) GetNavMousePosDirty() bool {
+	return C.ImGuiContext_GetNavMousePosDirty(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextState) SetCurLenW(v int32) {
-	C.ImGuiInputTextState_SetCurLenW(self.handle(), C.int(v))
+func (self ImGuiContext) SetNavDisableHighlight(v bool) {
+	C.ImGuiContext_SetNavDisableHighlight(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextState) GetCurLenW() int {
-	return int(C.ImGuiInputTextState_GetCurLenW(self.handle()))
+func (self ImGuiContext) GetNavDisableHighlight() bool {
+	return C.ImGuiContext_GetNavDisableHighlight(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextState) SetCurLenA(v int32) {
-	C.ImGuiInputTextState_SetCurLenA(self.handle(), C.int(v))
+func (self ImGuiContext) SetNavDisableMouseHover(v bool) {
+	C.ImGuiContext_SetNavDisableMouseHover(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextState) GetCurLenA() int {
-	return int(C.ImGuiInputTextState_GetCurLenA(self.handle()))
+func (self ImGuiContext) GetNavDisableMouseHover() bool {
+	return C.ImGuiContext_GetNavDisableMouseHover(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextState) SetTextAIsValid(v bool) {
-	C.ImGuiInputTextState_SetTextAIsValid(self.handle(), C.bool(v))
+func (self ImGuiContext) SetNavAnyRequest(v bool) {
+	C.ImGuiContext_SetNavAnyRequest(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextState) GetTextAIsValid() bool {
-	return C.ImGuiInputTextState_GetTextAIsValid(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetNavAnyRequest() bool {
+	return C.ImGuiContext_GetNavAnyRequest(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextState) SetBufCapacityA(v int32) {
-	C.ImGuiInputTextState_SetBufCapacityA(self.handle(), C.int(v))
+func (self ImGuiContext) SetNavInitRequest(v bool) {
+	C.ImGuiContext_SetNavInitRequest(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextState) GetBufCapacityA() int {
-	return int(C.ImGuiInputTextState_GetBufCapacityA(self.handle()))
+func (self ImGuiContext) GetNavInitRequest() bool {
+	return C.ImGuiContext_GetNavInitRequest(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextState) SetScrollX(v float32) {
-	C.ImGuiInputTextState_SetScrollX(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavInitRequestFromMove(v bool) {
+	C.ImGuiContext_SetNavInitRequestFromMove(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextState) GetScrollX() float32 {
-	return float32(C.ImGuiInputTextState_GetScrollX(self.handle()))
+func (self ImGuiContext) GetNavInitRequestFromMove() bool {
+	return C.ImGuiContext_GetNavInitRequestFromMove(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextState) SetCursorAnim(v float32) {
-	C.ImGuiInputTextState_SetCursorAnim(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavInitResultId(v ImGuiID) {
+	C.ImGuiContext_SetNavInitResultId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiInputTextState) GetCursorAnim() float32 {
-	return float32(C.ImGuiInputTextState_GetCursorAnim(self.handle()))
+func (self ImGuiContext) GetNavInitResultId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetNavInitResultId(self.handle()))
 }
 
-func (self ImGuiInputTextState) SetCursorFollow(v bool) {
-	C.ImGuiInputTextState_SetCursorFollow(self.handle(), C.bool(v))
+func (self ImGuiContext) SetNavInitResultRectRel(v ImRect) {
+	C.ImGuiContext_SetNavInitResultRectRel(self.handle(), v.toC())
 }
 
-func (self ImGuiInputTextState) GetCursorFollow() bool {
-	return C.ImGuiInputTextState_GetCursorFollow(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetNavInitResultRectRel() ImRect {
+	return newImRectFromC(C.ImGuiContext_GetNavInitResultRectRel(self.handle()))
 }
 
-func (self ImGuiInputTextState) SetSelectedAllMouseLock(v bool) {
-	C.ImGuiInputTextState_SetSelectedAllMouseLock(self.handle(), C.bool(v))
+func (self ImGuiContext) SetNavMoveSubmitted(v bool) {
+	C.ImGuiContext_SetNavMoveSubmitted(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextState) GetSelectedAllMouseLock() bool {
-	return C.ImGuiInputTextState_GetSelectedAllMouseLock(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetNavMoveSubmitted() bool {
+	return C.ImGuiContext_GetNavMoveSubmitted(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextState) SetEdited(v bool) {
-	C.ImGuiInputTextState_SetEdited(self.handle(), C.bool(v))
+func (self ImGuiContext) SetNavMoveScoringItems(v bool) {
+	C.ImGuiContext_SetNavMoveScoringItems(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextState) GetEdited() bool {
-	return C.ImGuiInputTextState_GetEdited(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetNavMoveScoringItems() bool {
+	return C.ImGuiContext_GetNavMoveScoringItems(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiInputTextState) SetFlags(v ImGuiInputTextFlags) {
-	C.ImGuiInputTextState_SetFlags(self.handle(), C.ImGuiInputTextFlags(v))
+func (self ImGuiContext) SetNavMoveForwardToNextFrame(v bool) {
+	C.ImGuiContext_SetNavMoveForwardToNextFrame(self.handle(), C.bool(v))
 }
 
-func (self ImGuiInputTextState) GetFlags() ImGuiInputTextFlags {
-	return ImGuiInputTextFlags(C.ImGuiInputTextState_GetFlags(self.handle()))
+func (self ImGuiContext) GetNavMoveForwardToNextFrame() bool {
+	return C.ImGuiContext_GetNavMoveForwardToNextFrame(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiShrinkWidthItem) SetIndex(v int32) {
-	C.ImGuiShrinkWidthItem_SetIndex(self.handle(), C.int(v))
+func (self ImGuiContext) SetNavMoveFlags(v ImGuiNavMoveFlags) {
+	C.ImGuiContext_SetNavMoveFlags(self.handle(), C.ImGuiNavMoveFlags(v))
 }
 
-func (self ImGuiShrinkWidthItem) GetIndex() int {
-	return int(C.ImGuiShrinkWidthItem_GetIndex(self.handle()))
+func (self ImGuiContext) GetNavMoveFlags() ImGuiNavMoveFlags {
+	return ImGuiNavMoveFlags(C.ImGuiContext_GetNavMoveFlags(self.handle()))
 }
 
-func (self ImGuiShrinkWidthItem) SetWidth(v float32) {
-	C.ImGuiShrinkWidthItem_SetWidth(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavMoveScrollFlags(v ImGuiScrollFlags) {
+	C.ImGuiContext_SetNavMoveScrollFlags(self.handle(), C.ImGuiScrollFlags(v))
 }
 
-func (self ImGuiShrinkWidthItem) GetWidth() float32 {
-	return float32(C.ImGuiShrinkWidthItem_GetWidth(self.handle()))
+func (self ImGuiContext) GetNavMoveScrollFlags() ImGuiScrollFlags {
+	return ImGuiScrollFlags(C.ImGuiContext_GetNavMoveScrollFlags(self.handle()))
 }
 
-func (self ImGuiShrinkWidthItem) SetInitialWidth(v float32) {
-	C.ImGuiShrinkWidthItem_SetInitialWidth(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavMoveKeyMods(v ImGuiModFlags) {
+	C.ImGuiContext_SetNavMoveKeyMods(self.handle(), C.ImGuiModFlags(v))
 }
 
-func (self ImGuiShrinkWidthItem) GetInitialWidth() float32 {
-	return float32(C.ImGuiShrinkWidthItem_GetInitialWidth(self.handle()))
+func (self ImGuiContext) GetNavMoveKeyMods() ImGuiModFlags {
+	return ImGuiModFlags(C.ImGuiContext_GetNavMoveKeyMods(self.handle()))
 }
 
-func (self ImGuiStackLevelInfo) SetID(v ImGuiID) {
-	C.ImGuiStackLevelInfo_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) SetNavMoveDir(v ImGuiDir) {
+	C.ImGuiContext_SetNavMoveDir(self.handle(), C.ImGuiDir(v))
 }
 
-func (self ImGuiStackLevelInfo) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiStackLevelInfo_GetID(self.handle()))
+func (self ImGuiContext) GetNavMoveDir() ImGuiDir {
+	return ImGuiDir(C.ImGuiContext_GetNavMoveDir(self.handle()))
 }
 
-func (self ImGuiStackLevelInfo) SetQueryFrameCount(v int) {
-	C.ImGuiStackLevelInfo_SetQueryFrameCount(self.handle(), C.ImS8(v))
+func (self ImGuiContext) SetNavMoveDirForDebug(v ImGuiDir) {
+	C.ImGuiContext_SetNavMoveDirForDebug(self.handle(), C.ImGuiDir(v))
 }
 
-func (self ImGuiStackLevelInfo) GetQueryFrameCount() int {
-	return int(C.ImGuiStackLevelInfo_GetQueryFrameCount(self.handle()))
+func (self ImGuiContext) GetNavMoveDirForDebug() ImGuiDir {
+	return ImGuiDir(C.ImGuiContext_GetNavMoveDirForDebug(self.handle()))
 }
 
-func (self ImGuiStackLevelInfo) SetQuerySuccess(v bool) {
-	C.ImGuiStackLevelInfo_SetQuerySuccess(self.handle(), C.bool(v))
+func (self ImGuiContext) SetNavMoveClipDir(v ImGuiDir) {
+	C.ImGuiContext_SetNavMoveClipDir(self.handle(), C.ImGuiDir(v))
 }
 
-func (self ImGuiStackLevelInfo) GetQuerySuccess() bool {
-	return C.ImGuiStackLevelInfo_GetQuerySuccess(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetNavMoveClipDir() ImGuiDir {
+	return ImGuiDir(C.ImGuiContext_GetNavMoveClipDir(self.handle()))
 }
 
-func (self ImGuiStackLevelInfo) SetDataType(v ImGuiDataType) {
-	C.ImGuiStackLevelInfo_SetDataType(self.handle(), C.ImGuiDataType(v))
+func (self ImGuiContext) SetNavScoringRect(v ImRect) {
+	C.ImGuiContext_SetNavScoringRect(self.handle(), v.toC())
 }
 
-func (self ImGuiStackLevelInfo) GetDataType() ImGuiDataType {
-	return ImGuiDataType(C.ImGuiStackLevelInfo_GetDataType(self.handle()))
+func (self ImGuiContext) GetNavScoringRect() ImRect {
+	return newImRectFromC(C.ImGuiContext_GetNavScoringRect(self.handle()))
 }
 
-func (self ImGuiStoragePair) Setkey(v ImGuiID) {
-	C.ImGuiStoragePair_Setkey(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) SetNavScoringNoClipRect(v ImRect) {
+	C.ImGuiContext_SetNavScoringNoClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiStoragePair) Getkey() ImGuiID {
-	return ImGuiID(C.ImGuiStoragePair_Getkey(self.handle()))
+func (self ImGuiContext) GetNavScoringNoClipRect() ImRect {
+	return newImRectFromC(C.ImGuiContext_GetNavScoringNoClipRect(self.handle()))
 }
 
-func (self ImFontGlyph) SetColored(v uint32) {
-	C.ImFontGlyph_SetColored(self.handle(), C.uint(v))
+func (self ImGuiContext) SetNavScoringDebugCount(v int32) {
+	C.ImGuiContext_SetNavScoringDebugCount(self.handle(), C.int(v))
 }
 
-func (self ImFontGlyph) GetColored() uint32 {
-	return uint32(C.ImFontGlyph_GetColored(self.handle()))
+func (self ImGuiContext) GetNavScoringDebugCount() int {
+	return int(C.ImGuiContext_GetNavScoringDebugCount(self.handle()))
 }
 
-func (self ImFontGlyph) SetVisible(v uint32) {
-	C.ImFontGlyph_SetVisible(self.handle(), C.uint(v))
+func (self ImGuiContext) SetNavTabbingDir(v int32) {
+	C.ImGuiContext_SetNavTabbingDir(self.handle(), C.int(v))
 }
 
-func (self ImFontGlyph) GetVisible() uint32 {
-	return uint32(C.ImFontGlyph_GetVisible(self.handle()))
+func (self ImGuiContext) GetNavTabbingDir() int {
+	return int(C.ImGuiContext_GetNavTabbingDir(self.handle()))
 }
 
-func (self ImFontGlyph) SetCodepoint(v uint32) {
-	C.ImFontGlyph_SetCodepoint(self.handle(), C.uint(v))
+func (self ImGuiContext) SetNavTabbingCounter(v int32) {
+	C.ImGuiContext_SetNavTabbingCounter(self.handle(), C.int(v))
 }
 
-func (self ImFontGlyph) GetCodepoint() uint32 {
-	return uint32(C.ImFontGlyph_GetCodepoint(self.handle()))
+func (self ImGuiContext) GetNavTabbingCounter() int {
+	return int(C.ImGuiContext_GetNavTabbingCounter(self.handle()))
 }
 
-func (self ImFontGlyph) SetAdvanceX(v float32) {
-	C.ImFontGlyph_SetAdvanceX(self.handle(), C.float(v))
+func (self ImGuiContext) GetNavMoveResultLocal() ImGuiNavItemData {
+	return newImGuiNavItemDataFromC(C.ImGuiContext_GetNavMoveResultLocal(self.handle()))
 }
 
-func (self ImFontGlyph) GetAdvanceX() float32 {
-	return float32(C.ImFontGlyph_GetAdvanceX(self.handle()))
+func (self ImGuiContext) GetNavMoveResultLocalVisible() ImGuiNavItemData {
+	return newImGuiNavItemDataFromC(C.ImGuiContext_GetNavMoveResultLocalVisible(self.handle()))
 }
 
-func (self ImFontGlyph) SetX0(v float32) {
-	C.ImFontGlyph_SetX0(self.handle(), C.float(v))
+func (self ImGuiContext) GetNavMoveResultOther() ImGuiNavItemData {
+	return newImGuiNavItemDataFromC(C.ImGuiContext_GetNavMoveResultOther(self.handle()))
 }
 
-func (self ImFontGlyph) GetX0() float32 {
-	return float32(C.ImFontGlyph_GetX0(self.handle()))
+func (self ImGuiContext) GetNavTabbingResultFirst() ImGuiNavItemData {
+	return newImGuiNavItemDataFromC(C.ImGuiContext_GetNavTabbingResultFirst(self.handle()))
 }
 
-func (self ImFontGlyph) SetY0(v float32) {
-	C.ImFontGlyph_SetY0(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavWindowingTarget(v ImGuiWindow) {
+	C.ImGuiContext_SetNavWindowingTarget(self.handle(), v.handle())
 }
 
-func (self ImFontGlyph) GetY0() float32 {
-	return float32(C.ImFontGlyph_GetY0(self.handle()))
+func (self ImGuiContext) GetNavWindowingTarget() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetNavWindowingTarget(self.handle())))
 }
 
-func (self ImFontGlyph) SetX1(v float32) {
-	C.ImFontGlyph_SetX1(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavWindowingTargetAnim(v ImGuiWindow) {
+	C.ImGuiContext_SetNavWindowingTargetAnim(self.handle(), v.handle())
 }
 
-func (self ImFontGlyph) GetX1() float32 {
-	return float32(C.ImFontGlyph_GetX1(self.handle()))
+func (self ImGuiContext) GetNavWindowingTargetAnim() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetNavWindowingTargetAnim(self.handle())))
 }
 
-func (self ImFontGlyph) SetY1(v float32) {
-	C.ImFontGlyph_SetY1(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavWindowingListWindow(v ImGuiWindow) {
+	C.ImGuiContext_SetNavWindowingListWindow(self.handle(), v.handle())
 }
 
-func (self ImFontGlyph) GetY1() float32 {
-	return float32(C.ImFontGlyph_GetY1(self.handle()))
+func (self ImGuiContext) GetNavWindowingListWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiContext_GetNavWindowingListWindow(self.handle())))
 }
 
-func (self ImFontGlyph) SetU0(v float32) {
-	C.ImFontGlyph_SetU0(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavWindowingTimer(v float32) {
+	C.ImGuiContext_SetNavWindowingTimer(self.handle(), C.float(v))
 }
 
-func (self ImFontGlyph) GetU0() float32 {
-	return float32(C.ImFontGlyph_GetU0(self.handle()))
+func (self ImGuiContext) GetNavWindowingTimer() float32 {
+	return float32(C.ImGuiContext_GetNavWindowingTimer(self.handle()))
 }
 
-func (self ImFontGlyph) SetV0(v float32) {
-	C.ImFontGlyph_SetV0(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavWindowingHighlightAlpha(v float32) {
+	C.ImGuiContext_SetNavWindowingHighlightAlpha(self.handle(), C.float(v))
 }
 
-func (self ImFontGlyph) GetV0() float32 {
-	return float32(C.ImFontGlyph_GetV0(self.handle()))
+func (self ImGuiContext) GetNavWindowingHighlightAlpha() float32 {
+	return float32(C.ImGuiContext_GetNavWindowingHighlightAlpha(self.handle()))
 }
 
-func (self ImFontGlyph) SetU1(v float32) {
-	C.ImFontGlyph_SetU1(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavWindowingToggleLayer(v bool) {
+	C.ImGuiContext_SetNavWindowingToggleLayer(self.handle(), C.bool(v))
 }
 
-func (self ImFontGlyph) GetU1() float32 {
-	return float32(C.ImFontGlyph_GetU1(self.handle()))
+func (self ImGuiContext) GetNavWindowingToggleLayer() bool {
+	return C.ImGuiContext_GetNavWindowingToggleLayer(self.handle()) == C.bool(true)
 }
 
-func (self ImFontGlyph) SetV1(v float32) {
-	C.ImFontGlyph_SetV1(self.handle(), C.float(v))
+func (self ImGuiContext) SetNavWindowingAccumDeltaPos(v ImVec2) {
+	C.ImGuiContext_SetNavWindowingAccumDeltaPos(self.handle(), v.toC())
 }
 
-func (self ImFontGlyph) GetV1() float32 {
-	return float32(C.ImFontGlyph_GetV1(self.handle()))
+func (self ImGuiContext) GetNavWindowingAccumDeltaPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiContext_GetNavWindowingAccumDeltaPos(self.handle()))
 }
 
-func (self ImGuiNextItemData) SetFlags(v ImGuiNextItemDataFlags) {
-	C.ImGuiNextItemData_SetFlags(self.handle(), C.ImGuiNextItemDataFlags(v))
+func (self ImGuiContext) SetNavWindowingAccumDeltaSize(v ImVec2) {
+	C.ImGuiContext_SetNavWindowingAccumDeltaSize(self.handle(), v.toC())
 }
 
-func (self ImGuiNextItemData) GetFlags() ImGuiNextItemDataFlags {
-	return ImGuiNextItemDataFlags(C.ImGuiNextItemData_GetFlags(self.handle()))
+func (self ImGuiContext) GetNavWindowingAccumDeltaSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiContext_GetNavWindowingAccumDeltaSize(self.handle()))
 }
 
-func (self ImGuiNextItemData) SetWidth(v float32) {
-	C.ImGuiNextItemData_SetWidth(self.handle(), C.float(v))
+func (self ImGuiContext) SetDimBgRatio(v float32) {
+	C.ImGuiContext_SetDimBgRatio(self.handle(), C.float(v))
 }
 
-func (self ImGuiNextItemData) GetWidth() float32 {
-	return float32(C.ImGuiNextItemData_GetWidth(self.handle()))
+func (self ImGuiContext) GetDimBgRatio() float32 {
+	return float32(C.ImGuiContext_GetDimBgRatio(self.handle()))
 }
 
-func (self ImGuiNextItemData) SetFocusScopeId(v ImGuiID) {
-	C.ImGuiNextItemData_SetFocusScopeId(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) SetMouseCursor(v ImGuiMouseCursor) {
+	C.ImGuiContext_SetMouseCursor(self.handle(), C.ImGuiMouseCursor(v))
 }
 
-func (self ImGuiNextItemData) GetFocusScopeId() ImGuiID {
-	return ImGuiID(C.ImGuiNextItemData_GetFocusScopeId(self.handle()))
+func (self ImGuiContext) GetMouseCursor() ImGuiMouseCursor {
+	return ImGuiMouseCursor(C.ImGuiContext_GetMouseCursor(self.handle()))
 }
 
-func (self ImGuiNextItemData) SetOpenCond(v ImGuiCond) {
-	C.ImGuiNextItemData_SetOpenCond(self.handle(), C.ImGuiCond(v))
+func (self ImGuiContext) SetDragDropActive(v bool) {
+	C.ImGuiContext_SetDragDropActive(self.handle(), C.bool(v))
 }
 
-func (self ImGuiNextItemData) GetOpenCond() ImGuiCond {
-	return ImGuiCond(C.ImGuiNextItemData_GetOpenCond(self.handle()))
+func (self ImGuiContext) GetDragDropActive() bool {
+	return C.ImGuiContext_GetDragDropActive(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiNextItemData) SetOpenVal(v bool) {
-	C.ImGuiNextItemData_SetOpenVal(self.handle(), C.bool(v))
+func (self ImGuiContext) SetDragDropWithinSource(v bool) {
+	C.ImGuiContext_SetDragDropWithinSource(self.handle(), C.bool(v))
 }
 
-func (self ImGuiNextItemData) GetOpenVal() bool {
-	return C.ImGuiNextItemData_GetOpenVal(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetDragDropWithinSource() bool {
+	return C.ImGuiContext_GetDragDropWithinSource(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiColorMod) SetCol(v ImGuiCol) {
-	C.ImGuiColorMod_SetCol(self.handle(), C.ImGuiCol(v))
+func (self ImGuiContext) SetDragDropWithinTarget(v bool) {
+	C.ImGuiContext_SetDragDropWithinTarget(self.handle(), C.bool(v))
 }
 
-func (self ImGuiColorMod) GetCol() ImGuiCol {
-	return ImGuiCol(C.ImGuiColorMod_GetCol(self.handle()))
+func (self ImGuiContext) GetDragDropWithinTarget() bool {
+	return C.ImGuiContext_GetDragDropWithinTarget(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiColorMod) SetBackupValue(v ImVec4) {
-	C.ImGuiColorMod_SetBackupValue(self.handle(), v.toC())
+func (self ImGuiContext) SetDragDropSourceFlags(v ImGuiDragDropFlags) {
+	C.ImGuiContext_SetDragDropSourceFlags(self.handle(), C.ImGuiDragDropFlags(v))
 }
 
-func (self ImGuiColorMod) GetBackupValue() ImVec4 {
-	return newImVec4FromC(C.ImGuiColorMod_GetBackupValue(self.handle()))
+func (self ImGuiContext) GetDragDropSourceFlags() ImGuiDragDropFlags {
+	return ImGuiDragDropFlags(C.ImGuiContext_GetDragDropSourceFlags(self.handle()))
 }
 
-func (self ImGuiDockContext) GetNodes() ImGuiStorage {
-	return newImGuiStorageFromC(C.ImGuiDockContext_GetNodes(self.handle()))
+func (self ImGuiContext) SetDragDropSourceFrameCount(v int32) {
+	C.ImGuiContext_SetDragDropSourceFrameCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiDockContext) SetWantFullRebuild(v bool) {
-	C.ImGuiDockContext_SetWantFullRebuild(self.handle(), C.bool(v))
+func (self ImGuiContext) GetDragDropSourceFrameCount() int {
+	return int(C.ImGuiContext_GetDragDropSourceFrameCount(self.handle()))
 }
 
-func (self ImGuiDockContext) GetWantFullRebuild() bool {
-	return C.ImGuiDockContext_GetWantFullRebuild(self.handle()) == C.bool(true)
+func (self ImGuiContext) SetDragDropMouseButton(v int32) {
+	C.ImGuiContext_SetDragDropMouseButton(self.handle(), C.int(v))
 }
 
-func (self ImGuiInputEventMouseWheel) SetWheelX(v float32) {
-	C.ImGuiInputEventMouseWheel_SetWheelX(self.handle(), C.float(v))
+func (self ImGuiContext) GetDragDropMouseButton() int {
+	return int(C.ImGuiContext_GetDragDropMouseButton(self.handle()))
 }
 
-func (self ImGuiInputEventMouseWheel) GetWheelX() float32 {
-	return float32(C.ImGuiInputEventMouseWheel_GetWheelX(self.handle()))
+func (self ImGuiContext) GetDragDropPayload() ImGuiPayload {
+	return newImGuiPayloadFromC(C.ImGuiContext_GetDragDropPayload(self.handle()))
 }
 
-func (self ImGuiInputEventMouseWheel) SetWheelY(v float32) {
-	C.ImGuiInputEventMouseWheel_SetWheelY(self.handle(), C.float(v))
+func (self ImGuiContext) SetDragDropTargetRect(v ImRect) {
+	C.ImGuiContext_SetDragDropTargetRect(self.handle(), v.toC())
 }
 
-func (self ImGuiInputEventMouseWheel) GetWheelY() float32 {
-	return float32(C.ImGuiInputEventMouseWheel_GetWheelY(self.handle()))
+func (self ImGuiContext) GetDragDropTargetRect() ImRect {
+	return newImRectFromC(C.ImGuiContext_GetDragDropTargetRect(self.handle()))
 }
 
-func (self ImGuiWindowClass) SetClassId(v ImGuiID) {
-	C.ImGuiWindowClass_SetClassId(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) SetDragDropTargetId(v ImGuiID) {
+	C.ImGuiContext_SetDragDropTargetId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindowClass) GetClassId() ImGuiID {
-	return ImGuiID(C.ImGuiWindowClass_GetClassId(self.handle()))
+func (self ImGuiContext) GetDragDropTargetId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetDragDropTargetId(self.handle()))
 }
 
-func (self ImGuiWindowClass) SetParentViewportId(v ImGuiID) {
-	C.ImGuiWindowClass_SetParentViewportId(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) SetDragDropAcceptFlags(v ImGuiDragDropFlags) {
+	C.ImGuiContext_SetDragDropAcceptFlags(self.handle(), C.ImGuiDragDropFlags(v))
 }
 
-func (self ImGuiWindowClass) GetParentViewportId() ImGuiID {
-	return ImGuiID(C.ImGuiWindowClass_GetParentViewportId(self.handle()))
+func (self ImGuiContext) GetDragDropAcceptFlags() ImGuiDragDropFlags {
+	return ImGuiDragDropFlags(C.ImGuiContext_GetDragDropAcceptFlags(self.handle()))
 }
 
-func (self ImGuiWindowClass) SetViewportFlagsOverrideSet(v ImGuiViewportFlags) {
-	C.ImGuiWindowClass_SetViewportFlagsOverrideSet(self.handle(), C.ImGuiViewportFlags(v))
+func (self ImGuiContext) SetDragDropAcceptIdCurrRectSurface(v float32) {
+	C.ImGuiContext_SetDragDropAcceptIdCurrRectSurface(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowClass) GetViewportFlagsOverrideSet() ImGuiViewportFlags {
-	return ImGuiViewportFlags(C.ImGuiWindowClass_GetViewportFlagsOverrideSet(self.handle()))
+func (self ImGuiContext) GetDragDropAcceptIdCurrRectSurface() float32 {
+	return float32(C.ImGuiContext_GetDragDropAcceptIdCurrRectSurface(self.handle()))
 }
 
-func (self ImGuiWindowClass) SetViewportFlagsOverrideClear(v ImGuiViewportFlags) {
-	C.ImGuiWindowClass_SetViewportFlagsOverrideClear(self.handle(), C.ImGuiViewportFlags(v))
+func (self ImGuiContext) SetDragDropAcceptIdCurr(v ImGuiID) {
+	C.ImGuiContext_SetDragDropAcceptIdCurr(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindowClass) GetViewportFlagsOverrideClear() ImGuiViewportFlags {
-	return ImGuiViewportFlags(C.ImGuiWindowClass_GetViewportFlagsOverrideClear(self.handle()))
+func (self ImGuiContext) GetDragDropAcceptIdCurr() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetDragDropAcceptIdCurr(self.handle()))
 }
 
-func (self ImGuiWindowClass) SetTabItemFlagsOverrideSet(v ImGuiTabItemFlags) {
-	C.ImGuiWindowClass_SetTabItemFlagsOverrideSet(self.handle(), C.ImGuiTabItemFlags(v))
+func (self ImGuiContext) SetDragDropAcceptIdPrev(v ImGuiID) {
+	C.ImGuiContext_SetDragDropAcceptIdPrev(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindowClass) GetTabItemFlagsOverrideSet() ImGuiTabItemFlags {
-	return ImGuiTabItemFlags(C.ImGuiWindowClass_GetTabItemFlagsOverrideSet(self.handle()))
+func (self ImGuiContext) GetDragDropAcceptIdPrev() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetDragDropAcceptIdPrev(self.handle()))
 }
 
-func (self ImGuiWindowClass) SetDockNodeFlagsOverrideSet(v ImGuiDockNodeFlags) {
-	C.ImGuiWindowClass_SetDockNodeFlagsOverrideSet(self.handle(), C.ImGuiDockNodeFlags(v))
+func (self ImGuiContext) SetDragDropAcceptFrameCount(v int32) {
+	C.ImGuiContext_SetDragDropAcceptFrameCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindowClass) GetDockNodeFlagsOverrideSet() ImGuiDockNodeFlags {
-	return ImGuiDockNodeFlags(C.ImGuiWindowClass_GetDockNodeFlagsOverrideSet(self.handle()))
+func (self ImGuiContext) GetDragDropAcceptFrameCount() int {
+	return int(C.ImGuiContext_GetDragDropAcceptFrameCount(self.handle()))
 }
 
-func (self ImGuiWindowClass) SetDockingAlwaysTabBar(v bool) {
-	C.ImGuiWindowClass_SetDockingAlwaysTabBar(self.handle(), C.bool(v))
+func (self ImGuiContext) SetDragDropHoldJustPressedId(v ImGuiID) {
+	C.ImGuiContext_SetDragDropHoldJustPressedId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiWindowClass) GetDockingAlwaysTabBar() bool {
-	return C.ImGuiWindowClass_GetDockingAlwaysTabBar(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetDragDropHoldJustPressedId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetDragDropHoldJustPressedId(self.handle()))
 }
 
-func (self ImGuiWindowClass) SetDockingAllowUnclassed(v bool) {
-	C.ImGuiWindowClass_SetDockingAllowUnclassed(self.handle(), C.bool(v))
+func (self ImGuiContext) SetClipperTempDataStacked(v int32) {
+	C.ImGuiContext_SetClipperTempDataStacked(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindowClass) GetDockingAllowUnclassed() bool {
-	return C.ImGuiWindowClass_GetDockingAllowUnclassed(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetClipperTempDataStacked() int {
+	return int(C.ImGuiContext_GetClipperTempDataStacked(self.handle()))
 }
 
-func (self ImGuiInputEventMouseButton) SetButton(v int32) {
-	C.ImGuiInputEventMouseButton_SetButton(self.handle(), C.int(v))
+func (self ImGuiContext) SetCurrentTable(v ImGuiTable) {
+	C.ImGuiContext_SetCurrentTable(self.handle(), v.handle())
 }
 
-func (self ImGuiInputEventMouseButton) GetButton() int {
-	return int(C.ImGuiInputEventMouseButton_GetButton(self.handle()))
+func (self ImGuiContext) GetCurrentTable() ImGuiTable {
+	return (ImGuiTable)(unsafe.Pointer(C.ImGuiContext_GetCurrentTable(self.handle())))
 }
 
-func (self ImGuiInputEventMouseButton) SetDown(v bool) {
-	C.ImGuiInputEventMouseButton_SetDown(self.handle(), C.bool(v))
+func (self ImGuiContext) SetTablesTempDataStacked(v int32) {
+	C.ImGuiContext_SetTablesTempDataStacked(self.handle(), C.int(v))
 }
 
-func (self ImGuiInputEventMouseButton) GetDown() bool {
-	return C.ImGuiInputEventMouseButton_GetDown(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetTablesTempDataStacked() int {
+	return int(C.ImGuiContext_GetTablesTempDataStacked(self.handle()))
 }
 
-func (self ImGuiPtrOrIndex) SetPtr(v unsafe.Pointer) {
-	C.ImGuiPtrOrIndex_SetPtr(self.handle(), v)
+func (self ImGuiContext) SetCurrentTabBar(v ImGuiTabBar) {
+	C.ImGuiContext_SetCurrentTabBar(self.handle(), v.handle())
 }
 
-func (self ImGuiPtrOrIndex) GetPtr() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiPtrOrIndex_GetPtr(self.handle()))
+func (self ImGuiContext) GetCurrentTabBar() ImGuiTabBar {
+	return (ImGuiTabBar)(unsafe.Pointer(C.ImGuiContext_GetCurrentTabBar(self.handle())))
 }
 
-func (self ImGuiPtrOrIndex) SetIndex(v int32) {
-	C.ImGuiPtrOrIndex_SetIndex(self.handle(), C.int(v))
+func (self ImGuiContext) SetMouseLastValidPos(v ImVec2) {
+	C.ImGuiContext_SetMouseLastValidPos(self.handle(), v.toC())
 }
 
-func (self ImGuiPtrOrIndex) GetIndex() int {
-	return int(C.ImGuiPtrOrIndex_GetIndex(self.handle()))
+func (self ImGuiContext) GetMouseLastValidPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiContext_GetMouseLastValidPos(self.handle()))
 }
 
-func (self ImGuiSettingsHandler) SetTypeName(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
-
-	C.ImGuiSettingsHandler_SetTypeName(self.handle(), vArg)
+func (self ImGuiContext) GetInputTextState() ImGuiInputTextState {
+	return newImGuiInputTextStateFromC(C.ImGuiContext_GetInputTextState(self.handle()))
 }
 
-func (self ImGuiSettingsHandler) GetTypeName() string {
-	return C.GoString(C.ImGuiSettingsHandler_GetTypeName(self.handle()))
+func (self ImGuiContext) GetInputTextPasswordFont() ImFont {
+	return newImFontFromC(C.ImGuiContext_GetInputTextPasswordFont(self.handle()))
 }
 
-func (self ImGuiSettingsHandler) SetTypeHash(v ImGuiID) {
-	C.ImGuiSettingsHandler_SetTypeHash(self.handle(), C.ImGuiID(v))
+func (self ImGuiContext) SetTempInputId(v ImGuiID) {
+	C.ImGuiContext_SetTempInputId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiSettingsHandler) GetTypeHash() ImGuiID {
-	return ImGuiID(C.ImGuiSettingsHandler_GetTypeHash(self.handle()))
+func (self ImGuiContext) GetTempInputId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetTempInputId(self.handle()))
 }
 
-func (self ImGuiSettingsHandler) SetUserData(v unsafe.Pointer) {
-	C.ImGuiSettingsHandler_SetUserData(self.handle(), v)
+func (self ImGuiContext) SetColorEditOptions(v ImGuiColorEditFlags) {
+	C.ImGuiContext_SetColorEditOptions(self.handle(), C.ImGuiColorEditFlags(v))
 }
 
-func (self ImGuiSettingsHandler) GetUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiSettingsHandler_GetUserData(self.handle()))
+func (self ImGuiContext) GetColorEditOptions() ImGuiColorEditFlags {
+	return ImGuiColorEditFlags(C.ImGuiContext_GetColorEditOptions(self.handle()))
 }
 
-func (self ImGuiInputEventAppFocused) SetFocused(v bool) {
-	C.ImGuiInputEventAppFocused_SetFocused(self.handle(), C.bool(v))
+func (self ImGuiContext) SetColorEditLastHue(v float32) {
+	C.ImGuiContext_SetColorEditLastHue(self.handle(), C.float(v))
 }
 
-func (self ImGuiInputEventAppFocused) GetFocused() bool {
-	return C.ImGuiInputEventAppFocused_GetFocused(self.handle()) == C.bool(true)
+func (self ImGuiContext) GetColorEditLastHue() float32 {
+	return float32(C.ImGuiContext_GetColorEditLastHue(self.handle()))
 }
 
-func (self ImGuiInputEventText) SetChar(v uint32) {
-	C.ImGuiInputEventText_SetChar(self.handle(), C.uint(v))
+func (self ImGuiContext) SetColorEditLastSat(v float32) {
+	C.ImGuiContext_SetColorEditLastSat(self.handle(), C.float(v))
 }
 
-func (self ImGuiInputEventText) GetChar() uint32 {
-	return uint32(C.ImGuiInputEventText_GetChar(self.handle()))
+func (self ImGuiContext) GetColorEditLastSat() float32 {
+	return float32(C.ImGuiContext_GetColorEditLastSat(self.handle()))
 }
 
-func (self ImGuiListClipperData) SetListClipper(v ImGuiListClipper) {
-	C.ImGuiListClipperData_SetListClipper(self.handle(), v.handle())
+func (self ImGuiContext) SetColorEditLastColor(v uint32) {
+	C.ImGuiContext_SetColorEditLastColor(self.handle(), C.ImU32(v))
 }
 
-func (self ImGuiListClipperData) GetListClipper() ImGuiListClipper {
-	return (ImGuiListClipper)(unsafe.Pointer(C.ImGuiListClipperData_GetListClipper(self.handle())))
+func (self ImGuiContext) GetColorEditLastColor() uint32 {
+	return uint32(C.ImGuiContext_GetColorEditLastColor(self.handle()))
 }
 
-func (self ImGuiListClipperData) SetLossynessOffset(v float32) {
-	C.ImGuiListClipperData_SetLossynessOffset(self.handle(), C.float(v))
+func (self ImGuiContext) SetColorPickerRef(v ImVec4) {
+	C.ImGuiContext_SetColorPickerRef(self.handle(), v.toC())
 }
 
-func (self ImGuiListClipperData) GetLossynessOffset() float32 {
-	return float32(C.ImGuiListClipperData_GetLossynessOffset(self.handle()))
+func (self ImGuiContext) GetColorPickerRef() ImVec4 {
+	return newImVec4FromC(C.ImGuiContext_GetColorPickerRef(self.handle()))
 }
 
-func (self ImGuiListClipperData) SetStepNo(v int32) {
-	C.ImGuiListClipperData_SetStepNo(self.handle(), C.int(v))
+func (self ImGuiContext) GetComboPreviewData() ImGuiComboPreviewData {
+	return newImGuiComboPreviewDataFromC(C.ImGuiContext_GetComboPreviewData(self.handle()))
 }
 
-func (self ImGuiListClipperData) GetStepNo() int {
-	return int(C.ImGuiListClipperData_GetStepNo(self.handle()))
+func (self ImGuiContext) SetSliderGrabClickOffset(v float32) {
+	C.ImGuiContext_SetSliderGrabClickOffset(self.handle(), C.float(v))
 }
 
-func (self ImGuiListClipperData) SetItemsFrozen(v int32) {
-	C.ImGuiListClipperData_SetItemsFrozen(self.handle(), C.int(v))
+func (self ImGuiContext) GetSliderGrabClickOffset() float32 {
+	return float32(C.ImGuiContext_GetSliderGrabClickOffset(self.handle()))
 }
 
-func (self ImGuiListClipperData) GetItemsFrozen() int {
-	return int(C.ImGuiListClipperData_GetItemsFrozen(self.handle()))
+func (self ImGuiContext) SetSliderCurrentAccum(v float32) {
+	C.ImGuiContext_SetSliderCurrentAccum(self.handle(), C.float(v))
 }
 
-func (self ImGuiOnceUponAFrame) SetRefFrame(v int32) {
-	C.ImGuiOnceUponAFrame_SetRefFrame(self.handle(), C.int(v))
+func (self ImGuiContext) GetSliderCurrentAccum() float32 {
+	return float32(C.ImGuiContext_GetSliderCurrentAccum(self.handle()))
 }
 
-func (self ImGuiOnceUponAFrame) GetRefFrame() int {
-	return int(C.ImGuiOnceUponAFrame_GetRefFrame(self.handle()))
+func (self ImGuiContext) SetSliderCurrentAccumDirty(v bool) {
+	C.ImGuiContext_SetSliderCurrentAccumDirty(self.handle(), C.bool(v))
 }
 
-func (self ImGuiSizeCallbackData) SetUserData(v unsafe.Pointer) {
-	C.ImGuiSizeCallbackData_SetUserData(self.handle(), v)
+func (self ImGuiContext) GetSliderCurrentAccumDirty() bool {
+	return C.ImGuiContext_GetSliderCurrentAccumDirty(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiSizeCallbackData) GetUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiSizeCallbackData_GetUserData(self.handle()))
+func (self ImGuiContext) SetDragCurrentAccumDirty(v bool) {
+	C.ImGuiContext_SetDragCurrentAccumDirty(self.handle(), C.bool(v))
 }
 
-func (self ImGuiSizeCallbackData) SetPos(v ImVec2) {
-	C.ImGuiSizeCallbackData_SetPos(self.handle(), v.toC())
+func (self ImGuiContext) GetDragCurrentAccumDirty() bool {
+	return C.ImGuiContext_GetDragCurrentAccumDirty(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiSizeCallbackData) GetPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiSizeCallbackData_GetPos(self.handle()))
+func (self ImGuiContext) SetDragCurrentAccum(v float32) {
+	C.ImGuiContext_SetDragCurrentAccum(self.handle(), C.float(v))
 }
 
-func (self ImGuiSizeCallbackData) SetCurrentSize(v ImVec2) {
-	C.ImGuiSizeCallbackData_SetCurrentSize(self.handle(), v.toC())
+func (self ImGuiContext) GetDragCurrentAccum() float32 {
+	return float32(C.ImGuiContext_GetDragCurrentAccum(self.handle()))
 }
 
-func (self ImGuiSizeCallbackData) GetCurrentSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiSizeCallbackData_GetCurrentSize(self.handle()))
+func (self ImGuiContext) SetDragSpeedDefaultRatio(v float32) {
+	C.ImGuiContext_SetDragSpeedDefaultRatio(self.handle(), C.float(v))
 }
 
-func (self ImGuiSizeCallbackData) SetDesiredSize(v ImVec2) {
-	C.ImGuiSizeCallbackData_SetDesiredSize(self.handle(), v.toC())
+func (self ImGuiContext) GetDragSpeedDefaultRatio() float32 {
+	return float32(C.ImGuiContext_GetDragSpeedDefaultRatio(self.handle()))
 }
 
-func (self ImGuiSizeCallbackData) GetDesiredSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiSizeCallbackData_GetDesiredSize(self.handle()))
+func (self ImGuiContext) SetScrollbarClickDeltaToGrabCenter(v float32) {
+	C.ImGuiContext_SetScrollbarClickDeltaToGrabCenter(self.handle(), C.float(v))
 }
 
-func (self ImGuiStyle) SetAlpha(v float32) {
-	C.ImGuiStyle_SetAlpha(self.handle(), C.float(v))
+func (self ImGuiContext) GetScrollbarClickDeltaToGrabCenter() float32 {
+	return float32(C.ImGuiContext_GetScrollbarClickDeltaToGrabCenter(self.handle()))
 }
 
-func (self ImGuiStyle) GetAlpha() float32 {
-	return float32(C.ImGuiStyle_GetAlpha(self.handle()))
+func (self ImGuiContext) SetDisabledAlphaBackup(v float32) {
+	C.ImGuiContext_SetDisabledAlphaBackup(self.handle(), C.float(v))
 }
 
-func (self ImGuiStyle) SetDisabledAlpha(v float32) {
-	C.ImGuiStyle_SetDisabledAlpha(self.handle(), C.float(v))
+func (self ImGuiContext) GetDisabledAlphaBackup() float32 {
+	return float32(C.ImGuiContext_GetDisabledAlphaBackup(self.handle()))
 }
 
-func (self ImGuiStyle) GetDisabledAlpha() float32 {
-	return float32(C.ImGuiStyle_GetDisabledAlpha(self.handle()))
+func (self ImGuiContext) SetDisabledStackSize(v int) {
+	C.ImGuiContext_SetDisabledStackSize(self.handle(), C.short(v))
 }
 
-func (self ImGuiStyle) SetWindowPadding(v ImVec2) {
-	C.ImGuiStyle_SetWindowPadding(self.handle(), v.toC())
+func (self ImGuiContext) GetDisabledStackSize() int {
+	return int(C.ImGuiContext_GetDisabledStackSize(self.handle()))
 }
 
-func (self ImGuiStyle) GetWindowPadding() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetWindowPadding(self.handle()))
+func (self ImGuiContext) SetTooltipOverrideCount(v int) {
+	C.ImGuiContext_SetTooltipOverrideCount(self.handle(), C.short(v))
 }
 
-func (self ImGuiStyle) SetWindowRounding(v float32) {
-	C.ImGuiStyle_SetWindowRounding(self.handle(), C.float(v))
+func (self ImGuiContext) GetTooltipOverrideCount() int {
+	return int(C.ImGuiContext_GetTooltipOverrideCount(self.handle()))
 }
 
-func (self ImGuiStyle) GetWindowRounding() float32 {
-	return float32(C.ImGuiStyle_GetWindowRounding(self.handle()))
+func (self ImGuiContext) SetTooltipSlowDelay(v float32) {
+	C.ImGuiContext_SetTooltipSlowDelay(self.handle(), C.float(v))
 }
 
-func (self ImGuiStyle) SetWindowBorderSize(v float32) {
-	C.ImGuiStyle_SetWindowBorderSize(self.handle(), C.float(v))
+func (self ImGuiContext) GetTooltipSlowDelay() float32 {
+	return float32(C.ImGuiContext_GetTooltipSlowDelay(self.handle()))
 }
 
-func (self ImGuiStyle) GetWindowBorderSize() float32 {
-	return float32(C.ImGuiStyle_GetWindowBorderSize(self.handle()))
+func (self ImGuiContext) GetPlatformImeData() ImGuiPlatformImeData {
+	return newImGuiPlatformImeDataFromC(C.ImGuiContext_GetPlatformImeData(self.handle()))
 }
 
-func (self ImGuiStyle) SetWindowMinSize(v ImVec2) {
-	C.ImGuiStyle_SetWindowMinSize(self.handle(), v.toC())
+func (self ImGuiContext) GetPlatformImeDataPrev() ImGuiPlatformImeData {
+	return newImGuiPlatformImeDataFromC(C.ImGuiContext_GetPlatformImeDataPrev(self.handle()))
 }
 
-func (self ImGuiStyle) GetWindowMinSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetWindowMinSize(self.handle()))
+func (self ImGuiContext) SetPlatformImeViewport(v ImGuiID) {
+	C.ImGuiContext_SetPlatformImeViewport(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiStyle) SetWindowTitleAlign(v ImVec2) {
-	C.ImGuiStyle_SetWindowTitleAlign(self.handle(), v.toC())
+func (self ImGuiContext) GetPlatformImeViewport() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetPlatformImeViewport(self.handle()))
 }
 
-func (self ImGuiStyle) GetWindowTitleAlign() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetWindowTitleAlign(self.handle()))
+func (self ImGuiContext) GetDockContext() ImGuiDockContext {
+	return newImGuiDockContextFromC(C.ImGuiContext_GetDockContext(self.handle()))
 }
 
-func (self ImGuiStyle) SetWindowMenuButtonPosition(v ImGuiDir) {
-	C.ImGuiStyle_SetWindowMenuButtonPosition(self.handle(), C.ImGuiDir(v))
+func (self ImGuiContext) SetSettingsLoaded(v bool) {
+	C.ImGuiContext_SetSettingsLoaded(self.handle(), C.bool(v))
 }
 
-func (self ImGuiStyle) GetWindowMenuButtonPosition() ImGuiDir {
-	return ImGuiDir(C.ImGuiStyle_GetWindowMenuButtonPosition(self.handle()))
+func (self ImGuiContext) GetSettingsLoaded() bool {
+	return C.ImGuiContext_GetSettingsLoaded(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiStyle) SetChildRounding(v float32) {
-	C.ImGuiStyle_SetChildRounding(self.handle(), C.float(v))
+func (self ImGuiContext) SetSettingsDirtyTimer(v float32) {
+	C.ImGuiContext_SetSettingsDirtyTimer(self.handle(), C.float(v))
 }
 
-func (self ImGuiStyle) GetChildRounding() float32 {
-	return float32(C.ImGuiStyle_GetChildRounding(self.handle()))
+func (self ImGuiContext) GetSettingsDirtyTimer() float32 {
+	return float32(C.ImGuiContext_GetSettingsDirtyTimer(self.handle()))
 }
 
-func (self ImGuiStyle) SetChildBorderSize(v float32) {
-	C.ImGuiStyle_SetChildBorderSize(self.handle(), C.float(v))
+func (self ImGuiContext) GetSettingsIniData() ImGuiTextBuffer {
+	return newImGuiTextBufferFromC(C.ImGuiContext_GetSettingsIniData(self.handle()))
 }
 
-func (self ImGuiStyle) GetChildBorderSize() float32 {
-	return float32(C.ImGuiStyle_GetChildBorderSize(self.handle()))
+func (self ImGuiContext) SetHookIdNext(v ImGuiID) {
+	C.ImGuiContext_SetHookIdNext(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiStyle) SetPopupRounding(v float32) {
-	C.ImGuiStyle_SetPopupRounding(self.handle(), C.float(v))
+func (self ImGuiContext) GetHookIdNext() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetHookIdNext(self.handle()))
 }
 
-func (self ImGuiStyle) GetPopupRounding() float32 {
-	return float32(C.ImGuiStyle_GetPopupRounding(self.handle()))
+func (self ImGuiContext) SetLogEnabled(v bool) {
+	C.ImGuiContext_SetLogEnabled(self.handle(), C.bool(v))
 }
 
-func (self ImGuiStyle) SetPopupBorderSize(v float32) {
-	C.ImGuiStyle_SetPopupBorderSize(self.handle(), C.float(v))
+func (self ImGuiContext) GetLogEnabled() bool {
+	return C.ImGuiContext_GetLogEnabled(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiStyle) GetPopupBorderSize() float32 {
-	return float32(C.ImGuiStyle_GetPopupBorderSize(self.handle()))
+func (self ImGuiContext) SetLogType(v ImGuiLogType) {
+	C.ImGuiContext_SetLogType(self.handle(), C.ImGuiLogType(v))
 }
 
-func (self ImGuiStyle) SetFramePadding(v ImVec2) {
-	C.ImGuiStyle_SetFramePadding(self.handle(), v.toC())
+func (self ImGuiContext) GetLogType() ImGuiLogType {
+	return ImGuiLogType(C.ImGuiContext_GetLogType(self.handle()))
 }
 
-func (self ImGuiStyle) GetFramePadding() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetFramePadding(self.handle()))
+func (self ImGuiContext) GetLogBuffer() ImGuiTextBuffer {
+	return newImGuiTextBufferFromC(C.ImGuiContext_GetLogBuffer(self.handle()))
 }
 
-func (self ImGuiStyle) SetFrameRounding(v float32) {
-	C.ImGuiStyle_SetFrameRounding(self.handle(), C.float(v))
+func (self ImGuiContext) SetLogNextPrefix(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
+
+	C.ImGuiContext_SetLogNextPrefix(self.handle(), vArg)
 }
 
-func (self ImGuiStyle) GetFrameRounding() float32 {
-	return float32(C.ImGuiStyle_GetFrameRounding(self.handle()))
+func (self ImGuiContext) GetLogNextPrefix() string {
+	return C.GoString(C.ImGuiContext_GetLogNextPrefix(self.handle()))
 }
 
-func (self ImGuiStyle) SetFrameBorderSize(v float32) {
-	C.ImGuiStyle_SetFrameBorderSize(self.handle(), C.float(v))
+func (self ImGuiContext) SetLogNextSuffix(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
+
+	C.ImGuiContext_SetLogNextSuffix(self.handle(), vArg)
 }
 
-func (self ImGuiStyle) GetFrameBorderSize() float32 {
-	return float32(C.ImGuiStyle_GetFrameBorderSize(self.handle()))
+func (self ImGuiContext) GetLogNextSuffix() string {
+	return C.GoString(C.ImGuiContext_GetLogNextSuffix(self.handle()))
 }
 
-func (self ImGuiStyle) SetItemSpacing(v ImVec2) {
-	C.ImGuiStyle_SetItemSpacing(self.handle(), v.toC())
+func (self ImGuiContext) SetLogLinePosY(v float32) {
+	C.ImGuiContext_SetLogLinePosY(self.handle(), C.float(v))
 }
 
-func (self ImGuiStyle) GetItemSpacing() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetItemSpacing(self.handle()))
+func (self ImGuiContext) GetLogLinePosY() float32 {
+	return float32(C.ImGuiContext_GetLogLinePosY(self.handle()))
 }
 
-func (self ImGuiStyle) SetItemInnerSpacing(v ImVec2) {
-	C.ImGuiStyle_SetItemInnerSpacing(self.handle(), v.toC())
+func (self ImGuiContext) SetLogLineFirstItem(v bool) {
+	C.ImGuiContext_SetLogLineFirstItem(self.handle(), C.bool(v))
 }
 
-func (self ImGuiStyle) GetItemInnerSpacing() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetItemInnerSpacing(self.handle()))
+func (self ImGuiContext) GetLogLineFirstItem() bool {
+	return C.ImGuiContext_GetLogLineFirstItem(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiStyle) SetCellPadding(v ImVec2) {
-	C.ImGuiStyle_SetCellPadding(self.handle(), v.toC())
+func (self ImGuiContext) SetLogDepthRef(v int32) {
+	C.ImGuiContext_SetLogDepthRef(self.handle(), C.int(v))
 }
 
-func (self ImGuiStyle) GetCellPadding() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetCellPadding(self.handle()))
+func (self ImGuiContext) GetLogDepthRef() int {
+	return int(C.ImGuiContext_GetLogDepthRef(self.handle()))
 }
 
-func (self ImGuiStyle) SetTouchExtraPadding(v ImVec2) {
-	C.ImGuiStyle_SetTouchExtraPadding(self.handle(), v.toC())
+func (self ImGuiContext) SetLogDepthToExpand(v int32) {
+	C.ImGuiContext_SetLogDepthToExpand(self.handle(), C.int(v))
 }
 
-func (self ImGuiStyle) GetTouchExtraPadding() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetTouchExtraPadding(self.handle()))
+func (self ImGuiContext) GetLogDepthToExpand() int {
+	return int(C.ImGuiContext_GetLogDepthToExpand(self.handle()))
 }
 
-func (self ImGuiStyle) SetIndentSpacing(v float32) {
-	C.ImGuiStyle_SetIndentSpacing(self.handle(), C.float(v))
+func (self ImGuiContext) SetLogDepthToExpandDefault(v int32) {
+	C.ImGuiContext_SetLogDepthToExpandDefault(self.handle(), C.int(v))
 }
 
-func (self ImGuiStyle) GetIndentSpacing() float32 {
-	return float32(C.ImGuiStyle_GetIndentSpacing(self.handle()))
+func (self ImGuiContext) GetLogDepthToExpandDefault() int {
+	return int(C.ImGuiContext_GetLogDepthToExpandDefault(self.handle()))
 }
 
-func (self ImGuiStyle) SetColumnsMinSpacing(v float32) {
-	C.ImGuiStyle_SetColumnsMinSpacing(self.handle(), C.float(v))
+func (self ImGuiContext) SetDebugLogFlags(v ImGuiDebugLogFlags) {
+	C.ImGuiContext_SetDebugLogFlags(self.handle(), C.ImGuiDebugLogFlags(v))
 }
 
-func (self ImGuiStyle) GetColumnsMinSpacing() float32 {
-	return float32(C.ImGuiStyle_GetColumnsMinSpacing(self.handle()))
+func (self ImGuiContext) GetDebugLogFlags() ImGuiDebugLogFlags {
+	return ImGuiDebugLogFlags(C.ImGuiContext_GetDebugLogFlags(self.handle()))
 }
 
-func (self ImGuiStyle) SetScrollbarSize(v float32) {
-	C.ImGuiStyle_SetScrollbarSize(self.handle(), C.float(v))
+func (self ImGuiContext) GetDebugLogBuf() ImGuiTextBuffer {
+	return newImGuiTextBufferFromC(C.ImGuiContext_GetDebugLogBuf(self.handle()))
 }
 
-func (self ImGuiStyle) GetScrollbarSize() float32 {
-	return float32(C.ImGuiStyle_GetScrollbarSize(self.handle()))
+func (self ImGuiContext) SetDebugItemPickerActive(v bool) {
+	C.ImGuiContext_SetDebugItemPickerActive(self.handle(), C.bool(v))
 }
 
-func (self ImGuiStyle) SetScrollbarRounding(v float32) {
-	C.ImGuiStyle_SetScrollbarRounding(self.handle(), C.float(v))
+func (self ImGuiContext) GetDebugItemPickerActive() bool {
+	return C.ImGuiContext_GetDebugItemPickerActive(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiStyle) GetScrollbarRounding() float32 {
-	return float32(C.ImGuiStyle_GetScrollbarRounding(self.handle()))
+func (self ImGuiContext) SetDebugItemPickerMouseButton(v uint) {
+	C.ImGuiContext_SetDebugItemPickerMouseButton(self.handle(), C.ImU8(v))
 }
 
-func (self ImGuiStyle) SetGrabMinSize(v float32) {
-	C.ImGuiStyle_SetGrabMinSize(self.handle(), C.float(v))
+func (self ImGuiContext) GetDebugItemPickerMouseButton() uint32 {
+	return uint32(C.ImGuiContext_GetDebugItemPickerMouseButton(self.handle()))
 }
 
-func (self ImGuiStyle) GetGrabMinSize() float32 {
-	return float32(C.ImGuiStyle_GetGrabMinSize(self.handle()))
+func (self ImGuiContext) SetDebugItemPickerBreakId(v ImGuiID) {
+	C.ImGuiContext_SetDebugItemPickerBreakId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiStyle) SetGrabRounding(v float32) {
-	C.ImGuiStyle_SetGrabRounding(self.handle(), C.float(v))
+func (self ImGuiContext) GetDebugItemPickerBreakId() ImGuiID {
+	return ImGuiID(C.ImGuiContext_GetDebugItemPickerBreakId(self.handle()))
 }
 
-func (self ImGuiStyle) GetGrabRounding() float32 {
-	return float32(C.ImGuiStyle_GetGrabRounding(self.handle()))
+func (self ImGuiContext) GetDebugMetricsConfig() ImGuiMetricsConfig {
+	return newImGuiMetricsConfigFromC(C.ImGuiContext_GetDebugMetricsConfig(self.handle()))
 }
 
-func (self ImGuiStyle) SetLogSliderDeadzone(v float32) {
-	C.ImGuiStyle_SetLogSliderDeadzone(self.handle(), C.float(v))
+func (self ImGuiContext) GetDebugStackTool() ImGuiStackTool {
+	return newImGuiStackToolFromC(C.ImGuiContext_GetDebugStackTool(self.handle()))
 }
 
-func (self ImGuiStyle) GetLogSliderDeadzone() float32 {
-	return float32(C.ImGuiStyle_GetLogSliderDeadzone(self.handle()))
+func (self ImGuiContext) SetFramerateSecPerFrameIdx(v int32) {
+	C.ImGuiContext_SetFramerateSecPerFrameIdx(self.handle(), C.int(v))
 }
 
-func (self ImGuiStyle) SetTabRounding(v float32) {
-	C.ImGuiStyle_SetTabRounding(self.handle(), C.float(v))
+func (self ImGuiContext) GetFramerateSecPerFrameIdx() int {
+	return int(C.ImGuiContext_GetFramerateSecPerFrameIdx(self.handle()))
 }
 
-func (self ImGuiStyle) GetTabRounding() float32 {
-	return float32(C.ImGuiStyle_GetTabRounding(self.handle()))
+func (self ImGuiContext) SetFramerateSecPerFrameCount(v int32) {
+	C.ImGuiContext_SetFramerateSecPerFrameCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiStyle) SetTabBorderSize(v float32) {
-	C.ImGuiStyle_SetTabBorderSize(self.handle(), C.float(v))
+func (self ImGuiContext) GetFramerateSecPerFrameCount() int {
+	return int(C.ImGuiContext_GetFramerateSecPerFrameCount(self.handle()))
 }
 
-func (self ImGuiStyle) GetTabBorderSize() float32 {
-	return float32(C.ImGuiStyle_GetTabBorderSize(self.handle()))
+func (self ImGuiContext) SetFramerateSecPerFrameAccum(v float32) {
+	C.ImGuiContext_SetFramerateSecPerFrameAccum(self.handle(), C.float(v))
 }
 
-func (self ImGuiStyle) SetTabMinWidthForCloseButton(v float32) {
-	C.ImGuiStyle_SetTabMinWidthForCloseButton(self.handle(), C.float(v))
+func (self ImGuiContext) GetFramerateSecPerFrameAccum() float32 {
+	return float32(C.ImGuiContext_GetFramerateSecPerFrameAccum(self.handle()))
 }
 
-func (self ImGuiStyle) GetTabMinWidthForCloseButton() float32 {
-	return float32(C.ImGuiStyle_GetTabMinWidthForCloseButton(self.handle()))
+func (self ImGuiContext) SetWantCaptureMouseNextFrame(v int32) {
+	C.ImGuiContext_SetWantCaptureMouseNextFrame(self.handle(), C.int(v))
 }
 
-func (self ImGuiStyle) SetColorButtonPosition(v ImGuiDir) {
-	C.ImGuiStyle_SetColorButtonPosition(self.handle(), C.ImGuiDir(v))
+func (self ImGuiContext) GetWantCaptureMouseNextFrame() int {
+	return int(C.ImGuiContext_GetWantCaptureMouseNextFrame(self.handle()))
 }
 
-func (self ImGuiStyle) GetColorButtonPosition() ImGuiDir {
-	return ImGuiDir(C.ImGuiStyle_GetColorButtonPosition(self.handle()))
+func (self ImGuiContext) SetWantCaptureKeyboardNextFrame(v int32) {
+	C.ImGuiContext_SetWantCaptureKeyboardNextFrame(self.handle(), C.int(v))
 }
 
-func (self ImGuiStyle) SetButtonTextAlign(v ImVec2) {
-	C.ImGuiStyle_SetButtonTextAlign(self.handle(), v.toC())
+func (self ImGuiContext) GetWantCaptureKeyboardNextFrame() int {
+	return int(C.ImGuiContext_GetWantCaptureKeyboardNextFrame(self.handle()))
 }
 
-func (self ImGuiStyle) GetButtonTextAlign() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetButtonTextAlign(self.handle()))
+func (self ImGuiContext) SetWantTextInputNextFrame(v int32) {
+	C.ImGuiContext_SetWantTextInputNextFrame(self.handle(), C.int(v))
 }
 
-func (self ImGuiStyle) SetSelectableTextAlign(v ImVec2) {
-	C.ImGuiStyle_SetSelectableTextAlign(self.handle(), v.toC())
+func (self ImGuiContext) GetWantTextInputNextFrame() int {
+	return int(C.ImGuiContext_GetWantTextInputNextFrame(self.handle()))
 }
 
-func (self ImGuiStyle) GetSelectableTextAlign() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetSelectableTextAlign(self.handle()))
+func (self ImGuiWindowTempData) SetCursorPos(v ImVec2) {
+	C.ImGuiWindowTempData_SetCursorPos(self.handle(), v.toC())
 }
 
-func (self ImGuiStyle) SetDisplayWindowPadding(v ImVec2) {
-	C.ImGuiStyle_SetDisplayWindowPadding(self.handle(), v.toC())
+func (self ImGuiWindowTempData) GetCursorPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindowTempData_GetCursorPos(self.handle()))
 }
 
-func (self ImGuiStyle) GetDisplayWindowPadding() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetDisplayWindowPadding(self.handle()))
+func (self ImGuiWindowTempData) SetCursorPosPrevLine(v ImVec2) {
+	C.ImGuiWindowTempData_SetCursorPosPrevLine(self.handle(), v.toC())
 }
 
-func (self ImGuiStyle) SetDisplaySafeAreaPadding(v ImVec2) {
-	C.ImGuiStyle_SetDisplaySafeAreaPadding(self.handle(), v.toC())
+func (self ImGuiWindowTempData) GetCursorPosPrevLine() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindowTempData_GetCursorPosPrevLine(self.handle()))
 }
 
-func (self ImGuiStyle) GetDisplaySafeAreaPadding() ImVec2 {
-	return newImVec2FromC(C.ImGuiStyle_GetDisplaySafeAreaPadding(self.handle()))
+func (self ImGuiWindowTempData) SetCursorStartPos(v ImVec2) {
+	C.ImGuiWindowTempData_SetCursorStartPos(self.handle(), v.toC())
 }
 
-func (self ImGuiStyle) SetMouseCursorScale(v float32) {
-	C.ImGuiStyle_SetMouseCursorScale(self.handle(), C.float(v))
+func (self ImGuiWindowTempData) GetCursorStartPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindowTempData_GetCursorStartPos(self.handle()))
 }
 
-func (self ImGuiStyle) GetMouseCursorScale() float32 {
-	return float32(C.ImGuiStyle_GetMouseCursorScale(self.handle()))
+func (self ImGuiWindowTempData) SetCursorMaxPos(v ImVec2) {
+	C.ImGuiWindowTempData_SetCursorMaxPos(self.handle(), v.toC())
 }
 
-func (self ImGuiStyle) SetAntiAliasedLines(v bool) {
-	C.ImGuiStyle_SetAntiAliasedLines(self.handle(), C.bool(v))
+func (self ImGuiWindowTempData) GetCursorMaxPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindowTempData_GetCursorMaxPos(self.handle()))
 }
 
-func (self ImGuiStyle) GetAntiAliasedLines() bool {
-	return C.ImGuiStyle_GetAntiAliasedLines(self.handle()) == C.bool(true)
+func (self ImGuiWindowTempData) SetIdealMaxPos(v ImVec2) {
+	C.ImGuiWindowTempData_SetIdealMaxPos(self.handle(), v.toC())
 }
 
-func (self ImGuiStyle) SetAntiAliasedLinesUseTex(v bool) {
-	C.ImGuiStyle_SetAntiAliasedLinesUseTex(self.handle(), C.bool(v))
+func (self ImGuiWindowTempData) GetIdealMaxPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindowTempData_GetIdealMaxPos(self.handle()))
 }
 
-func (self ImGuiStyle) GetAntiAliasedLinesUseTex() bool {
-	return C.ImGuiStyle_GetAntiAliasedLinesUseTex(self.handle()) == C.bool(true)
+func (self ImGuiWindowTempData) SetCurrLineSize(v ImVec2) {
+	C.ImGuiWindowTempData_SetCurrLineSize(self.handle(), v.toC())
 }
 
-func (self ImGuiStyle) SetAntiAliasedFill(v bool) {
-	C.ImGuiStyle_SetAntiAliasedFill(self.handle(), C.bool(v))
+func (self ImGuiWindowTempData) GetCurrLineSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindowTempData_GetCurrLineSize(self.handle()))
 }
 
-func (self ImGuiStyle) GetAntiAliasedFill() bool {
-	return C.ImGuiStyle_GetAntiAliasedFill(self.handle()) == C.bool(true)
+func (self ImGuiWindowTempData) SetPrevLineSize(v ImVec2) {
+	C.ImGuiWindowTempData_SetPrevLineSize(self.handle(), v.toC())
 }
 
-func (self ImGuiStyle) SetCurveTessellationTol(v float32) {
-	C.ImGuiStyle_SetCurveTessellationTol(self.handle(), C.float(v))
+func (self ImGuiWindowTempData) GetPrevLineSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindowTempData_GetPrevLineSize(self.handle()))
 }
 
-func (self ImGuiStyle) GetCurveTessellationTol() float32 {
-	return float32(C.ImGuiStyle_GetCurveTessellationTol(self.handle()))
+func (self ImGuiWindowTempData) SetCurrLineTextBaseOffset(v float32) {
+	C.ImGuiWindowTempData_SetCurrLineTextBaseOffset(self.handle(), C.float(v))
 }
 
-func (self ImGuiStyle) SetCircleTessellationMaxError(v float32) {
-	C.ImGuiStyle_SetCircleTessellationMaxError(self.handle(), C.float(v))
+func (self ImGuiWindowTempData) GetCurrLineTextBaseOffset() float32 {
+	return float32(C.ImGuiWindowTempData_GetCurrLineTextBaseOffset(self.handle()))
 }
 
-func (self ImGuiStyle) GetCircleTessellationMaxError() float32 {
-	return float32(C.ImGuiStyle_GetCircleTessellationMaxError(self.handle()))
+func (self ImGuiWindowTempData) SetPrevLineTextBaseOffset(v float32) {
+	C.ImGuiWindowTempData_SetPrevLineTextBaseOffset(self.handle(), C.float(v))
 }
 
-func (self ImGuiTabItem) SetID(v ImGuiID) {
-	C.ImGuiTabItem_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiWindowTempData) GetPrevLineTextBaseOffset() float32 {
+	return float32(C.ImGuiWindowTempData_GetPrevLineTextBaseOffset(self.handle()))
 }
 
-func (self ImGuiTabItem) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiTabItem_GetID(self.handle()))
+func (self ImGuiWindowTempData) SetIsSameLine(v bool) {
+	C.ImGuiWindowTempData_SetIsSameLine(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTabItem) SetFlags(v ImGuiTabItemFlags) {
-	C.ImGuiTabItem_SetFlags(self.handle(), C.ImGuiTabItemFlags(v))
+func (self ImGuiWindowTempData) GetIsSameLine() bool {
+	return C.ImGuiWindowTempData_GetIsSameLine(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTabItem) GetFlags() ImGuiTabItemFlags {
-	return ImGuiTabItemFlags(C.ImGuiTabItem_GetFlags(self.handle()))
+func (self ImGuiWindowTempData) SetCursorStartPosLossyness(v ImVec2) {
+	C.ImGuiWindowTempData_SetCursorStartPosLossyness(self.handle(), v.toC())
 }
 
-func (self ImGuiTabItem) SetWindow(v ImGuiWindow) {
-	C.ImGuiTabItem_SetWindow(self.handle(), v.handle())
+func (self ImGuiWindowTempData) GetCursorStartPosLossyness() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindowTempData_GetCursorStartPosLossyness(self.handle()))
 }
 
-func (self ImGuiTabItem) GetWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiTabItem_GetWindow(self.handle())))
+func (self ImGuiWindowTempData) SetNavLayerCurrent(v ImGuiNavLayer) {
+	C.ImGuiWindowTempData_SetNavLayerCurrent(self.handle(), C.ImGuiNavLayer(v))
 }
 
-func (self ImGuiTabItem) SetLastFrameVisible(v int32) {
-	C.ImGuiTabItem_SetLastFrameVisible(self.handle(), C.int(v))
+func (self ImGuiWindowTempData) GetNavLayerCurrent() ImGuiNavLayer {
+	return ImGuiNavLayer(C.ImGuiWindowTempData_GetNavLayerCurrent(self.handle()))
 }
 
-func (self ImGuiTabItem) GetLastFrameVisible() int {
-	return int(C.ImGuiTabItem_GetLastFrameVisible(self.handle()))
+func (self ImGuiWindowTempData) SetNavLayersActiveMask(v int) {
+	C.ImGuiWindowTempData_SetNavLayersActiveMask(self.handle(), C.short(v))
 }
 
-func (self ImGuiTabItem) SetLastFrameSelected(v int32) {
-	C.ImGuiTabItem_SetLastFrameSelected(self.handle(), C.int(v))
+func (self ImGuiWindowTempData) GetNavLayersActiveMask() int {
+	return int(C.ImGuiWindowTempData_GetNavLayersActiveMask(self.handle()))
 }
 
-func (self ImGuiTabItem) GetLastFrameSelected() int {
-	return int(C.ImGuiTabItem_GetLastFrameSelected(self.handle()))
+func (self ImGuiWindowTempData) SetNavLayersActiveMaskNext(v int) {
+	C.ImGuiWindowTempData_SetNavLayersActiveMaskNext(self.handle(), C.short(v))
 }
 
-func (self ImGuiTabItem) SetOffset(v float32) {
-	C.ImGuiTabItem_SetOffset(self.handle(), C.float(v))
+func (self ImGuiWindowTempData) GetNavLayersActiveMaskNext() int {
+	return int(C.ImGuiWindowTempData_GetNavLayersActiveMaskNext(self.handle()))
 }
 
-func (self ImGuiTabItem) GetOffset() float32 {
-	return float32(C.ImGuiTabItem_GetOffset(self.handle()))
+func (self ImGuiWindowTempData) SetNavFocusScopeIdCurrent(v ImGuiID) {
+	C.ImGuiWindowTempData_SetNavFocusScopeIdCurrent(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiTabItem) SetWidth(v float32) {
-	C.ImGuiTabItem_SetWidth(self.handle(), C.float(v))
+func (self ImGuiWindowTempData) GetNavFocusScopeIdCurrent() ImGuiID {
+	return ImGuiID(C.ImGuiWindowTempData_GetNavFocusScopeIdCurrent(self.handle()))
 }
 
-func (self ImGuiTabItem) GetWidth() float32 {
-	return float32(C.ImGuiTabItem_GetWidth(self.handle()))
+func (self ImGuiWindowTempData) SetNavHideHighlightOneFrame(v bool) {
+	C.ImGuiWindowTempData_SetNavHideHighlightOneFrame(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTabItem) SetContentWidth(v float32) {
-	C.ImGuiTabItem_SetContentWidth(self.handle(), C.float(v))
+func (self ImGuiWindowTempData) GetNavHideHighlightOneFrame() bool {
+	return C.ImGuiWindowTempData_GetNavHideHighlightOneFrame(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTabItem) GetContentWidth() float32 {
-	return float32(C.ImGuiTabItem_GetContentWidth(self.handle()))
+func (self ImGuiWindowTempData) SetNavHasScroll(v bool) {
+	C.ImGuiWindowTempData_SetNavHasScroll(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTabItem) SetRequestedWidth(v float32) {
-	C.ImGuiTabItem_SetRequestedWidth(self.handle(), C.float(v))
+func (self ImGuiWindowTempData) GetNavHasScroll() bool {
+	return C.ImGuiWindowTempData_GetNavHasScroll(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTabItem) GetRequestedWidth() float32 {
-	return float32(C.ImGuiTabItem_GetRequestedWidth(self.handle()))
+func (self ImGuiWindowTempData) SetMenuBarAppending(v bool) {
+	C.ImGuiWindowTempData_SetMenuBarAppending(self.handle(), C.bool(v))
 }
 
-func (self ImGuiTabItem) SetNameOffset(v int) {
-	C.ImGuiTabItem_SetNameOffset(self.handle(), C.ImS32(v))
+func (self ImGuiWindowTempData) GetMenuBarAppending() bool {
+	return C.ImGuiWindowTempData_GetMenuBarAppending(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiTabItem) GetNameOffset() int {
-	return int(C.ImGuiTabItem_GetNameOffset(self.handle()))
+func (self ImGuiWindowTempData) SetMenuBarOffset(v ImVec2) {
+	C.ImGuiWindowTempData_SetMenuBarOffset(self.handle(), v.toC())
 }
 
-func (self ImGuiTabItem) SetBeginOrder(v int) {
-	C.ImGuiTabItem_SetBeginOrder(self.handle(), C.ImS16(v))
+func (self ImGuiWindowTempData) GetMenuBarOffset() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindowTempData_GetMenuBarOffset(self.handle()))
 }
 
-func (self ImGuiTabItem) GetBeginOrder() int {
-	return int(C.ImGuiTabItem_GetBeginOrder(self.handle()))
+func (self ImGuiWindowTempData) GetMenuColumns() ImGuiMenuColumns {
+	return newImGuiMenuColumnsFromC(C.ImGuiWindowTempData_GetMenuColumns(self.handle()))
 }
 
-func (self ImGuiTabItem) SetIndexDuringLayout(v int) {
-	C.ImGuiTabItem_SetIndexDuringLayout(self.handle(), C.ImS16(v))
+func (self ImGuiWindowTempData) SetTreeDepth(v int32) {
+	C.ImGuiWindowTempData_SetTreeDepth(self.handle(), C.int(v))
 }
 
-func (self ImGuiTabItem) GetIndexDuringLayout() int {
-	return int(C.ImGuiTabItem_GetIndexDuringLayout(self.handle()))
+func (self ImGuiWindowTempData) GetTreeDepth() int {
+	return int(C.ImGuiWindowTempData_GetTreeDepth(self.handle()))
 }
 
-func (self ImGuiTabItem) SetWantClose(v bool) {
-	C.ImGuiTabItem_SetWantClose(self.handle(), C.bool(v))
+func (self ImGuiWindowTempData) SetTreeJumpToParentOnPopMask(v uint32) {
+	C.ImGuiWindowTempData_SetTreeJumpToParentOnPopMask(self.handle(), C.ImU32(v))
 }
 
-func (self ImGuiTabItem) GetWantClose() bool {
-	return C.ImGuiTabItem_GetWantClose(self.handle()) == C.bool(true)
+func (self ImGuiWindowTempData) GetTreeJumpToParentOnPopMask() uint32 {
+	return uint32(C.ImGuiWindowTempData_GetTreeJumpToParentOnPopMask(self.handle()))
 }
 
-func (self ImGuiTextRange) Setb(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiWindowTempData) SetStateStorage(v ImGuiStorage) {
+	C.ImGuiWindowTempData_SetStateStorage(self.handle(), v.handle())
+}
 
-	C.ImGuiTextRange_Setb(self.handle(), vArg)
+func (self ImGuiWindowTempData) GetStateStorage() ImGuiStorage {
+	return (ImGuiStorage)(unsafe.Pointer(C.ImGuiWindowTempData_GetStateStorage(self.handle())))
 }
 
-func (self ImGuiTextRange) Getb() string {
-	return C.GoString(C.ImGuiTextRange_Getb(self.handle()))
+func (self ImGuiWindowTempData) SetCurrentColumns(v ImGuiOldColumns) {
+	C.ImGuiWindowTempData_SetCurrentColumns(self.handle(), v.handle())
 }
 
-func (self ImGuiTextRange) Sete(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiWindowTempData) GetCurrentColumns() ImGuiOldColumns {
+	return (ImGuiOldColumns)(unsafe.Pointer(C.ImGuiWindowTempData_GetCurrentColumns(self.handle())))
+}
 
-	C.ImGuiTextRange_Sete(self.handle(), vArg)
+func (self ImGuiWindowTempData) SetCurrentTableIdx(v int32) {
+	C.ImGuiWindowTempData_SetCurrentTableIdx(self.handle(), C.int(v))
 }
 
-func (self ImGuiTextRange) Gete() string {
-	return C.GoString(C.ImGuiTextRange_Gete(self.handle()))
+func (self ImGuiWindowTempData) GetCurrentTableIdx() int {
+	return int(C.ImGuiWindowTempData_GetCurrentTableIdx(self.handle()))
 }
 
-func (self ImDrawCmdHeader) SetClipRect(v ImVec4) {
-	C.ImDrawCmdHeader_SetClipRect(self.handle(), v.toC())
+func (self ImGuiWindowTempData) SetLayoutType(v ImGuiLayoutType) {
+	C.ImGuiWindowTempData_SetLayoutType(self.handle(), C.ImGuiLayoutType(v))
 }
 
-func (self ImDrawCmdHeader) GetClipRect() ImVec4 {
-	return newImVec4FromC(C.ImDrawCmdHeader_GetClipRect(self.handle()))
+func (self ImGuiWindowTempData) GetLayoutType() ImGuiLayoutType {
+	return ImGuiLayoutType(C.ImGuiWindowTempData_GetLayoutType(self.handle()))
 }
 
-func (self ImDrawCmdHeader) SetTextureId(v ImTextureID) {
-	C.ImDrawCmdHeader_SetTextureId(self.handle(), C.ImTextureID(v))
+func (self ImGuiWindowTempData) SetParentLayoutType(v ImGuiLayoutType) {
+	C.ImGuiWindowTempData_SetParentLayoutType(self.handle(), C.ImGuiLayoutType(v))
 }
 
-func (self ImDrawCmdHeader) GetTextureId() ImTextureID {
-	return ImTextureID(C.ImDrawCmdHeader_GetTextureId(self.handle()))
+func (self ImGuiWindowTempData) GetParentLayoutType() ImGuiLayoutType {
+	return ImGuiLayoutType(C.ImGuiWindowTempData_GetParentLayoutType(self.handle()))
 }
 
-func (self ImDrawCmdHeader) SetVtxOffset(v uint32) {
-	C.ImDrawCmdHeader_SetVtxOffset(self.handle(), C.uint(v))
+func (self ImGuiWindowTempData) SetItemWidth(v float32) {
+	C.ImGuiWindowTempData_SetItemWidth(self.handle(), C.float(v))
 }
 
-func (self ImDrawCmdHeader) GetVtxOffset() uint32 {
-	return uint32(C.ImDrawCmdHeader_GetVtxOffset(self.handle()))
+func (self ImGuiWindowTempData) GetItemWidth() float32 {
+	return float32(C.ImGuiWindowTempData_GetItemWidth(self.handle()))
 }
 
-func (self ImGuiViewport) SetID(v ImGuiID) {
-	C.ImGuiViewport_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiWindowTempData) SetTextWrapPos(v float32) {
+	C.ImGuiWindowTempData_SetTextWrapPos(self.handle(), C.float(v))
 }
 
-func (self ImGuiViewport) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiViewport_GetID(self.handle()))
+func (self ImGuiWindowTempData) GetTextWrapPos() float32 {
+	return float32(C.ImGuiWindowTempData_GetTextWrapPos(self.handle()))
 }
 
-func (self ImGuiViewport) SetFlags(v ImGuiViewportFlags) {
-	C.ImGuiViewport_SetFlags(self.handle(), C.ImGuiViewportFlags(v))
+func (self ImGuiWindow) SetName(v string) {
+	vArg, vFin := wrapString(v)
+	defer vFin()
+
+	C.ImGuiWindow_SetName(self.handle(), vArg)
 }
 
-func (self ImGuiViewport) GetFlags() ImGuiViewportFlags {
-	return ImGuiViewportFlags(C.ImGuiViewport_GetFlags(self.handle()))
+func (self ImGuiWindow) SetID(v ImGuiID) {
+	C.ImGuiWindow_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiViewport) SetPos(v ImVec2) {
-	C.ImGuiViewport_SetPos(self.handle(), v.toC())
+func (self ImGuiWindow) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiWindow_GetID(self.handle()))
 }
 
-func (self ImGuiViewport) GetPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewport_GetPos(self.handle()))
+func (self ImGuiWindow) SetFlags(v ImGuiWindowFlags) {
+	C.ImGuiWindow_SetFlags(self.handle(), C.ImGuiWindowFlags(v))
 }
 
-func (self ImGuiViewport) SetSize(v ImVec2) {
-	C.ImGuiViewport_SetSize(self.handle(), v.toC())
+func (self ImGuiWindow) GetFlags() ImGuiWindowFlags {
+	return ImGuiWindowFlags(C.ImGuiWindow_GetFlags(self.handle()))
 }
 
-func (self ImGuiViewport) GetSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewport_GetSize(self.handle()))
+func (self ImGuiWindow) SetFlagsPreviousFrame(v ImGuiWindowFlags) {
+	C.ImGuiWindow_SetFlagsPreviousFrame(self.handle(), C.ImGuiWindowFlags(v))
 }
 
-func (self ImGuiViewport) SetWorkPos(v ImVec2) {
-	C.ImGuiViewport_SetWorkPos(self.handle(), v.toC())
+func (self ImGuiWindow) GetFlagsPreviousFrame() ImGuiWindowFlags {
+	return ImGuiWindowFlags(C.ImGuiWindow_GetFlagsPreviousFrame(self.handle()))
 }
 
-func (self ImGuiViewport) GetWorkPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewport_GetWorkPos(self.handle()))
+func (self ImGuiWindow) GetWindowClass() ImGuiWindowClass {
+	return newImGuiWindowClassFromC(C.ImGuiWindow_GetWindowClass(self.handle()))
 }
 
-func (self ImGuiViewport) SetWorkSize(v ImVec2) {
-	C.ImGuiViewport_SetWorkSize(self.handle(), v.toC())
+func (self ImGuiWindow) SetViewport(v ImGuiViewportP) {
+	C.ImGuiWindow_SetViewport(self.handle(), v.handle())
 }
 
-func (self ImGuiViewport) GetWorkSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewport_GetWorkSize(self.handle()))
+func (self ImGuiWindow) GetViewport() ImGuiViewportP {
+	return (ImGuiViewportP)(unsafe.Pointer(C.ImGuiWindow_GetViewport(self.handle())))
 }
 
-func (self ImGuiViewport) SetDpiScale(v float32) {
-	C.ImGuiViewport_SetDpiScale(self.handle(), C.float(v))
+func (self ImGuiWindow) SetViewportId(v ImGuiID) {
+	C.ImGuiWindow_SetViewportId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiViewport) GetDpiScale() float32 {
-	return float32(C.ImGuiViewport_GetDpiScale(self.handle()))
+func (self ImGuiWindow) GetViewportId() ImGuiID {
+	return ImGuiID(C.ImGuiWindow_GetViewportId(self.handle()))
 }
 
-func (self ImGuiViewport) SetParentViewportId(v ImGuiID) {
-	C.ImGuiViewport_SetParentViewportId(self.handle(), C.ImGuiID(v))
+func (self ImGuiWindow) SetViewportPos(v ImVec2) {
+	C.ImGuiWindow_SetViewportPos(self.handle(), v.toC())
 }
 
-func (self ImGuiViewport) GetParentViewportId() ImGuiID {
-	return ImGuiID(C.ImGuiViewport_GetParentViewportId(self.handle()))
+func (self ImGuiWindow) GetViewportPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetViewportPos(self.handle()))
 }
 
-func (self ImGuiViewport) SetDrawData(v ImDrawData) {
-	C.ImGuiViewport_SetDrawData(self.handle(), v.handle())
+func (self ImGuiWindow) SetViewportAllowPlatformMonitorExtend(v int32) {
+	C.ImGuiWindow_SetViewportAllowPlatformMonitorExtend(self.handle(), C.int(v))
 }
 
-func (self ImGuiViewport) GetDrawData() ImDrawData {
-	return (ImDrawData)(unsafe.Pointer(C.ImGuiViewport_GetDrawData(self.handle())))
+func (self ImGuiWindow) GetViewportAllowPlatformMonitorExtend() int {
+	return int(C.ImGuiWindow_GetViewportAllowPlatformMonitorExtend(self.handle()))
 }
 
-func (self ImGuiViewport) SetRendererUserData(v unsafe.Pointer) {
-	C.ImGuiViewport_SetRendererUserData(self.handle(), v)
+func (self ImGuiWindow) SetPos(v ImVec2) {
+	C.ImGuiWindow_SetPos(self.handle(), v.toC())
 }
 
-func (self ImGuiViewport) GetRendererUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiViewport_GetRendererUserData(self.handle()))
+func (self ImGuiWindow) GetPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetPos(self.handle()))
 }
 
-func (self ImGuiViewport) SetPlatformUserData(v unsafe.Pointer) {
-	C.ImGuiViewport_SetPlatformUserData(self.handle(), v)
+func (self ImGuiWindow) SetSize(v ImVec2) {
+	C.ImGuiWindow_SetSize(self.handle(), v.toC())
 }
 
-func (self ImGuiViewport) GetPlatformUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiViewport_GetPlatformUserData(self.handle()))
+func (self ImGuiWindow) GetSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetSize(self.handle()))
 }
 
-func (self ImGuiViewport) SetPlatformHandle(v unsafe.Pointer) {
-	C.ImGuiViewport_SetPlatformHandle(self.handle(), v)
+func (self ImGuiWindow) SetSizeFull(v ImVec2) {
+	C.ImGuiWindow_SetSizeFull(self.handle(), v.toC())
 }
 
-func (self ImGuiViewport) GetPlatformHandle() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiViewport_GetPlatformHandle(self.handle()))
+func (self ImGuiWindow) GetSizeFull() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetSizeFull(self.handle()))
 }
 
-func (self ImGuiViewport) SetPlatformHandleRaw(v unsafe.Pointer) {
-	C.ImGuiViewport_SetPlatformHandleRaw(self.handle(), v)
+func (self ImGuiWindow) SetContentSize(v ImVec2) {
+	C.ImGuiWindow_SetContentSize(self.handle(), v.toC())
 }
 
-func (self ImGuiViewport) GetPlatformHandleRaw() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiViewport_GetPlatformHandleRaw(self.handle()))
+func (self ImGuiWindow) GetContentSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetContentSize(self.handle()))
 }
 
-func (self ImGuiViewport) SetPlatformRequestMove(v bool) {
-	C.ImGuiViewport_SetPlatformRequestMove(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetContentSizeIdeal(v ImVec2) {
+	C.ImGuiWindow_SetContentSizeIdeal(self.handle(), v.toC())
 }
 
-func (self ImGuiViewport) GetPlatformRequestMove() bool {
-	return C.ImGuiViewport_GetPlatformRequestMove(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetContentSizeIdeal() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetContentSizeIdeal(self.handle()))
 }
 
-func (self ImGuiViewport) SetPlatformRequestResize(v bool) {
-	C.ImGuiViewport_SetPlatformRequestResize(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetContentSizeExplicit(v ImVec2) {
+	C.ImGuiWindow_SetContentSizeExplicit(self.handle(), v.toC())
 }
 
-func (self ImGuiViewport) GetPlatformRequestResize() bool {
-	return C.ImGuiViewport_GetPlatformRequestResize(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetContentSizeExplicit() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetContentSizeExplicit(self.handle()))
 }
 
-func (self ImGuiViewport) SetPlatformRequestClose(v bool) {
-	C.ImGuiViewport_SetPlatformRequestClose(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetWindowPadding(v ImVec2) {
+	C.ImGuiWindow_SetWindowPadding(self.handle(), v.toC())
 }
 
-func (self ImGuiViewport) GetPlatformRequestClose() bool {
-	return C.ImGuiViewport_GetPlatformRequestClose(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetWindowPadding() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetWindowPadding(self.handle()))
 }
 
-func (self ImGuiTableSortSpecs) SetSpecs(v ImGuiTableColumnSortSpecs) {
-	C.ImGuiTableSortSpecs_SetSpecs(self.handle(), v.handle())
+func (self ImGuiWindow) SetWindowRounding(v float32) {
+	C.ImGuiWindow_SetWindowRounding(self.handle(), C.float(v))
 }
 
-func (self ImGuiTableSortSpecs) GetSpecs() ImGuiTableColumnSortSpecs {
-	return (ImGuiTableColumnSortSpecs)(unsafe.Pointer(C.ImGuiTableSortSpecs_GetSpecs(self.handle())))
+func (self ImGuiWindow) GetWindowRounding() float32 {
+	return float32(C.ImGuiWindow_GetWindowRounding(self.handle()))
 }
 
-func (self ImGuiTableSortSpecs) SetSpecsCount(v int32) {
-	C.ImGuiTableSortSpecs_SetSpecsCount(self.handle(), C.int(v))
+func (self ImGuiWindow) SetWindowBorderSize(v float32) {
+	C.ImGuiWindow_SetWindowBorderSize(self.handle(), C.float(v))
 }
 
-func (self ImGuiTableSortSpecs) GetSpecsCount() int {
-	return int(C.ImGuiTableSortSpecs_GetSpecsCount(self.handle()))
+func (self ImGuiWindow) GetWindowBorderSize() float32 {
+	return float32(C.ImGuiWindow_GetWindowBorderSize(self.handle()))
 }
 
-func (self ImGuiTableSortSpecs) SetSpecsDirty(v bool) {
-	C.ImGuiTableSortSpecs_SetSpecsDirty(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetNameBufLen(v int32) {
+	C.ImGuiWindow_SetNameBufLen(self.handle(), C.int(v))
 }
 
-func (self ImGuiTableSortSpecs) GetSpecsDirty() bool {
-	return C.ImGuiTableSortSpecs_GetSpecsDirty(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetNameBufLen() int {
+	return int(C.ImGuiWindow_GetNameBufLen(self.handle()))
 }
 
-func (self ImFontAtlas) SetFlags(v ImFontAtlasFlags) {
-	C.ImFontAtlas_SetFlags(self.handle(), C.ImFontAtlasFlags(v))
+func (self ImGuiWindow) SetMoveId(v ImGuiID) {
+	C.ImGuiWindow_SetMoveId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImFontAtlas) GetFlags() ImFontAtlasFlags {
-	return ImFontAtlasFlags(C.ImFontAtlas_GetFlags(self.handle()))
+func (self ImGuiWindow) GetMoveId() ImGuiID {
+	return ImGuiID(C.ImGuiWindow_GetMoveId(self.handle()))
 }
 
-func (self ImFontAtlas) SetTexDesiredWidth(v int32) {
-	C.ImFontAtlas_SetTexDesiredWidth(self.handle(), C.int(v))
+func (self ImGuiWindow) SetTabId(v ImGuiID) {
+	C.ImGuiWindow_SetTabId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImFontAtlas) GetTexDesiredWidth() int {
-	return int(C.ImFontAtlas_GetTexDesiredWidth(self.handle()))
+func (self ImGuiWindow) GetTabId() ImGuiID {
+	return ImGuiID(C.ImGuiWindow_GetTabId(self.handle()))
 }
 
-func (self ImFontAtlas) SetTexGlyphPadding(v int32) {
-	C.ImFontAtlas_SetTexGlyphPadding(self.handle(), C.int(v))
+func (self ImGuiWindow) SetChildId(v ImGuiID) {
+	C.ImGuiWindow_SetChildId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImFontAtlas) GetTexGlyphPadding() int {
-	return int(C.ImFontAtlas_GetTexGlyphPadding(self.handle()))
+func (self ImGuiWindow) GetChildId() ImGuiID {
+	return ImGuiID(C.ImGuiWindow_GetChildId(self.handle()))
 }
 
-func (self ImFontAtlas) SetLocked(v bool) {
-	C.ImFontAtlas_SetLocked(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetScroll(v ImVec2) {
+	C.ImGuiWindow_SetScroll(self.handle(), v.toC())
 }
 
-func (self ImFontAtlas) GetLocked() bool {
-	return C.ImFontAtlas_GetLocked(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetScroll() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetScroll(self.handle()))
 }
 
-func (self ImFontAtlas) SetTexReady(v bool) {
-	C.ImFontAtlas_SetTexReady(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetScrollMax(v ImVec2) {
+	C.ImGuiWindow_SetScrollMax(self.handle(), v.toC())
 }
 
-func (self ImFontAtlas) GetTexReady() bool {
-	return C.ImFontAtlas_GetTexReady(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetScrollMax() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetScrollMax(self.handle()))
 }
 
-func (self ImFontAtlas) SetTexPixelsUseColors(v bool) {
-	C.ImFontAtlas_SetTexPixelsUseColors(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetScrollTarget(v ImVec2) {
+	C.ImGuiWindow_SetScrollTarget(self.handle(), v.toC())
 }
 
-func (self ImFontAtlas) GetTexPixelsUseColors() bool {
-	return C.ImFontAtlas_GetTexPixelsUseColors(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetScrollTarget() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetScrollTarget(self.handle()))
 }
 
-func (self ImFontAtlas) SetTexWidth(v int32) {
-	C.ImFontAtlas_SetTexWidth(self.handle(), C.int(v))
+func (self ImGuiWindow) SetScrollTargetCenterRatio(v ImVec2) {
+	C.ImGuiWindow_SetScrollTargetCenterRatio(self.handle(), v.toC())
 }
 
-func (self ImFontAtlas) GetTexWidth() int {
-	return int(C.ImFontAtlas_GetTexWidth(self.handle()))
+func (self ImGuiWindow) GetScrollTargetCenterRatio() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetScrollTargetCenterRatio(self.handle()))
 }
 
-func (self ImFontAtlas) SetTexHeight(v int32) {
-	C.ImFontAtlas_SetTexHeight(self.handle(), C.int(v))
+func (self ImGuiWindow) SetScrollTargetEdgeSnapDist(v ImVec2) {
+	C.ImGuiWindow_SetScrollTargetEdgeSnapDist(self.handle(), v.toC())
 }
 
-func (self ImFontAtlas) GetTexHeight() int {
-	return int(C.ImFontAtlas_GetTexHeight(self.handle()))
+func (self ImGuiWindow) GetScrollTargetEdgeSnapDist() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetScrollTargetEdgeSnapDist(self.handle()))
 }
 
-func (self ImFontAtlas) SetTexUvScale(v ImVec2) {
-	C.ImFontAtlas_SetTexUvScale(self.handle(), v.toC())
+func (self ImGuiWindow) SetScrollbarSizes(v ImVec2) {
+	C.ImGuiWindow_SetScrollbarSizes(self.handle(), v.toC())
 }
 
-func (self ImFontAtlas) GetTexUvScale() ImVec2 {
-	return newImVec2FromC(C.ImFontAtlas_GetTexUvScale(self.handle()))
+func (self ImGuiWindow) GetScrollbarSizes() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetScrollbarSizes(self.handle()))
 }
 
-func (self ImFontAtlas) SetTexUvWhitePixel(v ImVec2) {
-	C.ImFontAtlas_SetTexUvWhitePixel(self.handle(), v.toC())
+func (self ImGuiWindow) SetScrollbarX(v bool) {
+	C.ImGuiWindow_SetScrollbarX(self.handle(), C.bool(v))
 }
 
-func (self ImFontAtlas) GetTexUvWhitePixel() ImVec2 {
-	return newImVec2FromC(C.ImFontAtlas_GetTexUvWhitePixel(self.handle()))
+func (self ImGuiWindow) GetScrollbarX() bool {
+	return C.ImGuiWindow_GetScrollbarX(self.handle()) == C.bool(true)
 }
 
-func (self ImFontAtlas) SetFontBuilderIO(v ImFontBuilderIO) {
-	C.ImFontAtlas_SetFontBuilderIO(self.handle(), v.handle())
+func (self ImGuiWindow) SetScrollbarY(v bool) {
+	C.ImGuiWindow_SetScrollbarY(self.handle(), C.bool(v))
 }
 
-func (self ImFontAtlas) GetFontBuilderIO() ImFontBuilderIO {
-	return (ImFontBuilderIO)(unsafe.Pointer(C.ImFontAtlas_GetFontBuilderIO(self.handle())))
+func (self ImGuiWindow) GetScrollbarY() bool {
+	return C.ImGuiWindow_GetScrollbarY(self.handle()) == C.bool(true)
 }
 
-func (self ImFontAtlas) SetFontBuilderFlags(v uint32) {
-	C.ImFontAtlas_SetFontBuilderFlags(self.handle(), C.uint(v))
+func (self ImGuiWindow) SetViewportOwned(v bool) {
+	C.ImGuiWindow_SetViewportOwned(self.handle(), C.bool(v))
 }
 
-func (self ImFontAtlas) GetFontBuilderFlags() uint32 {
-	return uint32(C.ImFontAtlas_GetFontBuilderFlags(self.handle()))
+func (self ImGuiWindow) GetViewportOwned() bool {
+	return C.ImGuiWindow_GetViewportOwned(self.handle()) == C.bool(true)
 }
 
-func (self ImFontAtlas) SetPackIdMouseCursors(v int32) {
-	C.ImFontAtlas_SetPackIdMouseCursors(self.handle(), C.int(v))
+func (self ImGuiWindow) SetActive(v bool) {
+	C.ImGuiWindow_SetActive(self.handle(), C.bool(v))
 }
 
-func (self ImFontAtlas) GetPackIdMouseCursors() int {
-	return int(C.ImFontAtlas_GetPackIdMouseCursors(self.handle()))
+func (self ImGuiWindow) GetActive() bool {
+	return C.ImGuiWindow_GetActive(self.handle()) == C.bool(true)
 }
 
-func (self ImFontAtlas) SetPackIdLines(v int32) {
-	C.ImFontAtlas_SetPackIdLines(self.handle(), C.int(v))
+func (self ImGuiWindow) SetWasActive(v bool) {
+	C.ImGuiWindow_SetWasActive(self.handle(), C.bool(v))
 }
 
-func (self ImFontAtlas) GetPackIdLines() int {
-	return int(C.ImFontAtlas_GetPackIdLines(self.handle()))
+func (self ImGuiWindow) GetWasActive() bool {
+	return C.ImGuiWindow_GetWasActive(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) SetConfigFlags(v ImGuiConfigFlags) {
-	C.ImGuiIO_SetConfigFlags(self.handle(), C.ImGuiConfigFlags(v))
+func (self ImGuiWindow) SetWriteAccessed(v bool) {
+	C.ImGuiWindow_SetWriteAccessed(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) GetConfigFlags() ImGuiConfigFlags {
-	return ImGuiConfigFlags(C.ImGuiIO_GetConfigFlags(self.handle()))
+func (self ImGuiWindow) GetWriteAccessed() bool {
+	return C.ImGuiWindow_GetWriteAccessed(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) SetBackendFlags(v ImGuiBackendFlags) {
-	C.ImGuiIO_SetBackendFlags(self.handle(), C.ImGuiBackendFlags(v))
+func (self ImGuiWindow) SetCollapsed(v bool) {
+	C.ImGuiWindow_SetCollapsed(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) GetBackendFlags() ImGuiBackendFlags {
-	return ImGuiBackendFlags(C.ImGuiIO_GetBackendFlags(self.handle()))
+func (self ImGuiWindow) GetCollapsed() bool {
+	return C.ImGuiWindow_GetCollapsed(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) SetDisplaySize(v ImVec2) {
-	C.ImGuiIO_SetDisplaySize(self.handle(), v.toC())
+func (self ImGuiWindow) SetWantCollapseToggle(v bool) {
+	C.ImGuiWindow_SetWantCollapseToggle(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) GetDisplaySize() ImVec2 {
-	return newImVec2FromC(C.ImGuiIO_GetDisplaySize(self.handle()))
+func (self ImGuiWindow) GetWantCollapseToggle() bool {
+	return C.ImGuiWindow_GetWantCollapseToggle(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) SetDeltaTime(v float32) {
-	C.ImGuiIO_SetDeltaTime(self.handle(), C.float(v))
+func (self ImGuiWindow) SetSkipItems(v bool) {
+	C.ImGuiWindow_SetSkipItems(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) GetDeltaTime() float32 {
-	return float32(C.ImGuiIO_GetDeltaTime(self.handle()))
+func (self ImGuiWindow) GetSkipItems() bool {
+	return C.ImGuiWindow_GetSkipItems(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) SetIniSavingRate(v float32) {
-	C.ImGuiIO_SetIniSavingRate(self.handle(), C.float(v))
+func (self ImGuiWindow) SetAppearing(v bool) {
+	C.ImGuiWindow_SetAppearing(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) GetIniSavingRate() float32 {
-	return float32(C.ImGuiIO_GetIniSavingRate(self.handle()))
+func (self ImGuiWindow) GetAppearing() bool {
+	return C.ImGuiWindow_GetAppearing(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) SetIniFilename(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
-
-	C.ImGuiIO_SetIniFilename(self.handle(), vArg)
+func (self ImGuiWindow) SetHidden(v bool) {
+	C.ImGuiWindow_SetHidden(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) GetIniFilename() string {
-	return C.GoString(C.ImGuiIO_GetIniFilename(self.handle()))
+func (self ImGuiWindow) GetHidden() bool {
+	return C.ImGuiWindow_GetHidden(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) SetLogFilename(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiWindow) SetIsFallbackWindow(v bool) {
+	C.ImGuiWindow_SetIsFallbackWindow(self.handle(), C.bool(v))
+}
 
-	C.ImGuiIO_SetLogFilename(self.handle(), vArg)
+func (self ImGuiWindow) GetIsFallbackWindow() bool {
+	return C.ImGuiWindow_GetIsFallbackWindow(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) GetLogFilename() string {
-	return C.GoString(C.ImGuiIO_GetLogFilename(self.handle()))
+func (self ImGuiWindow) SetIsExplicitChild(v bool) {
+	C.ImGuiWindow_SetIsExplicitChild(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) SetMouseDoubleClickTime(v float32) {
-	C.ImGuiIO_SetMouseDoubleClickTime(self.handle(), C.float(v))
+func (self ImGuiWindow) GetIsExplicitChild() bool {
+	return C.ImGuiWindow_GetIsExplicitChild(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) GetMouseDoubleClickTime() float32 {
-	return float32(C.ImGuiIO_GetMouseDoubleClickTime(self.handle()))
+func (self ImGuiWindow) SetHasCloseButton(v bool) {
+	C.ImGuiWindow_SetHasCloseButton(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) SetMouseDoubleClickMaxDist(v float32) {
-	C.ImGuiIO_SetMouseDoubleClickMaxDist(self.handle(), C.float(v))
+func (self ImGuiWindow) GetHasCloseButton() bool {
+	return C.ImGuiWindow_GetHasCloseButton(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) GetMouseDoubleClickMaxDist() float32 {
-	return float32(C.ImGuiIO_GetMouseDoubleClickMaxDist(self.handle()))
+func (self ImGuiWindow) SetBeginCount(v int) {
+	C.ImGuiWindow_SetBeginCount(self.handle(), C.short(v))
 }
 
-func (self ImGuiIO) SetMouseDragThreshold(v float32) {
-	C.ImGuiIO_SetMouseDragThreshold(self.handle(), C.float(v))
+func (self ImGuiWindow) GetBeginCount() int {
+	return int(C.ImGuiWindow_GetBeginCount(self.handle()))
 }
 
-func (self ImGuiIO) GetMouseDragThreshold() float32 {
-	return float32(C.ImGuiIO_GetMouseDragThreshold(self.handle()))
+func (self ImGuiWindow) SetBeginOrderWithinParent(v int) {
+	C.ImGuiWindow_SetBeginOrderWithinParent(self.handle(), C.short(v))
 }
 
-func (self ImGuiIO) SetKeyRepeatDelay(v float32) {
-	C.ImGuiIO_SetKeyRepeatDelay(self.handle(), C.float(v))
+func (self ImGuiWindow) GetBeginOrderWithinParent() int {
+	return int(C.ImGuiWindow_GetBeginOrderWithinParent(self.handle()))
 }
 
-func (self ImGuiIO) GetKeyRepeatDelay() float32 {
-	return float32(C.ImGuiIO_GetKeyRepeatDelay(self.handle()))
+func (self ImGuiWindow) SetBeginOrderWithinContext(v int) {
+	C.ImGuiWindow_SetBeginOrderWithinContext(self.handle(), C.short(v))
 }
 
-func (self ImGuiIO) SetKeyRepeatRate(v float32) {
-	C.ImGuiIO_SetKeyRepeatRate(self.handle(), C.float(v))
+func (self ImGuiWindow) GetBeginOrderWithinContext() int {
+	return int(C.ImGuiWindow_GetBeginOrderWithinContext(self.handle()))
 }
 
-func (self ImGuiIO) GetKeyRepeatRate() float32 {
-	return float32(C.ImGuiIO_GetKeyRepeatRate(self.handle()))
+func (self ImGuiWindow) SetFocusOrder(v int) {
+	C.ImGuiWindow_SetFocusOrder(self.handle(), C.short(v))
 }
 
-func (self ImGuiIO) SetUserData(v unsafe.Pointer) {
-	C.ImGuiIO_SetUserData(self.handle(), v)
+func (self ImGuiWindow) GetFocusOrder() int {
+	return int(C.ImGuiWindow_GetFocusOrder(self.handle()))
 }
 
-func (self ImGuiIO) GetUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiIO_GetUserData(self.handle()))
+func (self ImGuiWindow) SetPopupId(v ImGuiID) {
+	C.ImGuiWindow_SetPopupId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiIO) SetFonts(v ImFontAtlas) {
-	C.ImGuiIO_SetFonts(self.handle(), v.handle())
+func (self ImGuiWindow) GetPopupId() ImGuiID {
+	return ImGuiID(C.ImGuiWindow_GetPopupId(self.handle()))
 }
 
-func (self ImGuiIO) GetFonts() ImFontAtlas {
-	return (ImFontAtlas)(unsafe.Pointer(C.ImGuiIO_GetFonts(self.handle())))
+func (self ImGuiWindow) SetAutoFitFramesX(v int) {
+	C.ImGuiWindow_SetAutoFitFramesX(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiIO) SetFontGlobalScale(v float32) {
-	C.ImGuiIO_SetFontGlobalScale(self.handle(), C.float(v))
+func (self ImGuiWindow) GetAutoFitFramesX() int {
+	return int(C.ImGuiWindow_GetAutoFitFramesX(self.handle()))
 }
 
-func (self ImGuiIO) GetFontGlobalScale() float32 {
-	return float32(C.ImGuiIO_GetFontGlobalScale(self.handle()))
+func (self ImGuiWindow) SetAutoFitFramesY(v int) {
+	C.ImGuiWindow_SetAutoFitFramesY(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiIO) SetFontAllowUserScaling(v bool) {
-	C.ImGuiIO_SetFontAllowUserScaling(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetAutoFitFramesY() int {
+	return int(C.ImGuiWindow_GetAutoFitFramesY(self.handle()))
 }
 
-func (self ImGuiIO) GetFontAllowUserScaling() bool {
-	return C.ImGuiIO_GetFontAllowUserScaling(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetAutoFitChildAxises(v int) {
+	C.ImGuiWindow_SetAutoFitChildAxises(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiIO) SetFontDefault(v ImFont) {
-	C.ImGuiIO_SetFontDefault(self.handle(), v.handle())
+func (self ImGuiWindow) GetAutoFitChildAxises() int {
+	return int(C.ImGuiWindow_GetAutoFitChildAxises(self.handle()))
 }
 
-func (self ImGuiIO) GetFontDefault() ImFont {
-	return (ImFont)(unsafe.Pointer(C.ImGuiIO_GetFontDefault(self.handle())))
+func (self ImGuiWindow) SetAutoFitOnlyGrows(v bool) {
+	C.ImGuiWindow_SetAutoFitOnlyGrows(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) SetDisplayFramebufferScale(v ImVec2) {
-	C.ImGuiIO_SetDisplayFramebufferScale(self.handle(), v.toC())
+func (self ImGuiWindow) GetAutoFitOnlyGrows() bool {
+	return C.ImGuiWindow_GetAutoFitOnlyGrows(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) GetDisplayFramebufferScale() ImVec2 {
-	return newImVec2FromC(C.ImGuiIO_GetDisplayFramebufferScale(self.handle()))
+func (self ImGuiWindow) SetAutoPosLastDirection(v ImGuiDir) {
+	C.ImGuiWindow_SetAutoPosLastDirection(self.handle(), C.ImGuiDir(v))
 }
 
-func (self ImGuiIO) SetConfigDockingNoSplit(v bool) {
-	C.ImGuiIO_SetConfigDockingNoSplit(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetAutoPosLastDirection() ImGuiDir {
+	return ImGuiDir(C.ImGuiWindow_GetAutoPosLastDirection(self.handle()))
 }
 
-func (self ImGuiIO) GetConfigDockingNoSplit() bool {
-	return C.ImGuiIO_GetConfigDockingNoSplit(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetHiddenFramesCanSkipItems(v int) {
+	C.ImGuiWindow_SetHiddenFramesCanSkipItems(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiIO) SetConfigDockingWithShift(v bool) {
-	C.ImGuiIO_SetConfigDockingWithShift(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetHiddenFramesCanSkipItems() int {
+	return int(C.ImGuiWindow_GetHiddenFramesCanSkipItems(self.handle()))
 }
 
-func (self ImGuiIO) GetConfigDockingWithShift() bool {
-	return C.ImGuiIO_GetConfigDockingWithShift(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetHiddenFramesCannotSkipItems(v int) {
+	C.ImGuiWindow_SetHiddenFramesCannotSkipItems(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiIO) SetConfigDockingAlwaysTabBar(v bool) {
-	C.ImGuiIO_SetConfigDockingAlwaysTabBar(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetHiddenFramesCannotSkipItems() int {
+	return int(C.ImGuiWindow_GetHiddenFramesCannotSkipItems(self.handle()))
 }
 
-func (self ImGuiIO) GetConfigDockingAlwaysTabBar() bool {
-	return C.ImGuiIO_GetConfigDockingAlwaysTabBar(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetHiddenFramesForRenderOnly(v int) {
+	C.ImGuiWindow_SetHiddenFramesForRenderOnly(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiIO) SetConfigDockingTransparentPayload(v bool) {
-	C.ImGuiIO_SetConfigDockingTransparentPayload(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetHiddenFramesForRenderOnly() int {
+	return int(C.ImGuiWindow_GetHiddenFramesForRenderOnly(self.handle()))
 }
 
-func (self ImGuiIO) GetConfigDockingTransparentPayload() bool {
-	return C.ImGuiIO_GetConfigDockingTransparentPayload(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetDisableInputsFrames(v int) {
+	C.ImGuiWindow_SetDisableInputsFrames(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiIO) SetConfigViewportsNoAutoMerge(v bool) {
-	C.ImGuiIO_SetConfigViewportsNoAutoMerge(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetDisableInputsFrames() int {
+	return int(C.ImGuiWindow_GetDisableInputsFrames(self.handle()))
 }
 
-func (self ImGuiIO) GetConfigViewportsNoAutoMerge() bool {
-	return C.ImGuiIO_GetConfigViewportsNoAutoMerge(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetSetWindowPosAllowFlags(v ImGuiCond) {
+	C.ImGuiWindow_SetSetWindowPosAllowFlags(self.handle(), C.ImGuiCond(v))
 }
 
-func (self ImGuiIO) SetConfigViewportsNoTaskBarIcon(v bool) {
-	C.ImGuiIO_SetConfigViewportsNoTaskBarIcon(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetSetWindowPosAllowFlags() ImGuiCond {
+	return ImGuiCond(C.ImGuiWindow_GetSetWindowPosAllowFlags(self.handle()))
 }
 
-func (self ImGuiIO) GetConfigViewportsNoTaskBarIcon() bool {
-	return C.ImGuiIO_GetConfigViewportsNoTaskBarIcon(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetSetWindowSizeAllowFlags(v ImGuiCond) {
+	C.ImGuiWindow_SetSetWindowSizeAllowFlags(self.handle(), C.ImGuiCond(v))
 }
 
-func (self ImGuiIO) SetConfigViewportsNoDecoration(v bool) {
-	C.ImGuiIO_SetConfigViewportsNoDecoration(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetSetWindowSizeAllowFlags() ImGuiCond {
+	return ImGuiCond(C.ImGuiWindow_GetSetWindowSizeAllowFlags(self.handle()))
 }
 
-func (self ImGuiIO) GetConfigViewportsNoDecoration() bool {
-	return C.ImGuiIO_GetConfigViewportsNoDecoration(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetSetWindowCollapsedAllowFlags(v ImGuiCond) {
+	C.ImGuiWindow_SetSetWindowCollapsedAllowFlags(self.handle(), C.ImGuiCond(v))
 }
 
-func (self ImGuiIO) SetConfigViewportsNoDefaultParent(v bool) {
-	C.ImGuiIO_SetConfigViewportsNoDefaultParent(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetSetWindowCollapsedAllowFlags() ImGuiCond {
+	return ImGuiCond(C.ImGuiWindow_GetSetWindowCollapsedAllowFlags(self.handle()))
 }
 
-func (self ImGuiIO) GetConfigViewportsNoDefaultParent() bool {
-	return C.ImGuiIO_GetConfigViewportsNoDefaultParent(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetSetWindowDockAllowFlags(v ImGuiCond) {
+	C.ImGuiWindow_SetSetWindowDockAllowFlags(self.handle(), C.ImGuiCond(v))
 }
 
-func (self ImGuiIO) SetMouseDrawCursor(v bool) {
-	C.ImGuiIO_SetMouseDrawCursor(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetSetWindowDockAllowFlags() ImGuiCond {
+	return ImGuiCond(C.ImGuiWindow_GetSetWindowDockAllowFlags(self.handle()))
 }
 
-func (self ImGuiIO) GetMouseDrawCursor() bool {
-	return C.ImGuiIO_GetMouseDrawCursor(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetSetWindowPosVal(v ImVec2) {
+	C.ImGuiWindow_SetSetWindowPosVal(self.handle(), v.toC())
 }
 
-func (self ImGuiIO) SetConfigMacOSXBehaviors(v bool) {
-	C.ImGuiIO_SetConfigMacOSXBehaviors(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetSetWindowPosVal() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetSetWindowPosVal(self.handle()))
 }
 
-func (self ImGuiIO) GetConfigMacOSXBehaviors() bool {
-	return C.ImGuiIO_GetConfigMacOSXBehaviors(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetSetWindowPosPivot(v ImVec2) {
+	C.ImGuiWindow_SetSetWindowPosPivot(self.handle(), v.toC())
 }
 
-func (self ImGuiIO) SetConfigInputTrickleEventQueue(v bool) {
-	C.ImGuiIO_SetConfigInputTrickleEventQueue(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetSetWindowPosPivot() ImVec2 {
+	return newImVec2FromC(C.ImGuiWindow_GetSetWindowPosPivot(self.handle()))
 }
 
-func (self ImGuiIO) GetConfigInputTrickleEventQueue() bool {
-	return C.ImGuiIO_GetConfigInputTrickleEventQueue(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetDC() ImGuiWindowTempData {
+	return newImGuiWindowTempDataFromC(C.ImGuiWindow_GetDC(self.handle()))
 }
 
-func (self ImGuiIO) SetConfigInputTextCursorBlink(v bool) {
-	C.ImGuiIO_SetConfigInputTextCursorBlink(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetOuterRectClipped(v ImRect) {
+	C.ImGuiWindow_SetOuterRectClipped(self.handle(), v.toC())
 }
 
-func (self ImGuiIO) GetConfigInputTextCursorBlink() bool {
-	return C.ImGuiIO_GetConfigInputTextCursorBlink(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetOuterRectClipped() ImRect {
+	return newImRectFromC(C.ImGuiWindow_GetOuterRectClipped(self.handle()))
 }
 
-func (self ImGuiIO) SetConfigInputTextEnterKeepActive(v bool) {
-	C.ImGuiIO_SetConfigInputTextEnterKeepActive(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetInnerRect(v ImRect) {
+	C.ImGuiWindow_SetInnerRect(self.handle(), v.toC())
 }
 
-func (self ImGuiIO) GetConfigInputTextEnterKeepActive() bool {
-	return C.ImGuiIO_GetConfigInputTextEnterKeepActive(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetInnerRect() ImRect {
+	return newImRectFromC(C.ImGuiWindow_GetInnerRect(self.handle()))
 }
 
-func (self ImGuiIO) SetConfigDragClickToInputText(v bool) {
-	C.ImGuiIO_SetConfigDragClickToInputText(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetInnerClipRect(v ImRect) {
+	C.ImGuiWindow_SetInnerClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiIO) GetConfigDragClickToInputText() bool {
-	return C.ImGuiIO_GetConfigDragClickToInputText(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetInnerClipRect() ImRect {
+	return newImRectFromC(C.ImGuiWindow_GetInnerClipRect(self.handle()))
 }
 
-func (self ImGuiIO) SetConfigWindowsResizeFromEdges(v bool) {
-	C.ImGuiIO_SetConfigWindowsResizeFromEdges(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetWorkRect(v ImRect) {
+	C.ImGuiWindow_SetWorkRect(self.handle(), v.toC())
 }
 
-func (self ImGuiIO) GetConfigWindowsResizeFromEdges() bool {
-	return C.ImGuiIO_GetConfigWindowsResizeFromEdges(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetWorkRect() ImRect {
+	return newImRectFromC(C.ImGuiWindow_GetWorkRect(self.handle()))
 }
 
-func (self ImGuiIO) SetConfigWindowsMoveFromTitleBarOnly(v bool) {
-	C.ImGuiIO_SetConfigWindowsMoveFromTitleBarOnly(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetParentWorkRect(v ImRect) {
+	C.ImGuiWindow_SetParentWorkRect(self.handle(), v.toC())
 }
 
-func (self ImGuiIO) GetConfigWindowsMoveFromTitleBarOnly() bool {
-	return C.ImGuiIO_GetConfigWindowsMoveFromTitleBarOnly(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetParentWorkRect() ImRect {
+	return newImRectFromC(C.ImGuiWindow_GetParentWorkRect(self.handle()))
 }
 
-func (self ImGuiIO) SetConfigMemoryCompactTimer(v float32) {
-	C.ImGuiIO_SetConfigMemoryCompactTimer(self.handle(), C.float(v))
+func (self ImGuiWindow) SetClipRect(v ImRect) {
+	C.ImGuiWindow_SetClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiIO) GetConfigMemoryCompactTimer() float32 {
-	return float32(C.ImGuiIO_GetConfigMemoryCompactTimer(self.handle()))
+func (self ImGuiWindow) GetClipRect() ImRect {
+	return newImRectFromC(C.ImGuiWindow_GetClipRect(self.handle()))
 }
 
-func (self ImGuiIO) SetBackendPlatformName(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
-
-	C.ImGuiIO_SetBackendPlatformName(self.handle(), vArg)
+func (self ImGuiWindow) SetContentRegionRect(v ImRect) {
+	C.ImGuiWindow_SetContentRegionRect(self.handle(), v.toC())
 }
 
-func (self ImGuiIO) GetBackendPlatformName() string {
-	return C.GoString(C.ImGuiIO_GetBackendPlatformName(self.handle()))
+func (self ImGuiWindow) GetContentRegionRect() ImRect {
+	return newImRectFromC(C.ImGuiWindow_GetContentRegionRect(self.handle()))
 }
 
-func (self ImGuiIO) SetBackendRendererName(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiWindow) SetLastFrameActive(v int32) {
+	C.ImGuiWindow_SetLastFrameActive(self.handle(), C.int(v))
+}
 
-	C.ImGuiIO_SetBackendRendererName(self.handle(), vArg)
+func (self ImGuiWindow) GetLastFrameActive() int {
+	return int(C.ImGuiWindow_GetLastFrameActive(self.handle()))
 }
 
-func (self ImGuiIO) GetBackendRendererName() string {
-	return C.GoString(C.ImGuiIO_GetBackendRendererName(self.handle()))
+func (self ImGuiWindow) SetLastFrameJustFocused(v int32) {
+	C.ImGuiWindow_SetLastFrameJustFocused(self.handle(), C.int(v))
 }
 
-func (self ImGuiIO) SetBackendPlatformUserData(v unsafe.Pointer) {
-	C.ImGuiIO_SetBackendPlatformUserData(self.handle(), v)
+func (self ImGuiWindow) GetLastFrameJustFocused() int {
+	return int(C.ImGuiWindow_GetLastFrameJustFocused(self.handle()))
 }
 
-func (self ImGuiIO) GetBackendPlatformUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiIO_GetBackendPlatformUserData(self.handle()))
+func (self ImGuiWindow) SetLastTimeActive(v float32) {
+	C.ImGuiWindow_SetLastTimeActive(self.handle(), C.float(v))
 }
 
-func (self ImGuiIO) SetBackendRendererUserData(v unsafe.Pointer) {
-	C.ImGuiIO_SetBackendRendererUserData(self.handle(), v)
+func (self ImGuiWindow) GetLastTimeActive() float32 {
+	return float32(C.ImGuiWindow_GetLastTimeActive(self.handle()))
 }
 
-func (self ImGuiIO) GetBackendRendererUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiIO_GetBackendRendererUserData(self.handle()))
+func (self ImGuiWindow) SetItemWidthDefault(v float32) {
+	C.ImGuiWindow_SetItemWidthDefault(self.handle(), C.float(v))
 }
 
-func (self ImGuiIO) SetBackendLanguageUserData(v unsafe.Pointer) {
-	C.ImGuiIO_SetBackendLanguageUserData(self.handle(), v)
+func (self ImGuiWindow) GetItemWidthDefault() float32 {
+	return float32(C.ImGuiWindow_GetItemWidthDefault(self.handle()))
 }
 
-func (self ImGuiIO) GetBackendLanguageUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiIO_GetBackendLanguageUserData(self.handle()))
+func (self ImGuiWindow) GetStateStorage() ImGuiStorage {
+	return newImGuiStorageFromC(C.ImGuiWindow_GetStateStorage(self.handle()))
 }
 
-func (self ImGuiIO) SetClipboardUserData(v unsafe.Pointer) {
-	C.ImGuiIO_SetClipboardUserData(self.handle(), v)
+func (self ImGuiWindow) SetFontWindowScale(v float32) {
+	C.ImGuiWindow_SetFontWindowScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiIO) GetClipboardUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiIO_GetClipboardUserData(self.handle()))
+func (self ImGuiWindow) GetFontWindowScale() float32 {
+	return float32(C.ImGuiWindow_GetFontWindowScale(self.handle()))
 }
 
-func (self ImGuiIO) Set_UnusedPadding(v unsafe.Pointer) {
-	C.ImGuiIO_Set_UnusedPadding(self.handle(), v)
+func (self ImGuiWindow) SetFontDpiScale(v float32) {
+	C.ImGuiWindow_SetFontDpiScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiIO) Get_UnusedPadding() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiIO_Get_UnusedPadding(self.handle()))
+func (self ImGuiWindow) GetFontDpiScale() float32 {
+	return float32(C.ImGuiWindow_GetFontDpiScale(self.handle()))
 }
 
-func (self ImGuiIO) SetWantCaptureMouse(v bool) {
-	C.ImGuiIO_SetWantCaptureMouse(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetSettingsOffset(v int32) {
+	C.ImGuiWindow_SetSettingsOffset(self.handle(), C.int(v))
 }
 
-func (self ImGuiIO) GetWantCaptureMouse() bool {
-	return C.ImGuiIO_GetWantCaptureMouse(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetSettingsOffset() int {
+	return int(C.ImGuiWindow_GetSettingsOffset(self.handle()))
 }
 
-func (self ImGuiIO) SetWantCaptureKeyboard(v bool) {
-	C.ImGuiIO_SetWantCaptureKeyboard(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetDrawList(v ImDrawList) {
+	C.ImGuiWindow_SetDrawList(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) GetWantCaptureKeyboard() bool {
-	return C.ImGuiIO_GetWantCaptureKeyboard(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetDrawList() ImDrawList {
+	return (ImDrawList)(unsafe.Pointer(C.ImGuiWindow_GetDrawList(self.handle())))
 }
 
-func (self ImGuiIO) SetWantTextInput(v bool) {
-	C.ImGuiIO_SetWantTextInput(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetDrawListInst() ImDrawList {
+	return newImDrawListFromC(C.ImGuiWindow_GetDrawListInst(self.handle()))
 }
 
-func (self ImGuiIO) GetWantTextInput() bool {
-	return C.ImGuiIO_GetWantTextInput(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetParentWindow(v ImGuiWindow) {
+	C.ImGuiWindow_SetParentWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) SetWantSetMousePos(v bool) {
-	C.ImGuiIO_SetWantSetMousePos(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetParentWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetParentWindow(self.handle())))
 }
 
-func (self ImGuiIO) GetWantSetMousePos() bool {
-	return C.ImGuiIO_GetWantSetMousePos(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetParentWindowInBeginStack(v ImGuiWindow) {
+	C.ImGuiWindow_SetParentWindowInBeginStack(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) SetWantSaveIniSettings(v bool) {
-	C.ImGuiIO_SetWantSaveIniSettings(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetParentWindowInBeginStack() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetParentWindowInBeginStack(self.handle())))
 }
 
-func (self ImGuiIO) GetWantSaveIniSettings() bool {
-	return C.ImGuiIO_GetWantSaveIniSettings(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetRootWindow(v ImGuiWindow) {
+	C.ImGuiWindow_SetRootWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) SetNavActive(v bool) {
-	C.ImGuiIO_SetNavActive(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetRootWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetRootWindow(self.handle())))
 }
 
-func (self ImGuiIO) GetNavActive() bool {
-	return C.ImGuiIO_GetNavActive(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetRootWindowPopupTree(v ImGuiWindow) {
+	C.ImGuiWindow_SetRootWindowPopupTree(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) SetNavVisible(v bool) {
-	C.ImGuiIO_SetNavVisible(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetRootWindowPopupTree() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetRootWindowPopupTree(self.handle())))
 }
 
-func (self ImGuiIO) GetNavVisible() bool {
-	return C.ImGuiIO_GetNavVisible(self.handle()) == C.bool(true)
+func (self ImGuiWindow) SetRootWindowDockTree(v ImGuiWindow) {
+	C.ImGuiWindow_SetRootWindowDockTree(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) SetFramerate(v float32) {
-	C.ImGuiIO_SetFramerate(self.handle(), C.float(v))
+func (self ImGuiWindow) GetRootWindowDockTree() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetRootWindowDockTree(self.handle())))
 }
 
-func (self ImGuiIO) GetFramerate() float32 {
-	return float32(C.ImGuiIO_GetFramerate(self.handle()))
+func (self ImGuiWindow) SetRootWindowForTitleBarHighlight(v ImGuiWindow) {
+	C.ImGuiWindow_SetRootWindowForTitleBarHighlight(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) SetMetricsRenderVertices(v int32) {
-	C.ImGuiIO_SetMetricsRenderVertices(self.handle(), C.int(v))
+func (self ImGuiWindow) GetRootWindowForTitleBarHighlight() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetRootWindowForTitleBarHighlight(self.handle())))
 }
 
-func (self ImGuiIO) GetMetricsRenderVertices() int {
-	return int(C.ImGuiIO_GetMetricsRenderVertices(self.handle()))
+func (self ImGuiWindow) SetRootWindowForNav(v ImGuiWindow) {
+	C.ImGuiWindow_SetRootWindowForNav(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) SetMetricsRenderIndices(v int32) {
-	C.ImGuiIO_SetMetricsRenderIndices(self.handle(), C.int(v))
+func (self ImGuiWindow) GetRootWindowForNav() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetRootWindowForNav(self.handle())))
 }
 
-func (self ImGuiIO) GetMetricsRenderIndices() int {
-	return int(C.ImGuiIO_GetMetricsRenderIndices(self.handle()))
+func (self ImGuiWindow) SetNavLastChildNavWindow(v ImGuiWindow) {
+	C.ImGuiWindow_SetNavLastChildNavWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) SetMetricsRenderWindows(v int32) {
-	C.ImGuiIO_SetMetricsRenderWindows(self.handle(), C.int(v))
+func (self ImGuiWindow) GetNavLastChildNavWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindow_GetNavLastChildNavWindow(self.handle())))
 }
 
-func (self ImGuiIO) GetMetricsRenderWindows() int {
-	return int(C.ImGuiIO_GetMetricsRenderWindows(self.handle()))
+func (self ImGuiWindow) SetMemoryDrawListIdxCapacity(v int32) {
+	C.ImGuiWindow_SetMemoryDrawListIdxCapacity(self.handle(), C.int(v))
 }
 
-func (self ImGuiIO) SetMetricsActiveWindows(v int32) {
-	C.ImGuiIO_SetMetricsActiveWindows(self.handle(), C.int(v))
+func (self ImGuiWindow) GetMemoryDrawListIdxCapacity() int {
+	return int(C.ImGuiWindow_GetMemoryDrawListIdxCapacity(self.handle()))
 }
 
-func (self ImGuiIO) GetMetricsActiveWindows() int {
-	return int(C.ImGuiIO_GetMetricsActiveWindows(self.handle()))
+func (self ImGuiWindow) SetMemoryDrawListVtxCapacity(v int32) {
+	C.ImGuiWindow_SetMemoryDrawListVtxCapacity(self.handle(), C.int(v))
 }
 
-func (self ImGuiIO) SetMetricsActiveAllocations(v int32) {
-	C.ImGuiIO_SetMetricsActiveAllocations(self.handle(), C.int(v))
+func (self ImGuiWindow) GetMemoryDrawListVtxCapacity() int {
+	return int(C.ImGuiWindow_GetMemoryDrawListVtxCapacity(self.handle()))
 }
 
-func (self ImGuiIO) GetMetricsActiveAllocations() int {
-	return int(C.ImGuiIO_GetMetricsActiveAllocations(self.handle()))
+func (self ImGuiWindow) SetMemoryCompacted(v bool) {
+	C.ImGuiWindow_SetMemoryCompacted(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) SetMouseDelta(v ImVec2) {
-	C.ImGuiIO_SetMouseDelta(self.handle(), v.toC())
+func (self ImGuiWindow) GetMemoryCompacted() bool {
+	return C.ImGuiWindow_GetMemoryCompacted(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) GetMouseDelta() ImVec2 {
-	return newImVec2FromC(C.ImGuiIO_GetMouseDelta(self.handle()))
+func (self ImGuiWindow) SetDockIsActive(v bool) {
+	C.ImGuiWindow_SetDockIsActive(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) SetMousePos(v ImVec2) {
-	C.ImGuiIO_SetMousePos(self.handle(), v.toC())
+func (self ImGuiWindow) GetDockIsActive() bool {
+	return C.ImGuiWindow_GetDockIsActive(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) GetMousePos() ImVec2 {
-	return newImVec2FromC(C.ImGuiIO_GetMousePos(self.handle()))
+func (self ImGuiWindow) SetDockNodeIsVisible(v bool) {
+	C.ImGuiWindow_SetDockNodeIsVisible(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) SetMouseWheel(v float32) {
-	C.ImGuiIO_SetMouseWheel(self.handle(), C.float(v))
+func (self ImGuiWindow) GetDockNodeIsVisible() bool {
+	return C.ImGuiWindow_GetDockNodeIsVisible(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) GetMouseWheel() float32 {
-	return float32(C.ImGuiIO_GetMouseWheel(self.handle()))
+func (self ImGuiWindow) SetDockTabIsVisible(v bool) {
+	C.ImGuiWindow_SetDockTabIsVisible(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) SetMouseWheelH(v float32) {
-	C.ImGuiIO_SetMouseWheelH(self.handle(), C.float(v))
+func (self ImGuiWindow) GetDockTabIsVisible() bool {
+	return C.ImGuiWindow_GetDockTabIsVisible(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) GetMouseWheelH() float32 {
-	return float32(C.ImGuiIO_GetMouseWheelH(self.handle()))
+func (self ImGuiWindow) SetDockTabWantClose(v bool) {
+	C.ImGuiWindow_SetDockTabWantClose(self.handle(), C.bool(v))
 }
 
-func (self ImGuiIO) SetMouseHoveredViewport(v ImGuiID) {
-	C.ImGuiIO_SetMouseHoveredViewport(self.handle(), C.ImGuiID(v))
+func (self ImGuiWindow) GetDockTabWantClose() bool {
+	return C.ImGuiWindow_GetDockTabWantClose(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiIO) GetMouseHoveredViewport() ImGuiID {
-	return ImGuiID(C.ImGuiIO_GetMouseHoveredViewport(self.handle()))
+func (self ImGuiWindow) SetDockOrder(v int) {
+	C.ImGuiWindow_SetDockOrder(self.handle(), C.short(v))
 }
 
-func (self ImGuiIO) SetKeyCtrl(v bool) {
-	C.ImGuiIO_SetKeyCtrl(self.handle(), C.bool(v))
+func (self ImGuiWindow) GetDockOrder() int {
+	return int(C.ImGuiWindow_GetDockOrder(self.handle()))
 }
 
-func (self ImGuiIO) GetKeyCtrl() bool {
-	return C.ImGuiIO_GetKeyCtrl(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetDockStyle() ImGuiWindowDockStyle {
+	return newImGuiWindowDockStyleFromC(C.ImGuiWindow_GetDockStyle(self.handle()))
 }
 
-func (self ImGuiIO) SetKeyShift(v bool) {
-	C.ImGuiIO_SetKeyShift(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetDockNode(v ImGuiDockNode) {
+	C.ImGuiWindow_SetDockNode(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) GetKeyShift() bool {
-	return C.ImGuiIO_GetKeyShift(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetDockNode() ImGuiDockNode {
+	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiWindow_GetDockNode(self.handle())))
 }
 
-func (self ImGuiIO) SetKeyAlt(v bool) {
-	C.ImGuiIO_SetKeyAlt(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetDockNodeAsHost(v ImGuiDockNode) {
+	C.ImGuiWindow_SetDockNodeAsHost(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) GetKeyAlt() bool {
-	return C.ImGuiIO_GetKeyAlt(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetDockNodeAsHost() ImGuiDockNode {
+	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiWindow_GetDockNodeAsHost(self.handle())))
 }
 
-func (self ImGuiIO) SetKeySuper(v bool) {
-	C.ImGuiIO_SetKeySuper(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetDockId(v ImGuiID) {
+	C.ImGuiWindow_SetDockId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiIO) GetKeySuper() bool {
-	return C.ImGuiIO_GetKeySuper(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetDockId() ImGuiID {
+	return ImGuiID(C.ImGuiWindow_GetDockId(self.handle()))
 }
 
-func (self ImGuiIO) SetKeyMods(v ImGuiModFlags) {
-	C.ImGuiIO_SetKeyMods(self.handle(), C.ImGuiModFlags(v))
+func (self ImGuiWindow) SetDockTabItemStatusFlags(v ImGuiItemStatusFlags) {
+	C.ImGuiWindow_SetDockTabItemStatusFlags(self.handle(), C.ImGuiItemStatusFlags(v))
 }
 
-func (self ImGuiIO) GetKeyMods() ImGuiModFlags {
-	return ImGuiModFlags(C.ImGuiIO_GetKeyMods(self.handle()))
+func (self ImGuiWindow) GetDockTabItemStatusFlags() ImGuiItemStatusFlags {
+	return ImGuiItemStatusFlags(C.ImGuiWindow_GetDockTabItemStatusFlags(self.handle()))
 }
 
-func (self ImGuiIO) SetWantCaptureMouseUnlessPopupClose(v bool) {
-	C.ImGuiIO_SetWantCaptureMouseUnlessPopupClose(self.handle(), C.bool(v))
+func (self ImGuiWindow) SetDockTabItemRect(v ImRect) {
+	C.ImGuiWindow_SetDockTabItemRect(self.handle(), v.toC())
 }
 
-func (self ImGuiIO) GetWantCaptureMouseUnlessPopupClose() bool {
-	return C.ImGuiIO_GetWantCaptureMouseUnlessPopupClose(self.handle()) == C.bool(true)
+func (self ImGuiWindow) GetDockTabItemRect() ImRect {
+	return newImRectFromC(C.ImGuiWindow_GetDockTabItemRect(self.handle()))
 }
 
-func (self ImGuiIO) SetMousePosPrev(v ImVec2) {
-	C.ImGuiIO_SetMousePosPrev(self.handle(), v.toC())
+func (self ImGuiTabItem) SetID(v ImGuiID) {
+	C.ImGuiTabItem_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiIO) GetMousePosPrev() ImVec2 {
-	return newImVec2FromC(C.ImGuiIO_GetMousePosPrev(self.handle()))
+func (self ImGuiTabItem) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiTabItem_GetID(self.handle()))
 }
 
-func (self ImGuiIO) SetPenPressure(v float32) {
-	C.ImGuiIO_SetPenPressure(self.handle(), C.float(v))
+func (self ImGuiTabItem) SetFlags(v ImGuiTabItemFlags) {
+	C.ImGuiTabItem_SetFlags(self.handle(), C.ImGuiTabItemFlags(v))
 }
 
-func (self ImGuiIO) GetPenPressure() float32 {
-	return float32(C.ImGuiIO_GetPenPressure(self.handle()))
+func (self ImGuiTabItem) GetFlags() ImGuiTabItemFlags {
+	return ImGuiTabItemFlags(C.ImGuiTabItem_GetFlags(self.handle()))
 }
 
-func (self ImGuiIO) SetAppFocusLost(v bool) {
-	C.ImGuiIO_SetAppFocusLost(self.handle(), C.bool(v))
+func (self ImGuiTabItem) SetWindow(v ImGuiWindow) {
+	C.ImGuiTabItem_SetWindow(self.handle(), v.handle())
 }
 
-func (self ImGuiIO) GetAppFocusLost() bool {
-	return C.ImGuiIO_GetAppFocusLost(self.handle()) == C.bool(true)
+func (self ImGuiTabItem) GetWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiTabItem_GetWindow(self.handle())))
 }
 
-func (self ImGuiIO) SetBackendUsingLegacyKeyArrays(v int) {
-	C.ImGuiIO_SetBackendUsingLegacyKeyArrays(self.handle(), C.ImS8(v))
+func (self ImGuiTabItem) SetLastFrameVisible(v int32) {
+	C.ImGuiTabItem_SetLastFrameVisible(self.handle(), C.int(v))
 }
 
-func (self ImGuiIO) GetBackendUsingLegacyKeyArrays() int {
-	return int(C.ImGuiIO_GetBackendUsingLegacyKeyArrays(self.handle()))
+func (self ImGuiTabItem) GetLastFrameVisible() int {
+	return int(C.ImGuiTabItem_GetLastFrameVisible(self.handle()))
 }
 
-func (self ImGuiIO) SetBackendUsingLegacyNavInputArray(v bool) {
-	C.ImGuiIO_SetBackendUsingLegacyNavInputArray(self.handle(), C.bool(v))
+func (self ImGuiTabItem) SetLastFrameSelected(v int32) {
+	C.ImGuiTabItem_SetLastFrameSelected(self.handle(), C.int(v))
 }
 
-func (self ImGuiIO) GetBackendUsingLegacyNavInputArray() bool {
-	return C.ImGuiIO_GetBackendUsingLegacyNavInputArray(self.handle()) == C.bool(true)
+func (self ImGuiTabItem) GetLastFrameSelected() int {
+	return int(C.ImGuiTabItem_GetLastFrameSelected(self.handle()))
 }
 
-func (self ImGuiInputEventKey) SetKey(v ImGuiKey) {
-	C.ImGuiInputEventKey_SetKey(self.handle(), C.ImGuiKey(v))
+func (self ImGuiTabItem) SetOffset(v float32) {
+	C.ImGuiTabItem_SetOffset(self.handle(), C.float(v))
 }
 
-func (self ImGuiInputEventKey) GetKey() ImGuiKey {
-	return ImGuiKey(C.ImGuiInputEventKey_GetKey(self.handle()))
+func (self ImGuiTabItem) GetOffset() float32 {
+	return float32(C.ImGuiTabItem_GetOffset(self.handle()))
 }
 
-func (self ImGuiInputEventKey) SetDown(v bool) {
-	C.ImGuiInputEventKey_SetDown(self.handle(), C.bool(v))
+func (self ImGuiTabItem) SetWidth(v float32) {
+	C.ImGuiTabItem_SetWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiInputEventKey) GetDown() bool {
-	return C.ImGuiInputEventKey_GetDown(self.handle()) == C.bool(true)
+func (self ImGuiTabItem) GetWidth() float32 {
+	return float32(C.ImGuiTabItem_GetWidth(self.handle()))
 }
 
-func (self ImGuiInputEventKey) SetAnalogValue(v float32) {
-	C.ImGuiInputEventKey_SetAnalogValue(self.handle(), C.float(v))
+func (self ImGuiTabItem) SetContentWidth(v float32) {
+	C.ImGuiTabItem_SetContentWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiInputEventKey) GetAnalogValue() float32 {
-	return float32(C.ImGuiInputEventKey_GetAnalogValue(self.handle()))
+func (self ImGuiTabItem) GetContentWidth() float32 {
+	return float32(C.ImGuiTabItem_GetContentWidth(self.handle()))
 }
 
-func (self ImGuiLastItemData) SetID(v ImGuiID) {
-	C.ImGuiLastItemData_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiTabItem) SetRequestedWidth(v float32) {
+	C.ImGuiTabItem_SetRequestedWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiLastItemData) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiLastItemData_GetID(self.handle()))
+func (self ImGuiTabItem) GetRequestedWidth() float32 {
+	return float32(C.ImGuiTabItem_GetRequestedWidth(self.handle()))
 }
 
-func (self ImGuiLastItemData) SetInFlags(v ImGuiItemFlags) {
-	C.ImGuiLastItemData_SetInFlags(self.handle(), C.ImGuiItemFlags(v))
+func (self ImGuiTabItem) SetNameOffset(v int) {
+	C.ImGuiTabItem_SetNameOffset(self.handle(), C.ImS32(v))
 }
 
-func (self ImGuiLastItemData) GetInFlags() ImGuiItemFlags {
-	return ImGuiItemFlags(C.ImGuiLastItemData_GetInFlags(self.handle()))
+func (self ImGuiTabItem) GetNameOffset() int {
+	return int(C.ImGuiTabItem_GetNameOffset(self.handle()))
 }
 
-func (self ImGuiLastItemData) SetStatusFlags(v ImGuiItemStatusFlags) {
-	C.ImGuiLastItemData_SetStatusFlags(self.handle(), C.ImGuiItemStatusFlags(v))
+func (self ImGuiTabItem) SetBeginOrder(v int) {
+	C.ImGuiTabItem_SetBeginOrder(self.handle(), C.ImS16(v))
 }
 
-func (self ImGuiLastItemData) GetStatusFlags() ImGuiItemStatusFlags {
-	return ImGuiItemStatusFlags(C.ImGuiLastItemData_GetStatusFlags(self.handle()))
+func (self ImGuiTabItem) GetBeginOrder() int {
+	return int(C.ImGuiTabItem_GetBeginOrder(self.handle()))
 }
 
-func (self ImGuiLastItemData) SetRect(v ImRect) {
-	C.ImGuiLastItemData_SetRect(self.handle(), v.toC())
+func (self ImGuiTabItem) SetIndexDuringLayout(v int) {
+	C.ImGuiTabItem_SetIndexDuringLayout(self.handle(), C.ImS16(v))
 }
 
-func (self ImGuiLastItemData) GetRect() ImRect {
-	return newImRectFromC(C.ImGuiLastItemData_GetRect(self.handle()))
+func (self ImGuiTabItem) GetIndexDuringLayout() int {
+	return int(C.ImGuiTabItem_GetIndexDuringLayout(self.handle()))
 }
 
-func (self ImGuiLastItemData) SetNavRect(v ImRect) {
-	C.ImGuiLastItemData_SetNavRect(self.handle(), v.toC())
+func (self ImGuiTabItem) SetWantClose(v bool) {
+	C.ImGuiTabItem_SetWantClose(self.handle(), C.bool(v))
 }
 
-func (self ImGuiLastItemData) GetNavRect() ImRect {
-	return newImRectFromC(C.ImGuiLastItemData_GetNavRect(self.handle()))
+func (self ImGuiTabItem) GetWantClose() bool {
+	return C.ImGuiTabItem_GetWantClose(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiLastItemData) SetDisplayRect(v ImRect) {
-	C.ImGuiLastItemData_SetDisplayRect(self.handle(), v.toC())
+func (self ImGuiTabBar) SetFlags(v ImGuiTabBarFlags) {
+	C.ImGuiTabBar_SetFlags(self.handle(), C.ImGuiTabBarFlags(v))
 }
 
-func (self ImGuiLastItemData) GetDisplayRect() ImRect {
-	return newImRectFromC(C.ImGuiLastItemData_GetDisplayRect(self.handle()))
+func (self ImGuiTabBar) GetFlags() ImGuiTabBarFlags {
+	return ImGuiTabBarFlags(C.ImGuiTabBar_GetFlags(self.handle()))
 }
 
-func (self ImGuiMetricsConfig) SetShowDebugLog(v bool) {
-	C.ImGuiMetricsConfig_SetShowDebugLog(self.handle(), C.bool(v))
+func (self ImGuiTabBar) SetID(v ImGuiID) {
+	C.ImGuiTabBar_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiMetricsConfig) GetShowDebugLog() bool {
-	return C.ImGuiMetricsConfig_GetShowDebugLog(self.handle()) == C.bool(true)
+func (self ImGuiTabBar) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiTabBar_GetID(self.handle()))
 }
 
-func (self ImGuiMetricsConfig) SetShowStackTool(v bool) {
-	C.ImGuiMetricsConfig_SetShowStackTool(self.handle(), C.bool(v))
+func (self ImGuiTabBar) SetSelectedTabId(v ImGuiID) {
+	C.ImGuiTabBar_SetSelectedTabId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiMetricsConfig) GetShowStackTool() bool {
-	return C.ImGuiMetricsConfig_GetShowStackTool(self.handle()) == C.bool(true)
+func (self ImGuiTabBar) GetSelectedTabId() ImGuiID {
+	return ImGuiID(C.ImGuiTabBar_GetSelectedTabId(self.handle()))
 }
 
-func (self ImGuiMetricsConfig) SetShowWindowsRects(v bool) {
-	C.ImGuiMetricsConfig_SetShowWindowsRects(self.handle(), C.bool(v))
+func (self ImGuiTabBar) SetNextSelectedTabId(v ImGuiID) {
+	C.ImGuiTabBar_SetNextSelectedTabId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiMetricsConfig) GetShowWindowsRects() bool {
-	return C.ImGuiMetricsConfig_GetShowWindowsRects(self.handle()) == C.bool(true)
+func (self ImGuiTabBar) GetNextSelectedTabId() ImGuiID {
+	return ImGuiID(C.ImGuiTabBar_GetNextSelectedTabId(self.handle()))
 }
 
-func (self ImGuiMetricsConfig) SetShowWindowsBeginOrder(v bool) {
-	C.ImGuiMetricsConfig_SetShowWindowsBeginOrder(self.handle(), C.bool(v))
+func (self ImGuiTabBar) SetVisibleTabId(v ImGuiID) {
+	C.ImGuiTabBar_SetVisibleTabId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiMetricsConfig) GetShowWindowsBeginOrder() bool {
-	return C.ImGuiMetricsConfig_GetShowWindowsBeginOrder(self.handle()) == C.bool(true)
+func (self ImGuiTabBar) GetVisibleTabId() ImGuiID {
+	return ImGuiID(C.ImGuiTabBar_GetVisibleTabId(self.handle()))
 }
 
-func (self ImGuiMetricsConfig) SetShowTablesRects(v bool) {
-	C.ImGuiMetricsConfig_SetShowTablesRects(self.handle(), C.bool(v))
+func (self ImGuiTabBar) SetCurrFrameVisible(v int32) {
+	C.ImGuiTabBar_SetCurrFrameVisible(self.handle(), C.int(v))
 }
 
-func (self ImGuiMetricsConfig) GetShowTablesRects() bool {
-	return C.ImGuiMetricsConfig_GetShowTablesRects(self.handle()) == C.bool(true)
+func (self ImGuiTabBar) GetCurrFrameVisible() int {
+	return int(C.ImGuiTabBar_GetCurrFrameVisible(self.handle()))
 }
 
-func (self ImGuiMetricsConfig) SetShowDrawCmdMesh(v bool) {
-	C.ImGuiMetricsConfig_SetShowDrawCmdMesh(self.handle(), C.bool(v))
+func (self ImGuiTabBar) SetPrevFrameVisible(v int32) {
+	C.ImGuiTabBar_SetPrevFrameVisible(self.handle(), C.int(v))
 }
 
-func (self ImGuiMetricsConfig) GetShowDrawCmdMesh() bool {
-	return C.ImGuiMetricsConfig_GetShowDrawCmdMesh(self.handle()) == C.bool(true)
+func (self ImGuiTabBar) GetPrevFrameVisible() int {
+	return int(C.ImGuiTabBar_GetPrevFrameVisible(self.handle()))
 }
 
-func (self ImGuiMetricsConfig) SetShowDrawCmdBoundingBoxes(v bool) {
-	C.ImGuiMetricsConfig_SetShowDrawCmdBoundingBoxes(self.handle(), C.bool(v))
+func (self ImGuiTabBar) SetBarRect(v ImRect) {
+	C.ImGuiTabBar_SetBarRect(self.handle(), v.toC())
 }
 
-func (self ImGuiMetricsConfig) GetShowDrawCmdBoundingBoxes() bool {
-	return C.ImGuiMetricsConfig_GetShowDrawCmdBoundingBoxes(self.handle()) == C.bool(true)
+func (self ImGuiTabBar) GetBarRect() ImRect {
+	return newImRectFromC(C.ImGuiTabBar_GetBarRect(self.handle()))
 }
 
-func (self ImGuiMetricsConfig) SetShowDockingNodes(v bool) {
-	C.ImGuiMetricsConfig_SetShowDockingNodes(self.handle(), C.bool(v))
+func (self ImGuiTabBar) SetCurrTabsContentsHeight(v float32) {
+	C.ImGuiTabBar_SetCurrTabsContentsHeight(self.handle(), C.float(v))
 }
 
-func (self ImGuiMetricsConfig) GetShowDockingNodes() bool {
-	return C.ImGuiMetricsConfig_GetShowDockingNodes(self.handle()) == C.bool(true)
+func (self ImGuiTabBar) GetCurrTabsContentsHeight() float32 {
+	return float32(C.ImGuiTabBar_GetCurrTabsContentsHeight(self.handle()))
 }
 
-func (self ImGuiMetricsConfig) SetShowWindowsRectsType(v int32) {
-	C.ImGuiMetricsConfig_SetShowWindowsRectsType(self.handle(), C.int(v))
+func (self ImGuiTabBar) SetPrevTabsContentsHeight(v float32) {
+	C.ImGuiTabBar_SetPrevTabsContentsHeight(self.handle(), C.float(v))
 }
 
-func (self ImGuiMetricsConfig) GetShowWindowsRectsType() int {
-	return int(C.ImGuiMetricsConfig_GetShowWindowsRectsType(self.handle()))
+func (self ImGuiTabBar) GetPrevTabsContentsHeight() float32 {
+	return float32(C.ImGuiTabBar_GetPrevTabsContentsHeight(self.handle()))
 }
 
-func (self ImGuiMetricsConfig) SetShowTablesRectsType(v int32) {
-	C.ImGuiMetricsConfig_SetShowTablesRectsType(self.handle(), C.int(v))
+func (self ImGuiTabBar) SetWidthAllTabs(v float32) {
+	C.ImGuiTabBar_SetWidthAllTabs(self.handle(), C.float(v))
 }
 
-func (self ImGuiMetricsConfig) GetShowTablesRectsType() int {
-	return int(C.ImGuiMetricsConfig_GetShowTablesRectsType(self.handle()))
+func (self ImGuiTabBar) GetWidthAllTabs() float32 {
+	return float32(C.ImGuiTabBar_GetWidthAllTabs(self.handle()))
 }
 
-func (self ImGuiPlatformMonitor) SetMainPos(v ImVec2) {
-	C.ImGuiPlatformMonitor_SetMainPos(self.handle(), v.toC())
+func (self ImGuiTabBar) SetWidthAllTabsIdeal(v float32) {
+	C.ImGuiTabBar_SetWidthAllTabsIdeal(self.handle(), C.float(v))
 }
 
-func (self ImGuiPlatformMonitor) GetMainPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiPlatformMonitor_GetMainPos(self.handle()))
+func (self ImGuiTabBar) GetWidthAllTabsIdeal() float32 {
+	return float32(C.ImGuiTabBar_GetWidthAllTabsIdeal(self.handle()))
 }
 
-func (self ImGuiPlatformMonitor) SetMainSize(v ImVec2) {
-	C.ImGuiPlatformMonitor_SetMainSize(self.handle(), v.toC())
+func (self ImGuiTabBar) SetScrollingAnim(v float32) {
+	C.ImGuiTabBar_SetScrollingAnim(self.handle(), C.float(v))
 }
 
-func (self ImGuiPlatformMonitor) GetMainSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiPlatformMonitor_GetMainSize(self.handle()))
+func (self ImGuiTabBar) GetScrollingAnim() float32 {
+	return float32(C.ImGuiTabBar_GetScrollingAnim(self.handle()))
 }
 
-func (self ImGuiPlatformMonitor) SetWorkPos(v ImVec2) {
-	C.ImGuiPlatformMonitor_SetWorkPos(self.handle(), v.toC())
+func (self ImGuiTabBar) SetScrollingTarget(v float32) {
+	C.ImGuiTabBar_SetScrollingTarget(self.handle(), C.float(v))
 }
 
-func (self ImGuiPlatformMonitor) GetWorkPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiPlatformMonitor_GetWorkPos(self.handle()))
+func (self ImGuiTabBar) GetScrollingTarget() float32 {
+	return float32(C.ImGuiTabBar_GetScrollingTarget(self.handle()))
 }
 
-func (self ImGuiPlatformMonitor) SetWorkSize(v ImVec2) {
-	C.ImGuiPlatformMonitor_SetWorkSize(self.handle(), v.toC())
+func (self ImGuiTabBar) SetScrollingTargetDistToVisibility(v float32) {
+	C.ImGuiTabBar_SetScrollingTargetDistToVisibility(self.handle(), C.float(v))
 }
 
-func (self ImGuiPlatformMonitor) GetWorkSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiPlatformMonitor_GetWorkSize(self.handle()))
+func (self ImGuiTabBar) GetScrollingTargetDistToVisibility() float32 {
+	return float32(C.ImGuiTabBar_GetScrollingTargetDistToVisibility(self.handle()))
 }
 
-func (self ImGuiPlatformMonitor) SetDpiScale(v float32) {
-	C.ImGuiPlatformMonitor_SetDpiScale(self.handle(), C.float(v))
+func (self ImGuiTabBar) SetScrollingSpeed(v float32) {
+	C.ImGuiTabBar_SetScrollingSpeed(self.handle(), C.float(v))
 }
 
-func (self ImGuiPlatformMonitor) GetDpiScale() float32 {
-	return float32(C.ImGuiPlatformMonitor_GetDpiScale(self.handle()))
+func (self ImGuiTabBar) GetScrollingSpeed() float32 {
+	return float32(C.ImGuiTabBar_GetScrollingSpeed(self.handle()))
 }
 
-func (self ImGuiContextHook) SetHookId(v ImGuiID) {
-	C.ImGuiContextHook_SetHookId(self.handle(), C.ImGuiID(v))
+func (self ImGuiTabBar) SetScrollingRectMinX(v float32) {
+	C.ImGuiTabBar_SetScrollingRectMinX(self.handle(), C.float(v))
 }
 
-func (self ImGuiContextHook) GetHookId() ImGuiID {
-	return ImGuiID(C.ImGuiContextHook_GetHookId(self.handle()))
+func (self ImGuiTabBar) GetScrollingRectMinX() float32 {
+	return float32(C.ImGuiTabBar_GetScrollingRectMinX(self.handle()))
 }
 
-func (self ImGuiContextHook) SetType(v ImGuiContextHookType) {
-	C.ImGuiContextHook_SetType(self.handle(), C.ImGuiContextHookType(v))
+func (self ImGuiTabBar) SetScrollingRectMaxX(v float32) {
+	C.ImGuiTabBar_SetScrollingRectMaxX(self.handle(), C.float(v))
 }
 
-func (self ImGuiContextHook) GetType() ImGuiContextHookType {
-	return ImGuiContextHookType(C.ImGuiContextHook_GetType(self.handle()))
+func (self ImGuiTabBar) GetScrollingRectMaxX() float32 {
+	return float32(C.ImGuiTabBar_GetScrollingRectMaxX(self.handle()))
 }
 
-func (self ImGuiContextHook) SetOwner(v ImGuiID) {
-	C.ImGuiContextHook_SetOwner(self.handle(), C.ImGuiID(v))
+func (self ImGuiTabBar) SetReorderRequestTabId(v ImGuiID) {
+	C.ImGuiTabBar_SetReorderRequestTabId(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiContextHook) GetOwner() ImGuiID {
-	return ImGuiID(C.ImGuiContextHook_GetOwner(self.handle()))
+func (self ImGuiTabBar) GetReorderRequestTabId() ImGuiID {
+	return ImGuiID(C.ImGuiTabBar_GetReorderRequestTabId(self.handle()))
 }
 
-func (self ImGuiContextHook) SetUserData(v unsafe.Pointer) {
-	C.ImGuiContextHook_SetUserData(self.handle(), v)
+func (self ImGuiTabBar) SetReorderRequestOffset(v int) {
+	C.ImGuiTabBar_SetReorderRequestOffset(self.handle(), C.ImS16(v))
 }
 
-func (self ImGuiContextHook) GetUserData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiContextHook_GetUserData(self.handle()))
+func (self ImGuiTabBar) GetReorderRequestOffset() int {
+	return int(C.ImGuiTabBar_GetReorderRequestOffset(self.handle()))
 }
 
-func (self ImGuiDockNode) SetID(v ImGuiID) {
-	C.ImGuiDockNode_SetID(self.handle(), C.ImGuiID(v))
+func (self ImGuiTabBar) SetBeginCount(v int) {
+	C.ImGuiTabBar_SetBeginCount(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiDockNode) GetID() ImGuiID {
-	return ImGuiID(C.ImGuiDockNode_GetID(self.handle()))
+func (self ImGuiTabBar) GetBeginCount() int {
+	return int(C.ImGuiTabBar_GetBeginCount(self.handle()))
 }
 
-func (self ImGuiDockNode) SetSharedFlags(v ImGuiDockNodeFlags) {
-	C.ImGuiDockNode_SetSharedFlags(self.handle(), C.ImGuiDockNodeFlags(v))
+func (self ImGuiTabBar) SetWantLayout(v bool) {
+	C.ImGuiTabBar_SetWantLayout(self.handle(), C.bool(v))
 }
 
-func (self ImGuiDockNode) GetSharedFlags() ImGuiDockNodeFlags {
-	return ImGuiDockNodeFlags(C.ImGuiDockNode_GetSharedFlags(self.handle()))
+func (self ImGuiTabBar) GetWantLayout() bool {
+	return C.ImGuiTabBar_GetWantLayout(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiDockNode) SetLocalFlagsInWindows(v ImGuiDockNodeFlags) {
-	C.ImGuiDockNode_SetLocalFlagsInWindows(self.handle(), C.ImGuiDockNodeFlags(v))
+func (self ImGuiTabBar) SetVisibleTabWasSubmitted(v bool) {
+	C.ImGuiTabBar_SetVisibleTabWasSubmitted(self.handle(), C.bool(v))
 }
 
-func (self ImGuiDockNode) GetLocalFlagsInWindows() ImGuiDockNodeFlags {
-	return ImGuiDockNodeFlags(C.ImGuiDockNode_GetLocalFlagsInWindows(self.handle()))
+func (self ImGuiTabBar) GetVisibleTabWasSubmitted() bool {
+	return C.ImGuiTabBar_GetVisibleTabWasSubmitted(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiDockNode) SetMergedFlags(v ImGuiDockNodeFlags) {
-	C.ImGuiDockNode_SetMergedFlags(self.handle(), C.ImGuiDockNodeFlags(v))
+func (self ImGuiTabBar) SetTabsAddedNew(v bool) {
+	C.ImGuiTabBar_SetTabsAddedNew(self.handle(), C.bool(v))
 }
 
-func (self ImGuiDockNode) GetMergedFlags() ImGuiDockNodeFlags {
-	return ImGuiDockNodeFlags(C.ImGuiDockNode_GetMergedFlags(self.handle()))
+func (self ImGuiTabBar) GetTabsAddedNew() bool {
+	return C.ImGuiTabBar_GetTabsAddedNew(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiDockNode) SetState(v ImGuiDockNodeState) {
-	C.ImGuiDockNode_SetState(self.handle(), C.ImGuiDockNodeState(v))
+func (self ImGuiTabBar) SetTabsActiveCount(v int) {
+	C.ImGuiTabBar_SetTabsActiveCount(self.handle(), C.ImS16(v))
 }
 
-func (self ImGuiDockNode) GetState() ImGuiDockNodeState {
-	return ImGuiDockNodeState(C.ImGuiDockNode_GetState(self.handle()))
+func (self ImGuiTabBar) GetTabsActiveCount() int {
+	return int(C.ImGuiTabBar_GetTabsActiveCount(self.handle()))
 }
 
-func (self ImGuiDockNode) SetParentNode(v ImGuiDockNode) {
-	C.ImGuiDockNode_SetParentNode(self.handle(), v.handle())
+func (self ImGuiTabBar) SetLastTabItemIdx(v int) {
+	C.ImGuiTabBar_SetLastTabItemIdx(self.handle(), C.ImS16(v))
 }
 
-func (self ImGuiDockNode) GetParentNode() ImGuiDockNode {
-	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiDockNode_GetParentNode(self.handle())))
+func (self ImGuiTabBar) GetLastTabItemIdx() int {
+	return int(C.ImGuiTabBar_GetLastTabItemIdx(self.handle()))
 }
 
-func (self ImGuiDockNode) SetTabBar(v ImGuiTabBar) {
-	C.ImGuiDockNode_SetTabBar(self.handle(), v.handle())
+func (self ImGuiTabBar) SetItemSpacingY(v float32) {
+	C.ImGuiTabBar_SetItemSpacingY(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetTabBar() ImGuiTabBar {
-	return (ImGuiTabBar)(unsafe.Pointer(C.ImGuiDockNode_GetTabBar(self.handle())))
+func (self ImGuiTabBar) GetItemSpacingY() float32 {
+	return float32(C.ImGuiTabBar_GetItemSpacingY(self.handle()))
 }
 
-func (self ImGuiDockNode) SetPos(v ImVec2) {
-	C.ImGuiDockNode_SetPos(self.handle(), v.toC())
+func (self ImGuiTabBar) SetFramePadding(v ImVec2) {
+	C.ImGuiTabBar_SetFramePadding(self.handle(), v.toC())
 }
 
-func (self ImGuiDockNode) GetPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiDockNode_GetPos(self.handle()))
+func (self ImGuiTabBar) GetFramePadding() ImVec2 {
+	return newImVec2FromC(C.ImGuiTabBar_GetFramePadding(self.handle()))
 }
 
-func (self ImGuiDockNode) SetSize(v ImVec2) {
-	C.ImGuiDockNode_SetSize(self.handle(), v.toC())
+func (self ImGuiTabBar) SetBackupCursorPos(v ImVec2) {
+	C.ImGuiTabBar_SetBackupCursorPos(self.handle(), v.toC())
 }
 
-func (self ImGuiDockNode) GetSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiDockNode_GetSize(self.handle()))
+func (self ImGuiTabBar) GetBackupCursorPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiTabBar_GetBackupCursorPos(self.handle()))
 }
 
-func (self ImGuiDockNode) SetSizeRef(v ImVec2) {
-	C.ImGuiDockNode_SetSizeRef(self.handle(), v.toC())
+func (self ImGuiTabBar) GetTabsNames() ImGuiTextBuffer {
+	return newImGuiTextBufferFromC(C.ImGuiTabBar_GetTabsNames(self.handle()))
 }
 
-func (self ImGuiDockNode) GetSizeRef() ImVec2 {
-	return newImVec2FromC(C.ImGuiDockNode_GetSizeRef(self.handle()))
+func (self ImGuiTableColumn) SetFlags(v ImGuiTableColumnFlags) {
+	C.ImGuiTableColumn_SetFlags(self.handle(), C.ImGuiTableColumnFlags(v))
 }
 
-func (self ImGuiDockNode) SetSplitAxis(v ImGuiAxis) {
-	C.ImGuiDockNode_SetSplitAxis(self.handle(), C.ImGuiAxis(v))
+func (self ImGuiTableColumn) GetFlags() ImGuiTableColumnFlags {
+	return ImGuiTableColumnFlags(C.ImGuiTableColumn_GetFlags(self.handle()))
 }
 
-func (self ImGuiDockNode) GetSplitAxis() ImGuiAxis {
-	return ImGuiAxis(C.ImGuiDockNode_GetSplitAxis(self.handle()))
+func (self ImGuiTableColumn) SetWidthGiven(v float32) {
+	C.ImGuiTableColumn_SetWidthGiven(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetWindowClass() ImGuiWindowClass {
-	return newImGuiWindowClassFromC(C.ImGuiDockNode_GetWindowClass(self.handle()))
+func (self ImGuiTableColumn) GetWidthGiven() float32 {
+	return float32(C.ImGuiTableColumn_GetWidthGiven(self.handle()))
 }
 
-func (self ImGuiDockNode) SetLastBgColor(v uint32) {
-	C.ImGuiDockNode_SetLastBgColor(self.handle(), C.ImU32(v))
+func (self ImGuiTableColumn) SetMinX(v float32) {
+	C.ImGuiTableColumn_SetMinX(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetLastBgColor() uint32 {
-	return uint32(C.ImGuiDockNode_GetLastBgColor(self.handle()))
+func (self ImGuiTableColumn) GetMinX() float32 {
+	return float32(C.ImGuiTableColumn_GetMinX(self.handle()))
 }
 
-func (self ImGuiDockNode) SetHostWindow(v ImGuiWindow) {
-	C.ImGuiDockNode_SetHostWindow(self.handle(), v.handle())
+func (self ImGuiTableColumn) SetMaxX(v float32) {
+	C.ImGuiTableColumn_SetMaxX(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetHostWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiDockNode_GetHostWindow(self.handle())))
+func (self ImGuiTableColumn) GetMaxX() float32 {
+	return float32(C.ImGuiTableColumn_GetMaxX(self.handle()))
 }
 
-func (self ImGuiDockNode) SetVisibleWindow(v ImGuiWindow) {
-	C.ImGuiDockNode_SetVisibleWindow(self.handle(), v.handle())
+func (self ImGuiTableColumn) SetWidthRequest(v float32) {
+	C.ImGuiTableColumn_SetWidthRequest(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetVisibleWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiDockNode_GetVisibleWindow(self.handle())))
+func (self ImGuiTableColumn) GetWidthRequest() float32 {
+	return float32(C.ImGuiTableColumn_GetWidthRequest(self.handle()))
 }
 
-func (self ImGuiDockNode) SetCentralNode(v ImGuiDockNode) {
-	C.ImGuiDockNode_SetCentralNode(self.handle(), v.handle())
+func (self ImGuiTableColumn) SetWidthAuto(v float32) {
+	C.ImGuiTableColumn_SetWidthAuto(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetCentralNode() ImGuiDockNode {
-	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiDockNode_GetCentralNode(self.handle())))
+func (self ImGuiTableColumn) GetWidthAuto() float32 {
+	return float32(C.ImGuiTableColumn_GetWidthAuto(self.handle()))
 }
 
-func (self ImGuiDockNode) SetOnlyNodeWithWindows(v ImGuiDockNode) {
-	C.ImGuiDockNode_SetOnlyNodeWithWindows(self.handle(), v.handle())
+func (self ImGuiTableColumn) SetStretchWeight(v float32) {
+	C.ImGuiTableColumn_SetStretchWeight(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetOnlyNodeWithWindows() ImGuiDockNode {
-	return (ImGuiDockNode)(unsafe.Pointer(C.ImGuiDockNode_GetOnlyNodeWithWindows(self.handle())))
+func (self ImGuiTableColumn) GetStretchWeight() float32 {
+	return float32(C.ImGuiTableColumn_GetStretchWeight(self.handle()))
 }
 
-func (self ImGuiDockNode) SetCountNodeWithWindows(v int32) {
-	C.ImGuiDockNode_SetCountNodeWithWindows(self.handle(), C.int(v))
+func (self ImGuiTableColumn) SetInitStretchWeightOrWidth(v float32) {
+	C.ImGuiTableColumn_SetInitStretchWeightOrWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetCountNodeWithWindows() int {
-	return int(C.ImGuiDockNode_GetCountNodeWithWindows(self.handle()))
+func (self ImGuiTableColumn) GetInitStretchWeightOrWidth() float32 {
+	return float32(C.ImGuiTableColumn_GetInitStretchWeightOrWidth(self.handle()))
 }
 
-func (self ImGuiDockNode) SetLastFrameAlive(v int32) {
-	C.ImGuiDockNode_SetLastFrameAlive(self.handle(), C.int(v))
+func (self ImGuiTableColumn) SetClipRect(v ImRect) {
+	C.ImGuiTableColumn_SetClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiDockNode) GetLastFrameAlive() int {
-	return int(C.ImGuiDockNode_GetLastFrameAlive(self.handle()))
+func (self ImGuiTableColumn) GetClipRect() ImRect {
+	return newImRectFromC(C.ImGuiTableColumn_GetClipRect(self.handle()))
 }
 
-func (self ImGuiDockNode) SetLastFrameActive(v int32) {
-	C.ImGuiDockNode_SetLastFrameActive(self.handle(), C.int(v))
+func (self ImGuiTableColumn) SetUserID(v ImGuiID) {
+	C.ImGuiTableColumn_SetUserID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiDockNode) GetLastFrameActive() int {
-	return int(C.ImGuiDockNode_GetLastFrameActive(self.handle()))
+func (self ImGuiTableColumn) GetUserID() ImGuiID {
+	return ImGuiID(C.ImGuiTableColumn_GetUserID(self.handle()))
 }
 
-func (self ImGuiDockNode) SetLastFrameFocused(v int32) {
-	C.ImGuiDockNode_SetLastFrameFocused(self.handle(), C.int(v))
+func (self ImGuiTableColumn) SetWorkMinX(v float32) {
+	C.ImGuiTableColumn_SetWorkMinX(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetLastFrameFocused() int {
-	return int(C.ImGuiDockNode_GetLastFrameFocused(self.handle()))
+func (self ImGuiTableColumn) GetWorkMinX() float32 {
+	return float32(C.ImGuiTableColumn_GetWorkMinX(self.handle()))
 }
 
-func (self ImGuiDockNode) SetLastFocusedNodeId(v ImGuiID) {
-	C.ImGuiDockNode_SetLastFocusedNodeId(self.handle(), C.ImGuiID(v))
+func (self ImGuiTableColumn) SetWorkMaxX(v float32) {
+	C.ImGuiTableColumn_SetWorkMaxX(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetLastFocusedNodeId() ImGuiID {
-	return ImGuiID(C.ImGuiDockNode_GetLastFocusedNodeId(self.handle()))
+func (self ImGuiTableColumn) GetWorkMaxX() float32 {
+	return float32(C.ImGuiTableColumn_GetWorkMaxX(self.handle()))
 }
 
-func (self ImGuiDockNode) SetSelectedTabId(v ImGuiID) {
-	C.ImGuiDockNode_SetSelectedTabId(self.handle(), C.ImGuiID(v))
+func (self ImGuiTableColumn) SetItemWidth(v float32) {
+	C.ImGuiTableColumn_SetItemWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetSelectedTabId() ImGuiID {
-	return ImGuiID(C.ImGuiDockNode_GetSelectedTabId(self.handle()))
+func (self ImGuiTableColumn) GetItemWidth() float32 {
+	return float32(C.ImGuiTableColumn_GetItemWidth(self.handle()))
 }
 
-func (self ImGuiDockNode) SetWantCloseTabId(v ImGuiID) {
-	C.ImGuiDockNode_SetWantCloseTabId(self.handle(), C.ImGuiID(v))
+func (self ImGuiTableColumn) SetContentMaxXFrozen(v float32) {
+	C.ImGuiTableColumn_SetContentMaxXFrozen(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetWantCloseTabId() ImGuiID {
-	return ImGuiID(C.ImGuiDockNode_GetWantCloseTabId(self.handle()))
+func (self ImGuiTableColumn) GetContentMaxXFrozen() float32 {
+	return float32(C.ImGuiTableColumn_GetContentMaxXFrozen(self.handle()))
 }
 
-func (self ImGuiDockNode) SetAuthorityForPos(v ImGuiDataAuthority) {
-	C.ImGuiDockNode_SetAuthorityForPos(self.handle(), C.ImGuiDataAuthority(v))
+func (self ImGuiTableColumn) SetContentMaxXUnfrozen(v float32) {
+	C.ImGuiTableColumn_SetContentMaxXUnfrozen(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetAuthorityForPos() ImGuiDataAuthority {
-	return ImGuiDataAuthority(C.ImGuiDockNode_GetAuthorityForPos(self.handle()))
+func (self ImGuiTableColumn) GetContentMaxXUnfrozen() float32 {
+	return float32(C.ImGuiTableColumn_GetContentMaxXUnfrozen(self.handle()))
 }
 
-func (self ImGuiDockNode) SetAuthorityForSize(v ImGuiDataAuthority) {
-	C.ImGuiDockNode_SetAuthorityForSize(self.handle(), C.ImGuiDataAuthority(v))
+func (self ImGuiTableColumn) SetContentMaxXHeadersUsed(v float32) {
+	C.ImGuiTableColumn_SetContentMaxXHeadersUsed(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetAuthorityForSize() ImGuiDataAuthority {
-	return ImGuiDataAuthority(C.ImGuiDockNode_GetAuthorityForSize(self.handle()))
+func (self ImGuiTableColumn) GetContentMaxXHeadersUsed() float32 {
+	return float32(C.ImGuiTableColumn_GetContentMaxXHeadersUsed(self.handle()))
 }
 
-func (self ImGuiDockNode) SetAuthorityForViewport(v ImGuiDataAuthority) {
-	C.ImGuiDockNode_SetAuthorityForViewport(self.handle(), C.ImGuiDataAuthority(v))
+func (self ImGuiTableColumn) SetContentMaxXHeadersIdeal(v float32) {
+	C.ImGuiTableColumn_SetContentMaxXHeadersIdeal(self.handle(), C.float(v))
 }
 
-func (self ImGuiDockNode) GetAuthorityForViewport() ImGuiDataAuthority {
-	return ImGuiDataAuthority(C.ImGuiDockNode_GetAuthorityForViewport(self.handle()))
+func (self ImGuiTableColumn) GetContentMaxXHeadersIdeal() float32 {
+	return float32(C.ImGuiTableColumn_GetContentMaxXHeadersIdeal(self.handle()))
 }
 
-func (self ImGuiDockNode) SetIsVisible(v bool) {
-	C.ImGuiDockNode_SetIsVisible(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetNameOffset(v int) {
+	C.ImGuiTableColumn_SetNameOffset(self.handle(), C.ImS16(v))
 }
 
-func (self ImGuiDockNode) GetIsVisible() bool {
-	return C.ImGuiDockNode_GetIsVisible(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetNameOffset() int {
+	return int(C.ImGuiTableColumn_GetNameOffset(self.handle()))
 }
 
-func (self ImGuiDockNode) SetIsFocused(v bool) {
-	C.ImGuiDockNode_SetIsFocused(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetDisplayOrder(v ImGuiTableColumnIdx) {
+	C.ImGuiTableColumn_SetDisplayOrder(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiDockNode) GetIsFocused() bool {
-	return C.ImGuiDockNode_GetIsFocused(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetDisplayOrder() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableColumn_GetDisplayOrder(self.handle()))
 }
 
-func (self ImGuiDockNode) SetIsBgDrawnThisFrame(v bool) {
-	C.ImGuiDockNode_SetIsBgDrawnThisFrame(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetIndexWithinEnabledSet(v ImGuiTableColumnIdx) {
+	C.ImGuiTableColumn_SetIndexWithinEnabledSet(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiDockNode) GetIsBgDrawnThisFrame() bool {
-	return C.ImGuiDockNode_GetIsBgDrawnThisFrame(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetIndexWithinEnabledSet() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableColumn_GetIndexWithinEnabledSet(self.handle()))
 }
 
-func (self ImGuiDockNode) SetHasCloseButton(v bool) {
-	C.ImGuiDockNode_SetHasCloseButton(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetPrevEnabledColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTableColumn_SetPrevEnabledColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiDockNode) GetHasCloseButton() bool {
-	return C.ImGuiDockNode_GetHasCloseButton(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetPrevEnabledColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableColumn_GetPrevEnabledColumn(self.handle()))
 }
 
-func (self ImGuiDockNode) SetHasWindowMenuButton(v bool) {
-	C.ImGuiDockNode_SetHasWindowMenuButton(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetNextEnabledColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTableColumn_SetNextEnabledColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiDockNode) GetHasWindowMenuButton() bool {
-	return C.ImGuiDockNode_GetHasWindowMenuButton(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetNextEnabledColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableColumn_GetNextEnabledColumn(self.handle()))
 }
 
-func (self ImGuiDockNode) SetHasCentralNodeChild(v bool) {
-	C.ImGuiDockNode_SetHasCentralNodeChild(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetSortOrder(v ImGuiTableColumnIdx) {
+	C.ImGuiTableColumn_SetSortOrder(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiDockNode) GetHasCentralNodeChild() bool {
-	return C.ImGuiDockNode_GetHasCentralNodeChild(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetSortOrder() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableColumn_GetSortOrder(self.handle()))
 }
 
-func (self ImGuiDockNode) SetWantCloseAll(v bool) {
-	C.ImGuiDockNode_SetWantCloseAll(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetDrawChannelCurrent(v ImGuiTableDrawChannelIdx) {
+	C.ImGuiTableColumn_SetDrawChannelCurrent(self.handle(), C.ImGuiTableDrawChannelIdx(v))
 }
 
-func (self ImGuiDockNode) GetWantCloseAll() bool {
-	return C.ImGuiDockNode_GetWantCloseAll(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetDrawChannelCurrent() ImGuiTableDrawChannelIdx {
+	return ImGuiTableDrawChannelIdx(C.ImGuiTableColumn_GetDrawChannelCurrent(self.handle()))
 }
 
-func (self ImGuiDockNode) SetWantLockSizeOnce(v bool) {
-	C.ImGuiDockNode_SetWantLockSizeOnce(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetDrawChannelFrozen(v ImGuiTableDrawChannelIdx) {
+	C.ImGuiTableColumn_SetDrawChannelFrozen(self.handle(), C.ImGuiTableDrawChannelIdx(v))
 }
 
-func (self ImGuiDockNode) GetWantLockSizeOnce() bool {
-	return C.ImGuiDockNode_GetWantLockSizeOnce(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetDrawChannelFrozen() ImGuiTableDrawChannelIdx {
+	return ImGuiTableDrawChannelIdx(C.ImGuiTableColumn_GetDrawChannelFrozen(self.handle()))
 }
 
-func (self ImGuiDockNode) SetWantMouseMove(v bool) {
-	C.ImGuiDockNode_SetWantMouseMove(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetDrawChannelUnfrozen(v ImGuiTableDrawChannelIdx) {
+	C.ImGuiTableColumn_SetDrawChannelUnfrozen(self.handle(), C.ImGuiTableDrawChannelIdx(v))
 }
 
-func (self ImGuiDockNode) GetWantMouseMove() bool {
-	return C.ImGuiDockNode_GetWantMouseMove(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetDrawChannelUnfrozen() ImGuiTableDrawChannelIdx {
+	return ImGuiTableDrawChannelIdx(C.ImGuiTableColumn_GetDrawChannelUnfrozen(self.handle()))
 }
 
-func (self ImGuiDockNode) SetWantHiddenTabBarUpdate(v bool) {
-	C.ImGuiDockNode_SetWantHiddenTabBarUpdate(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetIsEnabled(v bool) {
+	C.ImGuiTableColumn_SetIsEnabled(self.handle(), C.bool(v))
 }
 
-func (self ImGuiDockNode) GetWantHiddenTabBarUpdate() bool {
-	return C.ImGuiDockNode_GetWantHiddenTabBarUpdate(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetIsEnabled() bool {
+	return C.ImGuiTableColumn_GetIsEnabled(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiDockNode) SetWantHiddenTabBarToggle(v bool) {
-	C.ImGuiDockNode_SetWantHiddenTabBarToggle(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetIsUserEnabled(v bool) {
+	C.ImGuiTableColumn_SetIsUserEnabled(self.handle(), C.bool(v))
 }
 
-func (self ImGuiDockNode) GetWantHiddenTabBarToggle() bool {
-	return C.ImGuiDockNode_GetWantHiddenTabBarToggle(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetIsUserEnabled() bool {
+	return C.ImGuiTableColumn_GetIsUserEnabled(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiListClipperRange) SetMin(v int32) {
-	C.ImGuiListClipperRange_SetMin(self.handle(), C.int(v))
+func (self ImGuiTableColumn) SetIsUserEnabledNextFrame(v bool) {
+	C.ImGuiTableColumn_SetIsUserEnabledNextFrame(self.handle(), C.bool(v))
 }
 
-func (self ImGuiListClipperRange) GetMin() int {
-	return int(C.ImGuiListClipperRange_GetMin(self.handle()))
+func (self ImGuiTableColumn) GetIsUserEnabledNextFrame() bool {
+	return C.ImGuiTableColumn_GetIsUserEnabledNextFrame(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiListClipperRange) SetMax(v int32) {
-	C.ImGuiListClipperRange_SetMax(self.handle(), C.int(v))
+func (self ImGuiTableColumn) SetIsVisibleX(v bool) {
+	C.ImGuiTableColumn_SetIsVisibleX(self.handle(), C.bool(v))
 }
 
-func (self ImGuiListClipperRange) GetMax() int {
-	return int(C.ImGuiListClipperRange_GetMax(self.handle()))
+func (self ImGuiTableColumn) GetIsVisibleX() bool {
+	return C.ImGuiTableColumn_GetIsVisibleX(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiListClipperRange) SetPosToIndexConvert(v bool) {
-	C.ImGuiListClipperRange_SetPosToIndexConvert(self.handle(), C.bool(v))
+func (self ImGuiTableColumn) SetIsVisibleY(v bool) {
+	C.ImGuiTableColumn_SetIsVisibleY(self.handle(), C.bool(v))
 }
 
-func (self ImGuiListClipperRange) GetPosToIndexConvert() bool {
-	return C.ImGuiListClipperRange_GetPosToIndexConvert(self.handle()) == C.bool(true)
+func (self ImGuiTableColumn) GetIsVisibleY() bool {
+	return C.ImGuiTableColumn_GetIsVisibleY(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiListClipperRange) SetPosToIndexOffsetMin(v int) {
-	C.ImGuiListClipperRange_SetPosToIndexOffsetMin(self.handle(), C.ImS8(v))
+func (self ImGuiTableColumn) SetIsRequestOutput(v bool) {
+	C.ImGuiTableColumn_SetIsRequestOutput(self.handle(), C.bool(v))
 }
 
-func (self ImGuiListClipperRange) GetPosToIndexOffsetMin() int {
-	return int(C.ImGuiListClipperRange_GetPosToIndexOffsetMin(self.handle()))
+func (self ImGuiTableColumn) GetIsRequestOutput() bool {
+	return C.ImGuiTableColumn_GetIsRequestOutput(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiListClipperRange) SetPosToIndexOffsetMax(v int) {
-	C.ImGuiListClipperRange_SetPosToIndexOffsetMax(self.handle(), C.ImS8(v))
+func (self ImGuiTableColumn) SetIsSkipItems(v bool) {
+	C.ImGuiTableColumn_SetIsSkipItems(self.handle(), C.bool(v))
 }
 
-func (self ImGuiListClipperRange) GetPosToIndexOffsetMax() int {
-	return int(C.ImGuiListClipperRange_GetPosToIndexOffsetMax(self.handle()))
+func (self ImGuiTableColumn) GetIsSkipItems() bool {
+	return C.ImGuiTableColumn_GetIsSkipItems(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiViewportP) Get_ImGuiViewport() ImGuiViewport {
-	return newImGuiViewportFromC(C.ImGuiViewportP_Get_ImGuiViewport(self.handle()))
+func (self ImGuiTableColumn) SetIsPreserveWidthAuto(v bool) {
+	C.ImGuiTableColumn_SetIsPreserveWidthAuto(self.handle(), C.bool(v))
 }
 
-func (self ImGuiViewportP) SetIdx(v int32) {
-	C.ImGuiViewportP_SetIdx(self.handle(), C.int(v))
+func (self ImGuiTableColumn) GetIsPreserveWidthAuto() bool {
+	return C.ImGuiTableColumn_GetIsPreserveWidthAuto(self.handle()) == C.bool(true)
 }
 
-func (self ImGuiViewportP) GetIdx() int {
-	return int(C.ImGuiViewportP_GetIdx(self.handle()))
+func (self ImGuiTableColumn) SetNavLayerCurrent(v int) {
+	C.ImGuiTableColumn_SetNavLayerCurrent(self.handle(), C.ImS8(v))
 }
 
-func (self ImGuiViewportP) SetLastFrameActive(v int32) {
-	C.ImGuiViewportP_SetLastFrameActive(self.handle(), C.int(v))
+func (self ImGuiTableColumn) GetNavLayerCurrent() int {
+	return int(C.ImGuiTableColumn_GetNavLayerCurrent(self.handle()))
 }
 
-func (self ImGuiViewportP) GetLastFrameActive() int {
-	return int(C.ImGuiViewportP_GetLastFrameActive(self.handle()))
+func (self ImGuiTableColumn) SetAutoFitQueue(v uint) {
+	C.ImGuiTableColumn_SetAutoFitQueue(self.handle(), C.ImU8(v))
 }
 
-func (self ImGuiViewportP) SetLastFrontMostStampCount(v int32) {
-	C.ImGuiViewportP_SetLastFrontMostStampCount(self.handle(), C.int(v))
+func (self ImGuiTableColumn) GetAutoFitQueue() uint32 {
+	return uint32(C.ImGuiTableColumn_GetAutoFitQueue(self.handle()))
 }
 
-func (self ImGuiViewportP) GetLastFrontMostStampCount() int {
-	return int(C.ImGuiViewportP_GetLastFrontMostStampCount(self.handle()))
+func (self ImGuiTableColumn) SetCannotSkipItemsQueue(v uint) {
+	C.ImGuiTableColumn_SetCannotSkipItemsQueue(self.handle(), C.ImU8(v))
 }
 
-func (self ImGuiViewportP) SetLastNameHash(v ImGuiID) {
-	C.ImGuiViewportP_SetLastNameHash(self.handle(), C.ImGuiID(v))
+func (self ImGuiTableColumn) GetCannotSkipItemsQueue() uint32 {
+	return uint32(C.ImGuiTableColumn_GetCannotSkipItemsQueue(self.handle()))
 }
 
-func (self ImGuiViewportP) GetLastNameHash() ImGuiID {
-	return ImGuiID(C.ImGuiViewportP_GetLastNameHash(self.handle()))
+func (self ImGuiTableColumn) SetSortDirection(v uint) {
+	C.ImGuiTableColumn_SetSortDirection(self.handle(), C.ImU8(v))
 }
 
-func (self ImGuiViewportP) SetLastPos(v ImVec2) {
-	C.ImGuiViewportP_SetLastPos(self.handle(), v.toC())
+func (self ImGuiTableColumn) GetSortDirection() uint32 {
+	return uint32(C.ImGuiTableColumn_GetSortDirection(self.handle()))
 }
 
-func (self ImGuiViewportP) GetLastPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewportP_GetLastPos(self.handle()))
+func (self ImGuiTableColumn) SetSortDirectionsAvailCount(v uint) {
+	C.ImGuiTableColumn_SetSortDirectionsAvailCount(self.handle(), C.ImU8(v))
 }
 
-func (self ImGuiViewportP) SetAlpha(v float32) {
-	C.ImGuiViewportP_SetAlpha(self.handle(), C.float(v))
+func (self ImGuiTableColumn) GetSortDirectionsAvailCount() uint32 {
+	return uint32(C.ImGuiTableColumn_GetSortDirectionsAvailCount(self.handle()))
 }
 
-func (self ImGuiViewportP) GetAlpha() float32 {
-	return float32(C.ImGuiViewportP_GetAlpha(self.handle()))
+func (self ImGuiTableColumn) SetSortDirectionsAvailMask(v uint) {
+	C.ImGuiTableColumn_SetSortDirectionsAvailMask(self.handle(), C.ImU8(v))
 }
 
-func (self ImGuiViewportP) SetLastAlpha(v float32) {
-	C.ImGuiViewportP_SetLastAlpha(self.handle(), C.float(v))
+func (self ImGuiTableColumn) GetSortDirectionsAvailMask() uint32 {
+	return uint32(C.ImGuiTableColumn_GetSortDirectionsAvailMask(self.handle()))
 }
 
-func (self ImGuiViewportP) GetLastAlpha() float32 {
-	return float32(C.ImGuiViewportP_GetLastAlpha(self.handle()))
+func (self ImGuiTableColumn) SetSortDirectionsAvailList(v uint) {
+	C.ImGuiTableColumn_SetSortDirectionsAvailList(self.handle(), C.ImU8(v))
 }
 
-func (self ImGuiViewportP) SetPlatformMonitor(v int) {
-	C.ImGuiViewportP_SetPlatformMonitor(self.handle(), C.short(v))
+func (self ImGuiTableColumn) GetSortDirectionsAvailList() uint32 {
+	return uint32(C.ImGuiTableColumn_GetSortDirectionsAvailList(self.handle()))
 }
 
-func (self ImGuiViewportP) GetPlatformMonitor() int {
-	return int(C.ImGuiViewportP_GetPlatformMonitor(self.handle()))
+func (self ImGuiTableCellData) SetBgColor(v uint32) {
+	C.ImGuiTableCellData_SetBgColor(self.handle(), C.ImU32(v))
 }
 
-func (self ImGuiViewportP) SetPlatformWindowCreated(v bool) {
-	C.ImGuiViewportP_SetPlatformWindowCreated(self.handle(), C.bool(v))
+func (self ImGuiTableCellData) GetBgColor() uint32 {
+	return uint32(C.ImGuiTableCellData_GetBgColor(self.handle()))
 }
 
-func (self ImGuiViewportP) GetPlatformWindowCreated() bool {
-	return C.ImGuiViewportP_GetPlatformWindowCreated(self.handle()) == C.bool(true)
+func (self ImGuiTableCellData) SetColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTableCellData_SetColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiViewportP) SetWindow(v ImGuiWindow) {
-	C.ImGuiViewportP_SetWindow(self.handle(), v.handle())
+func (self ImGuiTableCellData) GetColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableCellData_GetColumn(self.handle()))
 }
 
-func (self ImGuiViewportP) GetWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiViewportP_GetWindow(self.handle())))
+func (self ImGuiTableInstanceData) SetLastOuterHeight(v float32) {
+	C.ImGuiTableInstanceData_SetLastOuterHeight(self.handle(), C.float(v))
 }
 
-func (self ImGuiViewportP) GetDrawDataP() ImDrawData {
-	return newImDrawDataFromC(C.ImGuiViewportP_GetDrawDataP(self.handle()))
+func (self ImGuiTableInstanceData) GetLastOuterHeight() float32 {
+	return float32(C.ImGuiTableInstanceData_GetLastOuterHeight(self.handle()))
 }
 
-func (self ImGuiViewportP) GetDrawDataBuilder() ImDrawDataBuilder {
-	return newImDrawDataBuilderFromC(C.ImGuiViewportP_GetDrawDataBuilder(self.handle()))
+func (self ImGuiTableInstanceData) SetLastFirstRowHeight(v float32) {
+	C.ImGuiTableInstanceData_SetLastFirstRowHeight(self.handle(), C.float(v))
 }
 
-func (self ImGuiViewportP) SetLastPlatformPos(v ImVec2) {
-	C.ImGuiViewportP_SetLastPlatformPos(self.handle(), v.toC())
+func (self ImGuiTableInstanceData) GetLastFirstRowHeight() float32 {
+	return float32(C.ImGuiTableInstanceData_GetLastFirstRowHeight(self.handle()))
 }
 
-func (self ImGuiViewportP) GetLastPlatformPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewportP_GetLastPlatformPos(self.handle()))
+func (self ImGuiTable) SetID(v ImGuiID) {
+	C.ImGuiTable_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiViewportP) SetLastPlatformSize(v ImVec2) {
-	C.ImGuiViewportP_SetLastPlatformSize(self.handle(), v.toC())
+func (self ImGuiTable) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiTable_GetID(self.handle()))
 }
 
-func (self ImGuiViewportP) GetLastPlatformSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewportP_GetLastPlatformSize(self.handle()))
+func (self ImGuiTable) SetFlags(v ImGuiTableFlags) {
+	C.ImGuiTable_SetFlags(self.handle(), C.ImGuiTableFlags(v))
 }
 
-func (self ImGuiViewportP) SetLastRendererSize(v ImVec2) {
-	C.ImGuiViewportP_SetLastRendererSize(self.handle(), v.toC())
+func (self ImGuiTable) GetFlags() ImGuiTableFlags {
+	return ImGuiTableFlags(C.ImGuiTable_GetFlags(self.handle()))
 }
 
-func (self ImGuiViewportP) GetLastRendererSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewportP_GetLastRendererSize(self.handle()))
+func (self ImGuiTable) SetRawData(v unsafe.Pointer) {
+	C.ImGuiTable_SetRawData(self.handle(), v)
 }
 
-func (self ImGuiViewportP) SetWorkOffsetMin(v ImVec2) {
-	C.ImGuiViewportP_SetWorkOffsetMin(self.handle(), v.toC())
+func (self ImGuiTable) GetRawData() unsafe.Pointer {
+	return unsafe.Pointer(C.ImGuiTable_GetRawData(self.handle()))
 }
 
-func (self ImGuiViewportP) GetWorkOffsetMin() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewportP_GetWorkOffsetMin(self.handle()))
+func (self ImGuiTable) SetTempData(v ImGuiTableTempData) {
+	C.ImGuiTable_SetTempData(self.handle(), v.handle())
 }
 
-func (self ImGuiViewportP) SetWorkOffsetMax(v ImVec2) {
-	C.ImGuiViewportP_SetWorkOffsetMax(self.handle(), v.toC())
+func (self ImGuiTable) GetTempData() ImGuiTableTempData {
+	return (ImGuiTableTempData)(unsafe.Pointer(C.ImGuiTable_GetTempData(self.handle())))
 }
 
-func (self ImGuiViewportP) GetWorkOffsetMax() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewportP_GetWorkOffsetMax(self.handle()))
+func (self ImGuiTable) SetEnabledMaskByDisplayOrder(v uint64) {
+	C.ImGuiTable_SetEnabledMaskByDisplayOrder(self.handle(), C.ImU64(v))
 }
 
-func (self ImGuiViewportP) SetBuildWorkOffsetMin(v ImVec2) {
-	C.ImGuiViewportP_SetBuildWorkOffsetMin(self.handle(), v.toC())
+func (self ImGuiTable) GetEnabledMaskByDisplayOrder() uint64 {
+	return uint64(C.ImGuiTable_GetEnabledMaskByDisplayOrder(self.handle()))
 }
 
-func (self ImGuiViewportP) GetBuildWorkOffsetMin() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewportP_GetBuildWorkOffsetMin(self.handle()))
+func (self ImGuiTable) SetEnabledMaskByIndex(v uint64) {
+	C.ImGuiTable_SetEnabledMaskByIndex(self.handle(), C.ImU64(v))
 }
 
-func (self ImGuiViewportP) SetBuildWorkOffsetMax(v ImVec2) {
-	C.ImGuiViewportP_SetBuildWorkOffsetMax(self.handle(), v.toC())
+func (self ImGuiTable) GetEnabledMaskByIndex() uint64 {
+	return uint64(C.ImGuiTable_GetEnabledMaskByIndex(self.handle()))
 }
 
-func (self ImGuiViewportP) GetBuildWorkOffsetMax() ImVec2 {
-	return newImVec2FromC(C.ImGuiViewportP_GetBuildWorkOffsetMax(self.handle()))
+func (self ImGuiTable) SetVisibleMaskByIndex(v uint64) {
+	C.ImGuiTable_SetVisibleMaskByIndex(self.handle(), C.ImU64(v))
 }
 
-func (self ImGuiWindowStackData) SetWindow(v ImGuiWindow) {
-	C.ImGuiWindowStackData_SetWindow(self.handle(), v.handle())
+func (self ImGuiTable) GetVisibleMaskByIndex() uint64 {
+	return uint64(C.ImGuiTable_GetVisibleMaskByIndex(self.handle()))
 }
 
-func (self ImGuiWindowStackData) GetWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiWindowStackData_GetWindow(self.handle())))
+func (self ImGuiTable) SetRequestOutputMaskByIndex(v uint64) {
+	C.ImGuiTable_SetRequestOutputMaskByIndex(self.handle(), C.ImU64(v))
 }
 
-func (self ImGuiWindowStackData) GetParentLastItemDataBackup() ImGuiLastItemData {
-	return newImGuiLastItemDataFromC(C.ImGuiWindowStackData_GetParentLastItemDataBackup(self.handle()))
+func (self ImGuiTable) GetRequestOutputMaskByIndex() uint64 {
+	return uint64(C.ImGuiTable_GetRequestOutputMaskByIndex(self.handle()))
 }
 
-func (self ImGuiWindowStackData) GetStackSizesOnBegin() ImGuiStackSizes {
-	return newImGuiStackSizesFromC(C.ImGuiWindowStackData_GetStackSizesOnBegin(self.handle()))
+func (self ImGuiTable) SetSettingsLoadedFlags(v ImGuiTableFlags) {
+	C.ImGuiTable_SetSettingsLoadedFlags(self.handle(), C.ImGuiTableFlags(v))
 }
 
-func (self ImGuiPopupData) SetPopupId(v ImGuiID) {
-	C.ImGuiPopupData_SetPopupId(self.handle(), C.ImGuiID(v))
+func (self ImGuiTable) GetSettingsLoadedFlags() ImGuiTableFlags {
+	return ImGuiTableFlags(C.ImGuiTable_GetSettingsLoadedFlags(self.handle()))
 }
 
-func (self ImGuiPopupData) GetPopupId() ImGuiID {
-	return ImGuiID(C.ImGuiPopupData_GetPopupId(self.handle()))
+func (self ImGuiTable) SetSettingsOffset(v int32) {
+	C.ImGuiTable_SetSettingsOffset(self.handle(), C.int(v))
 }
 
-func (self ImGuiPopupData) SetWindow(v ImGuiWindow) {
-	C.ImGuiPopupData_SetWindow(self.handle(), v.handle())
+func (self ImGuiTable) GetSettingsOffset() int {
+	return int(C.ImGuiTable_GetSettingsOffset(self.handle()))
 }
 
-func (self ImGuiPopupData) GetWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiPopupData_GetWindow(self.handle())))
+func (self ImGuiTable) SetLastFrameActive(v int32) {
+	C.ImGuiTable_SetLastFrameActive(self.handle(), C.int(v))
 }
 
-func (self ImGuiPopupData) SetSourceWindow(v ImGuiWindow) {
-	C.ImGuiPopupData_SetSourceWindow(self.handle(), v.handle())
+func (self ImGuiTable) GetLastFrameActive() int {
+	return int(C.ImGuiTable_GetLastFrameActive(self.handle()))
 }
 
-func (self ImGuiPopupData) GetSourceWindow() ImGuiWindow {
-	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiPopupData_GetSourceWindow(self.handle())))
+func (self ImGuiTable) SetColumnsCount(v int32) {
+	C.ImGuiTable_SetColumnsCount(self.handle(), C.int(v))
 }
 
-func (self ImGuiPopupData) SetParentNavLayer(v int32) {
-	C.ImGuiPopupData_SetParentNavLayer(self.handle(), C.int(v))
+func (self ImGuiTable) GetColumnsCount() int {
+	return int(C.ImGuiTable_GetColumnsCount(self.handle()))
 }
 
-func (self ImGuiPopupData) GetParentNavLayer() int {
-	return int(C.ImGuiPopupData_GetParentNavLayer(self.handle()))
+func (self ImGuiTable) SetCurrentRow(v int32) {
+	C.ImGuiTable_SetCurrentRow(self.handle(), C.int(v))
 }
 
-func (self ImGuiPopupData) SetOpenFrameCount(v int32) {
-	C.ImGuiPopupData_SetOpenFrameCount(self.handle(), C.int(v))
+func (self ImGuiTable) GetCurrentRow() int {
+	return int(C.ImGuiTable_GetCurrentRow(self.handle()))
 }
 
-func (self ImGuiPopupData) GetOpenFrameCount() int {
-	return int(C.ImGuiPopupData_GetOpenFrameCount(self.handle()))
+func (self ImGuiTable) SetCurrentColumn(v int32) {
+	C.ImGuiTable_SetCurrentColumn(self.handle(), C.int(v))
 }
 
-func (self ImGuiPopupData) SetOpenParentId(v ImGuiID) {
-	C.ImGuiPopupData_SetOpenParentId(self.handle(), C.ImGuiID(v))
+func (self ImGuiTable) GetCurrentColumn() int {
+	return int(C.ImGuiTable_GetCurrentColumn(self.handle()))
 }
 
-func (self ImGuiPopupData) GetOpenParentId() ImGuiID {
-	return ImGuiID(C.ImGuiPopupData_GetOpenParentId(self.handle()))
+func (self ImGuiTable) SetInstanceCurrent(v int) {
+	C.ImGuiTable_SetInstanceCurrent(self.handle(), C.ImS16(v))
 }
 
-func (self ImGuiPopupData) SetOpenPopupPos(v ImVec2) {
-	C.ImGuiPopupData_SetOpenPopupPos(self.handle(), v.toC())
+func (self ImGuiTable) GetInstanceCurrent() int {
+	return int(C.ImGuiTable_GetInstanceCurrent(self.handle()))
 }
 
-func (self ImGuiPopupData) GetOpenPopupPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiPopupData_GetOpenPopupPos(self.handle()))
+func (self ImGuiTable) SetInstanceInteracted(v int) {
+	C.ImGuiTable_SetInstanceInteracted(self.handle(), C.ImS16(v))
 }
 
-func (self ImGuiPopupData) SetOpenMousePos(v ImVec2) {
-	C.ImGuiPopupData_SetOpenMousePos(self.handle(), v.toC())
+func (self ImGuiTable) GetInstanceInteracted() int {
+	return int(C.ImGuiTable_GetInstanceInteracted(self.handle()))
 }
 
-func (self ImGuiPopupData) GetOpenMousePos() ImVec2 {
-	return newImVec2FromC(C.ImGuiPopupData_GetOpenMousePos(self.handle()))
+func (self ImGuiTable) SetRowPosY1(v float32) {
+	C.ImGuiTable_SetRowPosY1(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetCursorPos(v ImVec2) {
-	C.ImGuiWindowTempData_SetCursorPos(self.handle(), v.toC())
+func (self ImGuiTable) GetRowPosY1() float32 {
+	return float32(C.ImGuiTable_GetRowPosY1(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetCursorPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindowTempData_GetCursorPos(self.handle()))
+func (self ImGuiTable) SetRowPosY2(v float32) {
+	C.ImGuiTable_SetRowPosY2(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetCursorPosPrevLine(v ImVec2) {
-	C.ImGuiWindowTempData_SetCursorPosPrevLine(self.handle(), v.toC())
+func (self ImGuiTable) GetRowPosY2() float32 {
+	return float32(C.ImGuiTable_GetRowPosY2(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetCursorPosPrevLine() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindowTempData_GetCursorPosPrevLine(self.handle()))
+func (self ImGuiTable) SetRowMinHeight(v float32) {
+	C.ImGuiTable_SetRowMinHeight(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetCursorStartPos(v ImVec2) {
-	C.ImGuiWindowTempData_SetCursorStartPos(self.handle(), v.toC())
+func (self ImGuiTable) GetRowMinHeight() float32 {
+	return float32(C.ImGuiTable_GetRowMinHeight(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetCursorStartPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindowTempData_GetCursorStartPos(self.handle()))
+func (self ImGuiTable) SetRowTextBaseline(v float32) {
+	C.ImGuiTable_SetRowTextBaseline(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetCursorMaxPos(v ImVec2) {
-	C.ImGuiWindowTempData_SetCursorMaxPos(self.handle(), v.toC())
+func (self ImGuiTable) GetRowTextBaseline() float32 {
+	return float32(C.ImGuiTable_GetRowTextBaseline(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetCursorMaxPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindowTempData_GetCursorMaxPos(self.handle()))
+func (self ImGuiTable) SetRowIndentOffsetX(v float32) {
+	C.ImGuiTable_SetRowIndentOffsetX(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetIdealMaxPos(v ImVec2) {
-	C.ImGuiWindowTempData_SetIdealMaxPos(self.handle(), v.toC())
+func (self ImGuiTable) GetRowIndentOffsetX() float32 {
+	return float32(C.ImGuiTable_GetRowIndentOffsetX(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetIdealMaxPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindowTempData_GetIdealMaxPos(self.handle()))
+func (self ImGuiTable) SetRowFlags(v ImGuiTableRowFlags) {
+	C.ImGuiTable_SetRowFlags(self.handle(), C.ImGuiTableRowFlags(v))
 }
 
-func (self ImGuiWindowTempData) SetCurrLineSize(v ImVec2) {
-	C.ImGuiWindowTempData_SetCurrLineSize(self.handle(), v.toC())
+func (self ImGuiTable) GetRowFlags() ImGuiTableRowFlags {
+	return ImGuiTableRowFlags(C.ImGuiTable_GetRowFlags(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetCurrLineSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindowTempData_GetCurrLineSize(self.handle()))
+func (self ImGuiTable) SetLastRowFlags(v ImGuiTableRowFlags) {
+	C.ImGuiTable_SetLastRowFlags(self.handle(), C.ImGuiTableRowFlags(v))
 }
 
-func (self ImGuiWindowTempData) SetPrevLineSize(v ImVec2) {
-	C.ImGuiWindowTempData_SetPrevLineSize(self.handle(), v.toC())
+func (self ImGuiTable) GetLastRowFlags() ImGuiTableRowFlags {
+	return ImGuiTableRowFlags(C.ImGuiTable_GetLastRowFlags(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetPrevLineSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindowTempData_GetPrevLineSize(self.handle()))
+func (self ImGuiTable) SetRowBgColorCounter(v int32) {
+	C.ImGuiTable_SetRowBgColorCounter(self.handle(), C.int(v))
 }
 
-func (self ImGuiWindowTempData) SetCurrLineTextBaseOffset(v float32) {
-	C.ImGuiWindowTempData_SetCurrLineTextBaseOffset(self.handle(), C.float(v))
+func (self ImGuiTable) GetRowBgColorCounter() int {
+	return int(C.ImGuiTable_GetRowBgColorCounter(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetCurrLineTextBaseOffset() float32 {
-	return float32(C.ImGuiWindowTempData_GetCurrLineTextBaseOffset(self.handle()))
+func (self ImGuiTable) SetBorderColorStrong(v uint32) {
+	C.ImGuiTable_SetBorderColorStrong(self.handle(), C.ImU32(v))
 }
 
-func (self ImGuiWindowTempData) SetPrevLineTextBaseOffset(v float32) {
-	C.ImGuiWindowTempData_SetPrevLineTextBaseOffset(self.handle(), C.float(v))
+func (self ImGuiTable) GetBorderColorStrong() uint32 {
+	return uint32(C.ImGuiTable_GetBorderColorStrong(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetPrevLineTextBaseOffset() float32 {
-	return float32(C.ImGuiWindowTempData_GetPrevLineTextBaseOffset(self.handle()))
+func (self ImGuiTable) SetBorderColorLight(v uint32) {
+	C.ImGuiTable_SetBorderColorLight(self.handle(), C.ImU32(v))
 }
 
-func (self ImGuiWindowTempData) SetIsSameLine(v bool) {
-	C.ImGuiWindowTempData_SetIsSameLine(self.handle(), C.bool(v))
+func (self ImGuiTable) GetBorderColorLight() uint32 {
+	return uint32(C.ImGuiTable_GetBorderColorLight(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetIsSameLine() bool {
-	return C.ImGuiWindowTempData_GetIsSameLine(self.handle()) == C.bool(true)
+func (self ImGuiTable) SetBorderX1(v float32) {
+	C.ImGuiTable_SetBorderX1(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetCursorStartPosLossyness(v ImVec2) {
-	C.ImGuiWindowTempData_SetCursorStartPosLossyness(self.handle(), v.toC())
+func (self ImGuiTable) GetBorderX1() float32 {
+	return float32(C.ImGuiTable_GetBorderX1(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetCursorStartPosLossyness() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindowTempData_GetCursorStartPosLossyness(self.handle()))
+func (self ImGuiTable) SetBorderX2(v float32) {
+	C.ImGuiTable_SetBorderX2(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetNavLayerCurrent(v ImGuiNavLayer) {
-	C.ImGuiWindowTempData_SetNavLayerCurrent(self.handle(), C.ImGuiNavLayer(v))
+func (self ImGuiTable) GetBorderX2() float32 {
+	return float32(C.ImGuiTable_GetBorderX2(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetNavLayerCurrent() ImGuiNavLayer {
-	return ImGuiNavLayer(C.ImGuiWindowTempData_GetNavLayerCurrent(self.handle()))
+func (self ImGuiTable) SetHostIndentX(v float32) {
+	C.ImGuiTable_SetHostIndentX(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetNavLayersActiveMask(v int) {
-	C.ImGuiWindowTempData_SetNavLayersActiveMask(self.handle(), C.short(v))
+func (self ImGuiTable) GetHostIndentX() float32 {
+	return float32(C.ImGuiTable_GetHostIndentX(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetNavLayersActiveMask() int {
-	return int(C.ImGuiWindowTempData_GetNavLayersActiveMask(self.handle()))
+func (self ImGuiTable) SetMinColumnWidth(v float32) {
+	C.ImGuiTable_SetMinColumnWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetNavLayersActiveMaskNext(v int) {
-	C.ImGuiWindowTempData_SetNavLayersActiveMaskNext(self.handle(), C.short(v))
+func (self ImGuiTable) GetMinColumnWidth() float32 {
+	return float32(C.ImGuiTable_GetMinColumnWidth(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetNavLayersActiveMaskNext() int {
-	return int(C.ImGuiWindowTempData_GetNavLayersActiveMaskNext(self.handle()))
+func (self ImGuiTable) SetOuterPaddingX(v float32) {
+	C.ImGuiTable_SetOuterPaddingX(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetNavFocusScopeIdCurrent(v ImGuiID) {
-	C.ImGuiWindowTempData_SetNavFocusScopeIdCurrent(self.handle(), C.ImGuiID(v))
+func (self ImGuiTable) GetOuterPaddingX() float32 {
+	return float32(C.ImGuiTable_GetOuterPaddingX(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetNavFocusScopeIdCurrent() ImGuiID {
-	return ImGuiID(C.ImGuiWindowTempData_GetNavFocusScopeIdCurrent(self.handle()))
+func (self ImGuiTable) SetCellPaddingX(v float32) {
+	C.ImGuiTable_SetCellPaddingX(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetNavHideHighlightOneFrame(v bool) {
-	C.ImGuiWindowTempData_SetNavHideHighlightOneFrame(self.handle(), C.bool(v))
+func (self ImGuiTable) GetCellPaddingX() float32 {
+	return float32(C.ImGuiTable_GetCellPaddingX(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetNavHideHighlightOneFrame() bool {
-	return C.ImGuiWindowTempData_GetNavHideHighlightOneFrame(self.handle()) == C.bool(true)
+func (self ImGuiTable) SetCellPaddingY(v float32) {
+	C.ImGuiTable_SetCellPaddingY(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetNavHasScroll(v bool) {
-	C.ImGuiWindowTempData_SetNavHasScroll(self.handle(), C.bool(v))
+func (self ImGuiTable) GetCellPaddingY() float32 {
+	return float32(C.ImGuiTable_GetCellPaddingY(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetNavHasScroll() bool {
-	return C.ImGuiWindowTempData_GetNavHasScroll(self.handle()) == C.bool(true)
+func (self ImGuiTable) SetCellSpacingX1(v float32) {
+	C.ImGuiTable_SetCellSpacingX1(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetMenuBarAppending(v bool) {
-	C.ImGuiWindowTempData_SetMenuBarAppending(self.handle(), C.bool(v))
+func (self ImGuiTable) GetCellSpacingX1() float32 {
+	return float32(C.ImGuiTable_GetCellSpacingX1(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetMenuBarAppending() bool {
-	return C.ImGuiWindowTempData_GetMenuBarAppending(self.handle()) == C.bool(true)
+func (self ImGuiTable) SetCellSpacingX2(v float32) {
+	C.ImGuiTable_SetCellSpacingX2(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) SetMenuBarOffset(v ImVec2) {
-	C.ImGuiWindowTempData_SetMenuBarOffset(self.handle(), v.toC())
+func (self ImGuiTable) GetCellSpacingX2() float32 {
+	return float32(C.ImGuiTable_GetCellSpacingX2(self.handle()))
 }
 
-func (self ImGuiWindowTempData) GetMenuBarOffset() ImVec2 {
-	return newImVec2FromC(C.ImGuiWindowTempData_GetMenuBarOffset(self.handle()))
+func (self ImGuiTable) SetInnerWidth(v float32) {
+	C.ImGuiTable_SetInnerWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) GetMenuColumns() ImGuiMenuColumns {
-	return newImGuiMenuColumnsFromC(C.ImGuiWindowTempData_GetMenuColumns(self.handle()))
+func (self ImGuiTable) GetInnerWidth() float32 {
+	return float32(C.ImGuiTable_GetInnerWidth(self.handle()))
 }
 
-func (self ImGuiWindowTempData) SetTreeDepth(v int32) {
-	C.ImGuiWindowTempData_SetTreeDepth(self.handle(), C.int(v))
+func (self ImGuiTable) SetColumnsGivenWidth(v float32) {
+	C.ImGuiTable_SetColumnsGivenWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) GetTreeDepth() int {
-	return int(C.ImGuiWindowTempData_GetTreeDepth(self.handle()))
+func (self ImGuiTable) GetColumnsGivenWidth() float32 {
+	return float32(C.ImGuiTable_GetColumnsGivenWidth(self.handle()))
 }
 
-func (self ImGuiWindowTempData) SetTreeJumpToParentOnPopMask(v uint32) {
-	C.ImGuiWindowTempData_SetTreeJumpToParentOnPopMask(self.handle(), C.ImU32(v))
+func (self ImGuiTable) SetColumnsAutoFitWidth(v float32) {
+	C.ImGuiTable_SetColumnsAutoFitWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) GetTreeJumpToParentOnPopMask() uint32 {
-	return uint32(C.ImGuiWindowTempData_GetTreeJumpToParentOnPopMask(self.handle()))
+func (self ImGuiTable) GetColumnsAutoFitWidth() float32 {
+	return float32(C.ImGuiTable_GetColumnsAutoFitWidth(self.handle()))
 }
 
-func (self ImGuiWindowTempData) SetStateStorage(v ImGuiStorage) {
-	C.ImGuiWindowTempData_SetStateStorage(self.handle(), v.handle())
+func (self ImGuiTable) SetColumnsStretchSumWeights(v float32) {
+	C.ImGuiTable_SetColumnsStretchSumWeights(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) GetStateStorage() ImGuiStorage {
-	return (ImGuiStorage)(unsafe.Pointer(C.ImGuiWindowTempData_GetStateStorage(self.handle())))
+func (self ImGuiTable) GetColumnsStretchSumWeights() float32 {
+	return float32(C.ImGuiTable_GetColumnsStretchSumWeights(self.handle()))
 }
 
-func (self ImGuiWindowTempData) SetCurrentColumns(v ImGuiOldColumns) {
-	C.ImGuiWindowTempData_SetCurrentColumns(self.handle(), v.handle())
+func (self ImGuiTable) SetResizedColumnNextWidth(v float32) {
+	C.ImGuiTable_SetResizedColumnNextWidth(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) GetCurrentColumns() ImGuiOldColumns {
-	return (ImGuiOldColumns)(unsafe.Pointer(C.ImGuiWindowTempData_GetCurrentColumns(self.handle())))
+func (self ImGuiTable) GetResizedColumnNextWidth() float32 {
+	return float32(C.ImGuiTable_GetResizedColumnNextWidth(self.handle()))
 }
 
-func (self ImGuiWindowTempData) SetCurrentTableIdx(v int32) {
-	C.ImGuiWindowTempData_SetCurrentTableIdx(self.handle(), C.int(v))
+func (self ImGuiTable) SetResizeLockMinContentsX2(v float32) {
+	C.ImGuiTable_SetResizeLockMinContentsX2(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) GetCurrentTableIdx() int {
-	return int(C.ImGuiWindowTempData_GetCurrentTableIdx(self.handle()))
+func (self ImGuiTable) GetResizeLockMinContentsX2() float32 {
+	return float32(C.ImGuiTable_GetResizeLockMinContentsX2(self.handle()))
 }
 
-func (self ImGuiWindowTempData) SetLayoutType(v ImGuiLayoutType) {
-	C.ImGuiWindowTempData_SetLayoutType(self.handle(), C.ImGuiLayoutType(v))
+func (self ImGuiTable) SetRefScale(v float32) {
+	C.ImGuiTable_SetRefScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiWindowTempData) GetLayoutType() ImGuiLayoutType {
-	return ImGuiLayoutType(C.ImGuiWindowTempData_GetLayoutType(self.handle()))
+func (self ImGuiTable) GetRefScale() float32 {
+	return float32(C.ImGuiTable_GetRefScale(self.handle()))
 }
 
-func (self ImGuiWindowTempData) SetParentLayoutType(v ImGuiLayoutType) {
-	C.ImGuiWindowTempData_SetParentLayoutType(self.handle(), C.ImGuiLayoutType(v))
+func (self ImGuiTable) SetOuterRect(v ImRect) {
+	C.ImGuiTable_SetOuterRect(self.handle(), v.toC())
 }
 
-func (self ImGuiWindowTempData) GetParentLayoutType() ImGuiLayoutType {
-	return ImGuiLayoutType(C.ImGuiWindowTempData_GetParentLayoutType(self.handle()))
+func (self ImGuiTable) GetOuterRect() ImRect {
+	return newImRectFromC(C.ImGuiTable_GetOuterRect(self.handle()))
 }
 
-func (self ImGuiWindowTempData) SetItemWidth(v float32) {
-	C.ImGuiWindowTempData_SetItemWidth(self.handle(), C.float(v))
+func (self ImGuiTable) SetInnerRect(v ImRect) {
+	C.ImGuiTable_SetInnerRect(self.handle(), v.toC())
 }
 
-func (self ImGuiWindowTempData) GetItemWidth() float32 {
-	return float32(C.ImGuiWindowTempData_GetItemWidth(self.handle()))
+func (self ImGuiTable) GetInnerRect() ImRect {
+	return newImRectFromC(C.ImGuiTable_GetInnerRect(self.handle()))
 }
 
-func (self ImGuiWindowTempData) SetTextWrapPos(v float32) {
-	C.ImGuiWindowTempData_SetTextWrapPos(self.handle(), C.float(v))
+func (self ImGuiTable) SetWorkRect(v ImRect) {
+	C.ImGuiTable_SetWorkRect(self.handle(), v.toC())
 }
 
-func (self ImGuiWindowTempData) GetTextWrapPos() float32 {
-	return float32(C.ImGuiWindowTempData_GetTextWrapPos(self.handle()))
+func (self ImGuiTable) GetWorkRect() ImRect {
+	return newImRectFromC(C.ImGuiTable_GetWorkRect(self.handle()))
 }
 
-func (self ImGuiListClipper) SetDisplayStart(v int32) {
-	C.ImGuiListClipper_SetDisplayStart(self.handle(), C.int(v))
+func (self ImGuiTable) SetInnerClipRect(v ImRect) {
+	C.ImGuiTable_SetInnerClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiListClipper) GetDisplayStart() int {
-	return int(C.ImGuiListClipper_GetDisplayStart(self.handle()))
+func (self ImGuiTable) GetInnerClipRect() ImRect {
+	return newImRectFromC(C.ImGuiTable_GetInnerClipRect(self.handle()))
 }
 
-func (self ImGuiListClipper) SetDisplayEnd(v int32) {
-	C.ImGuiListClipper_SetDisplayEnd(self.handle(), C.int(v))
+func (self ImGuiTable) SetBgClipRect(v ImRect) {
+	C.ImGuiTable_SetBgClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiListClipper) GetDisplayEnd() int {
-	return int(C.ImGuiListClipper_GetDisplayEnd(self.handle()))
+func (self ImGuiTable) GetBgClipRect() ImRect {
+	return newImRectFromC(C.ImGuiTable_GetBgClipRect(self.handle()))
 }
 
-func (self ImGuiListClipper) SetItemsCount(v int32) {
-	C.ImGuiListClipper_SetItemsCount(self.handle(), C.int(v))
+func (self ImGuiTable) SetBg0ClipRectForDrawCmd(v ImRect) {
+	C.ImGuiTable_SetBg0ClipRectForDrawCmd(self.handle(), v.toC())
 }
 
-func (self ImGuiListClipper) GetItemsCount() int {
-	return int(C.ImGuiListClipper_GetItemsCount(self.handle()))
+func (self ImGuiTable) GetBg0ClipRectForDrawCmd() ImRect {
+	return newImRectFromC(C.ImGuiTable_GetBg0ClipRectForDrawCmd(self.handle()))
 }
 
-func (self ImGuiListClipper) SetItemsHeight(v float32) {
-	C.ImGuiListClipper_SetItemsHeight(self.handle(), C.float(v))
+func (self ImGuiTable) SetBg2ClipRectForDrawCmd(v ImRect) {
+	C.ImGuiTable_SetBg2ClipRectForDrawCmd(self.handle(), v.toC())
 }
 
-func (self ImGuiListClipper) GetItemsHeight() float32 {
-	return float32(C.ImGuiListClipper_GetItemsHeight(self.handle()))
+func (self ImGuiTable) GetBg2ClipRectForDrawCmd() ImRect {
+	return newImRectFromC(C.ImGuiTable_GetBg2ClipRectForDrawCmd(self.handle()))
 }
 
-func (self ImGuiListClipper) SetStartPosY(v float32) {
-	C.ImGuiListClipper_SetStartPosY(self.handle(), C.float(v))
+func (self ImGuiTable) SetHostClipRect(v ImRect) {
+	C.ImGuiTable_SetHostClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiListClipper) GetStartPosY() float32 {
-	return float32(C.ImGuiListClipper_GetStartPosY(self.handle()))
+func (self ImGuiTable) GetHostClipRect() ImRect {
+	return newImRectFromC(C.ImGuiTable_GetHostClipRect(self.handle()))
 }
 
-func (self ImGuiListClipper) SetTempData(v unsafe.Pointer) {
-	C.ImGuiListClipper_SetTempData(self.handle(), v)
+func (self ImGuiTable) SetHostBackupInnerClipRect(v ImRect) {
+	C.ImGuiTable_SetHostBackupInnerClipRect(self.handle(), v.toC())
 }
 
-func (self ImGuiListClipper) GetTempData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiListClipper_GetTempData(self.handle()))
+func (self ImGuiTable) GetHostBackupInnerClipRect() ImRect {
+	return newImRectFromC(C.ImGuiTable_GetHostBackupInnerClipRect(self.handle()))
 }
 
-func (self ImDrawListSplitter) Set_Current(v int32) {
-	C.ImDrawListSplitter_Set_Current(self.handle(), C.int(v))
+func (self ImGuiTable) SetOuterWindow(v ImGuiWindow) {
+	C.ImGuiTable_SetOuterWindow(self.handle(), v.handle())
 }
 
-func (self ImDrawListSplitter) Get_Current() int {
-	return int(C.ImDrawListSplitter_Get_Current(self.handle()))
+func (self ImGuiTable) GetOuterWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiTable_GetOuterWindow(self.handle())))
 }
 
-func (self ImDrawListSplitter) Set_Count(v int32) {
-	C.ImDrawListSplitter_Set_Count(self.handle(), C.int(v))
+func (self ImGuiTable) SetInnerWindow(v ImGuiWindow) {
+	C.ImGuiTable_SetInnerWindow(self.handle(), v.handle())
 }
 
-func (self ImDrawListSplitter) Get_Count() int {
-	return int(C.ImDrawListSplitter_Get_Count(self.handle()))
+func (self ImGuiTable) GetInnerWindow() ImGuiWindow {
+	return (ImGuiWindow)(unsafe.Pointer(C.ImGuiTable_GetInnerWindow(self.handle())))
 }
 
-func (self ImFont) SetFallbackAdvanceX(v float32) {
-	C.ImFont_SetFallbackAdvanceX(self.handle(), C.float(v))
+func (self ImGuiTable) GetColumnsNames() ImGuiTextBuffer {
+	return newImGuiTextBufferFromC(C.ImGuiTable_GetColumnsNames(self.handle()))
 }
 
-func (self ImFont) GetFallbackAdvanceX() float32 {
-	return float32(C.ImFont_GetFallbackAdvanceX(self.handle()))
+func (self ImGuiTable) SetDrawSplitter(v ImDrawListSplitter) {
+	C.ImGuiTable_SetDrawSplitter(self.handle(), v.handle())
 }
 
-func (self ImFont) SetFontSize(v float32) {
-	C.ImFont_SetFontSize(self.handle(), C.float(v))
+func (self ImGuiTable) GetDrawSplitter() ImDrawListSplitter {
+	return (ImDrawListSplitter)(unsafe.Pointer(C.ImGuiTable_GetDrawSplitter(self.handle())))
 }
 
-func (self ImFont) GetFontSize() float32 {
-	return float32(C.ImFont_GetFontSize(self.handle()))
+func (self ImGuiTable) GetInstanceDataFirst() ImGuiTableInstanceData {
+	return newImGuiTableInstanceDataFromC(C.ImGuiTable_GetInstanceDataFirst(self.handle()))
 }
 
-func (self ImFont) SetFallbackGlyph(v ImFontGlyph) {
-	C.ImFont_SetFallbackGlyph(self.handle(), v.handle())
+func (self ImGuiTable) GetSortSpecsSingle() ImGuiTableColumnSortSpecs {
+	return newImGuiTableColumnSortSpecsFromC(C.ImGuiTable_GetSortSpecsSingle(self.handle()))
 }
 
-func (self ImFont) GetFallbackGlyph() ImFontGlyph {
-	return (ImFontGlyph)(unsafe.Pointer(C.ImFont_GetFallbackGlyph(self.handle())))
+func (self ImGuiTable) GetSortSpecs() ImGuiTableSortSpecs {
+	return newImGuiTableSortSpecsFromC(C.ImGuiTable_GetSortSpecs(self.handle()))
 }
 
-func (self ImFont) SetContainerAtlas(v ImFontAtlas) {
-	C.ImFont_SetContainerAtlas(self.handle(), v.handle())
+func (self ImGuiTable) SetSortSpecsCount(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetSortSpecsCount(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImFont) GetContainerAtlas() ImFontAtlas {
-	return (ImFontAtlas)(unsafe.Pointer(C.ImFont_GetContainerAtlas(self.handle())))
+func (self ImGuiTable) GetSortSpecsCount() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetSortSpecsCount(self.handle()))
 }
 
-func (self ImFont) SetConfigData(v ImFontConfig) {
-	C.ImFont_SetConfigData(self.handle(), v.handle())
+func (self ImGuiTable) SetColumnsEnabledCount(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetColumnsEnabledCount(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImFont) GetConfigData() ImFontConfig {
-	return (ImFontConfig)(unsafe.Pointer(C.ImFont_GetConfigData(self.handle())))
+func (self ImGuiTable) GetColumnsEnabledCount() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetColumnsEnabledCount(self.handle()))
 }
 
-func (self ImFont) SetConfigDataCount(v int) {
-	C.ImFont_SetConfigDataCount(self.handle(), C.short(v))
+func (self ImGuiTable) SetColumnsEnabledFixedCount(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetColumnsEnabledFixedCount(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImFont) GetConfigDataCount() int {
-	return int(C.ImFont_GetConfigDataCount(self.handle()))
+func (self ImGuiTable) GetColumnsEnabledFixedCount() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetColumnsEnabledFixedCount(self.handle()))
 }
 
-func (self ImFont) SetFallbackChar(v ImWchar) {
-	C.ImFont_SetFallbackChar(self.handle(), C.ImWchar(v))
+func (self ImGuiTable) SetDeclColumnsCount(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetDeclColumnsCount(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImFont) SetEllipsisChar(v ImWchar) {
-	C.ImFont_SetEllipsisChar(self.handle(), C.ImWchar(v))
+func (self ImGuiTable) GetDeclColumnsCount() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetDeclColumnsCount(self.handle()))
 }
 
-func (self ImFont) SetDotChar(v ImWchar) {
-	C.ImFont_SetDotChar(self.handle(), C.ImWchar(v))
+func (self ImGuiTable) SetHoveredColumnBody(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetHoveredColumnBody(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImFont) SetDirtyLookupTables(v bool) {
-	C.ImFont_SetDirtyLookupTables(self.handle(), C.bool(v))
+func (self ImGuiTable) GetHoveredColumnBody() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetHoveredColumnBody(self.handle()))
 }
 
-func (self ImFont) GetDirtyLookupTables() bool {
-	return C.ImFont_GetDirtyLookupTables(self.handle()) == C.bool(true)
+func (self ImGuiTable) SetHoveredColumnBorder(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetHoveredColumnBorder(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImFont) SetScale(v float32) {
-	C.ImFont_SetScale(self.handle(), C.float(v))
+func (self ImGuiTable) GetHoveredColumnBorder() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetHoveredColumnBorder(self.handle()))
 }
 
-func (self ImFont) GetScale() float32 {
-	return float32(C.ImFont_GetScale(self.handle()))
+func (self ImGuiTable) SetAutoFitSingleColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetAutoFitSingleColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImFont) SetAscent(v float32) {
-	C.ImFont_SetAscent(self.handle(), C.float(v))
+func (self ImGuiTable) GetAutoFitSingleColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetAutoFitSingleColumn(self.handle()))
 }
 
-func (self ImFont) GetAscent() float32 {
-	return float32(C.ImFont_GetAscent(self.handle()))
+func (self ImGuiTable) SetResizedColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetResizedColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImFont) SetDescent(v float32) {
-	C.ImFont_SetDescent(self.handle(), C.float(v))
+func (self ImGuiTable) GetResizedColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetResizedColumn(self.handle()))
 }
 
-func (self ImFont) GetDescent() float32 {
-	return float32(C.ImFont_GetDescent(self.handle()))
+func (self ImGuiTable) SetLastResizedColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetLastResizedColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImFont) SetMetricsTotalSurface(v int32) {
-	C.ImFont_SetMetricsTotalSurface(self.handle(), C.int(v))
+func (self ImGuiTable) GetLastResizedColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetLastResizedColumn(self.handle()))
 }
 
-func (self ImFont) GetMetricsTotalSurface() int {
-	return int(C.ImFont_GetMetricsTotalSurface(self.handle()))
+func (self ImGuiTable) SetHeldHeaderColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetHeldHeaderColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiDataTypeInfo) SetSize(v uint64) {
-	C.ImGuiDataTypeInfo_SetSize(self.handle(), C.xlong(v))
+func (self ImGuiTable) GetHeldHeaderColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetHeldHeaderColumn(self.handle()))
 }
 
-func (self ImGuiDataTypeInfo) GetSize() float64 {
-	return float64(C.ImGuiDataTypeInfo_GetSize(self.handle()))
+func (self ImGuiTable) SetReorderColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetReorderColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiDataTypeInfo) SetName(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiTable) GetReorderColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetReorderColumn(self.handle()))
+}
 
-	C.ImGuiDataTypeInfo_SetName(self.handle(), vArg)
+func (self ImGuiTable) SetReorderColumnDir(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetReorderColumnDir(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiDataTypeInfo) GetName() string {
-	return C.GoString(C.ImGuiDataTypeInfo_GetName(self.handle()))
+func (self ImGuiTable) GetReorderColumnDir() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetReorderColumnDir(self.handle()))
 }
 
-func (self ImGuiDataTypeInfo) SetPrintFmt(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiTable) SetLeftMostEnabledColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetLeftMostEnabledColumn(self.handle(), C.ImGuiTableColumnIdx(v))
+}
 
-	C.ImGuiDataTypeInfo_SetPrintFmt(self.handle(), vArg)
+func (self ImGuiTable) GetLeftMostEnabledColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetLeftMostEnabledColumn(self.handle()))
 }
 
-func (self ImGuiDataTypeInfo) GetPrintFmt() string {
-	return C.GoString(C.ImGuiDataTypeInfo_GetPrintFmt(self.handle()))
+func (self ImGuiTable) SetRightMostEnabledColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetRightMostEnabledColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiDataTypeInfo) SetScanFmt(v string) {
-	vArg, vFin := wrapString(v)
-	defer vFin()
+func (self ImGuiTable) GetRightMostEnabledColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetRightMostEnabledColumn(self.handle()))
+}
 
-	C.ImGuiDataTypeInfo_SetScanFmt(self.handle(), vArg)
+func (self ImGuiTable) SetLeftMostStretchedColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetLeftMostStretchedColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiDataTypeInfo) GetScanFmt() string {
-	return C.GoString(C.ImGuiDataTypeInfo_GetScanFmt(self.handle()))
+func (self ImGuiTable) GetLeftMostStretchedColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetLeftMostStretchedColumn(self.handle()))
 }
 
-func (self ImGuiGroupData) SetWindowID(v ImGuiID) {
-	C.ImGuiGroupData_SetWindowID(self.handle(), C.ImGuiID(v))
+func (self ImGuiTable) SetRightMostStretchedColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetRightMostStretchedColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiGroupData) GetWindowID() ImGuiID {
-	return ImGuiID(C.ImGuiGroupData_GetWindowID(self.handle()))
+func (self ImGuiTable) GetRightMostStretchedColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetRightMostStretchedColumn(self.handle()))
 }
 
-func (self ImGuiGroupData) SetBackupCursorPos(v ImVec2) {
-	C.ImGuiGroupData_SetBackupCursorPos(self.handle(), v.toC())
+func (self ImGuiTable) SetContextPopupColumn(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetContextPopupColumn(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiGroupData) GetBackupCursorPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiGroupData_GetBackupCursorPos(self.handle()))
+func (self ImGuiTable) GetContextPopupColumn() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetContextPopupColumn(self.handle()))
 }
 
-func (self ImGuiGroupData) SetBackupCursorMaxPos(v ImVec2) {
-	C.ImGuiGroupData_SetBackupCursorMaxPos(self.handle(), v.toC())
+func (self ImGuiTable) SetFreezeRowsRequest(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetFreezeRowsRequest(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiGroupData) GetBackupCursorMaxPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiGroupData_GetBackupCursorMaxPos(self.handle()))
+func (self ImGuiTable) GetFreezeRowsRequest() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetFreezeRowsRequest(self.handle()))
 }
 
-func (self ImGuiGroupData) SetBackupCurrLineSize(v ImVec2) {
-	C.ImGuiGroupData_SetBackupCurrLineSize(self.handle(), v.toC())
+func (self ImGuiTable) SetFreezeRowsCount(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetFreezeRowsCount(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiGroupData) GetBackupCurrLineSize() ImVec2 {
-	return newImVec2FromC(C.ImGuiGroupData_GetBackupCurrLineSize(self.handle()))
+func (self ImGuiTable) GetFreezeRowsCount() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetFreezeRowsCount(self.handle()))
 }
 
-func (self ImGuiGroupData) SetBackupCurrLineTextBaseOffset(v float32) {
-	C.ImGuiGroupData_SetBackupCurrLineTextBaseOffset(self.handle(), C.float(v))
+func (self ImGuiTable) SetFreezeColumnsRequest(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetFreezeColumnsRequest(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiGroupData) GetBackupCurrLineTextBaseOffset() float32 {
-	return float32(C.ImGuiGroupData_GetBackupCurrLineTextBaseOffset(self.handle()))
+func (self ImGuiTable) GetFreezeColumnsRequest() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetFreezeColumnsRequest(self.handle()))
 }
 
-func (self ImGuiGroupData) SetBackupActiveIdIsAlive(v ImGuiID) {
-	C.ImGuiGroupData_SetBackupActiveIdIsAlive(self.handle(), C.ImGuiID(v))
+func (self ImGuiTable) SetFreezeColumnsCount(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetFreezeColumnsCount(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiGroupData) GetBackupActiveIdIsAlive() ImGuiID {
-	return ImGuiID(C.ImGuiGroupData_GetBackupActiveIdIsAlive(self.handle()))
+func (self ImGuiTable) GetFreezeColumnsCount() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetFreezeColumnsCount(self.handle()))
 }
 
-func (self ImGuiGroupData) SetBackupActiveIdPreviousFrameIsAlive(v bool) {
-	C.ImGuiGroupData_SetBackupActiveIdPreviousFrameIsAlive(self.handle(), C.bool(v))
+func (self ImGuiTable) SetRowCellDataCurrent(v ImGuiTableColumnIdx) {
+	C.ImGuiTable_SetRowCellDataCurrent(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiGroupData) GetBackupActiveIdPreviousFrameIsAlive() bool {
-	return C.ImGuiGroupData_GetBackupActiveIdPreviousFrameIsAlive(self.handle()) == C.bool(true)
+func (self ImGuiTable) GetRowCellDataCurrent() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTable_GetRowCellDataCurrent(self.handle()))
 }
 
-func (self ImGuiGroupData) SetBackupHoveredIdIsAlive(v bool) {
-	C.ImGuiGroupData_SetBackupHoveredIdIsAlive(self.handle(), C.bool(v))
+func (self ImGuiTable) SetDummyDrawChannel(v ImGuiTableDrawChannelIdx) {
+	C.ImGuiTable_SetDummyDrawChannel(self.handle(), C.ImGuiTableDrawChannelIdx(v))
 }
 
-func (self ImGuiGroupData) GetBackupHoveredIdIsAlive() bool {
-	return C.ImGuiGroupData_GetBackupHoveredIdIsAlive(self.handle()) == C.bool(true)
+func (self ImGuiTable) GetDummyDrawChannel() ImGuiTableDrawChannelIdx {
+	return ImGuiTableDrawChannelIdx(C.ImGuiTable_GetDummyDrawChannel(self.handle()))
 }
 
-func (self ImGuiGroupData) SetEmitItem(v bool) {
-	C.ImGuiGroupData_SetEmitItem(self.handle(), C.bool(v))
+func (self ImGuiTable) SetBg2DrawChannelCurrent(v ImGuiTableDrawChannelIdx) {
+	C.ImGuiTable_SetBg2DrawChannelCurrent(self.handle(), C.ImGuiTableDrawChannelIdx(v))
 }
 
-func (self ImGuiGroupData) GetEmitItem() bool {
-	return C.ImGuiGroupData_GetEmitItem(self.handle()) == C.bool(true)
+func (self ImGuiTable) GetBg2DrawChannelCurrent() ImGuiTableDrawChannelIdx {
+	return ImGuiTableDrawChannelIdx(C.ImGuiTable_GetBg2DrawChannelCurrent(self.handle()))
 }
 
-func (self ImDrawData) SetValid(v bool) {
-	C.ImDrawData_SetValid(self.handle(), C.bool(v))
+func (self ImGuiTable) SetBg2DrawChannelUnfrozen(v ImGuiTableDrawChannelIdx) {
+	C.ImGuiTable_SetBg2DrawChannelUnfrozen(self.handle(), C.ImGuiTableDrawChannelIdx(v))
 }
 
-func (self ImDrawData) GetValid() bool {
-	return C.ImDrawData_GetValid(self.handle()) == C.bool(true)
+func (self ImGuiTable) GetBg2DrawChannelUnfrozen() ImGuiTableDrawChannelIdx {
+	return ImGuiTableDrawChannelIdx(C.ImGuiTable_GetBg2DrawChannelUnfrozen(self.handle()))
 }
 
-func (self ImDrawData) SetCmdListsCount(v int32) {
-	C.ImDrawData_SetCmdListsCount(self.handle(), C.int(v))
+func (self ImGuiTable) SetIsLayoutLocked(v bool) {
+	C.ImGuiTable_SetIsLayoutLocked(self.handle(), C.bool(v))
 }
 
-func (self ImDrawData) GetCmdListsCount() int {
-	return int(C.ImDrawData_GetCmdListsCount(self.handle()))
+func (self ImGuiTable) GetIsLayoutLocked() bool {
+	return C.ImGuiTable_GetIsLayoutLocked(self.handle()) == C.bool(true)
 }
 
-func (self ImDrawData) SetTotalIdxCount(v int32) {
-	C.ImDrawData_SetTotalIdxCount(self.handle(), C.int(v))
+func (self ImGuiTable) SetIsInsideRow(v bool) {
+	C.ImGuiTable_SetIsInsideRow(self.handle(), C.bool(v))
 }
 
-func (self ImDrawData) GetTotalIdxCount() int {
-	return int(C.ImDrawData_GetTotalIdxCount(self.handle()))
+func (self ImGuiTable) GetIsInsideRow() bool {
+	return C.ImGuiTable_GetIsInsideRow(self.handle()) == C.bool(true)
 }
 
-func (self ImDrawData) SetTotalVtxCount(v int32) {
-	C.ImDrawData_SetTotalVtxCount(self.handle(), C.int(v))
+func (self ImGuiTable) SetIsInitializing(v bool) {
+	C.ImGuiTable_SetIsInitializing(self.handle(), C.bool(v))
 }
 
-func (self ImDrawData) GetTotalVtxCount() int {
-	return int(C.ImDrawData_GetTotalVtxCount(self.handle()))
+func (self ImGuiTable) GetIsInitializing() bool {
+	return C.ImGuiTable_GetIsInitializing(self.handle()) == C.bool(true)
 }
 
-func (self ImDrawData) SetDisplayPos(v ImVec2) {
-	C.ImDrawData_SetDisplayPos(self.handle(), v.toC())
+func (self ImGuiTable) SetIsSortSpecsDirty(v bool) {
+	C.ImGuiTable_SetIsSortSpecsDirty(self.handle(), C.bool(v))
 }
 
-func (self ImDrawData) GetDisplayPos() ImVec2 {
-	return newImVec2FromC(C.ImDrawData_GetDisplayPos(self.handle()))
+func (self ImGuiTable) GetIsSortSpecsDirty() bool {
+	return C.ImGuiTable_GetIsSortSpecsDirty(self.handle()) == C.bool(true)
 }
 
-func (self ImDrawData) SetDisplaySize(v ImVec2) {
-	C.ImDrawData_SetDisplaySize(self.handle(), v.toC())
+func (self ImGuiTable) SetIsUsingHeaders(v bool) {
+	C.ImGuiTable_SetIsUsingHeaders(self.handle(), C.bool(v))
 }
 
-func (self ImDrawData) GetDisplaySize() ImVec2 {
-	return newImVec2FromC(C.ImDrawData_GetDisplaySize(self.handle()))
+func (self ImGuiTable) GetIsUsingHeaders() bool {
+	return C.ImGuiTable_GetIsUsingHeaders(self.handle()) == C.bool(true)
 }
 
-func (self ImDrawData) SetFramebufferScale(v ImVec2) {
-	C.ImDrawData_SetFramebufferScale(self.handle(), v.toC())
+func (self ImGuiTable) SetIsContextPopupOpen(v bool) {
+	C.ImGuiTable_SetIsContextPopupOpen(self.handle(), C.bool(v))
 }
 
-func (self ImDrawData) GetFramebufferScale() ImVec2 {
-	return newImVec2FromC(C.ImDrawData_GetFramebufferScale(self.handle()))
+func (self ImGuiTable) GetIsContextPopupOpen() bool {
+	return C.ImGuiTable_GetIsContextPopupOpen(self.handle()) == C.bool(true)
 }
 
-func (self ImDrawData) SetOwnerViewport(v ImGuiViewport) {
-	C.ImDrawData_SetOwnerViewport(self.handle(), v.handle())
+func (self ImGuiTable) SetIsSettingsRequestLoad(v bool) {
+	C.ImGuiTable_SetIsSettingsRequestLoad(self.handle(), C.bool(v))
 }
 
-func (self ImDrawData) GetOwnerViewport() ImGuiViewport {
-	return (ImGuiViewport)(unsafe.Pointer(C.ImDrawData_GetOwnerViewport(self.handle())))
+func (self ImGuiTable) GetIsSettingsRequestLoad() bool {
+	return C.ImGuiTable_GetIsSettingsRequestLoad(self.handle()) == C.bool(true)
 }
 
-func (self ImFontConfig) SetFontData(v unsafe.Pointer) {
-	C.ImFontConfig_SetFontData(self.handle(), v)
+func (self ImGuiTable) SetIsSettingsDirty(v bool) {
+	C.ImGuiTable_SetIsSettingsDirty(self.handle(), C.bool(v))
 }
 
-func (self ImFontConfig) GetFontData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImFontConfig_GetFontData(self.handle()))
+func (self ImGuiTable) GetIsSettingsDirty() bool {
+	return C.ImGuiTable_GetIsSettingsDirty(self.handle()) == C.bool(true)
 }
 
-func (self ImFontConfig) SetFontDataSize(v int32) {
-	C.ImFontConfig_SetFontDataSize(self.handle(), C.int(v))
+func (self ImGuiTable) SetIsDefaultDisplayOrder(v bool) {
+	C.ImGuiTable_SetIsDefaultDisplayOrder(self.handle(), C.bool(v))
 }
 
-func (self ImFontConfig) GetFontDataSize() int {
-	return int(C.ImFontConfig_GetFontDataSize(self.handle()))
+func (self ImGuiTable) GetIsDefaultDisplayOrder() bool {
+	return C.ImGuiTable_GetIsDefaultDisplayOrder(self.handle()) == C.bool(true)
 }
 
-func (self ImFontConfig) SetFontDataOwnedByAtlas(v bool) {
-	C.ImFontConfig_SetFontDataOwnedByAtlas(self.handle(), C.bool(v))
+func (self ImGuiTable) SetIsResetAllRequest(v bool) {
+	C.ImGuiTable_SetIsResetAllRequest(self.handle(), C.bool(v))
 }
 
-func (self ImFontConfig) GetFontDataOwnedByAtlas() bool {
-	return C.ImFontConfig_GetFontDataOwnedByAtlas(self.handle()) == C.bool(true)
+func (self ImGuiTable) GetIsResetAllRequest() bool {
+	return C.ImGuiTable_GetIsResetAllRequest(self.handle()) == C.bool(true)
 }
 
-func (self ImFontConfig) SetFontNo(v int32) {
-	C.ImFontConfig_SetFontNo(self.handle(), C.int(v))
+func (self ImGuiTable) SetIsResetDisplayOrderRequest(v bool) {
+	C.ImGuiTable_SetIsResetDisplayOrderRequest(self.handle(), C.bool(v))
 }
 
-func (self ImFontConfig) GetFontNo() int {
-	return int(C.ImFontConfig_GetFontNo(self.handle()))
+func (self ImGuiTable) GetIsResetDisplayOrderRequest() bool {
+	return C.ImGuiTable_GetIsResetDisplayOrderRequest(self.handle()) == C.bool(true)
 }
 
-func (self ImFontConfig) SetSizePixels(v float32) {
-	C.ImFontConfig_SetSizePixels(self.handle(), C.float(v))
+func (self ImGuiTable) SetIsUnfrozenRows(v bool) {
+	C.ImGuiTable_SetIsUnfrozenRows(self.handle(), C.bool(v))
 }
 
-func (self ImFontConfig) GetSizePixels() float32 {
-	return float32(C.ImFontConfig_GetSizePixels(self.handle()))
+func (self ImGuiTable) GetIsUnfrozenRows() bool {
+	return C.ImGuiTable_GetIsUnfrozenRows(self.handle()) == C.bool(true)
 }
 
-func (self ImFontConfig) SetOversampleH(v int32) {
-	C.ImFontConfig_SetOversampleH(self.handle(), C.int(v))
+func (self ImGuiTable) SetIsDefaultSizingPolicy(v bool) {
+	C.ImGuiTable_SetIsDefaultSizingPolicy(self.handle(), C.bool(v))
 }
 
-func (self ImFontConfig) GetOversampleH() int {
-	return int(C.ImFontConfig_GetOversampleH(self.handle()))
+func (self ImGuiTable) GetIsDefaultSizingPolicy() bool {
+	return C.ImGuiTable_GetIsDefaultSizingPolicy(self.handle()) == C.bool(true)
 }
 
-func (self ImFontConfig) SetOversampleV(v int32) {
-	C.ImFontConfig_SetOversampleV(self.handle(), C.int(v))
+func (self ImGuiTable) SetMemoryCompacted(v bool) {
+	C.ImGuiTable_SetMemoryCompacted(self.handle(), C.bool(v))
 }
 
-func (self ImFontConfig) GetOversampleV() int {
-	return int(C.ImFontConfig_GetOversampleV(self.handle()))
+func (self ImGuiTable) GetMemoryCompacted() bool {
+	return C.ImGuiTable_GetMemoryCompacted(self.handle()) == C.bool(true)
 }
 
-func (self ImFontConfig) SetPixelSnapH(v bool) {
-	C.ImFontConfig_SetPixelSnapH(self.handle(), C.bool(v))
+func (self ImGuiTable) SetHostSkipItems(v bool) {
+	C.ImGuiTable_SetHostSkipItems(self.handle(), C.bool(v))
 }
 
-func (self ImFontConfig) GetPixelSnapH() bool {
-	return C.ImFontConfig_GetPixelSnapH(self.handle()) == C.bool(true)
+func (self ImGuiTable) GetHostSkipItems() bool {
+	return C.ImGuiTable_GetHostSkipItems(self.handle()) == C.bool(true)
 }
 
-func (self ImFontConfig) SetGlyphExtraSpacing(v ImVec2) {
-	C.ImFontConfig_SetGlyphExtraSpacing(self.handle(), v.toC())
+func (self ImGuiTableTempData) SetTableIndex(v int32) {
+	C.ImGuiTableTempData_SetTableIndex(self.handle(), C.int(v))
 }
 
-func (self ImFontConfig) GetGlyphExtraSpacing() ImVec2 {
-	return newImVec2FromC(C.ImFontConfig_GetGlyphExtraSpacing(self.handle()))
+func (self ImGuiTableTempData) GetTableIndex() int {
+	return int(C.ImGuiTableTempData_GetTableIndex(self.handle()))
 }
 
-func (self ImFontConfig) SetGlyphOffset(v ImVec2) {
-	C.ImFontConfig_SetGlyphOffset(self.handle(), v.toC())
+func (self ImGuiTableTempData) SetLastTimeActive(v float32) {
+	C.ImGuiTableTempData_SetLastTimeActive(self.handle(), C.float(v))
 }
 
-func (self ImFontConfig) GetGlyphOffset() ImVec2 {
-	return newImVec2FromC(C.ImFontConfig_GetGlyphOffset(self.handle()))
+func (self ImGuiTableTempData) GetLastTimeActive() float32 {
+	return float32(C.ImGuiTableTempData_GetLastTimeActive(self.handle()))
 }
 
-func (self ImFontConfig) SetGlyphRanges(v *ImWchar) {
-	C.ImFontConfig_SetGlyphRanges(self.handle(), (*C.ImWchar)(v))
+func (self ImGuiTableTempData) SetUserOuterSize(v ImVec2) {
+	C.ImGuiTableTempData_SetUserOuterSize(self.handle(), v.toC())
 }
 
-func (self ImFontConfig) GetGlyphRanges() *ImWchar {
-	return (*ImWchar)(C.ImFontConfig_GetGlyphRanges(self.handle()))
+func (self ImGuiTableTempData) GetUserOuterSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiTableTempData_GetUserOuterSize(self.handle()))
 }
 
-func (self ImFontConfig) SetGlyphMinAdvanceX(v float32) {
-	C.ImFontConfig_SetGlyphMinAdvanceX(self.handle(), C.float(v))
+func (self ImGuiTableTempData) GetDrawSplitter() ImDrawListSplitter {
+	return newImDrawListSplitterFromC(C.ImGuiTableTempData_GetDrawSplitter(self.handle()))
 }
 
-func (self ImFontConfig) GetGlyphMinAdvanceX() float32 {
-	return float32(C.ImFontConfig_GetGlyphMinAdvanceX(self.handle()))
+func (self ImGuiTableTempData) SetHostBackupWorkRect(v ImRect) {
+	C.ImGuiTableTempData_SetHostBackupWorkRect(self.handle(), v.toC())
 }
 
-func (self ImFontConfig) SetGlyphMaxAdvanceX(v float32) {
-	C.ImFontConfig_SetGlyphMaxAdvanceX(self.handle(), C.float(v))
+func (self ImGuiTableTempData) GetHostBackupWorkRect() ImRect {
+	return newImRectFromC(C.ImGuiTableTempData_GetHostBackupWorkRect(self.handle()))
 }
 
-func (self ImFontConfig) GetGlyphMaxAdvanceX() float32 {
-	return float32(C.ImFontConfig_GetGlyphMaxAdvanceX(self.handle()))
+func (self ImGuiTableTempData) SetHostBackupParentWorkRect(v ImRect) {
+	C.ImGuiTableTempData_SetHostBackupParentWorkRect(self.handle(), v.toC())
 }
 
-func (self ImFontConfig) SetMergeMode(v bool) {
-	C.ImFontConfig_SetMergeMode(self.handle(), C.bool(v))
+func (self ImGuiTableTempData) GetHostBackupParentWorkRect() ImRect {
+	return newImRectFromC(C.ImGuiTableTempData_GetHostBackupParentWorkRect(self.handle()))
 }
 
-func (self ImFontConfig) GetMergeMode() bool {
-	return C.ImFontConfig_GetMergeMode(self.handle()) == C.bool(true)
+func (self ImGuiTableTempData) SetHostBackupPrevLineSize(v ImVec2) {
+	C.ImGuiTableTempData_SetHostBackupPrevLineSize(self.handle(), v.toC())
 }
 
-func (self ImFontConfig) SetFontBuilderFlags(v uint32) {
-	C.ImFontConfig_SetFontBuilderFlags(self.handle(), C.uint(v))
+func (self ImGuiTableTempData) GetHostBackupPrevLineSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiTableTempData_GetHostBackupPrevLineSize(self.handle()))
 }
 
-func (self ImFontConfig) GetFontBuilderFlags() uint32 {
-	return uint32(C.ImFontConfig_GetFontBuilderFlags(self.handle()))
+func (self ImGuiTableTempData) SetHostBackupCurrLineSize(v ImVec2) {
+	C.ImGuiTableTempData_SetHostBackupCurrLineSize(self.handle(), v.toC())
 }
 
-func (self ImFontConfig) SetRasterizerMultiply(v float32) {
-	C.ImFontConfig_SetRasterizerMultiply(self.handle(), C.float(v))
+func (self ImGuiTableTempData) GetHostBackupCurrLineSize() ImVec2 {
+	return newImVec2FromC(C.ImGuiTableTempData_GetHostBackupCurrLineSize(self.handle()))
 }
 
-func (self ImFontConfig) GetRasterizerMultiply() float32 {
-	return float32(C.ImFontConfig_GetRasterizerMultiply(self.handle()))
+func (self ImGuiTableTempData) SetHostBackupCursorMaxPos(v ImVec2) {
+	C.ImGuiTableTempData_SetHostBackupCursorMaxPos(self.handle(), v.toC())
 }
 
-func (self ImFontConfig) SetEllipsisChar(v ImWchar) {
-	C.ImFontConfig_SetEllipsisChar(self.handle(), C.ImWchar(v))
+func (self ImGuiTableTempData) GetHostBackupCursorMaxPos() ImVec2 {
+	return newImVec2FromC(C.ImGuiTableTempData_GetHostBackupCursorMaxPos(self.handle()))
 }
 
-func (self ImFontConfig) SetDstFont(v ImFont) {
-	C.ImFontConfig_SetDstFont(self.handle(), v.handle())
+func (self ImGuiTableTempData) SetHostBackupItemWidth(v float32) {
+	C.ImGuiTableTempData_SetHostBackupItemWidth(self.handle(), C.float(v))
 }
 
-func (self ImFontConfig) GetDstFont() ImFont {
-	return (ImFont)(unsafe.Pointer(C.ImFontConfig_GetDstFont(self.handle())))
+func (self ImGuiTableTempData) GetHostBackupItemWidth() float32 {
+	return float32(C.ImGuiTableTempData_GetHostBackupItemWidth(self.handle()))
 }
 
-func (self ImGuiInputEventMouseViewport) SetHoveredViewportID(v ImGuiID) {
-	C.ImGuiInputEventMouseViewport_SetHoveredViewportID(self.handle(), C.ImGuiID(v))
+func (self ImGuiTableTempData) SetHostBackupItemWidthStackSize(v int32) {
+	C.ImGuiTableTempData_SetHostBackupItemWidthStackSize(self.handle(), C.int(v))
 }
 
-func (self ImGuiInputEventMouseViewport) GetHoveredViewportID() ImGuiID {
-	return ImGuiID(C.ImGuiInputEventMouseViewport_GetHoveredViewportID(self.handle()))
+func (self ImGuiTableTempData) GetHostBackupItemWidthStackSize() int {
+	return int(C.ImGuiTableTempData_GetHostBackupItemWidthStackSize(self.handle()))
 }
 
-func (self ImGuiOldColumnData) SetOffsetNorm(v float32) {
-	C.ImGuiOldColumnData_SetOffsetNorm(self.handle(), C.float(v))
+func (self ImGuiTableColumnSettings) SetWidthOrWeight(v float32) {
+	C.ImGuiTableColumnSettings_SetWidthOrWeight(self.handle(), C.float(v))
 }
 
-func (self ImGuiOldColumnData) GetOffsetNorm() float32 {
-	return float32(C.ImGuiOldColumnData_GetOffsetNorm(self.handle()))
+func (self ImGuiTableColumnSettings) GetWidthOrWeight() float32 {
+	return float32(C.ImGuiTableColumnSettings_GetWidthOrWeight(self.handle()))
 }
 
-func (self ImGuiOldColumnData) SetOffsetNormBeforeResize(v float32) {
-	C.ImGuiOldColumnData_SetOffsetNormBeforeResize(self.handle(), C.float(v))
+func (self ImGuiTableColumnSettings) SetUserID(v ImGuiID) {
+	C.ImGuiTableColumnSettings_SetUserID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiOldColumnData) GetOffsetNormBeforeResize() float32 {
-	return float32(C.ImGuiOldColumnData_GetOffsetNormBeforeResize(self.handle()))
+func (self ImGuiTableColumnSettings) GetUserID() ImGuiID {
+	return ImGuiID(C.ImGuiTableColumnSettings_GetUserID(self.handle()))
 }
 
-func (self ImGuiOldColumnData) SetFlags(v ImGuiOldColumnFlags) {
-	C.ImGuiOldColumnData_SetFlags(self.handle(), C.ImGuiOldColumnFlags(v))
+func (self ImGuiTableColumnSettings) SetIndex(v ImGuiTableColumnIdx) {
+	C.ImGuiTableColumnSettings_SetIndex(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiOldColumnData) GetFlags() ImGuiOldColumnFlags {
-	return ImGuiOldColumnFlags(C.ImGuiOldColumnData_GetFlags(self.handle()))
+func (self ImGuiTableColumnSettings) GetIndex() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableColumnSettings_GetIndex(self.handle()))
 }
 
-func (self ImGuiOldColumnData) SetClipRect(v ImRect) {
-	C.ImGuiOldColumnData_SetClipRect(self.handle(), v.toC())
+func (self ImGuiTableColumnSettings) SetDisplayOrder(v ImGuiTableColumnIdx) {
+	C.ImGuiTableColumnSettings_SetDisplayOrder(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiOldColumnData) GetClipRect() ImRect {
-	return newImRectFromC(C.ImGuiOldColumnData_GetClipRect(self.handle()))
+func (self ImGuiTableColumnSettings) GetDisplayOrder() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableColumnSettings_GetDisplayOrder(self.handle()))
 }
 
-func (self ImGuiPayload) SetData(v unsafe.Pointer) {
-	C.ImGuiPayload_SetData(self.handle(), v)
+func (self ImGuiTableColumnSettings) SetSortOrder(v ImGuiTableColumnIdx) {
+	C.ImGuiTableColumnSettings_SetSortOrder(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiPayload) GetData() unsafe.Pointer {
-	return unsafe.Pointer(C.ImGuiPayload_GetData(self.handle()))
+func (self ImGuiTableColumnSettings) GetSortOrder() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableColumnSettings_GetSortOrder(self.handle()))
 }
 
-func (self ImGuiPayload) SetDataSize(v int32) {
-	C.ImGuiPayload_SetDataSize(self.handle(), C.int(v))
+func (self ImGuiTableColumnSettings) SetSortDirection(v uint) {
+	C.ImGuiTableColumnSettings_SetSortDirection(self.handle(), C.ImU8(v))
 }
 
-func (self ImGuiPayload) GetDataSize() int {
-	return int(C.ImGuiPayload_GetDataSize(self.handle()))
+func (self ImGuiTableColumnSettings) GetSortDirection() uint32 {
+	return uint32(C.ImGuiTableColumnSettings_GetSortDirection(self.handle()))
 }
 
-func (self ImGuiPayload) SetSourceId(v ImGuiID) {
-	C.ImGuiPayload_SetSourceId(self.handle(), C.ImGuiID(v))
+func (self ImGuiTableColumnSettings) SetIsEnabled(v uint) {
+	C.ImGuiTableColumnSettings_SetIsEnabled(self.handle(), C.ImU8(v))
 }
 
-func (self ImGuiPayload) GetSourceId() ImGuiID {
-	return ImGuiID(C.ImGuiPayload_GetSourceId(self.handle()))
+func (self ImGuiTableColumnSettings) GetIsEnabled() uint32 {
+	return uint32(C.ImGuiTableColumnSettings_GetIsEnabled(self.handle()))
 }
 
-func (self ImGuiPayload) SetSourceParentId(v ImGuiID) {
-	C.ImGuiPayload_SetSourceParentId(self.handle(), C.ImGuiID(v))
+func (self ImGuiTableColumnSettings) SetIsStretch(v uint) {
+	C.ImGuiTableColumnSettings_SetIsStretch(self.handle(), C.ImU8(v))
 }
 
-func (self ImGuiPayload) GetSourceParentId() ImGuiID {
-	return ImGuiID(C.ImGuiPayload_GetSourceParentId(self.handle()))
+func (self ImGuiTableColumnSettings) GetIsStretch() uint32 {
+	return uint32(C.ImGuiTableColumnSettings_GetIsStretch(self.handle()))
 }
 
-func (self ImGuiPayload) SetDataFrameCount(v int32) {
-	C.ImGuiPayload_SetDataFrameCount(self.handle(), C.int(v))
+func (self ImGuiTableSettings) SetID(v ImGuiID) {
+	C.ImGuiTableSettings_SetID(self.handle(), C.ImGuiID(v))
 }
 
-func (self ImGuiPayload) GetDataFrameCount() int {
-	return int(C.ImGuiPayload_GetDataFrameCount(self.handle()))
+func (self ImGuiTableSettings) GetID() ImGuiID {
+	return ImGuiID(C.ImGuiTableSettings_GetID(self.handle()))
 }
 
-func (self ImGuiPayload) SetPreview(v bool) {
-	C.ImGuiPayload_SetPreview(self.handle(), C.bool(v))
+func (self ImGuiTableSettings) SetSaveFlags(v ImGuiTableFlags) {
+	C.ImGuiTableSettings_SetSaveFlags(self.handle(), C.ImGuiTableFlags(v))
 }
 
-func (self ImGuiPayload) GetPreview() bool {
-	return C.ImGuiPayload_GetPreview(self.handle()) == C.bool(true)
+func (self ImGuiTableSettings) GetSaveFlags() ImGuiTableFlags {
+	return ImGuiTableFlags(C.ImGuiTableSettings_GetSaveFlags(self.handle()))
 }
 
-func (self ImGuiPayload) SetDelivery(v bool) {
-	C.ImGuiPayload_SetDelivery(self.handle(), C.bool(v))
+func (self ImGuiTableSettings) SetRefScale(v float32) {
+	C.ImGuiTableSettings_SetRefScale(self.handle(), C.float(v))
 }
 
-func (self ImGuiPayload) GetDelivery() bool {
-	return C.ImGuiPayload_GetDelivery(self.handle()) == C.bool(true)
+func (self ImGuiTableSettings) GetRefScale() float32 {
+	return float32(C.ImGuiTableSettings_GetRefScale(self.handle()))
 }
 
-func (self ImGuiPlatformImeData) SetWantVisible(v bool) {
-	C.ImGuiPlatformImeData_SetWantVisible(self.handle(), C.bool(v))
+func (self ImGuiTableSettings) SetColumnsCount(v ImGuiTableColumnIdx) {
+	C.ImGuiTableSettings_SetColumnsCount(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiPlatformImeData) GetWantVisible() bool {
-	return C.ImGuiPlatformImeData_GetWantVisible(self.handle()) == C.bool(true)
+func (self ImGuiTableSettings) GetColumnsCount() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableSettings_GetColumnsCount(self.handle()))
 }
 
-func (self ImGuiPlatformImeData) SetInputPos(v ImVec2) {
-	C.ImGuiPlatformImeData_SetInputPos(self.handle(), v.toC())
+func (self ImGuiTableSettings) SetColumnsCountMax(v ImGuiTableColumnIdx) {
+	C.ImGuiTableSettings_SetColumnsCountMax(self.handle(), C.ImGuiTableColumnIdx(v))
 }
 
-func (self ImGuiPlatformImeData) GetInputPos() ImVec2 {
-	return newImVec2FromC(C.ImGuiPlatformImeData_GetInputPos(self.handle()))
+func (self ImGuiTableSettings) GetColumnsCountMax() ImGuiTableColumnIdx {
+	return ImGuiTableColumnIdx(C.ImGuiTableSettings_GetColumnsCountMax(self.handle()))
 }
 
-func (self ImGuiPlatformImeData) SetInputLineHeight(v float32) {
-	C.ImGuiPlatformImeData_SetInputLineHeight(self.handle(), C.float(v))
+func (self ImGuiTableSettings) SetWantApply(v bool) {
+	C.ImGuiTableSettings_SetWantApply(self.handle(), C.bool(v))
 }
 
-func (self ImGuiPlatformImeData) GetInputLineHeight() float32 {
-	return float32(C.ImGuiPlatformImeData_GetInputLineHeight(self.handle()))
+func (self ImGuiTableSettings) GetWantApply() bool {
+	return C.ImGuiTableSettings_GetWantApply(self.handle()) == C.bool(true)
 }
@@ -0,0 +1,89 @@
+package cimgui
+
+// gamepadDeadZone is the analog value under which a gamepad key is released,
+// as in the GLFW backend.
+const gamepadDeadZone = 0.10
+
+// gamepadBackend is implemented by backends feeding a real gamepad to dear imgui.
+type gamepadBackend interface {
+	// gamepad fills state with the real gamepad, reporting whether one is connected.
+	gamepad(state GamepadState) bool
+}
+
+// GamepadState maps the gamepad keys, from ImGuiKey_GamepadStart to
+// ImGuiKey_GamepadRStickDown, to their analog value in [0, 1].
+// Missing keys are released.
+type GamepadState map[ImGuiKey]float32
+
+// SetGamepadHook sets a function filling the state of a synthetic gamepad
+// before each frame, e.g. for kiosks or tests. The synthetic gamepad adds to
+// a real one: a key is down while either holds it, at the highest of both values.
+// Gamepad navigation needs ImGuiConfigFlags_NavEnableGamepad, see WindowOptions.Gamepad.
+func (w *Window) SetGamepadHook(hook func(state GamepadState)) {
+	w.gamepadHook = hook
+}
+
+// newBackendFrame starts the frame of the backend. While the gamepad hook is
+// set, the backend does not feed its gamepad, updateGamepad feeds it instead.
+func (w *Window) newBackendFrame() {
+	if w.gamepadHook == nil {
+		w.backend.NewFrame()
+		return
+	}
+
+	SetCurrentContext(w.backend.Context())
+	io := GetIO()
+	configFlags := io.GetConfigFlags()
+	io.SetConfigFlags(configFlags &^ ImGuiConfigFlags_NavEnableGamepad)
+
+	w.backend.NewFrame()
+
+	io.SetConfigFlags(configFlags)
+}
+
+// updateGamepad feeds the synthetic gamepad combined with the real one,
+// every frame as dear imgui keeps the last value fed for each key.
+func (w *Window) updateGamepad() {
+	// once the hook is removed, its keys are released one last time
+	if w.gamepadHook == nil && !w.gamepadFed {
+		return
+	}
+
+	io := GetIO()
+	state := GamepadState{}
+	if w.gamepadHook != nil {
+		w.gamepadHook(state)
+	}
+
+	pad := GamepadState{}
+	connected := false
+	if backend, ok := w.backend.(gamepadBackend); ok && io.GetConfigFlags()&ImGuiConfigFlags_NavEnableGamepad != 0 {
+		connected = backend.gamepad(pad)
+	}
+
+	for key := ImGuiKey(ImGuiKey_GamepadStart); key <= ImGuiKey_GamepadRStickDown; key++ {
+		value := clamp01(state[key])
+		if pad[key] > value {
+			value = pad[key]
+		}
+		io.AddKeyAnalogEvent(key, value > gamepadDeadZone, value)
+	}
+
+	w.gamepadFed = w.gamepadHook != nil
+	if w.gamepadFed || connected {
+		io.SetBackendFlags(io.GetBackendFlags() | ImGuiBackendFlags_HasGamepad)
+	} else {
+		io.SetBackendFlags(io.GetBackendFlags() &^ ImGuiBackendFlags_HasGamepad)
+	}
+}
+
+func clamp01(v float32) float32 {
+	switch {
+	case v < 0:
+		return 0
+	case v > 1:
+		return 1
+	default:
+		return v
+	}
+}
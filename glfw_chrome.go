@@ -0,0 +1,238 @@
+package cimgui
+
+import "image"
+
+// chromeEdges are the borders of a window being resized.
+type chromeEdges int
+
+const (
+	chromeEdgeLeft chromeEdges = 1 << iota
+	chromeEdgeRight
+	chromeEdgeTop
+	chromeEdgeBottom
+)
+
+// minChromeSize is the smallest size WindowChrome resizes a window to.
+const minChromeSize = 64
+
+// WindowChrome lets the UI of a frameless window, see GLFWWindowFlagsFrameless,
+// act as its title bar and borders: dragging it around, resizing it from
+// its borders, and minimizing, maximizing or closing it.
+// Its methods are called from the loop of the window, each frame.
+type WindowChrome struct {
+	// BorderSize is how wide the borders resizing the window are, in screen
+	// coordinates. 0 disables resizing.
+	BorderSize int
+
+	window      GLFWwindow
+	dragging    bool
+	resizing    chromeEdges
+	startCursor image.Point
+	startRect   image.Rectangle
+}
+
+// NewWindowChrome creates the chrome of window, with 4 pixels wide borders.
+func NewWindowChrome(window GLFWwindow) *WindowChrome {
+	return &WindowChrome{BorderSize: 4, window: window}
+}
+
+// DragItem lets the last item, e.g. an InvisibleButton, drag the window around.
+// Double clicking it maximizes or restores the window.
+func (c *WindowChrome) DragItem() {
+	c.drag(IsItemHovered(), IsItemActivated())
+}
+
+// DragRegion lets the empty space of the rectangle from rectMin to rectMax,
+// e.g. the rect of a menu bar, drag the window around. Double clicking it
+// maximizes or restores the window.
+func (c *WindowChrome) DragRegion(rectMin, rectMax ImVec2) {
+	hovered := IsMouseHoveringRect(rectMin, rectMax) && !IsAnyItemHovered() && IsWindowHovered()
+	c.drag(hovered, hovered && IsMouseClicked(ImGuiMouseButton_Left))
+}
+
+func (c *WindowChrome) drag(hovered, activated bool) {
+	if hovered && IsMouseDoubleClicked(ImGuiMouseButton_Left) {
+		c.dragging = false
+		c.ToggleMaximize()
+		return
+	}
+
+	if activated && c.resizing == 0 && !c.window.IsMaximized() {
+		c.dragging = true
+		c.start()
+	}
+
+	if !c.dragging {
+		return
+	}
+
+	if !IsMouseDown(ImGuiMouseButton_Left) {
+		c.dragging = false
+		return
+	}
+
+	pos := c.startRect.Min.Add(c.cursor().Sub(c.startCursor))
+	c.window.SetPos(pos.X, pos.Y)
+}
+
+// ResizeBorders lets the borders of the window resize it, showing resize
+// cursors over them. Call it once per frame, after the UI.
+func (c *WindowChrome) ResizeBorders() {
+	if c.BorderSize <= 0 || c.dragging || c.window.IsMaximized() {
+		c.resizing = 0
+		return
+	}
+
+	if c.resizing == 0 {
+		x, y := c.window.CursorPos()
+		width, height := c.window.Size()
+		edges := c.edgesAt(int(x), int(y), width, height)
+		if edges == 0 {
+			return
+		}
+
+		SetMouseCursor(edges.mouseCursor())
+		if IsMouseClicked(ImGuiMouseButton_Left) {
+			c.resizing = edges
+			c.start()
+		}
+
+		return
+	}
+
+	if !IsMouseDown(ImGuiMouseButton_Left) {
+		c.resizing = 0
+		return
+	}
+
+	SetMouseCursor(c.resizing.mouseCursor())
+	SetNextFrameWantCaptureMouse(true)
+
+	delta := c.cursor().Sub(c.startCursor)
+	rect := c.startRect
+	if c.resizing&chromeEdgeLeft != 0 {
+		rect.Min.X = minInt(rect.Min.X+delta.X, rect.Max.X-minChromeSize)
+	}
+	if c.resizing&chromeEdgeRight != 0 {
+		rect.Max.X = maxInt(rect.Max.X+delta.X, rect.Min.X+minChromeSize)
+	}
+	if c.resizing&chromeEdgeTop != 0 {
+		rect.Min.Y = minInt(rect.Min.Y+delta.Y, rect.Max.Y-minChromeSize)
+	}
+	if c.resizing&chromeEdgeBottom != 0 {
+		rect.Max.Y = maxInt(rect.Max.Y+delta.Y, rect.Min.Y+minChromeSize)
+	}
+
+	c.window.SetPos(rect.Min.X, rect.Min.Y)
+	c.window.SetSize(rect.Dx(), rect.Dy())
+}
+
+// Buttons draws minimize, maximize or restore, and close buttons at the end of
+// the current line, e.g. in a menu bar. Outside of menu bars, call SameLine first.
+func (c *WindowChrome) Buttons() {
+	maximize := "[ ]"
+	if c.window.IsMaximized() {
+		maximize = "[=]"
+	}
+	labels := []string{"_", maximize, "X"}
+
+	style := GetStyle()
+	spacing := style.GetItemSpacing().X
+	width := -spacing
+	for _, label := range labels {
+		var size ImVec2
+		CalcTextSize(&size, label)
+		width += size.X + 2*style.GetFramePadding().X + spacing
+	}
+
+	var avail ImVec2
+	GetContentRegionAvail(&avail)
+	if avail.X > width {
+		Dummy(NewImVec2(avail.X-width, 0))
+		SameLineV(0, 0)
+	}
+
+	PushID_Str("##WindowChrome")
+	defer PopID()
+
+	if SmallButton(labels[0] + "##Minimize") {
+		c.window.Iconify()
+	}
+	SameLine()
+	if SmallButton(labels[1] + "##Maximize") {
+		c.ToggleMaximize()
+	}
+	SameLine()
+	if SmallButton(labels[2] + "##Close") {
+		c.Close()
+	}
+}
+
+// ToggleMaximize maximizes the window, or restores it when maximized.
+func (c *WindowChrome) ToggleMaximize() {
+	if c.window.IsMaximized() {
+		c.window.Restore()
+	} else {
+		c.window.Maximize()
+	}
+}
+
+// Close asks the window to close, as its close button would: the OnCloseRequest
+// callback may still keep it open.
+func (c *WindowChrome) Close() {
+	if state := c.window.state(); state != nil && state.closeRequest != nil && !state.closeRequest() {
+		return
+	}
+
+	c.window.SetShouldClose(true)
+}
+
+// start records the cursor and window geometry a drag or resize starts from.
+func (c *WindowChrome) start() {
+	x, y := c.window.Pos()
+	width, height := c.window.Size()
+	c.startRect = image.Rect(x, y, x+width, y+height)
+	c.startCursor = c.cursor()
+}
+
+// cursor returns the cursor position in screen coordinates, which unlike
+// the mouse position of dear imgui does not move along with the window.
+func (c *WindowChrome) cursor() image.Point {
+	x, y := c.window.CursorPos()
+	wx, wy := c.window.Pos()
+
+	return image.Pt(wx+int(x), wy+int(y))
+}
+
+func (c *WindowChrome) edgesAt(x, y, width, height int) chromeEdges {
+	if x < 0 || y < 0 || x >= width || y >= height {
+		return 0
+	}
+
+	var edges chromeEdges
+	if x < c.BorderSize {
+		edges |= chromeEdgeLeft
+	} else if x >= width-c.BorderSize {
+		edges |= chromeEdgeRight
+	}
+	if y < c.BorderSize {
+		edges |= chromeEdgeTop
+	} else if y >= height-c.BorderSize {
+		edges |= chromeEdgeBottom
+	}
+
+	return edges
+}
+
+func (edges chromeEdges) mouseCursor() ImGuiMouseCursor {
+	switch edges {
+	case chromeEdgeLeft, chromeEdgeRight:
+		return ImGuiMouseCursor_ResizeEW
+	case chromeEdgeTop, chromeEdgeBottom:
+		return ImGuiMouseCursor_ResizeNS
+	case chromeEdgeLeft | chromeEdgeTop, chromeEdgeRight | chromeEdgeBottom:
+		return ImGuiMouseCursor_ResizeNWSE
+	default:
+		return ImGuiMouseCursor_ResizeNESW
+	}
+}
@@ -0,0 +1,348 @@
+package cimgui
+
+// #include "backend.h"
+import "C"
+import (
+	"image"
+	"unsafe"
+)
+
+// GLFWmonitor is a monitor connected to the computer. Monitors can only
+// be queried once a window was created.
+type GLFWmonitor uintptr
+
+func (m GLFWmonitor) handle() *C.GLFWmonitor {
+	return (*C.GLFWmonitor)(unsafe.Pointer(m))
+}
+
+// Monitors returns the connected monitors, the primary one first.
+func Monitors() []GLFWmonitor {
+	var count C.int
+	monitors := C.igGetMonitors(&count)
+	if monitors == nil || count == 0 {
+		return nil
+	}
+
+	result := make([]GLFWmonitor, int(count))
+	for i, m := range unsafe.Slice(monitors, int(count)) {
+		result[i] = GLFWmonitor(unsafe.Pointer(m))
+	}
+
+	return result
+}
+
+// PrimaryMonitor returns the monitor with the task bar or global menu bar, 0 if none.
+func PrimaryMonitor() GLFWmonitor {
+	return GLFWmonitor(unsafe.Pointer(C.igGetPrimaryMonitor()))
+}
+
+func (m GLFWmonitor) Name() string {
+	return C.GoString(C.igGLFWMonitor_GetName(m.handle()))
+}
+
+// Pos returns the position of the monitor on the virtual desktop, in screen coordinates.
+func (m GLFWmonitor) Pos() (x, y int) {
+	var cx, cy C.int
+	C.igGLFWMonitor_GetPos(m.handle(), &cx, &cy)
+
+	return int(cx), int(cy)
+}
+
+// WorkArea returns the area of the monitor not occupied by task bars or menu bars, in screen coordinates.
+func (m GLFWmonitor) WorkArea() image.Rectangle {
+	var x, y, width, height C.int
+	C.igGLFWMonitor_GetWorkarea(m.handle(), &x, &y, &width, &height)
+
+	return image.Rect(int(x), int(y), int(x+width), int(y+height))
+}
+
+// ContentScale returns the ratio between the DPI of the monitor and the platform default DPI.
+func (m GLFWmonitor) ContentScale() (x, y float32) {
+	var cx, cy C.float
+	C.igGLFWMonitor_GetContentScale(m.handle(), &cx, &cy)
+
+	return float32(cx), float32(cy)
+}
+
+// VideoMode returns the current resolution and refresh rate of the monitor.
+func (m GLFWmonitor) VideoMode() (width, height, refreshRate int) {
+	var cw, ch, cr C.int
+	C.igGLFWMonitor_GetVideoMode(m.handle(), &cw, &ch, &cr)
+
+	return int(cw), int(ch), int(cr)
+}
+
+func (w GLFWwindow) SetTitle(title string) {
+	if w.state() == nil {
+		return
+	}
+
+	titleArg, titleFin := wrapString(title)
+	defer titleFin()
+
+	C.igGLFWWindow_SetTitle(w.handle(), titleArg)
+}
+
+// Size returns the size of the content area of the window, in screen coordinates.
+func (w GLFWwindow) Size() (width, height int) {
+	if w.state() == nil {
+		return 0, 0
+	}
+
+	var cw, ch C.int
+	C.igGLFWWindow_GetDisplaySize(w.handle(), &cw, &ch)
+
+	return int(cw), int(ch)
+}
+
+func (w GLFWwindow) SetSize(width, height int) {
+	if w.state() == nil {
+		return
+	}
+
+	C.igGLFWWindow_SetSize(w.handle(), C.int(width), C.int(height))
+}
+
+// Pos returns the position of the top left corner of the content area, in screen coordinates.
+func (w GLFWwindow) Pos() (x, y int) {
+	if w.state() == nil {
+		return 0, 0
+	}
+
+	var cx, cy C.int
+	C.igGLFWWindow_GetPos(w.handle(), &cx, &cy)
+
+	return int(cx), int(cy)
+}
+
+func (w GLFWwindow) SetPos(x, y int) {
+	if w.state() == nil {
+		return
+	}
+
+	C.igGLFWWindow_SetPos(w.handle(), C.int(x), C.int(y))
+}
+
+// SetSizeLimits limits the size of the content area, -1 removes a limit.
+func (w GLFWwindow) SetSizeLimits(minWidth, minHeight, maxWidth, maxHeight int) {
+	if w.state() == nil {
+		return
+	}
+
+	C.igGLFWWindow_SetSizeLimits(w.handle(), C.int(minWidth), C.int(minHeight), C.int(maxWidth), C.int(maxHeight))
+}
+
+// SetIcon sets the icon of the window, the system picks the image closest
+// to the size it needs. Without images, the default icon is restored.
+func (w GLFWwindow) SetIcon(images ...image.Image) {
+	if w.state() == nil {
+		return
+	}
+
+	sizes := make([]C.int, 0, len(images)*2)
+	var pixels []byte
+	for _, img := range images {
+		nrgba := imageToNRGBA(img)
+		sizes = append(sizes, C.int(nrgba.Rect.Dx()), C.int(nrgba.Rect.Dy()))
+		pixels = append(pixels, nrgba.Pix...)
+	}
+
+	var sizesArg *C.int
+	var pixelsArg *C.uchar
+	if len(pixels) > 0 {
+		sizesArg = &sizes[0]
+		pixelsArg = (*C.uchar)(unsafe.Pointer(&pixels[0]))
+	}
+
+	C.igGLFWWindow_SetIcon(w.handle(), C.int(len(images)), sizesArg, pixelsArg)
+}
+
+// Monitor returns the monitor the window is fullscreen on, 0 when windowed.
+func (w GLFWwindow) Monitor() GLFWmonitor {
+	if w.state() == nil {
+		return 0
+	}
+
+	return GLFWmonitor(unsafe.Pointer(C.igGLFWWindow_GetMonitor(w.handle())))
+}
+
+// SetFullscreen makes the window fullscreen on monitor, at its current video
+// mode. A 0 monitor picks the monitor the window is on.
+func (w GLFWwindow) SetFullscreen(monitor GLFWmonitor) {
+	state := w.state()
+	if state == nil {
+		return
+	}
+
+	current := w.Monitor()
+	if monitor == 0 {
+		monitor = current
+	}
+
+	if current == 0 {
+		x, y := w.Pos()
+		width, height := w.Size()
+		state.windowed = image.Rect(x, y, x+width, y+height)
+
+		if monitor == 0 {
+			monitor = monitorAt(state.windowed)
+		}
+	}
+
+	if monitor == 0 {
+		return
+	}
+
+	width, height, refreshRate := monitor.VideoMode()
+	C.igGLFWWindow_SetMonitor(w.handle(), monitor.handle(), 0, 0, C.int(width), C.int(height), C.int(refreshRate))
+}
+
+// monitorAt returns the monitor showing most of r, the primary monitor if none does.
+func monitorAt(r image.Rectangle) GLFWmonitor {
+	best, bestArea := PrimaryMonitor(), 0
+	for _, m := range Monitors() {
+		x, y := m.Pos()
+		width, height, _ := m.VideoMode()
+		overlap := r.Intersect(image.Rect(x, y, x+width, y+height))
+		if area := overlap.Dx() * overlap.Dy(); area > bestArea {
+			best, bestArea = m, area
+		}
+	}
+
+	return best
+}
+
+// SetWindowed leaves fullscreen, restoring the position and size the window had before.
+func (w GLFWwindow) SetWindowed() {
+	state := w.state()
+	if state == nil || w.Monitor() == 0 {
+		return
+	}
+
+	r := state.windowed
+	C.igGLFWWindow_SetMonitor(w.handle(), nil, C.int(r.Min.X), C.int(r.Min.Y), C.int(r.Dx()), C.int(r.Dy()), 0)
+}
+
+func (w GLFWwindow) Iconify() {
+	if w.state() == nil {
+		return
+	}
+
+	C.igGLFWWindow_Iconify(w.handle())
+}
+
+func (w GLFWwindow) Maximize() {
+	if w.state() == nil {
+		return
+	}
+
+	C.igGLFWWindow_Maximize(w.handle())
+}
+
+// Restore restores an iconified or maximized window.
+func (w GLFWwindow) Restore() {
+	if w.state() == nil {
+		return
+	}
+
+	C.igGLFWWindow_Restore(w.handle())
+}
+
+func (w GLFWwindow) IsIconified() bool {
+	if w.state() == nil {
+		return false
+	}
+
+	return C.igGLFWWindow_IsIconified(w.handle()) == C.bool(true)
+}
+
+func (w GLFWwindow) IsMaximized() bool {
+	if w.state() == nil {
+		return false
+	}
+
+	return C.igGLFWWindow_IsMaximized(w.handle()) == C.bool(true)
+}
+
+// OnDrop sets a function called with the paths of the files dropped on the window.
+func (w GLFWwindow) OnDrop(callback func(paths []string)) {
+	if state := w.state(); state != nil {
+		state.drop = callback
+	}
+}
+
+// OnCloseRequest sets a function called when the user tries to close the
+// window, returning false keeps the window open.
+func (w GLFWwindow) OnCloseRequest(callback func() bool) {
+	if state := w.state(); state != nil {
+		state.closeRequest = callback
+	}
+}
+
+// OnFocus sets a function called when the window gains or loses the input focus.
+func (w GLFWwindow) OnFocus(callback func(focused bool)) {
+	if state := w.state(); state != nil {
+		state.focus = callback
+	}
+}
+
+// OnResize sets a function called with the new size of the content area, in screen coordinates.
+func (w GLFWwindow) OnResize(callback func(width, height int)) {
+	if state := w.state(); state != nil {
+		state.resize = callback
+	}
+}
+
+// OnContentScaleChanged sets a function called when the content scale of the
+// window changes, e.g. when it moves to a monitor with another DPI.
+func (w GLFWwindow) OnContentScaleChanged(callback func(x, y float32)) {
+	if state := w.state(); state != nil {
+		state.contentScaleChanged = callback
+	}
+}
+
+//export glfwWindowDropCallback
+func glfwWindowDropCallback(window *C.GLFWwindow, count C.int, paths **C.char) {
+	state := GLFWwindow(unsafe.Pointer(window)).state()
+	if state == nil || state.drop == nil {
+		return
+	}
+
+	goPaths := make([]string, int(count))
+	for i, path := range unsafe.Slice(paths, int(count)) {
+		goPaths[i] = C.GoString(path)
+	}
+
+	state.drop(goPaths)
+}
+
+//export glfwWindowCloseCallback
+func glfwWindowCloseCallback(window *C.GLFWwindow) C.bool {
+	state := GLFWwindow(unsafe.Pointer(window)).state()
+	if state == nil || state.closeRequest == nil {
+		return C.bool(true)
+	}
+
+	return C.bool(state.closeRequest())
+}
+
+//export glfwWindowFocusCallback
+func glfwWindowFocusCallback(window *C.GLFWwindow, focused C.int) {
+	if state := GLFWwindow(unsafe.Pointer(window)).state(); state != nil && state.focus != nil {
+		state.focus(focused != 0)
+	}
+}
+
+//export glfwWindowSizeCallback
+func glfwWindowSizeCallback(window *C.GLFWwindow, width, height C.int) {
+	if state := GLFWwindow(unsafe.Pointer(window)).state(); state != nil && state.resize != nil {
+		state.resize(int(width), int(height))
+	}
+}
+
+//export glfwWindowContentScaleCallback
+func glfwWindowContentScaleCallback(window *C.GLFWwindow, x, y C.float) {
+	if state := GLFWwindow(unsafe.Pointer(window)).state(); state != nil && state.contentScaleChanged != nil {
+		state.contentScaleChanged(float32(x), float32(y))
+	}
+}
@@ -0,0 +1,232 @@
+package cimgui
+
+import (
+	"image"
+	"image/color"
+	"time"
+	"unsafe"
+)
+
+// HeadlessBackend is a Backend without any display: frames are rasterized
+// into an image by a SoftwareRenderer, and input has to be fed through ImGuiIO.
+// It lets the whole UI run on machines without a GPU, e.g. in CI.
+type HeadlessBackend struct {
+	renderer    *SoftwareRenderer
+	context     ImGuiContext
+	width       int32
+	height      int32
+	scaleX      float32
+	scaleY      float32
+	clearColor  color.Color
+	shouldClose bool
+	lastFrame   time.Time
+	clipboard   string
+	mouseCursor ImGuiMouseCursor
+	cursorMode  CursorMode
+	cursorPos   ImVec2
+	wake        chan struct{}
+}
+
+var _ Backend = (*HeadlessBackend)(nil)
+
+// NewHeadlessBackend creates a dear imgui context with a width x height display.
+// The .ini file is neither loaded nor saved, unless set with Window.SetIniFile.
+func NewHeadlessBackend(width, height int) *HeadlessBackend {
+	b := &HeadlessBackend{
+		renderer:   NewSoftwareRenderer(width, height),
+		context:    CreateContext(),
+		width:      int32(width),
+		height:     int32(height),
+		scaleX:     1,
+		scaleY:     1,
+		clearColor: color.RGBA{R: 0x73, G: 0x8c, B: 0x99, A: 0xff},
+		wake:       make(chan struct{}, 1),
+	}
+
+	SetCurrentContext(b.context)
+
+	io := GetIO()
+	io.handle().IniFilename = nil
+	io.SetConfigFlags(io.GetConfigFlags() | ImGuiConfigFlags_NavEnableKeyboard | ImGuiConfigFlags_DockingEnable)
+	io.SetBackendFlags(io.GetBackendFlags() | ImGuiBackendFlags_HasSetMousePos)
+	StyleColorsDark()
+
+	b.renderer.CreateFontsTexture()
+
+	return b
+}
+
+// Image returns the last rendered frame.
+func (b *HeadlessBackend) Image() *image.RGBA {
+	return b.renderer.Image()
+}
+
+// SetDisplaySize resizes the display, starting with the next frame.
+func (b *HeadlessBackend) SetDisplaySize(width, height int) {
+	b.width = int32(width)
+	b.height = int32(height)
+}
+
+// SetContentScale sets the content scale reported to the window, e.g. to test High-DPI.
+// The framebuffer keeps the display size, as on Windows and Linux.
+func (b *HeadlessBackend) SetContentScale(x, y float32) {
+	b.scaleX = x
+	b.scaleY = y
+}
+
+// MouseCursor returns the cursor last set with SetMouseCursor.
+func (b *HeadlessBackend) MouseCursor() ImGuiMouseCursor {
+	return b.mouseCursor
+}
+
+// SetCursorMode records mode, there is no cursor to show or hide.
+func (b *HeadlessBackend) SetCursorMode(mode CursorMode) {
+	b.cursorMode = mode
+}
+
+// CursorMode returns the mode set with SetCursorMode.
+func (b *HeadlessBackend) CursorMode() CursorMode {
+	return b.cursorMode
+}
+
+// CursorPos returns where the cursor was last warped, by SetCursorPos or
+// by dear imgui through io.WantSetMousePos.
+func (b *HeadlessBackend) CursorPos() (x, y float32) {
+	return b.cursorPos.X, b.cursorPos.Y
+}
+
+// SetCursorPos warps the cursor to x, y and moves the dear imgui mouse along.
+func (b *HeadlessBackend) SetCursorPos(x, y float32) {
+	b.cursorPos = NewImVec2(x, y)
+
+	SetCurrentContext(b.context)
+	GetIO().AddMousePosEvent(x, y)
+}
+
+func (b *HeadlessBackend) NewFrame() {
+	now := time.Now()
+	deltaTime := float32(1.0 / 60.0)
+	if !b.lastFrame.IsZero() && now.After(b.lastFrame) {
+		deltaTime = float32(now.Sub(b.lastFrame).Seconds())
+	}
+	b.lastFrame = now
+
+	SetCurrentContext(b.context)
+
+	io := GetIO()
+	io.SetDisplaySize(NewImVec2(float32(b.width), float32(b.height)))
+	io.SetDeltaTime(deltaTime)
+
+	if io.GetWantSetMousePos() {
+		b.cursorPos = io.GetMousePos()
+	}
+}
+
+// ProcessEvents does nothing, input is queued directly into ImGuiIO.
+func (b *HeadlessBackend) ProcessEvents() {}
+
+// WaitEvents sleeps until PostEmptyEvent is called, e.g. by Window.Refresh
+// or Window.Post: input fed through ImGuiIO doesn't wake the backend up.
+func (b *HeadlessBackend) WaitEvents() {
+	<-b.wake
+}
+
+// WaitEventsTimeout sleeps for timeout, or until PostEmptyEvent is called.
+func (b *HeadlessBackend) WaitEventsTimeout(timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-b.wake:
+	}
+}
+
+func (b *HeadlessBackend) PostEmptyEvent() {
+	select {
+	case b.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (b *HeadlessBackend) ShouldClose() bool {
+	return b.shouldClose
+}
+
+func (b *HeadlessBackend) SetShouldClose(value bool) {
+	b.shouldClose = value
+}
+
+func (b *HeadlessBackend) DisplaySize() (width int32, height int32) {
+	return b.width, b.height
+}
+
+func (b *HeadlessBackend) FramebufferSize() (width int32, height int32) {
+	return b.width, b.height
+}
+
+func (b *HeadlessBackend) ContentScale() (x, y float32) {
+	return b.scaleX, b.scaleY
+}
+
+// ClipboardText returns the text of the clipboard of the backend, it is
+// not shared with other backends nor the OS.
+func (b *HeadlessBackend) ClipboardText() string {
+	return b.clipboard
+}
+
+func (b *HeadlessBackend) SetClipboardText(text string) {
+	b.clipboard = text
+}
+
+func (b *HeadlessBackend) SetMouseCursor(cursor ImGuiMouseCursor) {
+	b.mouseCursor = cursor
+}
+
+func (b *HeadlessBackend) Context() ImGuiContext {
+	return b.context
+}
+
+func (b *HeadlessBackend) Shutdown() {
+	DestroyContextV(b.context)
+}
+
+func (b *HeadlessBackend) CreateTexture(pixels unsafe.Pointer, width, height int, options TextureOptions) ImTextureID {
+	return b.renderer.CreateTexture(pixels, width, height, options)
+}
+
+func (b *HeadlessBackend) UpdateTexture(id ImTextureID, pixels unsafe.Pointer, width, height int) {
+	b.renderer.UpdateTexture(id, pixels, width, height)
+}
+
+func (b *HeadlessBackend) DeleteTexture(id ImTextureID) {
+	b.renderer.DeleteTexture(id)
+}
+
+func (b *HeadlessBackend) Textures() []TextureInfo {
+	return b.renderer.Textures()
+}
+
+// CaptureFrame returns a copy of the last rendered frame.
+func (b *HeadlessBackend) CaptureFrame() (*image.RGBA, error) {
+	frame := b.renderer.Image()
+	img := image.NewRGBA(frame.Rect)
+	copy(img.Pix, frame.Pix)
+
+	return img, nil
+}
+
+func (b *HeadlessBackend) SetClearColor(c ImVec4) {
+	b.clearColor = color.NRGBA{R: toByte(c.X), G: toByte(c.Y), B: toByte(c.W), A: toByte(c.Z)}
+}
+
+func (b *HeadlessBackend) ReloadFontsTexture() {
+	b.renderer.ReloadFontsTexture()
+}
+
+func (b *HeadlessBackend) RenderDrawData(data ImDrawData) {
+	scale := data.GetFramebufferScale()
+	b.renderer.Resize(int(float32(b.width)*scale.X), int(float32(b.height)*scale.Y))
+	b.renderer.Clear(b.clearColor)
+	b.renderer.RenderDrawData(data)
+}
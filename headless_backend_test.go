@@ -0,0 +1,514 @@
+package cimgui
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHeadlessBackendRunsWindow(t *testing.T) {
+	backend := NewHeadlessBackend(320, 240)
+	window := NewWindow(backend)
+
+	frames := 0
+	window.Run(func() {
+		frames++
+
+		SetNextWindowPosV(NewImVec2(10, 10), ImGuiCond_Always, NewImVec2(0, 0))
+		SetNextWindowSizeV(NewImVec2(200, 100), ImGuiCond_Always)
+		Begin("Headless")
+		Text("Hello without a display")
+		End()
+
+		if frames == 3 {
+			backend.SetShouldClose(true)
+		}
+	})
+
+	if frames != 3 {
+		t.Errorf("expect 3 frames got %d", frames)
+	}
+
+	img := backend.Image()
+	if got := img.Bounds().Dx(); got != 320 {
+		t.Errorf("expect a 320 pixels wide frame got %d", got)
+	}
+	if got := img.RGBAAt(300, 200); got != (color.RGBA{R: 0x73, G: 0x8c, B: 0x99, A: 0xff}) {
+		t.Errorf("outside window: expect clear color got %v", got)
+	}
+	if got := img.RGBAAt(100, 60); got == (color.RGBA{R: 0x73, G: 0x8c, B: 0x99, A: 0xff}) {
+		t.Errorf("inside window: expect the window background got the clear color")
+	}
+}
+
+func TestWindowStepAndClose(t *testing.T) {
+	backend := NewHeadlessBackend(64, 64)
+	window := NewWindow(backend)
+
+	frames := 0
+	window.SetLoop(func() {
+		frames++
+		if frames == 5 {
+			window.Close()
+		}
+	})
+
+	for i := 0; i < 3; i++ {
+		window.Step()
+	}
+	if frames != 3 || window.ShouldClose() {
+		t.Fatalf("expect 3 frames on an open window got %d frames, closed: %v", frames, window.ShouldClose())
+	}
+
+	for !window.ShouldClose() {
+		window.Step()
+	}
+	if frames != 5 {
+		t.Errorf("expect the window to close after the 5th frame, got %d frames", frames)
+	}
+
+	window.Step()
+	window.Close()
+	if frames != 5 {
+		t.Errorf("expect no frame once closed got %d frames", frames)
+	}
+}
+
+func TestRunWindowsKeepsContextsApart(t *testing.T) {
+	first := NewWindow(NewHeadlessBackend(64, 64))
+	second := NewWindow(NewHeadlessBackend(32, 32))
+	first.SetTargetFPS(0)
+	second.SetTargetFPS(0)
+
+	frames := map[*Window]int{}
+	for _, w := range []*Window{first, second} {
+		w := w
+		w.SetLoop(func() {
+			if got, want := GetCurrentContext(), w.Backend().Context(); got != want {
+				t.Errorf("expect context %v got %v", want, got)
+			}
+
+			frames[w]++
+			if frames[w] == 2 {
+				w.Close()
+			}
+		})
+	}
+
+	RunWindows(first, second)
+
+	if frames[first] != 2 || frames[second] != 2 {
+		t.Errorf("expect 2 frames per window got %d and %d", frames[first], frames[second])
+	}
+}
+
+func TestWindowOnDemandRendersAfterRefresh(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(64, 64))
+	window.SetTargetFPS(0)
+	window.SetOnDemand(true)
+
+	var refreshed atomic.Bool
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		refreshed.Store(true)
+		window.Refresh()
+	}()
+
+	// Fail instead of waiting forever if the window is never woken up
+	timeout := time.AfterFunc(5*time.Second, func() {
+		window.Post(func() {
+			t.Error("expect Refresh to wake the window up")
+			window.Close()
+		})
+	})
+	defer timeout.Stop()
+
+	frames := 0
+	framesBeforeRefresh := -1
+	window.Run(func() {
+		frames++
+		if refreshed.Load() && framesBeforeRefresh < 0 {
+			framesBeforeRefresh = frames - 1
+		}
+
+		if frames == 2*wakeFrameCount {
+			window.Close()
+		}
+	})
+
+	if framesBeforeRefresh != wakeFrameCount {
+		t.Errorf("expect %d frames before the refresh got %d", wakeFrameCount, framesBeforeRefresh)
+	}
+	if frames != 2*wakeFrameCount {
+		t.Errorf("expect %d frames got %d", 2*wakeFrameCount, frames)
+	}
+}
+
+func TestWindowTextureLifecycle(t *testing.T) {
+	backend := NewHeadlessBackend(64, 64)
+	window := NewWindow(backend)
+	defer window.Close()
+
+	img := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{G: 255, A: 255}), image.Point{}, draw.Src)
+
+	fonts := len(backend.Textures())
+	id := window.NewTextureFromImage(img, TextureOptions{Wrap: TextureWrapClampToEdge})
+
+	window.SetLoop(func() {
+		GetBackgroundDrawList_Nil().AddImage(id, NewImVec2(0, 0), NewImVec2(32, 32))
+	})
+	window.Step()
+
+	if got := backend.Image().RGBAAt(16, 16); got != (color.RGBA{G: 255, A: 255}) {
+		t.Errorf("expect the texture to be drawn got %v", got)
+	}
+
+	window.UpdateTextureFromImage(id, image.NewNRGBA(image.Rect(0, 0, 8, 8)))
+
+	textures := backend.Textures()
+	if len(textures) != fonts+1 {
+		t.Fatalf("expect %d live textures got %d", fonts+1, len(textures))
+	}
+	if got := textures[len(textures)-1]; got.ID != id || got.Width != 8 || got.Height != 8 || got.Options.Wrap != TextureWrapClampToEdge {
+		t.Errorf("expect the updated 8x8 clamped texture got %+v", got)
+	}
+
+	window.DeleteTexture(id)
+	if got := len(backend.Textures()); got != fonts {
+		t.Errorf("expect %d live textures after delete got %d", fonts, got)
+	}
+}
+
+func TestWindowEmptyTexture(t *testing.T) {
+	backend := NewHeadlessBackend(64, 64)
+	window := NewWindow(backend)
+	defer window.Close()
+
+	textures := len(backend.Textures())
+	if id := window.NewTextureFromImage(image.NewRGBA(image.Rectangle{}), TextureOptions{}); id != 0 {
+		t.Errorf("expect no texture for an empty image got %v", id)
+	}
+	if id := backend.CreateTexture(nil, 0, 4, TextureOptions{}); id != 0 {
+		t.Errorf("expect no texture for a 0 size got %v", id)
+	}
+
+	id := window.NewTextureFromImage(image.NewRGBA(image.Rect(0, 0, 2, 2)), TextureOptions{})
+	window.UpdateTextureFromImage(id, image.NewRGBA(image.Rectangle{}))
+	backend.UpdateTexture(id, nil, 0, 0)
+
+	got := backend.Textures()
+	if len(got) != textures+1 || got[len(got)-1].Width != 2 {
+		t.Errorf("expect the 2x2 texture to be left unchanged got %+v", got)
+	}
+
+	window.SetLoop(func() {
+		GetBackgroundDrawList_Nil().AddImage(id, NewImVec2(0, 0), NewImVec2(32, 32))
+	})
+	window.Step()
+}
+
+func TestWindowReloadFonts(t *testing.T) {
+	backend := NewHeadlessBackend(64, 64)
+	window := NewWindow(backend)
+	defer window.Close()
+
+	window.Step()
+	textures := len(backend.Textures())
+	fontsTexture := GetIO().GetFonts().handle().TexID
+
+	window.ReloadFonts(func(atlas ImFontAtlas) {
+		atlas.Clear()
+		atlas.AddFontDefault()
+		atlas.AddFontDefault()
+	})
+	window.Step()
+
+	atlas := GetIO().GetFonts()
+	if got := atlas.handle().Fonts.Size; got != 2 {
+		t.Errorf("expect 2 fonts got %d", got)
+	}
+	if atlas.handle().TexID == fontsTexture {
+		t.Error("expect a new fonts texture")
+	}
+	if got := len(backend.Textures()); got != textures {
+		t.Errorf("expect the old fonts texture to be deleted, %d live textures instead of %d", got, textures)
+	}
+}
+
+func TestWindowDPIScaling(t *testing.T) {
+	backend := NewHeadlessBackend(64, 64)
+	window := NewWindow(backend)
+	defer window.Close()
+
+	window.Step()
+	padding := GetStyle().GetWindowPadding()
+
+	backend.SetContentScale(2, 2)
+	var built float32
+	window.SetDPIScaling(&DPIScaling{BuildFonts: func(atlas ImFontAtlas, scale float32) {
+		built = scale
+		atlas.AddFontDefault()
+	}})
+	window.Step()
+
+	if got := GetStyle().GetWindowPadding(); got.X != 2*padding.X || got.Y != 2*padding.Y {
+		t.Errorf("expect the window padding %v to be doubled got %v", padding, got)
+	}
+	if built != 2 || window.ContentScale() != 2 {
+		t.Errorf("expect the fonts to be built at scale 2 got %v", built)
+	}
+
+	window.SetDPIScaling(nil)
+	window.Step()
+
+	if got := GetStyle().GetWindowPadding(); got != padding {
+		t.Errorf("expect the window padding %v to be restored got %v", padding, got)
+	}
+}
+
+func TestWindowCaptureWindow(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(320, 240))
+	defer window.Close()
+
+	window.SetLoop(func() {
+		SetNextWindowPosV(NewImVec2(10, 20), ImGuiCond_Always, NewImVec2(0, 0))
+		SetNextWindowSizeV(NewImVec2(100, 50), ImGuiCond_Always)
+		Begin("Captured")
+		End()
+	})
+	window.Step()
+
+	frame, err := window.CaptureFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := frame.Bounds().Size(); got != image.Pt(320, 240) {
+		t.Errorf("expect a 320x240 frame got %v", got)
+	}
+
+	img, err := window.CaptureWindow("Captured")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.Bounds().Size(); got != image.Pt(100, 50) {
+		t.Errorf("expect a 100x50 capture got %v", got)
+	}
+	if got, want := img.RGBAAt(50, 25), frame.RGBAAt(60, 45); got != want {
+		t.Errorf("expect the capture to match the frame, got %v want %v", got, want)
+	}
+
+	var posted *image.RGBA
+	window.Post(func() { posted, err = window.CaptureFrame() })
+	window.Step()
+	if err != nil || posted.Bounds() != frame.Bounds() {
+		t.Errorf("expect a capture from Post, got %v", err)
+	}
+
+	if _, err := window.CaptureWindow("Missing"); err == nil {
+		t.Error("expect an error for a missing window")
+	}
+}
+
+func TestWindowGamepadHook(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(64, 64))
+	defer window.Close()
+
+	io := GetIO()
+	io.SetConfigFlags(io.GetConfigFlags() | ImGuiConfigFlags_NavEnableGamepad)
+
+	pressed := true
+	window.SetGamepadHook(func(state GamepadState) {
+		if pressed {
+			state[ImGuiKey_GamepadFaceDown] = 1
+		}
+		state[ImGuiKey_GamepadLStickLeft] = 0.05
+	})
+
+	var down, deadZone bool
+	window.SetLoop(func() {
+		down = IsKeyDown(ImGuiKey_GamepadFaceDown)
+		deadZone = IsKeyDown(ImGuiKey_GamepadLStickLeft)
+	})
+
+	window.Step()
+	if !down || deadZone {
+		t.Errorf("expect only the face button to be down got %v and %v", down, deadZone)
+	}
+	if io.GetBackendFlags()&ImGuiBackendFlags_HasGamepad == 0 {
+		t.Error("expect the backend to have a gamepad")
+	}
+
+	pressed = false
+	window.Step()
+	if down {
+		t.Error("expect the face button to be released")
+	}
+
+	window.SetGamepadHook(nil)
+	window.Step()
+	if io.GetBackendFlags()&ImGuiBackendFlags_HasGamepad != 0 {
+		t.Error("expect the backend to have no gamepad once the hook is removed")
+	}
+}
+
+// padBackend has a real gamepad holding its B button, fed as the GLFW backend does.
+type padBackend struct {
+	*HeadlessBackend
+}
+
+func (b padBackend) NewFrame() {
+	b.HeadlessBackend.NewFrame()
+
+	io := GetIO()
+	if io.GetConfigFlags()&ImGuiConfigFlags_NavEnableGamepad == 0 {
+		return
+	}
+
+	pad := GamepadState{}
+	b.gamepad(pad)
+	for key := ImGuiKey(ImGuiKey_GamepadStart); key <= ImGuiKey_GamepadRStickDown; key++ {
+		io.AddKeyAnalogEvent(key, pad[key] > gamepadDeadZone, pad[key])
+	}
+}
+
+func (b padBackend) gamepad(state GamepadState) bool {
+	state[ImGuiKey_GamepadFaceRight] = 1
+	return true
+}
+
+func TestWindowGamepadHookHeldWithRealGamepad(t *testing.T) {
+	window := NewWindow(padBackend{NewHeadlessBackend(64, 64)})
+	defer window.Close()
+
+	io := GetIO()
+	io.SetConfigFlags(io.GetConfigFlags() | ImGuiConfigFlags_NavEnableGamepad)
+
+	window.SetGamepadHook(func(state GamepadState) {
+		state[ImGuiKey_GamepadFaceDown] = 1
+	})
+
+	var synthetic, physical bool
+	window.SetLoop(func() {
+		synthetic = IsKeyDown(ImGuiKey_GamepadFaceDown)
+		physical = IsKeyDown(ImGuiKey_GamepadFaceRight)
+	})
+
+	for i := 0; i < 5; i++ {
+		window.Step()
+		if !synthetic || !physical {
+			t.Fatalf("expect the synthetic and real keys to be down on frame %d got %v and %v", i, synthetic, physical)
+		}
+	}
+
+	window.SetGamepadHook(nil)
+	window.Step()
+	window.Step()
+	if synthetic || !physical {
+		t.Errorf("expect only the real key to be down once the hook is removed got %v and %v", synthetic, physical)
+	}
+	if io.GetBackendFlags()&ImGuiBackendFlags_HasGamepad == 0 {
+		t.Error("expect the backend to keep its real gamepad")
+	}
+}
+
+func TestHeadlessBackendSetCursorPos(t *testing.T) {
+	backend := NewHeadlessBackend(64, 64)
+	window := NewWindow(backend)
+	defer window.Close()
+
+	backend.SetCursorPos(12, 34)
+
+	var pos ImVec2
+	window.SetLoop(func() {
+		GetMousePos(&pos)
+	})
+	window.Step()
+
+	if pos != NewImVec2(12, 34) {
+		t.Errorf("expect the mouse at (12, 34) got %v", pos)
+	}
+	if x, y := backend.CursorPos(); x != 12 || y != 34 {
+		t.Errorf("expect the cursor at (12, 34) got (%v, %v)", x, y)
+	}
+}
+
+func TestWindowMouseCursor(t *testing.T) {
+	backend := NewHeadlessBackend(320, 240)
+	window := NewWindow(backend)
+	defer window.Close()
+
+	window.SetLoop(func() {
+		SetNextWindowPosV(NewImVec2(10, 10), ImGuiCond_Always, NewImVec2(0, 0))
+		SetNextWindowSizeV(NewImVec2(200, 100), ImGuiCond_Always)
+		Begin("Resizable")
+		End()
+	})
+	window.Step()
+
+	// The resize grip is in the bottom right corner of the window
+	GetIO().AddMousePosEvent(207, 107)
+	window.Step()
+	window.Step()
+
+	if got := backend.MouseCursor(); got != ImGuiMouseCursor_ResizeNWSE {
+		t.Errorf("expect the resize cursor over the grip got %v", got)
+	}
+
+	io := GetIO()
+	io.SetConfigFlags(io.GetConfigFlags() | ImGuiConfigFlags_NoMouseCursorChange)
+	io.AddMousePosEvent(300, 200)
+	window.Step()
+	window.Step()
+
+	if got := backend.MouseCursor(); got != ImGuiMouseCursor_ResizeNWSE {
+		t.Errorf("expect the cursor to be left alone with NoMouseCursorChange got %v", got)
+	}
+}
+
+func TestWindowSetClearColor(t *testing.T) {
+	backend := NewHeadlessBackend(64, 64)
+	window := NewWindow(backend)
+	defer window.Close()
+
+	window.SetClearColor(NewImVec4(1, 0.5, 0, 0.5))
+	window.Step()
+
+	// The frame is premultiplied, as composited transparent windows expect
+	if got, want := backend.Image().RGBAAt(32, 32), (color.RGBA{R: 0x80, G: 0x40, A: 0x80}); got != want {
+		t.Errorf("expect the clear color %v got %v", want, got)
+	}
+}
+
+func TestDrawListSlices(t *testing.T) {
+	backend := NewHeadlessBackend(128, 64)
+	window := NewWindow(backend)
+	defer window.Close()
+
+	window.SetLoop(func() {
+		drawList := GetBackgroundDrawList_Nil()
+		drawList.AddConvexPolyFilled([]ImVec2{NewImVec2(10, 10), NewImVec2(50, 10), NewImVec2(50, 50), NewImVec2(10, 50)}, 0xFF00FF00)
+		drawList.AddPolyline([]ImVec2{NewImVec2(60, 10), NewImVec2(100, 10), NewImVec2(100, 50)}, 0xFF0000FF, 0, 4)
+
+		Begin("Plot")
+		PlotLines_FloatPtrV("Values", []float32{1, 3, 2}, 0, "", 0, 3, NewImVec2(0, 0))
+		PlotHistogram_FloatPtrV("Empty", nil, 0, "", 0, 3, NewImVec2(0, 0))
+
+		options := DefaultPlotLines_FloatPtrOptions()
+		options.ScaleMin = 0
+		PlotLines_FloatPtrWithOptions("Options", []float32{1, 2}, options)
+		End()
+	})
+	window.Step()
+
+	img := backend.Image()
+	if got := img.RGBAAt(40, 40); got != (color.RGBA{G: 0xff, A: 0xff}) {
+		t.Errorf("expect the polygon to be filled got %v", got)
+	}
+	if got := img.RGBAAt(100, 40); got != (color.RGBA{R: 0xff, A: 0xff}) {
+		t.Errorf("expect the last segment of the polyline got %v", got)
+	}
+}
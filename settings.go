@@ -0,0 +1,172 @@
+package cimgui
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// iniState is where a window loads and saves the dear imgui .ini settings.
+type iniState struct {
+	path    string
+	write   func(data []byte) error
+	onError func(err error)
+}
+
+// DefaultIniPath returns the path of the .ini file of app in the user
+// config directory, e.g. $XDG_CONFIG_HOME/app/imgui.ini on Linux.
+func DefaultIniPath(app string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, app, "imgui.ini"), nil
+}
+
+// SetIniFile loads the dear imgui settings of the window (window positions,
+// sizes and docking layouts) from path, and saves them back there when they
+// change and when the window is closed. The file and its directory are
+// created on the first save, see DefaultIniPath.
+// It replaces WindowOptions.IniFilename, and must be called before the
+// first frame for the windows to get their settings.
+func (w *Window) SetIniFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	w.useIni(&iniState{path: path})
+	loadIniSettings(data)
+
+	return nil
+}
+
+// SetIniStorage is SetIniFile with settings read from r, and saved by write.
+// Each save calls write with all the settings, which replace the previously
+// written ones. Either of them may be nil to only load or only save the settings.
+func (w *Window) SetIniStorage(r io.Reader, write func(data []byte) error) error {
+	var data []byte
+	if r != nil {
+		var err error
+		if data, err = io.ReadAll(r); err != nil {
+			return err
+		}
+	}
+
+	w.useIni(&iniState{write: write})
+	loadIniSettings(data)
+
+	return nil
+}
+
+// SetIniErrorHandler sets a function called when the settings fail to be
+// saved automatically, the error is dropped otherwise.
+func (w *Window) SetIniErrorHandler(handler func(err error)) {
+	if w.ini == nil {
+		w.ini = &iniState{}
+	}
+
+	w.ini.onError = handler
+}
+
+// SaveIniSettings saves the settings of the window now, instead of waiting
+// for ImGuiIO.IniSavingRate seconds after the last change.
+func (w *Window) SaveIniSettings() error {
+	if w.ini == nil || w.closed {
+		return nil
+	}
+
+	SetCurrentContext(w.backend.Context())
+
+	return w.ini.save()
+}
+
+func (w *Window) useIni(ini *iniState) {
+	if w.ini != nil {
+		ini.onError = w.ini.onError
+	}
+	w.ini = ini
+
+	SetCurrentContext(w.backend.Context())
+
+	// Let the window save the settings instead of dear imgui
+	GetIO().handle().IniFilename = nil
+}
+
+func loadIniSettings(data []byte) {
+	if len(data) > 0 {
+		LoadIniSettingsFromMemoryV(string(data), uint64(len(data)))
+	}
+}
+
+// saveIniSettings saves the settings once dear imgui asks for it, which it
+// does IniSavingRate seconds after the last change.
+func (w *Window) saveIniSettings() {
+	io := GetIO()
+	if w.ini == nil || !io.GetWantSaveIniSettings() {
+		return
+	}
+
+	if err := w.ini.save(); err != nil && w.ini.onError != nil {
+		w.ini.onError(err)
+	}
+}
+
+// saveIniSettingsOnClose saves the settings as dear imgui does when its context is destroyed.
+func (w *Window) saveIniSettingsOnClose() {
+	if w.ini == nil {
+		return
+	}
+
+	SetCurrentContext(w.backend.Context())
+	if GetFrameCount() == 0 {
+		return
+	}
+
+	if err := w.ini.save(); err != nil && w.ini.onError != nil {
+		w.ini.onError(err)
+	}
+}
+
+func (ini *iniState) save() error {
+	GetIO().SetWantSaveIniSettings(false)
+
+	switch {
+	case ini.path != "":
+		return writeFileAtomic(ini.path, []byte(SaveIniSettingsToMemory()))
+	case ini.write != nil:
+		return ini.write([]byte(SaveIniSettingsToMemory()))
+	default:
+		return nil
+	}
+}
+
+// writeFileAtomic writes data to a temporary file renamed to path,
+// so a crash never leaves a truncated file behind.
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+
+	return err
+}
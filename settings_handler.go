@@ -0,0 +1,143 @@
+package cimgui
+
+// #include <stdlib.h>
+// #include "util.h"
+import "C"
+import (
+	"io"
+	"runtime/cgo"
+	"unsafe"
+)
+
+// SettingsHandler keeps application state, e.g. recent files or panel
+// toggles, in the .ini settings next to the windows and docking layouts.
+// Its sections are named [TypeName][name], as in "[Window][Debug##Default]".
+type SettingsHandler interface {
+	// ReadOpen is called for each section of the handler read from the settings,
+	// and returns the entry given to ReadLine, or nil to skip the section.
+	ReadOpen(name string) any
+	// ReadLine is called for each line of a section opened with ReadOpen.
+	ReadLine(entry any, line string)
+	// ApplyAll is called once all the settings are read.
+	ApplyAll()
+	// WriteAll writes all the sections of the handler, headers included.
+	WriteAll(out io.Writer)
+}
+
+type settingsHandlerKey struct {
+	context  ImGuiContext
+	typeName string
+}
+
+type settingsHandlerState struct {
+	handler  SettingsHandler
+	typeName *C.char
+	handle   cgo.Handle
+	entries  []any
+}
+
+var settingsHandlers = map[settingsHandlerKey]*settingsHandlerState{}
+
+// RegisterSettingsHandler adds handler to the current context for the
+// [typeName] sections of the settings, replacing any previous handler of typeName.
+// Settings are only given to the handlers registered before they are loaded,
+// e.g. before Window.SetIniFile.
+func RegisterSettingsHandler(typeName string, handler SettingsHandler) {
+	UnregisterSettingsHandler(typeName)
+
+	state := &settingsHandlerState{
+		handler:  handler,
+		typeName: C.CString(typeName),
+	}
+	state.handle = cgo.NewHandle(state)
+	settingsHandlers[settingsHandlerKey{GetCurrentContext(), typeName}] = state
+
+	C.AddGoSettingsHandler(state.typeName, C.uintptr_t(state.handle))
+}
+
+// UnregisterSettingsHandler removes the handler of typeName from the current context.
+func UnregisterSettingsHandler(typeName string) {
+	key := settingsHandlerKey{GetCurrentContext(), typeName}
+	state, ok := settingsHandlers[key]
+	if !ok {
+		return
+	}
+
+	C.igRemoveSettingsHandler(state.typeName)
+	state.release()
+	delete(settingsHandlers, key)
+}
+
+// releaseSettingsHandlers frees the handlers of a destroyed context.
+func releaseSettingsHandlers(context ImGuiContext) {
+	for key, state := range settingsHandlers {
+		if key.context == context {
+			state.release()
+			delete(settingsHandlers, key)
+		}
+	}
+}
+
+func (state *settingsHandlerState) release() {
+	state.handle.Delete()
+	C.free(unsafe.Pointer(state.typeName))
+}
+
+func settingsHandlerFromHandle(handle C.uintptr_t) *settingsHandlerState {
+	return cgo.Handle(handle).Value().(*settingsHandlerState)
+}
+
+//export goSettingsReadInit
+func goSettingsReadInit(handle C.uintptr_t) {
+	state := settingsHandlerFromHandle(handle)
+	state.entries = state.entries[:0]
+}
+
+//export goSettingsReadOpen
+func goSettingsReadOpen(handle C.uintptr_t, name *C.char) C.uintptr_t {
+	state := settingsHandlerFromHandle(handle)
+
+	entry := state.handler.ReadOpen(C.GoString(name))
+	if entry == nil {
+		return 0
+	}
+
+	// dear imgui only needs a non NULL entry, so it gets an index into entries
+	state.entries = append(state.entries, entry)
+
+	return C.uintptr_t(len(state.entries))
+}
+
+//export goSettingsReadLine
+func goSettingsReadLine(handle, entry C.uintptr_t, line *C.char) {
+	state := settingsHandlerFromHandle(handle)
+	state.handler.ReadLine(state.entries[entry-1], C.GoString(line))
+}
+
+//export goSettingsApplyAll
+func goSettingsApplyAll(handle C.uintptr_t) {
+	state := settingsHandlerFromHandle(handle)
+	state.handler.ApplyAll()
+	state.entries = nil
+}
+
+//export goSettingsWriteAll
+func goSettingsWriteAll(handle C.uintptr_t, buf *C.ImGuiTextBuffer) {
+	settingsHandlerFromHandle(handle).handler.WriteAll(textBufferWriter{buf})
+}
+
+// textBufferWriter appends to an ImGuiTextBuffer.
+type textBufferWriter struct {
+	buf *C.ImGuiTextBuffer
+}
+
+func (w textBufferWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	str := (*C.char)(unsafe.Pointer(&p[0]))
+	C.ImGuiTextBuffer_append(w.buf, str, (*C.char)(unsafe.Add(unsafe.Pointer(str), len(p))))
+
+	return len(p), nil
+}
@@ -0,0 +1,148 @@
+package cimgui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWindowIniStorage(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(320, 240))
+
+	var saved []string
+	err := window.SetIniStorage(strings.NewReader("[Window][Saved]\nPos=30,40\nSize=100,60\n"), func(data []byte) error {
+		saved = append(saved, string(data))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	GetIO().SetIniSavingRate(1e-9)
+
+	var pos ImVec2
+	moved := false
+	window.SetLoop(func() {
+		if moved {
+			SetNextWindowPosV(NewImVec2(50, 60), ImGuiCond_Always, NewImVec2(0, 0))
+		}
+		Begin("Saved")
+		GetWindowPos(&pos)
+		End()
+	})
+
+	window.Step()
+	if pos != NewImVec2(30, 40) {
+		t.Errorf("expect the loaded position (30, 40) got %v", pos)
+	}
+
+	moved = true
+	for i := 0; i < 3; i++ {
+		window.Step()
+	}
+	if len(saved) == 0 || !strings.Contains(saved[len(saved)-1], "Pos=50,60") {
+		t.Errorf("expect the new position to be saved got %q", saved)
+	}
+
+	saved = nil
+	window.Close()
+	if len(saved) != 1 || strings.Count(saved[0], "[Window][Saved]") != 1 {
+		t.Errorf("expect the settings to be saved once on close got %q", saved)
+	}
+}
+
+func TestWindowIniFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app", "imgui.ini")
+
+	window := NewWindow(NewHeadlessBackend(320, 240))
+	if err := window.SetIniFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	window.SetLoop(func() {
+		SetNextWindowPosV(NewImVec2(70, 80), ImGuiCond_Always, NewImVec2(0, 0))
+		Begin("File")
+		End()
+	})
+	window.Step()
+	window.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "[Window][File]\nPos=70,80") {
+		t.Errorf("expect the window to be saved on close got %q", data)
+	}
+}
+
+type recentFiles struct {
+	files   []string
+	applied bool
+}
+
+func (r *recentFiles) ReadOpen(name string) any {
+	if name != "Recent" {
+		return nil
+	}
+
+	return &r.files
+}
+
+func (r *recentFiles) ReadLine(entry any, line string) {
+	files := entry.(*[]string)
+	*files = append(*files, strings.TrimPrefix(line, "File="))
+}
+
+func (r *recentFiles) ApplyAll() {
+	r.applied = true
+}
+
+func (r *recentFiles) WriteAll(out io.Writer) {
+	fmt.Fprintf(out, "[App][Recent]\n")
+	for _, file := range r.files {
+		fmt.Fprintf(out, "File=%s\n", file)
+	}
+	fmt.Fprintf(out, "\n")
+}
+
+func TestRegisterSettingsHandler(t *testing.T) {
+	window := NewWindow(NewHeadlessBackend(64, 64))
+	defer window.Close()
+
+	recent := &recentFiles{}
+	RegisterSettingsHandler("App", recent)
+
+	var saved string
+	save := func(data []byte) error {
+		saved = string(data)
+		return nil
+	}
+	ini := "[App][Recent]\nFile=a.txt\nFile=b.txt\n\n[App][Skipped]\nFile=c.txt\n"
+	if err := window.SetIniStorage(strings.NewReader(ini), save); err != nil {
+		t.Fatal(err)
+	}
+
+	if !recent.applied || strings.Join(recent.files, ",") != "a.txt,b.txt" {
+		t.Errorf("expect a.txt and b.txt to be read and applied got %v, applied: %v", recent.files, recent.applied)
+	}
+
+	recent.files = append(recent.files, "d.txt")
+	window.Step()
+	if err := window.SaveIniSettings(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(saved, "[App][Recent]\nFile=a.txt\nFile=b.txt\nFile=d.txt\n") {
+		t.Errorf("expect the recent files to be saved got %q", saved)
+	}
+
+	UnregisterSettingsHandler("App")
+	if err := window.SaveIniSettings(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(saved, "[App]") {
+		t.Errorf("expect no section once unregistered got %q", saved)
+	}
+}
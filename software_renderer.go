@@ -0,0 +1,359 @@
+package cimgui
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"unsafe"
+)
+
+// SoftwareRenderer rasterizes ImDrawData on the CPU into an image.RGBA.
+// It needs neither a display nor an OpenGL context, so it can be used to run
+// the UI on headless machines (e.g. in CI) and to produce screenshots.
+//
+// Textures are always sampled with nearest filtering, and blended the same
+// way the OpenGL3 backend does, which keeps the target image alpha-premultiplied.
+type SoftwareRenderer struct {
+	target        *image.RGBA
+	textures      map[ImTextureID]*softwareTexture
+	nextTextureID ImTextureID
+	fontsTexture  ImTextureID
+}
+
+type softwareTexture struct {
+	pixels *image.NRGBA
+	wrap   TextureWrap
+}
+
+// NewSoftwareRenderer creates a renderer drawing into a width x height image.
+func NewSoftwareRenderer(width, height int) *SoftwareRenderer {
+	return &SoftwareRenderer{
+		target:   image.NewRGBA(image.Rect(0, 0, width, height)),
+		textures: make(map[ImTextureID]*softwareTexture),
+	}
+}
+
+// Image returns the image the renderer draws into.
+func (r *SoftwareRenderer) Image() *image.RGBA {
+	return r.target
+}
+
+// Resize replaces the target image if its size differs from width x height.
+func (r *SoftwareRenderer) Resize(width, height int) {
+	if r.target.Bounds().Dx() == width && r.target.Bounds().Dy() == height {
+		return
+	}
+
+	r.target = image.NewRGBA(image.Rect(0, 0, width, height))
+}
+
+// Clear fills the whole target image with c.
+func (r *SoftwareRenderer) Clear(c color.Color) {
+	draw.Draw(r.target, r.target.Bounds(), &image.Uniform{C: c}, image.Point{}, draw.Src)
+}
+
+// CreateTexture copies width x height RGBA pixels and returns an ID usable in draw commands.
+// It returns 0 for an empty texture, which can't be sampled.
+func (r *SoftwareRenderer) CreateTexture(pixels unsafe.Pointer, width, height int, options TextureOptions) ImTextureID {
+	if width <= 0 || height <= 0 {
+		return 0
+	}
+
+	r.nextTextureID++
+	r.textures[r.nextTextureID] = &softwareTexture{wrap: options.Wrap}
+	r.UpdateTexture(r.nextTextureID, pixels, width, height)
+
+	return r.nextTextureID
+}
+
+// UpdateTexture replaces the pixels of the texture id, its size may change.
+// An empty size leaves the texture unchanged.
+func (r *SoftwareRenderer) UpdateTexture(id ImTextureID, pixels unsafe.Pointer, width, height int) {
+	tex, ok := r.textures[id]
+	if !ok || width <= 0 || height <= 0 {
+		return
+	}
+
+	tex.pixels = image.NewNRGBA(image.Rect(0, 0, width, height))
+	copy(tex.pixels.Pix, ptrToByteSlice(pixels)[:len(tex.pixels.Pix)])
+}
+
+// DeleteTexture releases the texture id.
+func (r *SoftwareRenderer) DeleteTexture(id ImTextureID) {
+	delete(r.textures, id)
+}
+
+// Textures returns the live textures, sorted by ID.
+func (r *SoftwareRenderer) Textures() []TextureInfo {
+	textures := make(textureRegistry, len(r.textures))
+	for id, tex := range r.textures {
+		textures.add(TextureInfo{
+			ID:      id,
+			Width:   tex.pixels.Rect.Dx(),
+			Height:  tex.pixels.Rect.Dy(),
+			Options: TextureOptions{Filter: TextureFilterNearest, Wrap: tex.wrap},
+		})
+	}
+
+	return textures.list()
+}
+
+// ReloadFontsTexture replaces the texture of the font atlas of the current context, e.g. after it was rebuilt.
+func (r *SoftwareRenderer) ReloadFontsTexture() {
+	r.DeleteTexture(r.fontsTexture)
+	r.CreateFontsTexture()
+}
+
+// CreateFontsTexture uploads the font atlas of the current context and stores the texture ID in it.
+func (r *SoftwareRenderer) CreateFontsTexture() {
+	fonts := GetIO().GetFonts()
+	pixels, width, height, _ := fonts.GetTextureDataAsRGBA32()
+	r.fontsTexture = r.CreateTexture(pixels, int(width), int(height), TextureOptions{})
+	fonts.SetTexID(r.fontsTexture)
+}
+
+// softwareVertex is a vertex already transformed into target pixel coordinates.
+type softwareVertex struct {
+	x, y       float32
+	u, v       float32
+	r, g, b, a float32
+}
+
+// RenderDrawData draws data on top of the current content of the target image.
+func (r *SoftwareRenderer) RenderDrawData(data ImDrawData) {
+	displayPos := data.GetDisplayPos()
+	fbScale := data.GetFramebufferScale()
+
+	vertexSize, posOffset, uvOffset, colOffset := VertexBufferLayout()
+	indexSize := IndexBufferLayout()
+
+	for _, list := range data.CommandLists() {
+		vertexBuffer, vertexBufferSize := list.GetVertexBuffer()
+		indexBuffer, indexBufferSize := list.GetIndexBuffer()
+		if vertexBufferSize == 0 || indexBufferSize == 0 {
+			continue
+		}
+
+		vertices := ptrToByteSlice(vertexBuffer)[:vertexBufferSize]
+		indices := ptrToByteSlice(indexBuffer)[:indexBufferSize]
+
+		readVertex := func(idx int) softwareVertex {
+			base := idx * vertexSize
+			col := *(*uint32)(unsafe.Pointer(&vertices[base+colOffset]))
+			return softwareVertex{
+				x: (*(*float32)(unsafe.Pointer(&vertices[base+posOffset])) - displayPos.X) * fbScale.X,
+				y: (*(*float32)(unsafe.Pointer(&vertices[base+posOffset+4])) - displayPos.Y) * fbScale.Y,
+				u: *(*float32)(unsafe.Pointer(&vertices[base+uvOffset])),
+				v: *(*float32)(unsafe.Pointer(&vertices[base+uvOffset+4])),
+				r: float32(col&0xFF) / 255,
+				g: float32((col>>8)&0xFF) / 255,
+				b: float32((col>>16)&0xFF) / 255,
+				a: float32((col>>24)&0xFF) / 255,
+			}
+		}
+
+		readIndex := func(idx int) int {
+			if indexSize == 2 {
+				return int(*(*uint16)(unsafe.Pointer(&indices[idx*indexSize])))
+			}
+			return int(*(*uint32)(unsafe.Pointer(&indices[idx*indexSize])))
+		}
+
+		for _, cmd := range list.Commands() {
+			if cmd.HasUserCallback() {
+				// ImDrawCallback_ResetRenderState is (ImDrawCallback)(-1) and must not be called.
+				if uintptr(unsafe.Pointer(cmd.c().UserCallback)) != ^uintptr(0) {
+					cmd.CallUserCallback(list)
+				}
+				continue
+			}
+
+			// ImVec4 keeps C's z and w in its Z and W fields, i.e. max x and max y of the clip rect.
+			clipRect := cmd.GetClipRect()
+			clip := image.Rect(
+				int((clipRect.X-displayPos.X)*fbScale.X),
+				int((clipRect.Y-displayPos.Y)*fbScale.Y),
+				int((clipRect.Z-displayPos.X)*fbScale.X),
+				int((clipRect.W-displayPos.Y)*fbScale.Y),
+			).Intersect(r.target.Bounds())
+			if clip.Empty() {
+				continue
+			}
+
+			tex := r.textures[cmd.GetTextureId()]
+			vtxOffset := int(cmd.GetVtxOffset())
+			idxOffset := int(cmd.GetIdxOffset())
+
+			for i := 0; i+2 < int(cmd.GetElemCount()); i += 3 {
+				r.drawTriangle(
+					readVertex(vtxOffset+readIndex(idxOffset+i)),
+					readVertex(vtxOffset+readIndex(idxOffset+i+1)),
+					readVertex(vtxOffset+readIndex(idxOffset+i+2)),
+					tex,
+					clip,
+				)
+			}
+		}
+	}
+}
+
+func edgeFunction(a, b softwareVertex, x, y float32) float32 {
+	return (b.x-a.x)*(y-a.y) - (b.y-a.y)*(x-a.x)
+}
+
+// isTopLeftEdge reports whether a pixel center lying exactly on the edge a->b
+// belongs to the triangle, so shared edges are not blended twice.
+func isTopLeftEdge(a, b softwareVertex) bool {
+	dx, dy := b.x-a.x, b.y-a.y
+	return dy < 0 || (dy == 0 && dx > 0)
+}
+
+func (r *SoftwareRenderer) drawTriangle(v0, v1, v2 softwareVertex, tex *softwareTexture, clip image.Rectangle) {
+	area := edgeFunction(v0, v1, v2.x, v2.y)
+	if area == 0 {
+		return
+	}
+
+	if area < 0 {
+		v1, v2 = v2, v1
+		area = -area
+	}
+
+	bounds := image.Rect(
+		int(math.Floor(float64(min3(v0.x, v1.x, v2.x)))),
+		int(math.Floor(float64(min3(v0.y, v1.y, v2.y)))),
+		int(math.Ceil(float64(max3(v0.x, v1.x, v2.x)))),
+		int(math.Ceil(float64(max3(v0.y, v1.y, v2.y)))),
+	).Intersect(clip)
+	if bounds.Empty() {
+		return
+	}
+
+	topLeft0 := isTopLeftEdge(v1, v2)
+	topLeft1 := isTopLeftEdge(v2, v0)
+	topLeft2 := isTopLeftEdge(v0, v1)
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		py := float32(y) + 0.5
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			px := float32(x) + 0.5
+
+			w0 := edgeFunction(v1, v2, px, py)
+			w1 := edgeFunction(v2, v0, px, py)
+			w2 := edgeFunction(v0, v1, px, py)
+
+			if w0 < 0 || w1 < 0 || w2 < 0 ||
+				(w0 == 0 && !topLeft0) || (w1 == 0 && !topLeft1) || (w2 == 0 && !topLeft2) {
+				continue
+			}
+
+			w0 /= area
+			w1 /= area
+			w2 /= area
+
+			sr := w0*v0.r + w1*v1.r + w2*v2.r
+			sg := w0*v0.g + w1*v1.g + w2*v2.g
+			sb := w0*v0.b + w1*v1.b + w2*v2.b
+			sa := w0*v0.a + w1*v1.a + w2*v2.a
+
+			if tex != nil {
+				tr, tg, tb, ta := sampleNearest(tex, w0*v0.u+w1*v1.u+w2*v2.u, w0*v0.v+w1*v1.v+w2*v2.v)
+				sr *= tr
+				sg *= tg
+				sb *= tb
+				sa *= ta
+			}
+
+			if sa <= 0 {
+				continue
+			}
+
+			r.blend(x, y, sr, sg, sb, sa)
+		}
+	}
+}
+
+// blend composes a straight-alpha source color over the premultiplied target pixel,
+// matching glBlendFuncSeparate(GL_SRC_ALPHA, GL_ONE_MINUS_SRC_ALPHA, GL_ONE, GL_ONE_MINUS_SRC_ALPHA).
+func (r *SoftwareRenderer) blend(x, y int, sr, sg, sb, sa float32) {
+	offset := r.target.PixOffset(x, y)
+	pix := r.target.Pix[offset : offset+4 : offset+4]
+	inv := 1 - sa
+
+	pix[0] = toByte(sr*sa + float32(pix[0])/255*inv)
+	pix[1] = toByte(sg*sa + float32(pix[1])/255*inv)
+	pix[2] = toByte(sb*sa + float32(pix[2])/255*inv)
+	pix[3] = toByte(sa + float32(pix[3])/255*inv)
+}
+
+func sampleNearest(tex *softwareTexture, u, v float32) (r, g, b, a float32) {
+	size := tex.pixels.Rect.Size()
+	x := wrapCoord(int(math.Floor(float64(u*float32(size.X)))), size.X, tex.wrap)
+	y := wrapCoord(int(math.Floor(float64(v*float32(size.Y)))), size.Y, tex.wrap)
+
+	offset := tex.pixels.PixOffset(x, y)
+	pix := tex.pixels.Pix[offset : offset+4 : offset+4]
+
+	return float32(pix[0]) / 255, float32(pix[1]) / 255, float32(pix[2]) / 255, float32(pix[3]) / 255
+}
+
+// wrapCoord maps the texel coordinate c into [0, size) as OpenGL does for wrap.
+func wrapCoord(c, size int, wrap TextureWrap) int {
+	switch wrap {
+	case TextureWrapRepeat:
+		c %= size
+		if c < 0 {
+			c += size
+		}
+		return c
+	case TextureWrapMirroredRepeat:
+		c %= 2 * size
+		if c < 0 {
+			c += 2 * size
+		}
+		if c >= size {
+			c = 2*size - 1 - c
+		}
+		return c
+	default:
+		return clampInt(c, 0, size-1)
+	}
+}
+
+func toByte(v float32) uint8 {
+	switch {
+	case v <= 0:
+		return 0
+	case v >= 1:
+		return 255
+	default:
+		return uint8(v*255 + 0.5)
+	}
+}
+
+func clampInt(v, lo, hi int) int {
+	return maxInt(lo, minInt(v, hi))
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min3(a, b, c float32) float32 {
+	return float32(math.Min(float64(a), math.Min(float64(b), float64(c))))
+}
+
+func max3(a, b, c float32) float32 {
+	return float32(math.Max(float64(a), math.Max(float64(b), float64(c))))
+}
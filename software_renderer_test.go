@@ -0,0 +1,89 @@
+package cimgui
+
+import (
+	"image/color"
+	"os"
+	"testing"
+)
+
+// newTestFrame starts a frame on a fresh context and returns a function which
+// renders it and destroys the context. The working directory is moved to a
+// temporary one so dear imgui doesn't leave an imgui.ini behind.
+func newTestFrame(t *testing.T, width, height float32) (renderer *SoftwareRenderer, finish func()) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+
+	CreateContext()
+
+	renderer = NewSoftwareRenderer(int(width), int(height))
+	renderer.CreateFontsTexture()
+
+	io := GetIO()
+	io.SetDisplaySize(NewImVec2(width, height))
+	io.SetDeltaTime(1.0 / 60.0)
+
+	NewFrame()
+
+	finish = func() {
+		Render()
+		renderer.Clear(color.RGBA{A: 255})
+		renderer.RenderDrawData(GetDrawData())
+
+		DestroyContext()
+		_ = os.Chdir(wd)
+	}
+
+	return
+}
+
+func TestSoftwareRendererFillsRect(t *testing.T) {
+	renderer, finish := newTestFrame(t, 64, 64)
+
+	GetBackgroundDrawList_Nil().AddRectFilled(NewImVec2(8, 8), NewImVec2(24, 24), 0xFF0000FF)
+
+	finish()
+
+	img := renderer.Image()
+	if got := img.RGBAAt(16, 16); got != (color.RGBA{R: 255, A: 255}) {
+		t.Errorf("inside rect: expect opaque red got %v", got)
+	}
+	if got := img.RGBAAt(40, 40); got != (color.RGBA{A: 255}) {
+		t.Errorf("outside rect: expect clear color got %v", got)
+	}
+}
+
+func TestSoftwareRendererRendersWindow(t *testing.T) {
+	renderer, finish := newTestFrame(t, 320, 240)
+
+	SetNextWindowPosV(NewImVec2(10, 10), ImGuiCond_Always, NewImVec2(0, 0))
+	SetNextWindowSizeV(NewImVec2(200, 100), ImGuiCond_Always)
+	Begin("Software renderer")
+	Text("Hello from the CPU")
+	End()
+
+	finish()
+
+	img := renderer.Image()
+	drawn := 0
+	for y := 0; y < 240; y++ {
+		for x := 0; x < 320; x++ {
+			if img.RGBAAt(x, y) != (color.RGBA{A: 255}) {
+				drawn++
+			}
+		}
+	}
+
+	if drawn < 200*100/2 {
+		t.Errorf("expect the window to cover most of its rect, only %d pixels drawn", drawn)
+	}
+	if got := img.RGBAAt(300, 200); got != (color.RGBA{A: 255}) {
+		t.Errorf("outside window: expect clear color got %v", got)
+	}
+}
@@ -0,0 +1,178 @@
+package cimgui
+
+// #include "backend.h"
+import "C"
+import (
+	"image"
+	"image/draw"
+	"sort"
+	"unsafe"
+)
+
+// TextureFilter is how a texture is sampled when scaled.
+type TextureFilter int
+
+const (
+	TextureFilterLinear  TextureFilter = TextureFilter(C.TextureFilterLinear)
+	TextureFilterNearest TextureFilter = TextureFilter(C.TextureFilterNearest)
+)
+
+// TextureWrap is how a texture is sampled outside of the [0, 1] UV range.
+type TextureWrap int
+
+const (
+	TextureWrapRepeat         TextureWrap = TextureWrap(C.TextureWrapRepeat)
+	TextureWrapClampToEdge    TextureWrap = TextureWrap(C.TextureWrapClampToEdge)
+	TextureWrapMirroredRepeat TextureWrap = TextureWrap(C.TextureWrapMirroredRepeat)
+)
+
+// TextureOptions configures the sampling of a texture, the zero value is
+// linear filtering and repeat wrapping.
+type TextureOptions struct {
+	Filter TextureFilter
+	Wrap   TextureWrap
+}
+
+// TextureInfo describes a live texture.
+type TextureInfo struct {
+	ID      ImTextureID
+	Width   int
+	Height  int
+	Options TextureOptions
+}
+
+// textureRegistry tracks the live textures of a renderer.
+type textureRegistry map[ImTextureID]TextureInfo
+
+func (r textureRegistry) add(info TextureInfo) {
+	r[info.ID] = info
+}
+
+func (r textureRegistry) resize(id ImTextureID, width, height int) {
+	if info, ok := r[id]; ok {
+		info.Width = width
+		info.Height = height
+		r[id] = info
+	}
+}
+
+func (r textureRegistry) list() []TextureInfo {
+	textures := make([]TextureInfo, 0, len(r))
+	for _, info := range r {
+		textures = append(textures, info)
+	}
+
+	sort.Slice(textures, func(i, j int) bool { return textures[i].ID < textures[j].ID })
+
+	return textures
+}
+
+// imageToNRGBA returns the pixels of img as tightly packed, non premultiplied RGBA.
+func imageToNRGBA(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+	if nrgba, ok := img.(*image.NRGBA); ok && nrgba.Rect.Min == (image.Point{}) && nrgba.Stride == 4*bounds.Dx() {
+		return nrgba
+	}
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(nrgba, nrgba.Bounds(), img, bounds.Min, draw.Src)
+
+	return nrgba
+}
+
+// glTextures returns the textures created with the OpenGL functions below in
+// the current OpenGL context. The contexts of other windows than GLFWwindow
+// are not tracked.
+func glTextures() textureRegistry {
+	window := GLFWwindow(unsafe.Pointer(C.igGetCurrentGLFWWindow()))
+	if state := window.state(); state != nil {
+		return state.textures
+	}
+
+	return textureRegistry{}
+}
+
+// CreateTexture uploads width x height RGBA pixels to a new OpenGL texture,
+// with the default options, in the current OpenGL context.
+func CreateTexture(pixels unsafe.Pointer, width, height int) ImTextureID {
+	return CreateTextureWithOptions(pixels, width, height, TextureOptions{})
+}
+
+// CreateTextureWithOptions uploads width x height RGBA pixels to a new OpenGL
+// texture in the current OpenGL context. It returns 0 for an empty size.
+func CreateTextureWithOptions(pixels unsafe.Pointer, width, height int, options TextureOptions) ImTextureID {
+	if width <= 0 || height <= 0 {
+		return 0
+	}
+
+	id := ImTextureID(C.igCreateTexture((*C.uchar)(pixels), C.int(width), C.int(height), C.TextureFilter(options.Filter), C.TextureWrap(options.Wrap)))
+	glTextures().add(TextureInfo{ID: id, Width: width, Height: height, Options: options})
+
+	return id
+}
+
+// NewTextureFromImage uploads img to a new OpenGL texture in the current OpenGL context.
+// It returns 0 for an empty img.
+func NewTextureFromImage(img image.Image, options TextureOptions) ImTextureID {
+	nrgba := imageToNRGBA(img)
+	if len(nrgba.Pix) == 0 {
+		return 0
+	}
+
+	return CreateTextureWithOptions(unsafe.Pointer(&nrgba.Pix[0]), nrgba.Rect.Dx(), nrgba.Rect.Dy(), options)
+}
+
+// UpdateTexture replaces the pixels of the OpenGL texture id, its size may change.
+func UpdateTexture(id ImTextureID, pixels unsafe.Pointer, width, height int) {
+	C.igUpdateTexture(C.uintptr_t(id), (*C.uchar)(pixels), C.int(width), C.int(height))
+	glTextures().resize(id, width, height)
+}
+
+// UpdateTextureFromImage replaces the pixels of the OpenGL texture id with img.
+// An empty img leaves the texture unchanged.
+func UpdateTextureFromImage(id ImTextureID, img image.Image) {
+	nrgba := imageToNRGBA(img)
+	if len(nrgba.Pix) == 0 {
+		return
+	}
+
+	UpdateTexture(id, unsafe.Pointer(&nrgba.Pix[0]), nrgba.Rect.Dx(), nrgba.Rect.Dy())
+}
+
+// DeleteTexture frees the OpenGL texture id.
+func DeleteTexture(id ImTextureID) {
+	C.igDeleteTexture(C.uintptr_t(id))
+	delete(glTextures(), id)
+}
+
+// Textures returns the live OpenGL textures of the current OpenGL context, sorted by ID.
+func Textures() []TextureInfo {
+	return glTextures().list()
+}
+
+// NewTextureFromImage uploads img to a new texture of the window's renderer.
+// It returns 0 for an empty img.
+func (w *Window) NewTextureFromImage(img image.Image, options TextureOptions) ImTextureID {
+	nrgba := imageToNRGBA(img)
+	if len(nrgba.Pix) == 0 {
+		return 0
+	}
+
+	return w.backend.CreateTexture(unsafe.Pointer(&nrgba.Pix[0]), nrgba.Rect.Dx(), nrgba.Rect.Dy(), options)
+}
+
+// UpdateTextureFromImage replaces the pixels of the texture id of the window's renderer with img.
+// An empty img leaves the texture unchanged.
+func (w *Window) UpdateTextureFromImage(id ImTextureID, img image.Image) {
+	nrgba := imageToNRGBA(img)
+	if len(nrgba.Pix) == 0 {
+		return
+	}
+
+	w.backend.UpdateTexture(id, unsafe.Pointer(&nrgba.Pix[0]), nrgba.Rect.Dx(), nrgba.Rect.Dy())
+}
+
+// DeleteTexture frees the texture id of the window's renderer.
+func (w *Window) DeleteTexture(id ImTextureID) {
+	w.backend.DeleteTexture(id)
+}
@@ -61,6 +61,53 @@ func wrapString(value string) (wrapped *C.char, finisher func()) {
 	return
 }
 
+// wrapNullableString passes an empty value as NULL, for the arguments
+// whose default is NULL, e.g. the format of SliderScalar.
+func wrapNullableString(value string) (wrapped *C.char, finisher func()) {
+	if len(value) == 0 {
+		return nil, func() {}
+	}
+
+	return wrapString(value)
+}
+
+// wrapFloat32Slice passes values as is, cgo keeps them in place during the call.
+func wrapFloat32Slice(values []float32) *C.float {
+	if len(values) == 0 {
+		return nil
+	}
+
+	return (*C.float)(unsafe.Pointer(&values[0]))
+}
+
+// wrapStrings copies values into a single C allocation: the array of
+// pointers, followed by the zero terminated strings.
+func wrapStrings(values []string) (wrapped **C.char, finisher func()) {
+	if len(values) == 0 {
+		return nil, func() {}
+	}
+
+	ptrsSize := uintptr(len(values)) * unsafe.Sizeof(wrapped)
+	size := ptrsSize
+	for _, v := range values {
+		size += uintptr(len(v)) + 1
+	}
+
+	block := C.malloc(C.size_t(size))
+	ptrs := unsafe.Slice((**C.char)(block), len(values))
+	text := unsafe.Slice((*byte)(unsafe.Add(block, ptrsSize)), size-ptrsSize)
+	for i, v := range values {
+		ptrs[i] = (*C.char)(unsafe.Pointer(&text[0]))
+		n := copy(text, v)
+		text[n] = 0
+		text = text[n+1:]
+	}
+
+	wrapped = (**C.char)(block)
+	finisher = func() { C.free(block) }
+	return
+}
+
 // unrealisticLargePointer is used to cast an arbitrary native pointer to a slice.
 // Its value is chosen to fit into a 32bit architecture, and still be large
 // enough to cover "any" data blob. Note that this value is in bytes.
@@ -2,6 +2,15 @@
 #include "cimgui/cimgui.h"
 #include "cimgui_wrapper.h"
 
+extern "C" void goSettingsReadInit(uintptr_t handle);
+extern "C" uintptr_t goSettingsReadOpen(uintptr_t handle, const char *name);
+extern "C" void goSettingsReadLine(uintptr_t handle, uintptr_t entry, const char *line);
+extern "C" void goSettingsApplyAll(uintptr_t handle);
+extern "C" void goSettingsWriteAll(uintptr_t handle, ImGuiTextBuffer *buf);
+extern "C" const char *goClipboardGet(uintptr_t handle);
+extern "C" void goClipboardSet(uintptr_t handle, const char *text);
+extern "C" void goReleaseFrameCallbacks(ImGuiContext *ctx, bool shutdown);
+
 #define IM_OFFSETOF(_TYPE, _MEMBER) offsetof(_TYPE, _MEMBER) // Offset of _MEMBER within _TYPE. Standardized as offsetof() in C++11
 
 void GetIndexBufferLayout(size_t *entrySize) { *entrySize = sizeof(ImDrawIdx); }
@@ -38,3 +47,51 @@ void DestroyGlyphRange(ImVector_ImWchar *range) { ImVector_ImWchar_destroy(range
 ImWchar *GlyphRange_GetData(ImVector_ImWchar *range) { return range->Data; }
 
 int ImFontAtlas_GetFontCount(ImFontAtlas *self) { return self->Fonts.Size; }
+
+static void SettingsHandler_ReadInit(ImGuiContext *, ImGuiSettingsHandler *handler) { goSettingsReadInit((uintptr_t)handler->UserData); }
+
+static void *SettingsHandler_ReadOpen(ImGuiContext *, ImGuiSettingsHandler *handler, const char *name) { return (void *)goSettingsReadOpen((uintptr_t)handler->UserData, name); }
+
+static void SettingsHandler_ReadLine(ImGuiContext *, ImGuiSettingsHandler *handler, void *entry, const char *line) { goSettingsReadLine((uintptr_t)handler->UserData, (uintptr_t)entry, line); }
+
+static void SettingsHandler_ApplyAll(ImGuiContext *, ImGuiSettingsHandler *handler) { goSettingsApplyAll((uintptr_t)handler->UserData); }
+
+static void SettingsHandler_WriteAll(ImGuiContext *, ImGuiSettingsHandler *handler, ImGuiTextBuffer *buf) { goSettingsWriteAll((uintptr_t)handler->UserData, buf); }
+
+void AddGoSettingsHandler(const char *typeName, uintptr_t handle) {
+  ImGuiSettingsHandler handler = {};
+  handler.TypeName = typeName;
+  handler.TypeHash = igImHashStr(typeName, 0, 0);
+  handler.ReadInitFn = SettingsHandler_ReadInit;
+  handler.ReadOpenFn = SettingsHandler_ReadOpen;
+  handler.ReadLineFn = SettingsHandler_ReadLine;
+  handler.ApplyAllFn = SettingsHandler_ApplyAll;
+  handler.WriteAllFn = SettingsHandler_WriteAll;
+  handler.UserData = (void *)handle;
+  igAddSettingsHandler(&handler);
+}
+
+static const char *Clipboard_Get(void *userData) { return goClipboardGet((uintptr_t)userData); }
+
+static void Clipboard_Set(void *userData, const char *text) { goClipboardSet((uintptr_t)userData, text); }
+
+void ImGuiIO_SetGoClipboardHandler(ImGuiIO *self, uintptr_t handle) {
+  self->GetClipboardTextFn = Clipboard_Get;
+  self->SetClipboardTextFn = Clipboard_Set;
+  self->ClipboardUserData = (void *)handle;
+}
+
+static void FrameCallbacks_NewFrame(ImGuiContext *ctx, ImGuiContextHook *) { goReleaseFrameCallbacks(ctx, false); }
+
+static void FrameCallbacks_Shutdown(ImGuiContext *ctx, ImGuiContextHook *) { goReleaseFrameCallbacks(ctx, true); }
+
+void AddGoFrameCallbacksHooks(ImGuiContext *ctx) {
+  ImGuiContextHook hook = {};
+  hook.Type = ImGuiContextHookType_NewFramePre;
+  hook.Callback = FrameCallbacks_NewFrame;
+  igAddContextHook(ctx, &hook);
+
+  hook.Type = ImGuiContextHookType_Shutdown;
+  hook.Callback = FrameCallbacks_Shutdown;
+  igAddContextHook(ctx, &hook);
+}
@@ -2,6 +2,7 @@
 
 #include "cimgui_wrapper.h"
 #include <stddef.h>
+#include <stdint.h>
 
 #ifdef __cplusplus
 extern "C" {
@@ -25,6 +26,12 @@ extern ImWchar *GlyphRange_GetData(ImVector_ImWchar *range);
 
 extern int ImFontAtlas_GetFontCount(ImFontAtlas *self);
 
+extern void AddGoSettingsHandler(const char *typeName, uintptr_t handle);
+
+extern void ImGuiIO_SetGoClipboardHandler(ImGuiIO *self, uintptr_t handle);
+
+extern void AddGoFrameCallbacksHooks(ImGuiContext *ctx);
+
 #ifdef __cplusplus
 }
 #endif
@@ -0,0 +1,415 @@
+package cimgui
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultIdleTimeout is how long a window keeps rendering after the last event.
+	DefaultIdleTimeout = 500 * time.Millisecond
+
+	// wakeFrameCount is the number of frames rendered at least for each
+	// event, so dear imgui can settle hover and focus changes.
+	wakeFrameCount = 3
+)
+
+var targetFPS uint = 30
+
+// SetTargetFPS limits the frame rate of the windows without their own limit, 0 means unlimited.
+func SetTargetFPS(fps uint) {
+	targetFPS = fps
+}
+
+// refreshNotifier is implemented by backends which ask for a frame
+// outside of the loop, e.g. while the native window is being resized.
+type refreshNotifier interface {
+	onRefresh(refresh func())
+}
+
+// Window drives the dear imgui frame loop on top of a Backend.
+// Frames are either pumped one at a time with Step, or by Run until the
+// window is closed.
+//
+// A Window and dear imgui belong to the UI thread, the main thread for
+// GLFW windows: only Post, Call and Refresh may be used from other goroutines.
+// Functions given to Post and Call run on the UI thread in queue order.
+//
+// GLFW and most OpenGL drivers must be used from the main thread. The main
+// goroutine starts there, so the main package keeps it there before running
+// the windows from main:
+//
+//	func init() {
+//		runtime.LockOSThread()
+//	}
+type Window struct {
+	backend      Backend
+	loop         func()
+	beforeRender func()
+	afterRender  func()
+
+	fps         uint
+	hasFPS      bool
+	idleTimeout time.Duration
+	onDemand    bool
+	continuous  bool
+	refresh     atomic.Bool
+	nextFrame   time.Time
+	activeUntil time.Time
+	framesLeft  int
+
+	dpi *dpiState
+	ini *iniState
+
+	gamepadHook func(state GamepadState)
+	gamepadFed  bool
+
+	tasksMu     sync.Mutex
+	tasks       []task
+	tasksClosed bool
+	// waiter is the backend RunWindows waits on for events, if not backend
+	waiter Backend
+
+	inFrame   bool
+	rendering bool
+	closing   bool
+	closed    bool
+}
+
+// NewWindow creates a Window showing dear imgui through backend.
+func NewWindow(backend Backend) *Window {
+	w := &Window{backend: backend, idleTimeout: DefaultIdleTimeout}
+
+	if notifier, ok := backend.(refreshNotifier); ok {
+		notifier.onRefresh(func() {
+			if !w.rendering && !w.closed {
+				w.render()
+			}
+		})
+	}
+
+	return w
+}
+
+// Backend returns the backend the window was created with.
+func (w *Window) Backend() Backend {
+	return w.backend
+}
+
+// SetTargetFPS limits the frame rate of this window, 0 means unlimited.
+func (w *Window) SetTargetFPS(fps uint) {
+	w.fps = fps
+	w.hasFPS = true
+}
+
+func (w *Window) targetFPS() uint {
+	if w.hasFPS {
+		return w.fps
+	}
+
+	return targetFPS
+}
+
+// SetIdleTimeout sets how long the window keeps rendering after the last event
+// before it waits for the next one.
+func (w *Window) SetIdleTimeout(timeout time.Duration) {
+	w.idleTimeout = timeout
+}
+
+// SetOnDemand makes the window render only on input or Refresh, instead of
+// rendering until the idle timeout runs out.
+func (w *Window) SetOnDemand(onDemand bool) {
+	w.onDemand = onDemand
+}
+
+// SetContinuousRendering keeps the window rendering at its target FPS even
+// without input, e.g. while an animation runs.
+func (w *Window) SetContinuousRendering(continuous bool) {
+	w.continuous = continuous
+}
+
+// Refresh asks for a new frame of the window, waking it up if idle.
+// It may be called from any goroutine.
+func (w *Window) Refresh() {
+	w.tasksMu.Lock()
+	defer w.tasksMu.Unlock()
+
+	if !w.tasksClosed {
+		w.refresh.Store(true)
+		w.wakeUp()
+	}
+}
+
+// wakeUp wakes up the backend RunWindows waits on, w.tasksMu must be held.
+func (w *Window) wakeUp() {
+	w.backend.PostEmptyEvent()
+	if w.waiter != nil && w.waiter != w.backend {
+		w.waiter.PostEmptyEvent()
+	}
+}
+
+func (w *Window) setWaiter(waiter Backend) {
+	w.tasksMu.Lock()
+	defer w.tasksMu.Unlock()
+
+	w.waiter = waiter
+}
+
+// ReloadFonts calls build with the font atlas of the window between two frames,
+// then builds the atlas and uploads its texture again. build may be nil, and
+// typically clears the atlas and adds fonts at a new size.
+// It may be called from any goroutine.
+func (w *Window) ReloadFonts(build func(atlas ImFontAtlas)) {
+	w.Post(func() {
+		atlas := GetIO().GetFonts()
+		if build != nil {
+			build(atlas)
+		}
+
+		atlas.Build()
+		w.backend.ReloadFontsTexture()
+	})
+}
+
+// SetClearColor sets the background of the window behind the dear imgui
+// windows, see NewImVec4. With GLFWWindowFlagsTransparent, an alpha below 1
+// lets the desktop show through, e.g. for overlays drawing only their windows.
+func (w *Window) SetClearColor(color ImVec4) {
+	w.backend.SetClearColor(color)
+	w.Refresh()
+}
+
+// SetLoop sets the function building the UI, called once per frame.
+func (w *Window) SetLoop(loop func()) {
+	w.loop = loop
+}
+
+// SetBeforeRenderHook sets a function called before each frame.
+func (w *Window) SetBeforeRenderHook(hook func()) {
+	w.beforeRender = hook
+}
+
+// SetAfterRenderHook sets a function called after each frame and its events are processed.
+func (w *Window) SetAfterRenderHook(hook func()) {
+	w.afterRender = hook
+}
+
+// ShouldClose reports whether the window was closed or the user asked to close it.
+func (w *Window) ShouldClose() bool {
+	return w.closed || w.closing || w.backend.ShouldClose()
+}
+
+// Close shuts the backend down. When called from the loop, the current
+// frame is finished first. Closing a closed window does nothing.
+func (w *Window) Close() {
+	if w.closed {
+		return
+	}
+
+	w.backend.SetShouldClose(true)
+
+	if w.inFrame || w.rendering {
+		w.closing = true
+		return
+	}
+
+	w.closed = true
+	w.closeTasks()
+	w.saveIniSettingsOnClose()
+
+	context := w.backend.Context()
+	SetCurrentContext(context)
+	io := GetIO()
+
+	w.backend.Shutdown()
+	releaseSettingsHandlers(context)
+	releaseClipboardHandler(io)
+}
+
+// Step renders exactly one frame, then processes the pending events
+// without waiting for new ones. It does nothing once the window is closed.
+func (w *Window) Step() {
+	if w.closed {
+		return
+	}
+
+	w.inFrame = true
+
+	if w.beforeRender != nil {
+		w.beforeRender()
+	}
+
+	w.render()
+
+	w.backend.ProcessEvents()
+
+	if w.afterRender != nil {
+		w.afterRender()
+	}
+
+	w.inFrame = false
+
+	if w.closing {
+		w.closing = false
+		w.Close()
+	}
+}
+
+// Run sets loop and steps frames until the window should close, then closes it.
+// Between frames it sleeps to honor the target FPS, and waits for events
+// once the window is idle.
+func (w *Window) Run(loop func()) {
+	w.SetLoop(loop)
+	RunWindows(w)
+}
+
+// RunWindows steps the windows, each at its own frame rate, closing them
+// as they should close, until all of them are closed. Once every window
+// is idle, it sleeps until an event arrives.
+func RunWindows(windows ...*Window) {
+	open := make([]*Window, 0, len(windows))
+	now := time.Now()
+	for _, w := range windows {
+		w.nextFrame = now
+		w.wake(now)
+		open = append(open, w)
+	}
+
+	for {
+		now := time.Now()
+		for _, w := range open {
+			if w.refresh.CompareAndSwap(true, false) {
+				w.wake(now)
+			}
+
+			if !w.active(now) || now.Before(w.nextFrame) {
+				continue
+			}
+
+			w.Step()
+			w.scheduleNextFrame(now)
+		}
+
+		running := open[:0]
+		for _, w := range open {
+			if w.ShouldClose() {
+				w.Close()
+			} else {
+				running = append(running, w)
+			}
+		}
+		open = running
+
+		if len(open) == 0 {
+			return
+		}
+
+		// Events are waited for on a single backend: GLFW polls all its
+		// windows at once, and the other windows wake it up on Refresh and Post.
+		backend := open[0].backend
+		for _, w := range open {
+			w.setWaiter(backend)
+		}
+
+		active := false
+		var next time.Time
+		for _, w := range open {
+			if w.active(now) || w.refresh.Load() {
+				if !active || w.nextFrame.Before(next) {
+					next = w.nextFrame
+				}
+				active = true
+			}
+		}
+
+		if !active {
+			backend.WaitEvents()
+			wakeWindows(open, time.Now())
+			continue
+		}
+
+		if wait := time.Until(next); wait > 0 {
+			backend.WaitEventsTimeout(wait)
+			if now := time.Now(); now.Before(next) {
+				wakeWindows(open, now)
+			}
+		}
+	}
+}
+
+func wakeWindows(windows []*Window, now time.Time) {
+	for _, w := range windows {
+		w.wake(now)
+	}
+}
+
+func (w *Window) wake(now time.Time) {
+	w.activeUntil = now.Add(w.idleTimeout)
+	w.framesLeft = wakeFrameCount
+}
+
+func (w *Window) active(now time.Time) bool {
+	switch {
+	case w.continuous:
+		return true
+	case w.onDemand:
+		return w.framesLeft > 0
+	default:
+		return w.framesLeft > 0 || now.Before(w.activeUntil)
+	}
+}
+
+func (w *Window) scheduleNextFrame(now time.Time) {
+	if w.framesLeft > 0 {
+		w.framesLeft--
+	}
+
+	fps := w.targetFPS()
+	if fps == 0 {
+		w.nextFrame = now
+		return
+	}
+
+	w.nextFrame = w.nextFrame.Add(time.Second / time.Duration(fps))
+	if w.nextFrame.Before(now) {
+		w.nextFrame = now
+	}
+}
+
+// updateMouseCursor shows the cursor requested by the frame, unless the
+// application keeps the cursor to itself.
+func (w *Window) updateMouseCursor() {
+	io := GetIO()
+	if io.GetConfigFlags()&ImGuiConfigFlags_NoMouseCursorChange != 0 {
+		return
+	}
+
+	cursor := GetMouseCursor()
+	if io.GetMouseDrawCursor() {
+		cursor = ImGuiMouseCursor_None
+	}
+
+	w.backend.SetMouseCursor(cursor)
+}
+
+func (w *Window) render() {
+	w.rendering = true
+	defer func() { w.rendering = false }()
+
+	w.newBackendFrame()
+	w.useBackendClipboard()
+	w.runTasks()
+	w.updateDPIScale()
+	w.updateGamepad()
+	NewFrame()
+
+	if w.loop != nil {
+		w.loop()
+	}
+
+	Render()
+	w.updateMouseCursor()
+	w.saveIniSettings()
+	w.backend.RenderDrawData(GetDrawData())
+}